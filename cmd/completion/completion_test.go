@@ -8,6 +8,7 @@ import (
 
 	"github.com/opendatahub-io/odh-cli/cmd/completion"
 	"github.com/opendatahub-io/odh-cli/cmd/get"
+	"github.com/opendatahub-io/odh-cli/cmd/lint"
 	"github.com/opendatahub-io/odh-cli/cmd/logs"
 
 	. "github.com/onsi/gomega"
@@ -126,3 +127,39 @@ func TestLogsCommandCompletion(t *testing.T) {
 	g.Expect(output).To(ContainSubstring("dashboard"))
 	g.Expect(output).To(ContainSubstring("kserve"))
 }
+
+func TestLintChecksCompletion(t *testing.T) {
+	g := NewWithT(t)
+
+	root := &cobra.Command{Use: "kubectl-odh"}
+	lint.AddCommand(root, nil)
+
+	var buf bytes.Buffer
+	root.SetOut(&buf)
+	root.SetArgs([]string{"__complete", "lint", "list-checks", "--checks", ""})
+
+	err := root.Execute()
+	g.Expect(err).ToNot(HaveOccurred())
+
+	output := buf.String()
+	g.Expect(output).To(ContainSubstring("workloads"))
+	g.Expect(output).To(ContainSubstring("platform.dsc.readiness"))
+}
+
+func TestLintTargetVersionCompletion(t *testing.T) {
+	g := NewWithT(t)
+
+	root := &cobra.Command{Use: "kubectl-odh"}
+	lint.AddCommand(root, nil)
+
+	var buf bytes.Buffer
+	root.SetOut(&buf)
+	root.SetArgs([]string{"__complete", "lint", "--target-version", ""})
+
+	err := root.Execute()
+	g.Expect(err).ToNot(HaveOccurred())
+
+	output := buf.String()
+	g.Expect(output).To(ContainSubstring("latest"))
+	g.Expect(output).To(ContainSubstring("3.0"))
+}
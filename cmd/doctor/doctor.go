@@ -0,0 +1,81 @@
+package doctor
+
+import (
+	"github.com/spf13/cobra"
+
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	doctorpkg "github.com/opendatahub-io/odh-cli/pkg/doctor"
+	clierrors "github.com/opendatahub-io/odh-cli/pkg/util/errors"
+)
+
+const (
+	cmdName  = "doctor"
+	cmdShort = "Collect a support bundle for ODH/RHOAI troubleshooting"
+)
+
+const cmdLong = `
+Collect a support bundle for ODH/RHOAI troubleshooting.
+
+The bundle is a gzipped tarball containing:
+  lint/report.json       lint results in JSON (including upgrade checks with --target-version)
+  manifests/             the DataScienceCluster and DSCInitialization
+  logs/                  operator pod logs, including previous instances of restarted containers
+  events/                recent events in the operator, applications and monitoring namespaces
+  summary.yaml           what each step collected and any problems it ran into
+
+Collection problems, such as missing permissions or an unreachable operator, are
+reported as warnings and recorded in summary.yaml; the bundle is still written.
+`
+
+const cmdExample = `
+  # Collect a support bundle with the default settings
+  kubectl odh doctor
+
+  # Write the bundle to a custom path, including upgrade readiness checks
+  kubectl odh doctor --output bundle.tar.gz --target-version 3.0.0
+
+  # Collect the last 6 hours of logs and events without running lint
+  kubectl odh doctor --since 6h --skip-lint
+`
+
+// AddCommand adds the doctor command to the root command.
+func AddCommand(root *cobra.Command, flags *genericclioptions.ConfigFlags) {
+	streams := genericiooptions.IOStreams{
+		In:     root.InOrStdin(),
+		Out:    root.OutOrStdout(),
+		ErrOut: root.ErrOrStderr(),
+	}
+
+	command := doctorpkg.NewCommand(streams, flags)
+
+	cmd := &cobra.Command{
+		Use:           cmdName,
+		Short:         cmdShort,
+		Long:          cmdLong,
+		Example:       cmdExample,
+		Args:          cobra.NoArgs,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if err := command.Complete(); err != nil {
+				return clierrors.HandleError(cmd, err, "")
+			}
+
+			if err := command.Validate(); err != nil {
+				return clierrors.HandleError(cmd, err, "")
+			}
+
+			if err := command.Run(cmd.Context()); err != nil {
+				return clierrors.HandleError(cmd, err, "")
+			}
+
+			return nil
+		},
+	}
+
+	command.AddFlags(cmd.Flags())
+
+	root.AddCommand(cmd)
+}
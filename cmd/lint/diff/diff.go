@@ -0,0 +1,78 @@
+package diff
+
+import (
+	"github.com/spf13/cobra"
+
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	lintpkg "github.com/opendatahub-io/odh-cli/pkg/lint"
+	clierrors "github.com/opendatahub-io/odh-cli/pkg/util/errors"
+)
+
+const (
+	cmdName  = "diff OLD NEW"
+	cmdShort = "Compare two saved lint reports"
+)
+
+const cmdLong = `
+Compare two lint reports saved with 'lint -o json' or 'lint -o yaml' to track
+remediation progress between runs. No cluster is contacted.
+
+Checks that started failing (+), stopped failing (-), or whose impact, findings
+or impacted objects changed (~) are reported, each followed by the impacted
+objects that appeared (+) or disappeared (-). Findings are matched by their
+baseline fingerprint, so reworded messages are not reported as changes.
+
+To compare reports recorded in the cluster with 'lint --record', use
+'lint history FROM TO'.
+`
+
+const cmdExample = `
+  # Save a report before and after remediation, then compare them
+  kubectl odh lint --target-version 3.0 -o json > before.json
+  kubectl odh lint --target-version 3.0 -o json > after.json
+  kubectl odh lint diff before.json after.json
+
+  # Get the changes as JSON for automation
+  kubectl odh lint diff before.json after.json -o json
+`
+
+// AddCommand adds the diff subcommand to the lint command.
+func AddCommand(
+	parent *cobra.Command,
+	streams genericiooptions.IOStreams,
+) {
+	command := lintpkg.NewDiffCommand(streams)
+
+	cmd := &cobra.Command{
+		Use:           cmdName,
+		Short:         cmdShort,
+		Long:          cmdLong,
+		Example:       cmdExample,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		Args:          cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			outputFormat := string(command.OutputFormat)
+			command.OldFile, command.NewFile = args[0], args[1]
+
+			if err := command.Complete(); err != nil {
+				return clierrors.HandleError(cmd, err, outputFormat)
+			}
+
+			if err := command.Validate(); err != nil {
+				return clierrors.HandleError(cmd, err, outputFormat)
+			}
+
+			if err := command.Run(cmd.Context()); err != nil {
+				return clierrors.HandleError(cmd, err, outputFormat)
+			}
+
+			return nil
+		},
+	}
+
+	command.AddFlags(cmd.Flags())
+
+	parent.AddCommand(cmd)
+}
@@ -0,0 +1,78 @@
+package exportsnapshot
+
+import (
+	"github.com/spf13/cobra"
+
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	lintpkg "github.com/opendatahub-io/odh-cli/pkg/lint"
+	clierrors "github.com/opendatahub-io/odh-cli/pkg/util/errors"
+)
+
+const (
+	cmdName  = "export-snapshot"
+	cmdShort = "Export the cluster state required by lint checks to a tarball"
+)
+
+const cmdLong = `
+Collect exactly the resources read by the selected lint checks into a gzipped
+tarball so the assessment can be run offline with 'lint --from-dir'.
+
+Each resource type is written as a single YAML List document. Resource types
+that the cluster does not serve are skipped. Secret values (data and
+stringData) are replaced with a placeholder before writing; their keys are
+kept, as checks validate which keys a Secret carries. The
+last-applied-configuration annotation and the managed fields of Secrets,
+which can carry their values, are dropped.
+`
+
+const cmdExample = `
+  # Export the state needed by all checks
+  kubectl odh lint export-snapshot
+
+  # Export only what the notebook checks need
+  kubectl odh lint export-snapshot --checks "*notebook*" -f notebooks.tar.gz
+
+  # Run the lint offline against the exported snapshot
+  kubectl odh lint --from-dir odh-lint-snapshot.tar.gz --target-version 3.0.0
+`
+
+// AddCommand adds the export-snapshot subcommand to the lint command.
+func AddCommand(
+	parent *cobra.Command,
+	flags *genericclioptions.ConfigFlags,
+	streams genericiooptions.IOStreams,
+) {
+	command := lintpkg.NewExportSnapshotCommand(streams, flags)
+
+	cmd := &cobra.Command{
+		Use:           cmdName,
+		Short:         cmdShort,
+		Long:          cmdLong,
+		Example:       cmdExample,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			outputFormat := string(command.OutputFormat)
+
+			if err := command.Complete(); err != nil {
+				return clierrors.HandleError(cmd, err, outputFormat)
+			}
+
+			if err := command.Validate(); err != nil {
+				return clierrors.HandleError(cmd, err, outputFormat)
+			}
+
+			if err := command.Run(cmd.Context()); err != nil {
+				return clierrors.HandleError(cmd, err, outputFormat)
+			}
+
+			return nil
+		},
+	}
+
+	command.AddFlags(cmd.Flags())
+
+	parent.AddCommand(cmd)
+}
@@ -0,0 +1,88 @@
+package history
+
+import (
+	"github.com/spf13/cobra"
+
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	lintpkg "github.com/opendatahub-io/odh-cli/pkg/lint"
+	clierrors "github.com/opendatahub-io/odh-cli/pkg/util/errors"
+)
+
+const (
+	cmdName  = "history [FROM TO]"
+	cmdShort = "List or compare lint reports recorded in the cluster"
+)
+
+const cmdLong = `
+List the lint reports recorded with 'lint --record', or compare two of them.
+
+Reports are stored as ConfigMaps labeled app.kubernetes.io/component=lint-report
+in the operator namespace (override with -n). Each report holds the full
+DiagnosticResultList of the run, annotated with the cluster, target and
+OpenShift versions, the CLI version and commit, and the result counts.
+
+Given two report names (oldest first), the checks that started or stopped
+failing, or whose findings or impacted objects changed, are reported. The
+odh-lint-report- prefix of the names may be omitted.
+`
+
+const cmdExample = `
+  # Record a report, then list the recorded reports
+  kubectl odh lint --target-version 3.0 --record
+  kubectl odh lint history
+
+  # Compare two recorded reports
+  kubectl odh lint history odh-lint-report-20261001-080000 odh-lint-report-20261015-080000
+
+  # Compare as JSON, using the short names
+  kubectl odh lint history 20261001-080000 20261015-080000 -o json
+`
+
+// AddCommand adds the history subcommand to the lint command.
+func AddCommand(
+	parent *cobra.Command,
+	flags *genericclioptions.ConfigFlags,
+	streams genericiooptions.IOStreams,
+) {
+	command := lintpkg.NewHistoryCommand(streams, flags)
+
+	cmd := &cobra.Command{
+		Use:           cmdName,
+		Short:         cmdShort,
+		Long:          cmdLong,
+		Example:       cmdExample,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		Args: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 0 {
+				return nil
+			}
+
+			return cobra.ExactArgs(2)(cmd, args)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			outputFormat := string(command.OutputFormat)
+			command.Reports = args
+
+			if err := command.Complete(); err != nil {
+				return clierrors.HandleError(cmd, err, outputFormat)
+			}
+
+			if err := command.Validate(); err != nil {
+				return clierrors.HandleError(cmd, err, outputFormat)
+			}
+
+			if err := command.Run(cmd.Context()); err != nil {
+				return clierrors.HandleError(cmd, err, outputFormat)
+			}
+
+			return nil
+		},
+	}
+
+	command.AddFlags(cmd.Flags())
+
+	parent.AddCommand(cmd)
+}
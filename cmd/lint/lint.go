@@ -9,6 +9,14 @@ import (
 	"k8s.io/cli-runtime/pkg/genericclioptions"
 	"k8s.io/cli-runtime/pkg/genericiooptions"
 
+	"github.com/opendatahub-io/odh-cli/cmd/lint/diff"
+	"github.com/opendatahub-io/odh-cli/cmd/lint/exportsnapshot"
+	"github.com/opendatahub-io/odh-cli/cmd/lint/history"
+	"github.com/opendatahub-io/odh-cli/cmd/lint/listchecks"
+	"github.com/opendatahub-io/odh-cli/cmd/lint/permissions"
+	"github.com/opendatahub-io/odh-cli/cmd/lint/rbac"
+	"github.com/opendatahub-io/odh-cli/cmd/lint/schema"
+	"github.com/opendatahub-io/odh-cli/cmd/lint/versions"
 	lintpkg "github.com/opendatahub-io/odh-cli/pkg/lint"
 	clierrors "github.com/opendatahub-io/odh-cli/pkg/util/errors"
 )
@@ -45,6 +53,17 @@ Examples:
   # Assess upgrade readiness for version 3.0
   kubectl odh lint --target-version 3.0
 
+  # Assess upgrade readiness for the newest version offered by the operator catalog
+  kubectl odh lint --target-version latest
+
+  # Assess each upgrade hop of a multi-step upgrade, showing which hop introduces which blockers
+  kubectl odh lint --target-version 3.0,3.2
+  kubectl odh lint --until-version 3.2
+
+  # Assess a fleet of clusters from the contexts of the kubeconfig
+  kubectl odh lint --target-version 3.0 --contexts prod-east,prod-west
+  kubectl odh lint --target-version 3.0 --all-contexts -o json
+
   # Validate with JSON output
   kubectl odh lint -o json
 
@@ -61,11 +80,102 @@ const cmdExample = `
   # Output results in JSON format
   kubectl odh lint -o json
 
+  # Write a JUnit XML report for CI pipelines
+  kubectl odh lint --target-version 3.0 -o junit > lint-report.xml
+
+  # Write a standalone HTML report to attach to an upgrade change request
+  kubectl odh lint --target-version 3.0 -o html > lint-report.html
+
+  # Render results as Markdown to post as a pull request comment
+  kubectl odh lint --target-version 3.0 -o markdown > lint-report.md
+
+  # Load selectors, overrides and suppressions from a config file
+  kubectl odh lint --config .odh-lint.yaml
+
+  # Record current findings, then only report new ones in later runs
+  kubectl odh lint --target-version 3.0 --baseline-write baseline.json
+  kubectl odh lint --target-version 3.0 --baseline-compare baseline.json
+
+  # Compare two saved reports to track remediation progress
+  kubectl odh lint diff before.json after.json
+
+  # Record the report in the cluster and compare it with earlier runs
+  kubectl odh lint --target-version 3.0 --record
+  kubectl odh lint history
+
+  # Publish findings as Prometheus gauges for the node_exporter textfile collector
+  kubectl odh lint --target-version 3.0 -q --metrics-file /var/lib/node_exporter/odh_lint.prom
+
+  # Re-run checks every hour and report only what changed between runs
+  kubectl odh lint --target-version 3.0 --watch --interval 1h
+
+  # Verify the current user can read everything the checks need, without running them
+  kubectl odh lint --dry-run-permissions
+
+  # In a Job or CronJob, fail fast when the pod's service account lacks permissions
+  kubectl odh lint --target-version 3.0 --service-account-check -o json
+
+  # Report impacted workloads per namespace, with the namespace requester
+  kubectl odh lint --target-version 3.0 --group-by namespace
+
+  # List impacted objects by namespace instead of by image or runtime
+  kubectl odh lint --target-version 3.0 --verbose --group-render=off
+
+  # Write one Markdown report per namespace requester to reports/
+  kubectl odh lint --target-version 3.0 --split-by requester --output-dir reports/ -o markdown
+
+  # Write one JSON report per owner of the impacted objects (owner label, notebook user, Argo CD application, requester)
+  kubectl odh lint --target-version 3.0 --split-by owner --output-dir reports/ -o json
+
+  # Tell impacted workloads in use apart from idle ones, to remediate the former first
+  kubectl odh lint --target-version 3.0 --detect-usage -o wide
+
+  # List the findings with the highest risk score first, to remediate them first
+  kubectl odh lint --target-version 3.0 --detect-usage --sort-by score
+
+  # Also inspect the pods of flagged workloads for stale images and restart loops
+  kubectl odh lint --target-version 3.0 --deep
+
+  # Correlate workbench images pulled from a mirror registry on a disconnected cluster
+  kubectl odh lint --target-version 3.0 --registry-mirror registry.redhat.io/rhoai=mirror.example.com/rhoai
+
+  # Verify custom workbench images against their registry to spot renamed OOTB images
+  kubectl odh lint --target-version 3.0 --verify-registry
+
+  # Keep memory low on a cluster with thousands of workloads
+  kubectl odh lint --target-version 3.0 --low-memory
+
+  # Write the debug diagnostics of the checks as JSON to a file
+  kubectl odh lint --target-version 3.0 --log-level debug --log-format json --log-file lint.log
+
+  # Record every API read of the run, to debug a slow run
+  kubectl odh lint --target-version 3.0 --trace api-calls.json
+
+  # Export check and API call spans to an OpenTelemetry collector
+  OTEL_EXPORTER_OTLP_ENDPOINT=http://otel-collector:4318 kubectl odh lint --target-version 3.0
+
+  # Assess only the workloads of one team
+  kubectl odh lint --target-version 3.0 --selector app=team-a
+
   # Run only dashboard-related checks
   kubectl odh lint --checks "*dashboard*"
 
+  # Run the quick checks only, or skip the notebook checks on a large cluster
+  kubectl odh lint --target-version 3.0 --checks @fast
+  kubectl odh lint --target-version 3.0 --checks '!workloads.notebook.*'
+
   # Check upgrade readiness to version 3.1
   kubectl odh lint --target-version 3.1
+
+  # Preview and apply available remediations
+  kubectl odh lint --target-version 3.0 --fix
+
+  # Assess upgrade readiness offline from exported resources (e.g. a must-gather)
+  kubectl odh lint --target-version 3.0 --from-dir ./must-gather
+
+  # Export the state needed by the checks and assess it offline later
+  kubectl odh lint export-snapshot -f snapshot.tar.gz
+  kubectl odh lint --target-version 3.0 --from-dir snapshot.tar.gz
 `
 
 // wrapHandledError wraps an error as already-handled with its derived exit code,
@@ -161,5 +271,38 @@ func AddCommand(root *cobra.Command, flags *genericclioptions.ConfigFlags) {
 	// Register flags using AddFlags method
 	command.AddFlags(cmd.Flags())
 
+	diff.AddCommand(cmd, streams)
+	exportsnapshot.AddCommand(cmd, flags, streams)
+	history.AddCommand(cmd, flags, streams)
+	listchecks.AddCommand(cmd, streams)
+	permissions.AddCommand(cmd, streams)
+	rbac.AddCommand(cmd, streams)
+	schema.AddCommand(cmd)
+	versions.AddCommand(cmd, flags, streams)
+
+	registerFlagCompletions(cmd)
+
 	root.AddCommand(cmd)
 }
+
+// registerFlagCompletions completes --checks with the check IDs and --target-version with the
+// known release versions, on lint and on every subcommand with these flags.
+func registerFlagCompletions(cmd *cobra.Command) {
+	for _, c := range append([]*cobra.Command{cmd}, cmd.Commands()...) {
+		if c.Flags().Lookup("checks") != nil {
+			_ = c.RegisterFlagCompletionFunc("checks",
+				func(_ *cobra.Command, _ []string, _ string) ([]string, cobra.ShellCompDirective) {
+					return lintpkg.CheckSelectorValues(), cobra.ShellCompDirectiveNoFileComp
+				},
+			)
+		}
+
+		if c.Flags().Lookup("target-version") != nil {
+			_ = c.RegisterFlagCompletionFunc("target-version",
+				func(_ *cobra.Command, _ []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+					return lintpkg.TargetVersionValues(toComplete), cobra.ShellCompDirectiveNoFileComp
+				},
+			)
+		}
+	}
+}
@@ -0,0 +1,72 @@
+package listchecks
+
+import (
+	"github.com/spf13/cobra"
+
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	lintpkg "github.com/opendatahub-io/odh-cli/pkg/lint"
+	clierrors "github.com/opendatahub-io/odh-cli/pkg/util/errors"
+)
+
+const (
+	cmdName  = "list-checks"
+	cmdShort = "List registered lint checks and their metadata"
+)
+
+const cmdLong = `
+List every registered lint check without contacting a cluster.
+
+For each check the catalog includes its ID, name, group, description,
+remediation guidance, the versions it applies to, whether 'lint --fix' can
+remediate its findings, and the resource types (with the get/list permissions)
+it reads. Resources read by every run are reported once as platformResources.
+
+The table view shows a summary; use -o json or -o yaml for the full catalog.
+`
+
+const cmdExample = `
+  # List all checks
+  kubectl odh lint list-checks
+
+  # List workload checks as JSON for automation
+  kubectl odh lint list-checks --checks "workloads.*" -o json
+`
+
+// AddCommand adds the list-checks subcommand to the lint command.
+func AddCommand(
+	parent *cobra.Command,
+	streams genericiooptions.IOStreams,
+) {
+	command := lintpkg.NewListChecksCommand(streams)
+
+	cmd := &cobra.Command{
+		Use:           cmdName,
+		Short:         cmdShort,
+		Long:          cmdLong,
+		Example:       cmdExample,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			outputFormat := string(command.OutputFormat)
+
+			if err := command.Complete(); err != nil {
+				return clierrors.HandleError(cmd, err, outputFormat)
+			}
+
+			if err := command.Validate(); err != nil {
+				return clierrors.HandleError(cmd, err, outputFormat)
+			}
+
+			if err := command.Run(cmd.Context()); err != nil {
+				return clierrors.HandleError(cmd, err, outputFormat)
+			}
+
+			return nil
+		},
+	}
+
+	command.AddFlags(cmd.Flags())
+
+	parent.AddCommand(cmd)
+}
@@ -0,0 +1,73 @@
+package permissions
+
+import (
+	"github.com/spf13/cobra"
+
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	lintpkg "github.com/opendatahub-io/odh-cli/pkg/lint"
+	clierrors "github.com/opendatahub-io/odh-cli/pkg/util/errors"
+)
+
+const (
+	cmdName  = "permissions"
+	cmdShort = "Print the minimal ClusterRole needed to run lint checks"
+)
+
+const cmdLong = `
+Print a ClusterRole granting exactly the read access (get, list) needed to run
+the selected lint checks, without contacting a cluster.
+
+The role covers the resources read by every run (platform resources such as
+DataScienceCluster and ClusterVersion) plus the resources declared by each
+selected check. Bind it to a user or service account to run lint read-only.
+
+Use 'kubectl odh lint --dry-run-permissions' to verify the permissions of the
+current user against a cluster.
+`
+
+const cmdExample = `
+  # Print the ClusterRole needed by all checks
+  kubectl odh lint permissions
+
+  # Create the role for workload checks only
+  kubectl odh lint permissions --checks "workloads.*" --name odh-lint-workloads | kubectl apply -f -
+`
+
+// AddCommand adds the permissions subcommand to the lint command.
+func AddCommand(
+	parent *cobra.Command,
+	streams genericiooptions.IOStreams,
+) {
+	command := lintpkg.NewPermissionsCommand(streams)
+
+	cmd := &cobra.Command{
+		Use:           cmdName,
+		Short:         cmdShort,
+		Long:          cmdLong,
+		Example:       cmdExample,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			outputFormat := string(command.OutputFormat)
+
+			if err := command.Complete(); err != nil {
+				return clierrors.HandleError(cmd, err, outputFormat)
+			}
+
+			if err := command.Validate(); err != nil {
+				return clierrors.HandleError(cmd, err, outputFormat)
+			}
+
+			if err := command.Run(cmd.Context()); err != nil {
+				return clierrors.HandleError(cmd, err, outputFormat)
+			}
+
+			return nil
+		},
+	}
+
+	command.AddFlags(cmd.Flags())
+
+	parent.AddCommand(cmd)
+}
@@ -0,0 +1,75 @@
+package rbac
+
+import (
+	"github.com/spf13/cobra"
+
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	lintpkg "github.com/opendatahub-io/odh-cli/pkg/lint"
+	clierrors "github.com/opendatahub-io/odh-cli/pkg/util/errors"
+)
+
+const (
+	cmdName  = "rbac"
+	cmdShort = "Generate a ClusterRole and ClusterRoleBinding for running lint read-only"
+)
+
+const cmdLong = `
+Generate a List with a ClusterRole granting exactly the read access (get, list)
+needed by the selected lint checks and a ClusterRoleBinding granting it to the
+given subjects, without contacting a cluster.
+
+The resources are derived from the resource types each registered check
+declares, plus the platform resources read by every run. When no subject is
+given, the service account odh-lint in namespace odh-lint is bound.
+
+Use 'kubectl odh lint permissions' to print only the ClusterRole.
+`
+
+const cmdExample = `
+  # Grant a read-only lint service account
+  kubectl create namespace odh-lint
+  kubectl create serviceaccount odh-lint -n odh-lint
+  kubectl odh lint rbac | kubectl apply -f -
+
+  # Bind a group instead, covering workload checks only
+  kubectl odh lint rbac --group upgrade-admins --checks "workloads.*" -o json
+`
+
+// AddCommand adds the rbac subcommand to the lint command.
+func AddCommand(
+	parent *cobra.Command,
+	streams genericiooptions.IOStreams,
+) {
+	command := lintpkg.NewRBACCommand(streams)
+
+	cmd := &cobra.Command{
+		Use:           cmdName,
+		Short:         cmdShort,
+		Long:          cmdLong,
+		Example:       cmdExample,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			outputFormat := string(command.OutputFormat)
+
+			if err := command.Complete(); err != nil {
+				return clierrors.HandleError(cmd, err, outputFormat)
+			}
+
+			if err := command.Validate(); err != nil {
+				return clierrors.HandleError(cmd, err, outputFormat)
+			}
+
+			if err := command.Run(cmd.Context()); err != nil {
+				return clierrors.HandleError(cmd, err, outputFormat)
+			}
+
+			return nil
+		},
+	}
+
+	command.AddFlags(cmd.Flags())
+
+	parent.AddCommand(cmd)
+}
@@ -0,0 +1,36 @@
+package schema
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	schemapkg "github.com/opendatahub-io/odh-cli/pkg/schema"
+)
+
+const (
+	cmdName  = "schema"
+	cmdShort = "Output the JSON Schema of the lint JSON/YAML output"
+)
+
+// AddCommand adds the hidden schema subcommand to the lint command. It prints the same
+// DiagnosticResultList schema as 'lint --schema' for tooling that prefers a subcommand.
+func AddCommand(parent *cobra.Command) {
+	cmd := &cobra.Command{
+		Use:           cmdName,
+		Short:         cmdShort,
+		Hidden:        true,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		Args:          cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if err := schemapkg.WriteTo(cmd.OutOrStdout(), schemapkg.SchemaDiagnosticResultList); err != nil {
+				return fmt.Errorf("outputting schema: %w", err)
+			}
+
+			return nil
+		},
+	}
+
+	parent.AddCommand(cmd)
+}
@@ -0,0 +1,81 @@
+package versions
+
+import (
+	"github.com/spf13/cobra"
+
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	lintpkg "github.com/opendatahub-io/odh-cli/pkg/lint"
+	clierrors "github.com/opendatahub-io/odh-cli/pkg/util/errors"
+)
+
+const (
+	cmdName  = "versions"
+	cmdShort = "List the OpenShift AI versions offered by the cluster's operator catalog"
+)
+
+const cmdLong = `
+List the RHOAI or ODH operator versions offered by the OLM catalog of the cluster,
+i.e. the versions an upgrade can target.
+
+The catalog and package are taken from the operator Subscription; without one,
+the rhods-operator or opendatahub-operator PackageManifest of any catalog is
+used. Pre-release versions are skipped.
+
+The installed version is marked, along with the versions the --target-version
+aliases resolve to:
+  - latest: the newest version offered by the catalog
+  - next:   the newest patch of the first minor release after the installed version
+`
+
+const cmdExample = `
+  # List the versions offered by the catalog
+  kubectl odh lint versions
+
+  # Assess upgrade readiness for the next minor release
+  kubectl odh lint --target-version next
+
+  # List the versions as JSON
+  kubectl odh lint versions -o json
+`
+
+// AddCommand adds the versions subcommand to the lint command.
+func AddCommand(
+	parent *cobra.Command,
+	flags *genericclioptions.ConfigFlags,
+	streams genericiooptions.IOStreams,
+) {
+	command := lintpkg.NewVersionsCommand(streams, flags)
+
+	cmd := &cobra.Command{
+		Use:           cmdName,
+		Short:         cmdShort,
+		Long:          cmdLong,
+		Example:       cmdExample,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		Args:          cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			outputFormat := string(command.OutputFormat)
+
+			if err := command.Complete(); err != nil {
+				return clierrors.HandleError(cmd, err, outputFormat)
+			}
+
+			if err := command.Validate(); err != nil {
+				return clierrors.HandleError(cmd, err, outputFormat)
+			}
+
+			if err := command.Run(cmd.Context()); err != nil {
+				return clierrors.HandleError(cmd, err, outputFormat)
+			}
+
+			return nil
+		},
+	}
+
+	command.AddFlags(cmd.Flags())
+
+	parent.AddCommand(cmd)
+}
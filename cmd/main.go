@@ -13,14 +13,18 @@ import (
 	"github.com/opendatahub-io/odh-cli/cmd/components"
 	"github.com/opendatahub-io/odh-cli/cmd/deps"
 	"github.com/opendatahub-io/odh-cli/cmd/diagnose"
+	"github.com/opendatahub-io/odh-cli/cmd/doctor"
 	"github.com/opendatahub-io/odh-cli/cmd/events"
 	"github.com/opendatahub-io/odh-cli/cmd/get"
 	"github.com/opendatahub-io/odh-cli/cmd/lint"
 	"github.com/opendatahub-io/odh-cli/cmd/logs"
 	"github.com/opendatahub-io/odh-cli/cmd/mcp"
 	"github.com/opendatahub-io/odh-cli/cmd/migrate"
+	"github.com/opendatahub-io/odh-cli/cmd/plan"
 	"github.com/opendatahub-io/odh-cli/cmd/status"
+	"github.com/opendatahub-io/odh-cli/cmd/upgrade"
 	"github.com/opendatahub-io/odh-cli/cmd/version"
+	"github.com/opendatahub-io/odh-cli/cmd/workloads"
 	clierrors "github.com/opendatahub-io/odh-cli/pkg/util/errors"
 )
 
@@ -49,8 +53,12 @@ func main() {
 	completion.AddCommand(cmd, flags)
 	mcp.AddCommand(cmd, flags)
 	migrate.AddCommand(cmd, flags)
+	plan.AddCommand(cmd, flags)
 	events.AddCommand(cmd, flags)
 	diagnose.AddCommand(cmd, flags)
+	doctor.AddCommand(cmd, flags)
+	workloads.AddCommand(cmd, flags)
+	upgrade.AddCommand(cmd, flags)
 
 	if err := cmd.Execute(); err != nil {
 		exitCode := int(clierrors.ExitCodeFromError(err))
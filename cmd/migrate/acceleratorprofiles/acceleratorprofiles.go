@@ -0,0 +1,92 @@
+package acceleratorprofiles
+
+import (
+	"github.com/spf13/cobra"
+
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/opendatahub-io/odh-cli/pkg/migrate"
+	clierrors "github.com/opendatahub-io/odh-cli/pkg/util/errors"
+)
+
+const (
+	cmdName  = "accelerator-profiles"
+	cmdShort = "Generate HardwareProfiles for workloads using AcceleratorProfiles"
+)
+
+const cmdLong = `
+Generate the manifests migrating workloads from AcceleratorProfiles to HardwareProfiles.
+
+For every Notebook and InferenceService flagged by the accelerator migration checks
+(annotated with opendatahub.io/accelerator-name but no opendatahub.io/hardware-profile-name),
+the command generates:
+
+  - a HardwareProfile (infrastructure.opendatahub.io) per AcceleratorProfile and workload
+    type, named <accelerator-profile>-notebooks or <accelerator-profile>-serving, exposing
+    the accelerator identifier alongside CPU and memory and carrying its tolerations
+  - a merge patch annotating the workload with its HardwareProfile
+
+HardwareProfiles that already exist are not regenerated. Workloads referencing a missing
+AcceleratorProfile are reported on stderr and need manual migration.
+
+By default the manifests are printed to stdout as a YAML stream. Use --output-dir to write
+one file per resource instead; patches are written under <dir>/patches and can be applied with
+'kubectl patch --type merge --patch-file'. Use --apply to create the HardwareProfiles and
+patch the workloads directly; combined with --dry-run the changes are only validated by the
+API server.
+`
+
+const cmdExample = `
+  # Preview the manifests
+  kubectl odh migrate accelerator-profiles --dry-run
+
+  # Write the manifests to a directory for review
+  kubectl odh migrate accelerator-profiles --output-dir ./accelerator-migration
+
+  # Validate the changes against the API server without persisting them
+  kubectl odh migrate accelerator-profiles --apply --dry-run
+
+  # Apply the changes without prompting
+  kubectl odh migrate accelerator-profiles --apply --yes
+`
+
+// AddCommand adds the accelerator-profiles subcommand to the migrate command.
+func AddCommand(
+	parent *cobra.Command,
+	flags *genericclioptions.ConfigFlags,
+	streams genericiooptions.IOStreams,
+) {
+	command := migrate.NewAcceleratorProfilesCommand(streams)
+	command.ConfigFlags = flags
+
+	cmd := &cobra.Command{
+		Use:           cmdName,
+		Short:         cmdShort,
+		Long:          cmdLong,
+		Example:       cmdExample,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			outputFormat := string(command.OutputFormat)
+
+			if err := command.Complete(); err != nil {
+				return clierrors.HandleError(cmd, err, outputFormat)
+			}
+
+			if err := command.Validate(); err != nil {
+				return clierrors.HandleError(cmd, err, outputFormat)
+			}
+
+			if err := command.Run(cmd.Context()); err != nil {
+				return clierrors.HandleError(cmd, err, outputFormat)
+			}
+
+			return nil
+		},
+	}
+
+	command.AddFlags(cmd.Flags())
+
+	parent.AddCommand(cmd)
+}
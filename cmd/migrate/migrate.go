@@ -6,9 +6,13 @@ import (
 	"k8s.io/cli-runtime/pkg/genericclioptions"
 	"k8s.io/cli-runtime/pkg/genericiooptions"
 
+	"github.com/opendatahub-io/odh-cli/cmd/migrate/acceleratorprofiles"
 	"github.com/opendatahub-io/odh-cli/cmd/migrate/list"
+	"github.com/opendatahub-io/odh-cli/cmd/migrate/modelmesh"
 	"github.com/opendatahub-io/odh-cli/cmd/migrate/prepare"
 	"github.com/opendatahub-io/odh-cli/cmd/migrate/run"
+	"github.com/opendatahub-io/odh-cli/cmd/migrate/serverlessisvc"
+	"github.com/opendatahub-io/odh-cli/cmd/migrate/workbenchimages"
 )
 
 const (
@@ -22,15 +26,25 @@ The migrate command manages cluster migrations for OpenShift AI components.
 Use 'migrate list' to see available migrations filtered by version compatibility.
 Use 'migrate prepare' to backup resources before migration.
 Use 'migrate run' to execute one or more migrations sequentially.
+Use 'migrate accelerator-profiles' to generate HardwareProfiles for workloads that
+still reference AcceleratorProfiles.
+Use 'migrate modelmesh' to generate KServe RawDeployment manifests for ModelMesh workloads.
+Use 'migrate serverless-isvc' to convert Serverless InferenceServices to RawDeployment.
+Use 'migrate workbench-images' to move workbenches running outdated images to 2025.2+
+ImageStream tags.
 
 Migrations are version-aware and only execute when applicable to the current
 cluster state. Each migration can be run in dry-run mode to preview changes
 before applying them.
 
 Available subcommands:
-  list     List available migrations for a target version
-  prepare  Execute preparation steps (backups) for migrations
-  run      Execute one or more migrations
+  list                  List available migrations for a target version
+  prepare               Execute preparation steps (backups) for migrations
+  run                   Execute one or more migrations
+  accelerator-profiles  Generate HardwareProfile manifests and workload patches
+  modelmesh             Generate RawDeployment manifests for ModelMesh workloads
+  serverless-isvc       Convert Serverless InferenceServices to RawDeployment
+  workbench-images      Update outdated workbench images to 2025.2+ ImageStream tags
 
 Available migrations include RayCluster backup/migrate (raycluster.backup,
 raycluster.migrate), Kueue RHBOK, and model serving actions.
@@ -69,6 +83,18 @@ const cmdExample = `
 
   # Check for running training workloads before upgrade
   kubectl odh migrate run -m training.verify-workloads --target-version 3.0.0
+
+  # Preview the HardwareProfiles replacing AcceleratorProfiles
+  kubectl odh migrate accelerator-profiles --dry-run
+
+  # Preview RawDeployment manifests for ModelMesh InferenceServices
+  kubectl odh migrate modelmesh --dry-run
+
+  # Diff the RawDeployment conversion of Serverless InferenceServices
+  kubectl odh migrate serverless-isvc --dry-run
+
+  # Diff the image updates of the workbenches in a namespace
+  kubectl odh migrate workbench-images -n my-project --dry-run
 `
 
 // AddCommand adds the migrate command to the root command.
@@ -91,6 +117,10 @@ func AddCommand(root *cobra.Command, flags *genericclioptions.ConfigFlags) {
 	list.AddCommand(cmd, flags, streams)
 	prepare.AddCommand(cmd, flags, streams)
 	run.AddCommand(cmd, flags, streams)
+	acceleratorprofiles.AddCommand(cmd, flags, streams)
+	modelmesh.AddCommand(cmd, flags, streams)
+	serverlessisvc.AddCommand(cmd, flags, streams)
+	workbenchimages.AddCommand(cmd, flags, streams)
 
 	root.AddCommand(cmd)
 }
@@ -0,0 +1,91 @@
+package modelmesh
+
+import (
+	"github.com/spf13/cobra"
+
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/opendatahub-io/odh-cli/pkg/migrate"
+	clierrors "github.com/opendatahub-io/odh-cli/pkg/util/errors"
+)
+
+const (
+	cmdName  = "modelmesh"
+	cmdShort = "Generate KServe RawDeployment manifests for ModelMesh workloads"
+)
+
+const cmdLong = `
+Generate KServe RawDeployment manifests for the ModelMesh InferenceServices and multi-model
+ServingRuntimes reported by the KServe impacted-workloads check. ModelMesh is removed in
+RHOAI 3.x, so these workloads block the upgrade until they are migrated.
+
+For every multi-model ServingRuntime the command generates a single-model runtime named
+<runtime>-raw. Only runtimes using the OpenVINO Model Server (ovms) built-in adapter can be
+converted; the model server is reconfigured the way the kserve-ovms template runs it.
+
+For every ModelMesh InferenceService the command generates a manifest with the same name,
+the RawDeployment mode and the converted runtime. Token authentication and route exposure,
+configured on the runtime in ModelMesh, move to the InferenceService.
+
+Workloads that cannot be converted are reported on stderr with the reason, e.g. runtimes
+using the Triton, MLServer or a custom adapter, or InferenceServices that rely on runtime
+auto-selection instead of naming a runtime.
+
+The command never modifies the cluster. The deployment mode of an existing InferenceService
+cannot change, so review the manifests, create the runtimes and replace the InferenceServices:
+
+  kubectl apply -f <dir>/<namespace>/servingruntimes.serving.kserve.io-<runtime>-raw.yaml
+  kubectl replace --force -f <dir>/<namespace>/inferenceservices.serving.kserve.io-<name>.yaml
+
+Replacing an InferenceService deletes it first, so the model is unavailable until the new
+deployment is ready.
+`
+
+const cmdExample = `
+  # Preview the converted manifests
+  kubectl odh migrate modelmesh --dry-run
+
+  # Write one file per resource for review
+  kubectl odh migrate modelmesh --output-dir ./modelmesh-migration
+`
+
+// AddCommand adds the modelmesh subcommand to the migrate command.
+func AddCommand(
+	parent *cobra.Command,
+	flags *genericclioptions.ConfigFlags,
+	streams genericiooptions.IOStreams,
+) {
+	command := migrate.NewModelMeshCommand(streams)
+	command.ConfigFlags = flags
+
+	cmd := &cobra.Command{
+		Use:           cmdName,
+		Short:         cmdShort,
+		Long:          cmdLong,
+		Example:       cmdExample,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			outputFormat := string(command.OutputFormat)
+
+			if err := command.Complete(); err != nil {
+				return clierrors.HandleError(cmd, err, outputFormat)
+			}
+
+			if err := command.Validate(); err != nil {
+				return clierrors.HandleError(cmd, err, outputFormat)
+			}
+
+			if err := command.Run(cmd.Context()); err != nil {
+				return clierrors.HandleError(cmd, err, outputFormat)
+			}
+
+			return nil
+		},
+	}
+
+	command.AddFlags(cmd.Flags())
+
+	parent.AddCommand(cmd)
+}
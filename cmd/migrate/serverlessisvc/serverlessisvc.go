@@ -0,0 +1,94 @@
+package serverlessisvc
+
+import (
+	"github.com/spf13/cobra"
+
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/opendatahub-io/odh-cli/pkg/migrate"
+	clierrors "github.com/opendatahub-io/odh-cli/pkg/util/errors"
+)
+
+const (
+	cmdName  = "serverless-isvc"
+	cmdShort = "Convert Serverless InferenceServices to RawDeployment"
+)
+
+const cmdLong = `
+Generate the patches converting Serverless InferenceServices to RawDeployment. Serverless
+mode is removed in RHOAI 3.x, so these InferenceServices block the upgrade until they are
+migrated.
+
+For every InferenceService with the serving.kserve.io/deploymentMode=Serverless annotation,
+the patch:
+
+  - sets serving.kserve.io/deploymentMode to RawDeployment
+  - maps the Knative autoscaling annotations to their RawDeployment equivalents:
+      autoscaling.knative.dev/min-scale  -> spec.predictor.minReplicas
+      autoscaling.knative.dev/max-scale  -> spec.predictor.maxReplicas
+      autoscaling.knative.dev/target     -> spec.predictor.scaleTarget
+      autoscaling.knative.dev/metric     -> spec.predictor.scaleMetric (cpu and memory only)
+      autoscaling.knative.dev/class      -> serving.kserve.io/autoscalerClass: hpa
+  - removes all autoscaling.knative.dev/* annotations
+
+Fields already set on the predictor take precedence over the annotations. Settings without
+an equivalent (scale to zero, the concurrency and rps metrics, scale windows...) are dropped
+and reported on stderr for review.
+
+By default the patches are printed to stdout. Use --dry-run to see the change to each
+InferenceService as a diff, --output-dir to write one patch file per InferenceService, and
+--apply to patch the InferenceServices on the cluster; combined with --dry-run the patches
+are only validated by the API server.
+`
+
+const cmdExample = `
+  # Show the change to each Serverless InferenceService as a diff
+  kubectl odh migrate serverless-isvc --dry-run
+
+  # Write the patches to a directory for review
+  kubectl odh migrate serverless-isvc --output-dir ./serverless-migration
+
+  # Patch the InferenceServices without prompting
+  kubectl odh migrate serverless-isvc --apply --yes
+`
+
+// AddCommand adds the serverless-isvc subcommand to the migrate command.
+func AddCommand(
+	parent *cobra.Command,
+	flags *genericclioptions.ConfigFlags,
+	streams genericiooptions.IOStreams,
+) {
+	command := migrate.NewServerlessISVCCommand(streams)
+	command.ConfigFlags = flags
+
+	cmd := &cobra.Command{
+		Use:           cmdName,
+		Short:         cmdShort,
+		Long:          cmdLong,
+		Example:       cmdExample,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			outputFormat := string(command.OutputFormat)
+
+			if err := command.Complete(); err != nil {
+				return clierrors.HandleError(cmd, err, outputFormat)
+			}
+
+			if err := command.Validate(); err != nil {
+				return clierrors.HandleError(cmd, err, outputFormat)
+			}
+
+			if err := command.Run(cmd.Context()); err != nil {
+				return clierrors.HandleError(cmd, err, outputFormat)
+			}
+
+			return nil
+		},
+	}
+
+	command.AddFlags(cmd.Flags())
+
+	parent.AddCommand(cmd)
+}
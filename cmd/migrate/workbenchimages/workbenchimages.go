@@ -0,0 +1,94 @@
+package workbenchimages
+
+import (
+	"github.com/spf13/cobra"
+
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/opendatahub-io/odh-cli/pkg/migrate"
+	clierrors "github.com/opendatahub-io/odh-cli/pkg/util/errors"
+)
+
+const (
+	cmdName  = "workbench-images"
+	cmdShort = "Update outdated workbench images to 2025.2+ ImageStream tags"
+)
+
+const cmdLong = `
+Generate the patches moving workbenches off notebook images that are incompatible with
+RHOAI 3.x. Workbenches in 3.x are served through nginx, and the code-server and other
+non-Jupyter images only support it from the 2025.2 ImageStream tags on.
+
+The workbenches are selected with the notebook impacted-workloads lint check: every Notebook
+the check reports as requiring action before the upgrade has the containers running the
+outdated image updated to the newest 2025.2+ tag of the same ImageStream, and its
+notebooks.opendatahub.io/last-image-selection annotation updated to match. Workbenches
+running custom images or images that need a rebuild after the upgrade are reported on stderr
+for manual review.
+
+Use the global --namespace (-n) flag to only migrate the workbenches of one namespace.
+
+By default the patches are printed to stdout. Use --dry-run to see the change to each
+Notebook as a diff, --output-dir to write one patch and one rollback patch file per Notebook,
+and --apply to patch the Notebooks on the cluster; combined with --dry-run the patches are
+only validated by the API server. Before --apply patches the cluster, the rollback patches are
+written to --rollback-dir and can be applied with
+'kubectl patch notebook <name> -n <namespace> --type merge --patch-file <file>'.
+
+Running workbenches restart when their image changes.
+`
+
+const cmdExample = `
+  # Show the image update of each workbench as a diff
+  kubectl odh migrate workbench-images --dry-run
+
+  # Only migrate the workbenches of one namespace
+  kubectl odh migrate workbench-images -n my-project --dry-run
+
+  # Write the patches and rollback patches to a directory for review
+  kubectl odh migrate workbench-images --output-dir ./workbench-migration
+
+  # Patch the workbenches, saving the rollback patches to a known directory
+  kubectl odh migrate workbench-images --apply --rollback-dir ./workbench-rollback
+`
+
+// AddCommand adds the workbench-images subcommand to the migrate command.
+func AddCommand(
+	parent *cobra.Command,
+	flags *genericclioptions.ConfigFlags,
+	streams genericiooptions.IOStreams,
+) {
+	command := migrate.NewWorkbenchImagesCommand(streams)
+	command.ConfigFlags = flags
+
+	cmd := &cobra.Command{
+		Use:           cmdName,
+		Short:         cmdShort,
+		Long:          cmdLong,
+		Example:       cmdExample,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			outputFormat := string(command.OutputFormat)
+
+			if err := command.Complete(); err != nil {
+				return clierrors.HandleError(cmd, err, outputFormat)
+			}
+
+			if err := command.Validate(); err != nil {
+				return clierrors.HandleError(cmd, err, outputFormat)
+			}
+
+			if err := command.Run(cmd.Context()); err != nil {
+				return clierrors.HandleError(cmd, err, outputFormat)
+			}
+
+			return nil
+		},
+	}
+
+	command.AddFlags(cmd.Flags())
+
+	parent.AddCommand(cmd)
+}
@@ -0,0 +1,81 @@
+package plan
+
+import (
+	"github.com/spf13/cobra"
+
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	planpkg "github.com/opendatahub-io/odh-cli/pkg/plan"
+	clierrors "github.com/opendatahub-io/odh-cli/pkg/util/errors"
+)
+
+const (
+	cmdName  = "plan"
+	cmdShort = "Generate an upgrade runbook from lint results"
+)
+
+const cmdLong = `
+Runs the lint upgrade checks for a target version and converts the findings into
+an ordered remediation plan for sharing with change management.
+
+Each step covers one check and lists its findings, remediation guidance, affected
+objects and, where the check supports 'lint --fix', the equivalent kubectl patch
+commands. Steps that block the upgrade (prohibited, blocking) come first; within
+the same impact, dependencies and platform steps precede component and workload
+steps.
+
+The plan is read-only: no changes are made to the cluster.
+`
+
+const cmdExample = `
+  # Write a Markdown runbook for upgrading to 3.0
+  kubectl odh plan --target-version 3.0 > upgrade-plan.md
+
+  # JSON plan for automation
+  kubectl odh plan --target-version 3.0 -o json
+
+  # Only blocking steps, from an exported snapshot
+  kubectl odh plan --target-version 3.0 --severity critical --from-dir odh-lint-snapshot.tar.gz
+`
+
+// AddCommand adds the plan command to the root command.
+func AddCommand(root *cobra.Command, flags *genericclioptions.ConfigFlags) {
+	streams := genericiooptions.IOStreams{
+		In:     root.InOrStdin(),
+		Out:    root.OutOrStdout(),
+		ErrOut: root.ErrOrStderr(),
+	}
+
+	command := planpkg.NewCommand(streams, flags)
+
+	cmd := &cobra.Command{
+		Use:           cmdName,
+		Short:         cmdShort,
+		Long:          cmdLong,
+		Example:       cmdExample,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			outputFormat := string(command.OutputFormat)
+
+			if err := command.Complete(); err != nil {
+				return clierrors.HandleError(cmd, err, outputFormat)
+			}
+
+			if err := command.Validate(); err != nil {
+				return clierrors.HandleError(cmd, err, outputFormat)
+			}
+
+			if err := command.Run(cmd.Context()); err != nil {
+				return clierrors.HandleError(cmd, err, outputFormat)
+			}
+
+			return nil
+		},
+	}
+
+	command.AddFlags(cmd.Flags())
+
+	root.AddCommand(cmd)
+}
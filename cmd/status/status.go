@@ -50,8 +50,10 @@ The applications and operator namespaces are auto-detected from the
 DSCInitialization resource and OLM ClusterServiceVersions. Use
 --apps-namespace and --operator-namespace to override.
 
-By default, also checks required operator dependencies (ServiceMesh,
-Serverless, etc.) and shows which components need them.
+By default, also lists each enabled DSC component with the readiness
+reported by its component CR, and checks required operator dependencies
+(ServiceMesh, Serverless, etc.) and shows which components need them.
+Component health is not included in --watch streams.
 
 Use --wait-for=healthy to poll until the platform reaches healthy status.
 This is useful for automation and agent workflows that need to wait for
@@ -101,6 +103,9 @@ const cmdExample = `
   # Skip dependency checking
   kubectl odh status --include-deps=false
 
+  # Skip component health
+  kubectl odh status --include-components=false
+
   # Override namespace detection
   kubectl odh status --apps-namespace my-apps --operator-namespace my-operator
 
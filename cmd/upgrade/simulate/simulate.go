@@ -0,0 +1,80 @@
+package simulate
+
+import (
+	"github.com/spf13/cobra"
+
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/opendatahub-io/odh-cli/pkg/upgrade"
+	clierrors "github.com/opendatahub-io/odh-cli/pkg/util/errors"
+)
+
+const (
+	cmdName  = "simulate"
+	cmdShort = "Preview the DSC/DSCI after an upgrade"
+)
+
+const cmdLong = `
+Preview how the DataScienceCluster and DSCInitialization will look after upgrading
+to the target version, without touching the cluster.
+
+The current resources are read from the cluster and transformed with the rules of
+every release between the current and target versions: removed components are
+dropped, renamed components are moved to their new field and changed defaults are
+applied. The result is rendered as a unified diff followed by the list of changes.
+
+Use -o yaml or -o json for the current and simulated manifests as structured output,
+and --rules to replace the embedded transformation rules, e.g. with pre-release data.
+`
+
+const cmdExample = `
+  # Preview the DSC/DSCI after upgrading to 3.0
+  kubectl odh upgrade simulate --target-version 3.0
+
+  # Emit the current and simulated manifests as JSON
+  kubectl odh upgrade simulate --target-version 3.0 -o json
+
+  # Simulate with custom transformation rules
+  kubectl odh upgrade simulate --target-version 3.0 --rules ./rules.yaml
+`
+
+// AddCommand adds the simulate subcommand to the upgrade command.
+func AddCommand(
+	parent *cobra.Command,
+	flags *genericclioptions.ConfigFlags,
+	streams genericiooptions.IOStreams,
+) {
+	command := upgrade.NewSimulateCommand(streams, flags)
+
+	cmd := &cobra.Command{
+		Use:           cmdName,
+		Short:         cmdShort,
+		Long:          cmdLong,
+		Example:       cmdExample,
+		Args:          cobra.NoArgs,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			outputFormat := string(command.OutputFormat)
+
+			if err := command.Complete(); err != nil {
+				return clierrors.HandleError(cmd, err, outputFormat)
+			}
+
+			if err := command.Validate(); err != nil {
+				return clierrors.HandleError(cmd, err, outputFormat)
+			}
+
+			if err := command.Run(cmd.Context()); err != nil {
+				return clierrors.HandleError(cmd, err, outputFormat)
+			}
+
+			return nil
+		},
+	}
+
+	command.AddFlags(cmd.Flags())
+
+	parent.AddCommand(cmd)
+}
@@ -0,0 +1,53 @@
+package upgrade
+
+import (
+	"github.com/spf13/cobra"
+
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/opendatahub-io/odh-cli/cmd/upgrade/simulate"
+)
+
+const (
+	cmdName  = "upgrade"
+	cmdShort = "Preview ODH/RHOAI upgrades"
+)
+
+const cmdLong = `
+The upgrade command previews the effect of an OpenShift AI upgrade without
+modifying the cluster.
+
+Use 'lint --target-version' to assess upgrade readiness, and 'upgrade simulate'
+to see how the DataScienceCluster and DSCInitialization will look afterwards.
+
+Available subcommands:
+  simulate  Render the post-upgrade DSC/DSCI as a diff
+`
+
+const cmdExample = `
+  # Preview the DSC/DSCI after upgrading to 3.0
+  kubectl odh upgrade simulate --target-version 3.0
+`
+
+// AddCommand adds the upgrade command to the root command.
+func AddCommand(root *cobra.Command, flags *genericclioptions.ConfigFlags) {
+	streams := genericiooptions.IOStreams{
+		In:     root.InOrStdin(),
+		Out:    root.OutOrStdout(),
+		ErrOut: root.ErrOrStderr(),
+	}
+
+	cmd := &cobra.Command{
+		Use:           cmdName,
+		Short:         cmdShort,
+		Long:          cmdLong,
+		Example:       cmdExample,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+	}
+
+	simulate.AddCommand(cmd, flags, streams)
+
+	root.AddCommand(cmd)
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io"
 	"runtime"
+	"slices"
 
 	"github.com/spf13/cobra"
 
@@ -13,6 +14,8 @@ import (
 
 	"github.com/opendatahub-io/odh-cli/internal/version"
 	"github.com/opendatahub-io/odh-cli/pkg/api"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/compat"
+	printeryaml "github.com/opendatahub-io/odh-cli/pkg/printer/yaml"
 	"github.com/opendatahub-io/odh-cli/pkg/schema"
 )
 
@@ -21,7 +24,15 @@ const (
 	cmdShort = "Show version information"
 )
 
-func writeJSONVersion(out io.Writer, data map[string]string) error {
+func writeStructuredVersion[T any](out io.Writer, format string, data T) error {
+	if format == "yaml" {
+		if err := printeryaml.NewRenderer[T](printeryaml.WithWriter[T](out)).Render(data); err != nil {
+			return fmt.Errorf("failed to encode version information as YAML: %w", err)
+		}
+
+		return nil
+	}
+
 	encoder := json.NewEncoder(out)
 	encoder.SetIndent("", "  ")
 
@@ -40,6 +51,17 @@ func writeTextVersion(out io.Writer, format string, args ...any) error {
 	return nil
 }
 
+func writeTextUpdate(out io.Writer, update *version.UpdateInfo) error {
+	switch {
+	case update == nil:
+		return nil
+	case update.UpdateAvailable:
+		return writeTextVersion(out, "A newer version is available: %s (%s)\n", update.LatestVersion, update.URL)
+	default:
+		return writeTextVersion(out, "Latest release: %s\n", update.LatestVersion)
+	}
+}
+
 // AddCommand adds the version subcommand to the root command.
 func AddCommand(root *cobra.Command, _ *genericclioptions.ConfigFlags) {
 	var (
@@ -47,6 +69,7 @@ func AddCommand(root *cobra.Command, _ *genericclioptions.ConfigFlags) {
 		outputSchema bool
 		verbose      bool
 		quiet        bool
+		checkUpdate  bool
 	)
 
 	cmd := &cobra.Command{
@@ -64,6 +87,10 @@ func AddCommand(root *cobra.Command, _ *genericclioptions.ConfigFlags) {
 				return errors.New("--schema and --quiet cannot be used together")
 			}
 
+			if !slices.Contains([]string{"text", "json", "yaml"}, outputFormat) {
+				return fmt.Errorf("invalid output format: %s (must be one of: text, json, yaml)", outputFormat)
+			}
+
 			// Short-circuit if --schema was requested
 			if outputSchema {
 				schemaType := schema.SchemaVersionInfo
@@ -84,38 +111,67 @@ func AddCommand(root *cobra.Command, _ *genericclioptions.ConfigFlags) {
 				out = io.Discard
 			}
 
-			data := map[string]string{
-				"version": version.GetVersion(),
-				"commit":  version.GetCommit(),
-				"date":    version.GetDate(),
+			var update *version.UpdateInfo
+
+			if checkUpdate {
+				var err error
+
+				update, err = version.CheckUpdate(cmd.Context(), version.LatestReleaseURL, version.GetVersion())
+				if err != nil {
+					return fmt.Errorf("checking for updates: %w", err)
+				}
 			}
 
-			if verbose {
-				data["goVersion"] = runtime.Version()
-				data["platform"] = runtime.GOOS + "/" + runtime.GOARCH
+			info := version.VerboseInfo{
+				Version:      version.GetVersion(),
+				Commit:       version.GetCommit(),
+				Date:         version.GetDate(),
+				GoVersion:    runtime.Version(),
+				Platform:     runtime.GOOS + "/" + runtime.GOARCH,
+				CompatMatrix: compat.Default().LatestRelease(),
+				Update:       update,
 			}
 
-			if outputFormat == "json" {
-				return writeJSONVersion(out, data)
+			if outputFormat != "text" {
+				if verbose {
+					return writeStructuredVersion(out, outputFormat, info)
+				}
+
+				return writeStructuredVersion(out, outputFormat, version.Info{
+					Version:      info.Version,
+					Commit:       info.Commit,
+					Date:         info.Date,
+					CompatMatrix: info.CompatMatrix,
+					Update:       info.Update,
+				})
 			}
 
+			var err error
 			if verbose {
-				return writeTextVersion(out,
-					"kubectl-odh version %s\n  Commit:     %s\n  Built:      %s\n  Go version: %s\n  Platform:   %s\n",
-					data["version"], data["commit"], data["date"], data["goVersion"], data["platform"])
+				err = writeTextVersion(out,
+					"kubectl-odh version %s\n  Commit:        %s\n  Built:         %s\n  Go version:    %s\n"+
+						"  Platform:      %s\n  Compat matrix: %s\n",
+					info.Version, info.Commit, info.Date, info.GoVersion, info.Platform, info.CompatMatrix)
+			} else {
+				err = writeTextVersion(out,
+					"kubectl-odh version %s (commit: %s, built: %s, compat matrix: %s)\n",
+					info.Version, info.Commit, info.Date, info.CompatMatrix)
+			}
+
+			if err != nil {
+				return err
 			}
 
-			return writeTextVersion(out,
-				"kubectl-odh version %s (commit: %s, built: %s)\n",
-				data["version"], data["commit"], data["date"])
+			return writeTextUpdate(out, info.Update)
 		},
 	}
 
-	cmd.Flags().StringVarP(&outputFormat, "output", "o", "text", "Output format (text|json)")
-	_ = cmd.Flags().SetAnnotation("output", api.AnnotationValidValues, []string{"text", "json"})
+	cmd.Flags().StringVarP(&outputFormat, "output", "o", "text", "Output format (text|json|yaml)")
+	_ = cmd.Flags().SetAnnotation("output", api.AnnotationValidValues, []string{"text", "json", "yaml"})
 	cmd.Flags().BoolVar(&outputSchema, "schema", false, "Output JSON Schema for the command's structured output format")
 	cmd.Flags().BoolVarP(&verbose, "verbose", "v", false, "Enable verbose output")
 	cmd.Flags().BoolVarP(&quiet, "quiet", "q", false, "Suppress all output")
+	cmd.Flags().BoolVar(&checkUpdate, "check-update", false, "Check GitHub releases for a newer version of the plugin")
 
 	root.AddCommand(cmd)
 }
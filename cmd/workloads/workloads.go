@@ -0,0 +1,99 @@
+package workloads
+
+import (
+	"github.com/spf13/cobra"
+
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	clierrors "github.com/opendatahub-io/odh-cli/pkg/util/errors"
+	workloadspkg "github.com/opendatahub-io/odh-cli/pkg/workloads"
+)
+
+const (
+	cmdName  = "workloads"
+	cmdShort = "Inventory AI workloads on the cluster"
+)
+
+const cmdLong = `
+Inventory the AI workloads running on the cluster.
+
+Workload kinds are discovered from the CRDs labeled platform.opendatahub.io/part-of,
+so every installed ODH/RHOAI workload type is listed. Use --kind to restrict the
+listing to notebooks (notebook), InferenceServices (isvc), PyTorchJobs (pytorchjob)
+or Ray clusters and jobs (ray).
+
+Workloads are listed across all namespaces unless --namespace is set.
+`
+
+const listExample = `
+  # List all AI workloads across the cluster
+  kubectl odh workloads list
+
+  # List notebooks and InferenceServices only
+  kubectl odh workloads list --kind notebook,isvc
+
+  # List Ray workloads in a namespace
+  kubectl odh workloads list --kind ray -n my-project
+
+  # Export the inventory as JSON
+  kubectl odh workloads list -o json
+`
+
+// AddCommand adds the workloads command to the root command.
+func AddCommand(root *cobra.Command, flags *genericclioptions.ConfigFlags) {
+	streams := genericiooptions.IOStreams{
+		In:     root.InOrStdin(),
+		Out:    root.OutOrStdout(),
+		ErrOut: root.ErrOrStderr(),
+	}
+
+	cmd := &cobra.Command{
+		Use:   cmdName,
+		Short: cmdShort,
+		Long:  cmdLong,
+		Args:  cobra.NoArgs,
+	}
+
+	addListCommand(cmd, flags, streams)
+
+	root.AddCommand(cmd)
+}
+
+func addListCommand(parent *cobra.Command, flags *genericclioptions.ConfigFlags, streams genericiooptions.IOStreams) {
+	listCommand := workloadspkg.NewListCommand(streams, flags)
+
+	cmd := &cobra.Command{
+		Use:           "list",
+		Short:         "List AI workloads with owner, namespace, age and deployment mode",
+		Long:          cmdLong,
+		Example:       listExample,
+		Args:          cobra.NoArgs,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			outputFormat := listCommand.OutputFormat
+
+			if err := listCommand.Complete(); err != nil {
+				return clierrors.HandleError(cmd, err, outputFormat)
+			}
+
+			if err := listCommand.Validate(); err != nil {
+				return clierrors.HandleError(cmd, err, outputFormat)
+			}
+
+			if err := listCommand.Run(cmd.Context()); err != nil {
+				return clierrors.HandleError(cmd, err, outputFormat)
+			}
+
+			return nil
+		},
+	}
+
+	listCommand.AddFlags(cmd.Flags())
+	_ = cmd.RegisterFlagCompletionFunc("kind", func(_ *cobra.Command, _ []string, _ string) ([]string, cobra.ShellCompDirective) {
+		return workloadspkg.KindNames(), cobra.ShellCompDirectiveNoFileComp
+	})
+
+	parent.AddCommand(cmd)
+}
@@ -0,0 +1,78 @@
+package version
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/blang/semver/v4"
+)
+
+const (
+	// LatestReleaseURL is the GitHub API endpoint of the latest CLI release.
+	LatestReleaseURL = "https://api.github.com/repos/opendatahub-io/odh-cli/releases/latest"
+
+	updateCheckTimeout = 10 * time.Second
+	maxReleaseSize     = 1024 * 1024 // 1MB max response size
+)
+
+// UpdateInfo reports the latest released version of the CLI.
+type UpdateInfo struct {
+	LatestVersion   string `json:"latestVersion"   jsonschema:"description=Latest released CLI version"`
+	UpdateAvailable bool   `json:"updateAvailable" jsonschema:"description=Whether the latest release is newer than this build"`
+	URL             string `json:"url"             jsonschema:"description=Release page of the latest version"`
+}
+
+type githubRelease struct {
+	TagName string `json:"tag_name"`
+	HTMLURL string `json:"html_url"`
+}
+
+// CheckUpdate queries the GitHub release at releaseURL and reports whether it is newer than
+// the current version. Development builds, whose version is not semantic, are never reported
+// as outdated.
+func CheckUpdate(ctx context.Context, releaseURL string, current string) (*UpdateInfo, error) {
+	ctx, cancel := context.WithTimeout(ctx, updateCheckTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, releaseURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("create request: %w", err)
+	}
+
+	req.Header.Set("Accept", "application/vnd.github+json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("fetch latest release: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("fetch latest release: HTTP %d", resp.StatusCode)
+	}
+
+	var release githubRelease
+	if err := json.NewDecoder(io.LimitReader(resp.Body, maxReleaseSize)).Decode(&release); err != nil {
+		return nil, fmt.Errorf("decode latest release: %w", err)
+	}
+
+	latest, err := semver.ParseTolerant(release.TagName)
+	if err != nil {
+		return nil, fmt.Errorf("latest release %q: invalid version: %w", release.TagName, err)
+	}
+
+	info := &UpdateInfo{
+		LatestVersion: latest.String(),
+		URL:           release.HTMLURL,
+	}
+
+	if v, err := semver.ParseTolerant(current); err == nil {
+		info.UpdateAvailable = latest.GT(v)
+	}
+
+	return info, nil
+}
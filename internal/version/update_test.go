@@ -0,0 +1,67 @@
+package version_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/opendatahub-io/odh-cli/internal/version"
+
+	. "github.com/onsi/gomega"
+)
+
+const testLatestRelease = `{"tag_name":"v1.4.0","html_url":"https://github.com/opendatahub-io/odh-cli/releases/tag/v1.4.0"}`
+
+func newReleaseServer(t *testing.T, status int, body string) string {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+
+	return server.URL
+}
+
+func TestCheckUpdate(t *testing.T) {
+	t.Run("should report a newer release", func(t *testing.T) {
+		g := NewWithT(t)
+
+		update, err := version.CheckUpdate(t.Context(), newReleaseServer(t, http.StatusOK, testLatestRelease), "v1.3.2")
+
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(update).To(And(
+			HaveField("LatestVersion", "1.4.0"),
+			HaveField("UpdateAvailable", BeTrue()),
+			HaveField("URL", "https://github.com/opendatahub-io/odh-cli/releases/tag/v1.4.0"),
+		))
+	})
+
+	t.Run("should not report the current release", func(t *testing.T) {
+		g := NewWithT(t)
+
+		update, err := version.CheckUpdate(t.Context(), newReleaseServer(t, http.StatusOK, testLatestRelease), "1.4.0")
+
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(update.UpdateAvailable).To(BeFalse())
+	})
+
+	t.Run("should not report development builds as outdated", func(t *testing.T) {
+		g := NewWithT(t)
+
+		update, err := version.CheckUpdate(t.Context(), newReleaseServer(t, http.StatusOK, testLatestRelease), "dev")
+
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(update.LatestVersion).To(Equal("1.4.0"))
+		g.Expect(update.UpdateAvailable).To(BeFalse())
+	})
+
+	t.Run("should fail on an HTTP error", func(t *testing.T) {
+		g := NewWithT(t)
+
+		_, err := version.CheckUpdate(t.Context(), newReleaseServer(t, http.StatusForbidden, `{}`), "1.3.2")
+
+		g.Expect(err).To(MatchError(ContainSubstring("HTTP 403")))
+	})
+}
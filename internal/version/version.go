@@ -32,6 +32,10 @@ type Info struct {
 	Version string `json:"version" jsonschema:"description=CLI version string"`
 	Commit  string `json:"commit"  jsonschema:"description=Git commit hash"`
 	Date    string `json:"date"    jsonschema:"description=Build date"`
+
+	CompatMatrix string `json:"compatMatrix,omitempty" jsonschema:"description=Newest release covered by the embedded compatibility matrix"`
+
+	Update *UpdateInfo `json:"update,omitempty" jsonschema:"description=Latest release, set with --check-update"`
 }
 
 // VerboseInfo holds extended version information including Go runtime details.
@@ -41,6 +45,10 @@ type VerboseInfo struct {
 	Date      string `json:"date"      jsonschema:"description=Build date"`
 	GoVersion string `json:"goVersion" jsonschema:"description=Go runtime version"`
 	Platform  string `json:"platform"  jsonschema:"description=OS and architecture"`
+
+	CompatMatrix string `json:"compatMatrix,omitempty" jsonschema:"description=Newest release covered by the embedded compatibility matrix"`
+
+	Update *UpdateInfo `json:"update,omitempty" jsonschema:"description=Latest release, set with --check-update"`
 }
 
 // GetInfo returns the version info struct.
@@ -50,7 +50,8 @@ func MutateComponentState(ctx context.Context, cfg MutateConfig) error {
 	}
 
 	if !cfg.SkipConfirm && !cfg.DryRun {
-		prompt := fmt.Sprintf("Are you sure you want to %s component '%s'?", cfg.ActionVerb, cfg.ComponentName)
+		prompt := fmt.Sprintf("Are you sure you want to %s component '%s' (managementState: %s -> %s)?",
+			cfg.ActionVerb, cfg.ComponentName, component.ManagementState, cfg.TargetState)
 		if !confirmation.Prompt(cfg.IO, prompt) {
 			return ErrUserAborted()
 		}
@@ -59,6 +60,8 @@ func MutateComponentState(ctx context.Context, cfg MutateConfig) error {
 	opts := []client.PatchOption{}
 	if cfg.DryRun {
 		opts = append(opts, client.WithDryRun())
+		cfg.IO.Fprintf("DRY RUN: Would %s component '%s' (managementState: %s -> %s)",
+			cfg.ActionVerb, cfg.ComponentName, component.ManagementState, cfg.TargetState)
 		cfg.IO.Fprintln("DRY RUN: Validating patch against API server...")
 		cfg.IO.Fprintln()
 		cfg.IO.Fprintf("%s", string(patchBytes))
@@ -135,6 +135,7 @@ func TestMutateComponentState(t *testing.T) {
 
 		output := out.String()
 		g.Expect(output).To(ContainSubstring("DRY RUN"))
+		g.Expect(output).To(ContainSubstring("managementState: Managed -> Removed"))
 		g.Expect(output).To(ContainSubstring("trustyai"))
 		g.Expect(output).To(ContainSubstring("managementState"))
 		g.Expect(output).To(ContainSubstring("Removed"))
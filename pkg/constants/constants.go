@@ -7,10 +7,11 @@ const (
 	ManagementStateRemoved   = "Removed"
 )
 
-// Platform names for DSC and DSCI check kind identifiers.
+// Platform names for DSC, DSCI and cluster check kind identifiers.
 const (
-	PlatformDSCI = "dsci"
-	PlatformDSC  = "dsc"
+	PlatformDSCI    = "dsci"
+	PlatformDSC     = "dsc"
+	PlatformCluster = "cluster"
 )
 
 // Component names used across multiple package groups.
@@ -34,9 +35,16 @@ const (
 	LabelKueueQueueName        = "kueue.x-k8s.io/queue-name"
 )
 
+// LabelDashboardProject marks namespaces created as data science projects by the Dashboard.
+const LabelDashboardProject = "opendatahub.io/dashboard"
+
 // Workload annotations used across multiple check packages.
 const (
 	// AnnotationLegacyHardwareProfile is the annotation key for legacy hardware profile references
 	// on workload CRs (Notebooks, InferenceServices).
 	AnnotationLegacyHardwareProfile = "opendatahub.io/legacy-hardware-profile-name"
+
+	// AnnotationLintIgnore lists comma-separated lint check IDs whose findings are acknowledged
+	// for the annotated workload; the workload is excluded from those checks.
+	AnnotationLintIgnore = "odh.opendatahub.io/lint-ignore"
 )
@@ -11,7 +11,6 @@ import (
 
 	"github.com/blang/semver/v4"
 	"github.com/spf13/pflag"
-	"golang.org/x/term"
 	"gopkg.in/yaml.v3"
 
 	"k8s.io/cli-runtime/pkg/genericclioptions"
@@ -134,12 +133,7 @@ func shouldUseColor(w io.Writer) bool {
 		return false
 	}
 
-	f, ok := w.(*os.File)
-	if !ok {
-		return false
-	}
-
-	return term.IsTerminal(int(f.Fd()))
+	return iostreams.IsTerminal(w)
 }
 
 // Validate checks the command options.
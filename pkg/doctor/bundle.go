@@ -0,0 +1,100 @@
+package doctor
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"time"
+
+	"sigs.k8s.io/yaml"
+
+	"github.com/opendatahub-io/odh-cli/pkg/output"
+)
+
+const (
+	bundleFilePermission = 0o644
+
+	// SummaryFile is the bundle entry listing what each collection step gathered.
+	SummaryFile = "summary.yaml"
+)
+
+// StepResult records the outcome of one collection step.
+type StepResult struct {
+	// Name identifies the step (lint, manifests, logs, events).
+	Name string `json:"name" yaml:"name"`
+
+	// Files lists the bundle entries written by the step.
+	Files []string `json:"files,omitempty" yaml:"files,omitempty"`
+
+	// Errors lists the problems the step ran into. A step with errors may still have
+	// written some files.
+	Errors []string `json:"errors,omitempty" yaml:"errors,omitempty"`
+}
+
+// Summary is written as the last bundle entry so support engineers can see what was
+// collected and what failed without unpacking every file.
+type Summary struct {
+	output.Metadata `json:",inline" yaml:",inline"`
+
+	Steps []StepResult `json:"steps" yaml:"steps"`
+}
+
+// bundleWriter writes entries into a gzipped tarball.
+type bundleWriter struct {
+	gz  *gzip.Writer
+	tw  *tar.Writer
+	now time.Time
+}
+
+func newBundleWriter(out io.Writer) *bundleWriter {
+	gz := gzip.NewWriter(out)
+
+	return &bundleWriter{
+		gz:  gz,
+		tw:  tar.NewWriter(gz),
+		now: time.Now(),
+	}
+}
+
+// Add writes a regular file entry.
+func (b *bundleWriter) Add(name string, data []byte) error {
+	if err := b.tw.WriteHeader(&tar.Header{
+		Name:     name,
+		Mode:     bundleFilePermission,
+		Size:     int64(len(data)),
+		ModTime:  b.now,
+		Typeflag: tar.TypeReg,
+	}); err != nil {
+		return fmt.Errorf("writing archive header for %s: %w", name, err)
+	}
+
+	if _, err := b.tw.Write(data); err != nil {
+		return fmt.Errorf("writing archive entry %s: %w", name, err)
+	}
+
+	return nil
+}
+
+// AddYAML marshals the value to YAML and writes it as a file entry.
+func (b *bundleWriter) AddYAML(name string, value any) error {
+	data, err := yaml.Marshal(value)
+	if err != nil {
+		return fmt.Errorf("marshaling %s: %w", name, err)
+	}
+
+	return b.Add(name, data)
+}
+
+// Close finalizes the tar and gzip streams.
+func (b *bundleWriter) Close() error {
+	if err := b.tw.Close(); err != nil {
+		return fmt.Errorf("finalizing archive: %w", err)
+	}
+
+	if err := b.gz.Close(); err != nil {
+		return fmt.Errorf("finalizing gzip stream: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,282 @@
+package doctor
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"path"
+	"slices"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint"
+	"github.com/opendatahub-io/odh-cli/pkg/logs"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+)
+
+const (
+	stepLint      = "lint"
+	stepManifests = "manifests"
+	stepLogs      = "logs"
+	stepEvents    = "events"
+
+	lintReportFile = "lint/report.json"
+	lintStderrFile = "lint/stderr.txt"
+)
+
+// collectLint runs lint with JSON output and stores the report. Lint exits with an error
+// when it finds blocking issues; the report is kept and the error recorded in the summary.
+func (c *Command) collectLint(ctx context.Context, bundle *bundleWriter) StepResult {
+	step := StepResult{Name: stepLint}
+
+	var out, errOut bytes.Buffer
+
+	runErr := c.lintRunner(ctx, genericiooptions.IOStreams{In: &bytes.Buffer{}, Out: &out, ErrOut: &errOut})
+	if runErr != nil {
+		step.Errors = append(step.Errors, runErr.Error())
+	}
+
+	for _, entry := range []struct {
+		name string
+		data []byte
+	}{
+		{lintReportFile, out.Bytes()},
+		{lintStderrFile, errOut.Bytes()},
+	} {
+		if len(entry.data) == 0 {
+			continue
+		}
+
+		if err := bundle.Add(entry.name, entry.data); err != nil {
+			step.Errors = append(step.Errors, err.Error())
+
+			continue
+		}
+
+		step.Files = append(step.Files, entry.name)
+	}
+
+	return step
+}
+
+// runLint runs the lint command against the cluster with JSON output.
+func (c *Command) runLint(ctx context.Context, streams genericiooptions.IOStreams) error {
+	cmd := lint.NewCommand(streams, c.ConfigFlags)
+	cmd.OutputFormat = lint.OutputFormatJSON
+	cmd.NoColor = true
+	cmd.TargetVersion = c.TargetVersion
+
+	if err := cmd.Complete(); err != nil {
+		return fmt.Errorf("completing lint: %w", err)
+	}
+
+	if err := cmd.Validate(); err != nil {
+		return fmt.Errorf("validating lint: %w", err)
+	}
+
+	if err := cmd.Run(ctx); err != nil {
+		return fmt.Errorf("running lint: %w", err)
+	}
+
+	return nil
+}
+
+// collectManifests stores the DataScienceCluster and DSCInitialization singletons.
+func (c *Command) collectManifests(ctx context.Context, bundle *bundleWriter) StepResult {
+	step := StepResult{Name: stepManifests}
+
+	for _, rt := range []resources.ResourceType{resources.DataScienceCluster, resources.DSCInitialization} {
+		obj, err := client.GetSingleton(ctx, c.Client, rt)
+		if err != nil {
+			step.Errors = append(step.Errors, fmt.Sprintf("getting %s: %v", rt.Kind, err))
+
+			continue
+		}
+
+		unstructured.RemoveNestedField(obj.Object, "metadata", "managedFields")
+
+		name := path.Join(stepManifests, rt.Resource+".yaml")
+		if err := bundle.AddYAML(name, obj.Object); err != nil {
+			step.Errors = append(step.Errors, err.Error())
+
+			continue
+		}
+
+		step.Files = append(step.Files, name)
+	}
+
+	return step
+}
+
+// collectOperatorLogs stores the logs of every container of the operator pods, including
+// the previous instance of restarted containers.
+func (c *Command) collectOperatorLogs(ctx context.Context, bundle *bundleWriter) StepResult {
+	step := StepResult{Name: stepLogs}
+
+	if c.OperatorNamespace == "" {
+		step.Errors = append(step.Errors, "operator namespace not found")
+
+		return step
+	}
+
+	pods, err := c.Client.CoreV1().Pods(c.OperatorNamespace).List(ctx, metav1.ListOptions{
+		LabelSelector: logs.OperatorLabelSelector,
+	})
+	if err != nil {
+		step.Errors = append(step.Errors, fmt.Sprintf("listing operator pods: %v", err))
+
+		return step
+	}
+
+	if len(pods.Items) == 0 {
+		step.Errors = append(step.Errors, "no operator pods found in namespace "+c.OperatorNamespace)
+
+		return step
+	}
+
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+
+		for _, status := range pod.Status.ContainerStatuses {
+			c.addContainerLogs(ctx, bundle, &step, pod, status.Name, false)
+
+			if status.RestartCount > 0 {
+				c.addContainerLogs(ctx, bundle, &step, pod, status.Name, true)
+			}
+		}
+	}
+
+	return step
+}
+
+func (c *Command) addContainerLogs(
+	ctx context.Context,
+	bundle *bundleWriter,
+	step *StepResult,
+	pod *corev1.Pod,
+	container string,
+	previous bool,
+) {
+	opts := &corev1.PodLogOptions{
+		Container: container,
+		Previous:  previous,
+	}
+
+	if c.TailLines >= 0 {
+		opts.TailLines = &c.TailLines
+	}
+
+	if c.Since > 0 {
+		seconds := int64(c.Since.Seconds())
+		opts.SinceSeconds = &seconds
+	}
+
+	name := path.Join(stepLogs, pod.Namespace, pod.Name, container+".log")
+	if previous {
+		name = path.Join(stepLogs, pod.Namespace, pod.Name, container+".previous.log")
+	}
+
+	data, err := readLogs(ctx, c.Client, pod, opts)
+	if err != nil {
+		step.Errors = append(step.Errors, fmt.Sprintf("reading logs of %s/%s[%s]: %v", pod.Namespace, pod.Name, container, err))
+
+		return
+	}
+
+	if err := bundle.Add(name, data); err != nil {
+		step.Errors = append(step.Errors, err.Error())
+
+		return
+	}
+
+	step.Files = append(step.Files, name)
+}
+
+func readLogs(ctx context.Context, k8sClient client.Client, pod *corev1.Pod, opts *corev1.PodLogOptions) ([]byte, error) {
+	stream, err := k8sClient.CoreV1().Pods(pod.Namespace).GetLogs(pod.Name, opts).Stream(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("opening log stream: %w", err)
+	}
+	defer func() { _ = stream.Close() }()
+
+	data, err := io.ReadAll(stream)
+	if err != nil {
+		return nil, fmt.Errorf("reading log stream: %w", err)
+	}
+
+	return data, nil
+}
+
+// collectEvents stores the events newer than --since in the operator, applications and
+// monitoring namespaces, one file per namespace sorted by time.
+func (c *Command) collectEvents(ctx context.Context, bundle *bundleWriter) StepResult {
+	step := StepResult{Name: stepEvents}
+
+	var cutoff time.Time
+	if c.Since > 0 {
+		cutoff = time.Now().Add(-c.Since)
+	}
+
+	for _, ns := range c.eventNamespaces() {
+		list, err := c.Client.CoreV1().Events(ns).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			step.Errors = append(step.Errors, fmt.Sprintf("listing events in %s: %v", ns, err))
+
+			continue
+		}
+
+		events := slices.DeleteFunc(list.Items, func(e corev1.Event) bool {
+			return eventTime(e).Before(cutoff)
+		})
+
+		slices.SortStableFunc(events, func(a, b corev1.Event) int {
+			return eventTime(a).Compare(eventTime(b))
+		})
+
+		name := path.Join(stepEvents, ns+".yaml")
+		if err := bundle.AddYAML(name, map[string]any{
+			"apiVersion": "v1",
+			"kind":       "EventList",
+			"items":      events,
+		}); err != nil {
+			step.Errors = append(step.Errors, err.Error())
+
+			continue
+		}
+
+		step.Files = append(step.Files, name)
+	}
+
+	return step
+}
+
+// eventNamespaces returns the distinct, non-empty namespaces to collect events from.
+func (c *Command) eventNamespaces() []string {
+	var namespaces []string
+
+	for _, ns := range []string{c.OperatorNamespace, c.ApplicationsNamespace, c.MonitoringNamespace} {
+		if ns != "" && !slices.Contains(namespaces, ns) {
+			namespaces = append(namespaces, ns)
+		}
+	}
+
+	return namespaces
+}
+
+// eventTime returns the most recent timestamp recorded on the event.
+func eventTime(e corev1.Event) time.Time {
+	switch {
+	case !e.LastTimestamp.IsZero():
+		return e.LastTimestamp.Time
+	case !e.EventTime.IsZero():
+		return e.EventTime.Time
+	default:
+		return e.CreationTimestamp.Time
+	}
+}
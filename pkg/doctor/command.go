@@ -0,0 +1,219 @@
+package doctor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/pflag"
+
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/opendatahub-io/odh-cli/pkg/cmd"
+	"github.com/opendatahub-io/odh-cli/pkg/output"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+	"github.com/opendatahub-io/odh-cli/pkg/util/iostreams"
+)
+
+var _ cmd.Command = (*Command)(nil)
+
+const (
+	// DefaultBundleFile is the default support bundle written by doctor.
+	DefaultBundleFile = "odh-doctor-bundle.tar.gz"
+
+	defaultSince     = time.Hour
+	defaultTailLines = 2000
+	defaultTimeout   = 10 * time.Minute
+
+	flagDescOutput            = "Path of the support bundle (gzipped tarball) to write"
+	flagDescTargetVersion     = "Target version passed to lint for upgrade readiness checks"
+	flagDescSince             = "Only collect logs and events newer than this duration (0 collects everything)"
+	flagDescTail              = "Number of recent log lines to collect per container (-1 collects all)"
+	flagDescOperatorNamespace = "Override the operator namespace (auto-detected by default)"
+	flagDescSkipLint          = "Do not run lint; collect only logs, events and manifests"
+	flagDescTimeout           = "Maximum time to spend collecting the bundle"
+
+	msgCollecting     = "Collecting %s..."
+	msgStepWarning    = "  Warning: %s"
+	msgNamespaceError = "Warning: discovering %s namespace: %v"
+	msgBundleWritten  = "Support bundle written to %s"
+)
+
+// lintRunner runs lint with the given streams; replaced in tests.
+type lintRunner func(ctx context.Context, streams genericiooptions.IOStreams) error
+
+// Command collects a support bundle: a lint report, operator pod logs, recent events in the
+// operator and applications namespaces, and the DSC/DSCI manifests.
+type Command struct {
+	IO          iostreams.Interface
+	ConfigFlags *genericclioptions.ConfigFlags
+	Client      client.Client
+
+	// OutputFile is the path of the support bundle to write.
+	OutputFile string
+	// TargetVersion is passed to lint to include upgrade readiness checks.
+	TargetVersion string
+	// Since restricts collected logs and events to this recent window; zero collects everything.
+	Since time.Duration
+	// TailLines limits the log lines collected per container; negative collects all.
+	TailLines int64
+	// SkipLint disables the lint step.
+	SkipLint bool
+	// Timeout bounds the whole collection.
+	Timeout time.Duration
+
+	// OperatorNamespace is the namespace of the operator pods, auto-detected during Run
+	// unless set with --operator-namespace.
+	OperatorNamespace string
+	// ApplicationsNamespace and MonitoringNamespace are read from the DSCInitialization.
+	ApplicationsNamespace string
+	MonitoringNamespace   string
+
+	lintRunner lintRunner
+}
+
+// NewCommand creates a new doctor Command with defaults.
+func NewCommand(
+	streams genericiooptions.IOStreams,
+	configFlags *genericclioptions.ConfigFlags,
+) *Command {
+	c := &Command{
+		IO:          iostreams.NewIOStreams(streams.In, streams.Out, streams.ErrOut),
+		ConfigFlags: configFlags,
+		OutputFile:  DefaultBundleFile,
+		Since:       defaultSince,
+		TailLines:   defaultTailLines,
+		Timeout:     defaultTimeout,
+	}
+	c.lintRunner = c.runLint
+
+	return c
+}
+
+// AddFlags registers command-specific flags.
+func (c *Command) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVarP(&c.OutputFile, "output", "o", c.OutputFile, flagDescOutput)
+	fs.StringVar(&c.TargetVersion, "target-version", "", flagDescTargetVersion)
+	fs.DurationVar(&c.Since, "since", c.Since, flagDescSince)
+	fs.Int64Var(&c.TailLines, "tail", c.TailLines, flagDescTail)
+	fs.StringVar(&c.OperatorNamespace, "operator-namespace", "", flagDescOperatorNamespace)
+	fs.BoolVar(&c.SkipLint, "skip-lint", false, flagDescSkipLint)
+	fs.DurationVar(&c.Timeout, "timeout", c.Timeout, flagDescTimeout)
+}
+
+// Complete creates the Kubernetes client.
+func (c *Command) Complete() error {
+	k8sClient, err := client.NewClient(c.ConfigFlags)
+	if err != nil {
+		return fmt.Errorf("creating Kubernetes client: %w", err)
+	}
+
+	c.Client = k8sClient
+
+	return nil
+}
+
+// Validate checks that all options are valid before execution.
+func (c *Command) Validate() error {
+	if c.OutputFile == "" {
+		return errors.New("--output must not be empty")
+	}
+
+	if c.Since < 0 {
+		return errors.New("--since must be a non-negative duration")
+	}
+
+	if c.Timeout <= 0 {
+		return errors.New("--timeout must be positive")
+	}
+
+	return nil
+}
+
+// Run collects every part of the bundle and writes it to OutputFile. Collection problems are
+// reported as warnings and recorded in the bundle summary so a partially reachable cluster
+// still produces a useful bundle; only failures to write the bundle itself are returned.
+func (c *Command) Run(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, c.Timeout)
+	defer cancel()
+
+	c.discoverNamespaces(ctx)
+
+	f, err := os.OpenFile(c.OutputFile, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, bundleFilePermission)
+	if err != nil {
+		return fmt.Errorf("creating bundle file: %w", err)
+	}
+	defer func() { _ = f.Close() }()
+
+	bundle := newBundleWriter(f)
+	summary := Summary{Metadata: output.NewMetadata("doctor")}
+
+	type step struct {
+		name    string
+		collect func(context.Context, *bundleWriter) StepResult
+	}
+
+	steps := []step{
+		{stepManifests, c.collectManifests},
+		{stepLogs, c.collectOperatorLogs},
+		{stepEvents, c.collectEvents},
+	}
+
+	if !c.SkipLint {
+		steps = append(steps, step{stepLint, c.collectLint})
+	}
+
+	for _, s := range steps {
+		c.IO.Errorf(msgCollecting, s.name)
+
+		res := s.collect(ctx, bundle)
+		for _, e := range res.Errors {
+			c.IO.Errorf(msgStepWarning, e)
+		}
+
+		summary.Steps = append(summary.Steps, res)
+	}
+
+	if err := bundle.AddYAML(SummaryFile, summary); err != nil {
+		return err
+	}
+
+	if err := bundle.Close(); err != nil {
+		return err
+	}
+
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("closing bundle file: %w", err)
+	}
+
+	_, _ = fmt.Fprintf(c.IO.ErrOut(), msgBundleWritten+"\n", c.OutputFile)
+
+	return nil
+}
+
+// discoverNamespaces resolves the operator namespace (unless overridden) and the namespaces
+// configured in the DSCInitialization. Failures leave the namespace empty and are reported
+// as warnings; the steps needing it record the gap in the summary.
+func (c *Command) discoverNamespaces(ctx context.Context) {
+	if c.OperatorNamespace == "" {
+		ns, err := client.DiscoverOperatorNamespace(ctx, c.Client)
+		if err != nil {
+			c.IO.Errorf(msgNamespaceError, "operator", err)
+		}
+
+		c.OperatorNamespace = ns
+	}
+
+	namespaces, err := client.GetDSCINamespaces(ctx, c.Client)
+	if err != nil {
+		c.IO.Errorf(msgNamespaceError, "applications", err)
+
+		return
+	}
+
+	c.ApplicationsNamespace = namespaces.Applications
+	c.MonitoringNamespace = namespaces.Monitoring
+}
@@ -0,0 +1,230 @@
+package doctor_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"sigs.k8s.io/yaml"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+
+	"github.com/opendatahub-io/odh-cli/pkg/doctor"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+	"github.com/opendatahub-io/odh-cli/pkg/util/iostreams"
+
+	. "github.com/onsi/gomega"
+)
+
+const (
+	operatorNS     = "redhat-ods-operator"
+	applicationsNS = "redhat-ods-applications"
+)
+
+func newSingleton(rt resources.ResourceType, name string, spec map[string]any) *unstructured.Unstructured {
+	return &unstructured.Unstructured{
+		Object: map[string]any{
+			"apiVersion": rt.APIVersion(),
+			"kind":       rt.Kind,
+			"metadata": map[string]any{
+				"name":          name,
+				"managedFields": []any{map[string]any{"manager": "operator"}},
+			},
+			"spec": spec,
+		},
+	}
+}
+
+func newOperatorPod(restarts int32) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "rhods-operator-abc",
+			Namespace: operatorNS,
+			Labels: map[string]string{
+				"control-plane": "controller-manager",
+				"name":          "rhods-operator",
+			},
+		},
+		Status: corev1.PodStatus{
+			ContainerStatuses: []corev1.ContainerStatus{
+				{Name: "manager", RestartCount: restarts},
+			},
+		},
+	}
+}
+
+func newEvent(namespace, name string, last time.Time) *corev1.Event {
+	return &corev1.Event{
+		ObjectMeta:    metav1.ObjectMeta{Name: name, Namespace: namespace},
+		Reason:        "Testing",
+		Message:       name,
+		LastTimestamp: metav1.NewTime(last),
+	}
+}
+
+func newTestCommand(t *testing.T, dynObjects []runtime.Object, kubeObjects ...runtime.Object) (*doctor.Command, *bytes.Buffer) {
+	t.Helper()
+
+	listKinds := map[schema.GroupVersionResource]string{
+		resources.DataScienceCluster.GVR(): resources.DataScienceCluster.ListKind(),
+		resources.DSCInitialization.GVR():  resources.DSCInitialization.ListKind(),
+	}
+
+	k8sClient := client.NewForTesting(client.TestClientConfig{
+		Dynamic:    dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), listKinds, dynObjects...),
+		Kubernetes: kubefake.NewSimpleClientset(kubeObjects...), //nolint:staticcheck // NewClientset needs apply configurations for GetLogs
+	})
+
+	var errOut bytes.Buffer
+
+	cmd := doctor.NewCommand(genericiooptions.IOStreams{Out: io.Discard, ErrOut: &errOut}, nil)
+	cmd.Client = k8sClient
+	cmd.IO = iostreams.NewIOStreams(nil, io.Discard, &errOut)
+	cmd.OperatorNamespace = operatorNS
+	cmd.OutputFile = filepath.Join(t.TempDir(), "bundle.tar.gz")
+
+	return cmd, &errOut
+}
+
+// readBundle returns the content of every entry of the bundle keyed by name.
+func readBundle(t *testing.T, path string) map[string]string {
+	t.Helper()
+
+	g := NewWithT(t)
+
+	f, err := os.Open(path)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	defer func() { _ = f.Close() }()
+
+	gz, err := gzip.NewReader(f)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	entries := make(map[string]string)
+	tr := tar.NewReader(gz)
+
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		g.Expect(err).ToNot(HaveOccurred())
+
+		data, err := io.ReadAll(tr)
+		g.Expect(err).ToNot(HaveOccurred())
+
+		entries[hdr.Name] = string(data)
+	}
+
+	return entries
+}
+
+func TestCommand_Validate(t *testing.T) {
+	t.Run("rejects empty output", func(t *testing.T) {
+		g := NewWithT(t)
+
+		cmd, _ := newTestCommand(t, nil)
+		cmd.OutputFile = ""
+
+		g.Expect(cmd.Validate()).To(MatchError(ContainSubstring("--output")))
+	})
+
+	t.Run("rejects negative since", func(t *testing.T) {
+		g := NewWithT(t)
+
+		cmd, _ := newTestCommand(t, nil)
+		cmd.Since = -time.Minute
+
+		g.Expect(cmd.Validate()).To(MatchError(ContainSubstring("--since")))
+	})
+
+	t.Run("accepts defaults", func(t *testing.T) {
+		g := NewWithT(t)
+
+		cmd, _ := newTestCommand(t, nil)
+
+		g.Expect(cmd.Validate()).To(Succeed())
+	})
+}
+
+func TestCommand_Run(t *testing.T) {
+	t.Run("bundles lint, manifests, logs and events", func(t *testing.T) {
+		g := NewWithT(t)
+
+		now := time.Now()
+
+		cmd, errOut := newTestCommand(t,
+			[]runtime.Object{
+				newSingleton(resources.DataScienceCluster, "default-dsc", map[string]any{}),
+				newSingleton(resources.DSCInitialization, "default-dsci", map[string]any{
+					"applicationsNamespace": applicationsNS,
+				}),
+			},
+			newOperatorPod(1),
+			newEvent(operatorNS, "recent-operator-event", now.Add(-time.Minute)),
+			newEvent(applicationsNS, "recent-app-event", now.Add(-2*time.Minute)),
+			newEvent(applicationsNS, "stale-app-event", now.Add(-3*time.Hour)),
+		)
+		cmd.SetLintRunner(func(_ context.Context, streams genericiooptions.IOStreams) error {
+			_, _ = io.WriteString(streams.Out, `{"kind":"DiagnosticResultList"}`)
+
+			return errors.New("blocking findings")
+		})
+
+		g.Expect(cmd.Validate()).To(Succeed())
+		g.Expect(cmd.Run(t.Context())).To(Succeed())
+		g.Expect(errOut.String()).To(ContainSubstring("Support bundle written to"))
+
+		entries := readBundle(t, cmd.OutputFile)
+		g.Expect(entries).To(HaveKey("manifests/datascienceclusters.yaml"))
+		g.Expect(entries["manifests/dscinitializations.yaml"]).To(ContainSubstring(applicationsNS))
+		g.Expect(entries["manifests/dscinitializations.yaml"]).ToNot(ContainSubstring("managedFields"))
+		g.Expect(entries).To(HaveKey("logs/redhat-ods-operator/rhods-operator-abc/manager.log"))
+		g.Expect(entries).To(HaveKey("logs/redhat-ods-operator/rhods-operator-abc/manager.previous.log"))
+		g.Expect(entries["events/redhat-ods-operator.yaml"]).To(ContainSubstring("recent-operator-event"))
+		g.Expect(entries["events/redhat-ods-applications.yaml"]).To(ContainSubstring("recent-app-event"))
+		g.Expect(entries["events/redhat-ods-applications.yaml"]).ToNot(ContainSubstring("stale-app-event"))
+		g.Expect(entries["lint/report.json"]).To(ContainSubstring("DiagnosticResultList"))
+
+		var summary doctor.Summary
+		g.Expect(yaml.Unmarshal([]byte(entries[doctor.SummaryFile]), &summary)).To(Succeed())
+		g.Expect(summary.Command).To(Equal("doctor"))
+		g.Expect(summary.Steps).To(HaveLen(4))
+		g.Expect(summary.Steps[3].Name).To(Equal("lint"))
+		g.Expect(summary.Steps[3].Errors).To(ConsistOf("blocking findings"))
+	})
+
+	t.Run("records missing resources in the summary", func(t *testing.T) {
+		g := NewWithT(t)
+
+		cmd, errOut := newTestCommand(t, nil)
+		cmd.SkipLint = true
+
+		g.Expect(cmd.Run(t.Context())).To(Succeed())
+		g.Expect(errOut.String()).To(ContainSubstring("Warning"))
+
+		entries := readBundle(t, cmd.OutputFile)
+
+		var summary doctor.Summary
+		g.Expect(yaml.Unmarshal([]byte(entries[doctor.SummaryFile]), &summary)).To(Succeed())
+		g.Expect(summary.Steps).To(HaveLen(3))
+		g.Expect(summary.Steps[0].Errors).To(HaveLen(2))
+		g.Expect(summary.Steps[1].Errors).To(ConsistOf(ContainSubstring("no operator pods found")))
+	})
+}
@@ -0,0 +1,12 @@
+package doctor
+
+import (
+	"context"
+
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+)
+
+// SetLintRunner replaces the lint step so tests do not need a kubeconfig.
+func (c *Command) SetLintRunner(f func(ctx context.Context, streams genericiooptions.IOStreams) error) {
+	c.lintRunner = f
+}
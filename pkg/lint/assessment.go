@@ -0,0 +1,85 @@
+package lint
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+)
+
+// Assessment is the outcome of an upgrade readiness run without any rendering. It lets other
+// commands (such as plan) build on lint results.
+type Assessment struct {
+	// CurrentVersion is the detected RHOAI version.
+	CurrentVersion string
+
+	// TargetVersion is the requested target version.
+	TargetVersion string
+
+	// OpenShiftVersion is the detected OpenShift version, empty if detection failed.
+	OpenShiftVersion string
+
+	// Results are the check executions after config file overrides, suppressions, the baseline
+	// and the severity filter were applied. Empty when no upgrade checks are needed.
+	Results []check.CheckExecution
+
+	// Target is the check target the results were produced with; it is needed to plan
+	// remediations via check.Remediator.
+	Target check.Target
+}
+
+// Assess detects the cluster version and runs the selected upgrade checks like Run, but
+// returns the results instead of printing them. It requires a target version; the caller is
+// responsible for applying a timeout to ctx.
+func (c *Command) Assess(ctx context.Context) (*Assessment, error) {
+	if c.isMultiTarget() {
+		return nil, errors.New("a single target version is required")
+	}
+
+	if c.parsedTargetVersion == nil && c.targetAlias == "" {
+		return nil, errors.New("a target version is required")
+	}
+
+	closeLog, err := c.openLog()
+	if err != nil {
+		return nil, err
+	}
+	defer closeLog()
+
+	closeAuditLog, err := c.openAuditLog()
+	if err != nil {
+		return nil, err
+	}
+	defer closeAuditLog()
+
+	currentVersion, err := c.detectVersions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	assessment := &Assessment{
+		CurrentVersion:   currentVersion.String(),
+		TargetVersion:    c.TargetVersion,
+		OpenShiftVersion: c.currentOpenShiftVersion,
+	}
+
+	upgrade, err := c.isUpgrade(currentVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	if !upgrade {
+		return assessment, nil
+	}
+
+	results, _, target, err := c.executeUpgradeChecks(ctx, currentVersion, false)
+	if err != nil {
+		return nil, fmt.Errorf("assessing upgrade readiness: %w", err)
+	}
+
+	assessment.Results = results
+	assessment.Target = target
+
+	return assessment, nil
+}
@@ -0,0 +1,215 @@
+package lint
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+)
+
+// AuditDecision is how a check selected for a run was evaluated.
+type AuditDecision string
+
+const (
+	// AuditDecisionApplied means CanApply selected the check and it ran.
+	AuditDecisionApplied AuditDecision = "Applied"
+
+	// AuditDecisionNotApplicable means CanApply returned false, so the check did not run.
+	AuditDecisionNotApplicable AuditDecision = "NotApplicable"
+
+	// AuditDecisionSkipped means the check did not run because a check it depends on failed.
+	AuditDecisionSkipped AuditDecision = "Skipped"
+
+	// AuditDecisionExcluded means the check was excluded before evaluation, e.g. because the
+	// current user lacks the permissions it needs.
+	AuditDecisionExcluded AuditDecision = "Excluded"
+
+	// AuditDecisionFailed means CanApply or Validate returned an error.
+	AuditDecisionFailed AuditDecision = "Failed"
+)
+
+// AuditRecord is one line of the --audit-log file: the evaluation of a single check.
+type AuditRecord struct {
+	Check          string        `json:"check"`
+	Group          string        `json:"group"`
+	CurrentVersion string        `json:"currentVersion,omitempty"`
+	TargetVersion  string        `json:"targetVersion,omitempty"`
+	Started        *time.Time    `json:"started,omitempty"`
+	Finished       *time.Time    `json:"finished,omitempty"`
+	Decision       AuditDecision `json:"decision"`
+
+	// Reason is the structured reason of a decision other than Applied, e.g.
+	// NotApplicableVersionRange or PrerequisiteFailed, and Message explains it.
+	Reason  string `json:"reason,omitempty"`
+	Message string `json:"message,omitempty"`
+
+	// Conditions are the conditions the check emitted, after severity overrides.
+	Conditions []result.Condition `json:"conditions,omitempty"`
+
+	// Impacted is the number of impacted objects the check reported.
+	Impacted int `json:"impacted,omitempty"`
+
+	// Error is the error returned by CanApply or Validate, e.g. an API error.
+	Error string `json:"error,omitempty"`
+}
+
+// auditLog appends an AuditRecord per evaluated check to the --audit-log file as the run
+// progresses, so a run that is interrupted still leaves a trail of the checks it evaluated.
+type auditLog struct {
+	mu   sync.Mutex
+	out  io.Writer
+	err  error
+	path string
+	n    int
+}
+
+// openAuditLog opens the --audit-log file for appending. The returned function closes the
+// file and reports how many records were written, or the first write failure as a warning
+// so it does not mask the outcome of the run.
+func (c *Command) openAuditLog() (func(), error) {
+	if c.AuditLogFile == "" {
+		return func() {}, nil
+	}
+
+	//nolint:gosec // The audit log path is provided by the user
+	f, err := os.OpenFile(c.AuditLogFile, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("opening audit log: %w", err)
+	}
+
+	c.audit = &auditLog{out: f, path: c.AuditLogFile}
+
+	return func() {
+		audit := c.audit
+		c.audit = nil
+
+		_ = f.Close()
+
+		if audit.err != nil {
+			c.IO.Errorf("Warning: failed to write audit log: %v", audit.err)
+
+			return
+		}
+
+		c.IO.Errorf("Wrote %d check evaluation(s) to %s", audit.n, audit.path)
+	}, nil
+}
+
+// observer returns the function recording the checks evaluated against target; it is
+// registered with check.Executor.SetProgressFunc. A nil log records nothing.
+func (a *auditLog) observer(target check.Target) func(check.CheckExecution) {
+	if a == nil {
+		return nil
+	}
+
+	var currentVersion, targetVersion string
+	if target.CurrentVersion != nil {
+		currentVersion = target.CurrentVersion.String()
+	}
+
+	if target.TargetVersion != nil {
+		targetVersion = target.TargetVersion.String()
+	}
+
+	return func(exec check.CheckExecution) {
+		record := newAuditRecord(exec)
+		record.CurrentVersion = currentVersion
+		record.TargetVersion = targetVersion
+
+		a.write(record)
+	}
+}
+
+func (a *auditLog) write(record AuditRecord) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.err != nil {
+		return
+	}
+
+	data, err := json.Marshal(record)
+	if err != nil {
+		a.err = fmt.Errorf("encoding record of check %s: %w", record.Check, err)
+
+		return
+	}
+
+	if _, err := a.out.Write(append(data, '\n')); err != nil {
+		a.err = err
+
+		return
+	}
+
+	a.n++
+}
+
+// newAuditRecord describes how the executor evaluated a check.
+func newAuditRecord(exec check.CheckExecution) AuditRecord {
+	record := AuditRecord{
+		Check: exec.Check.ID(),
+		Group: string(exec.Check.Group()),
+	}
+
+	if !exec.Started.IsZero() {
+		started, finished := exec.Started.UTC(), exec.Finished.UTC()
+		record.Started, record.Finished = &started, &finished
+	}
+
+	if exec.Error != nil {
+		record.Error = exec.Error.Error()
+	}
+
+	if exec.Result != nil {
+		record.Conditions = exec.Result.Status.Conditions
+		record.Impacted = auditImpactedCount(exec.Result)
+	}
+
+	switch {
+	case exec.Excluded:
+		record.Decision = AuditDecisionExcluded
+	case !exec.Applied:
+		record.Decision = AuditDecisionNotApplicable
+		record.Reason = string(exec.Applicability.Reason)
+		record.Message = exec.Applicability.Message
+		// The conditions of --show-skipped only restate the reason
+		record.Conditions = nil
+	case exec.Error != nil:
+		record.Decision = AuditDecisionFailed
+	case exec.Result != nil && skippedReason(exec.Result) != "":
+		record.Decision = AuditDecisionSkipped
+		record.Reason = check.ReasonPrerequisiteFailed
+		record.Message = skippedReason(exec.Result)
+	default:
+		record.Decision = AuditDecisionApplied
+	}
+
+	return record
+}
+
+// skippedReason returns the message of the condition reporting a failed prerequisite, if any.
+func skippedReason(dr *result.DiagnosticResult) string {
+	for _, cond := range dr.Status.Conditions {
+		if cond.Reason == check.ReasonPrerequisiteFailed {
+			return cond.Message
+		}
+	}
+
+	return ""
+}
+
+// auditImpactedCount returns the impacted-count annotation set by workload checks, else the
+// number of impacted objects.
+func auditImpactedCount(dr *result.DiagnosticResult) int {
+	if count, err := strconv.Atoi(dr.Annotations[check.AnnotationImpactedWorkloadCount]); err == nil {
+		return count
+	}
+
+	return len(dr.ImpactedObjects)
+}
@@ -0,0 +1,70 @@
+package lint_test
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestCommand_AuditLog(t *testing.T) {
+	g := NewWithT(t)
+
+	dir := t.TempDir()
+	g.Expect(os.WriteFile(filepath.Join(dir, "dsc.yaml"), []byte(fixtureSnapshotDSC), 0o600)).To(Succeed())
+	g.Expect(os.WriteFile(filepath.Join(dir, "dsci.yaml"), []byte(fixtureSnapshotDSCI), 0o600)).To(Succeed())
+
+	auditPath := filepath.Join(t.TempDir(), "audit.jsonl")
+
+	report, errOut := runBaselineCommand(t, dir, func(c *lint.Command) {
+		c.AuditLogFile = auditPath
+		c.Verbose = true
+	})
+	g.Expect(errOut).To(ContainSubstring("check evaluation(s) to " + auditPath))
+
+	f, err := os.Open(auditPath)
+	g.Expect(err).ToNot(HaveOccurred())
+	t.Cleanup(func() { _ = f.Close() })
+
+	decisions := make(map[lint.AuditDecision]int)
+	applied := make(map[string]lint.AuditRecord)
+
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(nil, 1<<20)
+
+	for scanner.Scan() {
+		var record lint.AuditRecord
+		g.Expect(json.Unmarshal(scanner.Bytes(), &record)).To(Succeed())
+		g.Expect(record.Check).ToNot(BeEmpty())
+		g.Expect(record.TargetVersion).To(Equal("3.0.0"))
+
+		decisions[record.Decision]++
+
+		if record.Decision == lint.AuditDecisionApplied {
+			g.Expect(record.Started).ToNot(BeNil())
+			g.Expect(record.Finished).ToNot(BeNil())
+			g.Expect(record.Finished.Before(*record.Started)).To(BeFalse())
+
+			applied[record.Check] = record
+		}
+	}
+
+	g.Expect(scanner.Err()).ToNot(HaveOccurred())
+
+	// Every reported result comes from an applied check, whose conditions are recorded
+	g.Expect(decisions[lint.AuditDecisionApplied]).To(BeNumerically(">=", len(report.Results)))
+	g.Expect(decisions[lint.AuditDecisionNotApplicable]).To(BeNumerically(">", 0))
+
+	for _, record := range applied {
+		if len(record.Conditions) > 0 {
+			return
+		}
+	}
+
+	t.Fatal("no applied check recorded its conditions")
+}
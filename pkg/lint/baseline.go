@@ -0,0 +1,201 @@
+package lint
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/output"
+)
+
+const (
+	baselineKind           = "LintBaseline"
+	baselineFilePermission = 0o644
+
+	msgBaselineWritten = "Baseline with %d finding(s) written to %s"
+	msgBaselineMatched = "%d known finding(s) matched baseline %s and were excluded"
+)
+
+// Baseline is the file format written by --baseline-write and read by --baseline-compare.
+// It records the fingerprints of the findings of a run so later runs only report regressions.
+type Baseline struct {
+	output.Envelope
+
+	// Findings are the recorded findings, sorted by check ID, condition and object.
+	Findings []result.Finding `json:"findings" yaml:"findings"`
+}
+
+// NewBaseline collects the findings of all results into a Baseline.
+func NewBaseline(results []check.CheckExecution) *Baseline {
+	b := &Baseline{
+		Envelope: output.NewEnvelope(baselineKind, "lint"),
+		Findings: []result.Finding{},
+	}
+
+	for _, exec := range results {
+		if exec.Result == nil || exec.Check == nil {
+			continue
+		}
+
+		b.Findings = append(b.Findings, exec.Result.Findings(exec.Check.ID())...)
+	}
+
+	slices.SortFunc(b.Findings, func(a, b result.Finding) int {
+		return strings.Compare(a.CheckID+"\x00"+a.Condition+"\x00"+a.Object, b.CheckID+"\x00"+b.Condition+"\x00"+b.Object)
+	})
+
+	return b
+}
+
+// WriteBaseline writes the baseline as indented JSON.
+func WriteBaseline(path string, b *Baseline) error {
+	data, err := json.MarshalIndent(b, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshaling baseline: %w", err)
+	}
+
+	if err := os.WriteFile(path, append(data, '\n'), baselineFilePermission); err != nil {
+		return fmt.Errorf("writing baseline: %w", err)
+	}
+
+	return nil
+}
+
+// LoadBaseline reads a baseline file written by WriteBaseline.
+func LoadBaseline(path string) (*Baseline, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading baseline: %w", err)
+	}
+
+	var b Baseline
+	if err := json.Unmarshal(data, &b); err != nil {
+		return nil, fmt.Errorf("parsing baseline %s: %w", path, err)
+	}
+
+	if b.Kind != baselineKind {
+		return nil, fmt.Errorf("parsing baseline %s: unexpected kind %q (expected %s)", path, b.Kind, baselineKind)
+	}
+
+	return &b, nil
+}
+
+// fingerprints returns the set of recorded fingerprints.
+func (b *Baseline) fingerprints() map[string]struct{} {
+	known := make(map[string]struct{}, len(b.Findings))
+	for _, f := range b.Findings {
+		known[f.Fingerprint] = struct{}{}
+	}
+
+	return known
+}
+
+// ExcludeBaseline returns a filtered copy of results without the findings recorded in the
+// baseline, along with the number of findings excluded. The original slice is not modified.
+//
+// A condition is dropped when every one of its findings is known. Impacted objects are kept
+// only if they are part of at least one new finding. Results left with no non-passing
+// condition keep their passing conditions; results left with no conditions are removed.
+func ExcludeBaseline(results []check.CheckExecution, b *Baseline) ([]check.CheckExecution, int) {
+	known := b.fingerprints()
+	filtered := make([]check.CheckExecution, 0, len(results))
+	matched := 0
+
+	for _, exec := range results {
+		if exec.Result == nil || exec.Check == nil {
+			filtered = append(filtered, exec)
+
+			continue
+		}
+
+		checkID := exec.Check.ID()
+		newObjects := make(map[string]struct{})
+
+		var kept []result.Condition
+
+		for _, cond := range exec.Result.Status.Conditions {
+			if cond.Impact == result.ImpactNone {
+				kept = append(kept, cond)
+
+				continue
+			}
+
+			isNew := false
+
+			for _, f := range exec.Result.ConditionFindings(checkID, cond) {
+				if _, ok := known[f.Fingerprint]; ok {
+					matched++
+
+					continue
+				}
+
+				isNew = true
+				newObjects[f.Object] = struct{}{}
+			}
+
+			if isNew {
+				kept = append(kept, cond)
+			}
+		}
+
+		if len(kept) == 0 {
+			continue
+		}
+
+		filteredResult := *exec.Result
+		filteredResult.Status.Conditions = kept
+
+		if len(exec.Result.ImpactedObjects) > 0 {
+			filteredResult.ImpactedObjects = slices.DeleteFunc(
+				slices.Clone(exec.Result.ImpactedObjects),
+				func(obj metav1.PartialObjectMetadata) bool {
+					_, ok := newObjects[result.ObjectIdentity(&obj)]
+
+					return !ok
+				},
+			)
+		}
+
+		filtered = append(filtered, check.CheckExecution{
+			Check:  exec.Check,
+			Result: &filteredResult,
+			Error:  exec.Error,
+		})
+	}
+
+	return filtered, matched
+}
+
+// applyBaseline writes or compares against the baseline file selected by the flags.
+// In write mode the results are returned unchanged; in compare mode known findings are removed.
+func (c *Command) applyBaseline(results []check.CheckExecution) ([]check.CheckExecution, error) {
+	switch {
+	case c.BaselineWrite != "":
+		b := NewBaseline(results)
+		if err := WriteBaseline(c.BaselineWrite, b); err != nil {
+			return nil, err
+		}
+
+		_, _ = fmt.Fprintf(c.IO.ErrOut(), msgBaselineWritten+"\n", len(b.Findings), c.BaselineWrite)
+
+		return results, nil
+	case c.BaselineCompare != "":
+		b, err := LoadBaseline(c.BaselineCompare)
+		if err != nil {
+			return nil, err
+		}
+
+		filtered, matched := ExcludeBaseline(results, b)
+		c.IO.Errorf(msgBaselineMatched, matched, c.BaselineCompare)
+
+		return filtered, nil
+	default:
+		return results, nil
+	}
+}
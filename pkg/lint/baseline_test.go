@@ -0,0 +1,123 @@
+package lint_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+
+	. "github.com/onsi/gomega"
+)
+
+type baselineReport struct {
+	Results []result.DiagnosticResult `json:"results"`
+}
+
+func runBaselineCommand(t *testing.T, dir string, configure func(*lint.Command)) (baselineReport, string) {
+	t.Helper()
+
+	g := NewWithT(t)
+
+	var out, errOut bytes.Buffer
+	command := lint.NewCommand(genericiooptions.IOStreams{
+		In: &bytes.Buffer{}, Out: &out, ErrOut: &errOut,
+	}, testConfigFlags())
+	command.FromDir = dir
+	command.TargetVersion = "3.0.0"
+	command.OutputFormat = lint.OutputFormatJSON
+	configure(command)
+
+	g.Expect(command.Complete()).To(Succeed())
+	g.Expect(command.Validate()).To(Succeed())
+
+	// Findings may produce a non-zero exit error; the report itself must still be rendered.
+	_ = command.Run(t.Context())
+
+	var report baselineReport
+	g.Expect(json.Unmarshal(out.Bytes(), &report)).To(Succeed())
+
+	return report, errOut.String()
+}
+
+func countFailing(report baselineReport) int {
+	n := 0
+	for i := range report.Results {
+		if report.Results[i].GetImpact() != result.ImpactNone {
+			n++
+		}
+	}
+
+	return n
+}
+
+func TestCommand_Baseline(t *testing.T) {
+	t.Run("compare should exclude findings recorded by write", func(t *testing.T) {
+		g := NewWithT(t)
+
+		dir := t.TempDir()
+		g.Expect(os.WriteFile(filepath.Join(dir, "dsc.yaml"), []byte(fixtureSnapshotDSC), 0o600)).To(Succeed())
+		g.Expect(os.WriteFile(filepath.Join(dir, "dsci.yaml"), []byte(fixtureSnapshotDSCI), 0o600)).To(Succeed())
+
+		baselinePath := filepath.Join(t.TempDir(), "baseline.json")
+
+		written, errOut := runBaselineCommand(t, dir, func(c *lint.Command) {
+			c.BaselineWrite = baselinePath
+		})
+		g.Expect(countFailing(written)).To(BeNumerically(">", 0))
+		g.Expect(errOut).To(ContainSubstring("written to " + baselinePath))
+
+		b, err := lint.LoadBaseline(baselinePath)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(b.Kind).To(Equal("LintBaseline"))
+		g.Expect(b.Findings).ToNot(BeEmpty())
+
+		compared, _ := runBaselineCommand(t, dir, func(c *lint.Command) {
+			c.BaselineCompare = baselinePath
+		})
+		g.Expect(countFailing(compared)).To(Equal(0))
+	})
+
+	t.Run("compare should report findings missing from the baseline", func(t *testing.T) {
+		g := NewWithT(t)
+
+		dir := t.TempDir()
+		g.Expect(os.WriteFile(filepath.Join(dir, "dsc.yaml"), []byte(fixtureSnapshotDSC), 0o600)).To(Succeed())
+		g.Expect(os.WriteFile(filepath.Join(dir, "dsci.yaml"), []byte(fixtureSnapshotDSCI), 0o600)).To(Succeed())
+
+		baselinePath := filepath.Join(t.TempDir(), "baseline.json")
+		g.Expect(lint.WriteBaseline(baselinePath, lint.NewBaseline(nil))).To(Succeed())
+
+		compared, _ := runBaselineCommand(t, dir, func(c *lint.Command) {
+			c.BaselineCompare = baselinePath
+		})
+		g.Expect(countFailing(compared)).To(BeNumerically(">", 0))
+	})
+
+	t.Run("should reject write and compare together", func(t *testing.T) {
+		g := NewWithT(t)
+
+		command := lint.NewCommand(genericiooptions.IOStreams{
+			In: &bytes.Buffer{}, Out: &bytes.Buffer{}, ErrOut: &bytes.Buffer{},
+		}, testConfigFlags())
+		command.BaselineWrite = "a.json"
+		command.BaselineCompare = "b.json"
+
+		g.Expect(command.Validate()).To(MatchError(ContainSubstring("mutually exclusive")))
+	})
+
+	t.Run("LoadBaseline should reject files of another kind", func(t *testing.T) {
+		g := NewWithT(t)
+
+		path := filepath.Join(t.TempDir(), "other.json")
+		g.Expect(os.WriteFile(path, []byte(`{"kind": "DiagnosticResultList"}`), 0o600)).To(Succeed())
+
+		_, err := lint.LoadBaseline(path)
+		g.Expect(err).To(MatchError(ContainSubstring("unexpected kind")))
+	})
+}
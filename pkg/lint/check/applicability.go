@@ -0,0 +1,224 @@
+package check
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/opendatahub-io/odh-cli/pkg/constants"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+	"github.com/opendatahub-io/odh-cli/pkg/util/components"
+	"github.com/opendatahub-io/odh-cli/pkg/util/version"
+)
+
+// NotApplicableReason is the structured reason a check does not apply to a target.
+type NotApplicableReason string
+
+const (
+	// NotApplicableVersionRange means the current or target version is outside the versions
+	// the check covers.
+	NotApplicableVersionRange NotApplicableReason = "NotApplicableVersionRange"
+
+	// NotApplicableComponentNotManaged means no component the check covers is in a management
+	// state the check applies to (Managed unless declared otherwise), e.g. Removed.
+	NotApplicableComponentNotManaged NotApplicableReason = "ComponentNotManaged"
+
+	// NotApplicableFlavor means the check covers another product flavor than the cluster's,
+	// e.g. a RHOAI support policy on an ODH cluster.
+	NotApplicableFlavor NotApplicableReason = "NotApplicableFlavor"
+)
+
+// AnnotationNotApplicableReason is the result annotation key holding the NotApplicableReason of
+// a check reported as not applicable (see Executor.SetIncludeNotApplicable).
+const AnnotationNotApplicableReason = "check.opendatahub.io/not-applicable-reason"
+
+// Applicability is the outcome of evaluating whether a check applies to a target, with the
+// reason when it does not.
+type Applicability struct {
+	// Applies reports whether the check should run against the target.
+	Applies bool
+
+	// Reason is the structured reason the check does not apply; empty when unknown.
+	Reason NotApplicableReason
+
+	// Message explains the reason to users, e.g. "component kserve is Removed".
+	Message string
+}
+
+// Applicable returns the applicability of a check that applies to the target.
+func Applicable() Applicability {
+	return Applicability{Applies: true}
+}
+
+// NotApplicable returns the applicability of a check that does not apply to the target for
+// the given reason. Supports printf-style formatting of the message.
+func NotApplicable(reason NotApplicableReason, format string, args ...any) Applicability {
+	return Applicability{Reason: reason, Message: fmt.Sprintf(format, args...)}
+}
+
+// ApplicabilityReporter is an optional interface for checks that explain why they do not apply
+// to a target. The executor calls Applicability instead of CanApply for checks implementing it,
+// and records the reason. Their CanApply typically delegates to it with CanApplyFrom.
+type ApplicabilityReporter interface {
+	Applicability(ctx context.Context, target Target) (Applicability, error)
+}
+
+// CanApplyFrom converts the result of Applicability into the result of CanApply.
+func CanApplyFrom(a Applicability, err error) (bool, error) {
+	return a.Applies && err == nil, err
+}
+
+// UpgradeFrom2xTo3x returns whether the target is an upgrade from 2.x to 3.x, the version range
+// of most upgrade checks.
+func UpgradeFrom2xTo3x(target Target) Applicability {
+	if !version.IsUpgradeFrom2xTo3x(target.CurrentVersion, target.TargetVersion) {
+		return NotApplicable(NotApplicableVersionRange, "applies to %s, not %s -> %s", VersionsUpgrade2xTo3x,
+			version.MajorMinorLabel(target.CurrentVersion), version.MajorMinorLabel(target.TargetVersion))
+	}
+
+	return Applicable()
+}
+
+// ComponentsManaged returns whether any of the components with the given DataScienceCluster keys
+// is Managed. It returns NotApplicableComponentNotManaged, with the state of the components,
+// when none is.
+func ComponentsManaged(ctx context.Context, target Target, componentKeys ...string) (Applicability, error) {
+	return ComponentsInState(ctx, target, []string{constants.ManagementStateManaged}, componentKeys...)
+}
+
+// ComponentsInState is ComponentsManaged for components in one of the given management states,
+// e.g. Managed or Unmanaged.
+func ComponentsInState(ctx context.Context, target Target, states []string, componentKeys ...string) (Applicability, error) {
+	dsc, err := client.GetDataScienceCluster(ctx, target.Client)
+	if err != nil {
+		return Applicability{}, fmt.Errorf("getting DataScienceCluster: %w", err)
+	}
+
+	reasons := make([]string, 0, len(componentKeys))
+
+	for _, key := range componentKeys {
+		// Components that are not configured are Removed
+		state, err := components.GetManagementState(dsc, key)
+
+		switch {
+		case err != nil:
+			reasons = append(reasons, fmt.Sprintf("component %s has an unreadable management state", key))
+		case slices.Contains(states, state):
+			return Applicable(), nil
+		default:
+			reasons = append(reasons, fmt.Sprintf("component %s is %s", key, state))
+		}
+	}
+
+	return NotApplicable(NotApplicableComponentNotManaged, "%s", strings.Join(reasons, ", ")), nil
+}
+
+// declaredApplicability returns whether the target is within the version range with the given
+// label and, when components are given, one of them is in one of the given management states
+// (Managed when none are given).
+func declaredApplicability(
+	ctx context.Context,
+	target Target,
+	versions string,
+	components []string,
+	states []string,
+) (Applicability, error) {
+	if !inVersionRange(versions, target) {
+		return NotApplicable(NotApplicableVersionRange, "applies to %s, not %s -> %s", versions,
+			version.MajorMinorLabel(target.CurrentVersion), version.MajorMinorLabel(target.TargetVersion)), nil
+	}
+
+	if len(components) == 0 {
+		return Applicable(), nil
+	}
+
+	if len(states) == 0 {
+		return ComponentsManaged(ctx, target, components...)
+	}
+
+	return ComponentsInState(ctx, target, states, components...)
+}
+
+// evaluateApplicability runs the check's Applicability, or CanApply for checks that do not
+// report a reason. For those, the reason is inferred from the versions and components they
+// declare (see VersionDescriber and ComponentDescriber). Checks declaring product flavors (see
+// FlavorDescriber) do not apply to clusters of other flavors.
+func evaluateApplicability(ctx context.Context, target Target, check Check) (Applicability, error) {
+	if d, ok := check.(FlavorDescriber); ok && !inFlavors(d.ApplicableFlavors(), target.Flavor) {
+		return NotApplicable(NotApplicableFlavor, "applies to %s, not %s",
+			joinFlavors(d.ApplicableFlavors()), target.Flavor.DisplayName()), nil
+	}
+
+	if reporter, ok := check.(ApplicabilityReporter); ok {
+		return reporter.Applicability(ctx, target)
+	}
+
+	canApply, err := check.CanApply(ctx, target)
+	if err != nil || canApply {
+		return Applicability{Applies: canApply}, err
+	}
+
+	var (
+		versions   string
+		components []string
+		states     []string
+	)
+
+	if d, ok := check.(VersionDescriber); ok {
+		versions = d.ApplicableVersions()
+	}
+
+	if d, ok := check.(ComponentDescriber); ok {
+		components = d.ApplicableComponents()
+		states = d.ApplicableComponentStates()
+	}
+
+	applicability, err := declaredApplicability(ctx, target, versions, components, states)
+	if err != nil {
+		return Applicability{}, err
+	}
+
+	// Within its declarations, the check does not apply for a reason it does not report
+	if applicability.Applies {
+		return Applicability{}, nil
+	}
+
+	return applicability, nil
+}
+
+// inVersionRange returns whether the target is within the version range with the given label.
+// Unknown labels are considered to include every target.
+func inVersionRange(label string, target Target) bool {
+	switch label {
+	case VersionsUpgrade2xTo3x:
+		return version.IsUpgradeFrom2xTo3x(target.CurrentVersion, target.TargetVersion)
+	case VersionsUpgrade34To35:
+		return version.IsUpgradeFrom34To35(target.CurrentVersion, target.TargetVersion)
+	case VersionsTarget3x:
+		return version.IsVersion3x(target.TargetVersion)
+	case VersionsTargetAtLeast33:
+		//nolint:mnd // Version numbers 3.3
+		return version.IsVersionAtLeast(target.TargetVersion, 3, 3)
+	case VersionsCurrentOrTarget3x:
+		return version.IsVersion3x(target.CurrentVersion) || version.IsVersion3x(target.TargetVersion)
+	default:
+		return true
+	}
+}
+
+// inFlavors returns whether a check declaring the given flavors applies to a cluster of flavor.
+// Checks declaring none, and clusters of unknown flavor, match every flavor.
+func inFlavors(flavors []version.Flavor, flavor version.Flavor) bool {
+	return len(flavors) == 0 || flavor == version.FlavorUnknown || slices.Contains(flavors, flavor)
+}
+
+// joinFlavors returns the display names of flavors, e.g. "Red Hat OpenShift AI".
+func joinFlavors(flavors []version.Flavor) string {
+	names := make([]string, 0, len(flavors))
+	for _, f := range flavors {
+		names = append(names, f.DisplayName())
+	}
+
+	return strings.Join(names, ", ")
+}
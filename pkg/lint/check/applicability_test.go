@@ -0,0 +1,241 @@
+package check_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/blang/semver/v4"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/testutil"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/version"
+
+	. "github.com/onsi/gomega"
+)
+
+//nolint:gochecknoglobals // Test fixture - shared across test functions
+var applicabilityListKinds = map[schema.GroupVersionResource]string{
+	resources.DataScienceCluster.GVR(): resources.DataScienceCluster.ListKind(),
+}
+
+// reportingTestCheck reports it does not apply when its component is not Managed.
+type reportingTestCheck struct {
+	statsTestCheck
+
+	component string
+}
+
+func (c *reportingTestCheck) CanApply(ctx context.Context, target check.Target) (bool, error) {
+	return check.CanApplyFrom(c.Applicability(ctx, target))
+}
+
+func (c *reportingTestCheck) Applicability(ctx context.Context, target check.Target) (check.Applicability, error) {
+	return check.ComponentsManaged(ctx, target, c.component)
+}
+
+// declaredTestCheck relies on the CanApply of BaseCheck.
+type declaredTestCheck struct {
+	check.BaseCheck
+}
+
+func (c *declaredTestCheck) Validate(_ context.Context, _ check.Target) (*result.DiagnosticResult, error) {
+	return c.NewResult(), nil
+}
+
+func newDeclaredTestCheck(id string, versions string, components ...string) *declaredTestCheck {
+	return &declaredTestCheck{BaseCheck: check.BaseCheck{
+		CheckGroup:      check.GroupWorkload,
+		Kind:            "test",
+		Type:            check.CheckTypeRemoval,
+		CheckID:         id,
+		CheckName:       id,
+		CheckVersions:   versions,
+		CheckComponents: components,
+	}}
+}
+
+func TestBaseCheck_CanApply(t *testing.T) {
+	newTarget := func(t *testing.T, current string) check.Target {
+		t.Helper()
+
+		return testutil.NewTarget(t, testutil.TargetConfig{
+			ListKinds:      applicabilityListKinds,
+			Objects:        []*unstructured.Unstructured{testutil.NewDSC(map[string]string{"kserve": "Removed", "ray": "Managed"})},
+			CurrentVersion: current,
+			TargetVersion:  "3.0.0",
+		})
+	}
+
+	unmanagedKServe := newDeclaredTestCheck("e", check.VersionsAny, "kserve")
+	unmanagedKServe.CheckComponentStates = []string{"Managed", "Removed"}
+
+	for _, tc := range []struct {
+		name     string
+		check    *declaredTestCheck
+		current  string
+		expected bool
+	}{
+		{"should apply within the versions", newDeclaredTestCheck("a", check.VersionsUpgrade2xTo3x), "2.25.0", true},
+		{"should not apply outside the versions", newDeclaredTestCheck("b", check.VersionsUpgrade2xTo3x), "3.0.0", false},
+		{"should apply when a component is Managed", newDeclaredTestCheck("c", check.VersionsAny, "kserve", "ray"), "3.0.0", true},
+		{"should not apply when no component is Managed", newDeclaredTestCheck("d", check.VersionsAny, "kserve"), "3.0.0", false},
+		{"should apply when a component is in a declared state", unmanagedKServe, "3.0.0", true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			canApply, err := tc.check.CanApply(t.Context(), newTarget(t, tc.current))
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(canApply).To(Equal(tc.expected))
+		})
+	}
+}
+
+func TestComponentsManaged(t *testing.T) {
+	newTarget := func(t *testing.T, states map[string]string) check.Target {
+		t.Helper()
+
+		return testutil.NewTarget(t, testutil.TargetConfig{
+			ListKinds: applicabilityListKinds,
+			Objects:   []*unstructured.Unstructured{testutil.NewDSC(states)},
+		})
+	}
+
+	t.Run("should apply when one of the components is Managed", func(t *testing.T) {
+		g := NewWithT(t)
+
+		target := newTarget(t, map[string]string{"kserve": "Removed", "modelmeshserving": "Managed"})
+
+		applicability, err := check.ComponentsManaged(t.Context(), target, "kserve", "modelmeshserving")
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(applicability).To(Equal(check.Applicable()))
+	})
+
+	t.Run("should report the state of components that are not Managed", func(t *testing.T) {
+		g := NewWithT(t)
+
+		target := newTarget(t, map[string]string{"kserve": "Removed"})
+
+		applicability, err := check.ComponentsManaged(t.Context(), target, "kserve", "modelmeshserving")
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(applicability.Applies).To(BeFalse())
+		g.Expect(applicability.Reason).To(Equal(check.NotApplicableComponentNotManaged))
+		g.Expect(applicability.Message).To(Equal("component kserve is Removed, component modelmeshserving is Removed"))
+	})
+
+	t.Run("should fail without a DataScienceCluster", func(t *testing.T) {
+		g := NewWithT(t)
+
+		target := testutil.NewTarget(t, testutil.TargetConfig{ListKinds: applicabilityListKinds})
+
+		_, err := check.ComponentsManaged(t.Context(), target, "kserve")
+		g.Expect(err).To(MatchError(ContainSubstring("getting DataScienceCluster")))
+	})
+}
+
+func TestUpgradeFrom2xTo3x(t *testing.T) {
+	g := NewWithT(t)
+
+	current, target := semver.MustParse("3.0.0"), semver.MustParse("3.3.0")
+
+	g.Expect(check.UpgradeFrom2xTo3x(check.Target{CurrentVersion: &current, TargetVersion: &target})).To(Equal(
+		check.NotApplicable(check.NotApplicableVersionRange, "applies to upgrade 2.x -> 3.x, not 3.0 -> 3.3")))
+
+	current = semver.MustParse("2.25.0")
+	g.Expect(check.UpgradeFrom2xTo3x(check.Target{CurrentVersion: &current, TargetVersion: &target}).Applies).To(BeTrue())
+}
+
+func TestExecutorNotApplicableReason(t *testing.T) {
+	g := NewWithT(t)
+
+	versioned := newStatsTestCheck("workloads.test.versioned")
+	versioned.CheckVersions = check.VersionsUpgrade2xTo3x
+	versioned.notApplicable = true
+
+	reporting := &reportingTestCheck{statsTestCheck: *newStatsTestCheck("workloads.test.reporting"), component: "kserve"}
+
+	unexplained := newStatsTestCheck("workloads.test.unexplained")
+	unexplained.notApplicable = true
+
+	declaredVersions := newDeclaredTestCheck("workloads.test.declared-versions", check.VersionsUpgrade2xTo3x, "kserve")
+	declaredComponents := newDeclaredTestCheck("workloads.test.declared-components", check.VersionsAny, "kserve")
+
+	registry := check.NewRegistry()
+	g.Expect(registry.Register(versioned)).To(Succeed())
+	g.Expect(registry.Register(reporting)).To(Succeed())
+	g.Expect(registry.Register(unexplained)).To(Succeed())
+	g.Expect(registry.Register(declaredVersions)).To(Succeed())
+	g.Expect(registry.Register(declaredComponents)).To(Succeed())
+
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds:      applicabilityListKinds,
+		Objects:        []*unstructured.Unstructured{testutil.NewDSC(map[string]string{"kserve": "Removed"})},
+		CurrentVersion: "3.0.0",
+		TargetVersion:  "3.3.0",
+	})
+
+	executor := check.NewExecutor(registry, nil)
+	executor.SetIncludeNotApplicable(true)
+
+	results, err := executor.ExecuteSelective(t.Context(), target, []string{"*"}, check.GroupWorkload)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(results).To(HaveLen(5))
+
+	reasons := make(map[string]string, len(results))
+	messages := make(map[string]string, len(results))
+
+	for _, exec := range results {
+		g.Expect(check.IsNotApplicable(exec.Result)).To(BeTrue())
+
+		reasons[exec.Check.ID()] = exec.Result.Annotations[check.AnnotationNotApplicableReason]
+		messages[exec.Check.ID()] = exec.Applicability.Message
+	}
+
+	// Reasons are inferred from the declared versions and components of checks not reporting one
+	g.Expect(reasons).To(Equal(map[string]string{
+		"workloads.test.versioned":           string(check.NotApplicableVersionRange),
+		"workloads.test.reporting":           string(check.NotApplicableComponentNotManaged),
+		"workloads.test.unexplained":         "",
+		"workloads.test.declared-versions":   string(check.NotApplicableVersionRange),
+		"workloads.test.declared-components": string(check.NotApplicableComponentNotManaged),
+	}))
+	g.Expect(messages["workloads.test.declared-components"]).To(Equal("component kserve is Removed"))
+}
+
+func TestExecutorNotApplicableFlavor(t *testing.T) {
+	g := NewWithT(t)
+
+	rhoai := newStatsTestCheck("workloads.test.rhoai")
+	rhoai.CheckFlavors = []version.Flavor{version.FlavorRHOAI}
+
+	registry := check.NewRegistry()
+	g.Expect(registry.Register(rhoai)).To(Succeed())
+
+	executor := check.NewExecutor(registry, nil)
+	executor.SetIncludeNotApplicable(true)
+
+	for flavor, applies := range map[version.Flavor]bool{
+		version.FlavorRHOAI: true,
+		version.FlavorODH:   false,
+		// Checks apply when the flavor could not be detected
+		version.FlavorUnknown: true,
+	} {
+		target := testutil.NewTarget(t, testutil.TargetConfig{ListKinds: applicabilityListKinds})
+		target.Flavor = flavor
+
+		results, err := executor.ExecuteSelective(t.Context(), target, []string{"*"}, check.GroupWorkload)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(results).To(HaveLen(1))
+		g.Expect(check.IsNotApplicable(results[0].Result)).To(Equal(!applies), "flavor %q", flavor)
+
+		if !applies {
+			g.Expect(results[0].Applicability).To(Equal(check.NotApplicable(check.NotApplicableFlavor,
+				"applies to Red Hat OpenShift AI, not Open Data Hub")))
+		}
+	}
+}
@@ -1,7 +1,11 @@
 package check
 
 import (
+	"context"
+
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/version"
 )
 
 // BaseCheck provides common check metadata and functionality through composition.
@@ -34,6 +38,33 @@ type BaseCheck struct {
 	CheckName        string
 	CheckDescription string
 	CheckRemediation string
+
+	// CheckResources lists the resource types the check reads in addition to PlatformResources.
+	CheckResources []resources.ResourceType
+
+	// CheckVersions describes the versions CanApply accepts (one of the Versions* labels),
+	// reported by `lint list-checks`. The CanApply of BaseCheck only accepts targets within it.
+	CheckVersions string
+
+	// CheckComponents lists the DataScienceCluster keys of the components the check covers (e.g.
+	// "kserve"). The CanApply of BaseCheck only accepts targets where one of them is in one of
+	// CheckComponentStates.
+	CheckComponents []string
+
+	// CheckComponentStates lists the management states of CheckComponents the check applies to
+	// (e.g. Managed and Unmanaged); empty means Managed.
+	CheckComponentStates []string
+
+	// CheckFlavors lists the product flavors the check applies to; empty applies to every
+	// flavor. The executor reports the check as not applicable on clusters of other flavors.
+	CheckFlavors []version.Flavor
+
+	// CheckDependsOn lists the IDs of the checks that must pass for this check's results to be
+	// meaningful. The executor skips the check when one of them fails.
+	CheckDependsOn []string
+
+	// CheckTags lists the tags the check can be selected by with "@<tag>" (e.g. TagFast).
+	CheckTags []string
 }
 
 // ID returns the unique identifier for this check.
@@ -59,6 +90,48 @@ func (b BaseCheck) Remediation() string {
 	return b.CheckRemediation
 }
 
+// ApplicableVersions returns the human-readable version range this check applies to.
+// Implements check.VersionDescriber.
+func (b BaseCheck) ApplicableVersions() string {
+	return b.CheckVersions
+}
+
+// ApplicableComponents returns the DataScienceCluster keys of the components this check covers.
+// Implements check.ComponentDescriber.
+func (b BaseCheck) ApplicableComponents() []string {
+	return b.CheckComponents
+}
+
+// ApplicableComponentStates returns the management states of the components this check applies to.
+// Implements check.ComponentDescriber.
+func (b BaseCheck) ApplicableComponentStates() []string {
+	return b.CheckComponentStates
+}
+
+// ApplicableFlavors returns the product flavors this check applies to.
+// Implements check.FlavorDescriber.
+func (b BaseCheck) ApplicableFlavors() []version.Flavor {
+	return b.CheckFlavors
+}
+
+// RequiredResources returns the resource types this check reads.
+// Implements check.ResourceRequirer.
+func (b BaseCheck) RequiredResources() []resources.ResourceType {
+	return b.CheckResources
+}
+
+// Dependencies returns the IDs of the checks this check depends on.
+// Implements check.DependencyDeclarer.
+func (b BaseCheck) Dependencies() []string {
+	return b.CheckDependsOn
+}
+
+// Tags returns the tags the check can be selected by.
+// Implements check.TagDeclarer.
+func (b BaseCheck) Tags() []string {
+	return b.CheckTags
+}
+
 // Group returns the check group.
 // Required by check.Check interface.
 func (b BaseCheck) Group() CheckGroup {
@@ -77,6 +150,14 @@ func (b BaseCheck) CheckType() string {
 	return string(b.Type)
 }
 
+// CanApply returns whether the target is within CheckVersions and, when CheckComponents is set,
+// one of the components is in one of CheckComponentStates. Checks with other conditions
+// implement their own CanApply.
+// Required by check.Check interface.
+func (b BaseCheck) CanApply(ctx context.Context, target Target) (bool, error) {
+	return CanApplyFrom(declaredApplicability(ctx, target, b.CheckVersions, b.CheckComponents, b.CheckComponentStates))
+}
+
 // NewResult creates a DiagnosticResult initialized with this check's metadata.
 // This is the primary convenience method that eliminates result.New() boilerplate.
 //
@@ -5,6 +5,7 @@ import (
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/messages"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
 )
 
@@ -19,7 +20,9 @@ func WithReason(reason string) ConditionOption {
 }
 
 // WithMessage sets the condition message. Supports printf-style formatting:
-// if args are provided, the message is formatted with fmt.Sprintf.
+// if args are provided, the message is formatted with fmt.Sprintf. The message is not
+// translated; built-in checks use WithCatalogMessage, WithMessage is meant for user-provided
+// texts.
 func WithMessage(format string, args ...any) ConditionOption {
 	return func(c *result.Condition) {
 		if len(args) > 0 {
@@ -30,6 +33,28 @@ func WithMessage(format string, args ...any) ConditionOption {
 	}
 }
 
+// WithCatalogMessage sets the condition message from the message catalog and records its ID.
+// The message is formatted in English; the executor translates it to the language of the
+// target (see Target.Messages). Args format the message like WithMessage.
+func WithCatalogMessage(m messages.Message, args ...any) ConditionOption {
+	return func(c *result.Condition) {
+		c.MessageID = m.ID
+		c.MessageArgs = args
+		c.Message = m.Sprintf(args...)
+	}
+}
+
+// WithCatalogRemediation sets the condition remediation from the message catalog, like
+// WithCatalogMessage. Conditions carrying the remediation of their check use WithRemediation
+// instead: the executor translates it under the ID <check ID>.remediation.
+func WithCatalogRemediation(m messages.Message, args ...any) ConditionOption {
+	return func(c *result.Condition) {
+		c.RemediationID = m.ID
+		c.RemediationArgs = args
+		c.Remediation = m.Sprintf(args...)
+	}
+}
+
 // WithImpact sets the impact explicitly, overriding auto-derivation.
 // Use this when the default impact (derived from Status) is not appropriate.
 func WithImpact(impact result.Impact) ConditionOption {
@@ -70,16 +95,16 @@ func deriveImpact(status metav1.ConditionStatus) result.Impact {
 //	    check.ConditionTypeAvailable,
 //	    metav1.ConditionFalse,
 //	    check.WithReason(check.ReasonResourceNotFound),
-//	    check.WithMessage("DataScienceCluster not found"),
+//	    check.WithCatalogMessage(messages.DSCNotFound),
 //	)
 //
 //	condition := check.NewCondition(
 //	    check.ConditionTypeCompatible,
 //	    metav1.ConditionFalse,
 //	    check.WithReason(check.ReasonVersionIncompatible),
-//	    check.WithMessage("Found %d resources using deprecated API version %s", count, apiVersion),
+//	    check.WithCatalogMessage(msgDeprecatedAPIVersion, count, apiVersion),
 //	    check.WithImpact(result.ImpactAdvisory),
-//	    check.WithRemediation("Migrate resources before upgrading"),
+//	    check.WithRemediation(c.CheckRemediation),
 //	)
 func NewCondition(
 	conditionType string,
@@ -194,6 +219,9 @@ const (
 
 	// ReasonConfigurationUnmanaged indicates a configuration is not managed by the operator.
 	ReasonConfigurationUnmanaged = "ConfigurationUnmanaged"
+
+	// ReasonFindingsReported indicates checks of a group reported findings.
+	ReasonFindingsReported = "FindingsReported"
 )
 
 // Standard Reason Values - Unknown/Error.
@@ -204,6 +232,12 @@ const (
 	// ReasonCheckSkipped indicates the check was skipped.
 	ReasonCheckSkipped = "CheckSkipped"
 
+	// ReasonPrerequisiteFailed indicates the check was skipped because a check it depends on failed.
+	ReasonPrerequisiteFailed = "PrerequisiteFailed"
+
+	// ReasonNotApplicable indicates the check was not run because it does not apply to the target.
+	ReasonNotApplicable = "NotApplicable"
+
 	// ReasonAPIAccessDenied indicates API access was denied.
 	ReasonAPIAccessDenied = "APIAccessDenied"
 
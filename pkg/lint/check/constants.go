@@ -1,5 +1,7 @@
 package check
 
+import "github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+
 // CheckType represents the type of check being performed.
 type CheckType string
 
@@ -13,6 +15,20 @@ const (
 	CheckTypeDataIntegrity               CheckType = "data-integrity"
 	CheckTypeWorkloadState               CheckType = "workload-state"
 	CheckTypeAcceleratorProfileMigration CheckType = "acceleratorprofile-migration"
+	CheckTypePermissions                 CheckType = "permissions"
+	CheckTypeResourceHeadroom            CheckType = "resource-headroom"
+	CheckTypeDisruptionRisk              CheckType = "disruption-risk"
+	CheckTypeCustomPolicy                CheckType = "custom-policy"
+)
+
+// Check tags, selected with "@<tag>" in --checks.
+const (
+	// TagFast marks checks reading only platform resources and cluster-scoped singletons rather
+	// than workloads across namespaces, so they stay quick on large clusters.
+	TagFast = "fast"
+
+	// TagBlockingOnly marks checks whose findings always block the upgrade, never advisory.
+	TagBlockingOnly = "blocking-only"
 )
 
 // Annotation keys used across multiple packages.
@@ -24,5 +40,16 @@ const (
 	AnnotationCheckTargetVersion = "check.opendatahub.io/target-version"
 
 	// AnnotationImpactedWorkloadCount is the count of impacted workloads.
-	AnnotationImpactedWorkloadCount = "workload.opendatahub.io/impacted-count"
+	AnnotationImpactedWorkloadCount = result.AnnotationImpactedCount
+
+	// AnnotationReferencedByCount is set on impacted objects to the number of workloads
+	// referencing them.
+	AnnotationReferencedByCount = "workload.opendatahub.io/referenced-by-count"
+)
+
+// Check IDs other checks declare as dependencies.
+const (
+	// CheckIDDSCReadiness is the ID of the DataScienceCluster readiness check, the prerequisite
+	// of the checks reading component state from the DataScienceCluster.
+	CheckIDDSCReadiness = "platform.dsc.readiness"
 )
@@ -0,0 +1,128 @@
+package check
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// DependencyDeclarer is an optional interface checks implement to declare the checks they depend
+// on, e.g. workload checks reading component state from the DataScienceCluster. When a
+// prerequisite evaluated earlier in the run errors or reports a blocking or prohibited finding,
+// the executor skips the dependent check with a PrerequisiteFailed condition instead of running
+// it against a state it would misreport.
+//
+// BaseCheck implements it from the CheckDependsOn field.
+type DependencyDeclarer interface {
+	Dependencies() []string
+}
+
+// dependenciesOf returns the prerequisites declared by the check, if any.
+func dependenciesOf(chk Check) []string {
+	if d, ok := chk.(DependencyDeclarer); ok {
+		return d.Dependencies()
+	}
+
+	return nil
+}
+
+// ValidateDependencies verifies that every declared prerequisite is registered, runs no later than
+// its dependent in CanonicalGroupOrder and is not part of a dependency cycle.
+func (r *CheckRegistry) ValidateDependencies() error {
+	checks := r.ListAll()
+	slices.SortFunc(checks, func(a, b Check) int { return strings.Compare(a.ID(), b.ID()) })
+
+	for _, chk := range checks {
+		for _, dep := range dependenciesOf(chk) {
+			prerequisite, ok := r.Get(dep)
+			if !ok {
+				return fmt.Errorf("check %s depends on unknown check %s", chk.ID(), dep)
+			}
+
+			if slices.Index(CanonicalGroupOrder, prerequisite.Group()) > slices.Index(CanonicalGroupOrder, chk.Group()) {
+				return fmt.Errorf("check %s depends on check %s, which runs in the later %s group",
+					chk.ID(), dep, prerequisite.Group())
+			}
+		}
+	}
+
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+
+	state := make(map[string]int, len(checks))
+
+	var visit func(id string, path []string) error
+
+	visit = func(id string, path []string) error {
+		switch state[id] {
+		case visiting:
+			return fmt.Errorf("dependency cycle: %s", strings.Join(append(path, id), " -> "))
+		case visited:
+			return nil
+		}
+
+		state[id] = visiting
+
+		chk, _ := r.Get(id)
+		for _, dep := range dependenciesOf(chk) {
+			if err := visit(dep, append(path, id)); err != nil {
+				return err
+			}
+		}
+
+		state[id] = visited
+
+		return nil
+	}
+
+	for _, chk := range checks {
+		if err := visit(chk.ID(), nil); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// orderByDependencies returns the checks sorted by ID, with each check moved after the
+// prerequisites among them so those run first. Prerequisites outside the slice are ignored, as
+// are cycles, which ValidateDependencies rejects at registration time.
+func orderByDependencies(checks []Check) []Check {
+	sorted := slices.Clone(checks)
+	slices.SortFunc(sorted, func(a, b Check) int { return strings.Compare(a.ID(), b.ID()) })
+
+	byID := make(map[string]Check, len(sorted))
+	for _, chk := range sorted {
+		byID[chk.ID()] = chk
+	}
+
+	ordered := make([]Check, 0, len(sorted))
+	seen := make(map[string]bool, len(sorted))
+
+	var visit func(chk Check)
+
+	visit = func(chk Check) {
+		if seen[chk.ID()] {
+			return
+		}
+
+		seen[chk.ID()] = true
+
+		for _, dep := range dependenciesOf(chk) {
+			if prerequisite, ok := byID[dep]; ok {
+				visit(prerequisite)
+			}
+		}
+
+		ordered = append(ordered, chk)
+	}
+
+	for _, chk := range sorted {
+		visit(chk)
+	}
+
+	return ordered
+}
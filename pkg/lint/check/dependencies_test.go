@@ -0,0 +1,200 @@
+package check_test
+
+import (
+	"errors"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/messages"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+
+	. "github.com/onsi/gomega"
+)
+
+func newDependentTestCheck(id string, group check.CheckGroup, deps ...string) *statsTestCheck {
+	chk := newStatsTestCheck(id)
+	chk.CheckGroup = group
+	chk.CheckDependsOn = deps
+
+	return chk
+}
+
+func TestValidateDependencies(t *testing.T) {
+	tests := []struct {
+		name    string
+		checks  []check.Check
+		wantErr string
+	}{
+		{
+			name: "valid",
+			checks: []check.Check{
+				newDependentTestCheck("platform.test.ready", check.GroupPlatform),
+				newDependentTestCheck("workloads.test.a", check.GroupWorkload, "platform.test.ready"),
+				newDependentTestCheck("workloads.test.b", check.GroupWorkload, "workloads.test.a"),
+			},
+		},
+		{
+			name: "unknown prerequisite",
+			checks: []check.Check{
+				newDependentTestCheck("workloads.test.a", check.GroupWorkload, "platform.test.missing"),
+			},
+			wantErr: "depends on unknown check platform.test.missing",
+		},
+		{
+			name: "prerequisite in a later group",
+			checks: []check.Check{
+				newDependentTestCheck("platform.test.ready", check.GroupPlatform, "workloads.test.a"),
+				newDependentTestCheck("workloads.test.a", check.GroupWorkload),
+			},
+			wantErr: "runs in the later workload group",
+		},
+		{
+			name: "cycle",
+			checks: []check.Check{
+				newDependentTestCheck("workloads.test.a", check.GroupWorkload, "workloads.test.b"),
+				newDependentTestCheck("workloads.test.b", check.GroupWorkload, "workloads.test.a"),
+			},
+			wantErr: "dependency cycle: workloads.test.a -> workloads.test.b -> workloads.test.a",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			registry := check.NewRegistry()
+			for _, chk := range tt.checks {
+				g.Expect(registry.Register(chk)).To(Succeed())
+			}
+
+			err := registry.ValidateDependencies()
+			if tt.wantErr == "" {
+				g.Expect(err).ToNot(HaveOccurred())
+			} else {
+				g.Expect(err).To(MatchError(ContainSubstring(tt.wantErr)))
+			}
+		})
+	}
+}
+
+func TestExecutorDependencies(t *testing.T) {
+	t.Run("should skip dependents of a failed prerequisite from an earlier group", func(t *testing.T) {
+		g := NewWithT(t)
+
+		prerequisite := newDependentTestCheck("platform.test.ready", check.GroupPlatform)
+		prerequisite.fails = true
+
+		registry := check.NewRegistry()
+		g.Expect(registry.Register(prerequisite)).To(Succeed())
+		g.Expect(registry.Register(newDependentTestCheck("workloads.test.dependent", check.GroupWorkload, "platform.test.ready"))).To(Succeed())
+		g.Expect(registry.Register(newDependentTestCheck("workloads.test.independent", check.GroupWorkload))).To(Succeed())
+
+		executor := check.NewExecutor(registry, nil)
+
+		_, err := executor.ExecuteSelective(t.Context(), check.Target{}, []string{"*"}, check.GroupPlatform)
+		g.Expect(err).ToNot(HaveOccurred())
+
+		results, err := executor.ExecuteSelective(t.Context(), check.Target{}, []string{"*"}, check.GroupWorkload)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(results).To(HaveLen(2))
+		g.Expect(executor.Stats()).To(Equal(check.ExecutionStats{Completed: 2, Skipped: 1}))
+
+		for _, exec := range results {
+			cond := exec.Result.Status.Conditions[0]
+
+			if exec.Check.ID() == "workloads.test.independent" {
+				g.Expect(cond.Status).To(Equal(metav1.ConditionTrue))
+
+				continue
+			}
+
+			g.Expect(exec.Error).ToNot(HaveOccurred())
+			g.Expect(cond.Status).To(Equal(metav1.ConditionUnknown))
+			g.Expect(cond.Reason).To(Equal(check.ReasonPrerequisiteFailed))
+			g.Expect(cond.Message).To(Equal("Skipped: prerequisite check platform.test.ready failed"))
+			g.Expect(cond.Impact).To(Equal(result.ImpactNone))
+			g.Expect(check.IsSkipped(exec.Result)).To(BeTrue())
+		}
+	})
+
+	t.Run("should run prerequisites first and skip transitively within a group", func(t *testing.T) {
+		g := NewWithT(t)
+
+		prerequisite := newDependentTestCheck("workloads.test.c", check.GroupWorkload)
+		prerequisite.validateErr = errors.New("timeout listing resources")
+
+		registry := check.NewRegistry()
+		g.Expect(registry.Register(newDependentTestCheck("workloads.test.a", check.GroupWorkload, "workloads.test.b"))).To(Succeed())
+		g.Expect(registry.Register(newDependentTestCheck("workloads.test.b", check.GroupWorkload, "workloads.test.c"))).To(Succeed())
+		g.Expect(registry.Register(prerequisite)).To(Succeed())
+
+		executor := check.NewExecutor(registry, nil)
+
+		results := executor.ExecuteAll(t.Context(), check.Target{})
+
+		ids := make([]string, 0, len(results))
+		for _, exec := range results {
+			ids = append(ids, exec.Check.ID())
+		}
+
+		g.Expect(ids).To(Equal([]string{"workloads.test.c", "workloads.test.b", "workloads.test.a"}))
+		g.Expect(results[1].Result.Status.Conditions[0].Message).To(ContainSubstring("workloads.test.c"))
+		g.Expect(results[2].Result.Status.Conditions[0].Message).To(ContainSubstring("workloads.test.b"))
+		g.Expect(executor.Stats()).To(Equal(check.ExecutionStats{Failed: 1, Skipped: 2}))
+	})
+
+	t.Run("should report the skip in the language of the target", func(t *testing.T) {
+		g := NewWithT(t)
+
+		prerequisite := newDependentTestCheck("workloads.test.b", check.GroupWorkload)
+		prerequisite.fails = true
+
+		registry := check.NewRegistry()
+		g.Expect(registry.Register(newDependentTestCheck("workloads.test.a", check.GroupWorkload, "workloads.test.b"))).To(Succeed())
+		g.Expect(registry.Register(prerequisite)).To(Succeed())
+
+		printer, err := messages.NewPrinter(messages.LangJapanese)
+		g.Expect(err).ToNot(HaveOccurred())
+
+		results := check.NewExecutor(registry, nil).ExecuteAll(t.Context(), check.Target{Messages: printer})
+		g.Expect(results).To(HaveLen(2))
+
+		cond := results[1].Result.Status.Conditions[0]
+		g.Expect(cond.MessageID).To(Equal(messages.CheckPrerequisiteFailed.ID))
+		g.Expect(cond.Message).To(Equal("スキップ: 前提条件のチェック workloads.test.b が失敗しました"))
+	})
+
+	t.Run("should run dependents of a prerequisite with advisory findings", func(t *testing.T) {
+		g := NewWithT(t)
+
+		prerequisite := newDependentTestCheck("platform.test.ready", check.GroupPlatform)
+		prerequisite.advises = true
+
+		registry := check.NewRegistry()
+		g.Expect(registry.Register(prerequisite)).To(Succeed())
+		g.Expect(registry.Register(newDependentTestCheck("workloads.test.dependent", check.GroupWorkload, "platform.test.ready"))).To(Succeed())
+
+		executor := check.NewExecutor(registry, nil)
+
+		results := executor.ExecuteAll(t.Context(), check.Target{})
+		g.Expect(results).To(HaveLen(2))
+		g.Expect(results[1].Result.Status.Conditions[0].Status).To(Equal(metav1.ConditionTrue))
+		g.Expect(executor.Stats()).To(Equal(check.ExecutionStats{Completed: 2}))
+	})
+
+	t.Run("should run dependents of a passing prerequisite", func(t *testing.T) {
+		g := NewWithT(t)
+
+		registry := check.NewRegistry()
+		g.Expect(registry.Register(newDependentTestCheck("platform.test.ready", check.GroupPlatform))).To(Succeed())
+		g.Expect(registry.Register(newDependentTestCheck("workloads.test.dependent", check.GroupWorkload, "platform.test.ready"))).To(Succeed())
+
+		executor := check.NewExecutor(registry, nil)
+
+		results := executor.ExecuteAll(t.Context(), check.Target{})
+		g.Expect(results).To(HaveLen(2))
+		g.Expect(executor.Stats()).To(Equal(check.ExecutionStats{Completed: 2}))
+	})
+}
@@ -2,13 +2,23 @@ package check
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"slices"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/messages"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
 	"github.com/opendatahub-io/odh-cli/pkg/util/iostreams"
+	"github.com/opendatahub-io/odh-cli/pkg/util/telemetry"
 )
 
 // CheckExecution bundles a check with its execution result and any error encountered.
@@ -16,12 +26,59 @@ type CheckExecution struct {
 	Check  Check
 	Result *result.DiagnosticResult
 	Error  error
+
+	// Applied reports whether the check was run against the target: false when it was
+	// excluded or its CanApply returned false.
+	Applied bool
+
+	// Excluded reports whether the check was not evaluated because it was excluded from
+	// the run (see Executor.Exclude).
+	Excluded bool
+
+	// Applicability is the outcome of the check's CanApply, with the reason the check does
+	// not apply when known. It is zero for excluded checks.
+	Applicability Applicability
+
+	// Started and Finished bound the evaluation of the check, CanApply included. They are
+	// zero for excluded checks.
+	Started  time.Time
+	Finished time.Time
+}
+
+var errCheckPanicked = errors.New("check panicked")
+
+// ExecutionStats counts how the checks of a run executed, independent of their findings.
+type ExecutionStats struct {
+	// Completed is the number of checks that ran and reported a result.
+	Completed int
+
+	// Failed is the number of checks whose CanApply or Validate returned an error.
+	Failed int
+
+	// NotRun is the number of checks left unevaluated because the context was canceled
+	// or timed out, so the results of the run are partial.
+	NotRun int
+
+	// Skipped is the number of checks not run because a check they depend on failed.
+	Skipped int
 }
 
 // Executor orchestrates check execution.
 type Executor struct {
-	registry *CheckRegistry
-	io       iostreams.Interface
+	registry  *CheckRegistry
+	io        iostreams.Interface
+	excluded  map[string]struct{}
+	overrides map[string]result.Impact
+	stats     ExecutionStats
+
+	// failed holds the IDs of the checks that failed or were skipped in earlier executions,
+	// so their dependents in later groups are skipped as well.
+	failed map[string]struct{}
+
+	checkTimeout         time.Duration
+	retry                client.RetryPolicy
+	onEvaluated          func(CheckExecution)
+	includeNotApplicable bool
 }
 
 // NewExecutor creates a new check executor.
@@ -29,7 +86,59 @@ func NewExecutor(registry *CheckRegistry, io iostreams.Interface) *Executor {
 	return &Executor{
 		registry: registry,
 		io:       io,
+		retry:    client.DefaultRetryPolicy(),
+	}
+}
+
+// SetCheckTimeout limits the time each check may take, so a single slow check cannot
+// consume the whole command timeout. A check exceeding it is reported as failed and the
+// run continues. Zero disables the limit.
+func (e *Executor) SetCheckTimeout(timeout time.Duration) {
+	e.checkTimeout = timeout
+}
+
+// SetIncludeNotApplicable makes later executions also return a result for the checks whose
+// CanApply returned false, reporting they were skipped (see IsNotApplicable), so users can
+// confirm which checks were evaluated. These results pass and carry no impact.
+func (e *Executor) SetIncludeNotApplicable(include bool) {
+	e.includeNotApplicable = include
+}
+
+// SetProgressFunc registers a function called after each selected check is evaluated,
+// whether it ran, failed, was excluded or did not apply, e.g. to report progress. The
+// execution's Result is nil for checks that were excluded or did not apply, which its
+// Excluded and Applied fields tell apart.
+func (e *Executor) SetProgressFunc(fn func(exec CheckExecution)) {
+	e.onEvaluated = fn
+}
+
+// SetRetryPolicy sets how the API reads of checks failing with transient errors (429, 5xx)
+// are retried. Executors retry with client.DefaultRetryPolicy unless set otherwise.
+func (e *Executor) SetRetryPolicy(policy client.RetryPolicy) {
+	e.retry = policy
+}
+
+// Exclude skips the checks with the given IDs in later executions, e.g. because the
+// current user lacks the permissions they need.
+func (e *Executor) Exclude(ids ...string) {
+	if e.excluded == nil {
+		e.excluded = make(map[string]struct{}, len(ids))
 	}
+
+	for _, id := range ids {
+		e.excluded[id] = struct{}{}
+	}
+}
+
+// SetSeverityOverrides rewrites the impact of the findings reported by the checks with the
+// given IDs in later executions.
+func (e *Executor) SetSeverityOverrides(overrides map[string]result.Impact) {
+	e.overrides = overrides
+}
+
+// Stats returns the execution counts accumulated over all executions of the executor.
+func (e *Executor) Stats() ExecutionStats {
+	return e.stats
 }
 
 // ExecuteAll runs all checks in the registry against the target
@@ -57,33 +166,65 @@ func (e *Executor) ExecuteSelective(
 	return e.executeChecks(ctx, target, checks), nil
 }
 
-// executeChecks runs the provided checks against the target sequentially.
+// executeChecks runs the provided checks against the target sequentially, each after the
+// prerequisites it declares among them.
 func (e *Executor) executeChecks(ctx context.Context, target Target, checks []Check) []CheckExecution {
+	checks = orderByDependencies(checks)
 	results := make([]CheckExecution, 0, len(checks))
 
-	for _, check := range checks {
+	if target.Client != nil && e.retry.Attempts > 1 {
+		target.Client = client.NewRetryingReader(target.Client, e.retry)
+	}
+
+	for i, check := range checks {
 		// Check context before executing each check
 		if err := CheckContextError(ctx); err != nil {
 			// Context canceled or timed out - stop executing checks
+			e.stats.NotRun += len(checks) - i
+
 			break
 		}
 
-		// Filter by CanApply before executing
-		// Checks can use target.CurrentVersion, target.TargetVersion, or target.Client for filtering
-		canApply, err := check.CanApply(ctx, target)
-		if err != nil {
-			results = append(results, e.buildCanApplyError(check, err))
+		if _, ok := e.excluded[check.ID()]; ok {
+			e.reportEvaluated(CheckExecution{Check: check, Excluded: true})
 
 			continue
 		}
 
-		if !canApply {
+		started := time.Now()
+		exec, ok := e.runCheck(ctx, target, check)
+
+		exec.Check = check
+		exec.Applied = ok
+		exec.Started = started
+		exec.Finished = time.Now()
+
+		if !ok {
+			if e.includeNotApplicable {
+				exec.Result = buildNotApplicable(check, exec.Applicability)
+				localize(exec.Result, check, target.Messages)
+				results = append(results, exec)
+			}
+
+			e.reportEvaluated(exec)
+
 			continue
 		}
 
-		// Execute check sequentially
-		exec := e.executeCheck(ctx, target, check)
+		e.reportEvaluated(exec)
+		e.recordOutcome(exec)
+
+		switch {
+		case IsSkipped(exec.Result):
+			e.stats.Skipped++
+		case exec.Error != nil:
+			e.stats.Failed++
+		case exec.Result != nil:
+			e.stats.Completed++
+		}
+
 		if exec.Result != nil {
+			localize(exec.Result, check, target.Messages)
 			results = append(results, exec)
 		}
 	}
@@ -91,6 +232,182 @@ func (e *Executor) executeChecks(ctx context.Context, target Target, checks []Ch
 	return results
 }
 
+// localize translates the catalog messages and remediations of the result to the language of
+// the printer. A condition carrying the remediation of its check gets the message ID
+// <check ID>.remediation.
+func localize(dr *result.DiagnosticResult, check Check, p messages.Printer) {
+	var remediation string
+	if provider, ok := check.(RemediationProvider); ok {
+		remediation = provider.Remediation()
+	}
+
+	for i := range dr.Status.Conditions {
+		cond := &dr.Status.Conditions[i]
+
+		if cond.RemediationID == "" && remediation != "" && cond.Remediation == remediation {
+			cond.RemediationID = messages.RemediationID(check.ID())
+		}
+	}
+
+	Localize(dr, p)
+}
+
+// Localize translates the messages and remediations of the conditions of the result that carry
+// a message ID to the language of the printer. Texts changed since they were formatted from the
+// catalog, e.g. extended by the check, are left as they are.
+func Localize(dr *result.DiagnosticResult, p messages.Printer) {
+	for i := range dr.Status.Conditions {
+		cond := &dr.Status.Conditions[i]
+
+		if text, ok := translate(p, cond.MessageID, cond.Message, cond.MessageArgs); ok {
+			cond.Message = text
+		}
+
+		if text, ok := translate(p, cond.RemediationID, cond.Remediation, cond.RemediationArgs); ok {
+			cond.Remediation = text
+		}
+	}
+}
+
+// translate returns the translation of the catalog message with the given ID, provided text is
+// still the English message formatted with args.
+func translate(p messages.Printer, id string, text string, args []any) (string, bool) {
+	if id == "" {
+		return "", false
+	}
+
+	if m, ok := messages.Lookup(id); ok && m.Sprintf(args...) != text {
+		return "", false
+	}
+
+	return p.Translate(id, args...)
+}
+
+// recordOutcome remembers checks that errored, reported a blocking or prohibited finding or were
+// skipped, so the checks depending on them are skipped. Advisory findings do not make the state
+// a dependent reads unreliable, so they do not block it.
+func (e *Executor) recordOutcome(exec CheckExecution) {
+	if exec.Error == nil && !blocksDependents(exec.Result) {
+		return
+	}
+
+	if e.failed == nil {
+		e.failed = make(map[string]struct{})
+	}
+
+	e.failed[exec.Check.ID()] = struct{}{}
+}
+
+// blocksDependents returns true when the result reports a blocking or prohibited finding, or
+// that the check was itself skipped.
+func blocksDependents(dr *result.DiagnosticResult) bool {
+	if dr == nil {
+		return false
+	}
+
+	switch dr.GetImpact() {
+	case result.ImpactProhibited, result.ImpactBlocking:
+		return true
+	case result.ImpactAdvisory, result.ImpactNone:
+	}
+
+	return IsSkipped(dr)
+}
+
+// failedPrerequisite returns the first prerequisite of the check that failed earlier in the run.
+// Prerequisites that were not selected, excluded or did not apply do not block the check.
+func (e *Executor) failedPrerequisite(check Check) (string, bool) {
+	for _, dep := range dependenciesOf(check) {
+		if _, ok := e.failed[dep]; ok {
+			return dep, true
+		}
+	}
+
+	return "", false
+}
+
+func (e *Executor) reportEvaluated(exec CheckExecution) {
+	if e.onEvaluated != nil {
+		e.onEvaluated(exec)
+	}
+}
+
+// runCheck filters a check by CanApply and executes it, within an OpenTelemetry span carrying
+// its outcome. It returns false when the check does not apply to the target.
+func (e *Executor) runCheck(ctx context.Context, target Target, check Check) (CheckExecution, bool) {
+	target.Logger = target.Log().With("check", check.ID())
+
+	ctx, span := telemetry.Tracer().Start(ctx, "check "+check.ID(), trace.WithAttributes(
+		attribute.String("check.id", check.ID()),
+		attribute.String("check.group", string(check.Group())),
+		attribute.String("check.kind", check.CheckKind()),
+		attribute.String("check.type", check.CheckType()),
+	))
+	defer span.End()
+
+	exec, ok := e.evaluateCheck(ctx, target, check)
+	endCheckSpan(span, exec, ok)
+
+	return exec, ok
+}
+
+// endCheckSpan adds the outcome of a check to its span.
+func endCheckSpan(span trace.Span, exec CheckExecution, applied bool) {
+	span.SetAttributes(attribute.Bool("check.applied", applied))
+
+	if exec.Result != nil {
+		span.SetAttributes(attribute.String("check.impact", string(exec.Result.GetImpact())))
+	}
+
+	if exec.Error != nil {
+		span.RecordError(exec.Error)
+		span.SetStatus(codes.Error, exec.Error.Error())
+	}
+}
+
+// evaluateCheck executes a check that applies to the target, within the per-check timeout
+// when one is set.
+func (e *Executor) evaluateCheck(ctx context.Context, target Target, check Check) (CheckExecution, bool) {
+
+	if e.checkTimeout > 0 {
+		var cancel context.CancelFunc
+
+		ctx, cancel = context.WithTimeout(ctx, e.checkTimeout)
+		defer cancel()
+	}
+
+	// Filter by CanApply before executing
+	// Checks can use target.CurrentVersion, target.TargetVersion, or target.Client for filtering
+	applicability, err := canApplyCheck(ctx, target, check)
+	if err == nil && !applicability.Applies {
+		target.Log().DebugContext(ctx, "check does not apply",
+			"reason", string(applicability.Reason), "message", applicability.Message)
+
+		return CheckExecution{Applicability: applicability}, false
+	}
+
+	// A failed prerequisite also explains CanApply errors, e.g. an unreadable DataScienceCluster
+	if prerequisite, ok := e.failedPrerequisite(check); ok {
+		return buildSkipped(check, prerequisite), true
+	}
+
+	if err != nil {
+		return e.buildCanApplyError(check, e.checkTimeoutError(ctx, err)), true
+	}
+
+	// Execute check sequentially
+	return e.executeCheck(ctx, target, check), true
+}
+
+// checkTimeoutError adds the per-check timeout to errors caused by it.
+func (e *Executor) checkTimeoutError(ctx context.Context, err error) error {
+	if e.checkTimeout > 0 && errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return fmt.Errorf("check exceeded --check-timeout of %s: %w", e.checkTimeout, err)
+	}
+
+	return err
+}
+
 // buildCanApplyError creates a CheckExecution for a CanApply error.
 func (e *Executor) buildCanApplyError(check Check, err error) CheckExecution {
 	errorResult := result.New(
@@ -105,7 +422,7 @@ func (e *Executor) buildCanApplyError(check Check, err error) CheckExecution {
 			ConditionTypeValidated,
 			metav1.ConditionUnknown,
 			WithReason(ReasonCheckExecutionFailed),
-			WithMessage("Check applicability failed: %v", err),
+			WithCatalogMessage(messages.CheckApplicabilityFailed, err),
 		),
 	}
 
@@ -116,6 +433,88 @@ func (e *Executor) buildCanApplyError(check Check, err error) CheckExecution {
 	}
 }
 
+// buildSkipped creates a CheckExecution for a check skipped because the given prerequisite failed.
+// The check was not evaluated, so its condition carries no impact: the prerequisite already
+// reports the finding, and the skip does not count towards summaries, thresholds or the verdict.
+func buildSkipped(check Check, prerequisite string) CheckExecution {
+	skipped := result.New(
+		string(check.Group()),
+		check.CheckKind(),
+		check.CheckType(),
+		check.Description(),
+	)
+
+	cond := NewCondition(
+		ConditionTypeValidated,
+		metav1.ConditionUnknown,
+		WithReason(ReasonPrerequisiteFailed),
+		WithCatalogMessage(messages.CheckPrerequisiteFailed, prerequisite),
+		WithCatalogRemediation(messages.CheckPrerequisiteFailedRemedy, prerequisite),
+	)
+	cond.Impact = result.ImpactNone
+
+	skipped.Status.Conditions = []result.Condition{cond}
+
+	return CheckExecution{
+		Check:  check,
+		Result: skipped,
+	}
+}
+
+// buildNotApplicable creates the result of a check that was not run because its CanApply
+// returned false, recording the reason in AnnotationNotApplicableReason when known.
+func buildNotApplicable(check Check, applicability Applicability) *result.DiagnosticResult {
+	notApplicable := result.New(
+		string(check.Group()),
+		check.CheckKind(),
+		check.CheckType(),
+		check.Description(),
+	)
+
+	message := WithCatalogMessage(messages.CheckNotApplicable)
+	if applicability.Message != "" {
+		message = WithCatalogMessage(messages.CheckNotApplicableReason, applicability.Message)
+	}
+
+	notApplicable.Status.Conditions = []result.Condition{
+		NewCondition(
+			ConditionTypeValidated,
+			metav1.ConditionTrue,
+			WithReason(ReasonNotApplicable),
+			message,
+		),
+	}
+
+	if applicability.Reason != "" {
+		notApplicable.Annotations[AnnotationNotApplicableReason] = string(applicability.Reason)
+	}
+
+	return notApplicable
+}
+
+// IsNotApplicable returns true when the result reports the check was not run because it does
+// not apply to the target (see Executor.SetIncludeNotApplicable).
+func IsNotApplicable(dr *result.DiagnosticResult) bool {
+	if dr == nil {
+		return false
+	}
+
+	return slices.ContainsFunc(dr.Status.Conditions, func(c result.Condition) bool {
+		return c.Reason == ReasonNotApplicable
+	})
+}
+
+// IsSkipped returns true when the result reports the check was skipped for a failed prerequisite.
+func IsSkipped(dr *result.DiagnosticResult) bool {
+	if dr == nil {
+		return false
+	}
+
+	return slices.ContainsFunc(dr.Status.Conditions, func(c result.Condition) bool {
+		return c.Reason == ReasonPrerequisiteFailed
+	})
+}
+
 // executeCheck runs a single check and captures the result or error.
 func (e *Executor) executeCheck(ctx context.Context, target Target, check Check) CheckExecution {
 	// Ensure target has IOStreams for permission error logging
@@ -123,7 +522,7 @@ func (e *Executor) executeCheck(ctx context.Context, target Target, check Check)
 		target.IO = e.io
 	}
 
-	checkResult, err := check.Validate(ctx, target)
+	checkResult, err := validateCheck(ctx, target, check)
 
 	// Nil result signals the check should be silently skipped.
 	if err == nil && checkResult == nil {
@@ -132,7 +531,7 @@ func (e *Executor) executeCheck(ctx context.Context, target Target, check Check)
 
 	// If check returned an error, create a diagnostic result with error condition
 	if err != nil {
-		return e.buildValidateError(check, err)
+		return e.buildValidateError(check, e.checkTimeoutError(ctx, err))
 	}
 
 	// Validate the result
@@ -148,7 +547,7 @@ func (e *Executor) executeCheck(ctx context.Context, target Target, check Check)
 				ConditionTypeValidated,
 				metav1.ConditionUnknown,
 				WithReason(ReasonCheckExecutionFailed),
-				WithMessage("Invalid check result: %v", err),
+				WithCatalogMessage(messages.CheckInvalidResult, err),
 			),
 		}
 
@@ -159,6 +558,10 @@ func (e *Executor) executeCheck(ctx context.Context, target Target, check Check)
 		}
 	}
 
+	if impact, ok := e.overrides[check.ID()]; ok {
+		ApplySeverityOverride(checkResult, impact)
+	}
+
 	return CheckExecution{
 		Check:  check,
 		Result: checkResult,
@@ -166,36 +569,82 @@ func (e *Executor) executeCheck(ctx context.Context, target Target, check Check)
 	}
 }
 
+// canApplyCheck evaluates whether the check applies to the target, with the reason when it does
+// not, converting a panic into an error so a single faulty check cannot abort the whole run.
+func canApplyCheck(ctx context.Context, target Target, check Check) (applicability Applicability, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			applicability, err = Applicability{}, fmt.Errorf("%w: %v", errCheckPanicked, r)
+		}
+	}()
+
+	return evaluateApplicability(ctx, target, check)
+}
+
+// validateCheck runs the check's Validate, converting a panic into an error so a single
+// faulty check cannot abort the whole run.
+func validateCheck(ctx context.Context, target Target, check Check) (dr *result.DiagnosticResult, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			dr, err = nil, fmt.Errorf("%w: %v", errCheckPanicked, r)
+		}
+	}()
+
+	return check.Validate(ctx, target)
+}
+
+// ApplySeverityOverride rewrites the impact of every finding of the result. Passing conditions
+// (no impact) are left untouched. When a finding changes, the impact the check originally
+// reported is recorded in the AnnotationOriginalImpact annotation.
+func ApplySeverityOverride(dr *result.DiagnosticResult, impact result.Impact) {
+	original := dr.GetImpact()
+	changed := false
+
+	for i := range dr.Status.Conditions {
+		cond := &dr.Status.Conditions[i]
+		if cond.Impact != result.ImpactNone && cond.Impact != impact {
+			cond.Impact = impact
+			changed = true
+		}
+	}
+
+	if !changed {
+		return
+	}
+
+	if dr.Annotations == nil {
+		dr.Annotations = make(map[string]string)
+	}
+
+	dr.Annotations[result.AnnotationOriginalImpact] = string(original)
+}
+
 // buildValidateError creates a CheckExecution for a Validate error,
 // classifying the error into an appropriate reason and message.
 func (e *Executor) buildValidateError(check Check, err error) CheckExecution {
-	var message string
-	var reason string
+	reason := ReasonCheckExecutionFailed
+	message := messages.CheckExecutionFailed
 
 	// Handle specific error types
 	switch {
 	case apierrors.IsForbidden(err):
 		reason = ReasonAPIAccessDenied
-		message = "Insufficient permissions to access cluster resources"
+		message = messages.CheckAccessDenied
 
 		if e.io != nil {
-			e.io.Errorf("Permission denied: %s - Check: %s", message, check.Name())
+			e.io.Errorf("Permission denied: %s - Check: %s", message.Sprintf(err), check.Name())
 		}
 	case apierrors.IsUnauthorized(err):
 		reason = ReasonAPIAccessDenied
-		message = "Authentication required to access cluster resources"
+		message = messages.CheckUnauthorized
 
 		if e.io != nil {
-			e.io.Errorf("Unauthorized: %s - Check: %s", message, check.Name())
+			e.io.Errorf("Unauthorized: %s - Check: %s", message.Sprintf(err), check.Name())
 		}
 	case apierrors.IsTimeout(err):
-		reason = ReasonCheckExecutionFailed
-		message = "Request timed out"
+		message = messages.CheckTimedOut
 	case apierrors.IsServiceUnavailable(err) || apierrors.IsServerTimeout(err):
-		reason = ReasonCheckExecutionFailed
-		message = "API server is unavailable or overloaded"
-	default:
-		reason = ReasonCheckExecutionFailed
+		message = messages.CheckServerUnavailable
 	}
 
 	errorResult := result.New(
@@ -205,22 +654,12 @@ func (e *Executor) buildValidateError(check Check, err error) CheckExecution {
 		check.Description(),
 	)
 
-	var condition result.Condition
-	if reason == ReasonCheckExecutionFailed && message == "" {
-		condition = NewCondition(
-			ConditionTypeValidated,
-			metav1.ConditionUnknown,
-			WithReason(reason),
-			WithMessage("Check execution failed: %v", err),
-		)
-	} else {
-		condition = NewCondition(
-			ConditionTypeValidated,
-			metav1.ConditionUnknown,
-			WithReason(reason),
-			WithMessage("%s: %v", message, err),
-		)
-	}
+	condition := NewCondition(
+		ConditionTypeValidated,
+		metav1.ConditionUnknown,
+		WithReason(reason),
+		WithCatalogMessage(message, err),
+	)
 
 	errorResult.Status.Conditions = []result.Condition{condition}
 
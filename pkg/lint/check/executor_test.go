@@ -0,0 +1,504 @@
+package check_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/messages"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/util/logging"
+
+	. "github.com/onsi/gomega"
+)
+
+type statsTestCheck struct {
+	check.BaseCheck
+
+	canApplyErr   error
+	validateErr   error
+	notApplicable bool
+	panics        bool
+	blocks        bool
+	fails         bool
+	advises       bool
+}
+
+func (c *statsTestCheck) CanApply(_ context.Context, _ check.Target) (bool, error) {
+	return c.canApplyErr == nil && !c.notApplicable, c.canApplyErr
+}
+
+func (c *statsTestCheck) Validate(ctx context.Context, _ check.Target) (*result.DiagnosticResult, error) {
+	if c.panics {
+		panic("unexpected nil object")
+	}
+
+	if c.blocks {
+		<-ctx.Done()
+
+		return nil, ctx.Err()
+	}
+
+	if c.validateErr != nil {
+		return nil, c.validateErr
+	}
+
+	dr := c.NewResult()
+
+	if c.fails {
+		dr.SetCondition(check.NewCondition(check.ConditionTypeValidated, metav1.ConditionFalse,
+			check.WithReason("Test"), check.WithImpact(result.ImpactBlocking)))
+
+		return dr, nil
+	}
+
+	if c.advises {
+		dr.SetCondition(check.NewCondition(check.ConditionTypeValidated, metav1.ConditionFalse,
+			check.WithReason("Test"), check.WithImpact(result.ImpactAdvisory)))
+
+		return dr, nil
+	}
+
+	dr.SetCondition(check.NewCondition(check.ConditionTypeValidated, metav1.ConditionTrue, check.WithReason("Test")))
+
+	return dr, nil
+}
+
+func newStatsTestCheck(id string) *statsTestCheck {
+	return &statsTestCheck{BaseCheck: check.BaseCheck{
+		CheckGroup:    check.GroupWorkload,
+		Kind:          "test",
+		Type:          check.CheckTypeRemoval,
+		CheckID:       id,
+		CheckName:     id,
+		CheckVersions: check.VersionsAny,
+	}}
+}
+
+func TestExecutorStats(t *testing.T) {
+	newRegistry := func(g *WithT) *check.CheckRegistry {
+		registry := check.NewRegistry()
+
+		canApplyFailing := newStatsTestCheck("workloads.test.can-apply-failing")
+		canApplyFailing.canApplyErr = errors.New("crd lookup failed")
+
+		validateFailing := newStatsTestCheck("workloads.test.validate-failing")
+		validateFailing.validateErr = errors.New("timeout listing resources")
+
+		for _, chk := range []check.Check{newStatsTestCheck("workloads.test.passing"), canApplyFailing, validateFailing} {
+			g.Expect(registry.Register(chk)).To(Succeed())
+		}
+
+		return registry
+	}
+
+	t.Run("should count completed and failed checks", func(t *testing.T) {
+		g := NewWithT(t)
+
+		executor := check.NewExecutor(newRegistry(g), nil)
+
+		results, err := executor.ExecuteSelective(t.Context(), check.Target{}, []string{"*"}, check.GroupWorkload)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(results).To(HaveLen(3))
+		g.Expect(executor.Stats()).To(Equal(check.ExecutionStats{Completed: 1, Failed: 2}))
+	})
+
+	t.Run("should record a panicking check as failed and continue", func(t *testing.T) {
+		g := NewWithT(t)
+
+		panicking := newStatsTestCheck("workloads.test.panicking")
+		panicking.panics = true
+
+		registry := check.NewRegistry()
+		g.Expect(registry.Register(panicking)).To(Succeed())
+		g.Expect(registry.Register(newStatsTestCheck("workloads.test.passing"))).To(Succeed())
+
+		executor := check.NewExecutor(registry, nil)
+
+		results, err := executor.ExecuteSelective(t.Context(), check.Target{}, []string{"*"}, check.GroupWorkload)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(results).To(HaveLen(2))
+		g.Expect(executor.Stats()).To(Equal(check.ExecutionStats{Completed: 1, Failed: 1}))
+
+		for _, exec := range results {
+			if exec.Check.ID() != "workloads.test.panicking" {
+				continue
+			}
+
+			g.Expect(exec.Error).To(MatchError(ContainSubstring("check panicked: unexpected nil object")))
+			g.Expect(exec.Result.Status.Conditions).To(HaveLen(1))
+			g.Expect(exec.Result.Status.Conditions[0].Status).To(Equal(metav1.ConditionUnknown))
+			g.Expect(exec.Result.Status.Conditions[0].Reason).To(Equal(check.ReasonCheckExecutionFailed))
+		}
+	})
+
+	t.Run("should fail a check exceeding the check timeout and continue", func(t *testing.T) {
+		g := NewWithT(t)
+
+		slow := newStatsTestCheck("workloads.test.slow")
+		slow.blocks = true
+
+		registry := check.NewRegistry()
+		g.Expect(registry.Register(slow)).To(Succeed())
+		g.Expect(registry.Register(newStatsTestCheck("workloads.test.passing"))).To(Succeed())
+
+		executor := check.NewExecutor(registry, nil)
+		executor.SetCheckTimeout(10 * time.Millisecond)
+
+		results, err := executor.ExecuteSelective(t.Context(), check.Target{}, []string{"*"}, check.GroupWorkload)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(results).To(HaveLen(2))
+		g.Expect(executor.Stats()).To(Equal(check.ExecutionStats{Completed: 1, Failed: 1}))
+
+		for _, exec := range results {
+			if exec.Check.ID() == "workloads.test.slow" {
+				g.Expect(exec.Error).To(MatchError(ContainSubstring("exceeded --check-timeout of 10ms")))
+				g.Expect(exec.Result.Status.Conditions[0].Message).To(ContainSubstring("exceeded --check-timeout"))
+			}
+		}
+	})
+
+	t.Run("should report every evaluated check to the progress func", func(t *testing.T) {
+		g := NewWithT(t)
+
+		executor := check.NewExecutor(newRegistry(g), nil)
+		executor.Exclude("workloads.test.passing")
+
+		var evaluated []string
+		executor.SetProgressFunc(func(exec check.CheckExecution) {
+			evaluated = append(evaluated, exec.Check.ID())
+		})
+
+		_, err := executor.ExecuteSelective(t.Context(), check.Target{}, []string{"*"}, check.GroupWorkload)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(evaluated).To(ConsistOf(
+			"workloads.test.passing",
+			"workloads.test.can-apply-failing",
+			"workloads.test.validate-failing",
+		))
+	})
+
+	t.Run("should count checks not run after the context is done", func(t *testing.T) {
+		g := NewWithT(t)
+
+		ctx, cancel := context.WithCancel(t.Context())
+		cancel()
+
+		executor := check.NewExecutor(newRegistry(g), nil)
+
+		results, err := executor.ExecuteSelective(ctx, check.Target{}, []string{"*"}, check.GroupWorkload)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(results).To(BeEmpty())
+		g.Expect(executor.Stats()).To(Equal(check.ExecutionStats{NotRun: 3}))
+	})
+}
+
+func newOverrideTestResult(impacts ...result.Impact) *result.DiagnosticResult {
+	dr := result.New("workload", "notebook", "impacted-workloads", "test")
+
+	for i, impact := range impacts {
+		status := metav1.ConditionFalse
+		if impact == result.ImpactNone {
+			status = metav1.ConditionTrue
+		}
+
+		dr.Status.Conditions = append(dr.Status.Conditions, check.NewCondition(
+			"Condition"+string(rune('A'+i)),
+			status,
+			check.WithReason("Test"),
+			check.WithImpact(impact),
+		))
+	}
+
+	return dr
+}
+
+func TestExecutorIncludeNotApplicable(t *testing.T) {
+	newRegistry := func(g *WithT) *check.CheckRegistry {
+		notApplicable := newStatsTestCheck("workloads.test.not-applicable")
+		notApplicable.notApplicable = true
+
+		registry := check.NewRegistry()
+		g.Expect(registry.Register(notApplicable)).To(Succeed())
+		g.Expect(registry.Register(newStatsTestCheck("workloads.test.passing"))).To(Succeed())
+
+		return registry
+	}
+
+	t.Run("should omit checks that do not apply by default", func(t *testing.T) {
+		g := NewWithT(t)
+
+		executor := check.NewExecutor(newRegistry(g), nil)
+
+		var evaluated []check.CheckExecution
+		executor.SetProgressFunc(func(exec check.CheckExecution) { evaluated = append(evaluated, exec) })
+
+		results, err := executor.ExecuteSelective(t.Context(), check.Target{}, []string{"*"}, check.GroupWorkload)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(results).To(HaveLen(1))
+		g.Expect(results[0].Applied).To(BeTrue())
+		g.Expect(results[0].Finished.Before(results[0].Started)).To(BeFalse())
+
+		g.Expect(evaluated).To(HaveLen(2))
+		for _, exec := range evaluated {
+			g.Expect(exec.Applied).To(Equal(exec.Check.ID() == "workloads.test.passing"))
+		}
+	})
+
+	t.Run("should report checks that do not apply as skipped", func(t *testing.T) {
+		g := NewWithT(t)
+
+		executor := check.NewExecutor(newRegistry(g), nil)
+		executor.SetIncludeNotApplicable(true)
+
+		results, err := executor.ExecuteSelective(t.Context(), check.Target{}, []string{"*"}, check.GroupWorkload)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(results).To(HaveLen(2))
+		g.Expect(executor.Stats()).To(Equal(check.ExecutionStats{Completed: 1}))
+
+		for _, exec := range results {
+			if exec.Check.ID() != "workloads.test.not-applicable" {
+				continue
+			}
+
+			g.Expect(exec.Applied).To(BeFalse())
+			g.Expect(check.IsNotApplicable(exec.Result)).To(BeTrue())
+			g.Expect(exec.Result.IsFailing()).To(BeFalse())
+			g.Expect(exec.Result.GetImpact()).To(Equal(result.ImpactNone))
+		}
+	})
+}
+
+func TestApplySeverityOverride(t *testing.T) {
+	t.Run("should rewrite findings and record the original impact", func(t *testing.T) {
+		g := NewWithT(t)
+
+		dr := newOverrideTestResult(result.ImpactBlocking, result.ImpactAdvisory, result.ImpactNone)
+
+		check.ApplySeverityOverride(dr, result.ImpactAdvisory)
+
+		g.Expect(dr.Status.Conditions[0].Impact).To(Equal(result.ImpactAdvisory))
+		g.Expect(dr.Status.Conditions[1].Impact).To(Equal(result.ImpactAdvisory))
+		g.Expect(dr.Status.Conditions[2].Impact).To(Equal(result.ImpactNone))
+		g.Expect(dr.Annotations).To(HaveKeyWithValue(result.AnnotationOriginalImpact, string(result.ImpactBlocking)))
+	})
+
+	t.Run("should not annotate passing results", func(t *testing.T) {
+		g := NewWithT(t)
+
+		dr := newOverrideTestResult(result.ImpactNone)
+
+		check.ApplySeverityOverride(dr, result.ImpactBlocking)
+
+		g.Expect(dr.Status.Conditions[0].Impact).To(Equal(result.ImpactNone))
+		g.Expect(dr.Annotations).ToNot(HaveKey(result.AnnotationOriginalImpact))
+	})
+
+	t.Run("should not annotate results already at the overridden impact", func(t *testing.T) {
+		g := NewWithT(t)
+
+		dr := newOverrideTestResult(result.ImpactAdvisory)
+
+		check.ApplySeverityOverride(dr, result.ImpactAdvisory)
+
+		g.Expect(dr.Annotations).ToNot(HaveKey(result.AnnotationOriginalImpact))
+	})
+}
+
+// loggingTestCheck emits a diagnostic through the target logger.
+type loggingTestCheck struct {
+	*statsTestCheck
+}
+
+func (c *loggingTestCheck) Validate(ctx context.Context, target check.Target) (*result.DiagnosticResult, error) {
+	target.Log().DebugContext(ctx, "listing resources", "count", 3)
+
+	return c.statsTestCheck.Validate(ctx, target)
+}
+
+func TestExecutorLogger(t *testing.T) {
+	t.Run("should add the check ID to the records of checks", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var buf bytes.Buffer
+
+		logger, err := logging.New(&buf, logging.LevelDebug, logging.FormatJSON)
+		g.Expect(err).ToNot(HaveOccurred())
+
+		registry := check.NewRegistry()
+		g.Expect(registry.Register(&loggingTestCheck{newStatsTestCheck("workloads.test.logging")})).To(Succeed())
+
+		executor := check.NewExecutor(registry, nil)
+
+		_, err = executor.ExecuteSelective(t.Context(), check.Target{Logger: logger}, []string{"*"}, check.GroupWorkload)
+		g.Expect(err).ToNot(HaveOccurred())
+
+		var record map[string]any
+		g.Expect(json.Unmarshal(buf.Bytes(), &record)).To(Succeed())
+		g.Expect(record).To(HaveKeyWithValue("msg", "listing resources"))
+		g.Expect(record).To(HaveKeyWithValue("check", "workloads.test.logging"))
+		g.Expect(record).To(HaveKeyWithValue("count", BeNumerically("==", 3)))
+	})
+
+	t.Run("should drop records without a logger", func(t *testing.T) {
+		g := NewWithT(t)
+
+		registry := check.NewRegistry()
+		g.Expect(registry.Register(&loggingTestCheck{newStatsTestCheck("workloads.test.logging")})).To(Succeed())
+
+		executor := check.NewExecutor(registry, nil)
+
+		results, err := executor.ExecuteSelective(t.Context(), check.Target{}, []string{"*"}, check.GroupWorkload)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(results).To(HaveLen(1))
+	})
+}
+
+func TestExecutorSpans(t *testing.T) {
+	g := NewWithT(t)
+
+	recorder := tracetest.NewSpanRecorder()
+
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	t.Cleanup(func() { otel.SetTracerProvider(previous) })
+
+	blocking := newStatsTestCheck("workloads.test.blocking")
+	blocking.fails = true
+
+	failing := newStatsTestCheck("workloads.test.failing")
+	failing.validateErr = errors.New("boom")
+
+	registry := check.NewRegistry()
+	g.Expect(registry.Register(blocking)).To(Succeed())
+	g.Expect(registry.Register(failing)).To(Succeed())
+
+	executor := check.NewExecutor(registry, nil)
+
+	_, err := executor.ExecuteSelective(t.Context(), check.Target{}, []string{"*"}, check.GroupWorkload)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	spans := make(map[string]sdktrace.ReadOnlySpan)
+	for _, span := range recorder.Ended() {
+		spans[span.Name()] = span
+	}
+
+	g.Expect(spans).To(HaveKey("check workloads.test.blocking"))
+	g.Expect(spans).To(HaveKey("check workloads.test.failing"))
+
+	blockingSpan := spans["check workloads.test.blocking"]
+	g.Expect(blockingSpan.Attributes()).To(ContainElements(
+		attribute.String("check.id", "workloads.test.blocking"),
+		attribute.String("check.group", string(check.GroupWorkload)),
+		attribute.Bool("check.applied", true),
+		attribute.String("check.impact", string(result.ImpactBlocking)),
+	))
+	g.Expect(blockingSpan.Status().Code).To(Equal(codes.Unset))
+
+	failingSpan := spans["check workloads.test.failing"]
+	g.Expect(failingSpan.Status().Code).To(Equal(codes.Error))
+	g.Expect(failingSpan.Status().Description).To(ContainSubstring("boom"))
+}
+
+type remediationTestCheck struct {
+	check.BaseCheck
+}
+
+func (c *remediationTestCheck) CanApply(_ context.Context, _ check.Target) (bool, error) {
+	return true, nil
+}
+
+func (c *remediationTestCheck) Validate(_ context.Context, _ check.Target) (*result.DiagnosticResult, error) {
+	dr := c.NewResult()
+	dr.SetCondition(check.NewCondition(check.ConditionTypeValidated, metav1.ConditionFalse,
+		check.WithReason("Test"),
+		check.WithCatalogMessage(messages.OperatorNotInstalled, "kueue"),
+		check.WithImpact(result.ImpactAdvisory),
+		check.WithRemediation(c.CheckRemediation)))
+
+	return dr, nil
+}
+
+func TestExecutorLocalize(t *testing.T) {
+	t.Run("should translate catalog messages and record the remediation ID of the check", func(t *testing.T) {
+		g := NewWithT(t)
+
+		chk := &remediationTestCheck{BaseCheck: newStatsTestCheck("workloads.test.remediation").BaseCheck}
+		chk.CheckRemediation = "Install the operator"
+
+		registry := check.NewRegistry()
+		g.Expect(registry.Register(chk)).To(Succeed())
+
+		printer, err := messages.NewPrinter(messages.LangJapanese)
+		g.Expect(err).ToNot(HaveOccurred())
+
+		results := check.NewExecutor(registry, nil).ExecuteAll(t.Context(), check.Target{Messages: printer})
+		g.Expect(results).To(HaveLen(1))
+
+		cond := results[0].Result.Status.Conditions[0]
+		g.Expect(cond.MessageID).To(Equal(messages.OperatorNotInstalled.ID))
+		g.Expect(cond.Message).To(Equal("kueue オペレーターがインストールされていません"))
+		g.Expect(cond.RemediationID).To(Equal(messages.RemediationID("workloads.test.remediation")))
+		// The test check has no translation, so its remediation stays English
+		g.Expect(cond.Remediation).To(Equal("Install the operator"))
+	})
+}
+
+func TestLocalize(t *testing.T) {
+	printer, err := messages.NewPrinter(messages.LangJapanese)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	newResult := func(opts ...check.ConditionOption) *result.DiagnosticResult {
+		dr := result.New(string(check.GroupWorkload), "test", "localize", "")
+		dr.SetCondition(check.NewCondition(check.ConditionTypeValidated, metav1.ConditionFalse, opts...))
+
+		return dr
+	}
+
+	t.Run("should translate catalog messages and remediations", func(t *testing.T) {
+		g := NewWithT(t)
+
+		dr := newResult(
+			check.WithCatalogMessage(messages.CheckPrerequisiteFailed, "workloads.test.passing"),
+			check.WithCatalogRemediation(messages.CheckPrerequisiteFailedRemedy, "workloads.test.passing"),
+		)
+		check.Localize(dr, printer)
+
+		cond := dr.Status.Conditions[0]
+		g.Expect(cond.Message).To(Equal("スキップ: 前提条件のチェック workloads.test.passing が失敗しました"))
+		g.Expect(cond.Remediation).To(Equal("workloads.test.passing の検出事項を解決してから、チェックを再実行してください"))
+	})
+
+	t.Run("should leave texts changed after formatting in English", func(t *testing.T) {
+		g := NewWithT(t)
+
+		dr := newResult(check.WithCatalogMessage(messages.DSCNotFound))
+		dr.Status.Conditions[0].Message += " (details)"
+		check.Localize(dr, printer)
+
+		g.Expect(dr.Status.Conditions[0].Message).To(Equal(messages.DSCNotFound.Format + " (details)"))
+	})
+
+	t.Run("should leave English untouched", func(t *testing.T) {
+		g := NewWithT(t)
+
+		dr := newResult(check.WithCatalogMessage(messages.OperatorNotInstalled, "kueue"))
+		check.Localize(dr, messages.Printer{})
+
+		g.Expect(dr.Status.Conditions[0].Message).To(Equal("kueue operator is not installed"))
+	})
+}
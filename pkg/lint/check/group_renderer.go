@@ -0,0 +1,217 @@
+package check
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+)
+
+// AnnotationSchema identifies the impacted objects a GroupRenderer applies to: objects of
+// Kind carrying the Annotation its groups are keyed by.
+type AnnotationSchema struct {
+	Kind       string
+	Annotation string
+}
+
+// GroupRenderer renders the impacted objects of a result in verbose output grouped by the
+// value of a per-object annotation, then by namespace:
+//
+//	<label>: <value> (N <noun>)
+//	  - namespace: <ns>
+//	       - <crd-fqn>/<name>
+type GroupRenderer struct {
+	AnnotationSchema
+
+	// Noun names the grouped objects in group headers, e.g. "notebooks".
+	Noun string
+
+	// Unknown is the value shown for objects whose annotation is empty.
+	Unknown string
+
+	// Label returns the header label of the group started by obj, e.g. "custom image".
+	// Defaults to the annotation key.
+	Label func(obj metav1.PartialObjectMetadata) string
+
+	// Rank returns the sort key of the group started by obj; lower ranks are listed first and
+	// groups of the same rank are sorted by value. Defaults to sorting by value only.
+	Rank func(obj metav1.PartialObjectMetadata) int
+}
+
+// renderGroup holds the impacted objects sharing an annotation value.
+type renderGroup struct {
+	value      string
+	first      metav1.PartialObjectMetadata
+	namespaces map[string][]string // namespace -> []name
+	count      int
+}
+
+// FormatVerboseOutput renders the impacted objects of dr grouped by the renderer's annotation.
+func (g GroupRenderer) FormatVerboseOutput(out io.Writer, dr *result.DiagnosticResult) {
+	crdName := CRDFullyQualifiedName(dr)
+
+	for _, grp := range g.groups(dr.ImpactedObjects) {
+		label := g.Annotation
+		if g.Label != nil {
+			label = g.Label(grp.first)
+		}
+
+		_, _ = fmt.Fprintf(out, "    %s: %s (%d %s)\n", label, grp.value, grp.count, g.Noun)
+
+		namespaces := make([]string, 0, len(grp.namespaces))
+		for ns := range grp.namespaces {
+			namespaces = append(namespaces, ns)
+		}
+		sort.Strings(namespaces)
+
+		for _, ns := range namespaces {
+			names := grp.namespaces[ns]
+			sort.Strings(names)
+
+			if ns == "" {
+				// Cluster-scoped objects listed without namespace header.
+				for _, name := range names {
+					_, _ = fmt.Fprintf(out, "      - %s/%s\n", crdName, name)
+				}
+
+				continue
+			}
+
+			_, _ = fmt.Fprintf(out, "      - namespace: %s\n", ns)
+			for _, name := range names {
+				_, _ = fmt.Fprintf(out, "           - %s/%s\n", crdName, name)
+			}
+		}
+
+		_, _ = fmt.Fprintln(out)
+	}
+}
+
+// groups groups objects by annotation value, sorted by rank then value.
+func (g GroupRenderer) groups(objects []metav1.PartialObjectMetadata) []renderGroup {
+	var groups []renderGroup
+
+	index := make(map[string]int)
+
+	for _, obj := range objects {
+		value := obj.Annotations[g.Annotation]
+		if value == "" {
+			value = g.Unknown
+		}
+
+		if idx, ok := index[value]; ok {
+			groups[idx].namespaces[obj.Namespace] = append(groups[idx].namespaces[obj.Namespace], obj.Name)
+			groups[idx].count++
+
+			continue
+		}
+
+		index[value] = len(groups)
+		groups = append(groups, renderGroup{
+			value:      value,
+			first:      obj,
+			namespaces: map[string][]string{obj.Namespace: {obj.Name}},
+			count:      1,
+		})
+	}
+
+	sort.SliceStable(groups, func(i, j int) bool {
+		if g.Rank != nil {
+			ri, rj := g.Rank(groups[i].first), g.Rank(groups[j].first)
+			if ri != rj {
+				return ri < rj
+			}
+		}
+
+		return groups[i].value < groups[j].value
+	})
+
+	return groups
+}
+
+// GroupRendererRegistry holds the group renderers of verbose output, keyed by the annotation
+// schema they render.
+type GroupRendererRegistry struct {
+	mu        sync.RWMutex
+	renderers map[AnnotationSchema]GroupRenderer
+}
+
+// NewGroupRendererRegistry creates an empty group renderer registry.
+func NewGroupRendererRegistry() *GroupRendererRegistry {
+	return &GroupRendererRegistry{
+		renderers: make(map[AnnotationSchema]GroupRenderer),
+	}
+}
+
+// Register adds a renderer to the registry.
+// Returns error if a renderer for the same schema already exists.
+func (r *GroupRendererRegistry) Register(renderer GroupRenderer) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.renderers[renderer.AnnotationSchema]; exists {
+		return fmt.Errorf("group renderer for %s objects by %s already registered",
+			renderer.Kind, renderer.Annotation)
+	}
+
+	r.renderers[renderer.AnnotationSchema] = renderer
+
+	return nil
+}
+
+// MustRegister registers a renderer and panics if registration fails.
+func (r *GroupRendererRegistry) MustRegister(renderer GroupRenderer) {
+	if err := r.Register(renderer); err != nil {
+		panic(fmt.Sprintf("failed to register group renderer: %v", err))
+	}
+}
+
+// For returns the renderer of the impacted objects of dr: the objects must all be of the
+// renderer's kind and carry its annotation. When several renderers apply, the one with the
+// lexically smallest annotation key wins.
+func (r *GroupRendererRegistry) For(dr *result.DiagnosticResult) (GroupRenderer, bool) {
+	if r == nil || len(dr.ImpactedObjects) == 0 {
+		return GroupRenderer{}, false
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	kind := dr.ImpactedObjects[0].Kind
+
+	var (
+		match GroupRenderer
+		found bool
+	)
+
+	for schema, renderer := range r.renderers {
+		if schema.Kind != kind || !allAnnotated(dr.ImpactedObjects, schema) {
+			continue
+		}
+
+		if !found || schema.Annotation < match.Annotation {
+			match, found = renderer, true
+		}
+	}
+
+	return match, found
+}
+
+// allAnnotated reports whether every object is of the schema's kind and carries its annotation.
+func allAnnotated(objects []metav1.PartialObjectMetadata, schema AnnotationSchema) bool {
+	for _, obj := range objects {
+		if obj.Kind != schema.Kind {
+			return false
+		}
+
+		if _, ok := obj.Annotations[schema.Annotation]; !ok {
+			return false
+		}
+	}
+
+	return true
+}
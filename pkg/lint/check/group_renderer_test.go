@@ -0,0 +1,132 @@
+package check_test
+
+import (
+	"bytes"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+
+	. "github.com/onsi/gomega"
+)
+
+const testRuntimeAnnotation = "serving.kserve.io/runtime"
+
+func newRuntimeRenderer() check.GroupRenderer {
+	return check.GroupRenderer{
+		AnnotationSchema: check.AnnotationSchema{Kind: resources.InferenceService.Kind, Annotation: testRuntimeAnnotation},
+		Noun:             "InferenceServices",
+		Unknown:          "(unknown runtime)",
+		Label:            func(metav1.PartialObjectMetadata) string { return "runtime" },
+		Rank: func(obj metav1.PartialObjectMetadata) int {
+			if obj.Annotations[testRuntimeAnnotation] == "zeta" {
+				return 0
+			}
+
+			return 1
+		},
+	}
+}
+
+func newRuntimeResult(runtimes map[string]string) *result.DiagnosticResult {
+	dr := result.New("workload", "kserve", "impacted-workloads", "test")
+
+	names := make([]types.NamespacedName, 0, len(runtimes))
+	for name := range runtimes {
+		names = append(names, types.NamespacedName{Namespace: "ns1", Name: name})
+	}
+
+	dr.SetImpactedObjects(resources.InferenceService, names)
+
+	for i := range dr.ImpactedObjects {
+		dr.ImpactedObjects[i].Annotations = map[string]string{
+			testRuntimeAnnotation: runtimes[dr.ImpactedObjects[i].Name],
+		}
+	}
+
+	return dr
+}
+
+func TestGroupRenderer_FormatVerboseOutput(t *testing.T) {
+	g := NewWithT(t)
+
+	dr := newRuntimeResult(map[string]string{"isvc-a": "ovms", "isvc-b": "zeta", "isvc-c": "ovms", "isvc-d": ""})
+
+	var buf bytes.Buffer
+	newRuntimeRenderer().FormatVerboseOutput(&buf, dr)
+
+	crd := resources.InferenceService.CRDFQN()
+	g.Expect(buf.String()).To(Equal(
+		"    runtime: zeta (1 InferenceServices)\n" +
+			"      - namespace: ns1\n" +
+			"           - " + crd + "/isvc-b\n" +
+			"\n" +
+			"    runtime: (unknown runtime) (1 InferenceServices)\n" +
+			"      - namespace: ns1\n" +
+			"           - " + crd + "/isvc-d\n" +
+			"\n" +
+			"    runtime: ovms (2 InferenceServices)\n" +
+			"      - namespace: ns1\n" +
+			"           - " + crd + "/isvc-a\n" +
+			"           - " + crd + "/isvc-c\n" +
+			"\n",
+	))
+}
+
+func TestGroupRendererRegistry(t *testing.T) {
+	t.Run("should reject a second renderer for the same schema", func(t *testing.T) {
+		g := NewWithT(t)
+
+		registry := check.NewGroupRendererRegistry()
+		g.Expect(registry.Register(newRuntimeRenderer())).To(Succeed())
+		g.Expect(registry.Register(newRuntimeRenderer())).ToNot(Succeed())
+	})
+
+	t.Run("should select the renderer of annotated objects of its kind", func(t *testing.T) {
+		g := NewWithT(t)
+
+		registry := check.NewGroupRendererRegistry()
+		registry.MustRegister(newRuntimeRenderer())
+
+		renderer, ok := registry.For(newRuntimeResult(map[string]string{"isvc-a": "ovms"}))
+		g.Expect(ok).To(BeTrue())
+		g.Expect(renderer.Annotation).To(Equal(testRuntimeAnnotation))
+	})
+
+	t.Run("should not apply to results with objects lacking the annotation or of another kind", func(t *testing.T) {
+		g := NewWithT(t)
+
+		registry := check.NewGroupRendererRegistry()
+		registry.MustRegister(newRuntimeRenderer())
+
+		unannotated := newRuntimeResult(map[string]string{"isvc-a": "ovms"})
+		unannotated.AddImpactedObjects(resources.InferenceService, []types.NamespacedName{{Namespace: "ns1", Name: "isvc-b"}})
+
+		_, ok := registry.For(unannotated)
+		g.Expect(ok).To(BeFalse())
+
+		mixed := newRuntimeResult(map[string]string{"isvc-a": "ovms"})
+		mixed.ImpactedObjects = append(mixed.ImpactedObjects, metav1.PartialObjectMetadata{
+			TypeMeta: resources.ServingRuntime.TypeMeta(),
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "sr", Annotations: map[string]string{
+				testRuntimeAnnotation: "ovms",
+			}},
+		})
+
+		_, ok = registry.For(mixed)
+		g.Expect(ok).To(BeFalse())
+	})
+
+	t.Run("should not apply without a registry", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var registry *check.GroupRendererRegistry
+
+		_, ok := registry.For(newRuntimeResult(map[string]string{"isvc-a": "ovms"}))
+		g.Expect(ok).To(BeFalse())
+	})
+}
@@ -0,0 +1,209 @@
+// Package messages is the catalog of localizable check messages, e.g. skipped checks, a missing
+// DataScienceCluster or the findings of individual checks. Each message has an ID, stable across
+// languages and reported with the condition in structured output, an English format registered
+// with New by the package using it and optional translations embedded from data/<lang>.yaml.
+// Messages without a translation fall back to English.
+package messages
+
+import (
+	"embed"
+	"fmt"
+	"maps"
+	"path"
+	"slices"
+	"strings"
+	"sync"
+
+	"sigs.k8s.io/yaml"
+)
+
+// Supported languages.
+const (
+	LangEnglish  = "en"
+	LangJapanese = "ja"
+	LangChinese  = "zh"
+)
+
+// Message is an entry of the catalog: a stable ID and the English format of the message.
+type Message struct {
+	ID     string
+	Format string
+}
+
+// String returns the English format of the message, so messages can be passed as arguments of
+// other messages.
+func (m Message) String() string {
+	return m.Format
+}
+
+// Sprintf formats the message in English.
+func (m Message) Sprintf(args ...any) string {
+	if len(args) == 0 {
+		return m.Format
+	}
+
+	return fmt.Sprintf(m.Format, args...)
+}
+
+// english holds the English format of every registered message ID; translations must use the
+// same verbs.
+//
+//nolint:gochecknoglobals // Filled by package initialization only, read-only afterwards
+var english = map[string]string{}
+
+// New registers the English format of a message ID and returns the message. It is meant for
+// package-level variables; registering an ID twice panics.
+func New(id string, format string) Message {
+	if _, ok := english[id]; ok {
+		panic(fmt.Sprintf("message %s registered twice", id))
+	}
+
+	english[id] = format
+
+	return Message{ID: id, Format: format}
+}
+
+// Lookup returns the registered message with the given ID.
+func Lookup(id string) (Message, bool) {
+	format, ok := english[id]
+
+	return Message{ID: id, Format: format}, ok
+}
+
+// RemediationID returns the ID the remediation of the check with the given ID is translated
+// under. Its English text is the remediation of the check.
+func RemediationID(checkID string) string {
+	return checkID + ".remediation"
+}
+
+// IDs returns the registered message IDs, sorted.
+func IDs() []string {
+	return slices.Sorted(maps.Keys(english))
+}
+
+// Messages of the check framework.
+//
+//nolint:gochecknoglobals // Message catalog entries
+var (
+	DSCNotFound                   = New("dsc.not-found", "No DataScienceCluster found")
+	DSCINotFound                  = New("dsci.not-found", "No DSCInitialization found")
+	OperatorNotInstalled          = New("operator.not-installed", "%s operator is not installed")
+	OperatorInstalled             = New("operator.installed", "%s operator installed: %s")
+	CheckApplicabilityFailed      = New("check.applicability-failed", "Check applicability failed: %v")
+	CheckPrerequisiteFailed       = New("check.prerequisite-failed", "Skipped: prerequisite check %s failed")
+	CheckPrerequisiteFailedRemedy = New("check.prerequisite-failed.remediation", "Resolve the findings of %s and re-run the check")
+	CheckNotApplicable            = New("check.not-applicable", "Skipped: the check does not apply to this cluster or version")
+	CheckNotApplicableReason      = New("check.not-applicable.reason", "Skipped: the check does not apply: %s")
+	CheckInvalidResult            = New("check.invalid-result", "Invalid check result: %v")
+	CheckExecutionFailed          = New("check.execution-failed", "Check execution failed: %v")
+	CheckAccessDenied             = New("check.access-denied", "Insufficient permissions to access cluster resources: %v")
+	CheckUnauthorized             = New("check.unauthorized", "Authentication required to access cluster resources: %v")
+	CheckTimedOut                 = New("check.timed-out", "Request timed out: %v")
+	CheckServerUnavailable        = New("check.server-unavailable", "API server is unavailable or overloaded: %v")
+)
+
+//go:embed data/*.yaml
+var translationFiles embed.FS
+
+//nolint:gochecknoglobals // Parsed once from the embedded translations
+var translations = sync.OnceValue(func() map[string]map[string]string {
+	byLang, err := loadTranslations()
+	if err != nil {
+		panic(fmt.Sprintf("parsing embedded message translations: %v", err))
+	}
+
+	return byLang
+})
+
+func loadTranslations() (map[string]map[string]string, error) {
+	entries, err := translationFiles.ReadDir("data")
+	if err != nil {
+		return nil, fmt.Errorf("reading translations: %w", err)
+	}
+
+	byLang := make(map[string]map[string]string, len(entries))
+
+	for _, entry := range entries {
+		data, err := translationFiles.ReadFile(path.Join("data", entry.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("reading %s: %w", entry.Name(), err)
+		}
+
+		var catalog map[string]string
+		if err := yaml.UnmarshalStrict(data, &catalog); err != nil {
+			return nil, fmt.Errorf("parsing %s: %w", entry.Name(), err)
+		}
+
+		byLang[strings.TrimSuffix(entry.Name(), path.Ext(entry.Name()))] = catalog
+	}
+
+	return byLang, nil
+}
+
+// Languages returns the supported languages, English first.
+func Languages() []string {
+	return []string{LangEnglish, LangJapanese, LangChinese}
+}
+
+// Printer formats catalog messages in one language. The zero value formats English.
+type Printer struct {
+	lang string
+}
+
+// NewPrinter returns a Printer for the given language; empty selects English.
+func NewPrinter(lang string) (Printer, error) {
+	if lang == "" {
+		lang = LangEnglish
+	}
+
+	if !slices.Contains(Languages(), lang) {
+		return Printer{}, fmt.Errorf("invalid lang: %s (must be one of: %s)", lang, strings.Join(Languages(), ", "))
+	}
+
+	return Printer{lang: lang}, nil
+}
+
+// Language returns the language messages are formatted in.
+func (p Printer) Language() string {
+	if p.lang == "" {
+		return LangEnglish
+	}
+
+	return p.lang
+}
+
+// Sprintf formats the message in the language of the printer, falling back to English when it
+// has no translation.
+func (p Printer) Sprintf(m Message, args ...any) string {
+	if text, ok := p.Translate(m.ID, args...); ok {
+		return text
+	}
+
+	return m.Sprintf(args...)
+}
+
+// Translate formats the translation of the message ID in the language of the printer. It
+// reports false when there is none, e.g. for English or a message without a translation.
+// Arguments that are messages themselves are translated as well.
+func (p Printer) Translate(id string, args ...any) (string, bool) {
+	format, ok := translations()[p.Language()][id]
+	if !ok {
+		return "", false
+	}
+
+	translated := make([]any, len(args))
+	for i, arg := range args {
+		if m, ok := arg.(Message); ok {
+			arg = p.Sprintf(m)
+		}
+
+		translated[i] = arg
+	}
+
+	return Message{ID: id, Format: format}.Sprintf(translated...), true
+}
+
+// Translated returns the message IDs the language has a translation for, sorted.
+func Translated(lang string) []string {
+	return slices.Sorted(maps.Keys(translations()[lang]))
+}
@@ -0,0 +1,103 @@
+//nolint:testpackage // internal test: verifies the embedded translations against the English catalog
+package messages
+
+import (
+	"regexp"
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+//nolint:gochecknoglobals // Test fixture - shared across test functions
+var formatVerb = regexp.MustCompile(`%(%|[-+# 0]*[0-9]*(\.[0-9]+)?[a-zA-Z])`)
+
+func TestTranslations_MatchEnglishCatalog(t *testing.T) {
+	g := NewWithT(t)
+
+	byLang := translations()
+
+	for _, lang := range Languages()[1:] {
+		catalog, ok := byLang[lang]
+		g.Expect(ok).To(BeTrue(), "missing translations for %s", lang)
+
+		for id, format := range catalog {
+			source, known := english[id]
+			if !known {
+				// Messages of the checks are verified against the registry by the lint package
+				continue
+			}
+
+			g.Expect(formatVerb.FindAllString(format, -1)).To(Equal(formatVerb.FindAllString(source, -1)),
+				"%s: %s must use the verbs of the English message", lang, id)
+		}
+	}
+}
+
+func TestNew(t *testing.T) {
+	t.Run("should register the message", func(t *testing.T) {
+		g := NewWithT(t)
+
+		m, ok := Lookup(DSCNotFound.ID)
+		g.Expect(ok).To(BeTrue())
+		g.Expect(m).To(Equal(DSCNotFound))
+		g.Expect(IDs()).To(ContainElement(DSCNotFound.ID))
+	})
+
+	t.Run("should panic on a duplicate ID", func(t *testing.T) {
+		g := NewWithT(t)
+
+		g.Expect(func() { New(DSCNotFound.ID, "duplicate") }).To(Panic())
+	})
+}
+
+func TestPrinter_Sprintf(t *testing.T) {
+	t.Run("should format in the selected language", func(t *testing.T) {
+		g := NewWithT(t)
+
+		ja, err := NewPrinter(LangJapanese)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(ja.Sprintf(OperatorNotInstalled, "cert-manager")).To(Equal("cert-manager オペレーターがインストールされていません"))
+
+		zh, err := NewPrinter(LangChinese)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(zh.Sprintf(DSCNotFound)).To(Equal("未找到 DataScienceCluster"))
+	})
+
+	t.Run("should default to English", func(t *testing.T) {
+		g := NewWithT(t)
+
+		p, err := NewPrinter("")
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(p.Language()).To(Equal(LangEnglish))
+		g.Expect(p.Sprintf(OperatorInstalled, "cert-manager", "1.16.0")).To(Equal("cert-manager operator installed: 1.16.0"))
+		g.Expect(Printer{}.Sprintf(DSCNotFound)).To(Equal("No DataScienceCluster found"))
+	})
+
+	t.Run("should reject unsupported languages", func(t *testing.T) {
+		g := NewWithT(t)
+
+		_, err := NewPrinter("fr")
+		g.Expect(err).To(MatchError(ContainSubstring("invalid lang: fr")))
+	})
+}
+
+func TestPrinter_Translate(t *testing.T) {
+	t.Run("should translate message arguments", func(t *testing.T) {
+		g := NewWithT(t)
+
+		ja, err := NewPrinter(LangJapanese)
+		g.Expect(err).ToNot(HaveOccurred())
+
+		text, ok := ja.Translate(CheckNotApplicableReason.ID, DSCNotFound)
+		g.Expect(ok).To(BeTrue())
+		g.Expect(text).To(ContainSubstring(ja.Sprintf(DSCNotFound)))
+		g.Expect(text).ToNot(ContainSubstring(DSCNotFound.Format))
+	})
+
+	t.Run("should report missing translations", func(t *testing.T) {
+		g := NewWithT(t)
+
+		_, ok := Printer{}.Translate(DSCNotFound.ID)
+		g.Expect(ok).To(BeFalse())
+	})
+}
@@ -0,0 +1,132 @@
+package check
+
+import (
+	"slices"
+
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/version"
+)
+
+// Version range labels for BaseCheck.CheckVersions. They mirror the version helpers used by
+// CanApply implementations so the check catalog can be described without a cluster.
+const (
+	VersionsAny               = "any"
+	VersionsUpgrade2xTo3x     = "upgrade 2.x -> 3.x"
+	VersionsUpgrade34To35     = "upgrade 3.4 -> 3.5"
+	VersionsTarget3x          = "target 3.x"
+	VersionsTargetAtLeast33   = "target >= 3.3"
+	VersionsCurrentOrTarget3x = "current or target 3.x"
+)
+
+// ReadVerbs are the RBAC verbs lint needs on every resource type a check reads.
+//
+//nolint:gochecknoglobals // Static list shared by catalog and RBAC output.
+var ReadVerbs = []string{"get", "list"}
+
+// RemediationProvider is an optional interface exposing static remediation guidance for a check.
+// BaseCheck implements it from the CheckRemediation field.
+type RemediationProvider interface {
+	Remediation() string
+}
+
+// VersionDescriber is an optional interface exposing the version range a check applies to.
+// BaseCheck implements it from the CheckVersions field.
+type VersionDescriber interface {
+	ApplicableVersions() string
+}
+
+// ComponentDescriber is an optional interface exposing the DataScienceCluster components a
+// check covers and the management states it applies to. BaseCheck implements it from the
+// CheckComponents and CheckComponentStates fields.
+type ComponentDescriber interface {
+	ApplicableComponents() []string
+	ApplicableComponentStates() []string
+}
+
+// FlavorDescriber is an optional interface exposing the product flavors a check applies to.
+// BaseCheck implements it from the CheckFlavors field.
+type FlavorDescriber interface {
+	ApplicableFlavors() []version.Flavor
+}
+
+// ResourcePermission describes read access a check needs on one resource type.
+type ResourcePermission struct {
+	Group    string   `json:"group"    yaml:"group"`
+	Version  string   `json:"version"  yaml:"version"`
+	Resource string   `json:"resource" yaml:"resource"`
+	Kind     string   `json:"kind"     yaml:"kind"`
+	Verbs    []string `json:"verbs"    yaml:"verbs"`
+}
+
+// Metadata is the static description of a registered check, available without running it.
+type Metadata struct {
+	ID                 string               `json:"id"                          yaml:"id"`
+	Name               string               `json:"name"                        yaml:"name"`
+	Group              CheckGroup           `json:"group"                       yaml:"group"`
+	Kind               string               `json:"kind"                        yaml:"kind"`
+	Type               string               `json:"type"                        yaml:"type"`
+	Description        string               `json:"description"                 yaml:"description"`
+	Remediation        string               `json:"remediation,omitempty"       yaml:"remediation,omitempty"`
+	ApplicableVersions string               `json:"applicableVersions,omitempty" yaml:"applicableVersions,omitempty"`
+	ApplicableFlavors  []version.Flavor     `json:"applicableFlavors,omitempty"  yaml:"applicableFlavors,omitempty"`
+	Fixable            bool                 `json:"fixable"                     yaml:"fixable"`
+	DependsOn          []string             `json:"dependsOn,omitempty"         yaml:"dependsOn,omitempty"`
+	Tags               []string             `json:"tags,omitempty"              yaml:"tags,omitempty"`
+	RequiredResources  []ResourcePermission `json:"requiredResources"           yaml:"requiredResources"`
+}
+
+// DescribeCheck collects the metadata of a check from the Check interface and the optional
+// RemediationProvider, VersionDescriber, FlavorDescriber, ResourceRequirer, DependencyDeclarer, TagDeclarer and
+// Remediator interfaces.
+func DescribeCheck(chk Check) Metadata {
+	md := Metadata{
+		ID:                chk.ID(),
+		Name:              chk.Name(),
+		Group:             chk.Group(),
+		Kind:              chk.CheckKind(),
+		Type:              chk.CheckType(),
+		Description:       chk.Description(),
+		RequiredResources: []ResourcePermission{},
+	}
+
+	if p, ok := chk.(RemediationProvider); ok {
+		md.Remediation = p.Remediation()
+	}
+
+	if d, ok := chk.(VersionDescriber); ok {
+		md.ApplicableVersions = d.ApplicableVersions()
+	}
+
+	if d, ok := chk.(FlavorDescriber); ok {
+		md.ApplicableFlavors = d.ApplicableFlavors()
+	}
+
+	if _, ok := chk.(Remediator); ok {
+		md.Fixable = true
+	}
+
+	md.DependsOn = dependenciesOf(chk)
+	md.Tags = tagsOf(chk)
+
+	if r, ok := chk.(ResourceRequirer); ok {
+		md.RequiredResources = ResourcePermissions(r.RequiredResources())
+	}
+
+	return md
+}
+
+// ResourcePermissions converts resource types to the read permissions lint needs on them.
+func ResourcePermissions(types []resources.ResourceType) []ResourcePermission {
+	perms := make([]ResourcePermission, 0, len(types))
+	for _, rt := range types {
+		perms = append(perms, ResourcePermission{
+			Group:    rt.Group,
+			Version:  rt.Version,
+			Resource: rt.Resource,
+			Kind:     rt.Kind,
+			Verbs:    slices.Clone(ReadVerbs),
+		})
+	}
+
+	return perms
+}
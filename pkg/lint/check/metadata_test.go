@@ -0,0 +1,62 @@
+package check_test
+
+import (
+	"testing"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	mocks "github.com/opendatahub-io/odh-cli/pkg/util/test/mocks/check"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestDescribeCheck(t *testing.T) {
+	t.Run("should collect BaseCheck metadata", func(t *testing.T) {
+		g := NewWithT(t)
+
+		md := check.DescribeCheck(&requirerCheck{BaseCheck: check.BaseCheck{
+			CheckGroup:       check.GroupWorkload,
+			Kind:             "notebook",
+			Type:             check.CheckTypeImpactedWorkloads,
+			CheckID:          "workloads.notebook.test",
+			CheckName:        "Workloads :: Notebook :: Test",
+			CheckDescription: "Test description",
+			CheckRemediation: "Test remediation",
+			CheckResources:   []resources.ResourceType{resources.Notebook},
+			CheckVersions:    check.VersionsUpgrade2xTo3x,
+		}})
+
+		g.Expect(md.ID).To(Equal("workloads.notebook.test"))
+		g.Expect(md.Group).To(Equal(check.GroupWorkload))
+		g.Expect(md.Type).To(Equal(string(check.CheckTypeImpactedWorkloads)))
+		g.Expect(md.Remediation).To(Equal("Test remediation"))
+		g.Expect(md.ApplicableVersions).To(Equal(check.VersionsUpgrade2xTo3x))
+		g.Expect(md.Fixable).To(BeFalse())
+		g.Expect(md.RequiredResources).To(Equal([]check.ResourcePermission{{
+			Group:    resources.Notebook.Group,
+			Version:  resources.Notebook.Version,
+			Resource: resources.Notebook.Resource,
+			Kind:     resources.Notebook.Kind,
+			Verbs:    []string{"get", "list"},
+		}}))
+	})
+
+	t.Run("should tolerate checks without optional metadata", func(t *testing.T) {
+		g := NewWithT(t)
+
+		plain := mocks.NewMockCheck()
+		plain.On("ID").Return("components.plain")
+		plain.On("Name").Return("Plain")
+		plain.On("Group").Return(check.GroupComponent)
+		plain.On("CheckKind").Return("plain")
+		plain.On("CheckType").Return("removal")
+		plain.On("Description").Return("")
+
+		md := check.DescribeCheck(plain)
+
+		g.Expect(md.ID).To(Equal("components.plain"))
+		g.Expect(md.Remediation).To(BeEmpty())
+		g.Expect(md.ApplicableVersions).To(BeEmpty())
+		g.Expect(md.RequiredResources).To(BeEmpty())
+	})
+}
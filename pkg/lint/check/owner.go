@@ -0,0 +1,162 @@
+package check
+
+import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+)
+
+// Metadata keys the default owner resolvers read.
+const (
+	// AnnotationNotebookUsername is set by the dashboard on notebooks to the user who created them.
+	AnnotationNotebookUsername = "opendatahub.io/username"
+
+	// LabelOwner explicitly names the team owning a workload.
+	LabelOwner = "opendatahub.io/owner"
+
+	// LabelArgoCDInstance is set by Argo CD on the objects it manages to the owning Application.
+	LabelArgoCDInstance = "argocd.argoproj.io/instance"
+
+	// AnnotationNamespaceRequester is set by OpenShift on projects to the user who requested them.
+	AnnotationNamespaceRequester = "openshift.io/requester"
+)
+
+// OwnerResolver resolves the user or team owning an impacted object, so findings can be routed
+// to whoever can act on them. Resolvers are tried in order by ResolveOwners until one succeeds.
+type OwnerResolver interface {
+	// ResolveOwner returns the owner of the object, or "" when the resolver cannot tell,
+	// including when the metadata it needs cannot be read.
+	ResolveOwner(ctx context.Context, r client.Reader, obj metav1.PartialObjectMetadata) string
+}
+
+// OwnerResolverFunc adapts a function to OwnerResolver.
+type OwnerResolverFunc func(ctx context.Context, r client.Reader, obj metav1.PartialObjectMetadata) string
+
+// ResolveOwner calls f.
+func (f OwnerResolverFunc) ResolveOwner(ctx context.Context, r client.Reader, obj metav1.PartialObjectMetadata) string {
+	return f(ctx, r, obj)
+}
+
+// ObjectMetadataOwner resolves the owner from a label or annotation of the impacted object itself.
+type ObjectMetadataOwner struct {
+	// ResourceTypes are the types of the objects the resolver applies to.
+	ResourceTypes []resources.ResourceType
+
+	// Labels and Annotations are the keys read, labels first; the first one set wins.
+	Labels      []string
+	Annotations []string
+}
+
+// ResolveOwner implements OwnerResolver.
+func (o ObjectMetadataOwner) ResolveOwner(ctx context.Context, r client.Reader, obj metav1.PartialObjectMetadata) string {
+	for _, rt := range o.ResourceTypes {
+		if !isKind(obj, rt) {
+			continue
+		}
+
+		meta, err := r.GetResourceMetadata(ctx, rt, obj.Name, client.InNamespace(obj.Namespace))
+		if err != nil {
+			return ""
+		}
+
+		return firstValue(meta.Labels, o.Labels, firstValue(meta.Annotations, o.Annotations, ""))
+	}
+
+	return ""
+}
+
+// NamespaceRequesterOwner resolves the owner of namespaced objects from the openshift.io/requester
+// annotation of their namespace.
+type NamespaceRequesterOwner struct{}
+
+// ResolveOwner implements OwnerResolver.
+func (NamespaceRequesterOwner) ResolveOwner(ctx context.Context, r client.Reader, obj metav1.PartialObjectMetadata) string {
+	if obj.Namespace == "" {
+		return ""
+	}
+
+	meta, err := r.GetResourceMetadata(ctx, resources.Namespace, obj.Namespace)
+	if err != nil {
+		return ""
+	}
+
+	return meta.Annotations[AnnotationNamespaceRequester]
+}
+
+// DefaultOwnerResolvers returns the owner resolvers used by lint, most specific first: the
+// owner label of a workload, the dashboard user of a notebook, the Argo CD Application managing
+// the workload, then the requester of its namespace.
+func DefaultOwnerResolvers() []OwnerResolver {
+	workloads := []resources.ResourceType{
+		resources.Notebook, resources.InferenceService, resources.LLMInferenceService,
+		resources.RayCluster, resources.RayJob, resources.PyTorchJob, resources.TFJob,
+		resources.GuardrailsOrchestrator, resources.LlamaStackDistribution, resources.TrustyAIService,
+	}
+
+	return []OwnerResolver{
+		ObjectMetadataOwner{ResourceTypes: workloads, Labels: []string{LabelOwner}},
+		ObjectMetadataOwner{ResourceTypes: []resources.ResourceType{resources.Notebook}, Annotations: []string{AnnotationNotebookUsername}},
+		ObjectMetadataOwner{ResourceTypes: workloads, Labels: []string{LabelArgoCDInstance}},
+		NamespaceRequesterOwner{},
+	}
+}
+
+// ResolveOwners sets the AnnotationObjectOwner annotation of every impacted object to the
+// owner returned by the first resolver that can tell. Objects no resolver can tell are left
+// unannotated. Resolvers read the objects again, so r should cache reads (see
+// client.NewCachingReader) for objects sharing a namespace to be resolved with one request.
+func ResolveOwners(ctx context.Context, r client.Reader, results []CheckExecution, resolvers []OwnerResolver) {
+	annotateImpactedObjects(results, result.AnnotationObjectOwner, func(obj metav1.PartialObjectMetadata) string {
+		for _, resolver := range resolvers {
+			if owner := resolver.ResolveOwner(ctx, r, obj); owner != "" {
+				return owner
+			}
+		}
+
+		return ""
+	})
+}
+
+// annotateImpactedObjects sets the key annotation of every impacted object to the value
+// returned by valueOf, leaving the objects it returns "" for unannotated.
+func annotateImpactedObjects(
+	results []CheckExecution,
+	key string,
+	valueOf func(obj metav1.PartialObjectMetadata) string,
+) {
+	for _, exec := range results {
+		if exec.Result == nil {
+			continue
+		}
+
+		for i := range exec.Result.ImpactedObjects {
+			obj := &exec.Result.ImpactedObjects[i]
+
+			value := valueOf(*obj)
+			if value == "" {
+				continue
+			}
+
+			if obj.Annotations == nil {
+				obj.Annotations = make(map[string]string)
+			}
+
+			obj.Annotations[key] = value
+		}
+	}
+}
+
+// firstValue returns the value of the first of keys set in m, or fallback.
+func firstValue(m map[string]string, keys []string, fallback string) string {
+	for _, key := range keys {
+		if v := m[key]; v != "" {
+			return v
+		}
+	}
+
+	return fallback
+}
@@ -0,0 +1,103 @@
+package check_test
+
+import (
+	"context"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	metadatafake "k8s.io/client-go/metadata/fake"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+	"github.com/opendatahub-io/odh-cli/pkg/util/kube"
+
+	. "github.com/onsi/gomega"
+)
+
+func newOwnerTestObject(rt resources.ResourceType, namespace, name string, labels, annotations map[string]any) *unstructured.Unstructured {
+	meta := map[string]any{"name": name, "labels": labels, "annotations": annotations}
+	if namespace != "" {
+		meta["namespace"] = namespace
+	}
+
+	return &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": rt.APIVersion(),
+		"kind":       rt.Kind,
+		"metadata":   meta,
+	}}
+}
+
+func impactedObject(rt resources.ResourceType, namespace, name string) metav1.PartialObjectMetadata {
+	return metav1.PartialObjectMetadata{
+		TypeMeta:   rt.TypeMeta(),
+		ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+	}
+}
+
+func TestResolveOwners(t *testing.T) {
+	g := NewWithT(t)
+
+	objects := kube.ToPartialObjectMetadata(
+		newOwnerTestObject(resources.Namespace, "", "team-a", nil,
+			map[string]any{check.AnnotationNamespaceRequester: "alice@example.com"}),
+		newOwnerTestObject(resources.Notebook, "team-a", "nb-user", nil,
+			map[string]any{check.AnnotationNotebookUsername: "bob"}),
+		newOwnerTestObject(resources.Notebook, "team-a", "nb-labeled",
+			map[string]any{check.LabelOwner: "data-science"},
+			map[string]any{check.AnnotationNotebookUsername: "bob"}),
+		newOwnerTestObject(resources.Notebook, "team-a", "nb-plain", nil, nil),
+		newOwnerTestObject(resources.InferenceService, "team-b", "isvc-argo",
+			map[string]any{check.LabelArgoCDInstance: "serving-app"}, nil),
+	)
+
+	scheme := runtime.NewScheme()
+	_ = metav1.AddMetaToScheme(scheme)
+	reader := client.NewForTesting(client.TestClientConfig{
+		Metadata: metadatafake.NewSimpleMetadataClient(scheme, objects...),
+	})
+
+	dr := result.New("workload", "notebook", "owner", "test")
+	dr.ImpactedObjects = []metav1.PartialObjectMetadata{
+		impactedObject(resources.Notebook, "team-a", "nb-user"),
+		impactedObject(resources.Notebook, "team-a", "nb-labeled"),
+		impactedObject(resources.Notebook, "team-a", "nb-plain"),
+		impactedObject(resources.InferenceService, "team-b", "isvc-argo"),
+		impactedObject(resources.Notebook, "team-c", "nb-missing"),
+	}
+
+	results := []check.CheckExecution{{Result: dr}, {Result: nil}}
+
+	check.ResolveOwners(t.Context(), reader, results, check.DefaultOwnerResolvers())
+
+	owners := make(map[string]string, len(dr.ImpactedObjects))
+	for _, obj := range dr.ImpactedObjects {
+		owners[obj.Name] = obj.Annotations[result.AnnotationObjectOwner]
+	}
+
+	g.Expect(owners).To(Equal(map[string]string{
+		"nb-user":    "bob",
+		"nb-labeled": "data-science",
+		"nb-plain":   "alice@example.com",
+		"isvc-argo":  "serving-app",
+		"nb-missing": "",
+	}))
+}
+
+func TestResolveOwners_CustomResolver(t *testing.T) {
+	g := NewWithT(t)
+
+	dr := result.New("workload", "notebook", "owner", "test")
+	dr.ImpactedObjects = []metav1.PartialObjectMetadata{impactedObject(resources.Notebook, "team-a", "nb")}
+
+	resolver := check.OwnerResolverFunc(func(_ context.Context, _ client.Reader, obj metav1.PartialObjectMetadata) string {
+		return "owner-of-" + obj.Name
+	})
+
+	check.ResolveOwners(t.Context(), nil, []check.CheckExecution{{Result: dr}}, []check.OwnerResolver{resolver})
+
+	g.Expect(dr.ImpactedObjects[0].Annotations).To(HaveKeyWithValue(result.AnnotationObjectOwner, "owner-of-nb"))
+}
@@ -100,14 +100,17 @@ func (r *CheckRegistry) ListAll() []Check {
 	return result
 }
 
-// ListByPatterns returns checks matching any of the selector patterns and group.
+// ListByPatterns returns checks matching the selector patterns and group.
 // Each pattern can be:
 //   - Wildcard: "*" matches all checks
 //   - Group shortcut: "components", "dependencies", "platform", "services", "workloads"
+//   - Tag: "@fast", "@blocking-only" match checks declaring the tag
 //   - Exact ID: "components.dashboard"
 //   - Glob pattern: "components.*", "*dashboard*", "*.dashboard"
 //
-// A check is included if it matches ANY of the provided patterns (union semantics).
+// A check is included if it matches ANY of the provided patterns (union semantics), unless it
+// matches a pattern prefixed with "!" (e.g. "!workloads.notebook.*"). When all patterns are
+// exclusions, they apply to all checks.
 // If group is empty, all groups are included.
 // TargetVersion filtering is handled by CanApply in the executor.
 func (r *CheckRegistry) ListByPatterns(
@@ -117,6 +120,7 @@ func (r *CheckRegistry) ListByPatterns(
 	r.mu.RLock()
 	defer r.mu.RUnlock()
 
+	include, exclude := splitPatterns(patterns)
 	result := make([]Check, 0, len(r.checks))
 
 	for _, check := range r.checks {
@@ -125,41 +129,36 @@ func (r *CheckRegistry) ListByPatterns(
 			continue
 		}
 
-		// Match against any pattern
-		for _, pattern := range patterns {
-			matched, err := matchesPattern(check, pattern)
-			if err != nil {
-				return nil, fmt.Errorf("pattern matching for check %s: %w", check.ID(), err)
-			}
-
-			if matched {
-				result = append(result, check)
+		selected, err := selectsCheck(check, include, exclude)
+		if err != nil {
+			return nil, fmt.Errorf("pattern matching for check %s: %w", check.ID(), err)
+		}
 
-				break
-			}
+		if selected {
+			result = append(result, check)
 		}
 	}
 
 	return result, nil
 }
 
-// MatchesAnyCheck returns true if any registered check matches at least one of the patterns.
+// MatchesAnyCheck returns true if the patterns select at least one registered check.
 // This is used for early validation that user-provided selectors will match something.
 // Unlike ListByPatterns, this short-circuits on the first match to avoid materializing a full slice.
 func (r *CheckRegistry) MatchesAnyCheck(patterns []string) (bool, error) {
 	r.mu.RLock()
 	defer r.mu.RUnlock()
 
+	include, exclude := splitPatterns(patterns)
+
 	for _, check := range r.checks {
-		for _, pattern := range patterns {
-			matched, err := matchesPattern(check, pattern)
-			if err != nil {
-				return false, fmt.Errorf("pattern matching for check %s: %w", check.ID(), err)
-			}
-
-			if matched {
-				return true, nil
-			}
+		selected, err := selectsCheck(check, include, exclude)
+		if err != nil {
+			return false, fmt.Errorf("pattern matching for check %s: %w", check.ID(), err)
+		}
+
+		if selected {
+			return true, nil
 		}
 	}
 
@@ -223,6 +223,79 @@ func TestCheckRegistry_ListByPatterns_MultiplePatterns(t *testing.T) {
 	}
 }
 
+func TestCheckRegistry_ListByPatterns_ExclusionsAndTags(t *testing.T) {
+	registry := check.NewRegistry()
+
+	for _, chk := range []*statsTestCheck{
+		newTaggedTestCheck("platform.dsc.readiness", check.GroupPlatform, check.TagFast, check.TagBlockingOnly),
+		newTaggedTestCheck("workloads.notebook.impacted-workloads", check.GroupWorkload),
+		newTaggedTestCheck("workloads.notebook.connection-integrity", check.GroupWorkload, check.TagBlockingOnly),
+		newTaggedTestCheck("workloads.ray.impacted-workloads", check.GroupWorkload),
+	} {
+		NewWithT(t).Expect(registry.Register(chk)).To(Succeed())
+	}
+
+	tests := []struct {
+		name     string
+		patterns []string
+		wantIDs  []string
+	}{
+		{
+			name:     "exclusion applies to the included checks",
+			patterns: []string{"workloads", "!workloads.notebook.*"},
+			wantIDs:  []string{"workloads.ray.impacted-workloads"},
+		},
+		{
+			name:     "exclusions alone apply to all checks",
+			patterns: []string{"!workloads"},
+			wantIDs:  []string{"platform.dsc.readiness"},
+		},
+		{
+			name:     "tag selects the checks declaring it",
+			patterns: []string{"@blocking-only"},
+			wantIDs:  []string{"platform.dsc.readiness", "workloads.notebook.connection-integrity"},
+		},
+		{
+			name:     "excluded tag",
+			patterns: []string{"workloads.notebook.*", "!@blocking-only"},
+			wantIDs:  []string{"workloads.notebook.impacted-workloads"},
+		},
+		{
+			name:     "unknown tag matches nothing",
+			patterns: []string{"@slow"},
+			wantIDs:  []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			results, err := registry.ListByPatterns(tt.patterns, "")
+			g.Expect(err).ToNot(HaveOccurred())
+
+			gotIDs := make([]string, 0, len(results))
+			for _, c := range results {
+				gotIDs = append(gotIDs, c.ID())
+			}
+
+			g.Expect(gotIDs).To(ConsistOf(tt.wantIDs))
+
+			matched, err := registry.MatchesAnyCheck(tt.patterns)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(matched).To(Equal(len(tt.wantIDs) > 0))
+		})
+	}
+}
+
+func newTaggedTestCheck(id string, group check.CheckGroup, tags ...string) *statsTestCheck {
+	chk := newStatsTestCheck(id)
+	chk.CheckGroup = group
+	chk.CheckTags = tags
+
+	return chk
+}
+
 func TestCheckRegistry_ListByPatterns_InvalidPattern(t *testing.T) {
 	g := NewWithT(t)
 
@@ -0,0 +1,222 @@
+package check
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+)
+
+// Remediation describes a single machine-actionable change that resolves (part of) a finding.
+// Remediations are planned by checks against read-only cluster state and applied by the
+// lint command only when --fix is requested, so checks never perform writes themselves.
+type Remediation struct {
+	// ResourceType identifies the kind of resource to patch.
+	ResourceType resources.ResourceType
+
+	// Namespace of the resource to patch. Empty for cluster-scoped resources.
+	Namespace string
+
+	// Name of the resource to patch.
+	Name string
+
+	// PatchType is the Kubernetes patch strategy used for Patch.
+	PatchType types.PatchType
+
+	// Patch is the serialized patch document.
+	Patch []byte
+
+	// Description is a short human-readable summary shown in the preview.
+	Description string
+
+	// Command is the kubectl command applying the remediation by hand. When empty,
+	// CommandLine derives a kubectl patch command from Patch.
+	Command string
+}
+
+// Reference returns a kind/namespace/name reference suitable for display.
+func (r Remediation) Reference() string {
+	if r.Namespace == "" {
+		return fmt.Sprintf("%s/%s", r.ResourceType.Kind, r.Name)
+	}
+
+	return fmt.Sprintf("%s %s/%s", r.ResourceType.Kind, r.Namespace, r.Name)
+}
+
+// CommandLine returns a ready-to-run kubectl command applying the remediation.
+func (r Remediation) CommandLine() string {
+	if r.Command != "" {
+		return r.Command
+	}
+
+	return r.PatchCommand()
+}
+
+// PatchCommand renders the remediation as an equivalent `kubectl patch` command.
+func (r Remediation) PatchCommand() string {
+	var b strings.Builder
+
+	b.WriteString("kubectl patch ")
+	b.WriteString(kubectlTarget(r.ResourceType, r.Namespace, r.Name))
+	b.WriteString(" --type=")
+	b.WriteString(patchTypeFlag(r.PatchType))
+	b.WriteString(" -p ")
+	b.WriteString(shellQuote(string(r.Patch)))
+
+	return b.String()
+}
+
+// kubectlTarget returns the kubectl arguments selecting a single resource.
+func kubectlTarget(resourceType resources.ResourceType, namespace string, name string) string {
+	if namespace == "" {
+		return resourceType.CRDFQN() + " " + name
+	}
+
+	return resourceType.CRDFQN() + " " + name + " -n " + namespace
+}
+
+func patchTypeFlag(pt types.PatchType) string {
+	switch pt { //nolint:exhaustive // remediations only use merge, JSON and strategic merge patches
+	case types.JSONPatchType:
+		return "json"
+	case types.StrategicMergePatchType:
+		return "strategic"
+	default:
+		return "merge"
+	}
+}
+
+// shellQuote wraps s in single quotes for POSIX shells.
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
+
+// Remediator is an optional interface checks can implement alongside Validate to expose
+// remediations that `lint --fix` can apply to the cluster.
+//
+// Remediations receives the DiagnosticResult produced by Validate so it can derive the
+// changes from ImpactedObjects without re-querying the cluster. Returning an empty slice
+// means there is nothing to apply automatically.
+type Remediator interface {
+	Remediations(ctx context.Context, target Target, dr *result.DiagnosticResult) ([]Remediation, error)
+}
+
+// RemoveAnnotations builds a merge-patch Remediation that deletes the given annotation keys
+// from a single resource.
+func RemoveAnnotations(
+	resourceType resources.ResourceType,
+	ref types.NamespacedName,
+	keys ...string,
+) (Remediation, error) {
+	annotations := make(map[string]any, len(keys))
+	for _, key := range keys {
+		annotations[key] = nil
+	}
+
+	patch, err := json.Marshal(map[string]any{
+		"metadata": map[string]any{
+			"annotations": annotations,
+		},
+	})
+	if err != nil {
+		return Remediation{}, fmt.Errorf("building annotation removal patch: %w", err)
+	}
+
+	command := "kubectl annotate " + kubectlTarget(resourceType, ref.Namespace, ref.Name)
+	for _, key := range keys {
+		command += " " + key + "-"
+	}
+
+	return Remediation{
+		ResourceType: resourceType,
+		Namespace:    ref.Namespace,
+		Name:         ref.Name,
+		PatchType:    types.MergePatchType,
+		Patch:        patch,
+		Description:  "remove annotation(s) " + strings.Join(keys, ", "),
+		Command:      command,
+	}, nil
+}
+
+// RemoveAnnotationsFromImpacted builds annotation-removal remediations for every impacted
+// object of the given resource type in the diagnostic result.
+func RemoveAnnotationsFromImpacted(
+	dr *result.DiagnosticResult,
+	resourceType resources.ResourceType,
+	keys ...string,
+) ([]Remediation, error) {
+	remediations := make([]Remediation, 0, len(dr.ImpactedObjects))
+
+	for _, obj := range dr.ImpactedObjects {
+		if obj.Kind != resourceType.Kind {
+			continue
+		}
+
+		r, err := RemoveAnnotations(
+			resourceType,
+			types.NamespacedName{Namespace: obj.Namespace, Name: obj.Name},
+			keys...,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		remediations = append(remediations, r)
+	}
+
+	return remediations, nil
+}
+
+// AnnotateRemediationCommands sets the AnnotationObjectRemediationCmd annotation of the impacted
+// objects of failing results whose checks implement Remediator to the command applying their
+// remediation, so findings can be fixed by hand without --fix. Commands set by the checks
+// themselves are kept. Planning errors are logged and leave the objects unannotated.
+func AnnotateRemediationCommands(ctx context.Context, target Target, results []CheckExecution) {
+	for _, exec := range results {
+		if exec.Result == nil || exec.Error != nil || !exec.Result.IsFailing() {
+			continue
+		}
+
+		remediator, ok := exec.Check.(Remediator)
+		if !ok {
+			continue
+		}
+
+		remediations, err := remediator.Remediations(ctx, target, exec.Result)
+		if err != nil {
+			target.Log().Warn("planning remediation commands", "check", exec.Check.ID(), "error", err)
+
+			continue
+		}
+
+		commands := make(map[string][]string, len(remediations))
+		for _, r := range remediations {
+			key := remediationKey(r.ResourceType.Kind, r.Namespace, r.Name)
+			commands[key] = append(commands[key], r.CommandLine())
+		}
+
+		for i := range exec.Result.ImpactedObjects {
+			obj := &exec.Result.ImpactedObjects[i]
+
+			cmds, ok := commands[remediationKey(obj.Kind, obj.Namespace, obj.Name)]
+			if !ok || obj.Annotations[result.AnnotationObjectRemediationCmd] != "" {
+				continue
+			}
+
+			if obj.Annotations == nil {
+				obj.Annotations = make(map[string]string)
+			}
+
+			obj.Annotations[result.AnnotationObjectRemediationCmd] = strings.Join(cmds, " && ")
+		}
+	}
+}
+
+func remediationKey(kind string, namespace string, name string) string {
+	return kind + "/" + namespace + "/" + name
+}
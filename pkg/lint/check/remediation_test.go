@@ -0,0 +1,124 @@
+package check_test
+
+import (
+	"context"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/opendatahub-io/odh-cli/pkg/constants"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+
+	. "github.com/onsi/gomega"
+)
+
+const (
+	testRemediationNamespace = "user-ns"
+	testRemediationName      = "my-notebook"
+	testRemediationPatch     = `{"metadata":{"annotations":{"opendatahub.io/legacy-hardware-profile-name":null}}}`
+)
+
+func TestRemoveAnnotations(t *testing.T) {
+	g := NewWithT(t)
+
+	r, err := check.RemoveAnnotations(
+		resources.Notebook,
+		types.NamespacedName{Namespace: testRemediationNamespace, Name: testRemediationName},
+		constants.AnnotationLegacyHardwareProfile,
+	)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(r).To(And(
+		HaveField("Namespace", testRemediationNamespace),
+		HaveField("Name", testRemediationName),
+		HaveField("PatchType", types.MergePatchType),
+	))
+	g.Expect(string(r.Patch)).To(MatchJSON(testRemediationPatch))
+	g.Expect(r.Description).To(ContainSubstring(constants.AnnotationLegacyHardwareProfile))
+	g.Expect(r.Reference()).To(Equal("Notebook user-ns/my-notebook"))
+	g.Expect(r.CommandLine()).To(Equal(
+		"kubectl annotate notebooks.kubeflow.org my-notebook -n user-ns " + constants.AnnotationLegacyHardwareProfile + "-"))
+}
+
+func TestRemediationCommandLine(t *testing.T) {
+	g := NewWithT(t)
+
+	r := check.Remediation{
+		ResourceType: resources.InferenceService,
+		Namespace:    testRemediationNamespace,
+		Name:         "isvc",
+		PatchType:    types.MergePatchType,
+		Patch:        []byte(`{"metadata":{"labels":{"team":"it's-mine"}}}`),
+	}
+
+	g.Expect(r.CommandLine()).To(Equal(
+		"kubectl patch inferenceservices.serving.kserve.io isvc -n user-ns --type=merge " +
+			`-p '{"metadata":{"labels":{"team":"it'\''s-mine"}}}'`))
+}
+
+func TestRemoveAnnotationsFromImpacted(t *testing.T) {
+	g := NewWithT(t)
+
+	dr := result.New("workload", "notebook", "config-migration", "test")
+	dr.SetImpactedObjects(resources.Notebook, []types.NamespacedName{
+		{Namespace: testRemediationNamespace, Name: testRemediationName},
+	})
+	dr.ImpactedObjects = append(dr.ImpactedObjects, metav1.PartialObjectMetadata{
+		TypeMeta:   resources.InferenceService.TypeMeta(),
+		ObjectMeta: metav1.ObjectMeta{Namespace: testRemediationNamespace, Name: "other"},
+	})
+
+	remediations, err := check.RemoveAnnotationsFromImpacted(dr, resources.Notebook, constants.AnnotationLegacyHardwareProfile)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(remediations).To(HaveLen(1))
+	g.Expect(remediations[0].Name).To(Equal(testRemediationName))
+}
+
+type remediatingTestCheck struct {
+	*statsTestCheck
+}
+
+func (c *remediatingTestCheck) Remediations(
+	_ context.Context,
+	_ check.Target,
+	dr *result.DiagnosticResult,
+) ([]check.Remediation, error) {
+	return check.RemoveAnnotationsFromImpacted(dr, resources.Notebook, constants.AnnotationLegacyHardwareProfile)
+}
+
+func TestAnnotateRemediationCommands(t *testing.T) {
+	g := NewWithT(t)
+
+	failing := result.New("workload", "notebook", "config-migration", "test")
+	failing.SetCondition(check.NewCondition(check.ConditionTypeValidated, metav1.ConditionFalse,
+		check.WithReason("Test"), check.WithImpact(result.ImpactAdvisory)))
+	failing.SetImpactedObjects(resources.Notebook, []types.NamespacedName{
+		{Namespace: testRemediationNamespace, Name: testRemediationName},
+		{Namespace: testRemediationNamespace, Name: "custom"},
+	})
+	failing.ImpactedObjects[1].Annotations = map[string]string{
+		result.AnnotationObjectRemediationCmd: "kubectl delete notebook custom",
+	}
+
+	passing := result.New("workload", "notebook", "config-migration", "test")
+	passing.SetImpactedObjects(resources.Notebook, []types.NamespacedName{
+		{Namespace: testRemediationNamespace, Name: testRemediationName},
+	})
+
+	chk := &remediatingTestCheck{newStatsTestCheck("workloads.test.remediation")}
+
+	check.AnnotateRemediationCommands(t.Context(), check.Target{}, []check.CheckExecution{
+		{Check: chk, Result: failing},
+		{Check: chk, Result: passing},
+	})
+
+	g.Expect(failing.ImpactedObjects[0].Annotations).To(HaveKeyWithValue(
+		result.AnnotationObjectRemediationCmd, ContainSubstring("kubectl annotate notebooks.kubeflow.org my-notebook")))
+	g.Expect(failing.ImpactedObjects[1].Annotations).To(HaveKeyWithValue(
+		result.AnnotationObjectRemediationCmd, "kubectl delete notebook custom"))
+	g.Expect(passing.ImpactedObjects[0].Annotations).ToNot(HaveKey(result.AnnotationObjectRemediationCmd))
+}
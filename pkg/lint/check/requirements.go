@@ -0,0 +1,53 @@
+package check
+
+import (
+	"fmt"
+
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+)
+
+// PlatformResources are read by the lint command itself (version detection) and by the shared
+// validation builders (DSC/DSCI component state, OLM operator lookups), so every check depends
+// on them implicitly.
+//
+//nolint:gochecknoglobals // Static list of resource types shared by all checks.
+var PlatformResources = []resources.ResourceType{
+	resources.DataScienceCluster,
+	resources.DataScienceClusterV1,
+	resources.DSCInitialization,
+	resources.DSCInitializationV1,
+	resources.ClusterVersion,
+	resources.Subscription,
+	resources.ClusterServiceVersion,
+}
+
+// ResourceRequirer is an optional interface checks implement to declare the resource types they
+// read beyond PlatformResources. It drives `lint export-snapshot`, which collects exactly the
+// cluster state needed to run the selected checks offline.
+//
+// BaseCheck implements it from the CheckResources field.
+type ResourceRequirer interface {
+	RequiredResources() []resources.ResourceType
+}
+
+// RequiredResources returns the resource types read by each check matching any of the patterns,
+// keyed by check ID. Checks that do not implement ResourceRequirer map to an empty slice.
+func (r *CheckRegistry) RequiredResources(patterns []string) (map[string][]resources.ResourceType, error) {
+	checks, err := r.ListByPatterns(patterns, "")
+	if err != nil {
+		return nil, fmt.Errorf("listing checks: %w", err)
+	}
+
+	required := make(map[string][]resources.ResourceType, len(checks))
+
+	for _, chk := range checks {
+		var types []resources.ResourceType
+		if requirer, ok := chk.(ResourceRequirer); ok {
+			types = requirer.RequiredResources()
+		}
+
+		required[chk.ID()] = types
+	}
+
+	return required, nil
+}
@@ -0,0 +1,71 @@
+package check_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	mocks "github.com/opendatahub-io/odh-cli/pkg/util/test/mocks/check"
+
+	. "github.com/onsi/gomega"
+)
+
+type requirerCheck struct {
+	check.BaseCheck
+}
+
+func (c *requirerCheck) CanApply(_ context.Context, _ check.Target) (bool, error) {
+	return true, nil
+}
+
+func (c *requirerCheck) Validate(_ context.Context, _ check.Target) (*result.DiagnosticResult, error) {
+	return c.NewResult(), nil
+}
+
+func TestCheckRegistry_RequiredResources(t *testing.T) {
+	g := NewWithT(t)
+
+	registry := check.NewRegistry()
+
+	g.Expect(registry.Register(&requirerCheck{BaseCheck: check.BaseCheck{
+		CheckGroup:     check.GroupWorkload,
+		CheckID:        "workloads.notebook.test",
+		CheckResources: []resources.ResourceType{resources.Notebook, resources.Secret},
+	}})).To(Succeed())
+
+	plain := mocks.NewMockCheck()
+	plain.On("ID").Return("components.plain")
+	plain.On("Group").Return(check.GroupComponent)
+	g.Expect(registry.Register(plain)).To(Succeed())
+
+	t.Run("should return declared resources per check", func(t *testing.T) {
+		g := NewWithT(t)
+
+		required, err := registry.RequiredResources([]string{"*"})
+
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(required).To(HaveLen(2))
+		g.Expect(required["workloads.notebook.test"]).To(Equal([]resources.ResourceType{resources.Notebook, resources.Secret}))
+		g.Expect(required).To(HaveKeyWithValue("components.plain", BeEmpty()))
+	})
+
+	t.Run("should honour selectors", func(t *testing.T) {
+		g := NewWithT(t)
+
+		required, err := registry.RequiredResources([]string{"workloads"})
+
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(required).To(HaveLen(1))
+		g.Expect(required).To(HaveKey("workloads.notebook.test"))
+	})
+
+	t.Run("should reject invalid patterns", func(t *testing.T) {
+		g := NewWithT(t)
+
+		_, err := registry.RequiredResources([]string{"[invalid"})
+
+		g.Expect(err).To(HaveOccurred())
+	})
+}
@@ -0,0 +1,95 @@
+package result
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// fingerprintLength is the number of hex characters kept from the SHA-256 digest.
+const fingerprintLength = 32
+
+// Finding identifies a single non-passing condition of a check, scoped to one impacted
+// object when the result lists any. Findings are the unit recorded in lint baselines.
+type Finding struct {
+	// Fingerprint is a stable hash of CheckID, Condition and Object.
+	Fingerprint string `json:"fingerprint" yaml:"fingerprint"`
+
+	// CheckID is the ID of the check that reported the finding.
+	CheckID string `json:"checkId" yaml:"checkId"`
+
+	// Condition is the condition type of the finding.
+	Condition string `json:"condition" yaml:"condition"`
+
+	// Impact is the impact the finding was reported with (informational, not fingerprinted).
+	Impact Impact `json:"impact" yaml:"impact"`
+
+	// Object identifies the impacted object ("Kind namespace/name", or the UID when known).
+	// Empty for findings that are not about a specific object.
+	Object string `json:"object,omitempty" yaml:"object,omitempty"`
+}
+
+// Findings returns one Finding per non-passing condition and impacted object.
+// Conditions of results without impacted objects yield a single object-less finding.
+// Passing conditions (ImpactNone) produce no findings.
+func (r *DiagnosticResult) Findings(checkID string) []Finding {
+	var findings []Finding
+
+	for _, cond := range r.Status.Conditions {
+		findings = append(findings, r.ConditionFindings(checkID, cond)...)
+	}
+
+	return findings
+}
+
+// ConditionFindings returns the findings of a single condition of the result.
+func (r *DiagnosticResult) ConditionFindings(checkID string, cond Condition) []Finding {
+	if cond.Impact == ImpactNone {
+		return nil
+	}
+
+	if len(r.ImpactedObjects) == 0 {
+		return []Finding{newFinding(checkID, cond, "")}
+	}
+
+	findings := make([]Finding, 0, len(r.ImpactedObjects))
+	for i := range r.ImpactedObjects {
+		findings = append(findings, newFinding(checkID, cond, ObjectIdentity(&r.ImpactedObjects[i])))
+	}
+
+	return findings
+}
+
+// ObjectIdentity returns the identity used to fingerprint an impacted object: its UID when
+// set, otherwise its kind, namespace and name.
+func ObjectIdentity(obj *metav1.PartialObjectMetadata) string {
+	if obj.UID != "" {
+		return string(obj.UID)
+	}
+
+	if obj.Namespace == "" {
+		return obj.Kind + " " + obj.Name
+	}
+
+	return obj.Kind + " " + obj.Namespace + "/" + obj.Name
+}
+
+// FindingFingerprint computes the stable fingerprint of a finding. The impact and message
+// are intentionally excluded so that wording or severity changes do not create new findings.
+func FindingFingerprint(checkID string, conditionType string, object string) string {
+	sum := sha256.Sum256([]byte(strings.Join([]string{checkID, conditionType, object}, "\x00")))
+
+	return hex.EncodeToString(sum[:])[:fingerprintLength]
+}
+
+func newFinding(checkID string, cond Condition, object string) Finding {
+	return Finding{
+		Fingerprint: FindingFingerprint(checkID, cond.Type, object),
+		CheckID:     checkID,
+		Condition:   cond.Type,
+		Impact:      cond.Impact,
+		Object:      object,
+	}
+}
@@ -0,0 +1,25 @@
+package result
+
+// NamespaceSummary aggregates the failing findings of a run for the objects of one namespace.
+type NamespaceSummary struct {
+	Namespace string `json:"namespace" yaml:"namespace"`
+
+	// Requester is the openshift.io/requester annotation of the namespace, if known.
+	Requester string `json:"requester,omitempty" yaml:"requester,omitempty"`
+
+	// Prohibited, Blocking and Advisory count the findings of the namespace by impact.
+	Prohibited int `json:"prohibited" yaml:"prohibited"`
+	Blocking   int `json:"blocking"   yaml:"blocking"`
+	Advisory   int `json:"advisory"   yaml:"advisory"`
+
+	// Findings lists the impacted objects of the namespace, most severe first.
+	Findings []NamespaceFinding `json:"findings" yaml:"findings"`
+}
+
+// NamespaceFinding is an object impacted by a failing check.
+type NamespaceFinding struct {
+	Check  string `json:"check"  yaml:"check"`
+	Impact Impact `json:"impact" yaml:"impact"`
+	Kind   string `json:"kind"   yaml:"kind"`
+	Name   string `json:"name"   yaml:"name"`
+}
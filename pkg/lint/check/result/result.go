@@ -3,6 +3,7 @@ package result
 import (
 	"errors"
 	"fmt"
+	"strconv"
 	"strings"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -30,10 +31,64 @@ const (
 	// forms, avoiding naive derivation from Kind. Especially useful for multi-kind results
 	// where the result-level AnnotationResourceCRDName cannot represent all types.
 	AnnotationObjectCRDName = "result.opendatahub.io/crd-name"
+
+	// AnnotationObjectOwner is an optional per-object annotation key holding the user or team
+	// owning an impacted object, set by check.ResolveOwners so reports can be routed to them.
+	AnnotationObjectOwner = "result.opendatahub.io/owner"
+
+	// AnnotationObjectUsage is an optional per-object annotation key telling whether an impacted
+	// workload is in use (active) or not (idle), set by check.DetectUsage to prioritize remediation.
+	AnnotationObjectUsage = "result.opendatahub.io/usage"
+
+	// AnnotationImpactedCount is the result annotation key holding the number of objects the
+	// check found impacted, for checks reporting more objects than they list as impacted objects.
+	AnnotationImpactedCount = "workload.opendatahub.io/impacted-count"
+
+	// AnnotationObjectRemediationCmd is an optional per-object annotation key holding a
+	// ready-to-run command (e.g. kubectl annotate or kubectl patch) resolving the finding for
+	// the object. Set by checks directly or by check.AnnotateRemediationCommands.
+	AnnotationObjectRemediationCmd = "check.opendatahub.io/remediation-cmd"
+
+	// AnnotationSuppressedCount is the result annotation key holding the number of objects
+	// excluded from the check because they carry a lint-ignore annotation for it.
+	AnnotationSuppressedCount = "result.opendatahub.io/suppressed-count"
+
+	// AnnotationOriginalImpact is the result annotation key holding the impact the check
+	// reported before a severity override rewrote it.
+	AnnotationOriginalImpact = "result.opendatahub.io/original-impact"
+
+	// AnnotationScore is the result annotation key holding the risk score of a failing result
+	// (see DiagnosticResult.Score), set by check.AnnotateScores.
+	AnnotationScore = "result.opendatahub.io/score"
+
+	// AnnotationSummaryPassed, AnnotationSummaryAdvisory, AnnotationSummaryBlocking and
+	// AnnotationSummaryProhibited hold, on a synthesized group summary result, the number of
+	// checks of the group that passed or reported findings of the given impact.
+	AnnotationSummaryPassed     = "summary.opendatahub.io/passed"
+	AnnotationSummaryAdvisory   = "summary.opendatahub.io/advisory"
+	AnnotationSummaryBlocking   = "summary.opendatahub.io/blocking"
+	AnnotationSummaryProhibited = "summary.opendatahub.io/prohibited"
+
+	// AnnotationClusterVersionSource is the result list annotation key holding the source the
+	// cluster version was detected from, e.g. DataScienceCluster or OLM.
+	AnnotationClusterVersionSource = "result.opendatahub.io/cluster-version-source"
+
+	// AnnotationProductFlavor is the result list annotation key holding the product flavor of
+	// the cluster, ODH or RHOAI; absent when it could not be detected.
+	AnnotationProductFlavor = "result.opendatahub.io/product-flavor"
+)
+
+const (
+	// ObjectUsageActive is the AnnotationObjectUsage value of impacted workloads in use.
+	ObjectUsageActive = "active"
+
+	// ObjectUsageIdle is the AnnotationObjectUsage value of idle impacted workloads.
+	ObjectUsageIdle = "idle"
 )
 
 const (
-	diagnosticResultListKind = "DiagnosticResultList"
+	// DiagnosticResultListKind is the envelope kind of lint reports.
+	DiagnosticResultListKind = "DiagnosticResultList"
 )
 
 const (
@@ -59,6 +114,17 @@ const (
 	ImpactNone       Impact = ""           // No impact (omitted from JSON/YAML)
 )
 
+// Impact weights of the risk score, an order of magnitude apart so a finding impacting a few
+// objects outranks a finding of the next lower impact impacting as many.
+const (
+	scoreWeightProhibited = 100
+	scoreWeightBlocking   = 10
+	scoreWeightAdvisory   = 1
+
+	// scoreInUseFactor multiplies the score of findings impacting workloads in use.
+	scoreInUseFactor = 2
+)
+
 // Condition represents a diagnostic condition with severity level.
 // It embeds metav1.Condition and adds Impact and Remediation fields to indicate
 // the impact level and remediation guidance of the condition result.
@@ -72,6 +138,21 @@ type Condition struct {
 	// Remediation provides actionable guidance on how to resolve the condition.
 	// Set via WithRemediation option during condition creation.
 	Remediation string `json:"remediation,omitempty" yaml:"remediation,omitempty"`
+
+	// MessageID identifies Message in the message catalog (see package messages). It is
+	// stable across --lang values, so programmatic consumers can match on it.
+	// Set via WithCatalogMessage option during condition creation.
+	MessageID string `json:"messageID,omitempty" yaml:"messageID,omitempty"`
+
+	// MessageArgs are the arguments Message was formatted with, kept to translate it.
+	MessageArgs []any `json:"-" yaml:"-"`
+
+	// RemediationID identifies Remediation in the message catalog, like MessageID.
+	// Set via WithCatalogRemediation option, or by the executor for the remediation of the check.
+	RemediationID string `json:"remediationID,omitempty" yaml:"remediationID,omitempty"`
+
+	// RemediationArgs are the arguments Remediation was formatted with, kept to translate it.
+	RemediationArgs []any `json:"-" yaml:"-"`
 }
 
 // Validate ensures the condition has valid Status/Impact combination.
@@ -289,6 +370,58 @@ func (r *DiagnosticResult) GetImpact() Impact {
 	return maxImpact
 }
 
+// Score returns the risk score of the result, the score of a finding of its highest impact
+// (see ScoreFor). Passing results score 0.
+func (r *DiagnosticResult) Score() int {
+	return r.ScoreFor(r.GetImpact())
+}
+
+// ScoreFor returns the risk score of a finding of the result with the given impact, ranking the
+// value of its remediation: impact weight × impacted object count × in-use factor. The count is
+// the number of impacted objects, or AnnotationImpactedCount when higher, and at least 1 for
+// findings not tied to objects. The in-use factor doubles the score when an impacted object is
+// in use (see AnnotationObjectUsage).
+func (r *DiagnosticResult) ScoreFor(impact Impact) int {
+	var weight int
+
+	switch impact {
+	case ImpactProhibited:
+		weight = scoreWeightProhibited
+	case ImpactBlocking:
+		weight = scoreWeightBlocking
+	case ImpactAdvisory:
+		weight = scoreWeightAdvisory
+	case ImpactNone:
+		return 0
+	}
+
+	count := len(r.ImpactedObjects)
+	if annotated, err := strconv.Atoi(r.Annotations[AnnotationImpactedCount]); err == nil {
+		count = max(count, annotated)
+	}
+
+	score := weight * max(count, 1)
+
+	for _, obj := range r.ImpactedObjects {
+		if obj.Annotations[AnnotationObjectUsage] == ObjectUsageActive {
+			return score * scoreInUseFactor
+		}
+	}
+
+	return score
+}
+
+// SuppressedCount returns the number of objects excluded via lint-ignore annotations,
+// as recorded in AnnotationSuppressedCount. Returns 0 if the annotation is absent or invalid.
+func (r *DiagnosticResult) SuppressedCount() int {
+	n, err := strconv.Atoi(r.Annotations[AnnotationSuppressedCount])
+	if err != nil {
+		return 0
+	}
+
+	return n
+}
+
 // GetRemediation returns remediation guidance from the first condition that has it set.
 func (r *DiagnosticResult) GetRemediation() string {
 	for _, cond := range r.Status.Conditions {
@@ -396,15 +529,21 @@ type DiagnosticResultList struct {
 	TargetVersion    *string             `json:"targetVersion,omitempty"    jsonschema:"description=The target version for upgrade assessment" yaml:"targetVersion,omitempty"`
 	OpenShiftVersion *string             `json:"openShiftVersion,omitempty" jsonschema:"description=The OpenShift platform version"            yaml:"openShiftVersion,omitempty"`
 	Results          []*DiagnosticResult `json:"results"                    jsonschema:"description=Array of diagnostic check results"         yaml:"results"`
+	Suppressed       int                 `json:"suppressed,omitempty"       jsonschema:"description=Objects excluded via lint-ignore"          yaml:"suppressed,omitempty"`
+	Namespaces       []NamespaceSummary  `json:"namespaces,omitempty"       jsonschema:"description=Findings grouped by namespace"             yaml:"namespaces,omitempty"`
+	Summaries        []*DiagnosticResult `json:"summaries,omitempty"        jsonschema:"description=Synthesized roll-up result per check group" yaml:"summaries,omitempty"`
+	Annotations      map[string]string   `json:"annotations,omitempty"      jsonschema:"description=Metadata about the run with domain-qualified keys" yaml:"annotations,omitempty"`
 }
 
 // ComputeStatus calculates the Status based on Results.
 func (l *DiagnosticResultList) ComputeStatus() {
 	var warnings, errs int
+	l.Suppressed = 0
 	for _, r := range l.Results {
 		if r == nil {
 			continue
 		}
+		l.Suppressed += r.SuppressedCount()
 		impact := r.GetImpact()
 		switch impact {
 		case ImpactProhibited, ImpactBlocking:
@@ -425,7 +564,7 @@ func NewDiagnosticResultList(
 	openShiftVersion *string,
 ) *DiagnosticResultList {
 	return &DiagnosticResultList{
-		Envelope:         output.NewEnvelope(diagnosticResultListKind, "lint"),
+		Envelope:         output.NewEnvelope(DiagnosticResultListKind, "lint"),
 		ClusterVersion:   clusterVersion,
 		TargetVersion:    targetVersion,
 		OpenShiftVersion: openShiftVersion,
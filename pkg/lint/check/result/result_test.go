@@ -1083,3 +1083,139 @@ func TestDiagnosticResultList_ComputeStatus_NilResults(t *testing.T) {
 	g.Expect(list.Status).ToNot(BeNil())
 	g.Expect(list.Status.Result).To(Equal(output.StatusSuccess))
 }
+
+func TestDiagnosticResultList_ComputeStatus_SumsSuppressed(t *testing.T) {
+	g := NewWithT(t)
+
+	suppressed := result.New("workload", "notebook", "impacted-workloads", "description")
+	suppressed.Annotations[result.AnnotationSuppressedCount] = "2"
+
+	invalid := result.New("workload", "ray", "impacted-workloads", "description")
+	invalid.Annotations[result.AnnotationSuppressedCount] = "many"
+
+	list := result.NewDiagnosticResultList(nil, nil, nil)
+	list.Results = append(list.Results, suppressed, invalid, result.New("component", "test", "check", "description"))
+
+	list.ComputeStatus()
+
+	g.Expect(invalid.SuppressedCount()).To(Equal(0))
+	g.Expect(list.Suppressed).To(Equal(2))
+}
+
+// Finding fingerprint tests
+
+func TestFindingFingerprint_Stable(t *testing.T) {
+	g := NewWithT(t)
+
+	fp := result.FindingFingerprint("workloads.notebook.impacted", check.ConditionTypeCompatible, "Notebook ns1/nb")
+
+	g.Expect(fp).To(HaveLen(32))
+	g.Expect(result.FindingFingerprint("workloads.notebook.impacted", check.ConditionTypeCompatible, "Notebook ns1/nb")).To(Equal(fp))
+	g.Expect(result.FindingFingerprint("workloads.notebook.impacted", check.ConditionTypeCompatible, "Notebook ns1/other")).ToNot(Equal(fp))
+	g.Expect(result.FindingFingerprint("workloads.notebook.impacted", check.ConditionTypeValidated, "Notebook ns1/nb")).ToNot(Equal(fp))
+}
+
+func TestDiagnosticResult_Findings(t *testing.T) {
+	g := NewWithT(t)
+
+	dr := result.New("workload", "notebook", "impacted", "description")
+	dr.Status.Conditions = []result.Condition{
+		check.NewCondition(check.ConditionTypeValidated, metav1.ConditionTrue, check.WithReason(check.ReasonRequirementsMet)),
+		check.NewCondition(check.ConditionTypeCompatible, metav1.ConditionFalse,
+			check.WithReason(check.ReasonVersionIncompatible), check.WithImpact(result.ImpactBlocking)),
+	}
+	dr.AddImpactedObjects(resources.Notebook, []types.NamespacedName{
+		{Namespace: "ns1", Name: "a"},
+		{Namespace: "ns2", Name: "b"},
+	})
+	dr.ImpactedObjects[1].UID = "uid-b"
+
+	findings := dr.Findings("workloads.notebook.impacted")
+
+	g.Expect(findings).To(HaveLen(2))
+	g.Expect(findings[0].Object).To(Equal("Notebook ns1/a"))
+	g.Expect(findings[0].Impact).To(Equal(result.ImpactBlocking))
+	g.Expect(findings[1].Object).To(Equal("uid-b"))
+	g.Expect(findings[1].Fingerprint).To(Equal(
+		result.FindingFingerprint("workloads.notebook.impacted", check.ConditionTypeCompatible, "uid-b")))
+}
+
+func TestDiagnosticResult_Findings_NoImpactedObjects(t *testing.T) {
+	g := NewWithT(t)
+
+	dr := result.New("component", "kserve", "removal", "description")
+	dr.Status.Conditions = []result.Condition{
+		check.NewCondition(check.ConditionTypeCompatible, metav1.ConditionFalse,
+			check.WithReason(check.ReasonVersionIncompatible), check.WithImpact(result.ImpactAdvisory)),
+	}
+
+	findings := dr.Findings("components.kserve.removal")
+
+	g.Expect(findings).To(HaveLen(1))
+	g.Expect(findings[0].Object).To(BeEmpty())
+	g.Expect(findings[0].Condition).To(Equal(check.ConditionTypeCompatible))
+}
+
+func TestDiagnosticResult_Score(t *testing.T) {
+	newResult := func(impact result.Impact, objects int) *result.DiagnosticResult {
+		dr := result.New("workload", "notebook", "impacted", "description")
+		dr.Status.Conditions = []result.Condition{
+			check.NewCondition(check.ConditionTypeCompatible, metav1.ConditionFalse,
+				check.WithReason(check.ReasonVersionIncompatible), check.WithImpact(impact)),
+		}
+
+		for i := range objects {
+			dr.AddImpactedObjects(resources.Notebook, []types.NamespacedName{
+				{Namespace: "ns", Name: string(rune('a' + i))},
+			})
+		}
+
+		return dr
+	}
+
+	t.Run("should weigh impact by impacted object count", func(t *testing.T) {
+		g := NewWithT(t)
+
+		g.Expect(newResult(result.ImpactAdvisory, 3).Score()).To(Equal(3))
+		g.Expect(newResult(result.ImpactBlocking, 3).Score()).To(Equal(30))
+		g.Expect(newResult(result.ImpactProhibited, 3).Score()).To(Equal(300))
+	})
+
+	t.Run("should count findings without impacted objects once", func(t *testing.T) {
+		g := NewWithT(t)
+
+		g.Expect(newResult(result.ImpactBlocking, 0).Score()).To(Equal(10))
+	})
+
+	t.Run("should use the impacted count annotation when higher", func(t *testing.T) {
+		g := NewWithT(t)
+
+		dr := newResult(result.ImpactBlocking, 1)
+		dr.Annotations[result.AnnotationImpactedCount] = "40"
+
+		g.Expect(dr.Score()).To(Equal(400))
+	})
+
+	t.Run("should double the score when an impacted object is in use", func(t *testing.T) {
+		g := NewWithT(t)
+
+		dr := newResult(result.ImpactBlocking, 2)
+		dr.ImpactedObjects[0].Annotations = map[string]string{result.AnnotationObjectUsage: result.ObjectUsageIdle}
+		g.Expect(dr.Score()).To(Equal(20))
+
+		dr.ImpactedObjects[1].Annotations = map[string]string{result.AnnotationObjectUsage: result.ObjectUsageActive}
+		g.Expect(dr.Score()).To(Equal(40))
+	})
+
+	t.Run("should score passing results and conditions 0", func(t *testing.T) {
+		g := NewWithT(t)
+
+		dr := result.New("workload", "notebook", "impacted", "description")
+		dr.Status.Conditions = []result.Condition{
+			check.NewCondition(check.ConditionTypeValidated, metav1.ConditionTrue, check.WithReason(check.ReasonRequirementsMet)),
+		}
+
+		g.Expect(dr.Score()).To(BeZero())
+		g.Expect(newResult(result.ImpactBlocking, 2).ScoreFor(result.ImpactNone)).To(BeZero())
+	})
+}
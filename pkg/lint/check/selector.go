@@ -3,6 +3,8 @@ package check
 import (
 	"fmt"
 	"path"
+	"slices"
+	"strings"
 )
 
 // Selector shortcut names used in CLI --checks flag.
@@ -15,10 +17,76 @@ const (
 	SelectorWorkloads    = "workloads"
 )
 
+// Selector prefixes used in CLI --checks flag.
+const (
+	// SelectorExclude prefixes a pattern whose matching checks are excluded, e.g. "!workloads.*".
+	SelectorExclude = "!"
+
+	// SelectorTag prefixes a tag selecting the checks declaring it, e.g. "@fast".
+	SelectorTag = "@"
+)
+
+// TagDeclarer is an optional interface checks implement to declare the tags they can be
+// selected by with "@<tag>", e.g. TagFast.
+//
+// BaseCheck implements it from the CheckTags field.
+type TagDeclarer interface {
+	Tags() []string
+}
+
+// tagsOf returns the tags declared by the check, if any.
+func tagsOf(chk Check) []string {
+	if t, ok := chk.(TagDeclarer); ok {
+		return t.Tags()
+	}
+
+	return nil
+}
+
+// splitPatterns separates the exclusion patterns (prefixed with "!") from the inclusion
+// patterns. Without inclusion patterns, all checks are included.
+func splitPatterns(patterns []string) ([]string, []string) {
+	var include, exclude []string
+
+	for _, pattern := range patterns {
+		if excluded, ok := strings.CutPrefix(pattern, SelectorExclude); ok {
+			exclude = append(exclude, excluded)
+		} else {
+			include = append(include, pattern)
+		}
+	}
+
+	if len(include) == 0 {
+		include = []string{"*"}
+	}
+
+	return include, exclude
+}
+
+// selectsCheck returns true if the check matches any inclusion pattern and no exclusion pattern.
+func selectsCheck(check Check, include []string, exclude []string) (bool, error) {
+	for _, pattern := range exclude {
+		matched, err := matchesPattern(check, pattern)
+		if err != nil || matched {
+			return false, err
+		}
+	}
+
+	for _, pattern := range include {
+		matched, err := matchesPattern(check, pattern)
+		if err != nil || matched {
+			return matched, err
+		}
+	}
+
+	return false, nil
+}
+
 // matchesPattern returns true if the check matches the selector pattern
 // Pattern can be:
 //   - Wildcard: "*" matches all checks
 //   - Group shortcut: "components", "services", "workloads", "dependencies", "platform"
+//   - Tag: "@fast" matches checks declaring the tag
 //   - Exact ID: "components.dashboard"
 //   - Glob pattern: "components.*", "*dashboard*", "*.dashboard"
 func matchesPattern(check Check, pattern string) (bool, error) {
@@ -27,6 +95,10 @@ func matchesPattern(check Check, pattern string) (bool, error) {
 		return true, nil
 	}
 
+	if tag, ok := strings.CutPrefix(pattern, SelectorTag); ok {
+		return slices.Contains(tagsOf(check), tag), nil
+	}
+
 	// Group shortcuts
 	switch pattern {
 	case SelectorComponents:
@@ -0,0 +1,24 @@
+package check
+
+import (
+	"strings"
+
+	"github.com/opendatahub-io/odh-cli/pkg/constants"
+)
+
+// IsLintIgnored reports whether the annotations acknowledge findings of the given check via
+// the lint-ignore annotation (a comma-separated list of check IDs).
+func IsLintIgnored(annotations map[string]string, checkID string) bool {
+	value, ok := annotations[constants.AnnotationLintIgnore]
+	if !ok {
+		return false
+	}
+
+	for id := range strings.SplitSeq(value, ",") {
+		if strings.TrimSpace(id) == checkID {
+			return true
+		}
+	}
+
+	return false
+}
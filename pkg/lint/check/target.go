@@ -1,18 +1,26 @@
 package check
 
 import (
+	"log/slog"
+
 	"github.com/blang/semver/v4"
 
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/messages"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/compat"
 	"github.com/opendatahub-io/odh-cli/pkg/util/client"
 	"github.com/opendatahub-io/odh-cli/pkg/util/iostreams"
+	"github.com/opendatahub-io/odh-cli/pkg/util/logging"
+	"github.com/opendatahub-io/odh-cli/pkg/util/version"
 )
 
 // Target holds all context needed for executing diagnostic checks, including cluster version and optional resource.
 type Target struct {
 	// Client provides read-only access to Kubernetes API for querying resources.
 	// Uses the Reader interface to enforce that lint checks cannot perform write operations.
+	// The lint command passes a per-run caching reader (client.NewCachingReader), so checks
+	// may list the same resources without adding API calls; returned objects are copies.
 	Client client.Reader
 
 	// CurrentVersion contains the current/source cluster version as parsed semver
@@ -27,11 +35,37 @@ type Target struct {
 	// Nil if no target version available
 	TargetVersion *semver.Version
 
+	// Flavor is the product flavor of the cluster, ODH or RHOAI (optional)
+	// FlavorUnknown when it could not be detected; checks declaring flavors then apply
+	Flavor version.Flavor
+
 	// Resource is the specific resource being validated (optional)
 	// Only set for workload checks that operate on discovered CRs
 	// Nil for component and service checks
 	Resource *unstructured.Unstructured
 
+	// LabelSelector restricts the objects listed by workload checks (optional)
+	// Applied by the validate.Workloads builders; empty selects all objects
+	LabelSelector string
+
+	// Deep enables pod-level validation (optional)
+	// Workload checks built with validate.WithPods also inspect the Pods backing the resources
+	// they flag, e.g. for images running out of sync with the spec or restart loops
+	Deep bool
+
+	// LowMemory trades API calls for memory (optional)
+	// Workload builders with a metadata filter list metadata only and get the full objects of
+	// the matching items one by one, instead of listing every full object
+	LowMemory bool
+
+	// CompatMatrix holds the release compatibility data consulted by checks (optional)
+	// Set from --compat-matrix; nil uses the matrix embedded in the binary (see Compat)
+	CompatMatrix *compat.Matrix
+
+	// Messages formats the messages of the message catalog (optional)
+	// Set from --lang; the zero value formats English
+	Messages messages.Printer
+
 	// IO provides access to input/output streams for logging (optional)
 	// Used by checks to log warnings (e.g., permission errors) when verbose mode is enabled
 	// If nil, checks should skip logging
@@ -41,4 +75,26 @@ type Target struct {
 	// When true, checks should emit internal processing logs for troubleshooting
 	// When false, only user-facing summary information should be logged via IO
 	Debug bool
+
+	// Logger receives the structured diagnostics of checks (optional)
+	// The executor adds the check ID to every record; use Log to get a non-nil logger
+	Logger *slog.Logger
+}
+
+// Log returns the logger of the run, or a logger dropping every record when none is set.
+func (t Target) Log() *slog.Logger {
+	if t.Logger == nil {
+		return logging.Discard()
+	}
+
+	return t.Logger
+}
+
+// Compat returns the compatibility matrix for the run, falling back to the embedded default.
+func (t Target) Compat() *compat.Matrix {
+	if t.CompatMatrix == nil {
+		return compat.Default()
+	}
+
+	return t.CompatMatrix
 }
@@ -0,0 +1,235 @@
+package check
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+	"github.com/opendatahub-io/odh-cli/pkg/util/jq"
+)
+
+// Usage tells whether an impacted workload is in use, to prioritize the remediation of
+// findings on workloads users depend on over idle ones.
+type Usage string
+
+const (
+	// UsageUnknown is returned when the usage of an object cannot be determined.
+	UsageUnknown Usage = ""
+
+	// UsageActive marks a workload that is running or serving.
+	UsageActive Usage = result.ObjectUsageActive
+
+	// UsageIdle marks a workload that is stopped, scaled to zero, inactive or completed.
+	UsageIdle Usage = result.ObjectUsageIdle
+)
+
+// Metadata keys and defaults the default usage detectors read.
+const (
+	// AnnotationNotebookStopped is present on stopped Notebooks.
+	AnnotationNotebookStopped = "kubeflow-resource-stopped"
+
+	// AnnotationNotebookLastActivity is the RFC3339 time of the last kernel activity of a
+	// Notebook, maintained by the notebook culler.
+	AnnotationNotebookLastActivity = "notebooks.kubeflow.org/last-activity"
+
+	// AnnotationInferenceServiceStop marks an InferenceService whose predictor is scaled down.
+	AnnotationInferenceServiceStop = "serving.kserve.io/stop"
+
+	// LabelInferenceService is set by KServe on the predictor pods of an InferenceService.
+	LabelInferenceService = "serving.kserve.io/inferenceservice"
+
+	// DefaultNotebookIdleAfter is the inactivity after which a running Notebook is idle,
+	// matching the default of the notebook culler.
+	DefaultNotebookIdleAfter = 24 * time.Hour
+)
+
+// UsageDetector detects whether impacted objects are in use. Detectors are tried in order by
+// DetectUsage until one can tell.
+type UsageDetector interface {
+	// DetectUsage returns the usage of the object, or UsageUnknown when the detector does not
+	// apply to it or the state it needs cannot be read.
+	DetectUsage(ctx context.Context, r client.Reader, obj metav1.PartialObjectMetadata) Usage
+}
+
+// NotebookUsage detects the usage of Notebooks: stopped ones are idle, as are running ones
+// without kernel activity for IdleAfter.
+type NotebookUsage struct {
+	// IdleAfter is the inactivity after which a running Notebook is idle; 0 only considers
+	// stopped Notebooks idle.
+	IdleAfter time.Duration
+
+	// Now returns the current time; nil for time.Now.
+	Now func() time.Time
+}
+
+// DetectUsage implements UsageDetector.
+func (d NotebookUsage) DetectUsage(ctx context.Context, r client.Reader, obj metav1.PartialObjectMetadata) Usage {
+	if !isKind(obj, resources.Notebook) {
+		return UsageUnknown
+	}
+
+	meta, err := r.GetResourceMetadata(ctx, resources.Notebook, obj.Name, client.InNamespace(obj.Namespace))
+	if err != nil {
+		return UsageUnknown
+	}
+
+	if _, stopped := meta.Annotations[AnnotationNotebookStopped]; stopped {
+		return UsageIdle
+	}
+
+	lastActivity, err := time.Parse(time.RFC3339, meta.Annotations[AnnotationNotebookLastActivity])
+	if d.IdleAfter <= 0 || err != nil {
+		return UsageActive
+	}
+
+	now := time.Now
+	if d.Now != nil {
+		now = d.Now
+	}
+
+	if now().Sub(lastActivity) > d.IdleAfter {
+		return UsageIdle
+	}
+
+	return UsageActive
+}
+
+// InferenceServiceUsage detects the usage of InferenceServices: those with a running predictor
+// pod are active, stopped or scaled-to-zero ones are idle.
+type InferenceServiceUsage struct{}
+
+// DetectUsage implements UsageDetector.
+func (InferenceServiceUsage) DetectUsage(ctx context.Context, r client.Reader, obj metav1.PartialObjectMetadata) Usage {
+	if !isKind(obj, resources.InferenceService) {
+		return UsageUnknown
+	}
+
+	meta, err := r.GetResourceMetadata(ctx, resources.InferenceService, obj.Name, client.InNamespace(obj.Namespace))
+	if err != nil {
+		return UsageUnknown
+	}
+
+	if meta.Annotations[AnnotationInferenceServiceStop] == "true" {
+		return UsageIdle
+	}
+
+	// Listing every predictor pod of the namespace lets the reader cache serve its other services
+	pods, err := r.List(ctx, resources.Pod,
+		client.WithNamespace(obj.Namespace), client.WithLabelSelector(LabelInferenceService))
+	if err != nil {
+		return UsageUnknown
+	}
+
+	for _, pod := range pods {
+		phase, err := jq.Query[string](pod, ".status.phase")
+		if err != nil && !errors.Is(err, jq.ErrNotFound) {
+			return UsageUnknown
+		}
+
+		if pod.GetLabels()[LabelInferenceService] == obj.Name && phase == string(corev1.PodRunning) {
+			return UsageActive
+		}
+	}
+
+	return UsageIdle
+}
+
+// JobUsage detects the usage of training jobs: completed (succeeded or failed) jobs are idle,
+// others active.
+type JobUsage struct {
+	// ResourceTypes are the job types the detector applies to.
+	ResourceTypes []resources.ResourceType
+}
+
+// DetectUsage implements UsageDetector.
+func (d JobUsage) DetectUsage(ctx context.Context, r client.Reader, obj metav1.PartialObjectMetadata) Usage {
+	for _, rt := range d.ResourceTypes {
+		if !isKind(obj, rt) {
+			continue
+		}
+
+		job, err := r.GetResource(ctx, rt, obj.Name, client.InNamespace(obj.Namespace))
+		if err != nil {
+			return UsageUnknown
+		}
+
+		conditions, err := jq.Query[[]any](job, ".status.conditions")
+		if err != nil && !errors.Is(err, jq.ErrNotFound) {
+			return UsageUnknown
+		}
+
+		for _, c := range conditions {
+			condition, ok := c.(map[string]any)
+			if !ok {
+				continue
+			}
+
+			if (condition["type"] == "Succeeded" || condition["type"] == "Failed") && condition["status"] == "True" {
+				return UsageIdle
+			}
+		}
+
+		return UsageActive
+	}
+
+	return UsageUnknown
+}
+
+// DefaultUsageDetectors returns the usage detectors used by lint, for Notebooks,
+// InferenceServices and training jobs.
+func DefaultUsageDetectors() []UsageDetector {
+	return []UsageDetector{
+		NotebookUsage{IdleAfter: DefaultNotebookIdleAfter},
+		InferenceServiceUsage{},
+		JobUsage{ResourceTypes: []resources.ResourceType{resources.PyTorchJob, resources.TFJob}},
+	}
+}
+
+// DetectUsage sets the AnnotationObjectUsage annotation of every impacted object to the usage
+// returned by the first detector that can tell. Objects no detector can tell are left
+// unannotated. As with ResolveOwners, r should cache reads.
+func DetectUsage(ctx context.Context, r client.Reader, results []CheckExecution, detectors []UsageDetector) {
+	annotateImpactedObjects(results, result.AnnotationObjectUsage, func(obj metav1.PartialObjectMetadata) string {
+		for _, detector := range detectors {
+			if usage := detector.DetectUsage(ctx, r, obj); usage != UsageUnknown {
+				return string(usage)
+			}
+		}
+
+		return ""
+	})
+}
+
+// AnnotateScores sets the AnnotationScore annotation of every failing result to its risk score
+// (see result.DiagnosticResult.Score), so structured output can be ranked like --sort-by score.
+// Call it after DetectUsage, as objects in use double the score.
+func AnnotateScores(results []CheckExecution) {
+	for _, exec := range results {
+		if exec.Result == nil {
+			continue
+		}
+
+		score := exec.Result.Score()
+		if score == 0 {
+			continue
+		}
+
+		if exec.Result.Annotations == nil {
+			exec.Result.Annotations = make(map[string]string)
+		}
+
+		exec.Result.Annotations[result.AnnotationScore] = strconv.Itoa(score)
+	}
+}
+
+// isKind reports whether obj is of the given resource type.
+func isKind(obj metav1.PartialObjectMetadata, rt resources.ResourceType) bool {
+	return obj.GroupVersionKind().GroupKind() == rt.GVK().GroupKind()
+}
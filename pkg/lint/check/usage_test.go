@@ -0,0 +1,139 @@
+package check_test
+
+import (
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	metadatafake "k8s.io/client-go/metadata/fake"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+	"github.com/opendatahub-io/odh-cli/pkg/util/kube"
+
+	. "github.com/onsi/gomega"
+)
+
+//nolint:gochecknoglobals // Test fixture - shared across test functions
+var usageListKinds = map[schema.GroupVersionResource]string{
+	resources.Pod.GVR():        resources.Pod.ListKind(),
+	resources.PyTorchJob.GVR(): resources.PyTorchJob.ListKind(),
+}
+
+func newUsageTestPod(namespace, name, isvc, phase string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "v1",
+		"kind":       "Pod",
+		"metadata": map[string]any{
+			"name":      name,
+			"namespace": namespace,
+			"labels":    map[string]any{check.LabelInferenceService: isvc},
+		},
+		"status": map[string]any{"phase": phase},
+	}}
+}
+
+func newUsageTestJob(namespace, name string, conditions ...any) *unstructured.Unstructured {
+	job := newOwnerTestObject(resources.PyTorchJob, namespace, name, nil, nil)
+	job.Object["status"] = map[string]any{"conditions": conditions}
+
+	return job
+}
+
+func TestDetectUsage(t *testing.T) {
+	g := NewWithT(t)
+
+	now := time.Date(2026, 1, 10, 12, 0, 0, 0, time.UTC)
+
+	metadataObjects := kube.ToPartialObjectMetadata(
+		newOwnerTestObject(resources.Notebook, "ns", "nb-stopped", nil,
+			map[string]any{check.AnnotationNotebookStopped: now.Format(time.RFC3339)}),
+		newOwnerTestObject(resources.Notebook, "ns", "nb-inactive", nil,
+			map[string]any{check.AnnotationNotebookLastActivity: now.Add(-48 * time.Hour).Format(time.RFC3339)}),
+		newOwnerTestObject(resources.Notebook, "ns", "nb-active", nil,
+			map[string]any{check.AnnotationNotebookLastActivity: now.Add(-time.Hour).Format(time.RFC3339)}),
+		newOwnerTestObject(resources.InferenceService, "ns", "isvc-serving", nil, nil),
+		newOwnerTestObject(resources.InferenceService, "ns", "isvc-scaled-down", nil, nil),
+		newOwnerTestObject(resources.InferenceService, "ns", "isvc-stopped", nil,
+			map[string]any{check.AnnotationInferenceServiceStop: "true"}),
+	)
+
+	scheme := runtime.NewScheme()
+	_ = metav1.AddMetaToScheme(scheme)
+	reader := client.NewForTesting(client.TestClientConfig{
+		Metadata: metadatafake.NewSimpleMetadataClient(scheme, metadataObjects...),
+		Dynamic: dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), usageListKinds,
+			newUsageTestPod("ns", "isvc-serving-predictor", "isvc-serving", "Running"),
+			newUsageTestPod("ns", "isvc-scaled-down-predictor", "isvc-scaled-down", "Succeeded"),
+			newUsageTestJob("ns", "job-done", map[string]any{"type": "Succeeded", "status": "True"}),
+			newUsageTestJob("ns", "job-running", map[string]any{"type": "Running", "status": "True"}),
+		),
+	})
+
+	dr := result.New("workload", "notebook", "usage", "test")
+	dr.ImpactedObjects = []metav1.PartialObjectMetadata{
+		impactedObject(resources.Notebook, "ns", "nb-stopped"),
+		impactedObject(resources.Notebook, "ns", "nb-inactive"),
+		impactedObject(resources.Notebook, "ns", "nb-active"),
+		impactedObject(resources.InferenceService, "ns", "isvc-serving"),
+		impactedObject(resources.InferenceService, "ns", "isvc-scaled-down"),
+		impactedObject(resources.InferenceService, "ns", "isvc-stopped"),
+		impactedObject(resources.PyTorchJob, "ns", "job-done"),
+		impactedObject(resources.PyTorchJob, "ns", "job-running"),
+		impactedObject(resources.RayCluster, "ns", "ray"),
+	}
+
+	detectors := []check.UsageDetector{
+		check.NotebookUsage{IdleAfter: check.DefaultNotebookIdleAfter, Now: func() time.Time { return now }},
+		check.InferenceServiceUsage{},
+		check.JobUsage{ResourceTypes: []resources.ResourceType{resources.PyTorchJob}},
+	}
+
+	check.DetectUsage(t.Context(), reader, []check.CheckExecution{{Result: dr}}, detectors)
+
+	usage := make(map[string]string, len(dr.ImpactedObjects))
+	for _, obj := range dr.ImpactedObjects {
+		usage[obj.Name] = obj.Annotations[result.AnnotationObjectUsage]
+	}
+
+	g.Expect(usage).To(Equal(map[string]string{
+		"nb-stopped":       "idle",
+		"nb-inactive":      "idle",
+		"nb-active":        "active",
+		"isvc-serving":     "active",
+		"isvc-scaled-down": "idle",
+		"isvc-stopped":     "idle",
+		"job-done":         "idle",
+		"job-running":      "active",
+		"ray":              "",
+	}))
+}
+
+func TestAnnotateScores(t *testing.T) {
+	g := NewWithT(t)
+
+	failing := result.New("workload", "notebook", "failing", "test")
+	failing.SetCondition(check.NewCondition("Compatible", metav1.ConditionFalse,
+		check.WithReason("Incompatible"), check.WithMessage("incompatible"), check.WithImpact(result.ImpactBlocking)))
+	failing.ImpactedObjects = []metav1.PartialObjectMetadata{
+		impactedObject(resources.Notebook, "ns", "nb-a"),
+		impactedObject(resources.Notebook, "ns", "nb-b"),
+	}
+	failing.ImpactedObjects[0].Annotations = map[string]string{result.AnnotationObjectUsage: result.ObjectUsageActive}
+
+	passing := result.New("workload", "notebook", "passing", "test")
+	passing.SetCondition(check.NewCondition("Compatible", metav1.ConditionTrue,
+		check.WithReason("Compatible"), check.WithMessage("compatible")))
+
+	check.AnnotateScores([]check.CheckExecution{{Result: failing}, {Result: passing}, {}})
+
+	// Blocking (10) x 2 impacted objects x 2 as one of them is in use
+	g.Expect(failing.Annotations).To(HaveKeyWithValue(result.AnnotationScore, "40"))
+	g.Expect(passing.Annotations).ToNot(HaveKey(result.AnnotationScore))
+}
@@ -94,3 +94,50 @@ func FilterWorkloadsWithAcceleratorRefs(
 
 	return impacted, missingCount, nil
 }
+
+// CountAcceleratorProfileRefs lists workloads of the given resource types and counts, per
+// AcceleratorProfile, the workloads referencing it via annotations. References without a
+// namespace annotation resolve to the applications namespace. Resource types whose CRD is
+// not installed are skipped.
+func CountAcceleratorProfileRefs(
+	ctx context.Context,
+	c client.Reader,
+	workloadTypes ...resources.ResourceType,
+) (map[types.NamespacedName]int, error) {
+	appNS, err := client.GetApplicationsNamespace(ctx, c)
+	if err != nil {
+		return nil, fmt.Errorf("getting applications namespace: %w", err)
+	}
+
+	refs := make(map[types.NamespacedName]int)
+
+	for _, workloadType := range workloadTypes {
+		workloads, err := c.ListMetadata(ctx, workloadType)
+		if err != nil {
+			if client.IsResourceTypeNotFound(err) {
+				continue
+			}
+
+			return nil, fmt.Errorf("listing %s: %w", workloadType.Kind, err)
+		}
+
+		for _, w := range workloads {
+			profileRef := types.NamespacedName{
+				Namespace: kube.GetAnnotation(w, AnnotationAcceleratorNamespace),
+				Name:      kube.GetAnnotation(w, AnnotationAcceleratorName),
+			}
+
+			if profileRef.Name == "" {
+				continue
+			}
+
+			if profileRef.Namespace == "" {
+				profileRef.Namespace = appNS
+			}
+
+			refs[profileRef]++
+		}
+	}
+
+	return refs, nil
+}
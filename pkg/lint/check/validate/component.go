@@ -12,6 +12,7 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/messages"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
 	"github.com/opendatahub-io/odh-cli/pkg/util/client"
 	"github.com/opendatahub-io/odh-cli/pkg/util/components"
@@ -113,18 +114,22 @@ func (b *ComponentBuilder) WithApplicationsNamespace() *ComponentBuilder {
 }
 
 // Removal returns a ComponentValidateFn that sets a compatibility failure condition.
-// ManagementState and target version label are automatically supplied as the first two format arguments.
+// ManagementState and target version label are automatically supplied as the first two format arguments
+// of the catalog message.
 //
 // Example:
 //
+//	var msgCodeFlareRemoved = messages.New("components.codeflare.removal.enabled",
+//	    "CodeFlare is enabled (state: %s) but will be removed in RHOAI %s")
+//
 //	validate.Component(c, target).
 //	    InState(constants.ManagementStateManaged).
-//	    Run(ctx, validate.Removal("CodeFlare is enabled (state: %s) but will be removed in RHOAI %s"))
-func Removal(format string, opts ...check.ConditionOption) ComponentValidateFn {
+//	    Run(ctx, validate.Removal(msgCodeFlareRemoved))
+func Removal(message messages.Message, opts ...check.ConditionOption) ComponentValidateFn {
 	return func(_ context.Context, req *ComponentRequest) error {
 		allOpts := append([]check.ConditionOption{
 			check.WithReason(check.ReasonVersionIncompatible),
-			check.WithMessage(format, req.ManagementState, version.MajorMinorLabel(req.TargetVersion)),
+			check.WithCatalogMessage(message, req.ManagementState, version.MajorMinorLabel(req.TargetVersion)),
 		}, opts...)
 		req.Result.SetCondition(check.NewCondition(
 			check.ConditionTypeCompatible,
@@ -159,7 +164,7 @@ func (b *ComponentBuilder) Run(
 				check.ConditionTypeAvailable,
 				metav1.ConditionFalse,
 				check.WithReason(check.ReasonResourceNotFound),
-				check.WithMessage("No DataScienceCluster found"),
+				check.WithCatalogMessage(messages.DSCNotFound),
 			),
 		}
 
@@ -222,7 +227,7 @@ func (b *ComponentBuilder) Run(
 				check.ConditionTypeAvailable,
 				metav1.ConditionFalse,
 				check.WithReason(check.ReasonResourceNotFound),
-				check.WithMessage("No DSCInitialization found"),
+				check.WithCatalogMessage(messages.DSCINotFound),
 			))
 
 			return dr, nil
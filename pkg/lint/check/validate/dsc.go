@@ -9,6 +9,7 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/messages"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
 	"github.com/opendatahub-io/odh-cli/pkg/util/client"
 )
@@ -60,7 +61,7 @@ func (b *DSCBuilder) Run(
 				check.ConditionTypeAvailable,
 				metav1.ConditionFalse,
 				check.WithReason(check.ReasonResourceNotFound),
-				check.WithMessage("No DataScienceCluster found"),
+				check.WithCatalogMessage(messages.DSCNotFound),
 			),
 		}
 
@@ -8,6 +8,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/messages"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
 	"github.com/opendatahub-io/odh-cli/pkg/util/kube/olm"
 )
@@ -53,7 +54,7 @@ func Operator(c check.Check, target check.Target) *OperatorBuilder {
 					check.ConditionTypeAvailable,
 					metav1.ConditionFalse,
 					check.WithReason(check.ReasonResourceNotFound),
-					check.WithMessage("%s operator is not installed", kind),
+					check.WithCatalogMessage(messages.OperatorNotInstalled, kind),
 				)
 			}
 
@@ -61,7 +62,7 @@ func Operator(c check.Check, target check.Target) *OperatorBuilder {
 				check.ConditionTypeAvailable,
 				metav1.ConditionTrue,
 				check.WithReason(check.ReasonResourceFound),
-				check.WithMessage("%s operator installed: %s", kind, version),
+				check.WithCatalogMessage(messages.OperatorInstalled, kind, version),
 			)
 		},
 	}
@@ -0,0 +1,148 @@
+package validate
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+)
+
+// RestartLoopThreshold is the number of restarts from which a container that is not waiting in
+// CrashLoopBackOff is still considered to be in a restart loop.
+const RestartLoopThreshold = 5
+
+const reasonCrashLoopBackOff = "CrashLoopBackOff"
+
+// PodSelectorFn returns the labels selecting the Pods backing a workload item, or nil when the
+// item has no Pods.
+type PodSelectorFn[T any] func(item T) labels.Set
+
+// WithPods enables pod-level validation (--deep): when target.Deep is set, req.Pods returns the
+// Pods backing each item, selected by fn among the Pods of the item's namespace. Pods are only
+// listed for the items a check asks about, typically those it flags.
+func (b *WorkloadBuilder[T]) WithPods(fn PodSelectorFn[T]) *WorkloadBuilder[T] {
+	b.podSelectorFn = fn
+
+	return b
+}
+
+// Pods returns the Pods backing item. It returns nil without listing anything unless the
+// builder was configured with WithPods and the run is deep (target.Deep).
+func (r *WorkloadRequest[T]) Pods(ctx context.Context, item T) ([]*corev1.Pod, error) {
+	if r.podSelectorFn == nil || !r.Deep {
+		return nil, nil
+	}
+
+	selector := r.podSelectorFn(item)
+	if selector == nil {
+		return nil, nil
+	}
+
+	namespace := ""
+	if namespaced, ok := any(item).(interface{ GetNamespace() string }); ok {
+		namespace = namespaced.GetNamespace()
+	}
+
+	// Listing all Pods of the namespace lets the run's caching reader serve the other items
+	items, err := r.Client.List(ctx, resources.Pod, client.WithNamespace(namespace))
+	if err != nil {
+		return nil, fmt.Errorf("listing Pods in namespace %s: %w", namespace, err)
+	}
+
+	pods := make([]*corev1.Pod, 0)
+
+	for _, item := range items {
+		if !labels.SelectorFromSet(selector).Matches(labels.Set(item.GetLabels())) {
+			continue
+		}
+
+		var pod corev1.Pod
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(item.Object, &pod); err != nil {
+			return nil, fmt.Errorf("converting Pod %s/%s: %w", item.GetNamespace(), item.GetName(), err)
+		}
+
+		pods = append(pods, &pod)
+	}
+
+	return pods, nil
+}
+
+// StaleImage is a container whose Pod runs another image than the workload spec declares,
+// e.g. because the workload was updated but its Pod not restarted.
+type StaleImage struct {
+	Pod       string
+	Container string
+
+	// Running is the image of the container in the Pod, Spec the one of the workload.
+	Running string
+	Spec    string
+}
+
+// StaleImages compares the images of the containers of pods with specImages, the images
+// declared by the workload keyed by container name. Containers absent from specImages are
+// ignored.
+func StaleImages(pods []*corev1.Pod, specImages map[string]string) []StaleImage {
+	stale := make([]StaleImage, 0)
+
+	for _, pod := range pods {
+		for _, container := range pod.Spec.Containers {
+			spec, ok := specImages[container.Name]
+			if !ok || spec == "" || container.Image == spec {
+				continue
+			}
+
+			stale = append(stale, StaleImage{
+				Pod:       pod.Name,
+				Container: container.Name,
+				Running:   container.Image,
+				Spec:      spec,
+			})
+		}
+	}
+
+	return stale
+}
+
+// RestartLoop is a container that keeps restarting.
+type RestartLoop struct {
+	Pod       string
+	Container string
+	Restarts  int32
+
+	// Reason is the reason the container is waiting, e.g. CrashLoopBackOff, if any.
+	Reason string
+}
+
+// RestartLoops returns the containers of pods waiting in CrashLoopBackOff or restarted at least
+// RestartLoopThreshold times.
+func RestartLoops(pods []*corev1.Pod) []RestartLoop {
+	loops := make([]RestartLoop, 0)
+
+	for _, pod := range pods {
+		for _, status := range slices.Concat(pod.Status.InitContainerStatuses, pod.Status.ContainerStatuses) {
+			reason := ""
+			if status.State.Waiting != nil {
+				reason = status.State.Waiting.Reason
+			}
+
+			if reason != reasonCrashLoopBackOff && status.RestartCount < RestartLoopThreshold {
+				continue
+			}
+
+			loops = append(loops, RestartLoop{
+				Pod:       pod.Name,
+				Container: status.Name,
+				Restarts:  status.RestartCount,
+				Reason:    reason,
+			})
+		}
+	}
+
+	return loops
+}
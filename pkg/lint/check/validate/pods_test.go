@@ -0,0 +1,62 @@
+package validate_test
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/validate"
+
+	. "github.com/onsi/gomega"
+)
+
+func newValidatePod(name string, containers []corev1.Container, statuses ...corev1.ContainerStatus) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "ns"},
+		Spec:       corev1.PodSpec{Containers: containers},
+		Status:     corev1.PodStatus{ContainerStatuses: statuses},
+	}
+}
+
+func TestStaleImages(t *testing.T) {
+	g := NewWithT(t)
+
+	pods := []*corev1.Pod{
+		newValidatePod("nb-0", []corev1.Container{
+			{Name: "nb", Image: "quay.io/org/workbench:2024.1"},
+			{Name: "oauth-proxy", Image: "quay.io/org/oauth-proxy:1"},
+		}),
+		newValidatePod("nb-1", []corev1.Container{{Name: "nb", Image: "quay.io/org/workbench:2025.2"}}),
+	}
+
+	stale := validate.StaleImages(pods, map[string]string{"nb": "quay.io/org/workbench:2025.2"})
+
+	g.Expect(stale).To(Equal([]validate.StaleImage{{
+		Pod:       "nb-0",
+		Container: "nb",
+		Running:   "quay.io/org/workbench:2024.1",
+		Spec:      "quay.io/org/workbench:2025.2",
+	}}))
+}
+
+func TestRestartLoops(t *testing.T) {
+	g := NewWithT(t)
+
+	crashing := corev1.ContainerStatus{
+		Name:         "crashing",
+		RestartCount: 2,
+		State: corev1.ContainerState{
+			Waiting: &corev1.ContainerStateWaiting{Reason: "CrashLoopBackOff"},
+		},
+	}
+	flaky := corev1.ContainerStatus{Name: "flaky", RestartCount: validate.RestartLoopThreshold}
+	healthy := corev1.ContainerStatus{Name: "healthy", RestartCount: 1}
+
+	loops := validate.RestartLoops([]*corev1.Pod{newValidatePod("pod", nil, crashing, flaky, healthy)})
+
+	g.Expect(loops).To(Equal([]validate.RestartLoop{
+		{Pod: "pod", Container: "crashing", Restarts: 2, Reason: "CrashLoopBackOff"},
+		{Pod: "pod", Container: "flaky", Restarts: validate.RestartLoopThreshold},
+	}))
+}
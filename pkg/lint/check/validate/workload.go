@@ -3,6 +3,7 @@ package validate
 import (
 	"context"
 	"fmt"
+	"slices"
 	"strconv"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -30,6 +31,9 @@ type WorkloadRequest[T any] struct {
 
 	// Items contains the (optionally filtered) workload items.
 	Items []T
+
+	// podSelectorFn selects the Pods backing an item (see WithPods and Pods)
+	podSelectorFn PodSelectorFn[T]
 }
 
 // WorkloadValidateFn is the callback invoked by WorkloadBuilder.Run after listing and filtering.
@@ -48,28 +52,40 @@ type WorkloadBuilder[T kube.NamespacedNamer] struct {
 	resourceType   resources.ResourceType
 	listFn         func(ctx context.Context) ([]T, error)
 	filterFn       func(T) (bool, error)
+	metadataFn     func(metav1.Object) bool
+	podSelectorFn  PodSelectorFn[T]
 	componentNames []string
 }
 
 // Workloads creates a WorkloadBuilder that lists full unstructured objects.
 // Use this when the validation function needs access to spec or status fields.
+// Only objects matching target.LabelSelector are listed. In low-memory mode (target.LowMemory),
+// builders with a MetadataFilter list metadata only and get the matching objects one by one.
 func Workloads(
 	c check.Check,
 	target check.Target,
 	resourceType resources.ResourceType,
 ) *WorkloadBuilder[*unstructured.Unstructured] {
-	return &WorkloadBuilder[*unstructured.Unstructured]{
+	b := &WorkloadBuilder[*unstructured.Unstructured]{
 		check:        c,
 		target:       target,
 		resourceType: resourceType,
-		listFn: func(ctx context.Context) ([]*unstructured.Unstructured, error) {
-			return target.Client.List(ctx, resourceType)
-		},
 	}
+
+	b.listFn = func(ctx context.Context) ([]*unstructured.Unstructured, error) {
+		if target.LowMemory && b.metadataFn != nil {
+			return b.getMatchingObjects(ctx)
+		}
+
+		return target.Client.List(ctx, resourceType, client.WithLabelSelector(target.LabelSelector))
+	}
+
+	return b
 }
 
 // WorkloadsMetadata creates a WorkloadBuilder that lists metadata-only objects.
 // Use this when only name, namespace, labels, annotations, or finalizers are needed.
+// Only objects matching target.LabelSelector are listed.
 func WorkloadsMetadata(
 	c check.Check,
 	target check.Target,
@@ -80,7 +96,7 @@ func WorkloadsMetadata(
 		target:       target,
 		resourceType: resourceType,
 		listFn: func(ctx context.Context) ([]*metav1.PartialObjectMetadata, error) {
-			return target.Client.ListMetadata(ctx, resourceType)
+			return target.Client.ListMetadata(ctx, resourceType, client.WithLabelSelector(target.LabelSelector))
 		},
 	}
 }
@@ -94,6 +110,46 @@ func (b *WorkloadBuilder[T]) Filter(fn func(T) (bool, error)) *WorkloadBuilder[T
 	return b
 }
 
+// MetadataFilter adds a predicate on the metadata (name, namespace, labels, annotations) of the
+// items, applied before Filter. Checks should set it whenever items can be ruled out from their
+// metadata alone: in low-memory mode (target.LowMemory), full objects are then only fetched for
+// the items it selects.
+func (b *WorkloadBuilder[T]) MetadataFilter(fn func(obj metav1.Object) bool) *WorkloadBuilder[T] {
+	b.metadataFn = fn
+
+	return b
+}
+
+// getMatchingObjects lists the metadata of the workloads and gets the full objects selected by
+// the metadata filter, so the full objects of the others are never transferred or held.
+func (b *WorkloadBuilder[T]) getMatchingObjects(ctx context.Context) ([]*unstructured.Unstructured, error) {
+	items, err := b.target.Client.ListMetadata(ctx, b.resourceType, client.WithLabelSelector(b.target.LabelSelector))
+	if err != nil {
+		return nil, err
+	}
+
+	objects := make([]*unstructured.Unstructured, 0)
+
+	for _, item := range items {
+		if !b.metadataFn(item) {
+			continue
+		}
+
+		obj, err := b.target.Client.GetResource(ctx, b.resourceType, item.Name, client.InNamespace(item.Namespace))
+		switch {
+		case apierrors.IsNotFound(err):
+			// Deleted since it was listed
+			continue
+		case err != nil:
+			return nil, fmt.Errorf("getting %s %s/%s: %w", b.resourceType.Kind, item.Namespace, item.Name, err)
+		}
+
+		objects = append(objects, obj)
+	}
+
+	return objects, nil
+}
+
 // ForComponent specifies the DSC component(s) this workload check requires.
 // If set, Run() verifies at least one component is not in "Removed" state
 // before listing resources. If all components are Removed (or DSC is not found),
@@ -140,6 +196,16 @@ func (b *WorkloadBuilder[T]) Run(
 		return nil, fmt.Errorf("listing %s resources: %w", b.resourceType.Kind, err)
 	}
 
+	// Apply the metadata filter if set; already applied when listing in low-memory mode,
+	// applying it again is a no-op.
+	if b.metadataFn != nil {
+		items = slices.DeleteFunc(items, func(item T) bool {
+			obj, ok := any(item).(metav1.Object)
+
+			return ok && !b.metadataFn(obj)
+		})
+	}
+
 	// Apply filter if set.
 	if b.filterFn != nil {
 		filtered := make([]T, 0, len(items))
@@ -158,13 +224,20 @@ func (b *WorkloadBuilder[T]) Run(
 		items = filtered
 	}
 
+	// Exclude workloads acknowledged via the lint-ignore annotation.
+	items, suppressed := b.dropIgnored(items)
+	if suppressed > 0 {
+		dr.Annotations[result.AnnotationSuppressedCount] = strconv.Itoa(suppressed)
+	}
+
 	dr.Annotations[check.AnnotationImpactedWorkloadCount] = strconv.Itoa(len(items))
 
 	// Call the validation function.
 	req := &WorkloadRequest[T]{
-		Target: b.target,
-		Result: dr,
-		Items:  items,
+		Target:        b.target,
+		Result:        dr,
+		Items:         items,
+		podSelectorFn: b.podSelectorFn,
 	}
 
 	if err := fn(ctx, req); err != nil {
@@ -179,6 +252,24 @@ func (b *WorkloadBuilder[T]) Run(
 	return dr, nil
 }
 
+// dropIgnored removes items annotated to ignore this check and returns the remaining items
+// along with the number removed.
+func (b *WorkloadBuilder[T]) dropIgnored(items []T) ([]T, int) {
+	checkID := b.check.ID()
+	kept := items[:0:0]
+
+	for _, item := range items {
+		if annotated, ok := any(item).(interface{ GetAnnotations() map[string]string }); ok &&
+			check.IsLintIgnored(annotated.GetAnnotations(), checkID) {
+			continue
+		}
+
+		kept = append(kept, item)
+	}
+
+	return kept, len(items) - len(kept)
+}
+
 // checkComponentState verifies at least one component is not in Removed state.
 // Returns (true, nil) if at least one component is active, or (false, nil) if
 // all components are Removed or the DSC is not found.
@@ -181,6 +181,93 @@ func TestWorkloadBuilder_FullObjectListing_WithFilter(t *testing.T) {
 	g.Expect(dr.ImpactedObjects[0].Name).To(Equal("job-match"))
 }
 
+func newAnnotatedNotebook(name string, annotations map[string]any) *unstructured.Unstructured {
+	return &unstructured.Unstructured{
+		Object: map[string]any{
+			"apiVersion": resources.Notebook.APIVersion(),
+			"kind":       resources.Notebook.Kind,
+			"metadata":   map[string]any{"name": name, "namespace": "ns1", "annotations": annotations},
+			"spec":       map[string]any{"template": map[string]any{}},
+		},
+	}
+}
+
+func isSelected(obj metav1.Object) bool {
+	return obj.GetAnnotations()["selected"] == "true"
+}
+
+func TestWorkloadBuilder_MetadataFilter(t *testing.T) {
+	g := NewWithT(t)
+	ctx := t.Context()
+
+	nb1 := newAnnotatedNotebook("nb-selected", map[string]any{"selected": "true"})
+	nb2 := newAnnotatedNotebook("nb-other", map[string]any{"selected": "false"})
+
+	scheme := runtime.NewScheme()
+	dynamicClient := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(scheme, notebookListKinds, nb1, nb2)
+
+	c := client.NewForTesting(client.TestClientConfig{
+		Dynamic: dynamicClient,
+	})
+
+	dr, err := validate.Workloads(newWorkloadTestCheck(), check.Target{Client: c}, resources.Notebook).
+		MetadataFilter(isSelected).
+		Run(ctx, func(_ context.Context, req *validate.WorkloadRequest[*unstructured.Unstructured]) error {
+			g.Expect(req.Items).To(HaveLen(1))
+			g.Expect(req.Items[0].GetName()).To(Equal("nb-selected"))
+
+			return nil
+		})
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(dr.ImpactedObjects).To(HaveLen(1))
+}
+
+func TestWorkloadBuilder_LowMemory_GetsSelectedObjectsOnly(t *testing.T) {
+	g := NewWithT(t)
+	ctx := t.Context()
+
+	nb1 := newAnnotatedNotebook("nb-selected", map[string]any{"selected": "true"})
+	nb2 := newAnnotatedNotebook("nb-other", map[string]any{"selected": "false"})
+
+	scheme := runtime.NewScheme()
+	_ = metav1.AddMetaToScheme(scheme)
+	dynamicClient := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(scheme, notebookListKinds, nb1, nb2)
+	metadataClient := metadatafake.NewSimpleMetadataClient(scheme, kube.ToPartialObjectMetadata(nb1, nb2)...)
+
+	c := client.NewForTesting(client.TestClientConfig{
+		Dynamic:  dynamicClient,
+		Metadata: metadataClient,
+	})
+
+	target := check.Target{
+		Client:    c,
+		LowMemory: true,
+	}
+
+	dr, err := validate.Workloads(newWorkloadTestCheck(), target, resources.Notebook).
+		MetadataFilter(isSelected).
+		Run(ctx, func(_ context.Context, req *validate.WorkloadRequest[*unstructured.Unstructured]) error {
+			// Full objects are returned for the selected items
+			g.Expect(req.Items).To(HaveLen(1))
+			g.Expect(req.Items[0].GetName()).To(Equal("nb-selected"))
+			g.Expect(req.Items[0].Object).To(HaveKey("spec"))
+
+			return nil
+		})
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(dr.ImpactedObjects).To(HaveLen(1))
+
+	// Only the selected Notebook was fetched in full; none were listed in full
+	verbs := make([]string, 0)
+	for _, action := range dynamicClient.Actions() {
+		verbs = append(verbs, action.GetVerb())
+	}
+
+	g.Expect(verbs).To(Equal([]string{"get"}))
+}
+
 func TestWorkloadBuilder_FilterError_Propagated(t *testing.T) {
 	g := NewWithT(t)
 	ctx := t.Context()
@@ -823,3 +910,114 @@ func TestWorkloadBuilder_NoForComponent_BackwardCompatible(t *testing.T) {
 	g.Expect(validationCalled).To(BeTrue())
 	g.Expect(dr.Status.Conditions).To(HaveLen(1))
 }
+
+func TestWorkloadBuilder_LintIgnoreAnnotation_Suppresses(t *testing.T) {
+	g := NewWithT(t)
+	ctx := t.Context()
+
+	ignored := &unstructured.Unstructured{
+		Object: map[string]any{
+			"apiVersion": resources.Notebook.APIVersion(),
+			"kind":       resources.Notebook.Kind,
+			"metadata": map[string]any{
+				"name":      "nb-ignored",
+				"namespace": "ns1",
+				"annotations": map[string]any{
+					constants.AnnotationLintIgnore: "other.check, test.workload.check",
+				},
+			},
+		},
+	}
+
+	otherCheck := &unstructured.Unstructured{
+		Object: map[string]any{
+			"apiVersion": resources.Notebook.APIVersion(),
+			"kind":       resources.Notebook.Kind,
+			"metadata": map[string]any{
+				"name":      "nb-other",
+				"namespace": "ns1",
+				"annotations": map[string]any{
+					constants.AnnotationLintIgnore: "other.check",
+				},
+			},
+		},
+	}
+
+	scheme := runtime.NewScheme()
+	_ = metav1.AddMetaToScheme(scheme)
+	dynamicClient := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(scheme, notebookListKinds, ignored, otherCheck)
+
+	target := check.Target{
+		Client: client.NewForTesting(client.TestClientConfig{Dynamic: dynamicClient}),
+	}
+
+	dr, err := validate.Workloads(newWorkloadTestCheck(), target, resources.Notebook).
+		Run(ctx, func(_ context.Context, req *validate.WorkloadRequest[*unstructured.Unstructured]) error {
+			g.Expect(req.Items).To(HaveLen(1))
+			g.Expect(req.Items[0].GetName()).To(Equal("nb-other"))
+
+			return nil
+		})
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(dr.Annotations).To(HaveKeyWithValue(result.AnnotationSuppressedCount, "1"))
+	g.Expect(dr.Annotations).To(HaveKeyWithValue(check.AnnotationImpactedWorkloadCount, "1"))
+	g.Expect(dr.SuppressedCount()).To(Equal(1))
+	g.Expect(dr.ImpactedObjects).To(HaveLen(1))
+}
+
+func TestWorkloadBuilder_LabelSelector_RestrictsListing(t *testing.T) {
+	g := NewWithT(t)
+	ctx := t.Context()
+
+	newNotebook := func(name, team string) *unstructured.Unstructured {
+		return &unstructured.Unstructured{
+			Object: map[string]any{
+				"apiVersion": resources.Notebook.APIVersion(),
+				"kind":       resources.Notebook.Kind,
+				"metadata": map[string]any{
+					"name":      name,
+					"namespace": "ns1",
+					"labels":    map[string]any{"app": team},
+				},
+			},
+		}
+	}
+
+	nbA := newNotebook("nb-a", "team-a")
+	nbB := newNotebook("nb-b", "team-b")
+
+	scheme := runtime.NewScheme()
+	_ = metav1.AddMetaToScheme(scheme)
+	dynamicClient := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(scheme, notebookListKinds, nbA, nbB)
+	metadataClient := metadatafake.NewSimpleMetadataClient(scheme, kube.ToPartialObjectMetadata(nbA, nbB)...)
+
+	c := client.NewForTesting(client.TestClientConfig{
+		Dynamic:  dynamicClient,
+		Metadata: metadataClient,
+	})
+
+	target := check.Target{
+		Client:        c,
+		LabelSelector: "app=team-a",
+	}
+
+	chk := newWorkloadTestCheck()
+
+	dr, err := validate.Workloads(chk, target, resources.Notebook).
+		Run(ctx, func(_ context.Context, _ *validate.WorkloadRequest[*unstructured.Unstructured]) error {
+			return nil
+		})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(dr.ImpactedObjects).To(HaveLen(1))
+	g.Expect(dr.ImpactedObjects[0].Name).To(Equal("nb-a"))
+
+	dr, err = validate.WorkloadsMetadata(chk, target, resources.Notebook).
+		Run(ctx, func(_ context.Context, _ *validate.WorkloadRequest[*metav1.PartialObjectMetadata]) error {
+			return nil
+		})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(dr.Annotations).To(HaveKeyWithValue(check.AnnotationImpactedWorkloadCount, "1"))
+	g.Expect(dr.ImpactedObjects).To(HaveLen(1))
+	g.Expect(dr.ImpactedObjects[0].Name).To(Equal("nb-a"))
+}
@@ -65,16 +65,42 @@ type qualifiedObject struct {
 	name    string
 	crdFQN  string
 	context string
+	tags    string
 }
 
 // formatImpactedObject returns the display string for an impacted object.
-// Includes the Kind from TypeMeta when available to help identify the resource type.
+// Includes the Kind from TypeMeta when available to help identify the resource type,
+// and the resolved owner and usage when set.
 func formatImpactedObject(obj metav1.PartialObjectMetadata) string {
 	if obj.Kind != "" {
-		return fmt.Sprintf("%s (%s)", obj.Name, obj.Kind)
+		return fmt.Sprintf("%s (%s)", obj.Name, obj.Kind) + objectTags(obj)
 	}
 
-	return obj.Name
+	return obj.Name + objectTags(obj)
+}
+
+// objectTags returns the owner and usage of an impacted object as a " [owner: x, in use]"
+// suffix, or "" when neither is set.
+func objectTags(obj metav1.PartialObjectMetadata) string {
+	tags := make([]string, 0, 2) //nolint:mnd // owner and usage
+
+	if owner := obj.Annotations[result.AnnotationObjectOwner]; owner != "" {
+		tags = append(tags, "owner: "+owner)
+	}
+
+	switch Usage(obj.Annotations[result.AnnotationObjectUsage]) {
+	case UsageActive:
+		tags = append(tags, "in use")
+	case UsageIdle:
+		tags = append(tags, "idle")
+	case UsageUnknown:
+	}
+
+	if len(tags) == 0 {
+		return ""
+	}
+
+	return " [" + strings.Join(tags, ", ") + "]"
 }
 
 // groupByNamespace sub-groups objects by namespace, sorted alphabetically.
@@ -157,6 +183,7 @@ func (f *EnhancedVerboseFormatter) FormatVerboseOutput(out io.Writer, dr *result
 			name:    obj.Name,
 			crdFQN:  fqn,
 			context: ctx,
+			tags:    objectTags(obj),
 		})
 	}
 
@@ -209,7 +236,7 @@ func (f *EnhancedVerboseFormatter) namespaceHeader(ns string) string {
 // Objects with a non-empty context annotation get a sub-bullet on the following line.
 func writeQualifiedObjects(out io.Writer, objects []qualifiedObject, indent string) {
 	for _, obj := range objects {
-		_, _ = fmt.Fprintf(out, "%s- %s/%s\n", indent, obj.crdFQN, obj.name)
+		_, _ = fmt.Fprintf(out, "%s- %s/%s%s\n", indent, obj.crdFQN, obj.name, obj.tags)
 		if obj.context != "" {
 			_, _ = fmt.Fprintf(out, "%s  — (%s)\n", indent, obj.context)
 		}
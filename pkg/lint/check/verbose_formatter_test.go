@@ -49,6 +49,29 @@ func TestDefaultVerboseFormatter_NamespacedObjects(t *testing.T) {
 	g.Expect(buf.String()).To(Equal(expected))
 }
 
+func TestDefaultVerboseFormatter_WithOwner(t *testing.T) {
+	g := NewWithT(t)
+
+	dr := result.New("workload", "notebook", "impacted-workloads", "test description")
+	dr.ImpactedObjects = []metav1.PartialObjectMetadata{
+		{
+			TypeMeta: metav1.TypeMeta{Kind: "Notebook"},
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:   "ns-a",
+				Name:        "nb-1",
+				Annotations: map[string]string{result.AnnotationObjectOwner: "alice"},
+			},
+		},
+	}
+
+	formatter := &check.DefaultVerboseFormatter{}
+
+	var buf bytes.Buffer
+	formatter.FormatVerboseOutput(&buf, dr)
+
+	g.Expect(buf.String()).To(Equal("    ns-a:\n      - nb-1 (Notebook) [owner: alice]\n"))
+}
+
 func TestDefaultVerboseFormatter_ClusterScopedObjects(t *testing.T) {
 	g := NewWithT(t)
 
@@ -190,6 +213,40 @@ func TestEnhancedVerboseFormatter_NamespacedObjects(t *testing.T) {
 	g.Expect(buf.String()).To(Equal(expected))
 }
 
+func TestEnhancedVerboseFormatter_WithOwnerAndUsage(t *testing.T) {
+	g := NewWithT(t)
+
+	dr := result.New("workload", "notebook", "impacted-workloads", "test description")
+	dr.Annotations[result.AnnotationResourceCRDName] = "notebooks.kubeflow.org"
+	dr.ImpactedObjects = []metav1.PartialObjectMetadata{
+		{ObjectMeta: metav1.ObjectMeta{
+			Namespace:   "ns-a",
+			Name:        "nb-1",
+			Annotations: map[string]string{result.AnnotationObjectOwner: "alice"},
+		}},
+		{ObjectMeta: metav1.ObjectMeta{
+			Namespace:   "ns-a",
+			Name:        "nb-2",
+			Annotations: map[string]string{result.AnnotationObjectUsage: string(check.UsageIdle)},
+		}},
+		{ObjectMeta: metav1.ObjectMeta{Namespace: "ns-a", Name: "nb-3"}},
+	}
+	dr.ImpactedObjects[0].Annotations[result.AnnotationObjectUsage] = string(check.UsageActive)
+
+	formatter := &check.EnhancedVerboseFormatter{}
+
+	var buf bytes.Buffer
+	formatter.FormatVerboseOutput(&buf, dr)
+
+	expected := "" +
+		"      namespace: ns-a\n" +
+		"        - notebooks.kubeflow.org/nb-1 [owner: alice, in use]\n" +
+		"        - notebooks.kubeflow.org/nb-2 [idle]\n" +
+		"        - notebooks.kubeflow.org/nb-3\n"
+
+	g.Expect(buf.String()).To(Equal(expected))
+}
+
 func TestEnhancedVerboseFormatter_ClusterScopedObjects(t *testing.T) {
 	g := NewWithT(t)
 
@@ -7,10 +7,18 @@ import (
 
 	"github.com/opendatahub-io/odh-cli/pkg/constants"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/messages"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check/validate"
 	"github.com/opendatahub-io/odh-cli/pkg/resources"
-	"github.com/opendatahub-io/odh-cli/pkg/util/version"
+)
+
+// Messages of the AcceleratorProfile migration check.
+//
+//nolint:gochecknoglobals // Message catalog entries
+var (
+	msgNoAcceleratorProfiles = messages.New("components.dashboard.acceleratorprofile-migration.none", "No deprecated AcceleratorProfiles found - no migration required")
+	msgAcceleratorProfiles   = messages.New("components.dashboard.acceleratorprofile-migration.found", "Found %d deprecated AcceleratorProfile(s) that will be automatically migrated to HardwareProfiles (infrastructure.opendatahub.io) during upgrade")
 )
 
 // AcceleratorProfileMigrationCheck detects deprecated AcceleratorProfiles that will be auto-migrated to
@@ -30,16 +38,12 @@ func NewAcceleratorProfileMigrationCheck() *AcceleratorProfileMigrationCheck {
 			CheckName:        "Components :: Dashboard :: AcceleratorProfile Migration (3.x)",
 			CheckDescription: "Lists deprecated AcceleratorProfiles that will be auto-migrated to HardwareProfiles (infrastructure.opendatahub.io) during upgrade",
 			CheckRemediation: "Deprecated AcceleratorProfiles will be automatically migrated to HardwareProfiles (infrastructure.opendatahub.io) during upgrade - no manual action required",
+			CheckResources:   []resources.ResourceType{resources.AcceleratorProfile},
+			CheckVersions:    check.VersionsUpgrade2xTo3x,
 		},
 	}
 }
 
-// CanApply returns whether this check should run for the given target.
-// Only applies when upgrading from 2.x to 3.x.
-func (c *AcceleratorProfileMigrationCheck) CanApply(_ context.Context, target check.Target) (bool, error) {
-	return version.IsUpgradeFrom2xTo3x(target.CurrentVersion, target.TargetVersion), nil
-}
-
 // Validate executes the check against the provided target.
 func (c *AcceleratorProfileMigrationCheck) Validate(
 	ctx context.Context,
@@ -59,14 +63,14 @@ func (c *AcceleratorProfileMigrationCheck) newMigrationCondition(
 			check.ConditionTypeMigrationRequired,
 			metav1.ConditionTrue,
 			check.WithReason(check.ReasonNoMigrationRequired),
-			check.WithMessage("No deprecated AcceleratorProfiles found - no migration required"),
+			check.WithCatalogMessage(msgNoAcceleratorProfiles),
 		)}, nil
 	default:
 		return []result.Condition{check.NewCondition(
 			check.ConditionTypeMigrationRequired,
 			metav1.ConditionFalse,
 			check.WithReason(check.ReasonMigrationPending),
-			check.WithMessage("Found %d deprecated AcceleratorProfile(s) that will be automatically migrated to HardwareProfiles (infrastructure.opendatahub.io) during upgrade", len(req.Items)),
+			check.WithCatalogMessage(msgAcceleratorProfiles, len(req.Items)),
 			check.WithImpact(result.ImpactAdvisory),
 			check.WithRemediation(c.CheckRemediation),
 		)}, nil
@@ -0,0 +1,149 @@
+package dashboard
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/opendatahub-io/odh-cli/pkg/constants"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/messages"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/validate"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+)
+
+// Messages of the orphaned AcceleratorProfiles check.
+//
+//nolint:gochecknoglobals // Message catalog entries
+var (
+	msgNoProfiles    = messages.New("components.dashboard.orphaned-acceleratorprofiles.none", "No AcceleratorProfiles found")
+	msgAllReferenced = messages.New("components.dashboard.orphaned-acceleratorprofiles.referenced", "All %d AcceleratorProfile(s) are referenced by workloads")
+	msgOrphaned      = messages.New("components.dashboard.orphaned-acceleratorprofiles.found", "Found %d of %d AcceleratorProfile(s) not referenced by any Notebook, InferenceService or ServingRuntime")
+)
+
+const (
+	orphanedAcceleratorProfileCheckType = "orphaned-acceleratorprofiles"
+
+	// ConditionTypeAcceleratorProfilesReferenced reports whether every AcceleratorProfile is in use.
+	ConditionTypeAcceleratorProfilesReferenced = "AcceleratorProfilesReferenced"
+
+	// ReasonOrphanedAcceleratorProfiles is reported when AcceleratorProfiles are not referenced
+	// by any workload.
+	ReasonOrphanedAcceleratorProfiles = "OrphanedAcceleratorProfiles"
+)
+
+//nolint:gochecknoglobals // read-only list of the workload kinds referencing AcceleratorProfiles
+var acceleratorProfileReferrers = []resources.ResourceType{
+	resources.Notebook,
+	resources.InferenceService,
+	resources.ServingRuntime,
+}
+
+// OrphanedAcceleratorProfileCheck detects AcceleratorProfiles not referenced by any Notebook,
+// InferenceService or ServingRuntime. They are cleanup candidates: deleting them before upgrade
+// avoids migrating unused profiles to HardwareProfiles (infrastructure.opendatahub.io).
+type OrphanedAcceleratorProfileCheck struct {
+	check.BaseCheck
+}
+
+// NewOrphanedAcceleratorProfileCheck creates a new OrphanedAcceleratorProfileCheck instance.
+func NewOrphanedAcceleratorProfileCheck() *OrphanedAcceleratorProfileCheck {
+	return &OrphanedAcceleratorProfileCheck{
+		BaseCheck: check.BaseCheck{
+			CheckGroup:       check.GroupComponent,
+			Kind:             constants.ComponentDashboard,
+			Type:             orphanedAcceleratorProfileCheckType,
+			CheckID:          "components.dashboard.orphaned-acceleratorprofiles",
+			CheckName:        "Components :: Dashboard :: Orphaned AcceleratorProfiles (3.x)",
+			CheckDescription: "Lists AcceleratorProfiles not referenced by any Notebook, InferenceService or ServingRuntime, which would be migrated to HardwareProfiles (infrastructure.opendatahub.io) without being used",
+			CheckRemediation: "Delete AcceleratorProfiles that are no longer needed before upgrading so they are not migrated to HardwareProfiles (infrastructure.opendatahub.io)",
+			CheckResources: append(
+				[]resources.ResourceType{resources.AcceleratorProfile},
+				acceleratorProfileReferrers...,
+			),
+			CheckVersions: check.VersionsUpgrade2xTo3x,
+		},
+	}
+}
+
+// Validate executes the check against the provided target.
+func (c *OrphanedAcceleratorProfileCheck) Validate(
+	ctx context.Context,
+	target check.Target,
+) (*result.DiagnosticResult, error) {
+	return validate.WorkloadsMetadata(c, target, resources.AcceleratorProfile).
+		Run(ctx, c.findOrphanedProfiles)
+}
+
+// findOrphanedProfiles reports the AcceleratorProfiles without referencing workloads as
+// impacted objects, annotated with their referenced-by count.
+func (c *OrphanedAcceleratorProfileCheck) findOrphanedProfiles(
+	ctx context.Context,
+	req *validate.WorkloadRequest[*metav1.PartialObjectMetadata],
+) error {
+	dr := req.Result
+
+	if len(req.Items) == 0 {
+		dr.SetCondition(check.NewCondition(
+			ConditionTypeAcceleratorProfilesReferenced,
+			metav1.ConditionTrue,
+			check.WithReason(check.ReasonRequirementsMet),
+			check.WithCatalogMessage(msgNoProfiles),
+		))
+
+		return nil
+	}
+
+	refs, err := validate.CountAcceleratorProfileRefs(ctx, req.Client, acceleratorProfileReferrers...)
+	if err != nil {
+		return fmt.Errorf("counting AcceleratorProfile references: %w", err)
+	}
+
+	var orphaned []types.NamespacedName
+
+	for _, profile := range req.Items {
+		name := types.NamespacedName{Namespace: profile.GetNamespace(), Name: profile.GetName()}
+		if refs[name] == 0 {
+			orphaned = append(orphaned, name)
+		}
+	}
+
+	dr.Annotations[check.AnnotationImpactedWorkloadCount] = strconv.Itoa(len(orphaned))
+
+	// Always set, so the builder does not report every listed profile as impacted
+	dr.SetImpactedObjects(resources.AcceleratorProfile, orphaned)
+
+	for i := range dr.ImpactedObjects {
+		name := types.NamespacedName{Namespace: dr.ImpactedObjects[i].Namespace, Name: dr.ImpactedObjects[i].Name}
+		dr.ImpactedObjects[i].Annotations = map[string]string{
+			check.AnnotationReferencedByCount: strconv.Itoa(refs[name]),
+		}
+	}
+
+	if len(orphaned) == 0 {
+		dr.SetCondition(check.NewCondition(
+			ConditionTypeAcceleratorProfilesReferenced,
+			metav1.ConditionTrue,
+			check.WithReason(check.ReasonRequirementsMet),
+			check.WithCatalogMessage(msgAllReferenced, len(req.Items)),
+		))
+
+		return nil
+	}
+
+	dr.SetCondition(check.NewCondition(
+		ConditionTypeAcceleratorProfilesReferenced,
+		metav1.ConditionFalse,
+		check.WithReason(ReasonOrphanedAcceleratorProfiles),
+		check.WithCatalogMessage(msgOrphaned,
+			len(orphaned), len(req.Items)),
+		check.WithImpact(result.ImpactAdvisory),
+		check.WithRemediation(c.CheckRemediation),
+	))
+
+	return nil
+}
@@ -0,0 +1,147 @@
+package dashboard_test
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/testutil"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/validate"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/components/dashboard"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+)
+
+//nolint:gochecknoglobals // Test fixture - shared across test functions
+var orphanedProfileListKinds = map[schema.GroupVersionResource]string{
+	resources.DSCInitialization.GVR():  resources.DSCInitialization.ListKind(),
+	resources.AcceleratorProfile.GVR(): resources.AcceleratorProfile.ListKind(),
+	resources.Notebook.GVR():           resources.Notebook.ListKind(),
+	resources.InferenceService.GVR():   resources.InferenceService.ListKind(),
+	resources.ServingRuntime.GVR():     resources.ServingRuntime.ListKind(),
+}
+
+func TestOrphanedAcceleratorProfileCheck_Validate_NoProfiles(t *testing.T) {
+	g := NewWithT(t)
+
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds:      orphanedProfileListKinds,
+		Objects:        []*unstructured.Unstructured{testutil.NewDSCI(testAcceleratorProfileNamespace1)},
+		CurrentVersion: "2.17.0",
+		TargetVersion:  "3.0.0",
+	})
+
+	dr, err := dashboard.NewOrphanedAcceleratorProfileCheck().Validate(t.Context(), target)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(dr.Status.Conditions).To(HaveLen(1))
+	g.Expect(dr.Status.Conditions[0].Condition).To(MatchFields(IgnoreExtras, Fields{
+		"Type":   Equal(dashboard.ConditionTypeAcceleratorProfilesReferenced),
+		"Status": Equal(metav1.ConditionTrue),
+		"Reason": Equal(check.ReasonRequirementsMet),
+	}))
+	g.Expect(dr.ImpactedObjects).To(BeEmpty())
+}
+
+func TestOrphanedAcceleratorProfileCheck_Validate_AllReferenced(t *testing.T) {
+	g := NewWithT(t)
+
+	notebook := newAcceleratorWorkload(resources.Notebook, testAcceleratorProfileNamespace2, "nb",
+		testAcceleratorProfile1, "")
+
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds: orphanedProfileListKinds,
+		Objects: []*unstructured.Unstructured{
+			testutil.NewDSCI(testAcceleratorProfileNamespace1),
+			createAcceleratorProfile(testAcceleratorProfileNamespace1, testAcceleratorProfile1),
+			notebook,
+		},
+		CurrentVersion: "2.17.0",
+		TargetVersion:  "3.0.0",
+	})
+
+	dr, err := dashboard.NewOrphanedAcceleratorProfileCheck().Validate(t.Context(), target)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(dr.Status.Conditions).To(HaveLen(1))
+	g.Expect(dr.Status.Conditions[0].Condition).To(MatchFields(IgnoreExtras, Fields{
+		"Status": Equal(metav1.ConditionTrue),
+		"Reason": Equal(check.ReasonRequirementsMet),
+	}))
+	g.Expect(dr.Annotations[check.AnnotationImpactedWorkloadCount]).To(Equal("0"))
+	g.Expect(dr.ImpactedObjects).To(BeEmpty())
+}
+
+func TestOrphanedAcceleratorProfileCheck_Validate_WithOrphans(t *testing.T) {
+	g := NewWithT(t)
+
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds: orphanedProfileListKinds,
+		Objects: []*unstructured.Unstructured{
+			testutil.NewDSCI(testAcceleratorProfileNamespace1),
+			createAcceleratorProfile(testAcceleratorProfileNamespace1, testAcceleratorProfile1),
+			createAcceleratorProfile(testAcceleratorProfileNamespace2, testAcceleratorProfile2),
+			newAcceleratorWorkload(resources.InferenceService, testAcceleratorProfileNamespace2, "isvc",
+				testAcceleratorProfile1, testAcceleratorProfileNamespace1),
+			newAcceleratorWorkload(resources.ServingRuntime, testAcceleratorProfileNamespace2, "runtime",
+				testAcceleratorProfile1, ""),
+		},
+		CurrentVersion: "2.17.0",
+		TargetVersion:  "3.0.0",
+	})
+
+	dr, err := dashboard.NewOrphanedAcceleratorProfileCheck().Validate(t.Context(), target)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(dr.Status.Conditions).To(HaveLen(1))
+	g.Expect(dr.Status.Conditions[0].Condition).To(MatchFields(IgnoreExtras, Fields{
+		"Type":    Equal(dashboard.ConditionTypeAcceleratorProfilesReferenced),
+		"Status":  Equal(metav1.ConditionFalse),
+		"Reason":  Equal(dashboard.ReasonOrphanedAcceleratorProfiles),
+		"Message": ContainSubstring("1 of 2 AcceleratorProfile(s)"),
+	}))
+	g.Expect(dr.Status.Conditions[0].Impact).To(Equal(result.ImpactAdvisory))
+	g.Expect(dr.Annotations[check.AnnotationImpactedWorkloadCount]).To(Equal("1"))
+	g.Expect(dr.ImpactedObjects).To(HaveLen(1))
+	g.Expect(dr.ImpactedObjects[0].Namespace).To(Equal(testAcceleratorProfileNamespace2))
+	g.Expect(dr.ImpactedObjects[0].Name).To(Equal(testAcceleratorProfile2))
+	g.Expect(dr.ImpactedObjects[0].Annotations).To(HaveKeyWithValue(check.AnnotationReferencedByCount, "0"))
+}
+
+func TestOrphanedAcceleratorProfileCheck_Metadata(t *testing.T) {
+	g := NewWithT(t)
+
+	chk := dashboard.NewOrphanedAcceleratorProfileCheck()
+
+	g.Expect(chk.ID()).To(Equal("components.dashboard.orphaned-acceleratorprofiles"))
+	g.Expect(chk.Group()).To(Equal(check.GroupComponent))
+	g.Expect(chk.Description()).To(ContainSubstring("HardwareProfiles"))
+}
+
+func newAcceleratorWorkload(
+	rt resources.ResourceType,
+	namespace string,
+	name string,
+	profileName string,
+	profileNamespace string,
+) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(rt.GVK())
+	obj.SetNamespace(namespace)
+	obj.SetName(name)
+
+	annotations := map[string]string{validate.AnnotationAcceleratorName: profileName}
+	if profileNamespace != "" {
+		annotations[validate.AnnotationAcceleratorNamespace] = profileNamespace
+	}
+
+	obj.SetAnnotations(annotations)
+
+	return obj
+}
@@ -0,0 +1,199 @@
+package dashboard
+
+import (
+	"context"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/opendatahub-io/odh-cli/pkg/constants"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/messages"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/validate"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+)
+
+// Messages of the OdhDashboardConfig migration check.
+//
+//nolint:gochecknoglobals // Message catalog entries
+var (
+	msgNoRemovedSettings = messages.New("components.dashboard.dashboardconfig-migration.none", "No OdhDashboardConfig settings removed or moved in RHOAI 3.x are set")
+	msgRemovedSetting    = messages.New("components.dashboard.dashboardconfig-migration.found", "%s is set in OdhDashboardConfig %s but is not read in RHOAI 3.x: %s")
+
+	remediationRemovedSetting = messages.New("components.dashboard.dashboardconfig-migration.found.remediation", "Re-create %s after upgrading: %s")
+)
+
+const (
+	dashboardConfigCheckType = "dashboardconfig-migration"
+
+	// Conditions reporting, per OdhDashboardConfig field removed in 3.x, whether it is set.
+	ConditionTypeNotebookSizesMigrated       = "NotebookSizesMigrated"
+	ConditionTypeModelServerSizesMigrated    = "ModelServerSizesMigrated"
+	ConditionTypeModelServingTogglesMigrated = "ModelServingTogglesMigrated"
+	ConditionTypeGroupsConfigMigrated        = "GroupsConfigMigrated"
+)
+
+// dashboardConfigField is an OdhDashboardConfig setting removed or moved in 3.x.
+type dashboardConfigField struct {
+	conditionType string
+	name          string
+	paths         [][]string
+	replacement   string
+}
+
+// removedDashboardConfigFields lists the OdhDashboardConfig settings not read by the 3.x
+// Dashboard, with the mechanism replacing each of them.
+//
+//nolint:gochecknoglobals // Read-only lookup table
+var removedDashboardConfigFields = []dashboardConfigField{
+	{
+		conditionType: ConditionTypeNotebookSizesMigrated,
+		name:          "spec.notebookSizes",
+		paths:         [][]string{{"spec", "notebookSizes"}},
+		replacement:   "workbench container sizes are defined by HardwareProfiles (infrastructure.opendatahub.io) with the workbench feature visibility",
+	},
+	{
+		conditionType: ConditionTypeModelServerSizesMigrated,
+		name:          "spec.modelServerSizes",
+		paths:         [][]string{{"spec", "modelServerSizes"}},
+		replacement:   "model server sizes are defined by HardwareProfiles (infrastructure.opendatahub.io) with the model-serving feature visibility",
+	},
+	{
+		conditionType: ConditionTypeModelServingTogglesMigrated,
+		name:          "spec.dashboardConfig model serving toggles",
+		paths: [][]string{
+			{"spec", "dashboardConfig", "disableKServe"},
+			{"spec", "dashboardConfig", "disableModelMesh"},
+			{"spec", "dashboardConfig", "disableModelServing"},
+		},
+		replacement: "model serving platforms are enabled through the managementState of the kserve component in the DataScienceCluster",
+	},
+	{
+		conditionType: ConditionTypeGroupsConfigMigrated,
+		name:          "spec.groupsConfig",
+		paths:         [][]string{{"spec", "groupsConfig"}},
+		replacement:   "admin and allowed groups are configured in spec.adminGroups and spec.allowedGroups of the Auth resource (services.platform.opendatahub.io)",
+	},
+}
+
+// DashboardConfigMigrationCheck detects OdhDashboardConfig settings removed or moved in 3.x and
+// reports, per setting, where it is configured after upgrade.
+type DashboardConfigMigrationCheck struct {
+	check.BaseCheck
+}
+
+// NewDashboardConfigMigrationCheck creates a new DashboardConfigMigrationCheck instance.
+func NewDashboardConfigMigrationCheck() *DashboardConfigMigrationCheck {
+	return &DashboardConfigMigrationCheck{
+		BaseCheck: check.BaseCheck{
+			CheckGroup:       check.GroupComponent,
+			Kind:             constants.ComponentDashboard,
+			Type:             dashboardConfigCheckType,
+			CheckID:          "components.dashboard.dashboardconfig-migration",
+			CheckName:        "Components :: Dashboard :: OdhDashboardConfig Migration (3.x)",
+			CheckDescription: "Lists OdhDashboardConfig settings (notebook sizes, model server sizes, model serving toggles, groupsConfig) that are removed or moved in RHOAI 3.x",
+			CheckRemediation: "Re-create each listed setting with the mechanism that replaces it in RHOAI 3.x before or right after upgrading",
+			CheckResources:   []resources.ResourceType{resources.OdhDashboardConfig},
+			CheckVersions:    check.VersionsUpgrade2xTo3x,
+		},
+	}
+}
+
+// Validate executes the check against the provided target.
+func (c *DashboardConfigMigrationCheck) Validate(
+	ctx context.Context,
+	target check.Target,
+) (*result.DiagnosticResult, error) {
+	return validate.Workloads(c, target, resources.OdhDashboardConfig).
+		Filter(hasRemovedDashboardConfigFields).
+		Run(ctx, c.checkFields)
+}
+
+func hasRemovedDashboardConfigFields(obj *unstructured.Unstructured) (bool, error) {
+	for _, field := range removedDashboardConfigFields {
+		if field.isSet(obj) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// isSet reports whether any path of the field holds a value in obj.
+func (f dashboardConfigField) isSet(obj *unstructured.Unstructured) bool {
+	for _, path := range f.paths {
+		if value, found, _ := unstructured.NestedFieldNoCopy(obj.Object, path...); found && value != nil {
+			return true
+		}
+	}
+
+	return false
+}
+
+// checkFields sets one advisory condition per removed field set in any OdhDashboardConfig,
+// and annotates each impacted OdhDashboardConfig with the fields it sets.
+func (c *DashboardConfigMigrationCheck) checkFields(
+	_ context.Context,
+	req *validate.WorkloadRequest[*unstructured.Unstructured],
+) error {
+	dr := req.Result
+
+	if len(req.Items) == 0 {
+		dr.SetCondition(check.NewCondition(
+			check.ConditionTypeMigrationRequired,
+			metav1.ConditionTrue,
+			check.WithReason(check.ReasonNoMigrationRequired),
+			check.WithCatalogMessage(msgNoRemovedSettings),
+		))
+
+		return nil
+	}
+
+	dr.ImpactedObjects = []metav1.PartialObjectMetadata{}
+
+	for _, cfg := range req.Items {
+		var set []string
+
+		for _, field := range removedDashboardConfigFields {
+			if field.isSet(cfg) {
+				set = append(set, field.name)
+			}
+		}
+
+		dr.AddImpactedObjects(resources.OdhDashboardConfig, []types.NamespacedName{
+			{Namespace: cfg.GetNamespace(), Name: cfg.GetName()},
+		})
+		dr.ImpactedObjects[len(dr.ImpactedObjects)-1].Annotations = map[string]string{
+			result.AnnotationObjectContext: strings.Join(set, ", "),
+		}
+	}
+
+	for _, field := range removedDashboardConfigFields {
+		var configs []string
+
+		for _, cfg := range req.Items {
+			if field.isSet(cfg) {
+				configs = append(configs, cfg.GetNamespace()+"/"+cfg.GetName())
+			}
+		}
+
+		if len(configs) == 0 {
+			continue
+		}
+
+		dr.SetCondition(check.NewCondition(
+			field.conditionType,
+			metav1.ConditionFalse,
+			check.WithReason(check.ReasonMigrationPending),
+			check.WithCatalogMessage(msgRemovedSetting,
+				field.name, strings.Join(configs, ", "), field.replacement),
+			check.WithImpact(result.ImpactAdvisory),
+			check.WithCatalogRemediation(remediationRemovedSetting, field.name, field.replacement),
+		))
+	}
+
+	return nil
+}
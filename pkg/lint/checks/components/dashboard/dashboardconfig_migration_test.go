@@ -0,0 +1,119 @@
+package dashboard_test
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/testutil"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/components/dashboard"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+)
+
+//nolint:gochecknoglobals // Test fixture - shared across test functions
+var dashboardConfigListKinds = map[schema.GroupVersionResource]string{
+	resources.OdhDashboardConfig.GVR(): resources.OdhDashboardConfig.ListKind(),
+}
+
+func newDashboardConfig(spec map[string]any) *unstructured.Unstructured {
+	cfg := &unstructured.Unstructured{Object: map[string]any{"spec": spec}}
+	cfg.SetGroupVersionKind(resources.OdhDashboardConfig.GVK())
+	cfg.SetNamespace(testAcceleratorProfileNamespace1)
+	cfg.SetName("odh-dashboard-config")
+
+	return cfg
+}
+
+func TestDashboardConfigMigrationCheck_Validate_NoRemovedFields(t *testing.T) {
+	g := NewWithT(t)
+
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds: dashboardConfigListKinds,
+		Objects: []*unstructured.Unstructured{
+			newDashboardConfig(map[string]any{
+				"dashboardConfig": map[string]any{"disableTracking": true},
+			}),
+		},
+		CurrentVersion: "2.17.0",
+		TargetVersion:  "3.0.0",
+	})
+
+	dr, err := dashboard.NewDashboardConfigMigrationCheck().Validate(t.Context(), target)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(dr.Status.Conditions).To(HaveLen(1))
+	g.Expect(dr.Status.Conditions[0].Condition).To(MatchFields(IgnoreExtras, Fields{
+		"Type":   Equal(check.ConditionTypeMigrationRequired),
+		"Status": Equal(metav1.ConditionTrue),
+		"Reason": Equal(check.ReasonNoMigrationRequired),
+	}))
+	g.Expect(dr.ImpactedObjects).To(BeEmpty())
+}
+
+func TestDashboardConfigMigrationCheck_Validate_RemovedFields(t *testing.T) {
+	g := NewWithT(t)
+
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds: dashboardConfigListKinds,
+		Objects: []*unstructured.Unstructured{
+			newDashboardConfig(map[string]any{
+				"notebookSizes": []any{map[string]any{"name": "Small"}},
+				"dashboardConfig": map[string]any{
+					"disableTracking":  true,
+					"disableModelMesh": true,
+				},
+				"groupsConfig": map[string]any{
+					"adminGroups":   "rhods-admins",
+					"allowedGroups": "system:authenticated",
+				},
+			}),
+		},
+		CurrentVersion: "2.17.0",
+		TargetVersion:  "3.0.0",
+	})
+
+	dr, err := dashboard.NewDashboardConfigMigrationCheck().Validate(t.Context(), target)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(dr.Status.Conditions).To(HaveLen(3))
+
+	advisory := func(conditionType string, message string) any {
+		return MatchFields(IgnoreExtras, Fields{
+			"Condition": MatchFields(IgnoreExtras, Fields{
+				"Type":    Equal(conditionType),
+				"Status":  Equal(metav1.ConditionFalse),
+				"Reason":  Equal(check.ReasonMigrationPending),
+				"Message": ContainSubstring(message),
+			}),
+			"Impact": Equal(result.ImpactAdvisory),
+		})
+	}
+
+	g.Expect(dr.Status.Conditions).To(ConsistOf(
+		advisory(dashboard.ConditionTypeNotebookSizesMigrated, "HardwareProfiles"),
+		advisory(dashboard.ConditionTypeModelServingTogglesMigrated, "DataScienceCluster"),
+		advisory(dashboard.ConditionTypeGroupsConfigMigrated, "Auth resource"),
+	))
+
+	g.Expect(dr.ImpactedObjects).To(HaveLen(1))
+	g.Expect(dr.ImpactedObjects[0].Name).To(Equal("odh-dashboard-config"))
+	g.Expect(dr.ImpactedObjects[0].Annotations).To(HaveKeyWithValue(result.AnnotationObjectContext,
+		"spec.notebookSizes, spec.dashboardConfig model serving toggles, spec.groupsConfig"))
+}
+
+func TestDashboardConfigMigrationCheck_Metadata(t *testing.T) {
+	g := NewWithT(t)
+
+	chk := dashboard.NewDashboardConfigMigrationCheck()
+
+	g.Expect(chk.ID()).To(Equal("components.dashboard.dashboardconfig-migration"))
+	g.Expect(chk.Group()).To(Equal(check.GroupComponent))
+	g.Expect(chk.Description()).To(ContainSubstring("groupsConfig"))
+}
@@ -0,0 +1,260 @@
+package dashboard
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/opendatahub-io/odh-cli/pkg/constants"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/messages"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/validate"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+	"github.com/opendatahub-io/odh-cli/pkg/util/kube"
+)
+
+// Messages of the HardwareProfile coverage check.
+//
+//nolint:gochecknoglobals // Message catalog entries
+var (
+	msgNoAcceleratorWorkloads = messages.New("components.dashboard.hardwareprofile-coverage.none", "No workloads reference AcceleratorProfiles")
+	msgAllCovered             = messages.New("components.dashboard.hardwareprofile-coverage.covered", "All %d workload(s) referencing accelerators map to a HardwareProfile after upgrade")
+	msgUncovered              = messages.New("components.dashboard.hardwareprofile-coverage.uncovered", "Found %d of %d workload(s) referencing accelerators without a matching HardwareProfile after upgrade: they will lose their scheduling configuration")
+)
+
+const (
+	hardwareProfileCoverageCheckType = "hardwareprofile-coverage"
+
+	// ConditionTypeHardwareProfileCoverage reports whether every accelerator reference resolves
+	// to a HardwareProfile after upgrade.
+	ConditionTypeHardwareProfileCoverage = "HardwareProfileCoverage"
+
+	// AnnotationHardwareProfileName references a HardwareProfile (infrastructure.opendatahub.io) by name.
+	AnnotationHardwareProfileName = "opendatahub.io/hardware-profile-name"
+
+	// AnnotationHardwareProfileNamespace is the namespace of the referenced HardwareProfile.
+	AnnotationHardwareProfileNamespace = "opendatahub.io/hardware-profile-namespace"
+
+	// AnnotationCheckExpectedHardwareProfile is set on impacted workloads to the namespace/name
+	// of the HardwareProfile they are expected to reference after upgrade.
+	AnnotationCheckExpectedHardwareProfile = "check.opendatahub.io/expected-hardware-profile"
+
+	// Suffixes of the HardwareProfiles created from each AcceleratorProfile during upgrade.
+	migratedProfileSuffixNotebooks = "-notebooks"
+	migratedProfileSuffixServing   = "-serving"
+)
+
+// HardwareProfileCoverageCheck verifies that every workload referencing an accelerator through
+// the opendatahub.io/accelerator-name annotation resolves to a HardwareProfile
+// (infrastructure.opendatahub.io) under the post-migration naming scheme. The upgrade creates
+// "<profile>-notebooks" and "<profile>-serving" HardwareProfiles from each AcceleratorProfile;
+// workloads whose profile will not exist lose their scheduling configuration.
+type HardwareProfileCoverageCheck struct {
+	check.BaseCheck
+}
+
+// NewHardwareProfileCoverageCheck creates a new HardwareProfileCoverageCheck instance.
+func NewHardwareProfileCoverageCheck() *HardwareProfileCoverageCheck {
+	return &HardwareProfileCoverageCheck{
+		BaseCheck: check.BaseCheck{
+			CheckGroup:       check.GroupComponent,
+			Kind:             constants.ComponentDashboard,
+			Type:             hardwareProfileCoverageCheckType,
+			CheckID:          "components.dashboard.hardwareprofile-coverage",
+			CheckTags:        []string{check.TagBlockingOnly},
+			CheckName:        "Components :: Dashboard :: HardwareProfile Coverage (3.x)",
+			CheckDescription: "Verifies that every accelerator referenced by Notebooks and InferenceServices maps to a HardwareProfile (infrastructure.opendatahub.io) after upgrade",
+			CheckRemediation: "Create the missing AcceleratorProfile or HardwareProfile, or update the workload annotations to reference an existing profile before upgrading",
+			CheckResources: []resources.ResourceType{
+				resources.Notebook,
+				resources.InferenceService,
+				resources.AcceleratorProfile,
+				resources.HardwareProfile,
+				resources.InfrastructureHardwareProfile,
+			},
+			CheckVersions: check.VersionsUpgrade2xTo3x,
+		},
+	}
+}
+
+// Validate executes the check against the provided target.
+func (c *HardwareProfileCoverageCheck) Validate(
+	ctx context.Context,
+	target check.Target,
+) (*result.DiagnosticResult, error) {
+	return validate.WorkloadsMetadata(c, target, resources.Notebook).
+		Filter(hasAcceleratorRef).
+		Run(ctx, c.checkCoverage)
+}
+
+func hasAcceleratorRef(obj *metav1.PartialObjectMetadata) (bool, error) {
+	return kube.GetAnnotation(obj, validate.AnnotationAcceleratorName) != "", nil
+}
+
+// checkCoverage resolves the HardwareProfile each Notebook and InferenceService will reference
+// after upgrade and reports the workloads whose profile will not exist.
+func (c *HardwareProfileCoverageCheck) checkCoverage(
+	ctx context.Context,
+	req *validate.WorkloadRequest[*metav1.PartialObjectMetadata],
+) error {
+	dr := req.Result
+
+	isvcs, err := req.Client.ListMetadata(ctx, resources.InferenceService)
+	if err != nil && !client.IsResourceTypeNotFound(err) {
+		return fmt.Errorf("listing InferenceServices: %w", err)
+	}
+
+	workloads := map[resources.ResourceType][]*metav1.PartialObjectMetadata{
+		resources.Notebook:         req.Items,
+		resources.InferenceService: filterAcceleratorRefs(isvcs),
+	}
+
+	total := len(workloads[resources.Notebook]) + len(workloads[resources.InferenceService])
+	if total == 0 {
+		dr.Annotations[check.AnnotationImpactedWorkloadCount] = "0"
+		dr.ImpactedObjects = []metav1.PartialObjectMetadata{}
+		dr.SetCondition(check.NewCondition(
+			ConditionTypeHardwareProfileCoverage,
+			metav1.ConditionTrue,
+			check.WithReason(check.ReasonRequirementsMet),
+			check.WithCatalogMessage(msgNoAcceleratorWorkloads),
+		))
+
+		return nil
+	}
+
+	appNS, err := client.GetApplicationsNamespace(ctx, req.Client)
+	if err != nil {
+		return fmt.Errorf("getting applications namespace: %w", err)
+	}
+
+	available, err := postMigrationHardwareProfiles(ctx, req.Client)
+	if err != nil {
+		return err
+	}
+
+	impacted := 0
+	dr.ImpactedObjects = []metav1.PartialObjectMetadata{}
+
+	for _, rt := range []resources.ResourceType{resources.Notebook, resources.InferenceService} {
+		for _, w := range workloads[rt] {
+			expected := expectedHardwareProfile(w, rt, appNS)
+			if available.Has(expected) {
+				continue
+			}
+
+			dr.AddImpactedObjects(rt, []types.NamespacedName{{Namespace: w.GetNamespace(), Name: w.GetName()}})
+			dr.ImpactedObjects[len(dr.ImpactedObjects)-1].Annotations = map[string]string{
+				AnnotationCheckExpectedHardwareProfile: expected.String(),
+			}
+
+			impacted++
+		}
+	}
+
+	dr.Annotations[check.AnnotationImpactedWorkloadCount] = strconv.Itoa(impacted)
+
+	if impacted == 0 {
+		dr.SetCondition(check.NewCondition(
+			ConditionTypeHardwareProfileCoverage,
+			metav1.ConditionTrue,
+			check.WithReason(check.ReasonRequirementsMet),
+			check.WithCatalogMessage(msgAllCovered, total),
+		))
+
+		return nil
+	}
+
+	dr.SetCondition(check.NewCondition(
+		ConditionTypeHardwareProfileCoverage,
+		metav1.ConditionFalse,
+		check.WithReason(check.ReasonResourceNotFound),
+		check.WithCatalogMessage(msgUncovered,
+			impacted, total),
+		check.WithImpact(result.ImpactBlocking),
+		check.WithRemediation(c.CheckRemediation),
+	))
+
+	return nil
+}
+
+func filterAcceleratorRefs(items []*metav1.PartialObjectMetadata) []*metav1.PartialObjectMetadata {
+	var filtered []*metav1.PartialObjectMetadata
+
+	for _, item := range items {
+		if ok, _ := hasAcceleratorRef(item); ok {
+			filtered = append(filtered, item)
+		}
+	}
+
+	return filtered
+}
+
+// expectedHardwareProfile returns the HardwareProfile a workload references after upgrade.
+// An explicit hardware-profile-name annotation wins (its namespace defaults to the workload
+// namespace); otherwise the name derives from the referenced AcceleratorProfile, whose
+// namespace defaults to the applications namespace.
+func expectedHardwareProfile(
+	w *metav1.PartialObjectMetadata,
+	rt resources.ResourceType,
+	appNS string,
+) types.NamespacedName {
+	if name := kube.GetAnnotation(w, AnnotationHardwareProfileName); name != "" {
+		ns := kube.GetAnnotation(w, AnnotationHardwareProfileNamespace)
+		if ns == "" {
+			ns = w.GetNamespace()
+		}
+
+		return types.NamespacedName{Namespace: ns, Name: name}
+	}
+
+	ns := kube.GetAnnotation(w, validate.AnnotationAcceleratorNamespace)
+	if ns == "" {
+		ns = appNS
+	}
+
+	suffix := migratedProfileSuffixServing
+	if rt == resources.Notebook {
+		suffix = migratedProfileSuffixNotebooks
+	}
+
+	return types.NamespacedName{
+		Namespace: ns,
+		Name:      kube.GetAnnotation(w, validate.AnnotationAcceleratorName) + suffix,
+	}
+}
+
+// postMigrationHardwareProfiles returns the HardwareProfiles expected to exist after upgrade:
+// existing infrastructure and legacy HardwareProfiles, which keep their names, plus the
+// profiles created from each AcceleratorProfile.
+func postMigrationHardwareProfiles(ctx context.Context, c client.Reader) (sets.Set[types.NamespacedName], error) {
+	available := sets.New[types.NamespacedName]()
+
+	for _, rt := range []resources.ResourceType{resources.InfrastructureHardwareProfile, resources.HardwareProfile} {
+		profiles, err := kube.BuildResourceNameSet(ctx, c, rt)
+		if err != nil {
+			return nil, fmt.Errorf("building HardwareProfile cache: %w", err)
+		}
+
+		available = available.Union(profiles)
+	}
+
+	accelerators, err := kube.BuildResourceNameSet(ctx, c, resources.AcceleratorProfile)
+	if err != nil {
+		return nil, fmt.Errorf("building AcceleratorProfile cache: %w", err)
+	}
+
+	for ap := range accelerators {
+		for _, suffix := range []string{migratedProfileSuffixNotebooks, migratedProfileSuffixServing} {
+			available.Insert(types.NamespacedName{Namespace: ap.Namespace, Name: ap.Name + suffix})
+		}
+	}
+
+	return available, nil
+}
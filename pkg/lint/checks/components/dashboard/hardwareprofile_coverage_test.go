@@ -0,0 +1,147 @@
+package dashboard_test
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/testutil"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/components/dashboard"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+)
+
+//nolint:gochecknoglobals // Test fixture - shared across test functions
+var coverageListKinds = map[schema.GroupVersionResource]string{
+	resources.DSCInitialization.GVR():             resources.DSCInitialization.ListKind(),
+	resources.AcceleratorProfile.GVR():            resources.AcceleratorProfile.ListKind(),
+	resources.HardwareProfile.GVR():               resources.HardwareProfile.ListKind(),
+	resources.InfrastructureHardwareProfile.GVR(): resources.InfrastructureHardwareProfile.ListKind(),
+	resources.Notebook.GVR():                      resources.Notebook.ListKind(),
+	resources.InferenceService.GVR():              resources.InferenceService.ListKind(),
+}
+
+func newInfrastructureHardwareProfile(namespace string, name string) *unstructured.Unstructured {
+	profile := &unstructured.Unstructured{}
+	profile.SetGroupVersionKind(resources.InfrastructureHardwareProfile.GVK())
+	profile.SetNamespace(namespace)
+	profile.SetName(name)
+
+	return profile
+}
+
+func TestHardwareProfileCoverageCheck_Validate_NoReferences(t *testing.T) {
+	g := NewWithT(t)
+
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds:      coverageListKinds,
+		Objects:        []*unstructured.Unstructured{testutil.NewDSCI(testAcceleratorProfileNamespace1)},
+		CurrentVersion: "2.17.0",
+		TargetVersion:  "3.0.0",
+	})
+
+	dr, err := dashboard.NewHardwareProfileCoverageCheck().Validate(t.Context(), target)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(dr.Status.Conditions).To(HaveLen(1))
+	g.Expect(dr.Status.Conditions[0].Condition).To(MatchFields(IgnoreExtras, Fields{
+		"Type":   Equal(dashboard.ConditionTypeHardwareProfileCoverage),
+		"Status": Equal(metav1.ConditionTrue),
+		"Reason": Equal(check.ReasonRequirementsMet),
+	}))
+	g.Expect(dr.ImpactedObjects).To(BeEmpty())
+}
+
+func TestHardwareProfileCoverageCheck_Validate_Covered(t *testing.T) {
+	g := NewWithT(t)
+
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds: coverageListKinds,
+		Objects: []*unstructured.Unstructured{
+			testutil.NewDSCI(testAcceleratorProfileNamespace1),
+			// Migrated to nvidia-gpu-notebooks and nvidia-gpu-serving during upgrade.
+			createAcceleratorProfile(testAcceleratorProfileNamespace1, testAcceleratorProfile1),
+			// Already migrated.
+			newInfrastructureHardwareProfile(testAcceleratorProfileNamespace1, testAcceleratorProfile2+"-serving"),
+			newAcceleratorWorkload(resources.Notebook, testAcceleratorProfileNamespace2, "nb",
+				testAcceleratorProfile1, ""),
+			newAcceleratorWorkload(resources.InferenceService, testAcceleratorProfileNamespace2, "isvc",
+				testAcceleratorProfile2, testAcceleratorProfileNamespace1),
+		},
+		CurrentVersion: "2.17.0",
+		TargetVersion:  "3.0.0",
+	})
+
+	dr, err := dashboard.NewHardwareProfileCoverageCheck().Validate(t.Context(), target)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(dr.Status.Conditions).To(HaveLen(1))
+	g.Expect(dr.Status.Conditions[0].Condition).To(MatchFields(IgnoreExtras, Fields{
+		"Status":  Equal(metav1.ConditionTrue),
+		"Message": ContainSubstring("All 2 workload(s)"),
+	}))
+	g.Expect(dr.Annotations[check.AnnotationImpactedWorkloadCount]).To(Equal("0"))
+	g.Expect(dr.ImpactedObjects).To(BeEmpty())
+}
+
+func TestHardwareProfileCoverageCheck_Validate_Gaps(t *testing.T) {
+	g := NewWithT(t)
+
+	mismatched := newAcceleratorWorkload(resources.Notebook, testAcceleratorProfileNamespace2, "nb-mismatched",
+		testAcceleratorProfile1, "")
+	annotations := mismatched.GetAnnotations()
+	annotations[dashboard.AnnotationHardwareProfileName] = "does-not-exist"
+	mismatched.SetAnnotations(annotations)
+
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds: coverageListKinds,
+		Objects: []*unstructured.Unstructured{
+			testutil.NewDSCI(testAcceleratorProfileNamespace1),
+			createAcceleratorProfile(testAcceleratorProfileNamespace1, testAcceleratorProfile1),
+			newAcceleratorWorkload(resources.Notebook, testAcceleratorProfileNamespace2, "nb-ok",
+				testAcceleratorProfile1, ""),
+			mismatched,
+			newAcceleratorWorkload(resources.InferenceService, testAcceleratorProfileNamespace2, "isvc-missing",
+				testAcceleratorProfile2, ""),
+		},
+		CurrentVersion: "2.17.0",
+		TargetVersion:  "3.0.0",
+	})
+
+	dr, err := dashboard.NewHardwareProfileCoverageCheck().Validate(t.Context(), target)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(dr.Status.Conditions).To(HaveLen(1))
+	g.Expect(dr.Status.Conditions[0].Condition).To(MatchFields(IgnoreExtras, Fields{
+		"Type":    Equal(dashboard.ConditionTypeHardwareProfileCoverage),
+		"Status":  Equal(metav1.ConditionFalse),
+		"Reason":  Equal(check.ReasonResourceNotFound),
+		"Message": ContainSubstring("2 of 3 workload(s)"),
+	}))
+	g.Expect(dr.Status.Conditions[0].Impact).To(Equal(result.ImpactBlocking))
+	g.Expect(dr.Annotations[check.AnnotationImpactedWorkloadCount]).To(Equal("2"))
+	g.Expect(dr.ImpactedObjects).To(ConsistOf(
+		MatchFields(IgnoreExtras, Fields{
+			"TypeMeta": MatchFields(IgnoreExtras, Fields{"Kind": Equal(resources.Notebook.Kind)}),
+			"ObjectMeta": MatchFields(IgnoreExtras, Fields{
+				"Name": Equal("nb-mismatched"),
+				"Annotations": HaveKeyWithValue(dashboard.AnnotationCheckExpectedHardwareProfile,
+					testAcceleratorProfileNamespace2+"/does-not-exist"),
+			}),
+		}),
+		MatchFields(IgnoreExtras, Fields{
+			"TypeMeta": MatchFields(IgnoreExtras, Fields{"Kind": Equal(resources.InferenceService.Kind)}),
+			"ObjectMeta": MatchFields(IgnoreExtras, Fields{
+				"Name": Equal("isvc-missing"),
+				"Annotations": HaveKeyWithValue(dashboard.AnnotationCheckExpectedHardwareProfile,
+					testAcceleratorProfileNamespace1+"/"+testAcceleratorProfile2+"-serving"),
+			}),
+		}),
+	))
+}
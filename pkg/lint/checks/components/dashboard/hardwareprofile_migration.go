@@ -7,10 +7,18 @@ import (
 
 	"github.com/opendatahub-io/odh-cli/pkg/constants"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/messages"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check/validate"
 	"github.com/opendatahub-io/odh-cli/pkg/resources"
-	"github.com/opendatahub-io/odh-cli/pkg/util/version"
+)
+
+// Messages of the HardwareProfile migration check.
+//
+//nolint:gochecknoglobals // Message catalog entries
+var (
+	msgNoLegacyHardwareProfiles = messages.New("components.dashboard.hardwareprofile-migration.none", "No legacy HardwareProfiles found in opendatahub.io API group - no migration required")
+	msgLegacyHardwareProfiles   = messages.New("components.dashboard.hardwareprofile-migration.found", "Found %d legacy HardwareProfile(s) (opendatahub.io) that will be automatically migrated to HardwareProfiles (infrastructure.opendatahub.io) during upgrade")
 )
 
 const hardwareProfileCheckType = "hardwareprofile-migration"
@@ -32,16 +40,12 @@ func NewHardwareProfileMigrationCheck() *HardwareProfileMigrationCheck {
 			CheckName:        "Components :: Dashboard :: HardwareProfile Migration (3.x)",
 			CheckDescription: "Lists legacy HardwareProfiles (opendatahub.io) that will be auto-migrated to HardwareProfiles (infrastructure.opendatahub.io) during upgrade",
 			CheckRemediation: "Legacy HardwareProfiles will be automatically migrated to HardwareProfiles (infrastructure.opendatahub.io) during upgrade - no manual action required",
+			CheckResources:   []resources.ResourceType{resources.HardwareProfile},
+			CheckVersions:    check.VersionsUpgrade2xTo3x,
 		},
 	}
 }
 
-// CanApply returns whether this check should run for the given target.
-// Only applies when upgrading from 2.x to 3.x.
-func (c *HardwareProfileMigrationCheck) CanApply(_ context.Context, target check.Target) (bool, error) {
-	return version.IsUpgradeFrom2xTo3x(target.CurrentVersion, target.TargetVersion), nil
-}
-
 // Validate executes the check against the provided target.
 func (c *HardwareProfileMigrationCheck) Validate(
 	ctx context.Context,
@@ -61,14 +65,14 @@ func (c *HardwareProfileMigrationCheck) newMigrationCondition(
 			check.ConditionTypeMigrationRequired,
 			metav1.ConditionTrue,
 			check.WithReason(check.ReasonNoMigrationRequired),
-			check.WithMessage("No legacy HardwareProfiles found in opendatahub.io API group - no migration required"),
+			check.WithCatalogMessage(msgNoLegacyHardwareProfiles),
 		)}, nil
 	default:
 		return []result.Condition{check.NewCondition(
 			check.ConditionTypeMigrationRequired,
 			metav1.ConditionFalse,
 			check.WithReason(check.ReasonMigrationPending),
-			check.WithMessage("Found %d legacy HardwareProfile(s) (opendatahub.io) that will be automatically migrated to HardwareProfiles (infrastructure.opendatahub.io) during upgrade", len(req.Items)),
+			check.WithCatalogMessage(msgLegacyHardwareProfiles, len(req.Items)),
 			check.WithImpact(result.ImpactAdvisory),
 			check.WithRemediation(c.CheckRemediation),
 		)}, nil
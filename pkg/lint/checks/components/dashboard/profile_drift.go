@@ -0,0 +1,293 @@
+package dashboard
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/opendatahub-io/odh-cli/pkg/constants"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/messages"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/validate"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+)
+
+// Messages of the AcceleratorProfile and HardwareProfile drift check.
+//
+//nolint:gochecknoglobals // Message catalog entries
+var (
+	msgNoProfilePairs = messages.New("components.dashboard.acceleratorprofile-hardwareprofile-drift.no-pairs", "Found %d AcceleratorProfile(s) and %d HardwareProfile(s) - no profile pairs to compare")
+	msgNoDrift        = messages.New("components.dashboard.acceleratorprofile-hardwareprofile-drift.none", "All %d HardwareProfile(s) migrated from AcceleratorProfiles match their source profile")
+	msgDrift          = messages.New("components.dashboard.acceleratorprofile-hardwareprofile-drift.found", "Found %d of %d HardwareProfile(s) differing from the AcceleratorProfile they were migrated from: workloads switching to them will be scheduled differently")
+)
+
+const (
+	profileDriftCheckType = "acceleratorprofile-hardwareprofile-drift"
+
+	// ConditionTypeProfilesConsistent reports whether the HardwareProfiles migrated from
+	// AcceleratorProfiles schedule workloads like their source profiles.
+	ConditionTypeProfilesConsistent = "ProfilesConsistent"
+
+	// ReasonProfileDrift is reported when a HardwareProfile differs from the AcceleratorProfile
+	// it was migrated from.
+	ReasonProfileDrift = "ProfileDrift"
+
+	// AnnotationCheckSourceAcceleratorProfile is set on drifted HardwareProfiles to the
+	// namespace/name of the AcceleratorProfile they were migrated from.
+	AnnotationCheckSourceAcceleratorProfile = "check.opendatahub.io/source-acceleratorprofile"
+
+	// Workloads selecting an AcceleratorProfile request one accelerator by default.
+	acceleratorProfileDefaultCount = "1"
+)
+
+// ProfileDriftCheck cross-validates AcceleratorProfiles against the HardwareProfiles
+// (infrastructure.opendatahub.io) migrated from them, named "<profile>-notebooks" and
+// "<profile>-serving". While both exist, workloads switching from one to the other must keep
+// requesting the same accelerator and tolerating the same taints, or they are scheduled
+// differently after the switch.
+type ProfileDriftCheck struct {
+	check.BaseCheck
+}
+
+// NewProfileDriftCheck creates a new ProfileDriftCheck instance.
+func NewProfileDriftCheck() *ProfileDriftCheck {
+	return &ProfileDriftCheck{
+		BaseCheck: check.BaseCheck{
+			CheckGroup:       check.GroupComponent,
+			Kind:             constants.ComponentDashboard,
+			Type:             profileDriftCheckType,
+			CheckID:          "components.dashboard.acceleratorprofile-hardwareprofile-drift",
+			CheckName:        "Components :: Dashboard :: AcceleratorProfile to HardwareProfile Drift (3.x)",
+			CheckDescription: "Verifies that HardwareProfiles (infrastructure.opendatahub.io) migrated from AcceleratorProfiles keep the accelerator identifier, default request and tolerations of their source profile",
+			CheckRemediation: "Align the identifiers and node scheduling tolerations of each HardwareProfile with its source AcceleratorProfile, or confirm the scheduling change is intended, before switching workloads to HardwareProfiles",
+			CheckResources: []resources.ResourceType{
+				resources.AcceleratorProfile,
+				resources.InfrastructureHardwareProfile,
+			},
+			CheckVersions: check.VersionsUpgrade2xTo3x,
+		},
+	}
+}
+
+// Validate executes the check against the provided target.
+func (c *ProfileDriftCheck) Validate(
+	ctx context.Context,
+	target check.Target,
+) (*result.DiagnosticResult, error) {
+	return validate.Workloads(c, target, resources.AcceleratorProfile).
+		Run(ctx, c.checkDrift)
+}
+
+// checkDrift compares each AcceleratorProfile with the HardwareProfiles migrated from it and
+// reports the drifted HardwareProfiles as impacted objects, annotated with the differences.
+func (c *ProfileDriftCheck) checkDrift(
+	ctx context.Context,
+	req *validate.WorkloadRequest[*unstructured.Unstructured],
+) error {
+	dr := req.Result
+
+	// Always set, so the builder does not report every listed profile as impacted
+	dr.ImpactedObjects = []metav1.PartialObjectMetadata{}
+
+	hwps, err := req.Client.List(ctx, resources.InfrastructureHardwareProfile)
+	if err != nil && !client.IsResourceTypeNotFound(err) {
+		return fmt.Errorf("listing HardwareProfiles: %w", err)
+	}
+
+	if len(req.Items) == 0 || len(hwps) == 0 {
+		dr.SetCondition(check.NewCondition(
+			ConditionTypeProfilesConsistent,
+			metav1.ConditionTrue,
+			check.WithReason(check.ReasonRequirementsMet),
+			check.WithCatalogMessage(msgNoProfilePairs,
+				len(req.Items), len(hwps)),
+		))
+
+		return nil
+	}
+
+	byName := make(map[types.NamespacedName]*unstructured.Unstructured, len(hwps))
+	for _, hwp := range hwps {
+		byName[types.NamespacedName{Namespace: hwp.GetNamespace(), Name: hwp.GetName()}] = hwp
+	}
+
+	pairs := 0
+
+	for _, ap := range req.Items {
+		for _, suffix := range []string{migratedProfileSuffixNotebooks, migratedProfileSuffixServing} {
+			name := types.NamespacedName{Namespace: ap.GetNamespace(), Name: ap.GetName() + suffix}
+
+			hwp, ok := byName[name]
+			if !ok {
+				continue
+			}
+
+			pairs++
+
+			drift, err := profileDrift(ap, hwp)
+			if err != nil {
+				return fmt.Errorf("comparing AcceleratorProfile %s/%s with HardwareProfile %s: %w",
+					ap.GetNamespace(), ap.GetName(), name, err)
+			}
+
+			if len(drift) == 0 {
+				continue
+			}
+
+			dr.AddImpactedObjects(resources.InfrastructureHardwareProfile, []types.NamespacedName{name})
+			dr.ImpactedObjects[len(dr.ImpactedObjects)-1].Annotations = map[string]string{
+				AnnotationCheckSourceAcceleratorProfile: ap.GetNamespace() + "/" + ap.GetName(),
+				result.AnnotationObjectContext:          strings.Join(drift, "; "),
+			}
+		}
+	}
+
+	drifted := len(dr.ImpactedObjects)
+	dr.Annotations[check.AnnotationImpactedWorkloadCount] = strconv.Itoa(drifted)
+
+	if drifted == 0 {
+		dr.SetCondition(check.NewCondition(
+			ConditionTypeProfilesConsistent,
+			metav1.ConditionTrue,
+			check.WithReason(check.ReasonRequirementsMet),
+			check.WithCatalogMessage(msgNoDrift, pairs),
+		))
+
+		return nil
+	}
+
+	dr.SetCondition(check.NewCondition(
+		ConditionTypeProfilesConsistent,
+		metav1.ConditionFalse,
+		check.WithReason(ReasonProfileDrift),
+		check.WithCatalogMessage(msgDrift,
+			drifted, pairs),
+		check.WithImpact(result.ImpactAdvisory),
+		check.WithRemediation(c.CheckRemediation),
+	))
+
+	return nil
+}
+
+// profileDrift returns the differences between an AcceleratorProfile and a HardwareProfile
+// migrated from it that change how workloads are scheduled.
+func profileDrift(ap *unstructured.Unstructured, hwp *unstructured.Unstructured) ([]string, error) {
+	var drift []string
+
+	identifier, _, err := unstructured.NestedString(ap.Object, "spec", "identifier")
+	if err != nil {
+		return nil, fmt.Errorf("reading .spec.identifier: %w", err)
+	}
+
+	identifiers, _, err := unstructured.NestedSlice(hwp.Object, "spec", "identifiers")
+	if err != nil {
+		return nil, fmt.Errorf("reading .spec.identifiers: %w", err)
+	}
+
+	if identifier != "" {
+		defaultCount, found := identifierDefaultCount(identifiers, identifier)
+
+		switch {
+		case !found:
+			drift = append(drift, fmt.Sprintf("identifier %s is missing", identifier))
+		case defaultCount != acceleratorProfileDefaultCount:
+			drift = append(drift, fmt.Sprintf("%s is requested %s time(s) by default instead of %s",
+				identifier, defaultCount, acceleratorProfileDefaultCount))
+		}
+	}
+
+	apTolerations, err := tolerationSet(ap, "spec", "tolerations")
+	if err != nil {
+		return nil, err
+	}
+
+	hwpTolerations, err := tolerationSet(hwp, "spec", "scheduling", "node", "tolerations")
+	if err != nil {
+		return nil, err
+	}
+
+	if missing := sets.List(apTolerations.Difference(hwpTolerations)); len(missing) > 0 {
+		drift = append(drift, "missing tolerations "+strings.Join(missing, ", "))
+	}
+
+	if extra := sets.List(hwpTolerations.Difference(apTolerations)); len(extra) > 0 {
+		drift = append(drift, "additional tolerations "+strings.Join(extra, ", "))
+	}
+
+	return drift, nil
+}
+
+// identifierDefaultCount returns the default count of the given identifier in the identifiers
+// of a HardwareProfile, and whether the identifier is present.
+func identifierDefaultCount(identifiers []any, identifier string) (string, bool) {
+	for _, item := range identifiers {
+		entry, ok := item.(map[string]any)
+		if !ok || entry["identifier"] != identifier {
+			continue
+		}
+
+		if count, ok := entry["defaultCount"]; ok && count != nil {
+			return fmt.Sprint(count), true
+		}
+
+		return acceleratorProfileDefaultCount, true
+	}
+
+	return "", false
+}
+
+// tolerationSet returns the tolerations at the given path of obj, formatted so that tolerations
+// with the same effect on scheduling compare equal.
+func tolerationSet(obj *unstructured.Unstructured, fields ...string) (sets.Set[string], error) {
+	path := "." + strings.Join(fields, ".")
+
+	items, _, err := unstructured.NestedSlice(obj.Object, fields...)
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %w", path, err)
+	}
+
+	tolerations := sets.New[string]()
+
+	for _, item := range items {
+		entry, ok := item.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("reading %s: unexpected item type %T", path, item)
+		}
+
+		var t corev1.Toleration
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(entry, &t); err != nil {
+			return nil, fmt.Errorf("reading %s: %w", path, err)
+		}
+
+		tolerations.Insert(formatToleration(t))
+	}
+
+	return tolerations, nil
+}
+
+// formatToleration renders a toleration as key[=value]:effect, with the toleration period
+// when set. An empty operator defaults to Equal.
+func formatToleration(t corev1.Toleration) string {
+	s := t.Key
+	if t.Operator != corev1.TolerationOpExists {
+		s += "=" + t.Value
+	}
+
+	s += ":" + string(t.Effect)
+
+	if t.TolerationSeconds != nil {
+		s += fmt.Sprintf(" (%ds)", *t.TolerationSeconds)
+	}
+
+	return s
+}
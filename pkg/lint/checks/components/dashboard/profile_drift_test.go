@@ -0,0 +1,180 @@
+package dashboard_test
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/testutil"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/components/dashboard"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+)
+
+//nolint:gochecknoglobals // Test fixture - shared across test functions
+var profileDriftListKinds = map[schema.GroupVersionResource]string{
+	resources.AcceleratorProfile.GVR():            resources.AcceleratorProfile.ListKind(),
+	resources.InfrastructureHardwareProfile.GVR(): resources.InfrastructureHardwareProfile.ListKind(),
+}
+
+func newGPUToleration(operator string) map[string]any {
+	toleration := map[string]any{
+		"key":    "nvidia.com/gpu",
+		"effect": "NoSchedule",
+	}
+
+	if operator != "" {
+		toleration["operator"] = operator
+	}
+
+	return toleration
+}
+
+func newDriftAcceleratorProfile(name string, tolerations ...any) *unstructured.Unstructured {
+	profile := createAcceleratorProfile(testAcceleratorProfileNamespace1, name)
+	profile.Object["spec"] = map[string]any{
+		"displayName": "NVIDIA GPU",
+		"identifier":  "nvidia.com/gpu",
+		"enabled":     true,
+		"tolerations": tolerations,
+	}
+
+	return profile
+}
+
+func newDriftHardwareProfile(name string, identifier string, defaultCount int64, tolerations ...any) *unstructured.Unstructured {
+	profile := newInfrastructureHardwareProfile(testAcceleratorProfileNamespace1, name)
+	profile.Object["spec"] = map[string]any{
+		"identifiers": []any{
+			map[string]any{"identifier": "cpu", "resourceType": "CPU", "defaultCount": int64(2)},
+			map[string]any{"identifier": identifier, "resourceType": "Accelerator", "defaultCount": defaultCount},
+		},
+		"scheduling": map[string]any{
+			"type": "Node",
+			"node": map[string]any{"tolerations": tolerations},
+		},
+	}
+
+	return profile
+}
+
+func TestProfileDriftCheck_Validate_NoHardwareProfiles(t *testing.T) {
+	g := NewWithT(t)
+
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds: profileDriftListKinds,
+		Objects: []*unstructured.Unstructured{
+			newDriftAcceleratorProfile(testAcceleratorProfile1, newGPUToleration("Exists")),
+		},
+		CurrentVersion: "2.17.0",
+		TargetVersion:  "3.0.0",
+	})
+
+	dr, err := dashboard.NewProfileDriftCheck().Validate(t.Context(), target)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(dr.Status.Conditions).To(HaveLen(1))
+	g.Expect(dr.Status.Conditions[0].Condition).To(MatchFields(IgnoreExtras, Fields{
+		"Type":    Equal(dashboard.ConditionTypeProfilesConsistent),
+		"Status":  Equal(metav1.ConditionTrue),
+		"Reason":  Equal(check.ReasonRequirementsMet),
+		"Message": ContainSubstring("no profile pairs to compare"),
+	}))
+	g.Expect(dr.ImpactedObjects).To(BeEmpty())
+}
+
+func TestProfileDriftCheck_Validate_Consistent(t *testing.T) {
+	g := NewWithT(t)
+
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds: profileDriftListKinds,
+		Objects: []*unstructured.Unstructured{
+			// An empty operator defaults to Equal, matching the explicit operator of the HardwareProfile.
+			newDriftAcceleratorProfile(testAcceleratorProfile1, newGPUToleration("")),
+			newDriftHardwareProfile(testAcceleratorProfile1+"-notebooks", "nvidia.com/gpu", 1, newGPUToleration("Equal")),
+			// Not migrated from an AcceleratorProfile.
+			newDriftHardwareProfile("custom", "amd.com/gpu", 4),
+		},
+		CurrentVersion: "2.17.0",
+		TargetVersion:  "3.0.0",
+	})
+
+	dr, err := dashboard.NewProfileDriftCheck().Validate(t.Context(), target)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(dr.Status.Conditions).To(HaveLen(1))
+	g.Expect(dr.Status.Conditions[0].Condition).To(MatchFields(IgnoreExtras, Fields{
+		"Status":  Equal(metav1.ConditionTrue),
+		"Message": ContainSubstring("All 1 HardwareProfile(s)"),
+	}))
+	g.Expect(dr.Annotations[check.AnnotationImpactedWorkloadCount]).To(Equal("0"))
+	g.Expect(dr.ImpactedObjects).To(BeEmpty())
+}
+
+func TestProfileDriftCheck_Validate_Drift(t *testing.T) {
+	g := NewWithT(t)
+
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds: profileDriftListKinds,
+		Objects: []*unstructured.Unstructured{
+			newDriftAcceleratorProfile(testAcceleratorProfile1, newGPUToleration("Exists")),
+			newDriftHardwareProfile(testAcceleratorProfile1+"-notebooks", "nvidia.com/gpu", 1, newGPUToleration("Exists")),
+			newDriftHardwareProfile(testAcceleratorProfile1+"-serving", "nvidia.com/mig-1g.5gb", 1),
+			newDriftAcceleratorProfile(testAcceleratorProfile2),
+			newDriftHardwareProfile(testAcceleratorProfile2+"-notebooks", "nvidia.com/gpu", 2),
+		},
+		CurrentVersion: "2.17.0",
+		TargetVersion:  "3.0.0",
+	})
+
+	dr, err := dashboard.NewProfileDriftCheck().Validate(t.Context(), target)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(dr.Status.Conditions).To(HaveLen(1))
+	g.Expect(dr.Status.Conditions[0].Condition).To(MatchFields(IgnoreExtras, Fields{
+		"Type":    Equal(dashboard.ConditionTypeProfilesConsistent),
+		"Status":  Equal(metav1.ConditionFalse),
+		"Reason":  Equal(dashboard.ReasonProfileDrift),
+		"Message": ContainSubstring("Found 2 of 3 HardwareProfile(s)"),
+	}))
+	g.Expect(dr.Status.Conditions[0].Impact).To(Equal(result.ImpactAdvisory))
+	g.Expect(dr.Annotations[check.AnnotationImpactedWorkloadCount]).To(Equal("2"))
+
+	g.Expect(dr.ImpactedObjects).To(ConsistOf(
+		MatchFields(IgnoreExtras, Fields{
+			"TypeMeta": MatchFields(IgnoreExtras, Fields{"Kind": Equal(resources.InfrastructureHardwareProfile.Kind)}),
+			"ObjectMeta": MatchFields(IgnoreExtras, Fields{
+				"Name": Equal(testAcceleratorProfile1 + "-serving"),
+				"Annotations": SatisfyAll(
+					HaveKeyWithValue(dashboard.AnnotationCheckSourceAcceleratorProfile,
+						testAcceleratorProfileNamespace1+"/"+testAcceleratorProfile1),
+					HaveKeyWithValue(result.AnnotationObjectContext,
+						"identifier nvidia.com/gpu is missing; missing tolerations nvidia.com/gpu:NoSchedule"),
+				),
+			}),
+		}),
+		MatchFields(IgnoreExtras, Fields{
+			"ObjectMeta": MatchFields(IgnoreExtras, Fields{
+				"Name": Equal(testAcceleratorProfile2 + "-notebooks"),
+				"Annotations": HaveKeyWithValue(result.AnnotationObjectContext,
+					"nvidia.com/gpu is requested 2 time(s) by default instead of 1"),
+			}),
+		}),
+	))
+}
+
+func TestProfileDriftCheck_Metadata(t *testing.T) {
+	g := NewWithT(t)
+
+	chk := dashboard.NewProfileDriftCheck()
+
+	g.Expect(chk.ID()).To(Equal("components.dashboard.acceleratorprofile-hardwareprofile-drift"))
+	g.Expect(chk.Group()).To(Equal(check.GroupComponent))
+	g.Expect(chk.Description()).To(ContainSubstring("tolerations"))
+}
@@ -0,0 +1,166 @@
+package dashboard
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/opendatahub-io/odh-cli/pkg/constants"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/messages"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/validate"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+	"github.com/opendatahub-io/odh-cli/pkg/util/kube"
+)
+
+// Messages of the Dashboard tiles removal check.
+//
+//nolint:gochecknoglobals // Message catalog entries
+var (
+	msgNoUserTiles = messages.New("components.dashboard.tiles-removal.none", "No user-created OdhApplication, OdhDocument or OdhQuickStart resources found")
+	msgUserTiles   = messages.New("components.dashboard.tiles-removal.found", "Found %d user-created Dashboard tile resource(s) that are no longer served in RHOAI 3.x: %s")
+)
+
+const (
+	// ConditionTypeTilesCompatible reports whether no user-created Dashboard tile resources exist.
+	ConditionTypeTilesCompatible = "TilesCompatible"
+
+	// labelPlatformPartOf is set by the operator on the resources it deploys; tile resources
+	// without it were created by users.
+	labelPlatformPartOf = "platform.opendatahub.io/part-of"
+)
+
+//nolint:gochecknoglobals // read-only list of the Dashboard tile resource types
+var tileResources = []resources.ResourceType{
+	resources.OdhApplication,
+	resources.OdhDocument,
+	resources.OdhQuickStart,
+}
+
+// TilesRemovalCheck detects user-created OdhApplication, OdhDocument and OdhQuickStart
+// resources. The Dashboard no longer serves custom tiles from these resources in 3.x, so
+// they must be recreated in their new location after upgrade.
+type TilesRemovalCheck struct {
+	check.BaseCheck
+}
+
+// NewTilesRemovalCheck creates a new TilesRemovalCheck instance.
+func NewTilesRemovalCheck() *TilesRemovalCheck {
+	return &TilesRemovalCheck{
+		BaseCheck: check.BaseCheck{
+			CheckGroup:       check.GroupComponent,
+			Kind:             constants.ComponentDashboard,
+			Type:             check.CheckTypeRemoval,
+			CheckID:          "components.dashboard.tiles-removal",
+			CheckName:        "Components :: Dashboard :: Custom Tiles Removal (3.x)",
+			CheckDescription: "Lists user-created OdhApplication, OdhDocument and OdhQuickStart resources, which are no longer served by the Dashboard in RHOAI 3.x",
+			CheckRemediation: "Back up the listed resources before upgrading and recreate the tiles in the RHOAI 3.x Dashboard once the upgrade completes",
+			CheckResources:   tileResources,
+			CheckVersions:    check.VersionsUpgrade2xTo3x,
+		},
+	}
+}
+
+// Validate executes the check against the provided target.
+func (c *TilesRemovalCheck) Validate(
+	ctx context.Context,
+	target check.Target,
+) (*result.DiagnosticResult, error) {
+	return validate.WorkloadsMetadata(c, target, resources.OdhApplication).
+		Filter(isUserCreated).
+		Run(ctx, c.findCustomTiles)
+}
+
+func isUserCreated(obj *metav1.PartialObjectMetadata) (bool, error) {
+	return kube.GetLabel(obj, labelPlatformPartOf) == "", nil
+}
+
+// findCustomTiles lists the user-created tile resources of every kind, reporting them as
+// impacted objects with a per-namespace breakdown in the condition message.
+func (c *TilesRemovalCheck) findCustomTiles(
+	ctx context.Context,
+	req *validate.WorkloadRequest[*metav1.PartialObjectMetadata],
+) error {
+	dr := req.Result
+	dr.ImpactedObjects = []metav1.PartialObjectMetadata{}
+
+	perNamespace := make(map[string]int)
+
+	for _, rt := range tileResources {
+		items := req.Items
+
+		if rt != resources.OdhApplication {
+			listed, err := req.Client.ListMetadata(ctx, rt)
+			if err != nil && !client.IsResourceTypeNotFound(err) {
+				return fmt.Errorf("listing %s: %w", rt.Kind, err)
+			}
+
+			items = slices.DeleteFunc(listed, func(obj *metav1.PartialObjectMetadata) bool {
+				userCreated, _ := isUserCreated(obj)
+
+				return !userCreated
+			})
+		}
+
+		names := kube.ToNamespacedNames(items)
+		slices.SortFunc(names, func(a, b types.NamespacedName) int {
+			return strings.Compare(a.String(), b.String())
+		})
+
+		for _, n := range names {
+			perNamespace[n.Namespace]++
+		}
+
+		dr.AddImpactedObjects(rt, names)
+	}
+
+	total := len(dr.ImpactedObjects)
+	dr.Annotations[check.AnnotationImpactedWorkloadCount] = strconv.Itoa(total)
+
+	if total == 0 {
+		dr.SetCondition(check.NewCondition(
+			ConditionTypeTilesCompatible,
+			metav1.ConditionTrue,
+			check.WithReason(check.ReasonVersionCompatible),
+			check.WithCatalogMessage(msgNoUserTiles),
+		))
+
+		return nil
+	}
+
+	dr.SetCondition(check.NewCondition(
+		ConditionTypeTilesCompatible,
+		metav1.ConditionFalse,
+		check.WithReason(check.ReasonFeatureRemoved),
+		check.WithCatalogMessage(msgUserTiles,
+			total, formatNamespaceCounts(perNamespace)),
+		check.WithImpact(result.ImpactAdvisory),
+		check.WithRemediation(c.CheckRemediation),
+	))
+
+	return nil
+}
+
+// formatNamespaceCounts renders per-namespace counts as "ns-a (2), ns-b (1)", sorted by namespace.
+func formatNamespaceCounts(counts map[string]int) string {
+	namespaces := make([]string, 0, len(counts))
+	for ns := range counts {
+		namespaces = append(namespaces, ns)
+	}
+
+	slices.Sort(namespaces)
+
+	parts := make([]string, 0, len(namespaces))
+	for _, ns := range namespaces {
+		parts = append(parts, fmt.Sprintf("%s (%d)", ns, counts[ns]))
+	}
+
+	return strings.Join(parts, ", ")
+}
@@ -0,0 +1,118 @@
+package dashboard_test
+
+import (
+	"testing"
+
+	"github.com/blang/semver/v4"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/testutil"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/components/dashboard"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+)
+
+//nolint:gochecknoglobals // Test fixture - shared across test functions
+var tileListKinds = map[schema.GroupVersionResource]string{
+	resources.OdhApplication.GVR(): resources.OdhApplication.ListKind(),
+	resources.OdhDocument.GVR():    resources.OdhDocument.ListKind(),
+	resources.OdhQuickStart.GVR():  resources.OdhQuickStart.ListKind(),
+}
+
+func newTile(rt resources.ResourceType, namespace string, name string, managed bool) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(rt.GVK())
+	obj.SetNamespace(namespace)
+	obj.SetName(name)
+
+	if managed {
+		obj.SetLabels(map[string]string{"platform.opendatahub.io/part-of": "dashboard"})
+	}
+
+	return obj
+}
+
+func TestTilesRemovalCheck_CanApply(t *testing.T) {
+	g := NewWithT(t)
+
+	currentVer := semver.MustParse("2.17.0")
+	targetVer := semver.MustParse("3.0.0")
+
+	canApply, err := dashboard.NewTilesRemovalCheck().CanApply(t.Context(), check.Target{
+		CurrentVersion: &currentVer,
+		TargetVersion:  &targetVer,
+	})
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(canApply).To(BeTrue())
+}
+
+func TestTilesRemovalCheck_Validate_OnlyManagedTiles(t *testing.T) {
+	g := NewWithT(t)
+
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds: tileListKinds,
+		Objects: []*unstructured.Unstructured{
+			newTile(resources.OdhApplication, testAcceleratorProfileNamespace1, "jupyter", true),
+			newTile(resources.OdhDocument, testAcceleratorProfileNamespace1, "jupyter-doc", true),
+		},
+		CurrentVersion: "2.17.0",
+		TargetVersion:  "3.0.0",
+	})
+
+	dr, err := dashboard.NewTilesRemovalCheck().Validate(t.Context(), target)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(dr.Status.Conditions).To(HaveLen(1))
+	g.Expect(dr.Status.Conditions[0].Condition).To(MatchFields(IgnoreExtras, Fields{
+		"Type":   Equal(dashboard.ConditionTypeTilesCompatible),
+		"Status": Equal(metav1.ConditionTrue),
+		"Reason": Equal(check.ReasonVersionCompatible),
+	}))
+	g.Expect(dr.ImpactedObjects).To(BeEmpty())
+}
+
+func TestTilesRemovalCheck_Validate_UserCreatedTiles(t *testing.T) {
+	g := NewWithT(t)
+
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds: tileListKinds,
+		Objects: []*unstructured.Unstructured{
+			newTile(resources.OdhApplication, testAcceleratorProfileNamespace1, "jupyter", true),
+			newTile(resources.OdhApplication, testAcceleratorProfileNamespace1, "custom-app", false),
+			newTile(resources.OdhDocument, testAcceleratorProfileNamespace1, "custom-doc", false),
+			newTile(resources.OdhQuickStart, testAcceleratorProfileNamespace2, "custom-tutorial", false),
+		},
+		CurrentVersion: "2.17.0",
+		TargetVersion:  "3.0.0",
+	})
+
+	dr, err := dashboard.NewTilesRemovalCheck().Validate(t.Context(), target)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(dr.Status.Conditions).To(HaveLen(1))
+	g.Expect(dr.Status.Conditions[0].Condition).To(MatchFields(IgnoreExtras, Fields{
+		"Type":   Equal(dashboard.ConditionTypeTilesCompatible),
+		"Status": Equal(metav1.ConditionFalse),
+		"Reason": Equal(check.ReasonFeatureRemoved),
+		"Message": And(
+			ContainSubstring("Found 3 user-created"),
+			ContainSubstring(testAcceleratorProfileNamespace2+" (1), "+testAcceleratorProfileNamespace1+" (2)"),
+		),
+	}))
+	g.Expect(dr.Status.Conditions[0].Impact).To(Equal(result.ImpactAdvisory))
+	g.Expect(dr.Annotations[check.AnnotationImpactedWorkloadCount]).To(Equal("3"))
+	g.Expect(dr.ImpactedObjects).To(HaveLen(3))
+	g.Expect(dr.ImpactedObjects[0].Kind).To(Equal(resources.OdhApplication.Kind))
+	g.Expect(dr.ImpactedObjects[0].Name).To(Equal("custom-app"))
+	g.Expect(dr.ImpactedObjects[1].Kind).To(Equal(resources.OdhDocument.Kind))
+	g.Expect(dr.ImpactedObjects[2].Kind).To(Equal(resources.OdhQuickStart.Kind))
+	g.Expect(dr.ImpactedObjects[2].Namespace).To(Equal(testAcceleratorProfileNamespace2))
+}
@@ -11,6 +11,7 @@ import (
 
 	"github.com/opendatahub-io/odh-cli/pkg/constants"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/messages"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
 	"github.com/opendatahub-io/odh-cli/pkg/resources"
 	"github.com/opendatahub-io/odh-cli/pkg/util/client"
@@ -18,6 +19,19 @@ import (
 	"github.com/opendatahub-io/odh-cli/pkg/util/version"
 )
 
+// Messages of the Authorino TLS readiness check.
+//
+//nolint:gochecknoglobals // Message catalog entries
+var (
+	msgAuthorinoNotFound    = messages.New("components.kserve.authorino-tls-readiness.not-found", "Authorino resource not found. Authorino with TLS must be installed for llm-d")
+	msgAuthorinoForbidden   = messages.New("components.kserve.authorino-tls-readiness.forbidden", "Unable to read Authorino resource (insufficient permissions)")
+	msgListenerTLSMissing   = messages.New("components.kserve.authorino-tls-readiness.listener-tls-missing", "Authorino listener TLS configuration is missing. TLS must be configured for llm-d")
+	msgListenerTLSDisabled  = messages.New("components.kserve.authorino-tls-readiness.listener-tls-disabled", "Authorino listener TLS is not enabled. TLS must be enabled for llm-d")
+	msgCertSecretRefMissing = messages.New("components.kserve.authorino-tls-readiness.cert-secret-ref-missing", "Authorino TLS certSecretRef is not configured. A TLS certificate secret is required for llm-d")
+	msgCertSecretNameEmpty  = messages.New("components.kserve.authorino-tls-readiness.cert-secret-name-empty", "Authorino TLS certSecretRef.name is empty. A TLS certificate secret is required for llm-d")
+	msgTLSEnabled           = messages.New("components.kserve.authorino-tls-readiness.tls-enabled", "Authorino TLS is enabled with certificate secret %q")
+)
+
 // AuthorinoTLSReadinessCheck validates that Authorino is configured with TLS and ready.
 // Only applies when llm-d workloads (LLMInferenceService) are detected.
 type AuthorinoTLSReadinessCheck struct {
@@ -31,8 +45,11 @@ func NewAuthorinoTLSReadinessCheck() *AuthorinoTLSReadinessCheck {
 			Kind:             constants.ComponentKServe,
 			Type:             "authorino-tls-readiness",
 			CheckID:          "components.kserve.authorino-tls-readiness",
+			CheckTags:        []string{check.TagBlockingOnly},
 			CheckName:        "Components :: KServe :: Authorino TLS Readiness",
 			CheckDescription: "Validates that Authorino is configured with TLS and ready (required for llm-d)",
+			CheckResources:   []resources.ResourceType{resources.Authorino, resources.LLMInferenceService},
+			CheckVersions:    check.VersionsUpgrade2xTo3x,
 		},
 	}
 }
@@ -56,7 +73,7 @@ func (c *AuthorinoTLSReadinessCheck) Validate(ctx context.Context, target check.
 			check.ConditionTypeReady,
 			metav1.ConditionFalse,
 			check.WithReason(check.ReasonResourceNotFound),
-			check.WithMessage("Authorino resource not found. Authorino with TLS must be installed for llm-d"),
+			check.WithCatalogMessage(msgAuthorinoNotFound),
 			check.WithImpact(result.ImpactBlocking),
 		))
 
@@ -68,7 +85,7 @@ func (c *AuthorinoTLSReadinessCheck) Validate(ctx context.Context, target check.
 			check.ConditionTypeReady,
 			metav1.ConditionFalse,
 			check.WithReason(check.ReasonInsufficientData),
-			check.WithMessage("Unable to read Authorino resource (insufficient permissions)"),
+			check.WithCatalogMessage(msgAuthorinoForbidden),
 			check.WithImpact(result.ImpactBlocking),
 		))
 
@@ -96,7 +113,7 @@ func validateAuthorinoTLS(dr *result.DiagnosticResult, obj *unstructured.Unstruc
 			check.ConditionTypeConfigured,
 			metav1.ConditionFalse,
 			check.WithReason(check.ReasonConfigurationInvalid),
-			check.WithMessage("Authorino listener TLS configuration is missing. TLS must be configured for llm-d"),
+			check.WithCatalogMessage(msgListenerTLSMissing),
 			check.WithImpact(result.ImpactBlocking),
 		))
 
@@ -108,7 +125,7 @@ func validateAuthorinoTLS(dr *result.DiagnosticResult, obj *unstructured.Unstruc
 			check.ConditionTypeConfigured,
 			metav1.ConditionFalse,
 			check.WithReason(check.ReasonConfigurationInvalid),
-			check.WithMessage("Authorino listener TLS is not enabled. TLS must be enabled for llm-d"),
+			check.WithCatalogMessage(msgListenerTLSDisabled),
 			check.WithImpact(result.ImpactBlocking),
 		))
 
@@ -123,7 +140,7 @@ func validateAuthorinoTLS(dr *result.DiagnosticResult, obj *unstructured.Unstruc
 			check.ConditionTypeConfigured,
 			metav1.ConditionFalse,
 			check.WithReason(check.ReasonConfigurationInvalid),
-			check.WithMessage("Authorino TLS certSecretRef is not configured. A TLS certificate secret is required for llm-d"),
+			check.WithCatalogMessage(msgCertSecretRefMissing),
 			check.WithImpact(result.ImpactBlocking),
 		))
 	case err != nil:
@@ -133,7 +150,7 @@ func validateAuthorinoTLS(dr *result.DiagnosticResult, obj *unstructured.Unstruc
 			check.ConditionTypeConfigured,
 			metav1.ConditionFalse,
 			check.WithReason(check.ReasonConfigurationInvalid),
-			check.WithMessage("Authorino TLS certSecretRef.name is empty. A TLS certificate secret is required for llm-d"),
+			check.WithCatalogMessage(msgCertSecretNameEmpty),
 			check.WithImpact(result.ImpactBlocking),
 		))
 	default:
@@ -141,7 +158,7 @@ func validateAuthorinoTLS(dr *result.DiagnosticResult, obj *unstructured.Unstruc
 			check.ConditionTypeConfigured,
 			metav1.ConditionTrue,
 			check.WithReason(check.ReasonConfigurationValid),
-			check.WithMessage("Authorino TLS is enabled with certificate secret %q", certSecret),
+			check.WithCatalogMessage(msgTLSEnabled, certSecret),
 		))
 	}
 
@@ -9,12 +9,21 @@ import (
 
 	"github.com/opendatahub-io/odh-cli/pkg/constants"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/messages"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
 	"github.com/opendatahub-io/odh-cli/pkg/resources"
 	"github.com/opendatahub-io/odh-cli/pkg/util/client"
 	"github.com/opendatahub-io/odh-cli/pkg/util/version"
 )
 
+// Messages of the Kuadrant readiness check.
+//
+//nolint:gochecknoglobals // Message catalog entries
+var (
+	msgKuadrantNotFound  = messages.New("components.kserve.kuadrant-readiness.not-found", "Kuadrant resource not found. Kuadrant must be installed for llm-d")
+	msgKuadrantForbidden = messages.New("components.kserve.kuadrant-readiness.forbidden", "Unable to read Kuadrant resource (insufficient permissions)")
+)
+
 // KuadrantReadinessCheck validates that the Kuadrant resource is present and ready.
 // Only applies when llm-d workloads (LLMInferenceService) are detected.
 type KuadrantReadinessCheck struct {
@@ -28,8 +37,11 @@ func NewKuadrantReadinessCheck() *KuadrantReadinessCheck {
 			Kind:             constants.ComponentKServe,
 			Type:             "kuadrant-readiness",
 			CheckID:          "components.kserve.kuadrant-readiness",
+			CheckTags:        []string{check.TagBlockingOnly},
 			CheckName:        "Components :: KServe :: Kuadrant Readiness",
 			CheckDescription: "Validates that the Kuadrant resource is present and ready (required for llm-d)",
+			CheckResources:   []resources.ResourceType{resources.Kuadrant, resources.LLMInferenceService},
+			CheckVersions:    check.VersionsUpgrade2xTo3x,
 		},
 	}
 }
@@ -53,7 +65,7 @@ func (c *KuadrantReadinessCheck) Validate(ctx context.Context, target check.Targ
 			check.ConditionTypeReady,
 			metav1.ConditionFalse,
 			check.WithReason(check.ReasonResourceNotFound),
-			check.WithMessage("Kuadrant resource not found. Kuadrant must be installed for llm-d"),
+			check.WithCatalogMessage(msgKuadrantNotFound),
 			check.WithImpact(result.ImpactBlocking),
 		))
 
@@ -65,7 +77,7 @@ func (c *KuadrantReadinessCheck) Validate(ctx context.Context, target check.Targ
 			check.ConditionTypeReady,
 			metav1.ConditionFalse,
 			check.WithReason(check.ReasonInsufficientData),
-			check.WithMessage("Unable to read Kuadrant resource (insufficient permissions)"),
+			check.WithCatalogMessage(msgKuadrantForbidden),
 			check.WithImpact(result.ImpactBlocking),
 		))
 
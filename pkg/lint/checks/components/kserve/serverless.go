@@ -9,14 +9,22 @@ import (
 
 	"github.com/opendatahub-io/odh-cli/pkg/constants"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/messages"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check/validate"
-	"github.com/opendatahub-io/odh-cli/pkg/util/client"
-	"github.com/opendatahub-io/odh-cli/pkg/util/components"
 	"github.com/opendatahub-io/odh-cli/pkg/util/jq"
 	"github.com/opendatahub-io/odh-cli/pkg/util/version"
 )
 
+// Messages of the KServe serverless removal check.
+//
+//nolint:gochecknoglobals // Message catalog entries
+var (
+	msgServerlessNotConfigured = messages.New("components.kserve.serverless-removal.not-configured", "KServe serverless mode is not configured - ready for RHOAI %s upgrade")
+	msgServerlessEnabled       = messages.New("components.kserve.serverless-removal.enabled", "KServe serverless mode is enabled (state: %s) but will be removed in RHOAI %s")
+	msgServerlessDisabled      = messages.New("components.kserve.serverless-removal.disabled", "KServe serverless mode is disabled (state: %s) - ready for RHOAI %s upgrade")
+)
+
 const checkType = "serverless-removal"
 
 // ServerlessRemovalCheck validates that KServe serverless is disabled before upgrading to 3.x.
@@ -31,28 +39,16 @@ func NewServerlessRemovalCheck() *ServerlessRemovalCheck {
 			Kind:             constants.ComponentKServe,
 			Type:             checkType,
 			CheckID:          "components.kserve.serverless-removal",
+			CheckTags:        []string{check.TagFast, check.TagBlockingOnly},
 			CheckName:        "Components :: KServe :: Serverless Removal (3.x)",
 			CheckDescription: "Validates that KServe serverless mode is disabled before upgrading from RHOAI 2.x to 3.x (serverless support will be removed)",
 			CheckRemediation: "Disable KServe serverless mode by setting serving.managementState to 'Removed' in DataScienceCluster before upgrading",
+			CheckVersions:    check.VersionsUpgrade2xTo3x,
+			CheckComponents:  []string{constants.ComponentKServe},
 		},
 	}
 }
 
-// CanApply returns whether this check should run for the given target.
-// This check only applies when upgrading FROM 2.x TO 3.x and KServe is Managed.
-func (c *ServerlessRemovalCheck) CanApply(ctx context.Context, target check.Target) (bool, error) {
-	if !version.IsUpgradeFrom2xTo3x(target.CurrentVersion, target.TargetVersion) {
-		return false, nil
-	}
-
-	dsc, err := client.GetDataScienceCluster(ctx, target.Client)
-	if err != nil {
-		return false, fmt.Errorf("getting DataScienceCluster: %w", err)
-	}
-
-	return components.HasManagementState(dsc, constants.ComponentKServe, constants.ManagementStateManaged), nil
-}
-
 func (c *ServerlessRemovalCheck) Validate(ctx context.Context, target check.Target) (*result.DiagnosticResult, error) {
 	return validate.Component(c, target).
 		Run(ctx, func(_ context.Context, req *validate.ComponentRequest) error {
@@ -65,7 +61,7 @@ func (c *ServerlessRemovalCheck) Validate(ctx context.Context, target check.Targ
 					check.ConditionTypeCompatible,
 					metav1.ConditionTrue,
 					check.WithReason(check.ReasonVersionCompatible),
-					check.WithMessage("KServe serverless mode is not configured - ready for RHOAI %s upgrade", tv),
+					check.WithCatalogMessage(msgServerlessNotConfigured, tv),
 				))
 			case err != nil:
 				return fmt.Errorf("querying kserve serving managementState: %w", err)
@@ -74,7 +70,7 @@ func (c *ServerlessRemovalCheck) Validate(ctx context.Context, target check.Targ
 					check.ConditionTypeCompatible,
 					metav1.ConditionFalse,
 					check.WithReason(check.ReasonVersionIncompatible),
-					check.WithMessage("KServe serverless mode is enabled (state: %s) but will be removed in RHOAI %s", state, tv),
+					check.WithCatalogMessage(msgServerlessEnabled, state, tv),
 					check.WithImpact(result.ImpactBlocking),
 					check.WithRemediation(c.CheckRemediation),
 				))
@@ -83,7 +79,7 @@ func (c *ServerlessRemovalCheck) Validate(ctx context.Context, target check.Targ
 					check.ConditionTypeCompatible,
 					metav1.ConditionTrue,
 					check.WithReason(check.ReasonVersionCompatible),
-					check.WithMessage("KServe serverless mode is disabled (state: %s) - ready for RHOAI %s upgrade", state, tv),
+					check.WithCatalogMessage(msgServerlessDisabled, state, tv),
 				))
 			}
 
@@ -7,11 +7,20 @@ import (
 
 	"github.com/opendatahub-io/odh-cli/pkg/constants"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/messages"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check/validate"
 	"github.com/opendatahub-io/odh-cli/pkg/util/version"
 )
 
+// Messages of the Service Mesh operator upgrade check.
+//
+//nolint:gochecknoglobals // Message catalog entries
+var (
+	msgOperatorNotInstalled = messages.New("components.kserve.servicemesh-operator-upgrade.not-installed", "Service Mesh Operator v2 is not installed - ready for RHOAI %s upgrade")
+	msgOperatorInstalled    = messages.New("components.kserve.servicemesh-operator-upgrade.installed", "Service Mesh Operator v2 (%s) is installed but no longer required by RHOAI %s and should be removed. OpenShift 4.19+ handles service mesh internally")
+)
+
 // ServiceMeshOperatorCheck validates that Service Mesh Operator v2 is not installed when upgrading to 3.x,
 // as it is no longer required by RHOAI 3.x (OpenShift 4.19+ handles service mesh internally).
 type ServiceMeshOperatorCheck struct {
@@ -25,16 +34,14 @@ func NewServiceMeshOperatorCheck() *ServiceMeshOperatorCheck {
 			Kind:             constants.ComponentKServe,
 			Type:             "servicemesh-operator-upgrade",
 			CheckID:          "components.kserve.servicemesh-operator-upgrade",
+			CheckTags:        []string{check.TagFast},
 			CheckName:        "Components :: KServe :: ServiceMesh Operator Upgrade (3.x)",
 			CheckDescription: "Validates that Service Mesh Operator v2 is not installed when upgrading to RHOAI 3.x (no longer required, OpenShift 4.19+ handles service mesh internally)",
+			CheckVersions:    check.VersionsUpgrade2xTo3x,
 		},
 	}
 }
 
-func (c *ServiceMeshOperatorCheck) CanApply(_ context.Context, target check.Target) (bool, error) {
-	return version.IsUpgradeFrom2xTo3x(target.CurrentVersion, target.TargetVersion), nil
-}
-
 func (c *ServiceMeshOperatorCheck) Validate(ctx context.Context, target check.Target) (*result.DiagnosticResult, error) {
 	tv := version.MajorMinorLabel(target.TargetVersion)
 
@@ -47,7 +54,7 @@ func (c *ServiceMeshOperatorCheck) Validate(ctx context.Context, target check.Ta
 					check.ConditionTypeCompatible,
 					metav1.ConditionTrue,
 					check.WithReason(check.ReasonVersionCompatible),
-					check.WithMessage("Service Mesh Operator v2 is not installed - ready for RHOAI %s upgrade", tv),
+					check.WithCatalogMessage(msgOperatorNotInstalled, tv),
 				)
 			}
 
@@ -55,7 +62,7 @@ func (c *ServiceMeshOperatorCheck) Validate(ctx context.Context, target check.Ta
 				check.ConditionTypeCompatible,
 				metav1.ConditionFalse,
 				check.WithReason(check.ReasonVersionIncompatible),
-				check.WithMessage("Service Mesh Operator v2 (%s) is installed but no longer required by RHOAI %s and should be removed. OpenShift 4.19+ handles service mesh internally", operatorVersion, tv),
+				check.WithCatalogMessage(msgOperatorInstalled, operatorVersion, tv),
 			)
 		}).
 		Run(ctx)
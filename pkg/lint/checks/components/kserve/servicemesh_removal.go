@@ -10,12 +10,22 @@ import (
 
 	"github.com/opendatahub-io/odh-cli/pkg/constants"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/messages"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check/validate"
 	"github.com/opendatahub-io/odh-cli/pkg/util/jq"
 	"github.com/opendatahub-io/odh-cli/pkg/util/version"
 )
 
+// Messages of the ServiceMesh removal check.
+//
+//nolint:gochecknoglobals // Message catalog entries
+var (
+	msgServiceMeshNotConfigured = messages.New("components.kserve.servicemesh-removal.not-configured", "ServiceMesh is not configured in DSCInitialization")
+	msgServiceMeshEnabled       = messages.New("components.kserve.servicemesh-removal.enabled", "ServiceMesh is enabled (state: %s) but is no longer required by RHOAI %s. OpenShift 4.19+ handles service mesh internally")
+	msgServiceMeshDisabled      = messages.New("components.kserve.servicemesh-removal.disabled", "ServiceMesh is disabled (state: %s) - ready for RHOAI %s upgrade")
+)
+
 // ServiceMeshRemovalCheck validates that ServiceMesh is disabled before upgrading to 3.x.
 type ServiceMeshRemovalCheck struct {
 	check.BaseCheck
@@ -28,17 +38,15 @@ func NewServiceMeshRemovalCheck() *ServiceMeshRemovalCheck {
 			Kind:             constants.ComponentKServe,
 			Type:             "servicemesh-removal",
 			CheckID:          "components.kserve.servicemesh-removal",
+			CheckTags:        []string{check.TagFast, check.TagBlockingOnly},
 			CheckName:        "Components :: KServe :: ServiceMesh Removal (3.x)",
 			CheckDescription: "Validates that ServiceMesh is disabled before upgrading from RHOAI 2.x to 3.x (no longer required, OpenShift 4.19+ handles service mesh internally)",
 			CheckRemediation: "Disable ServiceMesh by setting managementState to 'Removed' in DSCInitialization before upgrading",
+			CheckVersions:    check.VersionsUpgrade2xTo3x,
 		},
 	}
 }
 
-func (c *ServiceMeshRemovalCheck) CanApply(_ context.Context, target check.Target) (bool, error) {
-	return version.IsUpgradeFrom2xTo3x(target.CurrentVersion, target.TargetVersion), nil
-}
-
 func (c *ServiceMeshRemovalCheck) Validate(ctx context.Context, target check.Target) (*result.DiagnosticResult, error) {
 	tv := version.MajorMinorLabel(target.TargetVersion)
 
@@ -51,7 +59,7 @@ func (c *ServiceMeshRemovalCheck) Validate(ctx context.Context, target check.Tar
 				check.ConditionTypeConfigured,
 				metav1.ConditionFalse,
 				check.WithReason(check.ReasonResourceNotFound),
-				check.WithMessage("ServiceMesh is not configured in DSCInitialization"),
+				check.WithCatalogMessage(msgServiceMeshNotConfigured),
 			))
 		case err != nil:
 			return fmt.Errorf("querying servicemesh managementState: %w", err)
@@ -60,7 +68,7 @@ func (c *ServiceMeshRemovalCheck) Validate(ctx context.Context, target check.Tar
 				check.ConditionTypeCompatible,
 				metav1.ConditionFalse,
 				check.WithReason(check.ReasonVersionIncompatible),
-				check.WithMessage("ServiceMesh is enabled (state: %s) but is no longer required by RHOAI %s. OpenShift 4.19+ handles service mesh internally", managementState, tv),
+				check.WithCatalogMessage(msgServiceMeshEnabled, managementState, tv),
 				check.WithImpact(result.ImpactBlocking),
 				check.WithRemediation(c.CheckRemediation),
 			))
@@ -69,7 +77,7 @@ func (c *ServiceMeshRemovalCheck) Validate(ctx context.Context, target check.Tar
 				check.ConditionTypeCompatible,
 				metav1.ConditionTrue,
 				check.WithReason(check.ReasonVersionCompatible),
-				check.WithMessage("ServiceMesh is disabled (state: %s) - ready for RHOAI %s upgrade", managementState, tv),
+				check.WithCatalogMessage(msgServiceMeshDisabled, managementState, tv),
 			))
 		}
 
@@ -9,12 +9,23 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/messages"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
 	"github.com/opendatahub-io/odh-cli/pkg/resources"
 	"github.com/opendatahub-io/odh-cli/pkg/util/client"
 	"github.com/opendatahub-io/odh-cli/pkg/util/jq"
 )
 
+// Messages of the readiness of the llm-d dependencies.
+//
+//nolint:gochecknoglobals // Message catalog entries
+var (
+	msgReadyConditionMissing = messages.New("components.kserve.readiness.condition-missing", "%s resource found but Ready condition is missing")
+	msgReadyStatusEmpty      = messages.New("components.kserve.readiness.status-empty", "%s resource found but Ready condition status is empty")
+	msgNotReady              = messages.New("components.kserve.readiness.not-ready", "%s is not ready (status: %s). %s must be ready for llm-d")
+	msgReady                 = messages.New("components.kserve.readiness.ready", "%s is installed and ready")
+)
+
 const (
 	kuadrantNamespace = "kuadrant-system"
 	kuadrantName      = "kuadrant"
@@ -50,7 +61,7 @@ func validateReadyCondition(
 			check.ConditionTypeReady,
 			metav1.ConditionFalse,
 			check.WithReason(check.ReasonInsufficientData),
-			check.WithMessage("%s resource found but Ready condition is missing", resourceName),
+			check.WithCatalogMessage(msgReadyConditionMissing, resourceName),
 			check.WithImpact(result.ImpactBlocking),
 		))
 	case err != nil:
@@ -60,7 +71,7 @@ func validateReadyCondition(
 			check.ConditionTypeReady,
 			metav1.ConditionFalse,
 			check.WithReason(check.ReasonInsufficientData),
-			check.WithMessage("%s resource found but Ready condition status is empty", resourceName),
+			check.WithCatalogMessage(msgReadyStatusEmpty, resourceName),
 			check.WithImpact(result.ImpactBlocking),
 		))
 	case readyStatus != "True":
@@ -68,7 +79,7 @@ func validateReadyCondition(
 			check.ConditionTypeReady,
 			metav1.ConditionFalse,
 			check.WithReason(check.ReasonResourceUnavailable),
-			check.WithMessage("%s is not ready (status: %s). %s must be ready for llm-d", resourceName, readyStatus, resourceName),
+			check.WithCatalogMessage(msgNotReady, resourceName, readyStatus, resourceName),
 			check.WithImpact(result.ImpactBlocking),
 		))
 	default:
@@ -76,7 +87,7 @@ func validateReadyCondition(
 			check.ConditionTypeReady,
 			metav1.ConditionTrue,
 			check.WithReason(check.ReasonResourceAvailable),
-			check.WithMessage("%s is installed and ready", resourceName),
+			check.WithCatalogMessage(msgReady, resourceName),
 		))
 	}
 
@@ -3,28 +3,34 @@ package kueue
 import (
 	"context"
 	"fmt"
+	"slices"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/opendatahub-io/odh-cli/pkg/constants"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/messages"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check/validate"
 	kueuediscovery "github.com/opendatahub-io/odh-cli/pkg/lint/checks/kueue/discovery"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
 	"github.com/opendatahub-io/odh-cli/pkg/util/client"
-	"github.com/opendatahub-io/odh-cli/pkg/util/components"
-	"github.com/opendatahub-io/odh-cli/pkg/util/version"
+)
+
+// Messages of the Kueue management state check.
+// Deferred: parameterize hardcoded version references using ComponentRequest.TargetVersion.
+//
+//nolint:gochecknoglobals // Message catalog entries
+var (
+	msgManagedProhibited   = messages.New("components.kueue.management-state.managed-prohibited", "The 3.3.2 upgrade currently only supports the Kueue managementState of Removed. A future 3.3.x release might allow an upgrade when you have migrated to the Red Hat build of Kueue Operator and the Kueue managementState is Unmanaged.")
+	msgUnmanagedProhibited = messages.New("components.kueue.management-state.unmanaged-prohibited", "The 3.3.2 upgrade currently only supports the Kueue managementState of Removed. A future 3.3.x release might allow an upgrade when the Kueue managementState is Unmanaged.")
+	msgManagedBlocking     = messages.New("components.kueue.management-state.managed-blocking", "The 3.3.2 upgrade currently only supports the Kueue managementState of Removed. The Kueue managementState is currently Managed but no workloads on the cluster are using Kueue. Set the Kueue managementState to Removed and then re-run this script to proceed with migration.")
+	msgUnmanagedBlocking   = messages.New("components.kueue.management-state.unmanaged-blocking", "The 3.3.2 upgrade currently only supports the Kueue managementState of Removed. The Kueue managementState is currently Unmanaged but no workloads on the cluster are using Kueue. Set the Kueue managementState to Removed and then re-run this script to proceed with migration.")
 )
 
 const (
 	kind                     = "kueue"
 	checkTypeManagementState = "management-state"
-
-	// Deferred: parameterize hardcoded version references using ComponentRequest.TargetVersion.
-	msgManagedProhibited   = "The 3.3.2 upgrade currently only supports the Kueue managementState of Removed. A future 3.3.x release might allow an upgrade when you have migrated to the Red Hat build of Kueue Operator and the Kueue managementState is Unmanaged."
-	msgUnmanagedProhibited = "The 3.3.2 upgrade currently only supports the Kueue managementState of Removed. A future 3.3.x release might allow an upgrade when the Kueue managementState is Unmanaged."
-	msgManagedBlocking     = "The 3.3.2 upgrade currently only supports the Kueue managementState of Removed. The Kueue managementState is currently Managed but no workloads on the cluster are using Kueue. Set the Kueue managementState to Removed and then re-run this script to proceed with migration."
-	msgUnmanagedBlocking   = "The 3.3.2 upgrade currently only supports the Kueue managementState of Removed. The Kueue managementState is currently Unmanaged but no workloads on the cluster are using Kueue. Set the Kueue managementState to Removed and then re-run this script to proceed with migration."
 )
 
 // ManagementStateCheck validates that Kueue managementState is Removed before upgrading to 3.x.
@@ -42,34 +48,21 @@ type ManagementStateCheck struct {
 func NewManagementStateCheck() *ManagementStateCheck {
 	return &ManagementStateCheck{
 		BaseCheck: check.BaseCheck{
-			CheckGroup:       check.GroupComponent,
-			Kind:             kind,
-			Type:             checkTypeManagementState,
-			CheckID:          "components.kueue.management-state",
-			CheckName:        "Components :: Kueue :: Management State (3.x)",
-			CheckDescription: "Validates that Kueue managementState is Removed before upgrading to RHOAI 3.x",
+			CheckGroup:           check.GroupComponent,
+			Kind:                 kind,
+			Type:                 checkTypeManagementState,
+			CheckID:              "components.kueue.management-state",
+			CheckTags:            []string{check.TagBlockingOnly},
+			CheckName:            "Components :: Kueue :: Management State (3.x)",
+			CheckDescription:     "Validates that Kueue managementState is Removed before upgrading to RHOAI 3.x",
+			CheckResources:       slices.Concat([]resources.ResourceType{resources.Namespace}, kueuediscovery.MonitoredWorkloadTypes),
+			CheckVersions:        check.VersionsUpgrade2xTo3x,
+			CheckComponents:      []string{constants.ComponentKueue},
+			CheckComponentStates: []string{constants.ManagementStateManaged, constants.ManagementStateUnmanaged},
 		},
 	}
 }
 
-// CanApply returns whether this check should run for the given target.
-// This check only applies when upgrading FROM 2.x TO 3.x and Kueue is Managed or Unmanaged.
-func (c *ManagementStateCheck) CanApply(ctx context.Context, target check.Target) (bool, error) {
-	if !version.IsUpgradeFrom2xTo3x(target.CurrentVersion, target.TargetVersion) {
-		return false, nil
-	}
-
-	dsc, err := client.GetDataScienceCluster(ctx, target.Client)
-	if err != nil {
-		return false, fmt.Errorf("getting DataScienceCluster: %w", err)
-	}
-
-	return components.HasManagementState(
-		dsc, "kueue",
-		constants.ManagementStateManaged, constants.ManagementStateUnmanaged,
-	), nil
-}
-
 func (c *ManagementStateCheck) Validate(ctx context.Context, target check.Target) (*result.DiagnosticResult, error) {
 	return validate.Component(c, target).
 		Run(ctx, func(ctx context.Context, req *validate.ComponentRequest) error {
@@ -78,12 +71,12 @@ func (c *ManagementStateCheck) Validate(ctx context.Context, target check.Target
 				return fmt.Errorf("checking kueue usage: %w", err)
 			}
 
-			setCondition := func(msg string, impact result.Impact) {
+			setCondition := func(msg messages.Message, impact result.Impact) {
 				req.Result.SetCondition(check.NewCondition(
 					check.ConditionTypeCompatible,
 					metav1.ConditionFalse,
 					check.WithReason(check.ReasonVersionIncompatible),
-					check.WithMessage("%s", msg),
+					check.WithCatalogMessage(msg),
 					check.WithImpact(impact),
 				))
 			}
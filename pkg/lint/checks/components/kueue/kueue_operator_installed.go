@@ -8,6 +8,7 @@ import (
 
 	"github.com/opendatahub-io/odh-cli/pkg/constants"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/messages"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check/validate"
 	"github.com/opendatahub-io/odh-cli/pkg/util/client"
@@ -15,12 +16,21 @@ import (
 	"github.com/opendatahub-io/odh-cli/pkg/util/kube/olm"
 )
 
+// Messages of the Kueue operator installed check.
+//
+//nolint:gochecknoglobals // Message catalog entries
+var (
+	msgManagedNotSupported = messages.New("components.kueue.operator-installed.managed-not-supported", "Kueue managementState is Managed — migration to the Red Hat build of Kueue operator is required before upgrading")
+	msgOperatorRequired    = messages.New("components.kueue.operator-installed.operator-required", "Red Hat build of Kueue operator is not installed but Kueue managementState is Unmanaged — Red Hat build of Kueue operator is required")
+	msgOperatorInstalled   = messages.New("components.kueue.operator-installed.installed", "Red Hat build of Kueue operator installed: %s")
+
+	remediationManagedNotSupported = messages.New("components.kueue.operator-installed.managed-not-supported.remediation", "Migrate to the Red Hat build of Kueue operator following https://docs.redhat.com/en/documentation/red_hat_openshift_ai_self-managed/2.25/html/managing_openshift_ai/managing-workloads-with-kueue#migrating-to-the-rhbok-operator_kueue before upgrading")
+)
+
 const (
-	checkTypeOperatorInstalled          = "operator-installed"
-	subscriptionName                    = "kueue-operator"
-	annotationInstalledVersion          = "operator.opendatahub.io/installed-version"
-	msgManagedNotSupported              = "Kueue managementState is Managed — migration to the Red Hat build of Kueue operator is required before upgrading"
-	operatorInstalledManagedRemediation = "Migrate to the Red Hat build of Kueue operator following https://docs.redhat.com/en/documentation/red_hat_openshift_ai_self-managed/2.25/html/managing_openshift_ai/managing-workloads-with-kueue#migrating-to-the-rhbok-operator_kueue before upgrading"
+	checkTypeOperatorInstalled = "operator-installed"
+	subscriptionName           = "kueue-operator"
+	annotationInstalledVersion = "operator.opendatahub.io/installed-version"
 )
 
 // OperatorInstalledCheck is currently deregistered — re-enable when a future 3.3.x release
@@ -42,8 +52,10 @@ func NewOperatorInstalledCheck() *OperatorInstalledCheck {
 			Kind:             kind,
 			Type:             checkTypeOperatorInstalled,
 			CheckID:          "components.kueue.operator-installed",
+			CheckTags:        []string{check.TagFast, check.TagBlockingOnly},
 			CheckName:        "Components :: Kueue :: Operator Installed",
 			CheckDescription: "Validates Red Hat build of Kueue operator installation is consistent with Kueue management state",
+			CheckVersions:    check.VersionsAny,
 		},
 	}
 }
@@ -99,9 +111,9 @@ func (c *OperatorInstalledCheck) validateManaged(
 		check.ConditionTypeCompatible,
 		metav1.ConditionFalse,
 		check.WithReason(check.ReasonVersionIncompatible),
-		check.WithMessage(msgManagedNotSupported),
+		check.WithCatalogMessage(msgManagedNotSupported),
 		check.WithImpact(result.ImpactProhibited),
-		check.WithRemediation(operatorInstalledManagedRemediation),
+		check.WithCatalogRemediation(remediationManagedNotSupported),
 	))
 }
 
@@ -116,7 +128,7 @@ func (c *OperatorInstalledCheck) validateUnmanaged(
 			check.ConditionTypeCompatible,
 			metav1.ConditionFalse,
 			check.WithReason(check.ReasonVersionIncompatible),
-			check.WithMessage("Red Hat build of Kueue operator is not installed but Kueue managementState is Unmanaged — Red Hat build of Kueue operator is required"),
+			check.WithCatalogMessage(msgOperatorRequired),
 			check.WithImpact(result.ImpactBlocking),
 		))
 	default:
@@ -124,7 +136,7 @@ func (c *OperatorInstalledCheck) validateUnmanaged(
 			check.ConditionTypeCompatible,
 			metav1.ConditionTrue,
 			check.WithReason(check.ReasonVersionCompatible),
-			check.WithMessage("Red Hat build of Kueue operator installed: %s", info.GetVersion()),
+			check.WithCatalogMessage(msgOperatorInstalled, info.GetVersion()),
 		))
 	}
 }
@@ -2,15 +2,18 @@ package llamastack
 
 import (
 	"context"
-	"fmt"
 
-	"github.com/opendatahub-io/odh-cli/pkg/constants"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/messages"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check/validate"
-	"github.com/opendatahub-io/odh-cli/pkg/util/client"
-	"github.com/opendatahub-io/odh-cli/pkg/util/components"
-	"github.com/opendatahub-io/odh-cli/pkg/util/version"
+)
+
+// Messages of the LlamaStack Operator removal check.
+//
+//nolint:gochecknoglobals // Message catalog entries
+var (
+	msgLlamaStackEnabled = messages.New("components.llamastackoperator.removal.enabled", "LlamaStack Operator is enabled (state: %s) but is replaced by ogx in RHOAI %s")
 )
 
 const kind = "llamastackoperator"
@@ -27,31 +30,19 @@ func NewRemovalCheck() *RemovalCheck {
 			Kind:             kind,
 			Type:             check.CheckTypeRemoval,
 			CheckID:          "components.llamastackoperator.removal",
+			CheckTags:        []string{check.TagFast, check.TagBlockingOnly},
 			CheckName:        "Components :: LlamaStack Operator :: Removal (3.5)",
 			CheckDescription: "Validates that LlamaStack Operator is disabled before upgrading from RHOAI 3.4 to 3.5 (component is replaced by ogx)",
 			CheckRemediation: "Disable LlamaStack Operator by setting managementState to 'Removed' in DataScienceCluster before upgrading",
+			CheckVersions:    check.VersionsUpgrade34To35,
+			CheckComponents:  []string{kind},
 		},
 	}
 }
 
-// CanApply returns whether this check should run for the given target.
-// This check only applies when upgrading FROM 3.4.x TO 3.5.x and LlamaStack Operator is Managed.
-func (c *RemovalCheck) CanApply(ctx context.Context, target check.Target) (bool, error) {
-	if !version.IsUpgradeFrom34To35(target.CurrentVersion, target.TargetVersion) {
-		return false, nil
-	}
-
-	dsc, err := client.GetDataScienceCluster(ctx, target.Client)
-	if err != nil {
-		return false, fmt.Errorf("getting DataScienceCluster: %w", err)
-	}
-
-	return components.HasManagementState(dsc, kind, constants.ManagementStateManaged), nil
-}
-
 func (c *RemovalCheck) Validate(ctx context.Context, target check.Target) (*result.DiagnosticResult, error) {
 	return validate.Component(c, target).
-		Run(ctx, validate.Removal("LlamaStack Operator is enabled (state: %s) but is replaced by ogx in RHOAI %s",
+		Run(ctx, validate.Removal(msgLlamaStackEnabled,
 			check.WithImpact(result.ImpactBlocking),
 			check.WithRemediation(c.CheckRemediation)))
 }
@@ -2,15 +2,18 @@ package modelmesh
 
 import (
 	"context"
-	"fmt"
 
-	"github.com/opendatahub-io/odh-cli/pkg/constants"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/messages"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check/validate"
-	"github.com/opendatahub-io/odh-cli/pkg/util/client"
-	"github.com/opendatahub-io/odh-cli/pkg/util/components"
-	"github.com/opendatahub-io/odh-cli/pkg/util/version"
+)
+
+// Messages of the ModelMesh removal check.
+//
+//nolint:gochecknoglobals // Message catalog entries
+var (
+	msgModelMeshEnabled = messages.New("components.modelmesh.removal.enabled", "ModelMesh Serving is enabled (state: %s) but will be removed in RHOAI %s")
 )
 
 const kind = "modelmeshserving"
@@ -27,31 +30,19 @@ func NewRemovalCheck() *RemovalCheck {
 			Kind:             kind,
 			Type:             check.CheckTypeRemoval,
 			CheckID:          "components.modelmesh.removal",
+			CheckTags:        []string{check.TagFast, check.TagBlockingOnly},
 			CheckName:        "Components :: ModelMesh Serving :: Removal (3.x)",
 			CheckDescription: "Validates that ModelMesh Serving is disabled before upgrading from RHOAI 2.x to 3.x (component will be removed)",
 			CheckRemediation: "Disable ModelMesh Serving by setting managementState to 'Removed' in DataScienceCluster before upgrading",
+			CheckVersions:    check.VersionsUpgrade2xTo3x,
+			CheckComponents:  []string{kind},
 		},
 	}
 }
 
-// CanApply returns whether this check should run for the given target.
-// This check only applies when upgrading FROM 2.x TO 3.x and ModelMesh Serving is Managed.
-func (c *RemovalCheck) CanApply(ctx context.Context, target check.Target) (bool, error) {
-	if !version.IsUpgradeFrom2xTo3x(target.CurrentVersion, target.TargetVersion) {
-		return false, nil
-	}
-
-	dsc, err := client.GetDataScienceCluster(ctx, target.Client)
-	if err != nil {
-		return false, fmt.Errorf("getting DataScienceCluster: %w", err)
-	}
-
-	return components.HasManagementState(dsc, kind, constants.ManagementStateManaged), nil
-}
-
 func (c *RemovalCheck) Validate(ctx context.Context, target check.Target) (*result.DiagnosticResult, error) {
 	return validate.Component(c, target).
-		Run(ctx, validate.Removal("ModelMesh Serving is enabled (state: %s) but will be removed in RHOAI %s",
+		Run(ctx, validate.Removal(msgModelMeshEnabled,
 			check.WithImpact(result.ImpactBlocking),
 			check.WithRemediation(c.CheckRemediation)))
 }
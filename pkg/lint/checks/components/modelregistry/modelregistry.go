@@ -0,0 +1,121 @@
+package modelregistry
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/opendatahub-io/odh-cli/pkg/constants"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/messages"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/validate"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+)
+
+// Messages of the ModelRegistry readiness check.
+//
+//nolint:gochecknoglobals // Message catalog entries
+var (
+	msgDatabasesValid = messages.New("components.modelregistry.readiness.databases-valid", "All %d ModelRegistry instance(s) have a valid database configuration")
+	msgDatabaseIssues = messages.New("components.modelregistry.readiness.database-issues", "Found ModelRegistry instance(s) with database configuration issues: %s")
+)
+
+const kind = "modelregistry"
+
+// ReadinessCheck validates that the model registry component is healthy and that every
+// ModelRegistry instance has a usable database configuration before upgrading.
+type ReadinessCheck struct {
+	check.BaseCheck
+}
+
+func NewReadinessCheck() *ReadinessCheck {
+	return &ReadinessCheck{
+		BaseCheck: check.BaseCheck{
+			CheckGroup:       check.GroupComponent,
+			Kind:             kind,
+			Type:             check.CheckTypeReadiness,
+			CheckID:          "components.modelregistry.readiness",
+			CheckName:        "Components :: ModelRegistry :: Readiness",
+			CheckDescription: "Validates that the model registry component is ready and that ModelRegistry instances reference existing database Secrets and a supported MySQL version",
+			CheckRemediation: "Resolve the ModelRegistryReady condition on the DataScienceCluster, create the missing database password Secrets, and upgrade MySQL databases to " + minMySQLVersionLabel + " or later before upgrading",
+			CheckResources: []resources.ResourceType{
+				resources.ModelRegistry,
+				resources.Secret,
+				resources.Deployment,
+			},
+			CheckVersions:   check.VersionsUpgrade2xTo3x,
+			CheckComponents: []string{kind},
+		},
+	}
+}
+
+// Validate reports the component Available condition from the DataScienceCluster status and
+// the Configured condition from the database configuration of each ModelRegistry instance.
+func (c *ReadinessCheck) Validate(ctx context.Context, target check.Target) (*result.DiagnosticResult, error) {
+	return validate.Component(c, target).
+		InState(constants.ManagementStateManaged).
+		Run(ctx, func(ctx context.Context, req *validate.ComponentRequest) error {
+			available, err := newAvailableCondition(req.DSC)
+			if err != nil {
+				return err
+			}
+
+			req.Result.SetCondition(available)
+
+			registries, err := req.Client.List(ctx, resources.ModelRegistry)
+			if err != nil && !client.IsResourceTypeNotFound(err) {
+				return fmt.Errorf("listing ModelRegistry resources: %w", err)
+			}
+
+			findings, err := inspectRegistries(ctx, req.Client, registries)
+			if err != nil {
+				return err
+			}
+
+			req.Result.SetCondition(c.newConfiguredCondition(len(registries), findings))
+
+			if impacted := findings.impacted(); len(impacted) > 0 {
+				req.Result.SetImpactedObjects(resources.ModelRegistry, impacted)
+			}
+
+			return nil
+		})
+}
+
+func (c *ReadinessCheck) newConfiguredCondition(total int, f registryFindings) result.Condition {
+	if len(f.missingSecret) == 0 && len(f.unsupportedMySQL) == 0 {
+		return check.NewCondition(
+			check.ConditionTypeConfigured,
+			metav1.ConditionTrue,
+			check.WithReason(check.ReasonConfigurationValid),
+			check.WithCatalogMessage(msgDatabasesValid, total),
+		)
+	}
+
+	var problems []string
+	if n := len(f.missingSecret); n > 0 {
+		problems = append(problems, fmt.Sprintf("%d referencing a missing database password Secret", n))
+	}
+
+	if n := len(f.unsupportedMySQL); n > 0 {
+		problems = append(problems, fmt.Sprintf("%d backed by MySQL older than %s", n, minMySQLVersionLabel))
+	}
+
+	reason := check.ReasonResourceNotFound
+	if len(f.missingSecret) == 0 {
+		reason = check.ReasonVersionIncompatible
+	}
+
+	return check.NewCondition(
+		check.ConditionTypeConfigured,
+		metav1.ConditionFalse,
+		check.WithReason(reason),
+		check.WithCatalogMessage(msgDatabaseIssues, strings.Join(problems, ", ")),
+		check.WithImpact(result.ImpactBlocking),
+		check.WithRemediation(c.CheckRemediation),
+	)
+}
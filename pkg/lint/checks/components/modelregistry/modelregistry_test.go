@@ -0,0 +1,208 @@
+package modelregistry_test
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/testutil"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/components/modelregistry"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+)
+
+const registriesNamespace = "rhoai-model-registries"
+
+//nolint:gochecknoglobals // Test fixture - shared across test functions
+var listKinds = map[schema.GroupVersionResource]string{
+	resources.DataScienceCluster.GVR(): resources.DataScienceCluster.ListKind(),
+	resources.ModelRegistry.GVR():      resources.ModelRegistry.ListKind(),
+	resources.Secret.GVR():             resources.Secret.ListKind(),
+	resources.Deployment.GVR():         resources.Deployment.ListKind(),
+}
+
+func newReadyDSC(ready string) *unstructured.Unstructured {
+	dsc := testutil.NewDSC(map[string]string{"modelregistry": "Managed"})
+	_ = unstructured.SetNestedSlice(dsc.Object, []any{
+		map[string]any{"type": "ModelRegistryReady", "status": ready},
+	}, "status", "conditions")
+
+	return dsc
+}
+
+func newModelRegistry(name string, host string, secretName string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{
+		Object: map[string]any{
+			"apiVersion": resources.ModelRegistry.APIVersion(),
+			"kind":       resources.ModelRegistry.Kind,
+			"metadata": map[string]any{
+				"name":      name,
+				"namespace": registriesNamespace,
+			},
+			"spec": map[string]any{
+				"mysql": map[string]any{
+					"host":     host,
+					"database": "model_registry",
+					"passwordSecret": map[string]any{
+						"name": secretName,
+						"key":  "database-password",
+					},
+				},
+			},
+		},
+	}
+}
+
+func newSecret(name string) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(resources.Secret.GVK())
+	obj.SetNamespace(registriesNamespace)
+	obj.SetName(name)
+
+	return obj
+}
+
+func newMySQLDeployment(name string, image string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{
+		Object: map[string]any{
+			"apiVersion": resources.Deployment.APIVersion(),
+			"kind":       resources.Deployment.Kind,
+			"metadata": map[string]any{
+				"name":      name,
+				"namespace": registriesNamespace,
+			},
+			"spec": map[string]any{
+				"template": map[string]any{
+					"spec": map[string]any{
+						"containers": []any{
+							map[string]any{"name": "mysql", "image": image},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestReadinessCheck_CanApply(t *testing.T) {
+	g := NewWithT(t)
+
+	chk := modelregistry.NewReadinessCheck()
+
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds:      listKinds,
+		Objects:        []*unstructured.Unstructured{newReadyDSC("True")},
+		CurrentVersion: "2.17.0",
+		TargetVersion:  "3.0.0",
+	})
+	canApply, err := chk.CanApply(t.Context(), target)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(canApply).To(BeTrue())
+
+	// Model registry Removed
+	target = testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds:      listKinds,
+		Objects:        []*unstructured.Unstructured{testutil.NewDSC(map[string]string{"modelregistry": "Removed"})},
+		CurrentVersion: "2.17.0",
+		TargetVersion:  "3.0.0",
+	})
+	canApply, err = chk.CanApply(t.Context(), target)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(canApply).To(BeFalse())
+
+	target = testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds:      listKinds,
+		CurrentVersion: "3.0.0",
+		TargetVersion:  "3.1.0",
+	})
+	canApply, err = chk.CanApply(t.Context(), target)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(canApply).To(BeFalse())
+}
+
+func TestReadinessCheck_Healthy(t *testing.T) {
+	g := NewWithT(t)
+
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds: listKinds,
+		Objects: []*unstructured.Unstructured{
+			newReadyDSC("True"),
+			newModelRegistry("registry", "model-registry-db", "db-credentials"),
+			newSecret("db-credentials"),
+			newMySQLDeployment("model-registry-db", "registry.redhat.io/rhel9/mysql-80:latest"),
+		},
+		CurrentVersion: "2.17.0",
+		TargetVersion:  "3.0.0",
+	})
+
+	dr, err := modelregistry.NewReadinessCheck().Validate(t.Context(), target)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(dr.Status.Conditions).To(HaveLen(2))
+	g.Expect(dr.Status.Conditions).To(ContainElements(
+		HaveField("Condition", MatchFields(IgnoreExtras, Fields{
+			"Type":   Equal(check.ConditionTypeAvailable),
+			"Status": Equal(metav1.ConditionTrue),
+			"Reason": Equal(check.ReasonResourceAvailable),
+		})),
+		HaveField("Condition", MatchFields(IgnoreExtras, Fields{
+			"Type":   Equal(check.ConditionTypeConfigured),
+			"Status": Equal(metav1.ConditionTrue),
+			"Reason": Equal(check.ReasonConfigurationValid),
+		})),
+	))
+	g.Expect(dr.ImpactedObjects).To(BeEmpty())
+}
+
+func TestReadinessCheck_NotReadyAndMisconfigured(t *testing.T) {
+	g := NewWithT(t)
+
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds: listKinds,
+		Objects: []*unstructured.Unstructured{
+			newReadyDSC("False"),
+			newModelRegistry("missing-secret", "external-db.example.com", "absent"),
+			newModelRegistry("old-mysql", "mysql57."+registriesNamespace+".svc.cluster.local", "db-credentials"),
+			newSecret("db-credentials"),
+			newMySQLDeployment("mysql57", "docker.io/library/mysql:5.7.44"),
+		},
+		CurrentVersion: "2.17.0",
+		TargetVersion:  "3.0.0",
+	})
+
+	dr, err := modelregistry.NewReadinessCheck().Validate(t.Context(), target)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(dr.Status.Conditions).To(HaveLen(2))
+	g.Expect(dr.Status.Conditions).To(ContainElements(
+		And(
+			HaveField("Condition", MatchFields(IgnoreExtras, Fields{
+				"Type":   Equal(check.ConditionTypeAvailable),
+				"Status": Equal(metav1.ConditionFalse),
+				"Reason": Equal(check.ReasonResourceUnavailable),
+			})),
+			HaveField("Impact", Equal(result.ImpactBlocking)),
+		),
+		And(
+			HaveField("Condition", MatchFields(IgnoreExtras, Fields{
+				"Type":   Equal(check.ConditionTypeConfigured),
+				"Status": Equal(metav1.ConditionFalse),
+				"Reason": Equal(check.ReasonResourceNotFound),
+				"Message": And(
+					ContainSubstring("1 referencing a missing database password Secret"),
+					ContainSubstring("1 backed by MySQL older than 8.0"),
+				),
+			})),
+			HaveField("Impact", Equal(result.ImpactBlocking)),
+		),
+	))
+	g.Expect(dr.ImpactedObjects).To(HaveLen(2))
+	g.Expect(dr.ImpactedObjects[0].Name).To(Equal("missing-secret"))
+	g.Expect(dr.ImpactedObjects[1].Name).To(Equal("old-mysql"))
+}
@@ -0,0 +1,224 @@
+package modelregistry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/messages"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+	"github.com/opendatahub-io/odh-cli/pkg/util/jq"
+)
+
+// Messages of the model registry component readiness.
+//
+//nolint:gochecknoglobals // Message catalog entries
+var (
+	msgReadyConditionMissing = messages.New("components.modelregistry.readiness.condition-missing", "DataScienceCluster does not report a %s condition")
+	msgComponentNotReady     = messages.New("components.modelregistry.readiness.not-ready", "Model registry component is not ready (%s: %s)")
+	msgComponentReady        = messages.New("components.modelregistry.readiness.ready", "Model registry component is ready")
+)
+
+const (
+	// readyConditionType is the DataScienceCluster status condition reporting model registry health.
+	readyConditionType = "ModelRegistryReady"
+
+	// minMySQLMajor is the oldest MySQL major version supported by the 3.x model registry.
+	minMySQLMajor        = 8
+	minMySQLVersionLabel = "8.0"
+)
+
+// mysqlImageVersion matches the MySQL version encoded in an image reference, either as the tag
+// (mysql:5.7.44) or as the Red Hat repository suffix (rhel8/mysql-80).
+var mysqlImageVersion = regexp.MustCompile(`mysql(?:-(\d)\d|[^:/]*:(\d+))`)
+
+// registryFindings collects ModelRegistry instances with database configuration issues.
+type registryFindings struct {
+	missingSecret    []types.NamespacedName
+	unsupportedMySQL []types.NamespacedName
+}
+
+// impacted returns the de-duplicated set of flagged instances, in discovery order.
+func (f registryFindings) impacted() []types.NamespacedName {
+	seen := sets.New[types.NamespacedName]()
+	out := make([]types.NamespacedName, 0, len(f.missingSecret)+len(f.unsupportedMySQL))
+
+	for _, n := range append(append([]types.NamespacedName{}, f.missingSecret...), f.unsupportedMySQL...) {
+		if seen.Has(n) {
+			continue
+		}
+
+		seen.Insert(n)
+		out = append(out, n)
+	}
+
+	return out
+}
+
+// newAvailableCondition maps the ModelRegistryReady condition of the DataScienceCluster to Available.
+func newAvailableCondition(dsc *unstructured.Unstructured) (result.Condition, error) {
+	status, err := jq.Query[string](dsc, fmt.Sprintf(`.status.conditions[] | select(.type == %q) | .status`, readyConditionType))
+
+	switch {
+	case errors.Is(err, jq.ErrNotFound):
+		return check.NewCondition(
+			check.ConditionTypeAvailable,
+			metav1.ConditionUnknown,
+			check.WithReason(check.ReasonInsufficientData),
+			check.WithCatalogMessage(msgReadyConditionMissing, readyConditionType),
+			check.WithImpact(result.ImpactAdvisory),
+		), nil
+	case err != nil:
+		return result.Condition{}, fmt.Errorf("querying %s condition: %w", readyConditionType, err)
+	case status != string(metav1.ConditionTrue):
+		return check.NewCondition(
+			check.ConditionTypeAvailable,
+			metav1.ConditionFalse,
+			check.WithReason(check.ReasonResourceUnavailable),
+			check.WithCatalogMessage(msgComponentNotReady, readyConditionType, status),
+			check.WithImpact(result.ImpactBlocking),
+		), nil
+	default:
+		return check.NewCondition(
+			check.ConditionTypeAvailable,
+			metav1.ConditionTrue,
+			check.WithReason(check.ReasonResourceAvailable),
+			check.WithCatalogMessage(msgComponentReady),
+		), nil
+	}
+}
+
+// inspectRegistries verifies that each registry's database password Secret exists and that a
+// MySQL database deployed in-cluster runs a supported version.
+func inspectRegistries(
+	ctx context.Context,
+	r client.Reader,
+	registries []*unstructured.Unstructured,
+) (registryFindings, error) {
+	var findings registryFindings
+
+	for _, mr := range registries {
+		name := types.NamespacedName{Namespace: mr.GetNamespace(), Name: mr.GetName()}
+
+		secretName, err := jq.Query[string](mr, `(.spec.mysql // .spec.postgres).passwordSecret.name`)
+		if err != nil && !errors.Is(err, jq.ErrNotFound) {
+			return findings, fmt.Errorf("querying database Secret for ModelRegistry %s: %w", name, err)
+		}
+
+		if secretName != "" {
+			exists, err := secretExists(ctx, r, mr.GetNamespace(), secretName)
+			if err != nil {
+				return findings, err
+			}
+
+			if !exists {
+				findings.missingSecret = append(findings.missingSecret, name)
+			}
+		}
+
+		host, err := jq.Query[string](mr, `.spec.mysql.host`)
+		if err != nil && !errors.Is(err, jq.ErrNotFound) {
+			return findings, fmt.Errorf("querying MySQL host for ModelRegistry %s: %w", name, err)
+		}
+
+		if host == "" {
+			continue
+		}
+
+		major, found, err := inClusterMySQLMajor(ctx, r, mr.GetNamespace(), host)
+		if err != nil {
+			return findings, err
+		}
+
+		if found && major < minMySQLMajor {
+			findings.unsupportedMySQL = append(findings.unsupportedMySQL, name)
+		}
+	}
+
+	return findings, nil
+}
+
+func secretExists(ctx context.Context, r client.Reader, namespace string, name string) (bool, error) {
+	_, err := r.GetResourceMetadata(ctx, resources.Secret, name, client.InNamespace(namespace))
+
+	switch {
+	case apierrors.IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("getting Secret %s/%s: %w", namespace, name, err)
+	default:
+		return true, nil
+	}
+}
+
+// inClusterMySQLMajor resolves the MySQL major version from the Deployment serving the given host.
+// The Deployment is matched by the Service name in the host; found is false for external databases
+// or images whose version cannot be determined.
+func inClusterMySQLMajor(
+	ctx context.Context,
+	r client.Reader,
+	namespace string,
+	host string,
+) (int, bool, error) {
+	serviceName, hostNamespace, _ := strings.Cut(host, ".")
+	if ns, _, _ := strings.Cut(hostNamespace, "."); ns != "" {
+		namespace = ns
+	}
+
+	deployment, err := r.GetResource(ctx, resources.Deployment, serviceName, client.InNamespace(namespace))
+
+	switch {
+	case apierrors.IsNotFound(err):
+		return 0, false, nil
+	case err != nil:
+		return 0, false, fmt.Errorf("getting Deployment %s/%s: %w", namespace, serviceName, err)
+	case deployment == nil:
+		return 0, false, nil
+	}
+
+	images, err := jq.Query[[]any](deployment, `[.spec.template.spec.containers[]?.image]`)
+	if err != nil {
+		return 0, false, fmt.Errorf("querying images of Deployment %s/%s: %w", namespace, serviceName, err)
+	}
+
+	for _, img := range images {
+		image, _ := img.(string)
+		if major, ok := parseMySQLMajor(image); ok {
+			return major, true, nil
+		}
+	}
+
+	return 0, false, nil
+}
+
+// parseMySQLMajor extracts the MySQL major version from an image reference.
+func parseMySQLMajor(image string) (int, bool) {
+	m := mysqlImageVersion.FindStringSubmatch(image)
+	if m == nil {
+		return 0, false
+	}
+
+	digits := m[1]
+	if digits == "" {
+		digits = m[2]
+	}
+
+	major, err := strconv.Atoi(digits)
+	if err != nil {
+		return 0, false
+	}
+
+	return major, true
+}
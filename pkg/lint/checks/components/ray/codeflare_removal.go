@@ -2,15 +2,18 @@ package ray
 
 import (
 	"context"
-	"fmt"
 
-	"github.com/opendatahub-io/odh-cli/pkg/constants"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/messages"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check/validate"
-	"github.com/opendatahub-io/odh-cli/pkg/util/client"
-	"github.com/opendatahub-io/odh-cli/pkg/util/components"
-	"github.com/opendatahub-io/odh-cli/pkg/util/version"
+)
+
+// Messages of the CodeFlare removal check.
+//
+//nolint:gochecknoglobals // Message catalog entries
+var (
+	msgCodeFlareEnabled = messages.New("components.ray.codeflare-removal.enabled", "CodeFlare is enabled (state: %s) but will be removed in RHOAI %s")
 )
 
 const (
@@ -33,33 +36,21 @@ func NewCodeFlareRemovalCheck() *CodeFlareRemovalCheck {
 			Kind:             kind,
 			Type:             check.CheckTypeRemoval,
 			CheckID:          "components.ray.codeflare-removal",
+			CheckTags:        []string{check.TagFast, check.TagBlockingOnly},
 			CheckName:        "Components :: Ray :: CodeFlare Removal (3.x)",
 			CheckDescription: "Validates that the CodeFlare security layer is disabled before upgrading from RHOAI 2.x to 3.x",
 			CheckRemediation: "Disable CodeFlare by setting managementState to 'Removed' in DataScienceCluster before upgrading",
+			CheckVersions:    check.VersionsUpgrade2xTo3x,
+			CheckComponents:  []string{dscComponent},
 		},
 	}
 }
 
-// CanApply returns whether this check should run for the given target.
-// This check only applies when upgrading FROM 2.x TO 3.x and CodeFlare is Managed.
-func (c *CodeFlareRemovalCheck) CanApply(ctx context.Context, target check.Target) (bool, error) {
-	if !version.IsUpgradeFrom2xTo3x(target.CurrentVersion, target.TargetVersion) {
-		return false, nil
-	}
-
-	dsc, err := client.GetDataScienceCluster(ctx, target.Client)
-	if err != nil {
-		return false, fmt.Errorf("getting DataScienceCluster: %w", err)
-	}
-
-	return components.HasManagementState(dsc, dscComponent, constants.ManagementStateManaged), nil
-}
-
 // Validate executes the check against the provided target.
 func (c *CodeFlareRemovalCheck) Validate(ctx context.Context, target check.Target) (*result.DiagnosticResult, error) {
 	return validate.Component(c, target).
 		WithComponentName(dscComponent).
-		Run(ctx, validate.Removal("CodeFlare is enabled (state: %s) but will be removed in RHOAI %s",
+		Run(ctx, validate.Removal(msgCodeFlareEnabled,
 			check.WithImpact(result.ImpactBlocking),
 			check.WithRemediation(c.CheckRemediation)))
 }
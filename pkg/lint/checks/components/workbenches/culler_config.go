@@ -0,0 +1,171 @@
+package workbenches
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/opendatahub-io/odh-cli/pkg/constants"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/messages"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/validate"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+	"github.com/opendatahub-io/odh-cli/pkg/util/version"
+)
+
+const (
+	cullerConfigCheckType = "culler-config"
+
+	// CullerConfigName is the name of the ConfigMap holding the notebook controller culling
+	// settings, written by the Dashboard culler settings page in the applications namespace.
+	CullerConfigName = "notebook-controller-culler-config"
+
+	// Culling settings of the notebook controller.
+	CullerKeyEnableCulling       = "ENABLE_CULLING"
+	CullerKeyCullIdleTime        = "CULL_IDLE_TIME"
+	CullerKeyIdlenessCheckPeriod = "IDLENESS_CHECK_PERIOD"
+)
+
+// Messages of the culler config check.
+//
+//nolint:gochecknoglobals // Message catalog entries
+var (
+	msgCullerConfigNotFound       = messages.New("components.workbenches.culler-config.not-found", "%s ConfigMap not found in namespace %s - notebook culling uses the defaults")
+	msgCullerConfigDefault        = messages.New("components.workbenches.culler-config.default", "%s ConfigMap matches the RHOAI %s culling defaults - nothing to re-apply")
+	msgCullerConfigCustomSettings = messages.New("components.workbenches.culler-config.custom-settings", "%s ConfigMap has %d custom culling setting(s) not preserved by the upgrade to RHOAI %s: %s")
+)
+
+// cullerDefaults are the culling settings the notebook controller is deployed with in 3.x.
+// Keys missing from this table are not read by the 3.x notebook controller.
+//
+//nolint:gochecknoglobals // Constant-like table used across check methods.
+var cullerDefaults = map[string]string{
+	CullerKeyEnableCulling:       "false",
+	CullerKeyCullIdleTime:        "1440",
+	CullerKeyIdlenessCheckPeriod: "1",
+}
+
+// CullerConfigCheck detects custom notebook culling configuration. In 3.x the notebook
+// controller is redeployed with its default culling settings, so idle-notebook culling
+// configured through the Dashboard or directly in the ConfigMap must be re-applied after
+// upgrade.
+type CullerConfigCheck struct {
+	check.BaseCheck
+}
+
+// NewCullerConfigCheck creates a new CullerConfigCheck instance.
+func NewCullerConfigCheck() *CullerConfigCheck {
+	return &CullerConfigCheck{
+		BaseCheck: check.BaseCheck{
+			CheckGroup:       check.GroupComponent,
+			Kind:             constants.ComponentWorkbenches,
+			Type:             cullerConfigCheckType,
+			CheckID:          "components.workbenches.culler-config",
+			CheckName:        "Components :: Workbenches :: Notebook Culler Configuration (3.x)",
+			CheckDescription: "Detects custom notebook culling settings in the notebook-controller-culler-config ConfigMap whose keys or defaults change in RHOAI 3.x",
+			CheckRemediation: "Record the custom culling settings and re-apply them from the Dashboard culler settings or to the notebook-controller-culler-config ConfigMap after upgrading",
+			CheckResources:   []resources.ResourceType{resources.ConfigMap},
+			CheckVersions:    check.VersionsUpgrade2xTo3x,
+			CheckComponents:  []string{constants.ComponentWorkbenches},
+			CheckDependsOn:   []string{check.CheckIDDSCReadiness},
+		},
+	}
+}
+
+// Validate executes the check against the provided target.
+func (c *CullerConfigCheck) Validate(ctx context.Context, target check.Target) (*result.DiagnosticResult, error) {
+	return validate.Component(c, target).
+		WithApplicationsNamespace().
+		Run(ctx, c.checkCullerConfig)
+}
+
+// checkCullerConfig reports the culler ConfigMap as impacted when it holds settings differing
+// from the 3.x defaults, annotated with those settings.
+func (c *CullerConfigCheck) checkCullerConfig(ctx context.Context, req *validate.ComponentRequest) error {
+	tv := version.MajorMinorLabel(req.TargetVersion)
+
+	cm, err := req.Client.GetResource(ctx, resources.ConfigMap, CullerConfigName,
+		client.InNamespace(req.ApplicationsNamespace))
+
+	switch {
+	case apierrors.IsNotFound(err):
+		req.Result.SetCondition(check.NewCondition(
+			check.ConditionTypeMigrationRequired,
+			metav1.ConditionTrue,
+			check.WithReason(check.ReasonNoMigrationRequired),
+			check.WithCatalogMessage(msgCullerConfigNotFound, CullerConfigName, req.ApplicationsNamespace),
+		))
+
+		return nil
+	case err != nil:
+		return fmt.Errorf("getting %s ConfigMap: %w", CullerConfigName, err)
+	}
+
+	custom, err := customCullerSettings(cm)
+	if err != nil {
+		return err
+	}
+
+	if len(custom) == 0 {
+		req.Result.SetCondition(check.NewCondition(
+			check.ConditionTypeMigrationRequired,
+			metav1.ConditionTrue,
+			check.WithReason(check.ReasonNoMigrationRequired),
+			check.WithCatalogMessage(msgCullerConfigDefault, CullerConfigName, tv),
+		))
+
+		return nil
+	}
+
+	req.Result.SetImpactedObjects(resources.ConfigMap, []types.NamespacedName{
+		{Namespace: cm.GetNamespace(), Name: cm.GetName()},
+	})
+	req.Result.ImpactedObjects[0].Annotations = map[string]string{
+		result.AnnotationObjectContext: strings.Join(custom, ", "),
+	}
+
+	req.Result.SetCondition(check.NewCondition(
+		check.ConditionTypeMigrationRequired,
+		metav1.ConditionFalse,
+		check.WithReason(check.ReasonMigrationPending),
+		check.WithCatalogMessage(msgCullerConfigCustomSettings, CullerConfigName, len(custom), tv, strings.Join(custom, ", ")),
+		check.WithImpact(result.ImpactAdvisory),
+		check.WithRemediation(c.CheckRemediation),
+	))
+
+	return nil
+}
+
+// customCullerSettings returns the settings of the culler ConfigMap that differ from the 3.x
+// defaults or are not read by the 3.x notebook controller, sorted by key.
+func customCullerSettings(cm *unstructured.Unstructured) ([]string, error) {
+	data, _, err := unstructured.NestedStringMap(cm.Object, "data")
+	if err != nil {
+		return nil, fmt.Errorf("reading %s ConfigMap data: %w", CullerConfigName, err)
+	}
+
+	var custom []string
+
+	for key, value := range data {
+		def, known := cullerDefaults[key]
+
+		switch {
+		case !known:
+			custom = append(custom, fmt.Sprintf("%s=%s (not supported)", key, value))
+		case strings.TrimSpace(value) != def:
+			custom = append(custom, fmt.Sprintf("%s=%s (default: %s)", key, value, def))
+		}
+	}
+
+	slices.Sort(custom)
+
+	return custom, nil
+}
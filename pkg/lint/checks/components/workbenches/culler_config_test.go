@@ -0,0 +1,149 @@
+package workbenches_test
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/testutil"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/components/workbenches"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+)
+
+const applicationsNamespace = "redhat-ods-applications"
+
+//nolint:gochecknoglobals // Test fixture - shared across test functions
+var listKinds = map[schema.GroupVersionResource]string{
+	resources.DataScienceCluster.GVR(): resources.DataScienceCluster.ListKind(),
+	resources.DSCInitialization.GVR():  resources.DSCInitialization.ListKind(),
+	resources.ConfigMap.GVR():          resources.ConfigMap.ListKind(),
+}
+
+func newCullerConfig(data map[string]any) *unstructured.Unstructured {
+	return &unstructured.Unstructured{
+		Object: map[string]any{
+			"apiVersion": resources.ConfigMap.APIVersion(),
+			"kind":       resources.ConfigMap.Kind,
+			"metadata": map[string]any{
+				"name":      workbenches.CullerConfigName,
+				"namespace": applicationsNamespace,
+			},
+			"data": data,
+		},
+	}
+}
+
+func newTarget(t *testing.T, objects ...*unstructured.Unstructured) check.Target {
+	t.Helper()
+
+	return testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds: listKinds,
+		Objects: append([]*unstructured.Unstructured{
+			testutil.NewDSC(map[string]string{"workbenches": "Managed"}),
+			testutil.NewDSCI(applicationsNamespace),
+		}, objects...),
+		CurrentVersion: "2.17.0",
+		TargetVersion:  "3.0.0",
+	})
+}
+
+func TestCullerConfigCheck_CanApply(t *testing.T) {
+	g := NewWithT(t)
+
+	chk := workbenches.NewCullerConfigCheck()
+
+	canApply, err := chk.CanApply(t.Context(), newTarget(t))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(canApply).To(BeTrue())
+
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds:      listKinds,
+		Objects:        []*unstructured.Unstructured{testutil.NewDSC(map[string]string{"workbenches": "Removed"})},
+		CurrentVersion: "2.17.0",
+		TargetVersion:  "3.0.0",
+	})
+
+	canApply, err = chk.CanApply(t.Context(), target)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(canApply).To(BeFalse())
+}
+
+func TestCullerConfigCheck_Validate_NotFound(t *testing.T) {
+	g := NewWithT(t)
+
+	dr, err := workbenches.NewCullerConfigCheck().Validate(t.Context(), newTarget(t))
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(dr.Status.Conditions).To(HaveLen(1))
+	g.Expect(dr.Status.Conditions[0].Condition).To(MatchFields(IgnoreExtras, Fields{
+		"Type":    Equal(check.ConditionTypeMigrationRequired),
+		"Status":  Equal(metav1.ConditionTrue),
+		"Reason":  Equal(check.ReasonNoMigrationRequired),
+		"Message": ContainSubstring("not found"),
+	}))
+	g.Expect(dr.ImpactedObjects).To(BeEmpty())
+}
+
+func TestCullerConfigCheck_Validate_Defaults(t *testing.T) {
+	g := NewWithT(t)
+
+	cm := newCullerConfig(map[string]any{
+		workbenches.CullerKeyEnableCulling:       "false",
+		workbenches.CullerKeyCullIdleTime:        "1440",
+		workbenches.CullerKeyIdlenessCheckPeriod: "1",
+	})
+
+	dr, err := workbenches.NewCullerConfigCheck().Validate(t.Context(), newTarget(t, cm))
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(dr.Status.Conditions).To(HaveLen(1))
+	g.Expect(dr.Status.Conditions[0].Condition).To(MatchFields(IgnoreExtras, Fields{
+		"Status":  Equal(metav1.ConditionTrue),
+		"Message": ContainSubstring("culling defaults"),
+	}))
+	g.Expect(dr.ImpactedObjects).To(BeEmpty())
+}
+
+func TestCullerConfigCheck_Validate_CustomSettings(t *testing.T) {
+	g := NewWithT(t)
+
+	cm := newCullerConfig(map[string]any{
+		workbenches.CullerKeyEnableCulling:       "true",
+		workbenches.CullerKeyCullIdleTime:        "240",
+		workbenches.CullerKeyIdlenessCheckPeriod: "1",
+		"CLUSTER_DOMAIN":                         "cluster.local",
+	})
+
+	dr, err := workbenches.NewCullerConfigCheck().Validate(t.Context(), newTarget(t, cm))
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(dr.Status.Conditions).To(HaveLen(1))
+	g.Expect(dr.Status.Conditions[0].Condition).To(MatchFields(IgnoreExtras, Fields{
+		"Type":    Equal(check.ConditionTypeMigrationRequired),
+		"Status":  Equal(metav1.ConditionFalse),
+		"Reason":  Equal(check.ReasonMigrationPending),
+		"Message": ContainSubstring("3 custom culling setting(s)"),
+	}))
+	g.Expect(dr.Status.Conditions[0].Impact).To(Equal(result.ImpactAdvisory))
+	g.Expect(dr.ImpactedObjects).To(HaveLen(1))
+	g.Expect(dr.ImpactedObjects[0].Name).To(Equal(workbenches.CullerConfigName))
+	g.Expect(dr.ImpactedObjects[0].Annotations).To(HaveKeyWithValue(result.AnnotationObjectContext,
+		"CLUSTER_DOMAIN=cluster.local (not supported), CULL_IDLE_TIME=240 (default: 1440), ENABLE_CULLING=true (default: false)"))
+}
+
+func TestCullerConfigCheck_Metadata(t *testing.T) {
+	g := NewWithT(t)
+
+	chk := workbenches.NewCullerConfigCheck()
+
+	g.Expect(chk.ID()).To(Equal("components.workbenches.culler-config"))
+	g.Expect(chk.Group()).To(Equal(check.GroupComponent))
+	g.Expect(chk.Description()).To(ContainSubstring(workbenches.CullerConfigName))
+}
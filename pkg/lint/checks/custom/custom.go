@@ -0,0 +1,155 @@
+package custom
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/messages"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+)
+
+const defaultKind = "custom"
+
+// Messages of the policy checks. The description of the violated policy is provided by the user
+// and not translated.
+//
+//nolint:gochecknoglobals // Message catalog entries
+var (
+	msgNoViolations = messages.New("custom.policy.none", "No violations found in %d object(s)")
+	msgViolations   = messages.New("custom.policy.violations", "Found %d object(s) violating the policy: %s")
+)
+
+// Check evaluates a user-defined Descriptor policy against the objects of its resource types.
+// Every object for which the policy reports a violation is listed as impacted, with the messages
+// of its deny rule as its context.
+type Check struct {
+	check.BaseCheck
+
+	policy    *policy
+	impact    result.Impact
+	resources []resources.ResourceType
+}
+
+// NewCheck creates a check from a descriptor, which must have been validated to compile its policy.
+func NewCheck(d *Descriptor) *Check {
+	name := d.Name
+	if name == "" {
+		name = d.ID
+	}
+
+	kind := d.Kind
+	if kind == "" {
+		kind = defaultKind
+	}
+
+	rts := make([]resources.ResourceType, 0, len(d.Resources))
+	for _, r := range d.Resources {
+		rts = append(rts, r.ResourceType())
+	}
+
+	return &Check{
+		BaseCheck: check.BaseCheck{
+			CheckGroup:       d.Group,
+			Kind:             kind,
+			Type:             check.CheckTypeCustomPolicy,
+			CheckID:          d.ID,
+			CheckName:        name,
+			CheckDescription: d.Description,
+			CheckRemediation: d.Remediation,
+			CheckResources:   rts,
+			CheckVersions:    check.VersionsAny,
+		},
+		policy:    d.policy,
+		impact:    d.Impact,
+		resources: rts,
+	}
+}
+
+// CanApply returns true: custom checks run for every version.
+func (c *Check) CanApply(_ context.Context, _ check.Target) (bool, error) {
+	return true, nil
+}
+
+// Validate lists the objects of each resource type, honoring the target label selector, and
+// evaluates the policy against each of them. Resource types not installed on the cluster are skipped.
+func (c *Check) Validate(ctx context.Context, target check.Target) (*result.DiagnosticResult, error) {
+	dr := c.NewResult()
+
+	if c.policy == nil {
+		return nil, fmt.Errorf("custom check %s: policy not compiled, the descriptor was not validated", c.CheckID)
+	}
+
+	var (
+		evaluated int
+		impacted  = make([]metav1.PartialObjectMetadata, 0)
+	)
+
+	for _, rt := range c.resources {
+		items, err := target.Client.List(ctx, rt, client.WithLabelSelector(target.LabelSelector))
+		if err != nil {
+			if client.IsResourceTypeNotFound(err) {
+				continue
+			}
+
+			return nil, fmt.Errorf("listing %s: %w", rt.Kind, err)
+		}
+
+		for _, item := range items {
+			evaluated++
+
+			violations, err := c.policy.evaluate(ctx, item.Object)
+			if err != nil {
+				return nil, fmt.Errorf("evaluating policy against %s %s/%s: %w",
+					rt.Kind, item.GetNamespace(), item.GetName(), err)
+			}
+
+			if len(violations) == 0 {
+				continue
+			}
+
+			impacted = append(impacted, metav1.PartialObjectMetadata{
+				TypeMeta: rt.TypeMeta(),
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: item.GetNamespace(),
+					Name:      item.GetName(),
+					Annotations: map[string]string{
+						result.AnnotationObjectContext: strings.Join(violations, "; "),
+						result.AnnotationObjectCRDName: rt.CRDFQN(),
+					},
+				},
+			})
+		}
+	}
+
+	dr.Annotations[check.AnnotationImpactedWorkloadCount] = strconv.Itoa(len(impacted))
+	dr.ImpactedObjects = impacted
+
+	if len(impacted) == 0 {
+		dr.SetCondition(check.NewCondition(
+			check.ConditionTypeValidated,
+			metav1.ConditionTrue,
+			check.WithReason(check.ReasonRequirementsMet),
+			check.WithCatalogMessage(msgNoViolations, evaluated),
+		))
+
+		return dr, nil
+	}
+
+	dr.SetCondition(check.NewCondition(
+		check.ConditionTypeValidated,
+		metav1.ConditionFalse,
+		check.WithReason(check.ReasonConfigurationInvalid),
+		check.WithCatalogMessage(msgViolations, len(impacted), c.CheckDescription),
+		check.WithImpact(c.impact),
+		check.WithRemediation(c.CheckRemediation),
+	))
+
+	return dr, nil
+}
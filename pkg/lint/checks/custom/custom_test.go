@@ -0,0 +1,208 @@
+package custom_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	resultpkg "github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/testutil"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/custom"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+)
+
+//nolint:gochecknoglobals // Test fixture - shared across test functions
+var listKinds = map[schema.GroupVersionResource]string{
+	resources.Notebook.GVR(): resources.Notebook.ListKind(),
+}
+
+const ownerLabelDescriptor = `
+id: custom.notebook.owner-label
+name: "Custom :: Notebook :: Owner Label"
+group: workload
+kind: notebook
+description: Notebooks must carry an owner label
+remediation: Label every Notebook with its owner
+impact: blocking
+resources:
+  - group: kubeflow.org
+    version: v1
+    kind: Notebook
+    resource: notebooks
+policy: |
+  package custom.notebook
+
+  deny contains "missing owner label" if not input.metadata.labels.owner
+`
+
+func newNotebook(name string, labels map[string]any) *unstructured.Unstructured {
+	return &unstructured.Unstructured{
+		Object: map[string]any{
+			"apiVersion": resources.Notebook.APIVersion(),
+			"kind":       resources.Notebook.Kind,
+			"metadata": map[string]any{
+				"name":      name,
+				"namespace": "ds-project",
+				"labels":    labels,
+			},
+		},
+	}
+}
+
+func writeDescriptor(t *testing.T, dir string, name string, content string) string {
+	t.Helper()
+
+	path := filepath.Join(dir, name)
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	return path
+}
+
+func loadCheck(t *testing.T, content string) *custom.Check {
+	t.Helper()
+
+	d, err := custom.LoadDescriptor(writeDescriptor(t, t.TempDir(), "check.yaml", content))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return custom.NewCheck(d)
+}
+
+func TestCheck_Metadata(t *testing.T) {
+	g := NewWithT(t)
+
+	chk := loadCheck(t, ownerLabelDescriptor)
+
+	g.Expect(chk.ID()).To(Equal("custom.notebook.owner-label"))
+	g.Expect(chk.Name()).To(Equal("Custom :: Notebook :: Owner Label"))
+	g.Expect(chk.Group()).To(Equal(check.GroupWorkload))
+	g.Expect(chk.CheckKind()).To(Equal("notebook"))
+	g.Expect(chk.CheckType()).To(Equal(string(check.CheckTypeCustomPolicy)))
+	g.Expect(chk.RequiredResources()).To(ConsistOf(resources.Notebook))
+}
+
+func TestCheck_NoViolations(t *testing.T) {
+	g := NewWithT(t)
+
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds: listKinds,
+		Objects: []*unstructured.Unstructured{
+			newNotebook("owned", map[string]any{"owner": "alice"}),
+		},
+	})
+
+	dr, err := loadCheck(t, ownerLabelDescriptor).Validate(t.Context(), target)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(dr.Status.Conditions).To(HaveLen(1))
+	g.Expect(dr.Status.Conditions[0].Condition).To(MatchFields(IgnoreExtras, Fields{
+		"Type":    Equal(check.ConditionTypeValidated),
+		"Status":  Equal(metav1.ConditionTrue),
+		"Reason":  Equal(check.ReasonRequirementsMet),
+		"Message": ContainSubstring("1 object(s)"),
+	}))
+	g.Expect(dr.ImpactedObjects).To(BeEmpty())
+}
+
+func TestCheck_Violations(t *testing.T) {
+	g := NewWithT(t)
+
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds: listKinds,
+		Objects: []*unstructured.Unstructured{
+			newNotebook("owned", map[string]any{"owner": "alice"}),
+			newNotebook("orphan", map[string]any{"app": "jupyter"}),
+		},
+	})
+
+	dr, err := loadCheck(t, ownerLabelDescriptor).Validate(t.Context(), target)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(dr.Status.Conditions).To(HaveLen(1))
+	g.Expect(dr.Status.Conditions[0].Condition).To(MatchFields(IgnoreExtras, Fields{
+		"Status":  Equal(metav1.ConditionFalse),
+		"Reason":  Equal(check.ReasonConfigurationInvalid),
+		"Message": ContainSubstring("Found 1 object(s) violating the policy"),
+	}))
+	g.Expect(dr.Status.Conditions[0].Impact).To(Equal(resultpkg.ImpactBlocking))
+	g.Expect(dr.Status.Conditions[0].Remediation).To(Equal("Label every Notebook with its owner"))
+	g.Expect(dr.Annotations).To(HaveKeyWithValue(check.AnnotationImpactedWorkloadCount, "1"))
+	g.Expect(dr.ImpactedObjects).To(HaveLen(1))
+	g.Expect(dr.ImpactedObjects[0].Name).To(Equal("orphan"))
+	g.Expect(dr.ImpactedObjects[0].Annotations).To(HaveKeyWithValue(resultpkg.AnnotationObjectContext, "missing owner label"))
+}
+
+func TestCheck_InvalidPolicyResult(t *testing.T) {
+	g := NewWithT(t)
+
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds: listKinds,
+		Objects:   []*unstructured.Unstructured{newNotebook("nb", nil)},
+	})
+
+	d := &custom.Descriptor{
+		ID:          "custom.notebook.count",
+		Group:       check.GroupWorkload,
+		Description: "reports a number",
+		Impact:      resultpkg.ImpactAdvisory,
+		Resources:   []custom.Resource{{Group: "kubeflow.org", Version: "v1", Kind: "Notebook", Resource: "notebooks"}},
+		Policy:      "package custom.count\n\ndeny contains 1 if true\n",
+	}
+	g.Expect(d.Validate()).To(Succeed())
+
+	_, err := custom.NewCheck(d).Validate(t.Context(), target)
+	g.Expect(err).To(MatchError(ContainSubstring("deny must contain string messages")))
+}
+
+func TestCheck_PolicyFile(t *testing.T) {
+	g := NewWithT(t)
+
+	dir := t.TempDir()
+	writeDescriptor(t, dir, "owner-label.rego", `package custom.notebook
+
+deny contains msg if {
+	not input.metadata.labels.owner
+	msg := sprintf("%s has no owner label", [input.metadata.name])
+}
+
+deny contains "app label must be jupyter" if input.metadata.labels.app != "jupyter"
+`)
+
+	d, err := custom.LoadDescriptor(writeDescriptor(t, dir, "check.yaml", `
+id: custom.notebook.labels
+group: workload
+impact: advisory
+description: Notebooks must carry owner and app labels
+resources:
+  - {group: kubeflow.org, version: v1, kind: Notebook, resource: notebooks}
+policyFile: owner-label.rego
+`))
+	g.Expect(err).ToNot(HaveOccurred())
+
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds: listKinds,
+		Objects: []*unstructured.Unstructured{
+			newNotebook("owned", map[string]any{"owner": "alice", "app": "jupyter"}),
+			newNotebook("orphan", map[string]any{"app": "vscode"}),
+		},
+	})
+
+	dr, err := custom.NewCheck(d).Validate(t.Context(), target)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(dr.ImpactedObjects).To(HaveLen(1))
+	g.Expect(dr.ImpactedObjects[0].Name).To(Equal("orphan"))
+	g.Expect(dr.ImpactedObjects[0].Annotations).To(HaveKeyWithValue(resultpkg.AnnotationObjectContext,
+		"app label must be jupyter; orphan has no owner label"))
+}
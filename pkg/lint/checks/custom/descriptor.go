@@ -0,0 +1,234 @@
+package custom
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/stdin"
+)
+
+// idPrefix is required on custom check IDs so they never collide with built-in checks.
+const idPrefix = "custom."
+
+// descriptorExtensions lists the file extensions loaded from a custom checks directory.
+//
+//nolint:gochecknoglobals // Read-only lookup table
+var descriptorExtensions = []string{".yaml", ".yml", ".json"}
+
+// Descriptor is the YAML/JSON definition of a user-defined check.
+//
+// The policy is a Rego module evaluated against every listed object, passed as input. Its deny
+// rule holds the violations of the object as a set of messages (used as the per-object reason);
+// an empty or undefined deny means the object complies.
+//
+//	id: custom.notebook.owner-label
+//	group: workload
+//	impact: advisory
+//	description: Notebooks must carry an owner label
+//	resources:
+//	  - {group: kubeflow.org, version: v1, kind: Notebook, resource: notebooks}
+//	policyFile: owner-label.rego
+//
+// with owner-label.rego:
+//
+//	package custom.notebook
+//
+//	deny contains "missing owner label" if not input.metadata.labels.owner
+type Descriptor struct {
+	// ID uniquely identifies the check and must start with "custom.".
+	ID string `json:"id" yaml:"id"`
+
+	// Name is the human-readable check name; defaults to the ID.
+	Name string `json:"name,omitempty" yaml:"name,omitempty"`
+
+	// Group is the check group (component, dependency, platform, service, workload).
+	Group check.CheckGroup `json:"group" yaml:"group"`
+
+	// Kind is the kind reported for results; defaults to "custom".
+	Kind string `json:"kind,omitempty" yaml:"kind,omitempty"`
+
+	// Description explains what the check validates.
+	Description string `json:"description" yaml:"description"`
+
+	// Remediation is the guidance attached to violations.
+	Remediation string `json:"remediation,omitempty" yaml:"remediation,omitempty"`
+
+	// Impact is the impact of violations (prohibited, blocking, advisory).
+	Impact result.Impact `json:"impact" yaml:"impact"`
+
+	// Resources lists the resource types whose objects are evaluated.
+	Resources []Resource `json:"resources" yaml:"resources"`
+
+	// Policy is the inline Rego module evaluated against each object.
+	Policy string `json:"policy,omitempty" yaml:"policy,omitempty"`
+
+	// PolicyFile is the path of the Rego module, relative to the descriptor; replaces Policy.
+	PolicyFile string `json:"policyFile,omitempty" yaml:"policyFile,omitempty"`
+
+	// policy is the compiled Policy, set by Validate.
+	policy *policy
+}
+
+// Resource identifies a resource type to list; it mirrors resources.ResourceType.
+type Resource struct {
+	Group    string `json:"group,omitempty" yaml:"group,omitempty"`
+	Version  string `json:"version"         yaml:"version"`
+	Kind     string `json:"kind"            yaml:"kind"`
+	Resource string `json:"resource"        yaml:"resource"`
+}
+
+// ResourceType converts the resource to a resources.ResourceType.
+func (r Resource) ResourceType() resources.ResourceType {
+	return resources.ResourceType{
+		Group:    r.Group,
+		Version:  r.Version,
+		Kind:     r.Kind,
+		Resource: r.Resource,
+	}
+}
+
+// Validate checks the descriptor fields and compiles the policy.
+func (d *Descriptor) Validate() error {
+	if !strings.HasPrefix(d.ID, idPrefix) || len(d.ID) == len(idPrefix) {
+		return fmt.Errorf("id %q must start with %q", d.ID, idPrefix)
+	}
+
+	if !slices.Contains(check.CanonicalGroupOrder, d.Group) {
+		return fmt.Errorf("%s: invalid group %q (must be one of: component, dependency, platform, service, workload)", d.ID, d.Group)
+	}
+
+	if !slices.Contains([]result.Impact{result.ImpactProhibited, result.ImpactBlocking, result.ImpactAdvisory}, d.Impact) {
+		return fmt.Errorf("%s: invalid impact %q (must be one of: prohibited, blocking, advisory)", d.ID, d.Impact)
+	}
+
+	if d.Description == "" {
+		return fmt.Errorf("%s: description must not be empty", d.ID)
+	}
+
+	if len(d.Resources) == 0 {
+		return fmt.Errorf("%s: at least one resource is required", d.ID)
+	}
+
+	for _, r := range d.Resources {
+		if r.Version == "" || r.Kind == "" || r.Resource == "" {
+			return fmt.Errorf("%s: resource %q must set version, kind and resource", d.ID, r.Kind)
+		}
+	}
+
+	if strings.TrimSpace(d.Policy) == "" {
+		return fmt.Errorf("%s: policy must not be empty", d.ID)
+	}
+
+	pol, err := compilePolicy(context.Background(), d.policyFilename(), d.Policy)
+	if err != nil {
+		return fmt.Errorf("%s: invalid policy: %w", d.ID, err)
+	}
+
+	d.policy = pol
+
+	return nil
+}
+
+// policyFilename names the policy module in Rego errors.
+func (d *Descriptor) policyFilename() string {
+	if d.PolicyFile != "" {
+		return d.PolicyFile
+	}
+
+	return d.ID + ".rego"
+}
+
+// LoadDescriptor reads and validates a single descriptor file; unknown fields are rejected.
+func LoadDescriptor(path string) (*Descriptor, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("opening custom check: %w", err)
+	}
+	defer func() { _ = f.Close() }()
+
+	var d Descriptor
+	if err := stdin.Parse(f, &d); err != nil {
+		return nil, fmt.Errorf("parsing custom check %s: %w", path, err)
+	}
+
+	if d.PolicyFile != "" {
+		if d.Policy != "" {
+			return nil, fmt.Errorf("custom check %s: policy and policyFile are mutually exclusive", path)
+		}
+
+		policyPath := d.PolicyFile
+		if !filepath.IsAbs(policyPath) {
+			policyPath = filepath.Join(filepath.Dir(path), policyPath)
+		}
+
+		module, err := os.ReadFile(policyPath)
+		if err != nil {
+			return nil, fmt.Errorf("custom check %s: reading policy: %w", path, err)
+		}
+
+		d.Policy = string(module)
+	}
+
+	if err := d.Validate(); err != nil {
+		return nil, fmt.Errorf("custom check %s: %w", path, err)
+	}
+
+	return &d, nil
+}
+
+// LoadChecks loads every descriptor in dir (or the single file dir points to) and returns the
+// checks in file name order. Duplicate IDs are rejected.
+func LoadChecks(dir string) ([]*Check, error) {
+	info, err := os.Stat(dir)
+	if err != nil {
+		return nil, fmt.Errorf("reading custom checks: %w", err)
+	}
+
+	paths := []string{dir}
+
+	if info.IsDir() {
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			return nil, fmt.Errorf("reading custom checks directory: %w", err)
+		}
+
+		paths = paths[:0]
+
+		for _, e := range entries {
+			if !e.IsDir() && slices.Contains(descriptorExtensions, strings.ToLower(filepath.Ext(e.Name()))) {
+				paths = append(paths, filepath.Join(dir, e.Name()))
+			}
+		}
+	}
+
+	if len(paths) == 0 {
+		return nil, errors.New("no custom check descriptors (.yaml, .yml, .json) found in " + dir)
+	}
+
+	checks := make([]*Check, 0, len(paths))
+	seen := make(map[string]string, len(paths))
+
+	for _, path := range paths {
+		d, err := LoadDescriptor(path)
+		if err != nil {
+			return nil, err
+		}
+
+		if prev, ok := seen[d.ID]; ok {
+			return nil, fmt.Errorf("custom check %s: duplicate id %q (also defined in %s)", path, d.ID, prev)
+		}
+
+		seen[d.ID] = path
+		checks = append(checks, NewCheck(d))
+	}
+
+	return checks, nil
+}
@@ -0,0 +1,64 @@
+package custom_test
+
+import (
+	"testing"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/custom"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestLoadChecks(t *testing.T) {
+	g := NewWithT(t)
+
+	dir := t.TempDir()
+	writeDescriptor(t, dir, "a.yaml", ownerLabelDescriptor)
+	writeDescriptor(t, dir, "README.md", "not a descriptor")
+
+	checks, err := custom.LoadChecks(dir)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(checks).To(HaveLen(1))
+	g.Expect(checks[0].ID()).To(Equal("custom.notebook.owner-label"))
+
+	writeDescriptor(t, dir, "b.yml", ownerLabelDescriptor)
+
+	_, err = custom.LoadChecks(dir)
+	g.Expect(err).To(MatchError(ContainSubstring("duplicate id")))
+}
+
+func TestLoadDescriptor_Invalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		wantErr string
+	}{
+		{name: "missing prefix", content: "id: notebook.owner", wantErr: `must start with "custom."`},
+		{name: "invalid group", content: "id: custom.x\ngroup: nope", wantErr: "invalid group"},
+		{name: "invalid impact", content: "id: custom.x\ngroup: workload\nimpact: fatal", wantErr: "invalid impact"},
+		{
+			name:    "invalid policy",
+			content: "id: custom.x\ngroup: workload\nimpact: advisory\ndescription: d\nresources: [{version: v1, kind: Pod, resource: pods}]\npolicy: 'package x\n\ndeny contains'",
+			wantErr: "invalid policy",
+		},
+		{
+			name:    "policy without deny rule",
+			content: "id: custom.x\ngroup: workload\nimpact: advisory\ndescription: d\nresources: [{version: v1, kind: Pod, resource: pods}]\npolicy: 'package x\n\nallow := true'",
+			wantErr: `must define a "deny" rule`,
+		},
+		{
+			name:    "policy and policy file",
+			content: "id: custom.x\npolicy: 'package x'\npolicyFile: x.rego",
+			wantErr: "mutually exclusive",
+		},
+		{name: "unknown field", content: "id: custom.x\nrego: deny", wantErr: "unknown field"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			_, err := custom.LoadDescriptor(writeDescriptor(t, t.TempDir(), "check.yaml", tt.content))
+			g.Expect(err).To(MatchError(ContainSubstring(tt.wantErr)))
+		})
+	}
+}
@@ -0,0 +1,88 @@
+package custom
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/open-policy-agent/opa/v1/ast"
+	"github.com/open-policy-agent/opa/v1/rego"
+)
+
+// policyRule is the rule of a policy module collecting the violations of the object.
+const policyRule = "deny"
+
+// policy is a compiled Rego module evaluated against each listed object, passed as input.
+type policy struct {
+	query rego.PreparedEvalQuery
+}
+
+// compilePolicy parses the Rego module (v1 syntax) and prepares the query of its deny rule.
+// Builtin errors (e.g. a type error in a comparison) fail the evaluation instead of leaving the
+// rule undefined, so a broken policy is not mistaken for a compliant object.
+func compilePolicy(ctx context.Context, filename string, module string) (*policy, error) {
+	mod, err := ast.ParseModule(filename, module)
+	if err != nil {
+		return nil, fmt.Errorf("parsing Rego module: %w", err)
+	}
+
+	if mod == nil {
+		return nil, errors.New("parsing Rego module: empty module")
+	}
+
+	defined := false
+
+	for _, rule := range mod.Rules {
+		if rule.Head.Ref().String() == policyRule {
+			defined = true
+
+			break
+		}
+	}
+
+	if !defined {
+		return nil, fmt.Errorf("package %s must define a %q rule", mod.Package.Path, policyRule)
+	}
+
+	query, err := rego.New(
+		rego.Query(mod.Package.Path.String()+"."+policyRule),
+		rego.Module(filename, module),
+		rego.StrictBuiltinErrors(true),
+	).PrepareForEval(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("compiling Rego module: %w", err)
+	}
+
+	return &policy{query: query}, nil
+}
+
+// evaluate returns the messages the deny rule reports for the object; none means it complies.
+func (p *policy) evaluate(ctx context.Context, obj map[string]any) ([]string, error) {
+	rs, err := p.query.Eval(ctx, rego.EvalInput(obj))
+	if err != nil {
+		return nil, fmt.Errorf("evaluating Rego policy: %w", err)
+	}
+
+	// An undefined deny rule yields no result
+	if len(rs) == 0 || len(rs[0].Expressions) == 0 {
+		return nil, nil
+	}
+
+	values, ok := rs[0].Expressions[0].Value.([]any)
+	if !ok {
+		return nil, fmt.Errorf("%s must be a set of messages, got %T", policyRule, rs[0].Expressions[0].Value)
+	}
+
+	messages := make([]string, 0, len(values))
+
+	for _, v := range values {
+		msg, ok := v.(string)
+		if !ok {
+			return nil, fmt.Errorf("%s must contain string messages, got %T", policyRule, v)
+		}
+
+		messages = append(messages, msg)
+	}
+
+	return messages, nil
+}
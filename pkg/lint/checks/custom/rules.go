@@ -0,0 +1,300 @@
+package custom
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"text/template"
+
+	"github.com/google/cel-go/cel"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/messages"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/validate"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/stdin"
+)
+
+// Messages of the rule checks.
+//
+//nolint:gochecknoglobals // Message catalog entries
+var (
+	msgRuleSatisfied = messages.New("custom.rule.none", "No %s matches rule %s")
+	msgRuleMatched   = messages.New("custom.rule.matched", "Found %d %s(s) matching rule %s")
+)
+
+const (
+	// ruleObjectVariable is the CEL variable holding the evaluated object, as in Kubernetes
+	// ValidatingAdmissionPolicy expressions.
+	ruleObjectVariable = "object"
+)
+
+// RulesFile is the YAML/JSON schema of the file passed with --rules-file.
+type RulesFile struct {
+	Rules []Rule `json:"rules" yaml:"rules"`
+}
+
+// Rule is a lightweight workload gate: every object of Resource for which Expression evaluates to
+// true is reported as impacted, with Message rendered against the object as its context.
+//
+//	rules:
+//	  - id: custom.isvc.owner-label
+//	    description: InferenceServices must carry an owner label
+//	    resource: {group: serving.kserve.io, version: v1beta1, kind: InferenceService, resource: inferenceservices}
+//	    expression: '!has(object.metadata.labels) || !("owner" in object.metadata.labels)'
+//	    message: 'InferenceService {{.metadata.name}} has no owner label'
+//	    impact: advisory
+type Rule struct {
+	// ID uniquely identifies the rule and must start with "custom.".
+	ID string `json:"id" yaml:"id"`
+
+	// Description explains what the rule gates; defaults to the ID.
+	Description string `json:"description,omitempty" yaml:"description,omitempty"`
+
+	// Resource is the resource type whose objects are evaluated.
+	Resource Resource `json:"resource" yaml:"resource"`
+
+	// Expression is a CEL boolean expression over the object variable; true marks the object
+	// as impacted.
+	Expression string `json:"expression" yaml:"expression"`
+
+	// Message is a text/template rendered against each matching object.
+	Message string `json:"message" yaml:"message"`
+
+	// Impact is the impact of matches (prohibited, blocking, advisory).
+	Impact result.Impact `json:"impact" yaml:"impact"`
+
+	// Remediation is the guidance attached to matches.
+	Remediation string `json:"remediation,omitempty" yaml:"remediation,omitempty"`
+}
+
+// validate checks the rule fields, compiles the expression and parses the message template.
+func (r *Rule) validate(env *cel.Env) (cel.Program, *template.Template, error) {
+	if !strings.HasPrefix(r.ID, idPrefix) || len(r.ID) == len(idPrefix) {
+		return nil, nil, fmt.Errorf("id %q must start with %q", r.ID, idPrefix)
+	}
+
+	if !slices.Contains([]result.Impact{result.ImpactProhibited, result.ImpactBlocking, result.ImpactAdvisory}, r.Impact) {
+		return nil, nil, fmt.Errorf("%s: invalid impact %q (must be one of: prohibited, blocking, advisory)", r.ID, r.Impact)
+	}
+
+	if r.Resource.Version == "" || r.Resource.Kind == "" || r.Resource.Resource == "" {
+		return nil, nil, fmt.Errorf("%s: resource must set version, kind and resource", r.ID)
+	}
+
+	if strings.TrimSpace(r.Expression) == "" {
+		return nil, nil, fmt.Errorf("%s: expression must not be empty", r.ID)
+	}
+
+	program, err := compileExpression(env, r.Expression)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: invalid expression: %w", r.ID, err)
+	}
+
+	if r.Message == "" {
+		return nil, nil, fmt.Errorf("%s: message must not be empty", r.ID)
+	}
+
+	tmpl, err := template.New(r.ID).Option("missingkey=zero").Parse(r.Message)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: invalid message template: %w", r.ID, err)
+	}
+
+	return program, tmpl, nil
+}
+
+// newRuleEnv creates the CEL environment of rule expressions, declaring the object variable.
+func newRuleEnv() (*cel.Env, error) {
+	env, err := cel.NewEnv(cel.Variable(ruleObjectVariable, cel.DynType))
+	if err != nil {
+		return nil, fmt.Errorf("creating CEL environment: %w", err)
+	}
+
+	return env, nil
+}
+
+// compileExpression type-checks a rule expression, which must evaluate to a boolean, and plans
+// its program. Expressions over object fields are dynamically typed, so their result is only
+// known to be a boolean at evaluation.
+func compileExpression(env *cel.Env, expression string) (cel.Program, error) {
+	ast, issues := env.Compile(expression)
+	if issues.Err() != nil {
+		return nil, issues.Err() //nolint:wrapcheck // Wrapped with the rule ID by the caller
+	}
+
+	if out := ast.OutputType(); !out.IsExactType(cel.BoolType) && !out.IsExactType(cel.DynType) {
+		return nil, fmt.Errorf("expression must evaluate to a bool, got %s", out)
+	}
+
+	program, err := env.Program(ast)
+	if err != nil {
+		return nil, fmt.Errorf("planning expression: %w", err)
+	}
+
+	return program, nil
+}
+
+// RuleCheck runs a Rule through the generic workload builder, so label selectors and lint-ignore
+// annotations apply as for built-in workload checks.
+type RuleCheck struct {
+	check.BaseCheck
+
+	expression cel.Program
+	message    *template.Template
+	impact     result.Impact
+	resource   resources.ResourceType
+}
+
+// LoadRules reads a rules file and returns a check per rule. Duplicate IDs are rejected.
+func LoadRules(path string) ([]*RuleCheck, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("opening rules file: %w", err)
+	}
+	defer func() { _ = f.Close() }()
+
+	var file RulesFile
+	if err := stdin.Parse(f, &file); err != nil {
+		return nil, fmt.Errorf("parsing rules file %s: %w", path, err)
+	}
+
+	if len(file.Rules) == 0 {
+		return nil, errors.New("rules file " + path + " defines no rules")
+	}
+
+	env, err := newRuleEnv()
+	if err != nil {
+		return nil, err
+	}
+
+	checks := make([]*RuleCheck, 0, len(file.Rules))
+	seen := make(map[string]bool, len(file.Rules))
+
+	for i := range file.Rules {
+		rule := &file.Rules[i]
+
+		program, tmpl, err := rule.validate(env)
+		if err != nil {
+			return nil, fmt.Errorf("rules file %s: %w", path, err)
+		}
+
+		if seen[rule.ID] {
+			return nil, fmt.Errorf("rules file %s: duplicate id %q", path, rule.ID)
+		}
+
+		seen[rule.ID] = true
+		checks = append(checks, newRuleCheck(rule, program, tmpl))
+	}
+
+	return checks, nil
+}
+
+func newRuleCheck(r *Rule, program cel.Program, tmpl *template.Template) *RuleCheck {
+	description := r.Description
+	if description == "" {
+		description = r.ID
+	}
+
+	rt := r.Resource.ResourceType()
+
+	return &RuleCheck{
+		BaseCheck: check.BaseCheck{
+			CheckGroup:       check.GroupWorkload,
+			Kind:             strings.ToLower(rt.Kind),
+			Type:             check.CheckTypeCustomPolicy,
+			CheckID:          r.ID,
+			CheckName:        r.ID,
+			CheckDescription: description,
+			CheckRemediation: r.Remediation,
+			CheckResources:   []resources.ResourceType{rt},
+			CheckVersions:    check.VersionsAny,
+		},
+		expression: program,
+		message:    tmpl,
+		impact:     r.Impact,
+		resource:   rt,
+	}
+}
+
+// CanApply returns true: rules run for every version.
+func (c *RuleCheck) CanApply(_ context.Context, _ check.Target) (bool, error) {
+	return true, nil
+}
+
+// Validate lists the rule's resource, keeps the objects matching the expression and lists them
+// as impacted with the rendered message.
+func (c *RuleCheck) Validate(ctx context.Context, target check.Target) (*result.DiagnosticResult, error) {
+	return validate.Workloads(c, target, c.resource).
+		Filter(c.matches).
+		Run(ctx, func(_ context.Context, req *validate.WorkloadRequest[*unstructured.Unstructured]) error {
+			if len(req.Items) == 0 {
+				req.Result.SetCondition(check.NewCondition(
+					check.ConditionTypeValidated,
+					metav1.ConditionTrue,
+					check.WithReason(check.ReasonRequirementsMet),
+					check.WithCatalogMessage(msgRuleSatisfied, c.resource.Kind, c.CheckID),
+				))
+
+				return nil
+			}
+
+			impacted := make([]metav1.PartialObjectMetadata, 0, len(req.Items))
+
+			for _, item := range req.Items {
+				var msg strings.Builder
+				if err := c.message.Execute(&msg, item.Object); err != nil {
+					return fmt.Errorf("rendering message of rule %s for %s/%s: %w",
+						c.CheckID, item.GetNamespace(), item.GetName(), err)
+				}
+
+				impacted = append(impacted, metav1.PartialObjectMetadata{
+					TypeMeta: c.resource.TypeMeta(),
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: item.GetNamespace(),
+						Name:      item.GetName(),
+						Annotations: map[string]string{
+							result.AnnotationObjectContext: msg.String(),
+						},
+					},
+				})
+			}
+
+			req.Result.Annotations[result.AnnotationResourceCRDName] = c.resource.CRDFQN()
+			req.Result.ImpactedObjects = impacted
+			req.Result.SetCondition(check.NewCondition(
+				check.ConditionTypeValidated,
+				metav1.ConditionFalse,
+				check.WithReason(check.ReasonWorkloadsImpacted),
+				check.WithCatalogMessage(msgRuleMatched, len(req.Items), c.resource.Kind, c.CheckID),
+				check.WithImpact(c.impact),
+				check.WithRemediation(c.CheckRemediation),
+			))
+
+			return nil
+		})
+}
+
+// matches evaluates the rule expression against the object. As in Kubernetes admission
+// policies, selecting a missing field is an error; expressions guard optional fields with has().
+func (c *RuleCheck) matches(obj *unstructured.Unstructured) (bool, error) {
+	out, _, err := c.expression.Eval(map[string]any{ruleObjectVariable: obj.Object})
+	if err != nil {
+		return false, fmt.Errorf("evaluating rule %s against %s/%s: %w",
+			c.CheckID, obj.GetNamespace(), obj.GetName(), err)
+	}
+
+	matched, ok := out.Value().(bool)
+	if !ok {
+		return false, fmt.Errorf("evaluating rule %s against %s/%s: expression must evaluate to a bool, got %s",
+			c.CheckID, obj.GetNamespace(), obj.GetName(), out.Type())
+	}
+
+	return matched, nil
+}
@@ -0,0 +1,161 @@
+package custom_test
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	resultpkg "github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/testutil"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/custom"
+
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+)
+
+const ownerRules = `
+rules:
+  - id: custom.notebook.owner-label
+    description: Notebooks must carry an owner label
+    resource: {group: kubeflow.org, version: v1, kind: Notebook, resource: notebooks}
+    expression: '!("owner" in object.metadata.labels)'
+    message: 'Notebook {{.metadata.name}} in {{.metadata.namespace}} has no owner label'
+    impact: advisory
+`
+
+func loadRules(t *testing.T, content string) []*custom.RuleCheck {
+	t.Helper()
+
+	rules, err := custom.LoadRules(writeDescriptor(t, t.TempDir(), "rules.yaml", content))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return rules
+}
+
+func TestRuleCheck_Metadata(t *testing.T) {
+	g := NewWithT(t)
+
+	rules := loadRules(t, ownerRules)
+
+	g.Expect(rules).To(HaveLen(1))
+	g.Expect(rules[0].ID()).To(Equal("custom.notebook.owner-label"))
+	g.Expect(rules[0].Group()).To(Equal(check.GroupWorkload))
+	g.Expect(rules[0].CheckKind()).To(Equal("notebook"))
+	g.Expect(rules[0].Description()).To(Equal("Notebooks must carry an owner label"))
+}
+
+func TestRuleCheck_NoMatches(t *testing.T) {
+	g := NewWithT(t)
+
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds: listKinds,
+		Objects: []*unstructured.Unstructured{
+			newNotebook("owned", map[string]any{"owner": "alice"}),
+		},
+	})
+
+	dr, err := loadRules(t, ownerRules)[0].Validate(t.Context(), target)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(dr.Status.Conditions).To(HaveLen(1))
+	g.Expect(dr.Status.Conditions[0].Condition).To(MatchFields(IgnoreExtras, Fields{
+		"Type":   Equal(check.ConditionTypeValidated),
+		"Status": Equal(metav1.ConditionTrue),
+		"Reason": Equal(check.ReasonRequirementsMet),
+	}))
+	g.Expect(dr.Annotations).To(HaveKeyWithValue(check.AnnotationImpactedWorkloadCount, "0"))
+}
+
+func TestRuleCheck_Matches(t *testing.T) {
+	g := NewWithT(t)
+
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds: listKinds,
+		Objects: []*unstructured.Unstructured{
+			newNotebook("owned", map[string]any{"owner": "alice"}),
+			newNotebook("orphan", map[string]any{"app": "jupyter"}),
+		},
+	})
+
+	dr, err := loadRules(t, ownerRules)[0].Validate(t.Context(), target)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(dr.Status.Conditions).To(HaveLen(1))
+	g.Expect(dr.Status.Conditions[0].Condition).To(MatchFields(IgnoreExtras, Fields{
+		"Status":  Equal(metav1.ConditionFalse),
+		"Reason":  Equal(check.ReasonWorkloadsImpacted),
+		"Message": Equal("Found 1 Notebook(s) matching rule custom.notebook.owner-label"),
+	}))
+	g.Expect(dr.Status.Conditions[0].Impact).To(Equal(resultpkg.ImpactAdvisory))
+	g.Expect(dr.Annotations).To(HaveKeyWithValue(check.AnnotationImpactedWorkloadCount, "1"))
+	g.Expect(dr.ImpactedObjects).To(HaveLen(1))
+	g.Expect(dr.ImpactedObjects[0].Annotations).To(HaveKeyWithValue(
+		resultpkg.AnnotationObjectContext, "Notebook orphan in ds-project has no owner label"))
+}
+
+func TestLoadRules_Invalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		wantErr string
+	}{
+		{name: "no rules", content: "rules: []", wantErr: "defines no rules"},
+		{name: "missing prefix", content: "rules:\n  - id: owner\n    impact: advisory", wantErr: `must start with "custom."`},
+		{
+			name:    "invalid expression",
+			content: "rules:\n  - id: custom.x\n    impact: advisory\n    resource: {version: v1, kind: Pod, resource: pods}\n    expression: 'object.metadata.'\n    message: m",
+			wantErr: "invalid expression",
+		},
+		{
+			name:    "non-boolean expression",
+			content: "rules:\n  - id: custom.x\n    impact: advisory\n    resource: {version: v1, kind: Pod, resource: pods}\n    expression: 'size(object.metadata.name)'\n    message: m",
+			wantErr: "must evaluate to a bool",
+		},
+		{
+			name:    "invalid template",
+			content: "rules:\n  - id: custom.x\n    impact: advisory\n    resource: {version: v1, kind: Pod, resource: pods}\n    expression: 'true'\n    message: '{{.metadata'",
+			wantErr: "invalid message template",
+		},
+		{
+			name: "duplicate id",
+			content: "rules:\n" +
+				"  - {id: custom.x, impact: advisory, resource: {version: v1, kind: Pod, resource: pods}, expression: 'true', message: m}\n" +
+				"  - {id: custom.x, impact: advisory, resource: {version: v1, kind: Pod, resource: pods}, expression: 'true', message: m}",
+			wantErr: "duplicate id",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			_, err := custom.LoadRules(writeDescriptor(t, t.TempDir(), "rules.yaml", tt.content))
+			g.Expect(err).To(MatchError(ContainSubstring(tt.wantErr)))
+		})
+	}
+}
+
+func TestRuleCheck_EvaluationError(t *testing.T) {
+	g := NewWithT(t)
+
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds: listKinds,
+		Objects:   []*unstructured.Unstructured{newNotebook("nb", nil)},
+	})
+
+	rules := loadRules(t, `
+rules:
+  - id: custom.notebook.stopped
+    resource: {group: kubeflow.org, version: v1, kind: Notebook, resource: notebooks}
+    expression: 'object.spec.stopped'
+    message: 'Notebook {{.metadata.name}} is stopped'
+    impact: advisory
+`)
+
+	_, err := rules[0].Validate(t.Context(), target)
+	g.Expect(err).To(MatchError(ContainSubstring("evaluating rule custom.notebook.stopped against ds-project/nb")))
+}
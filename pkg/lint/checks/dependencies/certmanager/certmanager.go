@@ -2,19 +2,37 @@ package certmanager
 
 import (
 	"context"
+	"strings"
+
+	"github.com/blang/semver/v4"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/messages"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check/validate"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/compat"
+	"github.com/opendatahub-io/odh-cli/pkg/util/version"
+)
+
+// Messages of the cert-manager installed check.
+//
+//nolint:gochecknoglobals // Message catalog entries
+var (
+	msgNotInstalled     = messages.New("dependencies.certmanager.installed.not-installed", "%s is not installed")
+	msgInstalled        = messages.New("dependencies.certmanager.installed.installed", "%s installed: %s")
+	msgVersionUnknown   = messages.New("dependencies.certmanager.installed.version-unknown", "%s installed: %s, but its version could not be determined (RHOAI %s requires %s or later)")
+	msgVersionTooOld    = messages.New("dependencies.certmanager.installed.version-too-old", "%s %s is older than %s required by RHOAI %s")
+	msgVersionSupported = messages.New("dependencies.certmanager.installed.version-supported", "%s installed: %s (RHOAI %s requires %s or later)")
 )
 
 const kind = "cert-manager"
 
 const displayName = "cert-manager Operator for Red Hat OpenShift"
 
-// Check validates cert-manager operator installation.
+// Check validates cert-manager operator installation and that its version meets the minimum
+// required by the target release.
 type Check struct {
 	check.BaseCheck
 }
@@ -26,8 +44,11 @@ func NewCheck() *Check {
 			Kind:             kind,
 			Type:             check.CheckTypeInstalled,
 			CheckID:          "dependencies.certmanager.installed",
+			CheckTags:        []string{check.TagFast},
 			CheckName:        "Dependencies :: cert-manager :: Installed",
-			CheckDescription: "Reports the cert-manager operator installation status and version",
+			CheckDescription: "Reports the cert-manager operator installation status and verifies its version meets the target release minimum",
+			CheckRemediation: "Install or upgrade the " + displayName + " to the minimum version required by the target release before upgrading",
+			CheckVersions:    check.VersionsAny,
 		},
 	}
 }
@@ -36,26 +57,83 @@ func (c *Check) CanApply(_ context.Context, _ check.Target) (bool, error) {
 	return true, nil
 }
 
+// Validate reports whether cert-manager is installed and meets the target release minimum.
 func (c *Check) Validate(ctx context.Context, target check.Target) (*result.DiagnosticResult, error) {
 	return validate.Operator(c, target).
 		WithNames("cert-manager", "openshift-cert-manager-operator").
-		WithConditionBuilder(func(found bool, version string) result.Condition {
+		WithConditionBuilder(func(found bool, csv string) result.Condition {
 			if !found {
 				return check.NewCondition(
 					check.ConditionTypeAvailable,
 					metav1.ConditionFalse,
 					check.WithReason(check.ReasonResourceNotFound),
-					check.WithMessage("%s is not installed", displayName),
+					check.WithCatalogMessage(msgNotInstalled, displayName),
 					check.WithImpact(result.ImpactBlocking),
 				)
 			}
 
-			return check.NewCondition(
-				check.ConditionTypeAvailable,
-				metav1.ConditionTrue,
-				check.WithReason(check.ReasonResourceFound),
-				check.WithMessage("%s installed: %s", displayName, version),
-			)
+			return c.versionCondition(target, csv)
 		}).
 		Run(ctx)
 }
+
+// versionCondition compares the installed CSV version against the minimum for the target release.
+// A version that cannot be determined is reported as advisory rather than blocking.
+func (c *Check) versionCondition(target check.Target, csv string) result.Condition {
+	minimum, ok := target.Compat().MinimumOperatorVersion(compat.OperatorCertManager, target.TargetVersion)
+	if !ok {
+		return check.NewCondition(
+			check.ConditionTypeAvailable,
+			metav1.ConditionTrue,
+			check.WithReason(check.ReasonResourceFound),
+			check.WithCatalogMessage(msgInstalled, displayName, csv),
+		)
+	}
+
+	tv := version.MajorMinorLabel(target.TargetVersion)
+
+	installed, ok := csvVersion(csv)
+	if !ok {
+		return check.NewCondition(
+			check.ConditionTypeAvailable,
+			metav1.ConditionUnknown,
+			check.WithReason(check.ReasonInsufficientData),
+			check.WithCatalogMessage(msgVersionUnknown, displayName, csv, tv, minimum),
+			check.WithImpact(result.ImpactAdvisory),
+			check.WithRemediation(c.CheckRemediation),
+		)
+	}
+
+	if installed.LT(minimum) {
+		return check.NewCondition(
+			check.ConditionTypeAvailable,
+			metav1.ConditionFalse,
+			check.WithReason(check.ReasonVersionIncompatible),
+			check.WithCatalogMessage(msgVersionTooOld, displayName, installed, minimum, tv),
+			check.WithImpact(result.ImpactBlocking),
+			check.WithRemediation(c.CheckRemediation),
+		)
+	}
+
+	return check.NewCondition(
+		check.ConditionTypeAvailable,
+		metav1.ConditionTrue,
+		check.WithReason(check.ReasonResourceFound),
+		check.WithCatalogMessage(msgVersionSupported, displayName, csv, tv, minimum),
+	)
+}
+
+// csvVersion extracts the semantic version from a CSV name such as "cert-manager-operator.v1.12.0".
+func csvVersion(csv string) (semver.Version, bool) {
+	_, raw, found := strings.Cut(csv, ".v")
+	if !found {
+		return semver.Version{}, false
+	}
+
+	v, err := semver.ParseTolerant(raw)
+	if err != nil {
+		return semver.Version{}, false
+	}
+
+	return v, true
+}
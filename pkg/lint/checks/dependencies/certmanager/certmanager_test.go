@@ -1,6 +1,7 @@
 package certmanager_test
 
 import (
+	"strings"
 	"testing"
 
 	operatorsv1alpha1 "github.com/operator-framework/api/pkg/operators/v1alpha1"
@@ -12,6 +13,7 @@ import (
 	resultpkg "github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check/testutil"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/dependencies/certmanager"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/compat"
 
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
@@ -116,3 +118,101 @@ func TestCertManagerCheck_Metadata(t *testing.T) {
 	g.Expect(certManagerCheck.Group()).To(Equal(check.GroupDependency))
 	g.Expect(certManagerCheck.Description()).ToNot(BeEmpty())
 }
+
+func newCertManagerSubscription(csv string) *operatorsv1alpha1.Subscription {
+	return &operatorsv1alpha1.Subscription{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "openshift-cert-manager-operator",
+			Namespace: "cert-manager-operator",
+		},
+		Status: operatorsv1alpha1.SubscriptionStatus{
+			InstalledCSV: csv,
+		},
+	}
+}
+
+func TestCertManagerCheck_TargetMinimumMet(t *testing.T) {
+	g := NewWithT(t)
+	ctx := t.Context()
+
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		OLM:           operatorfake.NewSimpleClientset(newCertManagerSubscription("cert-manager-operator.v1.17.0")), //nolint:staticcheck // NewClientset requires generated apply configs not available in OLM
+		TargetVersion: "3.2.0",
+	})
+
+	result, err := certmanager.NewCheck().Validate(ctx, target)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(result.Status.Conditions).To(HaveLen(1))
+	g.Expect(result.Status.Conditions[0].Condition).To(MatchFields(IgnoreExtras, Fields{
+		"Status":  Equal(metav1.ConditionTrue),
+		"Reason":  Equal(check.ReasonResourceFound),
+		"Message": ContainSubstring("RHOAI 3.2 requires 1.17.0 or later"),
+	}))
+}
+
+func TestCertManagerCheck_TargetMinimumNotMet(t *testing.T) {
+	g := NewWithT(t)
+	ctx := t.Context()
+
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		OLM:           operatorfake.NewSimpleClientset(newCertManagerSubscription("cert-manager-operator.v1.15.1")), //nolint:staticcheck // NewClientset requires generated apply configs not available in OLM
+		TargetVersion: "3.0.0",
+	})
+
+	result, err := certmanager.NewCheck().Validate(ctx, target)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(result.Status.Conditions).To(HaveLen(1))
+	g.Expect(result.Status.Conditions[0].Condition).To(MatchFields(IgnoreExtras, Fields{
+		"Status":  Equal(metav1.ConditionFalse),
+		"Reason":  Equal(check.ReasonVersionIncompatible),
+		"Message": ContainSubstring("1.15.1 is older than 1.16.0 required by RHOAI 3.0"),
+	}))
+	g.Expect(result.Status.Conditions[0].Impact).To(Equal(resultpkg.ImpactBlocking))
+}
+
+func TestCertManagerCheck_TargetVersionUnknown(t *testing.T) {
+	g := NewWithT(t)
+	ctx := t.Context()
+
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		OLM:           operatorfake.NewSimpleClientset(newCertManagerSubscription("cert-manager-operator-custom")), //nolint:staticcheck // NewClientset requires generated apply configs not available in OLM
+		TargetVersion: "3.0.0",
+	})
+
+	result, err := certmanager.NewCheck().Validate(ctx, target)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(result.Status.Conditions).To(HaveLen(1))
+	g.Expect(result.Status.Conditions[0].Condition).To(MatchFields(IgnoreExtras, Fields{
+		"Status":  Equal(metav1.ConditionUnknown),
+		"Reason":  Equal(check.ReasonInsufficientData),
+		"Message": ContainSubstring("could not be determined"),
+	}))
+	g.Expect(result.Status.Conditions[0].Impact).To(Equal(resultpkg.ImpactAdvisory))
+}
+
+func TestCertManagerCheck_CompatMatrixOverride(t *testing.T) {
+	g := NewWithT(t)
+	ctx := t.Context()
+
+	matrix, err := compat.Parse(strings.NewReader("releases:\n  - version: \"3.0\"\n    operators:\n      cert-manager: 1.18.0\n"))
+	g.Expect(err).ToNot(HaveOccurred())
+
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		OLM:           operatorfake.NewSimpleClientset(newCertManagerSubscription("cert-manager-operator.v1.16.0")), //nolint:staticcheck // NewClientset requires generated apply configs not available in OLM
+		TargetVersion: "3.0.0",
+	})
+	target.CompatMatrix = matrix
+
+	result, err := certmanager.NewCheck().Validate(ctx, target)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(result.Status.Conditions).To(HaveLen(1))
+	g.Expect(result.Status.Conditions[0].Condition).To(MatchFields(IgnoreExtras, Fields{
+		"Status":  Equal(metav1.ConditionFalse),
+		"Reason":  Equal(check.ReasonVersionIncompatible),
+		"Message": ContainSubstring("1.16.0 is older than 1.18.0 required by RHOAI 3.0"),
+	}))
+}
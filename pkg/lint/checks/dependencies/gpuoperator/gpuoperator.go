@@ -0,0 +1,137 @@
+package gpuoperator
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/messages"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/util/version"
+)
+
+const kind = "gpu-operator"
+
+//nolint:gochecknoglobals // Message catalog entries
+var (
+	msgNoAcceleratorWorkloads = messages.New("dependencies.gpuoperator.installed.no-accelerator-workloads", "No workloads request GPU accelerators - no GPU operator required")
+	msgOLMUnavailable         = messages.New("dependencies.gpuoperator.installed.olm-unavailable", "OLM client not available - cannot verify GPU operator installation for %d accelerator-backed workload(s)")
+	msgOperatorsReady         = messages.New("dependencies.gpuoperator.installed.ready", "GPU operators installed for all accelerator-backed workloads: %s")
+	msgOperatorsNotReady      = messages.New("dependencies.gpuoperator.installed.not-ready", "Accelerator-backed workloads may fail after upgrading to RHOAI %s: %s")
+)
+
+// Check verifies that the GPU operator matching each accelerator vendor requested by workloads is
+// installed at a supported version, so accelerator-backed workloads keep running after upgrade.
+type Check struct {
+	check.BaseCheck
+}
+
+func NewCheck() *Check {
+	return &Check{
+		BaseCheck: check.BaseCheck{
+			CheckGroup:       check.GroupDependency,
+			Kind:             kind,
+			Type:             check.CheckTypeInstalled,
+			CheckID:          "dependencies.gpuoperator.installed",
+			CheckName:        "Dependencies :: GPU Operator :: Installed",
+			CheckDescription: "Verifies the NVIDIA or AMD GPU operator is installed at a supported version when workloads request GPU accelerators",
+			CheckRemediation: "Install or upgrade the NVIDIA GPU Operator or AMD GPU Operator from OperatorHub to the minimum version supported by the target release before upgrading",
+			CheckResources:   workloadResources,
+			CheckVersions:    check.VersionsTarget3x,
+		},
+	}
+}
+
+// Validate collects the accelerator resources requested by workloads and checks the GPU operator
+// installed for each vendor.
+func (c *Check) Validate(ctx context.Context, target check.Target) (*result.DiagnosticResult, error) {
+	dr := c.NewResult()
+	tv := version.MajorMinorLabel(target.TargetVersion)
+
+	if target.TargetVersion != nil {
+		dr.Annotations[check.AnnotationCheckTargetVersion] = target.TargetVersion.String()
+	}
+
+	workloads, err := findAcceleratorWorkloads(ctx, target.Client)
+	if err != nil {
+		return nil, err
+	}
+
+	total := 0
+	for _, w := range workloads {
+		total += len(w)
+	}
+
+	if total == 0 {
+		dr.SetCondition(check.NewCondition(
+			check.ConditionTypeAvailable,
+			metav1.ConditionTrue,
+			check.WithReason(check.ReasonRequirementsMet),
+			check.WithCatalogMessage(msgNoAcceleratorWorkloads),
+		))
+
+		return dr, nil
+	}
+
+	if !target.Client.OLM().Available() {
+		dr.SetCondition(check.NewCondition(
+			check.ConditionTypeAvailable,
+			metav1.ConditionUnknown,
+			check.WithReason(check.ReasonInsufficientData),
+			check.WithCatalogMessage(msgOLMUnavailable, total),
+		))
+
+		return dr, nil
+	}
+
+	var installed, problems []string
+
+	for _, v := range acceleratorVendors {
+		impacted := workloads[v.resourceName]
+		if len(impacted) == 0 {
+			continue
+		}
+
+		status, err := inspectOperator(ctx, target.Client, target.Compat(), target.TargetVersion, v)
+		if err != nil {
+			return nil, err
+		}
+
+		if status.ok {
+			installed = append(installed, status.message)
+
+			continue
+		}
+
+		problems = append(problems, fmt.Sprintf("%s (%d workload(s) request %s)", status.message, len(impacted), v.resourceName))
+
+		dr.ImpactedObjects = append(dr.ImpactedObjects, impacted...)
+	}
+
+	if len(problems) == 0 {
+		dr.SetCondition(check.NewCondition(
+			check.ConditionTypeAvailable,
+			metav1.ConditionTrue,
+			check.WithReason(check.ReasonResourceFound),
+			check.WithCatalogMessage(msgOperatorsReady, strings.Join(installed, ", ")),
+		))
+
+		return dr, nil
+	}
+
+	dr.Annotations[check.AnnotationImpactedWorkloadCount] = strconv.Itoa(len(dr.ImpactedObjects))
+	dr.SetCondition(check.NewCondition(
+		check.ConditionTypeAvailable,
+		metav1.ConditionFalse,
+		check.WithReason(check.ReasonDependencyUnavailable),
+		check.WithCatalogMessage(msgOperatorsNotReady, tv, strings.Join(problems, "; ")),
+		check.WithImpact(result.ImpactAdvisory),
+		check.WithRemediation(c.CheckRemediation),
+	))
+
+	return dr, nil
+}
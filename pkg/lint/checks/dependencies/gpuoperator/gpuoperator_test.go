@@ -0,0 +1,197 @@
+package gpuoperator_test
+
+import (
+	"testing"
+
+	operatorsv1alpha1 "github.com/operator-framework/api/pkg/operators/v1alpha1"
+	operatorfake "github.com/operator-framework/operator-lifecycle-manager/pkg/api/client/clientset/versioned/fake"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	resultpkg "github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/testutil"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/dependencies/gpuoperator"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+)
+
+//nolint:gochecknoglobals // Test fixture - shared across test functions
+var listKinds = map[schema.GroupVersionResource]string{
+	resources.Notebook.GVR():         resources.Notebook.ListKind(),
+	resources.InferenceService.GVR(): resources.InferenceService.ListKind(),
+	resources.RayCluster.GVR():       resources.RayCluster.ListKind(),
+	resources.PyTorchJob.GVR():       resources.PyTorchJob.ListKind(),
+}
+
+func newNotebook(name string, limits map[string]any) *unstructured.Unstructured {
+	return &unstructured.Unstructured{
+		Object: map[string]any{
+			"apiVersion": resources.Notebook.APIVersion(),
+			"kind":       resources.Notebook.Kind,
+			"metadata": map[string]any{
+				"name":      name,
+				"namespace": "project",
+			},
+			"spec": map[string]any{
+				"template": map[string]any{
+					"spec": map[string]any{
+						"containers": []any{
+							map[string]any{
+								"name":      name,
+								"resources": map[string]any{"limits": limits},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func newInferenceService(name string, requests map[string]any) *unstructured.Unstructured {
+	return &unstructured.Unstructured{
+		Object: map[string]any{
+			"apiVersion": resources.InferenceService.APIVersion(),
+			"kind":       resources.InferenceService.Kind,
+			"metadata": map[string]any{
+				"name":      name,
+				"namespace": "models",
+			},
+			"spec": map[string]any{
+				"predictor": map[string]any{
+					"model": map[string]any{
+						"resources": map[string]any{"requests": requests},
+					},
+				},
+			},
+		},
+	}
+}
+
+func newSubscription(name string, csv string) *operatorsv1alpha1.Subscription {
+	return &operatorsv1alpha1.Subscription{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "openshift-operators",
+		},
+		Status: operatorsv1alpha1.SubscriptionStatus{
+			InstalledCSV: csv,
+		},
+	}
+}
+
+func TestGPUOperatorCheck_CanApply(t *testing.T) {
+	g := NewWithT(t)
+
+	chk := gpuoperator.NewCheck()
+
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds:      listKinds,
+		CurrentVersion: "2.17.0",
+		TargetVersion:  "3.0.0",
+	})
+	canApply, err := chk.CanApply(t.Context(), target)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(canApply).To(BeTrue())
+
+	target = testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds:      listKinds,
+		CurrentVersion: "2.16.0",
+		TargetVersion:  "2.17.0",
+	})
+	canApply, err = chk.CanApply(t.Context(), target)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(canApply).To(BeFalse())
+}
+
+func TestGPUOperatorCheck_NoAcceleratorWorkloads(t *testing.T) {
+	g := NewWithT(t)
+
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds: listKinds,
+		Objects: []*unstructured.Unstructured{
+			newNotebook("cpu-only", map[string]any{"cpu": "2"}),
+		},
+		OLM:            operatorfake.NewSimpleClientset(), //nolint:staticcheck // NewClientset requires generated apply configs not available in OLM
+		CurrentVersion: "2.17.0",
+		TargetVersion:  "3.0.0",
+	})
+
+	dr, err := gpuoperator.NewCheck().Validate(t.Context(), target)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(dr.Status.Conditions).To(HaveLen(1))
+	g.Expect(dr.Status.Conditions[0].Condition).To(MatchFields(IgnoreExtras, Fields{
+		"Type":   Equal(check.ConditionTypeAvailable),
+		"Status": Equal(metav1.ConditionTrue),
+		"Reason": Equal(check.ReasonRequirementsMet),
+	}))
+	g.Expect(dr.ImpactedObjects).To(BeEmpty())
+}
+
+func TestGPUOperatorCheck_OperatorInstalled(t *testing.T) {
+	g := NewWithT(t)
+
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds: listKinds,
+		Objects: []*unstructured.Unstructured{
+			newNotebook("gpu-notebook", map[string]any{"nvidia.com/gpu": "1"}),
+		},
+		OLM: operatorfake.NewSimpleClientset( //nolint:staticcheck // NewClientset requires generated apply configs not available in OLM
+			newSubscription("gpu-operator-certified", "gpu-operator-certified.v24.9.2"),
+		),
+		CurrentVersion: "2.17.0",
+		TargetVersion:  "3.0.0",
+	})
+
+	dr, err := gpuoperator.NewCheck().Validate(t.Context(), target)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(dr.Status.Conditions).To(HaveLen(1))
+	g.Expect(dr.Status.Conditions[0].Condition).To(MatchFields(IgnoreExtras, Fields{
+		"Status":  Equal(metav1.ConditionTrue),
+		"Reason":  Equal(check.ReasonResourceFound),
+		"Message": ContainSubstring("NVIDIA GPU Operator 24.9.2"),
+	}))
+	g.Expect(dr.ImpactedObjects).To(BeEmpty())
+}
+
+func TestGPUOperatorCheck_OperatorMissingOrOutdated(t *testing.T) {
+	g := NewWithT(t)
+
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds: listKinds,
+		Objects: []*unstructured.Unstructured{
+			newNotebook("gpu-notebook", map[string]any{"nvidia.com/gpu": "1"}),
+			newInferenceService("amd-model", map[string]any{"amd.com/gpu": "1"}),
+		},
+		OLM: operatorfake.NewSimpleClientset( //nolint:staticcheck // NewClientset requires generated apply configs not available in OLM
+			newSubscription("gpu-operator-certified", "gpu-operator-certified.v23.9.1"),
+		),
+		CurrentVersion: "2.17.0",
+		TargetVersion:  "3.0.0",
+	})
+
+	dr, err := gpuoperator.NewCheck().Validate(t.Context(), target)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(dr.Status.Conditions).To(HaveLen(1))
+	g.Expect(dr.Status.Conditions[0].Condition).To(MatchFields(IgnoreExtras, Fields{
+		"Status": Equal(metav1.ConditionFalse),
+		"Reason": Equal(check.ReasonDependencyUnavailable),
+		"Message": And(
+			ContainSubstring("NVIDIA GPU Operator 23.9.1 is older than the minimum supported 24.6.0"),
+			ContainSubstring("AMD GPU Operator is not installed"),
+		),
+	}))
+	g.Expect(dr.Status.Conditions[0].Impact).To(Equal(resultpkg.ImpactAdvisory))
+	g.Expect(dr.Annotations).To(HaveKeyWithValue(check.AnnotationImpactedWorkloadCount, "2"))
+	g.Expect(dr.ImpactedObjects).To(HaveLen(2))
+	g.Expect(dr.ImpactedObjects[0].Kind).To(Equal(resources.Notebook.Kind))
+	g.Expect(dr.ImpactedObjects[1].Kind).To(Equal(resources.InferenceService.Kind))
+}
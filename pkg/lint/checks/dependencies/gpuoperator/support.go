@@ -0,0 +1,155 @@
+package gpuoperator
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/blang/semver/v4"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/compat"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+	"github.com/opendatahub-io/odh-cli/pkg/util/jq"
+	"github.com/opendatahub-io/odh-cli/pkg/util/kube/olm"
+)
+
+// acceleratorResourcesQuery collects the extended resource names requested or limited by any
+// container in the object, regardless of where the pod template is nested.
+const acceleratorResourcesQuery = `[.. | objects | .resources? // empty | objects | (.limits // {}), (.requests // {}) | objects | keys[]] | unique`
+
+// acceleratorVendor maps an accelerator extended resource to the operator that provides it.
+type acceleratorVendor struct {
+	resourceName  string
+	operatorName  string
+	subscriptions []string
+	compatName    string
+}
+
+//nolint:gochecknoglobals // Read-only lookup table
+var acceleratorVendors = []acceleratorVendor{
+	{
+		resourceName:  "nvidia.com/gpu",
+		operatorName:  "NVIDIA GPU Operator",
+		subscriptions: []string{"gpu-operator-certified", "gpu-operator"},
+		compatName:    compat.OperatorNvidiaGPU,
+	},
+	{
+		resourceName:  "amd.com/gpu",
+		operatorName:  "AMD GPU Operator",
+		subscriptions: []string{"amd-gpu-operator"},
+		compatName:    compat.OperatorAMDGPU,
+	},
+}
+
+// workloadResources lists the workload types that can request accelerators.
+//
+//nolint:gochecknoglobals // Read-only lookup table
+var workloadResources = []resources.ResourceType{
+	resources.Notebook,
+	resources.InferenceService,
+	resources.RayCluster,
+	resources.PyTorchJob,
+}
+
+// operatorStatus describes whether the operator for a vendor is usable after upgrade.
+type operatorStatus struct {
+	ok      bool
+	message string
+}
+
+// findAcceleratorWorkloads returns the workloads requesting each known accelerator resource,
+// keyed by resource name. Workload types whose CRD is not installed are skipped.
+func findAcceleratorWorkloads(
+	ctx context.Context,
+	r client.Reader,
+) (map[string][]metav1.PartialObjectMetadata, error) {
+	workloads := make(map[string][]metav1.PartialObjectMetadata)
+
+	for _, rt := range workloadResources {
+		items, err := r.List(ctx, rt)
+		if err != nil {
+			if client.IsResourceTypeNotFound(err) {
+				continue
+			}
+
+			return nil, fmt.Errorf("listing %s: %w", rt.Kind, err)
+		}
+
+		for _, item := range items {
+			names, err := jq.Query[[]string](item, acceleratorResourcesQuery)
+			if err != nil {
+				return nil, fmt.Errorf("querying resources of %s %s/%s: %w", rt.Kind, item.GetNamespace(), item.GetName(), err)
+			}
+
+			for _, v := range acceleratorVendors {
+				if !slices.Contains(names, v.resourceName) {
+					continue
+				}
+
+				workloads[v.resourceName] = append(workloads[v.resourceName], metav1.PartialObjectMetadata{
+					TypeMeta: rt.TypeMeta(),
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: item.GetNamespace(),
+						Name:      item.GetName(),
+					},
+				})
+			}
+		}
+	}
+
+	return workloads, nil
+}
+
+// inspectOperator looks up the OLM subscription of the vendor operator and compares the
+// installed CSV version against the minimum the compatibility matrix requires for the target.
+func inspectOperator(
+	ctx context.Context,
+	r client.Reader,
+	matrix *compat.Matrix,
+	target *semver.Version,
+	v acceleratorVendor,
+) (operatorStatus, error) {
+	info, err := olm.FindOperator(ctx, r, func(sub *olm.SubscriptionInfo) bool {
+		return slices.Contains(v.subscriptions, sub.Name)
+	})
+	if err != nil {
+		return operatorStatus{}, fmt.Errorf("checking %s presence: %w", v.operatorName, err)
+	}
+
+	if !info.Found() {
+		return operatorStatus{message: v.operatorName + " is not installed"}, nil
+	}
+
+	installed, ok := csvVersion(info.GetVersion())
+	if !ok {
+		// The installed version cannot be determined, so it is reported as installed rather than guessed.
+		return operatorStatus{ok: true, message: fmt.Sprintf("%s (%s)", v.operatorName, info.GetVersion())}, nil
+	}
+
+	if minimum, found := matrix.MinimumOperatorVersion(v.compatName, target); found && installed.LT(minimum) {
+		return operatorStatus{
+			message: fmt.Sprintf("%s %s is older than the minimum supported %s", v.operatorName, installed, minimum),
+		}, nil
+	}
+
+	return operatorStatus{ok: true, message: fmt.Sprintf("%s %s", v.operatorName, installed)}, nil
+}
+
+// csvVersion extracts the semantic version from a CSV name such as "gpu-operator-certified.v24.9.0".
+func csvVersion(csv string) (semver.Version, bool) {
+	_, raw, found := strings.Cut(csv, ".v")
+	if !found {
+		return semver.Version{}, false
+	}
+
+	v, err := semver.ParseTolerant(raw)
+	if err != nil {
+		return semver.Version{}, false
+	}
+
+	return v, true
+}
@@ -0,0 +1,157 @@
+package odhoperator
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	operatorsv1alpha1 "github.com/operator-framework/api/pkg/operators/v1alpha1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/messages"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/kube/olm"
+	"github.com/opendatahub-io/odh-cli/pkg/util/version"
+)
+
+// Messages of the operator Subscription check.
+//
+//nolint:gochecknoglobals // Message catalog entries
+var (
+	msgOLMUnavailable       = messages.New("dependencies.odhoperator.subscription.olm-unavailable", "OLM client not available - cannot inspect the operator Subscription")
+	msgSubscriptionNotFound = messages.New("dependencies.odhoperator.subscription.not-found", "No Subscription found for the %s packages - the operator is not managed by OLM")
+	msgSubscriptionReady    = messages.New("dependencies.odhoperator.subscription.ready", "Subscription %s/%s (channel %q, %s approval) allows upgrading to %s")
+	msgSubscriptionBlocked  = messages.New("dependencies.odhoperator.subscription.blocked", "Subscription %s/%s may block the upgrade to %s: %s")
+)
+
+const kind = "odh-operator"
+
+const (
+	msgManualApproval        = "installPlanApproval is Manual, so the upgrade InstallPlan waits for approval"
+	msgStartingCSVPinned     = "startingCSV is pinned to %s"
+	msgChannelMissingTarget  = "channel %q does not provide a %s release"
+	msgChannelNotInCatalog   = "channel %q is not offered by the %s catalog"
+	remediationSubscription  = "Switch the operator Subscription to a channel that provides the target release, remove spec.startingCSV, and approve the pending InstallPlan (or set installPlanApproval to Automatic) when upgrading"
+	subscriptionPackagesText = "rhods-operator/opendatahub-operator"
+)
+
+// SubscriptionCheck inspects the OLM Subscription of the RHOAI/ODH operator for settings that
+// stop OLM from upgrading to the target version without any visible error: Manual install plan
+// approval, a pinned startingCSV, or a channel that does not carry the target release.
+type SubscriptionCheck struct {
+	check.BaseCheck
+}
+
+func NewSubscriptionCheck() *SubscriptionCheck {
+	return &SubscriptionCheck{
+		BaseCheck: check.BaseCheck{
+			CheckGroup:       check.GroupDependency,
+			Kind:             kind,
+			Type:             check.CheckTypeReadiness,
+			CheckID:          "dependencies.odhoperator.subscription",
+			CheckTags:        []string{check.TagFast},
+			CheckName:        "Dependencies :: ODH Operator :: Subscription",
+			CheckDescription: "Flags RHOAI/ODH operator Subscription settings that block the upgrade: Manual approval, pinned startingCSV, or a channel without the target version",
+			CheckRemediation: remediationSubscription,
+			CheckResources: []resources.ResourceType{
+				resources.Subscription,
+				resources.PackageManifest,
+			},
+			CheckVersions: check.VersionsAny,
+		},
+	}
+}
+
+// CanApply returns whether this check should run for the given target.
+// Only applies when the target is a newer minor or major release than the current version.
+func (c *SubscriptionCheck) CanApply(_ context.Context, target check.Target) (bool, error) {
+	if target.CurrentVersion == nil || target.TargetVersion == nil {
+		return false, nil
+	}
+
+	return !version.SameMajorMinor(target.CurrentVersion, target.TargetVersion) &&
+		target.TargetVersion.GT(*target.CurrentVersion), nil
+}
+
+// Validate looks up the operator Subscription and evaluates its upgrade settings.
+func (c *SubscriptionCheck) Validate(ctx context.Context, target check.Target) (*result.DiagnosticResult, error) {
+	dr := c.NewResult()
+	tv := version.MajorMinorLabel(target.TargetVersion)
+
+	if target.TargetVersion != nil {
+		dr.Annotations[check.AnnotationCheckTargetVersion] = target.TargetVersion.String()
+	}
+
+	if !target.Client.OLM().Available() {
+		dr.SetCondition(check.NewCondition(
+			check.ConditionTypeConfigured,
+			metav1.ConditionUnknown,
+			check.WithReason(check.ReasonInsufficientData),
+			check.WithCatalogMessage(msgOLMUnavailable),
+		))
+
+		return dr, nil
+	}
+
+	sub, err := olm.FindSubscriptionByPackage(ctx, target.Client, operatorPackages...)
+	if err != nil {
+		return nil, fmt.Errorf("finding operator Subscription: %w", err)
+	}
+
+	if sub == nil {
+		dr.SetCondition(check.NewCondition(
+			check.ConditionTypeConfigured,
+			metav1.ConditionUnknown,
+			check.WithReason(check.ReasonResourceNotFound),
+			check.WithCatalogMessage(msgSubscriptionNotFound, subscriptionPackagesText),
+		))
+
+		return dr, nil
+	}
+
+	channelProblem, err := checkChannel(ctx, target.Client, sub, target.TargetVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	var problems []string
+
+	impact := result.ImpactAdvisory
+	if channelProblem != "" {
+		problems = append(problems, channelProblem)
+		impact = result.ImpactBlocking
+	}
+
+	if sub.Spec.InstallPlanApproval == operatorsv1alpha1.ApprovalManual {
+		problems = append(problems, msgManualApproval)
+	}
+
+	if sub.Spec.StartingCSV != "" {
+		problems = append(problems, fmt.Sprintf(msgStartingCSVPinned, sub.Spec.StartingCSV))
+	}
+
+	if len(problems) == 0 {
+		dr.SetCondition(check.NewCondition(
+			check.ConditionTypeConfigured,
+			metav1.ConditionTrue,
+			check.WithReason(check.ReasonConfigurationValid),
+			check.WithCatalogMessage(msgSubscriptionReady, sub.Namespace, sub.Name, sub.Spec.Channel, approvalLabel(sub), tv),
+		))
+
+		return dr, nil
+	}
+
+	dr.SetCondition(check.NewCondition(
+		check.ConditionTypeConfigured,
+		metav1.ConditionFalse,
+		check.WithReason(check.ReasonConfigurationInvalid),
+		check.WithCatalogMessage(msgSubscriptionBlocked, sub.Namespace, sub.Name, tv, strings.Join(problems, "; ")),
+		check.WithImpact(impact),
+		check.WithRemediation(c.CheckRemediation),
+	))
+
+	return dr, nil
+}
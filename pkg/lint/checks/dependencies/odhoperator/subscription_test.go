@@ -0,0 +1,212 @@
+package odhoperator_test
+
+import (
+	"testing"
+
+	operatorsv1alpha1 "github.com/operator-framework/api/pkg/operators/v1alpha1"
+	operatorfake "github.com/operator-framework/operator-lifecycle-manager/pkg/api/client/clientset/versioned/fake"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	resultpkg "github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/testutil"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/dependencies/odhoperator"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+)
+
+//nolint:gochecknoglobals // Test fixture - shared across test functions
+var listKinds = map[schema.GroupVersionResource]string{
+	resources.PackageManifest.GVR(): resources.PackageManifest.ListKind(),
+}
+
+func newSubscription(channel string, approval operatorsv1alpha1.Approval, startingCSV string) *operatorsv1alpha1.Subscription {
+	return &operatorsv1alpha1.Subscription{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "rhods-operator",
+			Namespace: "redhat-ods-operator",
+		},
+		Spec: &operatorsv1alpha1.SubscriptionSpec{
+			CatalogSource:          "redhat-operators",
+			CatalogSourceNamespace: "openshift-marketplace",
+			Package:                "rhods-operator",
+			Channel:                channel,
+			InstallPlanApproval:    approval,
+			StartingCSV:            startingCSV,
+		},
+		Status: operatorsv1alpha1.SubscriptionStatus{
+			InstalledCSV: "rhods-operator.2.25.0",
+		},
+	}
+}
+
+func newPackageManifest(channels map[string][]string) *unstructured.Unstructured {
+	chs := make([]any, 0, len(channels))
+	for name, csvs := range channels {
+		entries := make([]any, 0, len(csvs))
+		for _, csv := range csvs {
+			entries = append(entries, map[string]any{"name": csv})
+		}
+
+		chs = append(chs, map[string]any{
+			"name":       name,
+			"currentCSV": csvs[0],
+			"entries":    entries,
+		})
+	}
+
+	return &unstructured.Unstructured{
+		Object: map[string]any{
+			"apiVersion": resources.PackageManifest.APIVersion(),
+			"kind":       resources.PackageManifest.Kind,
+			"metadata": map[string]any{
+				"name":      "rhods-operator",
+				"namespace": "openshift-marketplace",
+			},
+			"status": map[string]any{
+				"catalogSource": "redhat-operators",
+				"channels":      chs,
+			},
+		},
+	}
+}
+
+func TestSubscriptionCheck_CanApply(t *testing.T) {
+	g := NewWithT(t)
+
+	chk := odhoperator.NewSubscriptionCheck()
+
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds:      listKinds,
+		CurrentVersion: "2.25.0",
+		TargetVersion:  "3.0.0",
+	})
+	canApply, err := chk.CanApply(t.Context(), target)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(canApply).To(BeTrue())
+
+	target = testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds:      listKinds,
+		CurrentVersion: "3.0.0",
+		TargetVersion:  "3.0.1",
+	})
+	canApply, err = chk.CanApply(t.Context(), target)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(canApply).To(BeFalse())
+}
+
+func TestSubscriptionCheck_UpgradeAllowed(t *testing.T) {
+	g := NewWithT(t)
+
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds: listKinds,
+		Objects: []*unstructured.Unstructured{
+			newPackageManifest(map[string][]string{
+				"fast-3.x": {"rhods-operator.3.0.0", "rhods-operator.2.25.0"},
+			}),
+		},
+		OLM: operatorfake.NewSimpleClientset( //nolint:staticcheck // NewClientset requires generated apply configs not available in OLM
+			newSubscription("fast-3.x", operatorsv1alpha1.ApprovalAutomatic, ""),
+		),
+		CurrentVersion: "2.25.0",
+		TargetVersion:  "3.0.0",
+	})
+
+	dr, err := odhoperator.NewSubscriptionCheck().Validate(t.Context(), target)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(dr.Status.Conditions).To(HaveLen(1))
+	g.Expect(dr.Status.Conditions[0].Condition).To(MatchFields(IgnoreExtras, Fields{
+		"Type":    Equal(check.ConditionTypeConfigured),
+		"Status":  Equal(metav1.ConditionTrue),
+		"Reason":  Equal(check.ReasonConfigurationValid),
+		"Message": ContainSubstring(`channel "fast-3.x", Automatic approval`),
+	}))
+}
+
+func TestSubscriptionCheck_ManualApprovalAndPinnedCSV(t *testing.T) {
+	g := NewWithT(t)
+
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds: listKinds,
+		Objects: []*unstructured.Unstructured{
+			newPackageManifest(map[string][]string{
+				"fast-3.x": {"rhods-operator.3.0.0"},
+			}),
+		},
+		OLM: operatorfake.NewSimpleClientset( //nolint:staticcheck // NewClientset requires generated apply configs not available in OLM
+			newSubscription("fast-3.x", operatorsv1alpha1.ApprovalManual, "rhods-operator.2.25.0"),
+		),
+		CurrentVersion: "2.25.0",
+		TargetVersion:  "3.0.0",
+	})
+
+	dr, err := odhoperator.NewSubscriptionCheck().Validate(t.Context(), target)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(dr.Status.Conditions).To(HaveLen(1))
+	g.Expect(dr.Status.Conditions[0].Condition).To(MatchFields(IgnoreExtras, Fields{
+		"Status": Equal(metav1.ConditionFalse),
+		"Reason": Equal(check.ReasonConfigurationInvalid),
+		"Message": And(
+			ContainSubstring("installPlanApproval is Manual"),
+			ContainSubstring("startingCSV is pinned to rhods-operator.2.25.0"),
+		),
+	}))
+	g.Expect(dr.Status.Conditions[0].Impact).To(Equal(resultpkg.ImpactAdvisory))
+}
+
+func TestSubscriptionCheck_ChannelWithoutTarget(t *testing.T) {
+	g := NewWithT(t)
+
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds: listKinds,
+		Objects: []*unstructured.Unstructured{
+			newPackageManifest(map[string][]string{
+				"stable-2.25": {"rhods-operator.2.25.1", "rhods-operator.2.25.0"},
+				"fast-3.x":    {"rhods-operator.3.0.0"},
+			}),
+		},
+		OLM: operatorfake.NewSimpleClientset( //nolint:staticcheck // NewClientset requires generated apply configs not available in OLM
+			newSubscription("stable-2.25", "", ""),
+		),
+		CurrentVersion: "2.25.0",
+		TargetVersion:  "3.0.0",
+	})
+
+	dr, err := odhoperator.NewSubscriptionCheck().Validate(t.Context(), target)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(dr.Status.Conditions).To(HaveLen(1))
+	g.Expect(dr.Status.Conditions[0].Condition).To(MatchFields(IgnoreExtras, Fields{
+		"Status":  Equal(metav1.ConditionFalse),
+		"Reason":  Equal(check.ReasonConfigurationInvalid),
+		"Message": ContainSubstring(`channel "stable-2.25" does not provide a 3.0 release`),
+	}))
+	g.Expect(dr.Status.Conditions[0].Impact).To(Equal(resultpkg.ImpactBlocking))
+}
+
+func TestSubscriptionCheck_SubscriptionNotFound(t *testing.T) {
+	g := NewWithT(t)
+
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds:      listKinds,
+		OLM:            operatorfake.NewSimpleClientset(), //nolint:staticcheck // NewClientset requires generated apply configs not available in OLM
+		CurrentVersion: "2.25.0",
+		TargetVersion:  "3.0.0",
+	})
+
+	dr, err := odhoperator.NewSubscriptionCheck().Validate(t.Context(), target)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(dr.Status.Conditions).To(HaveLen(1))
+	g.Expect(dr.Status.Conditions[0].Condition).To(MatchFields(IgnoreExtras, Fields{
+		"Status": Equal(metav1.ConditionUnknown),
+		"Reason": Equal(check.ReasonResourceNotFound),
+	}))
+}
@@ -0,0 +1,106 @@
+package odhoperator
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/blang/semver/v4"
+	operatorsv1alpha1 "github.com/operator-framework/api/pkg/operators/v1alpha1"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+	"github.com/opendatahub-io/odh-cli/pkg/util/jq"
+	"github.com/opendatahub-io/odh-cli/pkg/util/version"
+)
+
+// operatorPackages lists the OLM package names of the RHOAI and ODH operators.
+//
+//nolint:gochecknoglobals // Read-only lookup table
+var operatorPackages = []string{"rhods-operator", "opendatahub-operator"}
+
+// checkChannel verifies that the subscribed channel of the catalog PackageManifest carries a CSV
+// for the target major.minor release. It returns a description of the problem, or an empty string
+// when the channel is fine or the PackageManifest is not available to verify against.
+func checkChannel(
+	ctx context.Context,
+	r client.Reader,
+	sub *operatorsv1alpha1.Subscription,
+	target *semver.Version,
+) (string, error) {
+	if target == nil {
+		return "", nil
+	}
+
+	manifests, err := client.List[*unstructured.Unstructured](ctx, r, resources.PackageManifest,
+		func(pm *unstructured.Unstructured) (bool, error) {
+			if pm.GetName() != sub.Spec.Package {
+				return false, nil
+			}
+
+			if sub.Spec.CatalogSourceNamespace != "" && pm.GetNamespace() != sub.Spec.CatalogSourceNamespace {
+				return false, nil
+			}
+
+			catalogSource, err := jq.Query[string](pm, ".status.catalogSource")
+			if err != nil {
+				return false, nil
+			}
+
+			return catalogSource == sub.Spec.CatalogSource, nil
+		})
+
+	switch {
+	case client.IsResourceTypeNotFound(err):
+		return "", nil
+	case err != nil:
+		return "", fmt.Errorf("listing PackageManifests for %s: %w", sub.Spec.Package, err)
+	case len(manifests) == 0:
+		return "", nil
+	}
+
+	csvs, err := jq.Query[[]string](manifests[0], fmt.Sprintf(
+		`[.status.channels[]? | select(.name == %q) | .currentCSV // empty, (.entries[]?.name)]`, sub.Spec.Channel))
+	if err != nil {
+		return "", fmt.Errorf("querying channel %q of PackageManifest %s: %w", sub.Spec.Channel, sub.Spec.Package, err)
+	}
+
+	if len(csvs) == 0 {
+		return fmt.Sprintf(msgChannelNotInCatalog, sub.Spec.Channel, sub.Spec.CatalogSource), nil
+	}
+
+	for _, csv := range csvs {
+		if v, ok := csvVersion(csv); ok && version.SameMajorMinor(&v, target) {
+			return "", nil
+		}
+	}
+
+	return fmt.Sprintf(msgChannelMissingTarget, sub.Spec.Channel, version.MajorMinorLabel(target)), nil
+}
+
+// csvVersion extracts the semantic version from a CSV name such as "rhods-operator.2.25.0" or
+// "opendatahub-operator.v2.30.0".
+func csvVersion(csv string) (semver.Version, bool) {
+	_, raw, found := strings.Cut(csv, ".")
+	if !found {
+		return semver.Version{}, false
+	}
+
+	v, err := semver.ParseTolerant(raw)
+	if err != nil {
+		return semver.Version{}, false
+	}
+
+	return v, true
+}
+
+// approvalLabel returns the install plan approval mode, which OLM defaults to Automatic.
+func approvalLabel(sub *operatorsv1alpha1.Subscription) string {
+	if sub.Spec.InstallPlanApproval == "" {
+		return string(operatorsv1alpha1.ApprovalAutomatic)
+	}
+
+	return string(sub.Spec.InstallPlanApproval)
+}
@@ -8,10 +8,20 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/messages"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
 	"github.com/opendatahub-io/odh-cli/pkg/util/version"
 )
 
+// Messages of the OpenShift version requirement check.
+//
+//nolint:gochecknoglobals // Message catalog entries
+var (
+	msgVersionUnknown = messages.New("dependencies.openshift.version-requirement.unknown", "Unable to detect OpenShift version: %s. RHOAI %s requires OpenShift %s or later")
+	msgVersionMet     = messages.New("dependencies.openshift.version-requirement.met", "OpenShift %s meets RHOAI %s minimum version requirement (%s+)")
+	msgVersionNotMet  = messages.New("dependencies.openshift.version-requirement.not-met", "OpenShift %s does not meet RHOAI %s minimum version requirement (%s+). Upgrade OpenShift to %s or later before upgrading RHOAI")
+)
+
 const (
 	kind      = "openshift-platform"
 	checkType = "version-requirement"
@@ -33,16 +43,16 @@ func NewCheck() *Check {
 			Kind:             kind,
 			Type:             checkType,
 			CheckID:          "dependencies.openshift.version-requirement",
+			CheckTags:        []string{check.TagFast, check.TagBlockingOnly},
 			CheckName:        "Dependencies :: OpenShift :: Version Requirement (3.x)",
 			CheckDescription: "Validates that OpenShift is at least version 4.19.9 when upgrading to RHOAI 3.x",
+			CheckVersions:    check.VersionsCurrentOrTarget3x,
+			// The minimum OpenShift version is a RHOAI support requirement
+			CheckFlavors: []version.Flavor{version.FlavorRHOAI},
 		},
 	}
 }
 
-func (c *Check) CanApply(_ context.Context, target check.Target) (bool, error) {
-	return version.IsVersion3x(target.CurrentVersion) || version.IsVersion3x(target.TargetVersion), nil
-}
-
 func (c *Check) Validate(
 	ctx context.Context,
 	target check.Target,
@@ -58,7 +68,7 @@ func (c *Check) Validate(
 			check.ConditionTypeCompatible,
 			metav1.ConditionFalse,
 			check.WithReason(check.ReasonInsufficientData),
-			check.WithMessage("Unable to detect OpenShift version: %s. RHOAI %s requires OpenShift %s or later", err.Error(), tv, minVersion.String()),
+			check.WithCatalogMessage(msgVersionUnknown, err.Error(), tv, minVersion.String()),
 			check.WithImpact(result.ImpactBlocking),
 		))
 	case ver.GTE(minVersion):
@@ -66,14 +76,14 @@ func (c *Check) Validate(
 			check.ConditionTypeCompatible,
 			metav1.ConditionTrue,
 			check.WithReason(check.ReasonVersionCompatible),
-			check.WithMessage("OpenShift %s meets RHOAI %s minimum version requirement (%s+)", ver.String(), tv, minVersion.String()),
+			check.WithCatalogMessage(msgVersionMet, ver.String(), tv, minVersion.String()),
 		))
 	default:
 		dr.SetCondition(check.NewCondition(
 			check.ConditionTypeCompatible,
 			metav1.ConditionFalse,
 			check.WithReason(check.ReasonVersionIncompatible),
-			check.WithMessage("OpenShift %s does not meet RHOAI %s minimum version requirement (%s+). Upgrade OpenShift to %s or later before upgrading RHOAI",
+			check.WithCatalogMessage(msgVersionNotMet,
 				ver.String(), tv, minVersion.String(), minVersion.String()),
 			check.WithImpact(result.ImpactBlocking),
 		))
@@ -11,10 +11,25 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/messages"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
 	"github.com/opendatahub-io/odh-cli/pkg/util/version"
 )
 
+// Messages of the OSSM v3 compatibility check.
+//
+//nolint:gochecknoglobals // Message catalog entries
+var (
+	msgOLMUnavailable       = messages.New("dependencies.ossm-v3-compatibility.compatibility.olm-unavailable", "OLM client not available; skipping OSSM v3 compatibility check")
+	msgSubscriptionNotFound = messages.New("dependencies.ossm-v3-compatibility.compatibility.subscription-not-found", "servicemeshoperator3 subscription not found; OSSM v3 is not installed via OLM")
+	msgNoInstalledCSV       = messages.New("dependencies.ossm-v3-compatibility.compatibility.no-installed-csv", "servicemeshoperator3 subscription has no installed CSV; operator may be pending installation")
+	msgUnparsableVersion    = messages.New("dependencies.ossm-v3-compatibility.compatibility.unparsable-version", "Unable to parse version from installed CSV %q: %v")
+	msgNotAffected          = messages.New("dependencies.ossm-v3-compatibility.compatibility.not-affected", "servicemeshoperator3 is installed at %s, which is not affected by the OSSM v3.4 compatibility issue")
+	msgFixedByOCP           = messages.New("dependencies.ossm-v3-compatibility.compatibility.fixed-by-ocp", "servicemeshoperator3 is at %s (affected), but OCP %s includes the fix (Sail Library); no action required")
+	msgAffected             = messages.New("dependencies.ossm-v3-compatibility.compatibility.affected", "servicemeshoperator3 is installed at %s (CSV: %s), which is affected by a known issue: OSSM v3.4.0+ rejects Istio v1.26.2 as end-of-life on OCP 4.19-4.21, causing GatewayConfig to get stuck NotReady")
+	msgAffectedDrifted      = messages.New("dependencies.ossm-v3-compatibility.compatibility.affected-drifted", "servicemeshoperator3 is installed at %s (CSV: %s), which is affected by a known issue: OSSM v3.4.0+ rejects Istio v1.26.2 as end-of-life on OCP 4.19-4.21, causing GatewayConfig to get stuck NotReady. Subscription has drifted from pinned version %s")
+)
+
 const (
 	checkKind = "ossm-v3-compatibility"
 	checkType = "compatibility"
@@ -42,19 +57,17 @@ func NewCheck() *Check {
 			Kind:             checkKind,
 			Type:             checkType,
 			CheckID:          "dependencies.ossm-v3-compatibility.compatibility",
+			CheckTags:        []string{check.TagFast, check.TagBlockingOnly},
 			CheckName:        "Dependencies :: OSSM v3 Compatibility :: Version Compatibility",
 			CheckDescription: "Detects servicemeshoperator3 subscription drift to v3.4.0+ which causes GatewayConfig failures on unpatched OCP 4.19-4.21",
 			CheckRemediation: "Do not approve servicemeshoperator3 InstallPlans beyond v3.3.x on OCP 4.19-4.21. " +
 				"Upgrade to OpenShift Container Platform 4.21.22 or higher to resolve via the Sail Library (no OLM dependency). " +
 				"See https://access.redhat.com/solutions/7145505 for details.",
+			CheckVersions: check.VersionsTarget3x,
 		},
 	}
 }
 
-func (c *Check) CanApply(_ context.Context, target check.Target) (bool, error) {
-	return version.IsVersion3x(target.TargetVersion), nil
-}
-
 func (c *Check) Validate(ctx context.Context, target check.Target) (*result.DiagnosticResult, error) {
 	dr := c.NewResult()
 
@@ -63,7 +76,7 @@ func (c *Check) Validate(ctx context.Context, target check.Target) (*result.Diag
 			check.ConditionTypeCompatible,
 			metav1.ConditionTrue,
 			check.WithReason(check.ReasonCheckSkipped),
-			check.WithMessage("OLM client not available; skipping OSSM v3 compatibility check"),
+			check.WithCatalogMessage(msgOLMUnavailable),
 		))
 
 		return dr, nil
@@ -80,7 +93,7 @@ func (c *Check) Validate(ctx context.Context, target check.Target) (*result.Diag
 			check.ConditionTypeCompatible,
 			metav1.ConditionTrue,
 			check.WithReason(check.ReasonRequirementsMet),
-			check.WithMessage("servicemeshoperator3 subscription not found; OSSM v3 is not installed via OLM"),
+			check.WithCatalogMessage(msgSubscriptionNotFound),
 		))
 
 		return dr, nil
@@ -92,7 +105,7 @@ func (c *Check) Validate(ctx context.Context, target check.Target) (*result.Diag
 			check.ConditionTypeCompatible,
 			metav1.ConditionTrue,
 			check.WithReason(check.ReasonRequirementsMet),
-			check.WithMessage("servicemeshoperator3 subscription has no installed CSV; operator may be pending installation"),
+			check.WithCatalogMessage(msgNoInstalledCSV),
 		))
 
 		return dr, nil
@@ -104,7 +117,7 @@ func (c *Check) Validate(ctx context.Context, target check.Target) (*result.Diag
 			check.ConditionTypeCompatible,
 			metav1.ConditionUnknown,
 			check.WithReason(check.ReasonInsufficientData),
-			check.WithMessage("Unable to parse version from installed CSV %q: %v", installedCSV, err),
+			check.WithCatalogMessage(msgUnparsableVersion, installedCSV, err),
 		))
 
 		return dr, nil
@@ -117,8 +130,8 @@ func (c *Check) Validate(ctx context.Context, target check.Target) (*result.Diag
 				check.ConditionTypeCompatible,
 				metav1.ConditionTrue,
 				check.WithReason(check.ReasonVersionCompatible),
-				check.WithMessage(
-					"servicemeshoperator3 is at %s (affected), but OCP %s includes the fix (Sail Library); no action required",
+				check.WithCatalogMessage(
+					msgFixedByOCP,
 					installedVersion, ocpVersion,
 				),
 			))
@@ -135,7 +148,7 @@ func (c *Check) Validate(ctx context.Context, target check.Target) (*result.Diag
 			check.ConditionTypeCompatible,
 			metav1.ConditionFalse,
 			check.WithReason(check.ReasonVersionIncompatible),
-			check.WithMessage("%s", buildDriftMessage(installedVersion, installedCSV, startingCSV)),
+			driftMessage(installedVersion, installedCSV, startingCSV),
 			check.WithRemediation(c.CheckRemediation),
 			check.WithImpact(result.ImpactBlocking),
 		))
@@ -147,7 +160,7 @@ func (c *Check) Validate(ctx context.Context, target check.Target) (*result.Diag
 		check.ConditionTypeCompatible,
 		metav1.ConditionTrue,
 		check.WithReason(check.ReasonVersionCompatible),
-		check.WithMessage("servicemeshoperator3 is installed at %s, which is not affected by the OSSM v3.4 compatibility issue", installedVersion),
+		check.WithCatalogMessage(msgNotAffected, installedVersion),
 	))
 
 	return dr, nil
@@ -163,19 +176,13 @@ func findSubscription(list *operatorsv1alpha1.SubscriptionList) *operatorsv1alph
 	return nil
 }
 
-func buildDriftMessage(installedVersion semver.Version, installedCSV, startingCSV string) string {
-	message := fmt.Sprintf(
-		"servicemeshoperator3 is installed at %s (CSV: %s), which is affected by a known issue: "+
-			"OSSM v3.4.0+ rejects Istio v1.26.2 as end-of-life on OCP 4.19-4.21, "+
-			"causing GatewayConfig to get stuck NotReady",
-		installedVersion, installedCSV,
-	)
-
+// driftMessage reports the affected version, with the pinned version when the Subscription drifted from it.
+func driftMessage(installedVersion semver.Version, installedCSV, startingCSV string) check.ConditionOption {
 	if startingCSV != "" && startingCSV != installedCSV {
-		message += ". Subscription has drifted from pinned version " + startingCSV
+		return check.WithCatalogMessage(msgAffectedDrifted, installedVersion, installedCSV, startingCSV)
 	}
 
-	return message
+	return check.WithCatalogMessage(msgAffected, installedVersion, installedCSV)
 }
 
 func parseCSVVersion(csv string) (semver.Version, error) {
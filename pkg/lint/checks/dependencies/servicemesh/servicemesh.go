@@ -12,21 +12,38 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/messages"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
 	"github.com/opendatahub-io/odh-cli/pkg/resources"
 	"github.com/opendatahub-io/odh-cli/pkg/util/client"
 	"github.com/opendatahub-io/odh-cli/pkg/util/jq"
-	"github.com/opendatahub-io/odh-cli/pkg/util/version"
+)
+
+// Messages of the Service Mesh installed check.
+//
+//nolint:gochecknoglobals // Message catalog entries
+var (
+	msgEnvVarNotFound              = messages.New("dependencies.servicemesh.installed.env-var-not-found", "%s env var not found on ingress-operator deployment")
+	msgEnvVarEmpty                 = messages.New("dependencies.servicemesh.installed.env-var-empty", "%s env var is empty on ingress-operator deployment")
+	msgDeploymentNotFound          = messages.New("dependencies.servicemesh.installed.deployment-not-found", "ingress-operator deployment not found in openshift-ingress-operator namespace")
+	msgDeploymentForbidden         = messages.New("dependencies.servicemesh.installed.deployment-forbidden", "Unable to read ingress-operator deployment (insufficient permissions)")
+	msgPackageManifestNotFound     = messages.New("dependencies.servicemesh.installed.packagemanifest-not-found", "servicemeshoperator3 PackageManifest not found in redhat-operators catalog (required: %s in '%s' channel)")
+	msgAvailable                   = messages.New("dependencies.servicemesh.installed.available", "%s (%s) is available in the '%s' channel of the 'redhat-operators' cluster catalog")
+	msgNotAvailable                = messages.New("dependencies.servicemesh.installed.not-available", "%s version %s is not available in the '%s' channel of the cluster catalog")
+	remediationEnvVarNotFound      = messages.New("dependencies.servicemesh.installed.env-var-not-found.remediation", "Verify the ingress-operator deployment in the openshift-ingress-operator namespace has the %s environment variable.")
+	remediationEnvVarEmpty         = messages.New("dependencies.servicemesh.installed.env-var-empty.remediation", "Verify the ingress-operator deployment in the openshift-ingress-operator namespace has a non-empty %s environment variable.")
+	remediationDeploymentNotFound  = messages.New("dependencies.servicemesh.installed.deployment-not-found.remediation", "Check that the openshift-ingress-operator namespace and the ingress-operator deployment exist in the cluster.")
+	remediationDeploymentForbidden = messages.New("dependencies.servicemesh.installed.deployment-forbidden.remediation", "Grant read access to deployments in the openshift-ingress-operator namespace.")
+
+	// remediationMirror is the shared remediation for failures where the required
+	// servicemeshoperator3 CSV is not available in the cluster catalog.
+	remediationMirror = messages.New("dependencies.servicemesh.installed.mirror.remediation", "Mirror %s into the '%s' channel of the redhat-operators catalog source in the openshift-marketplace namespace. See the pre-requisite instructions in the RHOAI 2.x to 3.x upgrade guide.")
 )
 
 const kind = "servicemesh-v3"
 
 const displayName = "Red Hat Service Mesh v3"
 
-// mirrorRemediationFmt is the shared remediation template for failures where the required
-// servicemeshoperator3 CSV is not available in the cluster catalog.
-const mirrorRemediationFmt = "Mirror %s into the '%s' channel of the redhat-operators catalog source in the openshift-marketplace namespace. See the pre-requisite instructions in the RHOAI 2.x to 3.x upgrade guide."
-
 // Check validates that the required Service Mesh v3 version is available in the cluster's operator catalog.
 type Check struct {
 	check.BaseCheck
@@ -39,16 +56,15 @@ func NewCheck() *Check {
 			Kind:             kind,
 			Type:             check.CheckTypeInstalled,
 			CheckID:          "dependencies.servicemesh.installed",
+			CheckTags:        []string{check.TagBlockingOnly},
 			CheckName:        "Dependencies :: Service Mesh v3 :: Installed",
 			CheckDescription: "Validates that the required Service Mesh v3 version is available to install from the cluster's operator catalog",
+			CheckResources:   []resources.ResourceType{resources.Deployment, resources.PackageManifest},
+			CheckVersions:    check.VersionsUpgrade2xTo3x,
 		},
 	}
 }
 
-func (c *Check) CanApply(_ context.Context, target check.Target) (bool, error) {
-	return version.IsUpgradeFrom2xTo3x(target.CurrentVersion, target.TargetVersion), nil
-}
-
 // extractEnvVar extracts a named environment variable from the ingress-operator deployment.
 // It returns the value, a diagnostic result if the variable is missing/empty, and an error.
 // When the returned result is non-nil, the caller should return it immediately.
@@ -62,8 +78,8 @@ func extractEnvVar(deploy *unstructured.Unstructured, dr *result.DiagnosticResul
 			check.ConditionTypeAvailable,
 			metav1.ConditionFalse,
 			check.WithReason(check.ReasonDependencyUnavailable),
-			check.WithMessage("%s env var not found on ingress-operator deployment", envName),
-			check.WithRemediation(fmt.Sprintf("Verify the ingress-operator deployment in the openshift-ingress-operator namespace has the %s environment variable.", envName)),
+			check.WithCatalogMessage(msgEnvVarNotFound, envName),
+			check.WithCatalogRemediation(remediationEnvVarNotFound, envName),
 			check.WithImpact(result.ImpactBlocking),
 		))
 
@@ -75,8 +91,8 @@ func extractEnvVar(deploy *unstructured.Unstructured, dr *result.DiagnosticResul
 			check.ConditionTypeAvailable,
 			metav1.ConditionFalse,
 			check.WithReason(check.ReasonDependencyUnavailable),
-			check.WithMessage("%s env var is empty on ingress-operator deployment", envName),
-			check.WithRemediation(fmt.Sprintf("Verify the ingress-operator deployment in the openshift-ingress-operator namespace has a non-empty %s environment variable.", envName)),
+			check.WithCatalogMessage(msgEnvVarEmpty, envName),
+			check.WithCatalogRemediation(remediationEnvVarEmpty, envName),
 			check.WithImpact(result.ImpactBlocking),
 		))
 
@@ -99,8 +115,8 @@ func (c *Check) Validate(ctx context.Context, target check.Target) (*result.Diag
 			check.ConditionTypeAvailable,
 			metav1.ConditionFalse,
 			check.WithReason(check.ReasonResourceNotFound),
-			check.WithMessage("ingress-operator deployment not found in openshift-ingress-operator namespace"),
-			check.WithRemediation("Check that the openshift-ingress-operator namespace and the ingress-operator deployment exist in the cluster."),
+			check.WithCatalogMessage(msgDeploymentNotFound),
+			check.WithCatalogRemediation(remediationDeploymentNotFound),
 			check.WithImpact(result.ImpactBlocking),
 		))
 
@@ -112,8 +128,8 @@ func (c *Check) Validate(ctx context.Context, target check.Target) (*result.Diag
 			check.ConditionTypeAvailable,
 			metav1.ConditionFalse,
 			check.WithReason(check.ReasonInsufficientData),
-			check.WithMessage("Unable to read ingress-operator deployment (insufficient permissions)"),
-			check.WithRemediation("Grant read access to deployments in the openshift-ingress-operator namespace."),
+			check.WithCatalogMessage(msgDeploymentForbidden),
+			check.WithCatalogRemediation(remediationDeploymentForbidden),
 			check.WithImpact(result.ImpactBlocking),
 		))
 
@@ -162,8 +178,8 @@ func (c *Check) Validate(ctx context.Context, target check.Target) (*result.Diag
 			check.ConditionTypeAvailable,
 			metav1.ConditionFalse,
 			check.WithReason(check.ReasonResourceNotFound),
-			check.WithMessage("servicemeshoperator3 PackageManifest not found in redhat-operators catalog (required: %s in '%s' channel)", requiredCSV, requiredChannel),
-			check.WithRemediation(fmt.Sprintf(mirrorRemediationFmt, requiredCSV, requiredChannel)),
+			check.WithCatalogMessage(msgPackageManifestNotFound, requiredCSV, requiredChannel),
+			check.WithCatalogRemediation(remediationMirror, requiredCSV, requiredChannel),
 			check.WithImpact(result.ImpactBlocking),
 		))
 
@@ -185,15 +201,15 @@ func (c *Check) Validate(ctx context.Context, target check.Target) (*result.Diag
 			check.ConditionTypeAvailable,
 			metav1.ConditionTrue,
 			check.WithReason(check.ReasonResourceFound),
-			check.WithMessage("%s (%s) is available in the '%s' channel of the 'redhat-operators' cluster catalog", displayName, requiredCSV, requiredChannel),
+			check.WithCatalogMessage(msgAvailable, displayName, requiredCSV, requiredChannel),
 		))
 	} else {
 		dr.SetCondition(check.NewCondition(
 			check.ConditionTypeAvailable,
 			metav1.ConditionFalse,
 			check.WithReason(check.ReasonDependencyUnavailable),
-			check.WithMessage("%s version %s is not available in the '%s' channel of the cluster catalog", displayName, displayVersion, requiredChannel),
-			check.WithRemediation(fmt.Sprintf(mirrorRemediationFmt, requiredCSV, requiredChannel)),
+			check.WithCatalogMessage(msgNotAvailable, displayName, displayVersion, requiredChannel),
+			check.WithCatalogRemediation(remediationMirror, requiredCSV, requiredChannel),
 			check.WithImpact(result.ImpactBlocking),
 		))
 	}
@@ -8,11 +8,19 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/messages"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/dependencies/shared"
 	"github.com/opendatahub-io/odh-cli/pkg/resources"
 	"github.com/opendatahub-io/odh-cli/pkg/util/client"
-	"github.com/opendatahub-io/odh-cli/pkg/util/version"
+)
+
+// Messages of the shared OSSM usage check.
+//
+//nolint:gochecknoglobals // Message catalog entries
+var (
+	msgNoSharedResources = messages.New("dependencies.shared-ossm.shared-usage.none", "No shared OSSM resources detected outside RHOAI-managed namespaces")
+	msgSharedResources   = messages.New("dependencies.shared-ossm.shared-usage.found", "Found %d OSSM resource(s) outside RHOAI-managed namespaces in: %s. These may be impacted by the RHOAI migration")
 )
 
 const (
@@ -43,14 +51,12 @@ func NewCheck() *Check {
 			CheckName:        "Dependencies :: Shared OSSM :: Shared Usage Detection",
 			CheckDescription: "Detects OpenShift Service Mesh resources shared between RHOAI and non-AI workloads",
 			CheckRemediation: "Review the identified Service Mesh resources before migration. Non-AI workloads sharing OSSM may be impacted by the RHOAI 2.x to 3.x migration.",
+			CheckResources:   []resources.ResourceType{resources.ServiceMeshControlPlane, resources.ServiceMeshMemberRoll, resources.ServiceMeshMember},
+			CheckVersions:    check.VersionsUpgrade2xTo3x,
 		},
 	}
 }
 
-func (c *Check) CanApply(_ context.Context, target check.Target) (bool, error) {
-	return version.IsUpgradeFrom2xTo3x(target.CurrentVersion, target.TargetVersion), nil
-}
-
 func (c *Check) Validate(ctx context.Context, target check.Target) (*result.DiagnosticResult, error) {
 	dr := c.NewResult()
 
@@ -78,7 +84,7 @@ func (c *Check) Validate(ctx context.Context, target check.Target) (*result.Diag
 			check.ConditionTypeValidated,
 			metav1.ConditionTrue,
 			check.WithReason(check.ReasonRequirementsMet),
-			check.WithMessage("No shared OSSM resources detected outside RHOAI-managed namespaces"),
+			check.WithCatalogMessage(msgNoSharedResources),
 		))
 
 		return dr, nil
@@ -90,8 +96,8 @@ func (c *Check) Validate(ctx context.Context, target check.Target) (*result.Diag
 		check.ConditionTypeValidated,
 		metav1.ConditionFalse,
 		check.WithReason(check.ReasonWorkloadsImpacted),
-		check.WithMessage(
-			"Found %d OSSM resource(s) outside RHOAI-managed namespaces in: %s. These may be impacted by the RHOAI migration",
+		check.WithCatalogMessage(
+			msgSharedResources,
 			totalCount,
 			strings.Join(namespaces, ", "),
 		),
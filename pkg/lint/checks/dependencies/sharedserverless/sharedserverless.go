@@ -8,11 +8,19 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/messages"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/dependencies/shared"
 	"github.com/opendatahub-io/odh-cli/pkg/resources"
 	"github.com/opendatahub-io/odh-cli/pkg/util/client"
-	"github.com/opendatahub-io/odh-cli/pkg/util/version"
+)
+
+// Messages of the shared Serverless usage check.
+//
+//nolint:gochecknoglobals // Message catalog entries
+var (
+	msgNoSharedResources = messages.New("dependencies.shared-serverless.shared-usage.none", "No shared Serverless resources detected outside RHOAI-managed namespaces")
+	msgSharedResources   = messages.New("dependencies.shared-serverless.shared-usage.found", "Found %d Serverless resource(s) outside RHOAI-managed namespaces in: %s. These may be impacted by the RHOAI migration")
 )
 
 const (
@@ -46,14 +54,12 @@ func NewCheck() *Check {
 			CheckName:        "Dependencies :: Shared Serverless :: Shared Usage Detection",
 			CheckDescription: "Detects Knative/Serverless resources shared between RHOAI and non-AI workloads",
 			CheckRemediation: "Review the identified Knative/Serverless resources before migration. Non-AI workloads using OpenShift Serverless may be impacted by the RHOAI 2.x to 3.x migration.",
+			CheckResources:   []resources.ResourceType{resources.KnativeServing, resources.KnativeEventing, resources.KnativeService},
+			CheckVersions:    check.VersionsUpgrade2xTo3x,
 		},
 	}
 }
 
-func (c *Check) CanApply(_ context.Context, target check.Target) (bool, error) {
-	return version.IsUpgradeFrom2xTo3x(target.CurrentVersion, target.TargetVersion), nil
-}
-
 func (c *Check) Validate(ctx context.Context, target check.Target) (*result.DiagnosticResult, error) {
 	dr := c.NewResult()
 
@@ -81,7 +87,7 @@ func (c *Check) Validate(ctx context.Context, target check.Target) (*result.Diag
 			check.ConditionTypeValidated,
 			metav1.ConditionTrue,
 			check.WithReason(check.ReasonRequirementsMet),
-			check.WithMessage("No shared Serverless resources detected outside RHOAI-managed namespaces"),
+			check.WithCatalogMessage(msgNoSharedResources),
 		))
 
 		return dr, nil
@@ -93,8 +99,8 @@ func (c *Check) Validate(ctx context.Context, target check.Target) (*result.Diag
 		check.ConditionTypeValidated,
 		metav1.ConditionFalse,
 		check.WithReason(check.ReasonWorkloadsImpacted),
-		check.WithMessage(
-			"Found %d Serverless resource(s) outside RHOAI-managed namespaces in: %s. These may be impacted by the RHOAI migration",
+		check.WithCatalogMessage(
+			msgSharedResources,
 			totalCount,
 			strings.Join(namespaces, ", "),
 		),
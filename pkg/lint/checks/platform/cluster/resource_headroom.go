@@ -0,0 +1,107 @@
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/opendatahub-io/odh-cli/pkg/constants"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/messages"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/validate"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/version"
+)
+
+// minHeadroomPercent is the share of schedulable capacity that should remain unrequested once the
+// target release's additional deployments are scheduled.
+const minHeadroomPercent = 10
+
+//nolint:gochecknoglobals // Message catalog entries
+var (
+	msgNoSchedulableNodes = messages.New("platform.cluster.resource-headroom.no-schedulable-nodes", "No schedulable nodes found - cannot estimate resource headroom for RHOAI %s")
+	msgHeadroomSufficient = messages.New("platform.cluster.resource-headroom.sufficient", "Schedulable capacity can accommodate the estimated RHOAI %s footprint (%s): %s")
+	msgHeadroomLow        = messages.New("platform.cluster.resource-headroom.low", "Schedulable capacity headroom falls below %d%% after the estimated RHOAI %s footprint (%s): %s")
+)
+
+// ResourceHeadroomCheck estimates the CPU and memory requested by deployments that are new in the
+// target release and compares it with the unrequested capacity of schedulable nodes.
+type ResourceHeadroomCheck struct {
+	check.BaseCheck
+}
+
+// NewResourceHeadroomCheck creates a new ResourceHeadroomCheck.
+func NewResourceHeadroomCheck() *ResourceHeadroomCheck {
+	return &ResourceHeadroomCheck{
+		BaseCheck: check.BaseCheck{
+			CheckGroup:       check.GroupPlatform,
+			Kind:             constants.PlatformCluster,
+			Type:             check.CheckTypeResourceHeadroom,
+			CheckID:          "platform.cluster.resource-headroom",
+			CheckName:        "Platform :: Cluster :: Resource Headroom (3.x)",
+			CheckDescription: "Estimates the CPU and memory required by components added in RHOAI 3.x and compares it with the unrequested capacity of schedulable nodes",
+			CheckRemediation: "Add worker nodes or free up requested CPU/memory before upgrading so the new controllers and gateway can be scheduled",
+			CheckResources: []resources.ResourceType{
+				resources.DataScienceCluster,
+				resources.Node,
+				resources.Pod,
+			},
+			CheckVersions: check.VersionsUpgrade2xTo3x,
+		},
+	}
+}
+
+// Validate sums the footprint of the enabled components and the capacity of schedulable nodes.
+func (c *ResourceHeadroomCheck) Validate(ctx context.Context, target check.Target) (*result.DiagnosticResult, error) {
+	tv := version.MajorMinorLabel(target.TargetVersion)
+
+	return validate.DSC(c, target).Run(ctx, func(dr *result.DiagnosticResult, dsc *unstructured.Unstructured) error {
+		required, added := estimateFootprint(dsc)
+
+		capacity, err := schedulableCapacity(ctx, target.Client)
+		if err != nil {
+			return err
+		}
+
+		if capacity.nodes == 0 {
+			dr.SetCondition(check.NewCondition(
+				check.ConditionTypeAvailable,
+				metav1.ConditionUnknown,
+				check.WithReason(check.ReasonInsufficientData),
+				check.WithCatalogMessage(msgNoSchedulableNodes, tv),
+			))
+
+			return nil
+		}
+
+		footprint := fmt.Sprintf("%s for %s", required, strings.Join(added, ", "))
+		cpuOK := capacity.cpu.hasHeadroom(required.cpuMillis)
+		memoryOK := capacity.memory.hasHeadroom(required.memoryBytes)
+
+		if cpuOK && memoryOK {
+			dr.SetCondition(check.NewCondition(
+				check.ConditionTypeAvailable,
+				metav1.ConditionTrue,
+				check.WithReason(check.ReasonResourceAvailable),
+				check.WithCatalogMessage(msgHeadroomSufficient, tv, footprint, capacity.summary(required)),
+			))
+
+			return nil
+		}
+
+		dr.SetCondition(check.NewCondition(
+			check.ConditionTypeAvailable,
+			metav1.ConditionFalse,
+			check.WithReason(check.ReasonQuotaExceeded),
+			check.WithCatalogMessage(msgHeadroomLow, minHeadroomPercent, tv, footprint, capacity.summary(required)),
+			check.WithImpact(result.ImpactAdvisory),
+			check.WithRemediation(c.CheckRemediation),
+		))
+
+		return nil
+	})
+}
@@ -0,0 +1,189 @@
+package cluster_test
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/testutil"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/platform/cluster"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+)
+
+//nolint:gochecknoglobals // Test fixture - shared across test functions
+var listKinds = map[schema.GroupVersionResource]string{
+	resources.DataScienceCluster.GVR(): resources.DataScienceCluster.ListKind(),
+	resources.Node.GVR():               resources.Node.ListKind(),
+	resources.Pod.GVR():                resources.Pod.ListKind(),
+}
+
+func toUnstructured(t *testing.T, obj runtime.Object) *unstructured.Unstructured {
+	t.Helper()
+
+	u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		t.Fatalf("converting %T: %v", obj, err)
+	}
+
+	return &unstructured.Unstructured{Object: u}
+}
+
+func newNode(t *testing.T, name string, cpu string, memory string, taints ...corev1.Taint) *unstructured.Unstructured {
+	t.Helper()
+
+	return toUnstructured(t, &corev1.Node{
+		TypeMeta:   resources.Node.TypeMeta(),
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec:       corev1.NodeSpec{Taints: taints},
+		Status: corev1.NodeStatus{
+			Allocatable: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse(cpu),
+				corev1.ResourceMemory: resource.MustParse(memory),
+			},
+			Conditions: []corev1.NodeCondition{
+				{Type: corev1.NodeReady, Status: corev1.ConditionTrue},
+			},
+		},
+	})
+}
+
+func newPod(t *testing.T, name string, node string, cpu string, memory string) *unstructured.Unstructured {
+	t.Helper()
+
+	return toUnstructured(t, &corev1.Pod{
+		TypeMeta:   resources.Pod.TypeMeta(),
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "apps"},
+		Spec: corev1.PodSpec{
+			NodeName: node,
+			Containers: []corev1.Container{
+				{
+					Name: "app",
+					Resources: corev1.ResourceRequirements{
+						Requests: corev1.ResourceList{
+							corev1.ResourceCPU:    resource.MustParse(cpu),
+							corev1.ResourceMemory: resource.MustParse(memory),
+						},
+					},
+				},
+			},
+		},
+		Status: corev1.PodStatus{Phase: corev1.PodRunning},
+	})
+}
+
+func TestResourceHeadroomCheck_CanApply(t *testing.T) {
+	g := NewWithT(t)
+
+	chk := cluster.NewResourceHeadroomCheck()
+
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds:      listKinds,
+		CurrentVersion: "2.17.0",
+		TargetVersion:  "3.0.0",
+	})
+	canApply, err := chk.CanApply(t.Context(), target)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(canApply).To(BeTrue())
+
+	target = testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds:      listKinds,
+		CurrentVersion: "3.0.0",
+		TargetVersion:  "3.1.0",
+	})
+	canApply, err = chk.CanApply(t.Context(), target)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(canApply).To(BeFalse())
+}
+
+func TestResourceHeadroomCheck_SufficientHeadroom(t *testing.T) {
+	g := NewWithT(t)
+
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds: listKinds,
+		Objects: []*unstructured.Unstructured{
+			testutil.NewDSC(map[string]string{"kserve": "Managed"}),
+			newNode(t, "worker-1", "8", "32Gi"),
+			newPod(t, "app", "worker-1", "2", "8Gi"),
+		},
+		CurrentVersion: "2.17.0",
+		TargetVersion:  "3.0.0",
+	})
+
+	dr, err := cluster.NewResourceHeadroomCheck().Validate(t.Context(), target)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(dr.Status.Conditions).To(HaveLen(1))
+	g.Expect(dr.Status.Conditions[0].Condition).To(MatchFields(IgnoreExtras, Fields{
+		"Type":    Equal(check.ConditionTypeAvailable),
+		"Status":  Equal(metav1.ConditionTrue),
+		"Reason":  Equal(check.ReasonResourceAvailable),
+		"Message": ContainSubstring("300m CPU, 556Mi memory for data-science-gateway, kube-auth-proxy, llmisvc-controller-manager"),
+	}))
+}
+
+func TestResourceHeadroomCheck_LowHeadroom(t *testing.T) {
+	g := NewWithT(t)
+
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds: listKinds,
+		Objects: []*unstructured.Unstructured{
+			testutil.NewDSC(map[string]string{}),
+			newNode(t, "worker-1", "4", "16Gi"),
+			// Control plane capacity is not available to the new deployments.
+			newNode(t, "master-0", "16", "64Gi", corev1.Taint{
+				Key:    "node-role.kubernetes.io/master",
+				Effect: corev1.TaintEffectNoSchedule,
+			}),
+			newPod(t, "busy", "worker-1", "3700m", "8Gi"),
+			newPod(t, "control-plane", "master-0", "1", "1Gi"),
+		},
+		CurrentVersion: "2.17.0",
+		TargetVersion:  "3.0.0",
+	})
+
+	dr, err := cluster.NewResourceHeadroomCheck().Validate(t.Context(), target)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(dr.Status.Conditions).To(HaveLen(1))
+	g.Expect(dr.Status.Conditions[0].Condition).To(MatchFields(IgnoreExtras, Fields{
+		"Status": Equal(metav1.ConditionFalse),
+		"Reason": Equal(check.ReasonQuotaExceeded),
+		"Message": And(
+			ContainSubstring("below 10%"),
+			ContainSubstring("1 schedulable node(s) with 0.3 of 4.0 CPU cores"),
+		),
+	}))
+	g.Expect(dr.Status.Conditions[0].Impact).To(Equal(result.ImpactAdvisory))
+}
+
+func TestResourceHeadroomCheck_NoSchedulableNodes(t *testing.T) {
+	g := NewWithT(t)
+
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds: listKinds,
+		Objects: []*unstructured.Unstructured{
+			testutil.NewDSC(map[string]string{}),
+		},
+		CurrentVersion: "2.17.0",
+		TargetVersion:  "3.0.0",
+	})
+
+	dr, err := cluster.NewResourceHeadroomCheck().Validate(t.Context(), target)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(dr.Status.Conditions).To(HaveLen(1))
+	g.Expect(dr.Status.Conditions[0].Condition).To(MatchFields(IgnoreExtras, Fields{
+		"Status": Equal(metav1.ConditionUnknown),
+		"Reason": Equal(check.ReasonInsufficientData),
+	}))
+}
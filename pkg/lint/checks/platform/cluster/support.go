@@ -0,0 +1,221 @@
+package cluster
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/opendatahub-io/odh-cli/pkg/constants"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+	"github.com/opendatahub-io/odh-cli/pkg/util/components"
+)
+
+const bytesPerGiB = 1 << 30
+
+// footprint is the estimated resource request of a deployment added by the target release.
+// An empty component means the deployment is part of the platform and always installed.
+type footprint struct {
+	component  string
+	deployment string
+	cpu        resource.Quantity
+	memory     resource.Quantity
+}
+
+// addedFootprints lists deployments introduced in RHOAI 3.x, with the requests set by their
+// default manifests.
+//
+//nolint:gochecknoglobals // Read-only lookup table
+var addedFootprints = []footprint{
+	{
+		deployment: "data-science-gateway",
+		cpu:        resource.MustParse("100m"),
+		memory:     resource.MustParse("128Mi"),
+	},
+	{
+		deployment: "kube-auth-proxy",
+		cpu:        resource.MustParse("100m"),
+		memory:     resource.MustParse("128Mi"),
+	},
+	{
+		component:  constants.ComponentKServe,
+		deployment: "llmisvc-controller-manager",
+		cpu:        resource.MustParse("100m"),
+		memory:     resource.MustParse("300Mi"),
+	},
+	{
+		component:  constants.ComponentTrainingOperator,
+		deployment: "kubeflow-trainer-controller-manager",
+		cpu:        resource.MustParse("100m"),
+		memory:     resource.MustParse("256Mi"),
+	},
+}
+
+// requirement is an amount of CPU and memory.
+type requirement struct {
+	cpuMillis   int64
+	memoryBytes int64
+}
+
+func (r requirement) String() string {
+	return fmt.Sprintf("%dm CPU, %s memory", r.cpuMillis, resource.NewQuantity(r.memoryBytes, resource.BinarySI))
+}
+
+// pool tracks the allocatable and already requested amount of a single resource.
+type pool struct {
+	allocatable int64
+	requested   int64
+}
+
+// hasHeadroom reports whether at least minHeadroomPercent of the pool stays unrequested after
+// adding extra.
+func (p pool) hasHeadroom(extra int64) bool {
+	return (p.allocatable-p.requested-extra)*100 >= p.allocatable*minHeadroomPercent
+}
+
+// free returns the unrequested amount, which is negative when nodes are overcommitted.
+func (p pool) free() int64 {
+	return p.allocatable - p.requested
+}
+
+// capacity aggregates the CPU and memory of schedulable nodes.
+type capacity struct {
+	nodes  int
+	cpu    pool
+	memory pool
+}
+
+func (c capacity) summary(required requirement) string {
+	return fmt.Sprintf(
+		"%d schedulable node(s) with %.1f of %.1f CPU cores and %.1f of %.1f GiB memory unrequested, %.1f cores and %.1f GiB after upgrade",
+		c.nodes,
+		float64(c.cpu.free())/1000, float64(c.cpu.allocatable)/1000,
+		float64(c.memory.free())/bytesPerGiB, float64(c.memory.allocatable)/bytesPerGiB,
+		float64(c.cpu.free()-required.cpuMillis)/1000,
+		float64(c.memory.free()-required.memoryBytes)/bytesPerGiB,
+	)
+}
+
+// estimateFootprint sums the requests of deployments added for the platform and for every
+// Managed component, returning the total and the names of the deployments counted.
+func estimateFootprint(dsc *unstructured.Unstructured) (requirement, []string) {
+	var total requirement
+
+	added := make([]string, 0, len(addedFootprints))
+
+	for _, f := range addedFootprints {
+		if f.component != "" && !components.HasManagementState(dsc, f.component, constants.ManagementStateManaged) {
+			continue
+		}
+
+		total.cpuMillis += f.cpu.MilliValue()
+		total.memoryBytes += f.memory.Value()
+		added = append(added, f.deployment)
+	}
+
+	return total, added
+}
+
+// schedulableCapacity sums the allocatable resources of nodes that accept regular workloads and
+// the requests of the pods running on them.
+func schedulableCapacity(ctx context.Context, r client.Reader) (capacity, error) {
+	var c capacity
+
+	nodes, err := r.List(ctx, resources.Node)
+	if err != nil {
+		return c, fmt.Errorf("listing nodes: %w", err)
+	}
+
+	schedulable := sets.New[string]()
+
+	for _, obj := range nodes {
+		var node corev1.Node
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, &node); err != nil {
+			return c, fmt.Errorf("converting node %s: %w", obj.GetName(), err)
+		}
+
+		if !isSchedulable(&node) {
+			continue
+		}
+
+		schedulable.Insert(node.Name)
+		c.nodes++
+		c.cpu.allocatable += node.Status.Allocatable.Cpu().MilliValue()
+		c.memory.allocatable += node.Status.Allocatable.Memory().Value()
+	}
+
+	if c.nodes == 0 {
+		return c, nil
+	}
+
+	pods, err := r.List(ctx, resources.Pod)
+	if err != nil {
+		return c, fmt.Errorf("listing pods: %w", err)
+	}
+
+	for _, obj := range pods {
+		var pod corev1.Pod
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, &pod); err != nil {
+			return c, fmt.Errorf("converting pod %s/%s: %w", obj.GetNamespace(), obj.GetName(), err)
+		}
+
+		if !schedulable.Has(pod.Spec.NodeName) ||
+			pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
+			continue
+		}
+
+		requests := podRequests(&pod)
+		c.cpu.requested += requests.cpuMillis
+		c.memory.requested += requests.memoryBytes
+	}
+
+	return c, nil
+}
+
+// isSchedulable reports whether a node is Ready, not cordoned and free of taints that repel
+// pods without tolerations, such as the control plane taint.
+func isSchedulable(node *corev1.Node) bool {
+	if node.Spec.Unschedulable {
+		return false
+	}
+
+	for _, taint := range node.Spec.Taints {
+		if taint.Effect == corev1.TaintEffectNoSchedule || taint.Effect == corev1.TaintEffectNoExecute {
+			return false
+		}
+	}
+
+	for _, cond := range node.Status.Conditions {
+		if cond.Type == corev1.NodeReady {
+			return cond.Status == corev1.ConditionTrue
+		}
+	}
+
+	return false
+}
+
+// podRequests returns the effective requests of a pod as computed by the scheduler: the larger of
+// the summed app containers and the largest init container.
+func podRequests(pod *corev1.Pod) requirement {
+	var containers, init requirement
+
+	for _, ctr := range pod.Spec.Containers {
+		containers.cpuMillis += ctr.Resources.Requests.Cpu().MilliValue()
+		containers.memoryBytes += ctr.Resources.Requests.Memory().Value()
+	}
+
+	for _, ctr := range pod.Spec.InitContainers {
+		init.cpuMillis = max(init.cpuMillis, ctr.Resources.Requests.Cpu().MilliValue())
+		init.memoryBytes = max(init.memoryBytes, ctr.Resources.Requests.Memory().Value())
+	}
+
+	return requirement{
+		cpuMillis:   max(containers.cpuMillis, init.cpuMillis),
+		memoryBytes: max(containers.memoryBytes, init.memoryBytes),
+	}
+}
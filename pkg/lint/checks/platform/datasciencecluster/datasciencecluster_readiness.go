@@ -24,9 +24,11 @@ func NewDataScienceClusterReadinessCheck() *DataScienceClusterReadinessCheck {
 			CheckGroup:       check.GroupPlatform,
 			Kind:             constants.PlatformDSC,
 			Type:             check.CheckTypeReadiness,
-			CheckID:          "platform.dsc.readiness",
+			CheckID:          check.CheckIDDSCReadiness,
+			CheckTags:        []string{check.TagFast, check.TagBlockingOnly},
 			CheckName:        "Platform :: DSC :: Readiness Check",
 			CheckDescription: "Validates that DataScienceCluster is in Ready state",
+			CheckVersions:    check.VersionsAny,
 		},
 	}
 }
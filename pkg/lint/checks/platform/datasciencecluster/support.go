@@ -8,10 +8,20 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/messages"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
 	"github.com/opendatahub-io/odh-cli/pkg/util/jq"
 )
 
+// Messages of the DataScienceCluster readiness check.
+//
+//nolint:gochecknoglobals // Message catalog entries
+var (
+	msgReadyConditionMissing = messages.New("platform.datasciencecluster.readiness.condition-missing", "%s resource found but Ready condition is missing")
+	msgNotReady              = messages.New("platform.datasciencecluster.readiness.not-ready", "%s is not ready (status: %s) due to '%s'. %s must be ready before upgrading")
+	msgReady                 = messages.New("platform.datasciencecluster.readiness.ready", "%s is ready")
+)
+
 // validateReadyCondition checks that the Ready condition is True on a resource.
 func validateReadyCondition(
 	dr *result.DiagnosticResult,
@@ -32,7 +42,7 @@ func validateReadyCondition(
 			check.ConditionTypeReady,
 			metav1.ConditionFalse,
 			check.WithReason(check.ReasonInsufficientData),
-			check.WithMessage("%s resource found but Ready condition is missing", resourceName),
+			check.WithCatalogMessage(msgReadyConditionMissing, resourceName),
 			check.WithImpact(result.ImpactBlocking),
 		))
 	case readyCondition.Status != expectedStatus:
@@ -40,7 +50,7 @@ func validateReadyCondition(
 			check.ConditionTypeReady,
 			metav1.ConditionFalse,
 			check.WithReason(check.ReasonResourceUnavailable),
-			check.WithMessage("%s is not ready (status: %s) due to '%s'. %s must be ready before upgrading", resourceName, readyCondition.Status, readyCondition.Message, resourceName),
+			check.WithCatalogMessage(msgNotReady, resourceName, readyCondition.Status, readyCondition.Message, resourceName),
 			check.WithImpact(result.ImpactBlocking),
 		))
 	default:
@@ -48,7 +58,7 @@ func validateReadyCondition(
 			check.ConditionTypeReady,
 			metav1.ConditionTrue,
 			check.WithReason(check.ReasonResourceAvailable),
-			check.WithMessage("%s is ready", resourceName),
+			check.WithCatalogMessage(msgReady, resourceName),
 		))
 	}
 
@@ -23,8 +23,10 @@ func NewDSCInitializationReadinessCheck() *DSCInitializationReadinessCheck {
 			Kind:             constants.PlatformDSCI,
 			Type:             check.CheckTypeReadiness,
 			CheckID:          "platform.dsci.readiness",
+			CheckTags:        []string{check.TagFast, check.TagBlockingOnly},
 			CheckName:        "Platform :: DSCI :: Readiness Check",
 			CheckDescription: "Validates that DSCInitialization is in Ready state before upgrading to RHOAI 3.x",
+			CheckVersions:    check.VersionsAny,
 		},
 	}
 }
@@ -9,10 +9,23 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/messages"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
 	"github.com/opendatahub-io/odh-cli/pkg/util/jq"
 )
 
+// Messages of the DSCInitialization readiness check.
+//
+//nolint:gochecknoglobals // Message catalog entries
+var (
+	msgPhaseMissing         = messages.New("platform.dsci.readiness.phase-missing", "%s resource found but phase field is missing")
+	msgPhaseEmpty           = messages.New("platform.dsci.readiness.phase-empty", "%s resource found but phase is empty")
+	msgUnexpectedConditions = messages.New("platform.dsci.readiness.unexpected-conditions", "%s phase is Ready but some conditions are not expected. Conditions: %s")
+	msgReady                = messages.New("platform.dsci.readiness.ready", "%s is ready")
+	msgNotReady             = messages.New("platform.dsci.readiness.not-ready", "%s is not ready (phase: %s). %s must be ready before upgrading")
+	msgNotReadyConditions   = messages.New("platform.dsci.readiness.not-ready-conditions", "%s is not ready (phase: %s). %s must be ready before upgrading. Conditions: %s")
+)
+
 // dsciStatusCondition is a minimal representation of a DSCI status condition used for
 // collecting unhappy condition messages regardless of phase.
 type dsciStatusCondition struct {
@@ -99,7 +112,7 @@ func validatePhaseReady(
 			check.ConditionTypeReady,
 			metav1.ConditionFalse,
 			check.WithReason(check.ReasonInsufficientData),
-			check.WithMessage("%s resource found but phase field is missing", resourceName),
+			check.WithCatalogMessage(msgPhaseMissing, resourceName),
 			check.WithImpact(result.ImpactBlocking),
 		))
 	case err != nil:
@@ -109,7 +122,7 @@ func validatePhaseReady(
 			check.ConditionTypeReady,
 			metav1.ConditionFalse,
 			check.WithReason(check.ReasonInsufficientData),
-			check.WithMessage("%s resource found but phase is empty", resourceName),
+			check.WithCatalogMessage(msgPhaseEmpty, resourceName),
 			check.WithImpact(result.ImpactBlocking),
 		))
 	case phaseStatus != "Ready":
@@ -118,16 +131,16 @@ func validatePhaseReady(
 			return fmt.Errorf("collecting conditions from %s: %w", resourceName, msgErr)
 		}
 
-		msg := fmt.Sprintf("%s is not ready (phase: %s). %s must be ready before upgrading", resourceName, phaseStatus, resourceName)
+		message := check.WithCatalogMessage(msgNotReady, resourceName, phaseStatus, resourceName)
 		if len(condMessages) > 0 {
-			msg += ". Conditions: " + strings.Join(condMessages, "; ")
+			message = check.WithCatalogMessage(msgNotReadyConditions, resourceName, phaseStatus, resourceName, strings.Join(condMessages, "; "))
 		}
 
 		dr.SetCondition(check.NewCondition(
 			check.ConditionTypeReady,
 			metav1.ConditionFalse,
 			check.WithReason(check.ReasonResourceUnavailable),
-			check.WithMessage("%s", msg),
+			message,
 			check.WithImpact(result.ImpactBlocking),
 		))
 	default:
@@ -142,7 +155,7 @@ func validatePhaseReady(
 				check.ConditionTypeReady,
 				metav1.ConditionFalse,
 				check.WithReason(check.ReasonResourceUnavailable),
-				check.WithMessage("%s phase is Ready but some conditions are not expected. Conditions: %s", resourceName, strings.Join(condMessages, "; ")),
+				check.WithCatalogMessage(msgUnexpectedConditions, resourceName, strings.Join(condMessages, "; ")),
 				check.WithImpact(result.ImpactBlocking),
 			))
 		} else {
@@ -150,7 +163,7 @@ func validatePhaseReady(
 				check.ConditionTypeReady,
 				metav1.ConditionTrue,
 				check.WithReason(check.ReasonResourceAvailable),
-				check.WithMessage("%s is ready", resourceName),
+				check.WithCatalogMessage(msgReady, resourceName),
 			))
 		}
 	}
@@ -0,0 +1,128 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/messages"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/validate"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/version"
+)
+
+// Messages of the authentication migration check.
+//
+//nolint:gochecknoglobals // Message catalog entries
+var (
+	msgAuthMigrationClean  = messages.New("services.auth.migration.clean", "Authentication configuration maps cleanly to the RHOAI %s Gateway API and kube-rbac-proxy model")
+	msgAuthMigrationManual = messages.New("services.auth.migration.manual", "Authentication configuration needs manual migration to the RHOAI %s Gateway API and kube-rbac-proxy model: %s")
+)
+
+const kind = "auth"
+
+const (
+	msgCustomAudiences       = "DSCInitialization sets custom token audiences (%s)"
+	msgUnsupportedAdmin      = "Auth CR adminGroups include %s"
+	msgExternalAuthConfigs   = "%d AuthConfig(s) use external identity providers"
+	msgAudienceAuthConfigs   = "%d AuthConfig(s) use custom token review audiences"
+	remediationAuthMigration = "Remove custom audiences from DSCInitialization spec.serviceMesh.auth, replace system:authenticated in the Auth CR adminGroups with explicit groups, " +
+		"and recreate external identity provider (JWT/OIDC, OAuth2 introspection) configuration on the Gateway after upgrading"
+)
+
+// MigrationCheck validates that the 2.x authentication configuration, i.e. DSCInitialization token
+// audiences, the Auth CR and Authorino AuthConfigs, carries over to the 3.x model where model
+// endpoints are protected by Gateway API and kube-rbac-proxy using Kubernetes token reviews only.
+type MigrationCheck struct {
+	check.BaseCheck
+}
+
+func NewMigrationCheck() *MigrationCheck {
+	return &MigrationCheck{
+		BaseCheck: check.BaseCheck{
+			CheckGroup:       check.GroupService,
+			Kind:             kind,
+			Type:             check.CheckTypeConfigMigration,
+			CheckID:          "services.auth.migration",
+			CheckName:        "Services :: Auth :: Migration (3.x)",
+			CheckDescription: "Validates that AuthConfig resources and the Auth CR map to the RHOAI 3.x Gateway API and kube-rbac-proxy auth model, flagging custom audiences and external auth providers",
+			CheckRemediation: remediationAuthMigration,
+			CheckResources: []resources.ResourceType{
+				resources.DSCInitialization,
+				resources.Auth,
+				resources.AuthConfig,
+			},
+			CheckVersions: check.VersionsUpgrade2xTo3x,
+		},
+	}
+}
+
+// Validate inspects DSCInitialization audiences, the Auth CR and every AuthConfig.
+func (c *MigrationCheck) Validate(ctx context.Context, target check.Target) (*result.DiagnosticResult, error) {
+	tv := version.MajorMinorLabel(target.TargetVersion)
+
+	return validate.DSCI(c, target).Run(ctx, func(dr *result.DiagnosticResult, dsci *unstructured.Unstructured) error {
+		audiences, err := customAudiences(dsci, ".spec.serviceMesh.auth.audiences")
+		if err != nil {
+			return fmt.Errorf("querying DSCInitialization audiences: %w", err)
+		}
+
+		adminGroups, err := unsupportedAdminGroups(ctx, target.Client)
+		if err != nil {
+			return err
+		}
+
+		findings, err := inspectAuthConfigs(ctx, target.Client)
+		if err != nil {
+			return err
+		}
+
+		var problems []string
+		if len(audiences) > 0 {
+			problems = append(problems, fmt.Sprintf(msgCustomAudiences, strings.Join(audiences, ", ")))
+		}
+
+		if len(adminGroups) > 0 {
+			problems = append(problems, fmt.Sprintf(msgUnsupportedAdmin, strings.Join(adminGroups, ", ")))
+		}
+
+		if len(findings.externalProviders) > 0 {
+			problems = append(problems, fmt.Sprintf(msgExternalAuthConfigs, len(findings.externalProviders)))
+		}
+
+		if len(findings.customAudiences) > 0 {
+			problems = append(problems, fmt.Sprintf(msgAudienceAuthConfigs, len(findings.customAudiences)))
+		}
+
+		if len(problems) == 0 {
+			dr.SetCondition(check.NewCondition(
+				check.ConditionTypeCompatible,
+				metav1.ConditionTrue,
+				check.WithReason(check.ReasonNoMigrationRequired),
+				check.WithCatalogMessage(msgAuthMigrationClean, tv),
+			))
+
+			return nil
+		}
+
+		dr.SetCondition(check.NewCondition(
+			check.ConditionTypeCompatible,
+			metav1.ConditionFalse,
+			check.WithReason(check.ReasonMigrationPending),
+			check.WithCatalogMessage(msgAuthMigrationManual, tv, strings.Join(problems, "; ")),
+			check.WithImpact(result.ImpactAdvisory),
+			check.WithRemediation(c.CheckRemediation),
+		))
+
+		if impacted := findings.impacted(); len(impacted) > 0 {
+			dr.SetImpactedObjects(resources.AuthConfig, impacted)
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,159 @@
+package auth_test
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/testutil"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/services/auth"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+)
+
+//nolint:gochecknoglobals // Test fixture - shared across test functions
+var listKinds = map[schema.GroupVersionResource]string{
+	resources.DSCInitialization.GVR(): resources.DSCInitialization.ListKind(),
+	resources.Auth.GVR():              resources.Auth.ListKind(),
+	resources.AuthConfig.GVR():        resources.AuthConfig.ListKind(),
+}
+
+func newDSCIWithAudiences(audiences ...any) *unstructured.Unstructured {
+	dsci := testutil.NewDSCI("redhat-ods-applications")
+	_ = unstructured.SetNestedSlice(dsci.Object, audiences, "spec", "serviceMesh", "auth", "audiences")
+
+	return dsci
+}
+
+func newAuthCR(adminGroups ...any) *unstructured.Unstructured {
+	return &unstructured.Unstructured{
+		Object: map[string]any{
+			"apiVersion": resources.Auth.APIVersion(),
+			"kind":       resources.Auth.Kind,
+			"metadata":   map[string]any{"name": "auth"},
+			"spec": map[string]any{
+				"adminGroups":   adminGroups,
+				"allowedGroups": []any{"system:authenticated"},
+			},
+		},
+	}
+}
+
+func newAuthConfig(name string, authentication map[string]any) *unstructured.Unstructured {
+	return &unstructured.Unstructured{
+		Object: map[string]any{
+			"apiVersion": resources.AuthConfig.APIVersion(),
+			"kind":       resources.AuthConfig.Kind,
+			"metadata": map[string]any{
+				"name":      name,
+				"namespace": "models",
+			},
+			"spec": map[string]any{
+				"hosts":          []any{name + ".example.com"},
+				"authentication": authentication,
+			},
+		},
+	}
+}
+
+func tokenReview(audiences ...any) map[string]any {
+	return map[string]any{
+		"kubernetes-user": map[string]any{
+			"kubernetesTokenReview": map[string]any{"audiences": audiences},
+		},
+	}
+}
+
+func TestMigrationCheck_CanApply(t *testing.T) {
+	g := NewWithT(t)
+
+	chk := auth.NewMigrationCheck()
+
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds:      listKinds,
+		CurrentVersion: "2.17.0",
+		TargetVersion:  "3.0.0",
+	})
+	canApply, err := chk.CanApply(t.Context(), target)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(canApply).To(BeTrue())
+
+	target = testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds:      listKinds,
+		CurrentVersion: "3.0.0",
+		TargetVersion:  "3.1.0",
+	})
+	canApply, err = chk.CanApply(t.Context(), target)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(canApply).To(BeFalse())
+}
+
+func TestMigrationCheck_DefaultConfiguration(t *testing.T) {
+	g := NewWithT(t)
+
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds: listKinds,
+		Objects: []*unstructured.Unstructured{
+			newDSCIWithAudiences("https://kubernetes.default.svc"),
+			newAuthCR("rhods-admins"),
+			newAuthConfig("model", tokenReview("https://kubernetes.default.svc")),
+		},
+		CurrentVersion: "2.17.0",
+		TargetVersion:  "3.0.0",
+	})
+
+	dr, err := auth.NewMigrationCheck().Validate(t.Context(), target)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(dr.Status.Conditions).To(HaveLen(1))
+	g.Expect(dr.Status.Conditions[0].Condition).To(MatchFields(IgnoreExtras, Fields{
+		"Type":   Equal(check.ConditionTypeCompatible),
+		"Status": Equal(metav1.ConditionTrue),
+		"Reason": Equal(check.ReasonNoMigrationRequired),
+	}))
+	g.Expect(dr.ImpactedObjects).To(BeEmpty())
+}
+
+func TestMigrationCheck_ManualMigrationRequired(t *testing.T) {
+	g := NewWithT(t)
+
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds: listKinds,
+		Objects: []*unstructured.Unstructured{
+			newDSCIWithAudiences("https://kubernetes.default.svc", "https://sso.example.com"),
+			newAuthCR("system:authenticated"),
+			newAuthConfig("oidc-model", map[string]any{
+				"keycloak": map[string]any{"jwt": map[string]any{"issuerUrl": "https://sso.example.com/realms/ai"}},
+			}),
+			newAuthConfig("audience-model", tokenReview("https://sso.example.com")),
+			newAuthConfig("default-model", tokenReview("https://kubernetes.default.svc")),
+		},
+		CurrentVersion: "2.17.0",
+		TargetVersion:  "3.0.0",
+	})
+
+	dr, err := auth.NewMigrationCheck().Validate(t.Context(), target)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(dr.Status.Conditions).To(HaveLen(1))
+	g.Expect(dr.Status.Conditions[0].Condition).To(MatchFields(IgnoreExtras, Fields{
+		"Status": Equal(metav1.ConditionFalse),
+		"Reason": Equal(check.ReasonMigrationPending),
+		"Message": And(
+			ContainSubstring("custom token audiences (https://sso.example.com)"),
+			ContainSubstring(`adminGroups include "system:authenticated"`),
+			ContainSubstring("1 AuthConfig(s) use external identity providers"),
+			ContainSubstring("1 AuthConfig(s) use custom token review audiences"),
+		),
+	}))
+	g.Expect(dr.Status.Conditions[0].Impact).To(Equal(result.ImpactAdvisory))
+	g.Expect(dr.ImpactedObjects).To(HaveLen(2))
+	g.Expect(dr.ImpactedObjects[0].Name).To(Equal("oidc-model"))
+	g.Expect(dr.ImpactedObjects[1].Name).To(Equal("audience-model"))
+}
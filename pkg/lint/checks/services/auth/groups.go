@@ -0,0 +1,347 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/messages"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/validate"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+	"github.com/opendatahub-io/odh-cli/pkg/util/version"
+)
+
+// Messages of the Auth groups check.
+//
+//nolint:gochecknoglobals // Message catalog entries
+var (
+	msgGroupsClean    = messages.New("services.auth.groups.clean", "Dashboard groupsConfig groups are all carried over to the RHOAI %s Auth CR group model")
+	msgGroupsManual   = messages.New("services.auth.groups.manual", "User groups are not carried over to the RHOAI %s Auth CR group model: %s")
+	msgNoGroupsConfig = messages.New("services.auth.groups.no-groups-config", "No Dashboard groupsConfig found - the Auth CR groups apply unchanged in RHOAI %s")
+)
+
+const (
+	groupsCheckType = "groups-migration"
+
+	// AnnotationCheckExpectedAdminGroups and AnnotationCheckExpectedAllowedGroups are set on the
+	// impacted Auth CR to the comma-separated groups it must list to carry over the 2.x
+	// Dashboard groupsConfig.
+	AnnotationCheckExpectedAdminGroups   = "check.opendatahub.io/expected-admin-groups"
+	AnnotationCheckExpectedAllowedGroups = "check.opendatahub.io/expected-allowed-groups"
+
+	// virtualGroupPrefix marks groups computed by the API server, which have no Group object.
+	virtualGroupPrefix = "system:"
+)
+
+const (
+	msgMissingAdminGroups    = "admin groups %s are missing from the Auth CR adminGroups"
+	msgMissingAllowedGroups  = "allowed groups %s are missing from the Auth CR allowedGroups"
+	msgUnsupportedAdminGroup = "groupsConfig admin groups include %s, which is not supported in the Auth CR adminGroups"
+	msgAuthCRNotFound        = "no Auth CR exists to carry the groups over"
+	msgUnknownGroups         = "groups %s do not exist"
+	remediationGroups        = "Add the groupsConfig groups to spec.adminGroups and spec.allowedGroups of the Auth CR, replacing system:authenticated admin access with explicit groups, " +
+		"and create the missing OpenShift groups"
+)
+
+// GroupsCheck validates the 2.x admin and allowed user groups, configured in the Dashboard
+// groupsConfig, against the 3.x model where access is granted to the adminGroups and
+// allowedGroups of the Auth CR only. Groups missing from the Auth CR lose their access after
+// upgrade.
+type GroupsCheck struct {
+	check.BaseCheck
+}
+
+// NewGroupsCheck creates a new GroupsCheck instance.
+func NewGroupsCheck() *GroupsCheck {
+	return &GroupsCheck{
+		BaseCheck: check.BaseCheck{
+			CheckGroup:       check.GroupService,
+			Kind:             kind,
+			Type:             groupsCheckType,
+			CheckID:          "services.auth.groups",
+			CheckName:        "Services :: Auth :: User Groups (3.x)",
+			CheckDescription: "Validates that the admin and allowed user groups of the Dashboard groupsConfig are carried over to the RHOAI 3.x Auth CR group model",
+			CheckRemediation: remediationGroups,
+			CheckResources: []resources.ResourceType{
+				resources.OdhDashboardConfig,
+				resources.Auth,
+				resources.OpenShiftGroup,
+			},
+			CheckVersions: check.VersionsUpgrade2xTo3x,
+		},
+	}
+}
+
+// Validate compares the groupsConfig of every OdhDashboardConfig with the Auth CR groups.
+func (c *GroupsCheck) Validate(ctx context.Context, target check.Target) (*result.DiagnosticResult, error) {
+	return validate.Workloads(c, target, resources.OdhDashboardConfig).
+		Run(ctx, c.checkGroups)
+}
+
+// userGroups holds admin and allowed groups, in configuration order.
+type userGroups struct {
+	admin   []string
+	allowed []string
+}
+
+func (c *GroupsCheck) checkGroups(
+	ctx context.Context,
+	req *validate.WorkloadRequest[*unstructured.Unstructured],
+) error {
+	dr := req.Result
+	tv := version.MajorMinorLabel(req.TargetVersion)
+
+	// Always set, so the builder does not report the dashboard configurations as impacted
+	dr.ImpactedObjects = []metav1.PartialObjectMetadata{}
+
+	configured, err := dashboardGroups(req.Items)
+	if err != nil {
+		return err
+	}
+
+	if len(configured.admin) == 0 && len(configured.allowed) == 0 {
+		dr.SetCondition(check.NewCondition(
+			check.ConditionTypeCompatible,
+			metav1.ConditionTrue,
+			check.WithReason(check.ReasonNoMigrationRequired),
+			check.WithCatalogMessage(msgNoGroupsConfig, tv),
+		))
+
+		return nil
+	}
+
+	authCR, err := client.GetSingleton(ctx, req.Client, resources.Auth)
+	if err != nil && !apierrors.IsNotFound(err) && !client.IsResourceTypeNotFound(err) {
+		return fmt.Errorf("getting Auth CR: %w", err)
+	}
+
+	var current userGroups
+	if authCR != nil {
+		if current, err = authGroups(authCR); err != nil {
+			return err
+		}
+	}
+
+	var unsupported []string
+
+	missingAdmin := missingGroups(configured.admin, current.admin)
+	missingAdmin = slices.DeleteFunc(missingAdmin, func(g string) bool {
+		if slices.Contains(unsupportedAdminGroupNames, g) {
+			unsupported = append(unsupported, fmt.Sprintf("%q", g))
+
+			return true
+		}
+
+		return false
+	})
+	missingAllowed := missingGroups(configured.allowed, current.allowed)
+
+	expected := userGroups{
+		admin:   append(slices.Clone(current.admin), missingAdmin...),
+		allowed: append(slices.Clone(current.allowed), missingAllowed...),
+	}
+
+	unknown, err := unknownGroups(ctx, req.Client, slices.Concat(expected.admin, expected.allowed))
+	if err != nil {
+		return err
+	}
+
+	var problems []string
+	if len(missingAdmin) > 0 {
+		problems = append(problems, fmt.Sprintf(msgMissingAdminGroups, strings.Join(missingAdmin, ", ")))
+	}
+
+	if len(missingAllowed) > 0 {
+		problems = append(problems, fmt.Sprintf(msgMissingAllowedGroups, strings.Join(missingAllowed, ", ")))
+	}
+
+	if len(unsupported) > 0 {
+		problems = append(problems, fmt.Sprintf(msgUnsupportedAdminGroup, strings.Join(unsupported, ", ")))
+	}
+
+	if authCR == nil && len(missingAdmin)+len(missingAllowed) > 0 {
+		problems = append(problems, msgAuthCRNotFound)
+	}
+
+	if len(unknown) > 0 {
+		problems = append(problems, fmt.Sprintf(msgUnknownGroups, strings.Join(unknown, ", ")))
+	}
+
+	if len(problems) == 0 {
+		dr.SetCondition(check.NewCondition(
+			check.ConditionTypeCompatible,
+			metav1.ConditionTrue,
+			check.WithReason(check.ReasonNoMigrationRequired),
+			check.WithCatalogMessage(msgGroupsClean, tv),
+		))
+
+		return nil
+	}
+
+	dr.SetCondition(check.NewCondition(
+		check.ConditionTypeCompatible,
+		metav1.ConditionFalse,
+		check.WithReason(check.ReasonMigrationPending),
+		check.WithCatalogMessage(msgGroupsManual, tv, strings.Join(problems, "; ")),
+		check.WithImpact(result.ImpactAdvisory),
+		check.WithRemediation(c.CheckRemediation),
+	))
+
+	if authCR == nil || len(missingAdmin)+len(missingAllowed) == 0 {
+		return nil
+	}
+
+	dr.SetImpactedObjects(resources.Auth, []types.NamespacedName{{Name: authCR.GetName()}})
+
+	annotations := make(map[string]string, 2)
+	if len(missingAdmin) > 0 {
+		annotations[AnnotationCheckExpectedAdminGroups] = strings.Join(expected.admin, ",")
+	}
+
+	if len(missingAllowed) > 0 {
+		annotations[AnnotationCheckExpectedAllowedGroups] = strings.Join(expected.allowed, ",")
+	}
+
+	dr.ImpactedObjects[0].Annotations = annotations
+
+	return nil
+}
+
+// Remediations adds the missing groupsConfig groups to the impacted Auth CR.
+func (c *GroupsCheck) Remediations(
+	_ context.Context,
+	_ check.Target,
+	dr *result.DiagnosticResult,
+) ([]check.Remediation, error) {
+	var remediations []check.Remediation
+
+	for _, obj := range dr.ImpactedObjects {
+		if obj.Kind != resources.Auth.Kind {
+			continue
+		}
+
+		spec := map[string]any{}
+
+		for field, annotation := range map[string]string{
+			"adminGroups":   AnnotationCheckExpectedAdminGroups,
+			"allowedGroups": AnnotationCheckExpectedAllowedGroups,
+		} {
+			if groups := obj.Annotations[annotation]; groups != "" {
+				spec[field] = strings.Split(groups, ",")
+			}
+		}
+
+		if len(spec) == 0 {
+			continue
+		}
+
+		patch, err := json.Marshal(map[string]any{"spec": spec})
+		if err != nil {
+			return nil, fmt.Errorf("building Auth CR groups patch: %w", err)
+		}
+
+		remediations = append(remediations, check.Remediation{
+			ResourceType: resources.Auth,
+			Namespace:    obj.Namespace,
+			Name:         obj.Name,
+			PatchType:    types.MergePatchType,
+			Patch:        patch,
+			Description:  "add the Dashboard groupsConfig groups to the Auth CR",
+		})
+	}
+
+	return remediations, nil
+}
+
+// dashboardGroups returns the groups of the groupsConfig of the OdhDashboardConfigs, whose
+// adminGroups and allowedGroups are comma-separated lists.
+func dashboardGroups(configs []*unstructured.Unstructured) (userGroups, error) {
+	var groups userGroups
+
+	for _, cfg := range configs {
+		for field, dst := range map[string]*[]string{"adminGroups": &groups.admin, "allowedGroups": &groups.allowed} {
+			value, _, err := unstructured.NestedString(cfg.Object, "spec", "groupsConfig", field)
+			if err != nil {
+				return groups, fmt.Errorf("reading groupsConfig.%s of OdhDashboardConfig %s/%s: %w",
+					field, cfg.GetNamespace(), cfg.GetName(), err)
+			}
+
+			for g := range strings.SplitSeq(value, ",") {
+				if g = strings.TrimSpace(g); g != "" && !slices.Contains(*dst, g) {
+					*dst = append(*dst, g)
+				}
+			}
+		}
+	}
+
+	return groups, nil
+}
+
+// authGroups returns the adminGroups and allowedGroups of the Auth CR.
+func authGroups(authCR *unstructured.Unstructured) (userGroups, error) {
+	admin, _, err := unstructured.NestedStringSlice(authCR.Object, "spec", "adminGroups")
+	if err != nil {
+		return userGroups{}, fmt.Errorf("reading Auth CR adminGroups: %w", err)
+	}
+
+	allowed, _, err := unstructured.NestedStringSlice(authCR.Object, "spec", "allowedGroups")
+	if err != nil {
+		return userGroups{}, fmt.Errorf("reading Auth CR allowedGroups: %w", err)
+	}
+
+	return userGroups{admin: admin, allowed: allowed}, nil
+}
+
+// missingGroups returns the groups of want not in have, in order.
+func missingGroups(want []string, have []string) []string {
+	var missing []string
+
+	for _, g := range want {
+		if !slices.Contains(have, g) {
+			missing = append(missing, g)
+		}
+	}
+
+	return missing
+}
+
+// unknownGroups returns the groups without an OpenShift Group object, skipping virtual groups.
+// Nothing is reported on clusters without the OpenShift Group API.
+func unknownGroups(ctx context.Context, r client.Reader, groups []string) ([]string, error) {
+	items, err := r.ListMetadata(ctx, resources.OpenShiftGroup)
+	if err != nil {
+		if client.IsResourceTypeNotFound(err) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("listing OpenShift groups: %w", err)
+	}
+
+	existing := sets.New[string]()
+	for _, item := range items {
+		existing.Insert(item.GetName())
+	}
+
+	var unknown []string
+
+	for _, g := range groups {
+		if strings.HasPrefix(g, virtualGroupPrefix) || existing.Has(g) || slices.Contains(unknown, g) {
+			continue
+		}
+
+		unknown = append(unknown, g)
+	}
+
+	return unknown, nil
+}
@@ -0,0 +1,156 @@
+package auth_test
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/testutil"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/services/auth"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+)
+
+//nolint:gochecknoglobals // Test fixture - shared across test functions
+var groupsListKinds = map[schema.GroupVersionResource]string{
+	resources.OdhDashboardConfig.GVR(): resources.OdhDashboardConfig.ListKind(),
+	resources.Auth.GVR():               resources.Auth.ListKind(),
+	resources.OpenShiftGroup.GVR():     resources.OpenShiftGroup.ListKind(),
+}
+
+func newDashboardConfig(adminGroups string, allowedGroups string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{
+		Object: map[string]any{
+			"apiVersion": resources.OdhDashboardConfig.APIVersion(),
+			"kind":       resources.OdhDashboardConfig.Kind,
+			"metadata": map[string]any{
+				"name":      "odh-dashboard-config",
+				"namespace": "redhat-ods-applications",
+			},
+			"spec": map[string]any{
+				"groupsConfig": map[string]any{
+					"adminGroups":   adminGroups,
+					"allowedGroups": allowedGroups,
+				},
+			},
+		},
+	}
+}
+
+func newGroup(name string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{
+		Object: map[string]any{
+			"apiVersion": resources.OpenShiftGroup.APIVersion(),
+			"kind":       resources.OpenShiftGroup.Kind,
+			"metadata":   map[string]any{"name": name},
+		},
+	}
+}
+
+func TestGroupsCheck_Validate_CarriedOver(t *testing.T) {
+	g := NewWithT(t)
+
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds: groupsListKinds,
+		Objects: []*unstructured.Unstructured{
+			newDashboardConfig("rhods-admins", "system:authenticated"),
+			newAuthCR("rhods-admins"),
+			newGroup("rhods-admins"),
+		},
+		CurrentVersion: "2.17.0",
+		TargetVersion:  "3.0.0",
+	})
+
+	dr, err := auth.NewGroupsCheck().Validate(t.Context(), target)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(dr.Status.Conditions).To(HaveLen(1))
+	g.Expect(dr.Status.Conditions[0].Condition).To(MatchFields(IgnoreExtras, Fields{
+		"Type":   Equal(check.ConditionTypeCompatible),
+		"Status": Equal(metav1.ConditionTrue),
+		"Reason": Equal(check.ReasonNoMigrationRequired),
+	}))
+	g.Expect(dr.ImpactedObjects).To(BeEmpty())
+}
+
+func TestGroupsCheck_Validate_MissingGroups(t *testing.T) {
+	g := NewWithT(t)
+
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds: groupsListKinds,
+		Objects: []*unstructured.Unstructured{
+			newDashboardConfig("rhods-admins, ml-admins, system:authenticated", "system:authenticated,data-scientists"),
+			newAuthCR("rhods-admins"),
+			newGroup("rhods-admins"),
+			newGroup("ml-admins"),
+		},
+		CurrentVersion: "2.17.0",
+		TargetVersion:  "3.0.0",
+	})
+
+	chk := auth.NewGroupsCheck()
+
+	dr, err := chk.Validate(t.Context(), target)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(dr.Status.Conditions).To(HaveLen(1))
+	g.Expect(dr.Status.Conditions[0].Condition).To(MatchFields(IgnoreExtras, Fields{
+		"Status": Equal(metav1.ConditionFalse),
+		"Reason": Equal(check.ReasonMigrationPending),
+		"Message": SatisfyAll(
+			ContainSubstring("admin groups ml-admins are missing"),
+			ContainSubstring("allowed groups data-scientists are missing"),
+			ContainSubstring(`include "system:authenticated"`),
+			ContainSubstring("groups data-scientists do not exist"),
+		),
+	}))
+	g.Expect(dr.Status.Conditions[0].Impact).To(Equal(result.ImpactAdvisory))
+
+	g.Expect(dr.ImpactedObjects).To(HaveLen(1))
+	g.Expect(dr.ImpactedObjects[0].Kind).To(Equal(resources.Auth.Kind))
+	g.Expect(dr.ImpactedObjects[0].Annotations).To(And(
+		HaveKeyWithValue(auth.AnnotationCheckExpectedAdminGroups, "rhods-admins,ml-admins"),
+		HaveKeyWithValue(auth.AnnotationCheckExpectedAllowedGroups, "system:authenticated,data-scientists"),
+	))
+
+	remediations, err := chk.Remediations(t.Context(), target, dr)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(remediations).To(HaveLen(1))
+	g.Expect(remediations[0].ResourceType).To(Equal(resources.Auth))
+	g.Expect(remediations[0].Name).To(Equal("auth"))
+	g.Expect(remediations[0].PatchType).To(Equal(types.MergePatchType))
+	g.Expect(string(remediations[0].Patch)).To(MatchJSON(
+		`{"spec":{"adminGroups":["rhods-admins","ml-admins"],"allowedGroups":["system:authenticated","data-scientists"]}}`))
+}
+
+func TestGroupsCheck_Validate_NoAuthCR(t *testing.T) {
+	g := NewWithT(t)
+
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds: groupsListKinds,
+		Objects: []*unstructured.Unstructured{
+			newDashboardConfig("rhods-admins", ""),
+			newGroup("rhods-admins"),
+		},
+		CurrentVersion: "2.17.0",
+		TargetVersion:  "3.0.0",
+	})
+
+	dr, err := auth.NewGroupsCheck().Validate(t.Context(), target)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(dr.Status.Conditions).To(HaveLen(1))
+	g.Expect(dr.Status.Conditions[0].Condition).To(MatchFields(IgnoreExtras, Fields{
+		"Status":  Equal(metav1.ConditionFalse),
+		"Message": ContainSubstring("no Auth CR exists"),
+	}))
+	g.Expect(dr.ImpactedObjects).To(BeEmpty())
+}
@@ -0,0 +1,139 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+	"github.com/opendatahub-io/odh-cli/pkg/util/jq"
+)
+
+// defaultAudience is the Kubernetes API server audience accepted by kube-rbac-proxy in 3.x.
+const defaultAudience = "https://kubernetes.default.svc"
+
+// unsupportedAdminGroupNames lists adminGroups values rejected by the 3.x Auth CR validation.
+//
+//nolint:gochecknoglobals // Read-only lookup table
+var unsupportedAdminGroupNames = []string{"system:authenticated", ""}
+
+// externalIdentityProviders lists AuthConfig authentication methods that delegate to an identity
+// provider outside the cluster and have no kube-rbac-proxy equivalent.
+//
+//nolint:gochecknoglobals // Read-only lookup table
+var externalIdentityProviders = []string{"jwt", "oidc", "oauth2Introspection"}
+
+// authConfigFindings collects AuthConfigs that need manual migration.
+type authConfigFindings struct {
+	externalProviders []types.NamespacedName
+	customAudiences   []types.NamespacedName
+}
+
+// impacted returns the de-duplicated set of flagged AuthConfigs, in discovery order.
+func (f authConfigFindings) impacted() []types.NamespacedName {
+	seen := sets.New[types.NamespacedName]()
+	out := make([]types.NamespacedName, 0, len(f.externalProviders)+len(f.customAudiences))
+
+	for _, n := range slices.Concat(f.externalProviders, f.customAudiences) {
+		if seen.Has(n) {
+			continue
+		}
+
+		seen.Insert(n)
+		out = append(out, n)
+	}
+
+	return out
+}
+
+// customAudiences returns the audiences at expr other than the default Kubernetes audience.
+func customAudiences(obj *unstructured.Unstructured, expr string) ([]string, error) {
+	audiences, err := jq.Query[[]any](obj, expr)
+	if err != nil && !errors.Is(err, jq.ErrNotFound) {
+		return nil, err
+	}
+
+	var custom []string
+
+	for _, a := range audiences {
+		if s, ok := a.(string); ok && s != defaultAudience && !slices.Contains(custom, s) {
+			custom = append(custom, s)
+		}
+	}
+
+	return custom, nil
+}
+
+// unsupportedAdminGroups returns the Auth CR adminGroups entries rejected in 3.x.
+// A missing Auth CR or CRD is not an error: the operator recreates it with defaults.
+func unsupportedAdminGroups(ctx context.Context, r client.Reader) ([]string, error) {
+	authCR, err := client.GetSingleton(ctx, r, resources.Auth)
+
+	switch {
+	case apierrors.IsNotFound(err), client.IsResourceTypeNotFound(err):
+		return nil, nil
+	case err != nil:
+		return nil, fmt.Errorf("getting Auth CR: %w", err)
+	}
+
+	groups, err := jq.Query[[]any](authCR, ".spec.adminGroups")
+	if err != nil && !errors.Is(err, jq.ErrNotFound) {
+		return nil, fmt.Errorf("querying Auth CR adminGroups: %w", err)
+	}
+
+	var unsupported []string
+
+	for _, g := range groups {
+		if s, ok := g.(string); ok && slices.Contains(unsupportedAdminGroupNames, s) {
+			unsupported = append(unsupported, fmt.Sprintf("%q", s))
+		}
+	}
+
+	return unsupported, nil
+}
+
+// inspectAuthConfigs flags AuthConfigs that authenticate through an external identity provider or
+// restrict Kubernetes token reviews to custom audiences.
+func inspectAuthConfigs(ctx context.Context, r client.Reader) (authConfigFindings, error) {
+	var findings authConfigFindings
+
+	authConfigs, err := r.List(ctx, resources.AuthConfig)
+	if err != nil && !client.IsResourceTypeNotFound(err) {
+		return findings, fmt.Errorf("listing AuthConfigs: %w", err)
+	}
+
+	for _, ac := range authConfigs {
+		name := types.NamespacedName{Namespace: ac.GetNamespace(), Name: ac.GetName()}
+
+		methods, err := jq.Query[[]any](ac, `[.spec.authentication // {} | .[] | keys[]]`)
+		if err != nil {
+			return findings, fmt.Errorf("querying authentication methods of AuthConfig %s: %w", name, err)
+		}
+
+		if slices.ContainsFunc(methods, func(m any) bool {
+			s, _ := m.(string)
+
+			return slices.Contains(externalIdentityProviders, s)
+		}) {
+			findings.externalProviders = append(findings.externalProviders, name)
+		}
+
+		audiences, err := customAudiences(ac, `[.spec.authentication // {} | .[] | .kubernetesTokenReview.audiences // [] | .[]]`)
+		if err != nil {
+			return findings, fmt.Errorf("querying token review audiences of AuthConfig %s: %w", name, err)
+		}
+
+		if len(audiences) > 0 {
+			findings.customAudiences = append(findings.customAudiences, name)
+		}
+	}
+
+	return findings, nil
+}
@@ -0,0 +1,171 @@
+package servicemesh
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/opendatahub-io/odh-cli/pkg/constants"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/messages"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+	"github.com/opendatahub-io/odh-cli/pkg/util/version"
+)
+
+const kind = "servicemesh"
+
+const (
+	// labelIstioInjection enables sidecar injection for a namespace.
+	labelIstioInjection = "istio-injection"
+
+	// labelIstioRevision enables sidecar injection for a namespace through a control plane revision.
+	labelIstioRevision = "istio.io/rev"
+
+	// annotationSidecarStatus is set by the injector on every pod that received an istio-proxy sidecar.
+	annotationSidecarStatus = "sidecar.istio.io/status"
+)
+
+//nolint:gochecknoglobals // Message catalog entries
+var (
+	msgNoSidecarLeftovers = messages.New("services.servicemesh.sidecar-leftovers.none", "No Service Mesh sidecar injection leftovers found in data science projects")
+	msgSidecarLeftovers   = messages.New("services.servicemesh.sidecar-leftovers.found", "Found Service Mesh leftovers in %d data science project(s) that will be orphaned when Service Mesh is removed in RHOAI %s: %s")
+)
+
+// SidecarLeftoversCheck lists Service Mesh artifacts in data science project namespaces:
+// sidecar injection labels, ServiceMeshMember resources and pods running an injected sidecar.
+// Service Mesh is no longer used by RHOAI 3.x, so these are left behind after the upgrade.
+type SidecarLeftoversCheck struct {
+	check.BaseCheck
+}
+
+func NewSidecarLeftoversCheck() *SidecarLeftoversCheck {
+	return &SidecarLeftoversCheck{
+		BaseCheck: check.BaseCheck{
+			CheckGroup:       check.GroupService,
+			Kind:             kind,
+			Type:             check.CheckTypeRemoval,
+			CheckID:          "services.servicemesh.sidecar-leftovers",
+			CheckName:        "Services :: ServiceMesh :: Sidecar Injection Leftovers (3.x)",
+			CheckDescription: "Lists sidecar injection labels, ServiceMeshMembers and sidecar-injected pods in data science projects that will be orphaned when Service Mesh is removed in RHOAI 3.x",
+			CheckRemediation: "After upgrading, remove the istio-injection and istio.io/rev labels from data science project namespaces, delete their ServiceMeshMember resources, and restart the listed pods so they run without the istio-proxy sidecar",
+			CheckResources: []resources.ResourceType{
+				resources.Namespace,
+				resources.ServiceMeshMember,
+				resources.Pod,
+			},
+			CheckVersions: check.VersionsUpgrade2xTo3x,
+		},
+	}
+}
+
+// Validate scans every data science project namespace for Service Mesh leftovers.
+func (c *SidecarLeftoversCheck) Validate(
+	ctx context.Context,
+	target check.Target,
+) (*result.DiagnosticResult, error) {
+	dr := c.NewResult()
+	tv := version.MajorMinorLabel(target.TargetVersion)
+
+	if target.TargetVersion != nil {
+		dr.Annotations[check.AnnotationCheckTargetVersion] = target.TargetVersion.String()
+	}
+
+	projects, err := target.Client.ListMetadata(ctx, resources.Namespace,
+		client.WithLabelSelector(constants.LabelDashboardProject+"=true"))
+	if err != nil {
+		return nil, fmt.Errorf("listing data science projects: %w", err)
+	}
+
+	var (
+		labeled  []types.NamespacedName
+		members  []types.NamespacedName
+		pods     []types.NamespacedName
+		affected = sets.New[string]()
+	)
+
+	for _, ns := range projects {
+		labels := ns.GetLabels()
+		if labels[labelIstioInjection] == "enabled" || labels[labelIstioRevision] != "" {
+			labeled = append(labeled, types.NamespacedName{Name: ns.GetName()})
+			affected.Insert(ns.GetName())
+		}
+
+		smms, err := target.Client.ListMetadata(ctx, resources.ServiceMeshMember, client.WithNamespace(ns.GetName()))
+		if err != nil && !client.IsResourceTypeNotFound(err) {
+			return nil, fmt.Errorf("listing ServiceMeshMembers in %s: %w", ns.GetName(), err)
+		}
+
+		for _, smm := range smms {
+			members = append(members, types.NamespacedName{Namespace: smm.GetNamespace(), Name: smm.GetName()})
+			affected.Insert(ns.GetName())
+		}
+
+		nsPods, err := target.Client.ListMetadata(ctx, resources.Pod, client.WithNamespace(ns.GetName()))
+		if err != nil {
+			return nil, fmt.Errorf("listing pods in %s: %w", ns.GetName(), err)
+		}
+
+		for _, pod := range nsPods {
+			if _, ok := pod.GetAnnotations()[annotationSidecarStatus]; ok {
+				pods = append(pods, types.NamespacedName{Namespace: pod.GetNamespace(), Name: pod.GetName()})
+				affected.Insert(ns.GetName())
+			}
+		}
+	}
+
+	dr.Annotations[check.AnnotationImpactedWorkloadCount] = strconv.Itoa(len(labeled) + len(members) + len(pods))
+
+	if affected.Len() == 0 {
+		dr.SetCondition(check.NewCondition(
+			check.ConditionTypeCompatible,
+			metav1.ConditionTrue,
+			check.WithReason(check.ReasonVersionCompatible),
+			check.WithCatalogMessage(msgNoSidecarLeftovers),
+		))
+
+		return dr, nil
+	}
+
+	var found []string
+	if len(labeled) > 0 {
+		found = append(found, fmt.Sprintf("%d namespace(s) with sidecar injection enabled", len(labeled)))
+	}
+
+	if len(members) > 0 {
+		found = append(found, fmt.Sprintf("%d ServiceMeshMember(s)", len(members)))
+	}
+
+	if len(pods) > 0 {
+		found = append(found, fmt.Sprintf("%d sidecar-injected pod(s)", len(pods)))
+	}
+
+	dr.SetCondition(check.NewCondition(
+		check.ConditionTypeCompatible,
+		metav1.ConditionFalse,
+		check.WithReason(check.ReasonFeatureRemoved),
+		check.WithCatalogMessage(msgSidecarLeftovers, affected.Len(), tv, strings.Join(found, ", ")),
+		check.WithImpact(result.ImpactAdvisory),
+		check.WithRemediation(c.CheckRemediation),
+	))
+
+	if len(labeled) > 0 {
+		dr.AddImpactedObjects(resources.Namespace, labeled)
+	}
+
+	if len(members) > 0 {
+		dr.AddImpactedObjects(resources.ServiceMeshMember, members)
+	}
+
+	if len(pods) > 0 {
+		dr.AddImpactedObjects(resources.Pod, pods)
+	}
+
+	return dr, nil
+}
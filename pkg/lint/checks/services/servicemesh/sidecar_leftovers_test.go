@@ -0,0 +1,138 @@
+package servicemesh_test
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/testutil"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/services/servicemesh"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+)
+
+//nolint:gochecknoglobals // Test fixture - shared across test functions
+var listKinds = map[schema.GroupVersionResource]string{
+	resources.Namespace.GVR():         resources.Namespace.ListKind(),
+	resources.ServiceMeshMember.GVR(): resources.ServiceMeshMember.ListKind(),
+	resources.Pod.GVR():               resources.Pod.ListKind(),
+}
+
+func newObject(rt resources.ResourceType, namespace string, name string) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(rt.GVK())
+	obj.SetNamespace(namespace)
+	obj.SetName(name)
+
+	return obj
+}
+
+func newProject(name string, labels map[string]string) *unstructured.Unstructured {
+	ns := newObject(resources.Namespace, "", name)
+	ns.SetLabels(labels)
+
+	return ns
+}
+
+func newInjectedPod(namespace string, name string) *unstructured.Unstructured {
+	pod := newObject(resources.Pod, namespace, name)
+	pod.SetAnnotations(map[string]string{"sidecar.istio.io/status": `{"containers":["istio-proxy"]}`})
+
+	return pod
+}
+
+func TestSidecarLeftoversCheck_CanApply(t *testing.T) {
+	g := NewWithT(t)
+
+	chk := servicemesh.NewSidecarLeftoversCheck()
+
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds:      listKinds,
+		CurrentVersion: "2.17.0",
+		TargetVersion:  "3.0.0",
+	})
+	canApply, err := chk.CanApply(t.Context(), target)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(canApply).To(BeTrue())
+
+	target = testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds:      listKinds,
+		CurrentVersion: "3.0.0",
+		TargetVersion:  "3.1.0",
+	})
+	canApply, err = chk.CanApply(t.Context(), target)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(canApply).To(BeFalse())
+}
+
+func TestSidecarLeftoversCheck_NoLeftovers(t *testing.T) {
+	g := NewWithT(t)
+
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds: listKinds,
+		Objects: []*unstructured.Unstructured{
+			newProject("project", map[string]string{"opendatahub.io/dashboard": "true"}),
+			newObject(resources.Pod, "project", "plain-pod"),
+			// Mesh artifacts outside data science projects are not reported.
+			newProject("istio-app", map[string]string{"istio-injection": "enabled"}),
+			newInjectedPod("istio-app", "injected"),
+		},
+		CurrentVersion: "2.17.0",
+		TargetVersion:  "3.0.0",
+	})
+
+	dr, err := servicemesh.NewSidecarLeftoversCheck().Validate(t.Context(), target)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(dr.Status.Conditions).To(HaveLen(1))
+	g.Expect(dr.Status.Conditions[0].Condition).To(MatchFields(IgnoreExtras, Fields{
+		"Type":   Equal(check.ConditionTypeCompatible),
+		"Status": Equal(metav1.ConditionTrue),
+		"Reason": Equal(check.ReasonVersionCompatible),
+	}))
+	g.Expect(dr.ImpactedObjects).To(BeEmpty())
+}
+
+func TestSidecarLeftoversCheck_LeftoversFound(t *testing.T) {
+	g := NewWithT(t)
+
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds: listKinds,
+		Objects: []*unstructured.Unstructured{
+			newProject("labeled", map[string]string{
+				"opendatahub.io/dashboard": "true",
+				"istio-injection":          "enabled",
+			}),
+			newProject("member", map[string]string{"opendatahub.io/dashboard": "true"}),
+			newObject(resources.ServiceMeshMember, "member", "default"),
+			newInjectedPod("member", "model-predictor-abc"),
+		},
+		CurrentVersion: "2.17.0",
+		TargetVersion:  "3.0.0",
+	})
+
+	dr, err := servicemesh.NewSidecarLeftoversCheck().Validate(t.Context(), target)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(dr.Status.Conditions).To(HaveLen(1))
+	g.Expect(dr.Status.Conditions[0].Condition).To(MatchFields(IgnoreExtras, Fields{
+		"Status": Equal(metav1.ConditionFalse),
+		"Reason": Equal(check.ReasonFeatureRemoved),
+		"Message": And(
+			ContainSubstring("2 data science project(s)"),
+			ContainSubstring("1 namespace(s) with sidecar injection enabled, 1 ServiceMeshMember(s), 1 sidecar-injected pod(s)"),
+		),
+	}))
+	g.Expect(dr.Status.Conditions[0].Impact).To(Equal(result.ImpactAdvisory))
+	g.Expect(dr.Annotations).To(HaveKeyWithValue(check.AnnotationImpactedWorkloadCount, "3"))
+	g.Expect(dr.ImpactedObjects).To(HaveLen(3))
+	g.Expect(dr.ImpactedObjects[0].Kind).To(Equal(resources.Namespace.Kind))
+	g.Expect(dr.ImpactedObjects[1].Kind).To(Equal(resources.ServiceMeshMember.Kind))
+	g.Expect(dr.ImpactedObjects[2].Name).To(Equal("model-predictor-abc"))
+}
@@ -10,17 +10,24 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/types"
 
-	"github.com/opendatahub-io/odh-cli/pkg/constants"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/messages"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check/validate"
 	"github.com/opendatahub-io/odh-cli/pkg/resources"
 	"github.com/opendatahub-io/odh-cli/pkg/util/client"
-	"github.com/opendatahub-io/odh-cli/pkg/util/components"
 	"github.com/opendatahub-io/odh-cli/pkg/util/inspect"
 	"github.com/opendatahub-io/odh-cli/pkg/util/version"
 )
 
+// Messages of the InstructLab removal check.
+//
+//nolint:gochecknoglobals // Message catalog entries
+var (
+	msgInstructLabFound = messages.New("workloads.datasciencepipelines.instructlab-removal.found", "Found %d DataSciencePipelinesApplication(s) with deprecated '.spec.apiServer.managedPipelines.instructLab' field - InstructLab feature was removed in RHOAI %s")
+	msgNoInstructLab    = messages.New("workloads.datasciencepipelines.instructlab-removal.none", "No DataSciencePipelinesApplications found using deprecated 'managedPipelines.instructLab' field - ready for RHOAI %s upgrade")
+)
+
 const (
 	kind                        = "datasciencepipelines"
 	checkTypeInstructLabRemoval = "instructlab-removal"
@@ -40,29 +47,18 @@ func NewInstructLabRemovalCheck() *InstructLabRemovalCheck {
 			CheckName:        "Workloads :: DataSciencePipelines :: InstructLab ManagedPipelines Removal (3.x)",
 			CheckDescription: "Validates that DSPA objects do not use the removed InstructLab managedPipelines field before upgrading to RHOAI 3.x",
 			CheckRemediation: "Remove the '.spec.apiServer.managedPipelines.instructLab' field from affected DSPA objects before upgrading",
+			CheckResources:   []resources.ResourceType{resources.DataSciencePipelinesApplicationV1, resources.DataSciencePipelinesApplicationV1Alpha1},
+			CheckVersions:    check.VersionsUpgrade2xTo3x,
+			CheckComponents:  []string{kind},
+			CheckDependsOn:   []string{check.CheckIDDSCReadiness},
 		},
 	}
 }
 
-// CanApply returns whether this check should run for the given target.
-// This check only applies when upgrading FROM 2.x TO 3.x and DataSciencePipelines is Managed.
-func (c *InstructLabRemovalCheck) CanApply(ctx context.Context, target check.Target) (bool, error) {
-	if !version.IsUpgradeFrom2xTo3x(target.CurrentVersion, target.TargetVersion) {
-		return false, nil
-	}
-
-	dsc, err := client.GetDataScienceCluster(ctx, target.Client)
-	if err != nil {
-		return false, fmt.Errorf("getting DataScienceCluster: %w", err)
-	}
-
-	return components.HasManagementState(dsc, kind, constants.ManagementStateManaged), nil
-}
-
 func (c *InstructLabRemovalCheck) Validate(ctx context.Context, target check.Target) (*result.DiagnosticResult, error) {
 	return validate.Component(c, target).
 		Run(ctx, func(ctx context.Context, req *validate.ComponentRequest) error {
-			dspas, usedResourceType, err := c.listDSPAs(ctx, req.Client)
+			dspas, usedResourceType, err := listDSPAs(ctx, req.Client)
 			if err != nil {
 				return err
 			}
@@ -96,7 +92,7 @@ func (c *InstructLabRemovalCheck) Validate(ctx context.Context, target check.Tar
 					check.ConditionTypeCompatible,
 					metav1.ConditionFalse,
 					check.WithReason(check.ReasonFeatureRemoved),
-					check.WithMessage("Found %d DataSciencePipelinesApplication(s) with deprecated '.spec.apiServer.managedPipelines.instructLab' field - InstructLab feature was removed in RHOAI %s", len(impactedDSPAs), tv),
+					check.WithCatalogMessage(msgInstructLabFound, len(impactedDSPAs), tv),
 					check.WithImpact(result.ImpactAdvisory),
 					check.WithRemediation(c.CheckRemediation),
 				))
@@ -110,7 +106,7 @@ func (c *InstructLabRemovalCheck) Validate(ctx context.Context, target check.Tar
 				check.ConditionTypeCompatible,
 				metav1.ConditionTrue,
 				check.WithReason(check.ReasonVersionCompatible),
-				check.WithMessage("No DataSciencePipelinesApplications found using deprecated 'managedPipelines.instructLab' field - ready for RHOAI %s upgrade", tv),
+				check.WithCatalogMessage(msgNoInstructLab, tv),
 			))
 
 			return nil
@@ -119,7 +115,7 @@ func (c *InstructLabRemovalCheck) Validate(ctx context.Context, target check.Tar
 
 // listDSPAs attempts to list DSPAs using v1 first, falling back to v1alpha1 if v1 is not available.
 // Returns the list of DSPAs and the ResourceType that was successfully used.
-func (c *InstructLabRemovalCheck) listDSPAs(
+func listDSPAs(
 	ctx context.Context,
 	r client.Reader,
 ) ([]*unstructured.Unstructured, resources.ResourceType, error) {
@@ -8,12 +8,23 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/messages"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
 	"github.com/opendatahub-io/odh-cli/pkg/resources"
 	"github.com/opendatahub-io/odh-cli/pkg/util/jq"
 	"github.com/opendatahub-io/odh-cli/pkg/util/version"
 )
 
+// Messages of the DataSciencePipelinesApplication stored version removal check.
+//
+//nolint:gochecknoglobals // Message catalog entries
+var (
+	msgStoredVersionFound    = messages.New("workloads.datasciencepipelines.stored-version-removal.found", "Some DataSciencePipelinesApplication resources still use the deprecated %s API version which will be removed in RHOAI %s")
+	msgStoredVersionNotFound = messages.New("workloads.datasciencepipelines.stored-version-removal.none", "No DataSciencePipelinesApplication resources using deprecated %s API version - ready for RHOAI %s upgrade")
+	msgCRDNotFound           = messages.New("workloads.datasciencepipelines.stored-version-removal.crd-not-found", "DataSciencePipelinesApplication CRD not found - DataSciencePipelines may not be installed")
+	msgCRDForbidden          = messages.New("workloads.datasciencepipelines.stored-version-removal.crd-forbidden", "Unable to access CRD %s - insufficient permissions")
+)
+
 const (
 	checkTypeStoredVersionRemoval = "stored-version-removal"
 
@@ -22,10 +33,6 @@ const (
 
 	// The deprecated stored version that will be removed in 3.x.
 	deprecatedStoredVersion = "v1alpha1"
-
-	msgStoredVersionFound    = "Some DataSciencePipelinesApplication resources still use the deprecated %s API version which will be removed in RHOAI %s"
-	msgStoredVersionNotFound = "No DataSciencePipelinesApplication resources using deprecated %s API version - ready for RHOAI %s upgrade"
-	msgCRDNotFound           = "DataSciencePipelinesApplication CRD not found - DataSciencePipelines may not be installed"
 )
 
 // StoredVersionRemovalCheck validates that the DataSciencePipelinesApplication CRD
@@ -43,19 +50,16 @@ func NewStoredVersionRemovalCheck() *StoredVersionRemovalCheck {
 			Kind:             kind,
 			Type:             checkTypeStoredVersionRemoval,
 			CheckID:          "workloads.datasciencepipelines.stored-version-removal",
+			CheckTags:        []string{check.TagFast, check.TagBlockingOnly},
 			CheckName:        "Workloads :: DataSciencePipelines :: v1alpha1 StoredVersion Removal (3.x)",
 			CheckDescription: "Validates that the DataSciencePipelinesApplication CRD does not have v1alpha1 in status.storedVersions before upgrading to RHOAI 3.x",
 			CheckRemediation: "Migrate all DataSciencePipelinesApplication resources from v1alpha1 to v1",
+			CheckResources:   []resources.ResourceType{resources.CustomResourceDefinition},
+			CheckVersions:    check.VersionsUpgrade2xTo3x,
 		},
 	}
 }
 
-// CanApply returns whether this check should run for the given target.
-// This check applies when upgrading FROM 2.x TO 3.x.
-func (c *StoredVersionRemovalCheck) CanApply(_ context.Context, target check.Target) (bool, error) {
-	return version.IsUpgradeFrom2xTo3x(target.CurrentVersion, target.TargetVersion), nil
-}
-
 // Validate checks the DSPA CRD status.storedVersions for the deprecated v1alpha1 version.
 func (c *StoredVersionRemovalCheck) Validate(
 	ctx context.Context,
@@ -75,7 +79,7 @@ func (c *StoredVersionRemovalCheck) Validate(
 				check.ConditionTypeCompatible,
 				metav1.ConditionTrue,
 				check.WithReason(check.ReasonResourceNotFound),
-				check.WithMessage(msgCRDNotFound),
+				check.WithCatalogMessage(msgCRDNotFound),
 			))
 
 			return dr, nil
@@ -90,7 +94,7 @@ func (c *StoredVersionRemovalCheck) Validate(
 			check.ConditionTypeCompatible,
 			metav1.ConditionUnknown,
 			check.WithReason(check.ReasonAPIAccessDenied),
-			check.WithMessage("Unable to access CRD %s - insufficient permissions", dspaCRDName),
+			check.WithCatalogMessage(msgCRDForbidden, dspaCRDName),
 		))
 
 		return dr, nil
@@ -106,7 +110,7 @@ func (c *StoredVersionRemovalCheck) Validate(
 			check.ConditionTypeCompatible,
 			metav1.ConditionFalse,
 			check.WithReason(check.ReasonVersionIncompatible),
-			check.WithMessage(msgStoredVersionFound, deprecatedStoredVersion, tv),
+			check.WithCatalogMessage(msgStoredVersionFound, deprecatedStoredVersion, tv),
 			check.WithImpact(result.ImpactBlocking),
 			check.WithRemediation(c.CheckRemediation),
 		))
@@ -118,7 +122,7 @@ func (c *StoredVersionRemovalCheck) Validate(
 		check.ConditionTypeCompatible,
 		metav1.ConditionTrue,
 		check.WithReason(check.ReasonVersionCompatible),
-		check.WithMessage(msgStoredVersionNotFound, deprecatedStoredVersion, tv),
+		check.WithCatalogMessage(msgStoredVersionNotFound, deprecatedStoredVersion, tv),
 	))
 
 	return dr, nil
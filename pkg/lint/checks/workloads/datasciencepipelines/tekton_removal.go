@@ -0,0 +1,154 @@
+package datasciencepipelines
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/messages"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/validate"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/inspect"
+	"github.com/opendatahub-io/odh-cli/pkg/util/jq"
+	"github.com/opendatahub-io/odh-cli/pkg/util/version"
+)
+
+// Messages of the Tekton pipelines removal check.
+//
+//nolint:gochecknoglobals // Message catalog entries
+var (
+	msgTektonFound = messages.New("workloads.datasciencepipelines.tekton-removal.found", "Found %d DataSciencePipelinesApplication(s) configured for v1 (Tekton) pipelines - only v2 (Argo) pipelines are supported in RHOAI %s")
+	msgNoTekton    = messages.New("workloads.datasciencepipelines.tekton-removal.none", "No DataSciencePipelinesApplications configured for v1 (Tekton) pipelines - ready for RHOAI %s upgrade")
+)
+
+const (
+	checkTypeTektonRemoval = "tekton-removal"
+
+	// dspVersionV1 is the Tekton-based DSP version, removed in 3.x.
+	dspVersionV1 = "v1"
+)
+
+// tektonFields are the v1 (Tekton) apiServer fields that have no effect on v2 (Argo) pipelines.
+//
+//nolint:gochecknoglobals // read-only list of JQ expressions
+var tektonFields = []string{
+	".spec.apiServer.artifactImage",
+	".spec.apiServer.cacheImage",
+	".spec.apiServer.moveResultsImage",
+	".spec.apiServer.stripEOF",
+	".spec.apiServer.terminateStatus",
+	".spec.apiServer.trackArtifacts",
+	".spec.apiServer.archiveLogs",
+	".spec.apiServer.injectDefaultScript",
+	".spec.apiServer.artifactScriptConfigMap",
+	".spec.apiServer.autoUpdatePipelineDefaultVersion",
+}
+
+// TektonRemovalCheck detects DataSciencePipelinesApplications still configured for the
+// v1 (Tekton) pipelines engine, either through spec.dspVersion or Tekton-specific fields.
+// Only v2 (Argo) pipelines are supported in RHOAI 3.x.
+type TektonRemovalCheck struct {
+	check.BaseCheck
+}
+
+// NewTektonRemovalCheck creates a new TektonRemovalCheck.
+func NewTektonRemovalCheck() *TektonRemovalCheck {
+	return &TektonRemovalCheck{
+		BaseCheck: check.BaseCheck{
+			CheckGroup:       check.GroupWorkload,
+			Kind:             kind,
+			Type:             checkTypeTektonRemoval,
+			CheckID:          "workloads.datasciencepipelines.tekton-removal",
+			CheckTags:        []string{check.TagBlockingOnly},
+			CheckName:        "Workloads :: DataSciencePipelines :: v1 (Tekton) Pipelines Removal (3.x)",
+			CheckDescription: "Validates that DSPA objects are not configured for v1 (Tekton) pipelines, which are removed in RHOAI 3.x",
+			CheckRemediation: "Migrate pipelines to v2 (Argo): set '.spec.dspVersion' to 'v2', remove Tekton-specific '.spec.apiServer' fields, and recompile pipeline definitions with the KFP v2 SDK before upgrading",
+			CheckResources:   []resources.ResourceType{resources.DataSciencePipelinesApplicationV1, resources.DataSciencePipelinesApplicationV1Alpha1},
+			CheckVersions:    check.VersionsUpgrade2xTo3x,
+			CheckComponents:  []string{kind},
+			CheckDependsOn:   []string{check.CheckIDDSCReadiness},
+		},
+	}
+}
+
+// Validate lists DSPAs and reports those still configured for Tekton-based pipelines.
+func (c *TektonRemovalCheck) Validate(ctx context.Context, target check.Target) (*result.DiagnosticResult, error) {
+	return validate.Component(c, target).
+		Run(ctx, func(ctx context.Context, req *validate.ComponentRequest) error {
+			dspas, usedResourceType, err := listDSPAs(ctx, req.Client)
+			if err != nil {
+				return err
+			}
+
+			tv := version.MajorMinorLabel(req.TargetVersion)
+			impactedDSPAs := make([]types.NamespacedName, 0)
+
+			for _, dspa := range dspas {
+				usesTekton, err := isTektonConfigured(dspa)
+				if err != nil {
+					return fmt.Errorf("inspecting DSPA %s/%s: %w", dspa.GetNamespace(), dspa.GetName(), err)
+				}
+
+				if !usesTekton {
+					continue
+				}
+
+				impactedDSPAs = append(impactedDSPAs, types.NamespacedName{
+					Namespace: dspa.GetNamespace(),
+					Name:      dspa.GetName(),
+				})
+			}
+
+			req.Result.Annotations[check.AnnotationImpactedWorkloadCount] = strconv.Itoa(len(impactedDSPAs))
+
+			if len(impactedDSPAs) > 0 {
+				req.Result.SetCondition(check.NewCondition(
+					check.ConditionTypeCompatible,
+					metav1.ConditionFalse,
+					check.WithReason(check.ReasonFeatureRemoved),
+					check.WithCatalogMessage(msgTektonFound, len(impactedDSPAs), tv),
+					check.WithImpact(result.ImpactBlocking),
+					check.WithRemediation(c.CheckRemediation),
+				))
+
+				req.Result.SetImpactedObjects(usedResourceType, impactedDSPAs)
+
+				return nil
+			}
+
+			req.Result.SetCondition(check.NewCondition(
+				check.ConditionTypeCompatible,
+				metav1.ConditionTrue,
+				check.WithReason(check.ReasonVersionCompatible),
+				check.WithCatalogMessage(msgNoTekton, tv),
+			))
+
+			return nil
+		})
+}
+
+// isTektonConfigured reports whether the DSPA selects dspVersion v1 or sets any Tekton-specific field.
+func isTektonConfigured(dspa *unstructured.Unstructured) (bool, error) {
+	dspVersion, err := jq.Query[string](dspa, ".spec.dspVersion")
+	if err != nil && !errors.Is(err, jq.ErrNotFound) {
+		return false, fmt.Errorf("querying spec.dspVersion: %w", err)
+	}
+
+	if dspVersion == dspVersionV1 {
+		return true, nil
+	}
+
+	found, err := inspect.HasFields(dspa, tektonFields...)
+	if err != nil {
+		return false, err
+	}
+
+	return len(found) > 0, nil
+}
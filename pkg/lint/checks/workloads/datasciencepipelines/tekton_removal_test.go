@@ -0,0 +1,121 @@
+package datasciencepipelines_test
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/testutil"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/workloads/datasciencepipelines"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+)
+
+func newDSPAWithSpec(name string, namespace string, spec map[string]any) *unstructured.Unstructured {
+	return &unstructured.Unstructured{
+		Object: map[string]any{
+			"apiVersion": resources.DataSciencePipelinesApplicationV1.APIVersion(),
+			"kind":       resources.DataSciencePipelinesApplicationV1.Kind,
+			"metadata": map[string]any{
+				"name":      name,
+				"namespace": namespace,
+			},
+			"spec": spec,
+		},
+	}
+}
+
+func TestTektonRemovalCheck_CanApply(t *testing.T) {
+	g := NewWithT(t)
+
+	chk := datasciencepipelines.NewTektonRemovalCheck()
+	dsc := testutil.NewDSC(map[string]string{"datasciencepipelines": "Managed"})
+
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds:      instructLabListKinds,
+		Objects:        []*unstructured.Unstructured{dsc},
+		CurrentVersion: "2.17.0",
+		TargetVersion:  "3.0.0",
+	})
+	canApply, err := chk.CanApply(t.Context(), target)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(canApply).To(BeTrue())
+
+	target = testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds:      instructLabListKinds,
+		Objects:        []*unstructured.Unstructured{dsc},
+		CurrentVersion: "3.0.0",
+		TargetVersion:  "3.1.0",
+	})
+	canApply, err = chk.CanApply(t.Context(), target)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(canApply).To(BeFalse())
+}
+
+func TestTektonRemovalCheck_V2DSPA(t *testing.T) {
+	g := NewWithT(t)
+
+	dsc := testutil.NewDSC(map[string]string{"datasciencepipelines": "Managed"})
+	dspa := newDSPAWithSpec("v2-dspa", "test-ns", map[string]any{"dspVersion": "v2"})
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds:      instructLabListKinds,
+		Objects:        []*unstructured.Unstructured{dsc, dspa},
+		CurrentVersion: "2.17.0",
+		TargetVersion:  "3.0.0",
+	})
+
+	dr, err := datasciencepipelines.NewTektonRemovalCheck().Validate(t.Context(), target)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(dr.Status.Conditions).To(HaveLen(1))
+	g.Expect(dr.Status.Conditions[0].Condition).To(MatchFields(IgnoreExtras, Fields{
+		"Type":   Equal(check.ConditionTypeCompatible),
+		"Status": Equal(metav1.ConditionTrue),
+		"Reason": Equal(check.ReasonVersionCompatible),
+	}))
+	g.Expect(dr.Annotations).To(HaveKeyWithValue(check.AnnotationImpactedWorkloadCount, "0"))
+	g.Expect(dr.ImpactedObjects).To(BeEmpty())
+}
+
+func TestTektonRemovalCheck_TektonDSPAs(t *testing.T) {
+	g := NewWithT(t)
+
+	dsc := testutil.NewDSC(map[string]string{"datasciencepipelines": "Managed"})
+	v1DSPA := newDSPAWithSpec("v1-dspa", "ns1", map[string]any{"dspVersion": "v1"})
+	tektonFieldsDSPA := newDSPAWithSpec("tekton-fields", "ns2", map[string]any{
+		"dspVersion": "v2",
+		"apiServer": map[string]any{
+			"stripEOF":       true,
+			"archiveLogs":    false,
+			"trackArtifacts": true,
+		},
+	})
+	cleanDSPA := newDSPAWithSpec("clean", "ns3", map[string]any{"dspVersion": "v2"})
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds:      instructLabListKinds,
+		Objects:        []*unstructured.Unstructured{dsc, v1DSPA, tektonFieldsDSPA, cleanDSPA},
+		CurrentVersion: "2.17.0",
+		TargetVersion:  "3.0.0",
+	})
+
+	dr, err := datasciencepipelines.NewTektonRemovalCheck().Validate(t.Context(), target)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(dr.Status.Conditions).To(HaveLen(1))
+	g.Expect(dr.Status.Conditions[0].Condition).To(MatchFields(IgnoreExtras, Fields{
+		"Type":    Equal(check.ConditionTypeCompatible),
+		"Status":  Equal(metav1.ConditionFalse),
+		"Reason":  Equal(check.ReasonFeatureRemoved),
+		"Message": And(ContainSubstring("Found 2"), ContainSubstring("Tekton")),
+	}))
+	g.Expect(dr.Status.Conditions[0].Impact).To(Equal(result.ImpactBlocking))
+	g.Expect(dr.Annotations).To(HaveKeyWithValue(check.AnnotationImpactedWorkloadCount, "2"))
+	g.Expect(dr.ImpactedObjects).To(HaveLen(2))
+	g.Expect(dr.ImpactedObjects[0].Name).To(Equal("v1-dspa"))
+	g.Expect(dr.ImpactedObjects[1].Name).To(Equal("tekton-fields"))
+}
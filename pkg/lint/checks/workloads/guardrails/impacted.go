@@ -7,13 +7,10 @@ import (
 
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
-	"github.com/opendatahub-io/odh-cli/pkg/constants"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
 	"github.com/opendatahub-io/odh-cli/pkg/resources"
 	"github.com/opendatahub-io/odh-cli/pkg/util/client"
-	"github.com/opendatahub-io/odh-cli/pkg/util/components"
-	"github.com/opendatahub-io/odh-cli/pkg/util/version"
 )
 
 const (
@@ -44,25 +41,14 @@ func NewImpactedWorkloadsCheck() *ImpactedWorkloadsCheck {
 			CheckName:        "Workloads :: Guardrails :: Impacted Workloads (3.x)",
 			CheckDescription: "Detects GuardrailsOrchestrator CRs with configuration that will be impacted in RHOAI 3.x upgrade",
 			CheckRemediation: "Review and fix GuardrailsOrchestrator configuration before upgrading to ensure correct operation in RHOAI 3.x",
+			CheckResources:   []resources.ResourceType{resources.GuardrailsOrchestrator, resources.ConfigMap},
+			CheckVersions:    check.VersionsUpgrade2xTo3x,
+			CheckComponents:  []string{"trustyai"},
+			CheckDependsOn:   []string{check.CheckIDDSCReadiness},
 		},
 	}
 }
 
-// CanApply returns whether this check should run for the given target.
-// Only applies when upgrading from 2.x to 3.x and TrustyAI is Managed.
-func (c *ImpactedWorkloadsCheck) CanApply(ctx context.Context, target check.Target) (bool, error) {
-	if !version.IsUpgradeFrom2xTo3x(target.CurrentVersion, target.TargetVersion) {
-		return false, nil
-	}
-
-	dsc, err := client.GetDataScienceCluster(ctx, target.Client)
-	if err != nil {
-		return false, fmt.Errorf("getting DataScienceCluster: %w", err)
-	}
-
-	return components.HasManagementState(dsc, "trustyai", constants.ManagementStateManaged), nil
-}
-
 // Validate executes the check against the provided target.
 func (c *ImpactedWorkloadsCheck) Validate(
 	ctx context.Context,
@@ -12,12 +12,22 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/messages"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
 	"github.com/opendatahub-io/odh-cli/pkg/resources"
 	"github.com/opendatahub-io/odh-cli/pkg/util/client"
 	"github.com/opendatahub-io/odh-cli/pkg/util/jq"
 )
 
+// Messages of the GuardrailsOrchestrator configuration check.
+//
+//nolint:gochecknoglobals // Message catalog entries
+var (
+	msgNoOrchestrators            = messages.New("workloads.guardrails.impacted-workloads.none", "No GuardrailsOrchestrators found")
+	msgOrchestratorsValid         = messages.New("workloads.guardrails.impacted-workloads.valid", "All %d GuardrailsOrchestrator(s) configured correctly")
+	msgOrchestratorsMisconfigured = messages.New("workloads.guardrails.impacted-workloads.misconfigured", "Found %d misconfigured GuardrailsOrchestrator(s)")
+)
+
 const (
 	annotationIssues = "guardrails.opendatahub.io/issues"
 )
@@ -244,7 +254,7 @@ func (c *ImpactedWorkloadsCheck) newConfigurationCondition(
 			ConditionTypeConfigurationValid,
 			metav1.ConditionTrue,
 			check.WithReason(check.ReasonVersionCompatible),
-			check.WithMessage("No GuardrailsOrchestrators found"),
+			check.WithCatalogMessage(msgNoOrchestrators),
 		)
 	}
 
@@ -253,7 +263,7 @@ func (c *ImpactedWorkloadsCheck) newConfigurationCondition(
 			ConditionTypeConfigurationValid,
 			metav1.ConditionTrue,
 			check.WithReason(check.ReasonConfigurationValid),
-			check.WithMessage("All %d GuardrailsOrchestrator(s) configured correctly", total),
+			check.WithCatalogMessage(msgOrchestratorsValid, total),
 		)
 	}
 
@@ -261,7 +271,7 @@ func (c *ImpactedWorkloadsCheck) newConfigurationCondition(
 		ConditionTypeConfigurationValid,
 		metav1.ConditionFalse,
 		check.WithReason(check.ReasonConfigurationInvalid),
-		check.WithMessage("Found %d misconfigured GuardrailsOrchestrator(s)", impacted),
+		check.WithCatalogMessage(msgOrchestratorsMisconfigured, impacted),
 		check.WithImpact(result.ImpactAdvisory),
 		check.WithRemediation(c.CheckRemediation),
 	)
@@ -2,16 +2,11 @@ package guardrails
 
 import (
 	"context"
-	"fmt"
 
-	"github.com/opendatahub-io/odh-cli/pkg/constants"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check/validate"
 	"github.com/opendatahub-io/odh-cli/pkg/resources"
-	"github.com/opendatahub-io/odh-cli/pkg/util/client"
-	"github.com/opendatahub-io/odh-cli/pkg/util/components"
-	"github.com/opendatahub-io/odh-cli/pkg/util/version"
 )
 
 const (
@@ -32,25 +27,14 @@ func NewOtelMigrationCheck() *OtelMigrationCheck {
 			CheckID:          "workloads.guardrails.otel-config-migration",
 			CheckName:        "Workloads :: Guardrails :: OTEL Config Migration (3.x)",
 			CheckDescription: "Detects GuardrailsOrchestrator CRs using deprecated otelExporter configuration fields that need migration",
+			CheckResources:   []resources.ResourceType{resources.GuardrailsOrchestrator},
+			CheckVersions:    check.VersionsUpgrade2xTo3x,
+			CheckComponents:  []string{"trustyai"},
+			CheckDependsOn:   []string{check.CheckIDDSCReadiness},
 		},
 	}
 }
 
-// CanApply returns whether this check should run for the given target.
-// Only applies when upgrading from 2.x to 3.x and TrustyAI is Managed.
-func (c *OtelMigrationCheck) CanApply(ctx context.Context, target check.Target) (bool, error) {
-	if !version.IsUpgradeFrom2xTo3x(target.CurrentVersion, target.TargetVersion) {
-		return false, nil
-	}
-
-	dsc, err := client.GetDataScienceCluster(ctx, target.Client)
-	if err != nil {
-		return false, fmt.Errorf("getting DataScienceCluster: %w", err)
-	}
-
-	return components.HasManagementState(dsc, "trustyai", constants.ManagementStateManaged), nil
-}
-
 // Validate executes the check against the provided target.
 func (c *OtelMigrationCheck) Validate(
 	ctx context.Context,
@@ -8,11 +8,20 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/messages"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check/validate"
 	"github.com/opendatahub-io/odh-cli/pkg/util/jq"
 )
 
+// Messages of the GuardrailsOrchestrator otelExporter migration check.
+//
+//nolint:gochecknoglobals // Message catalog entries
+var (
+	msgNoDeprecatedOtelExporter = messages.New("workloads.guardrails.otel-config-migration.none", "No GuardrailsOrchestrators found using deprecated otelExporter fields")
+	msgDeprecatedOtelExporter   = messages.New("workloads.guardrails.otel-config-migration.found", "Found %d GuardrailsOrchestrator(s) using deprecated otelExporter fields - migrate to new format before upgrading")
+)
+
 // hasDeprecatedOtelFields returns true if the object contains a non-empty otelExporter section.
 // An empty otelExporter ({}) is not considered deprecated since there is no configuration to migrate.
 func hasDeprecatedOtelFields(obj *unstructured.Unstructured) (bool, error) {
@@ -39,7 +48,7 @@ func (c *OtelMigrationCheck) newOtelMigrationCondition(
 			ConditionTypeOtelConfigCompatible,
 			metav1.ConditionTrue,
 			check.WithReason(check.ReasonVersionCompatible),
-			check.WithMessage("No GuardrailsOrchestrators found using deprecated otelExporter fields"),
+			check.WithCatalogMessage(msgNoDeprecatedOtelExporter),
 		)}, nil
 	}
 
@@ -47,7 +56,7 @@ func (c *OtelMigrationCheck) newOtelMigrationCondition(
 		ConditionTypeOtelConfigCompatible,
 		metav1.ConditionFalse,
 		check.WithReason(check.ReasonConfigurationInvalid),
-		check.WithMessage("Found %d GuardrailsOrchestrator(s) using deprecated otelExporter fields - migrate to new format before upgrading", count),
+		check.WithCatalogMessage(msgDeprecatedOtelExporter, count),
 		check.WithImpact(result.ImpactAdvisory),
 	)}, nil
 }
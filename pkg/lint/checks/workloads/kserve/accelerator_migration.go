@@ -9,12 +9,19 @@ import (
 
 	"github.com/opendatahub-io/odh-cli/pkg/constants"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/messages"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check/validate"
 	"github.com/opendatahub-io/odh-cli/pkg/resources"
-	"github.com/opendatahub-io/odh-cli/pkg/util/client"
-	"github.com/opendatahub-io/odh-cli/pkg/util/components"
-	"github.com/opendatahub-io/odh-cli/pkg/util/version"
+)
+
+// Messages of the InferenceService AcceleratorProfile migration check.
+//
+//nolint:gochecknoglobals // Message catalog entries
+var (
+	msgNoAcceleratorProfiles        = messages.New("workloads.kserve.accelerator-migration.none", "No InferenceServices found using deprecated AcceleratorProfiles - no migration needed")
+	msgAcceleratorProfilesMissing   = messages.New("workloads.kserve.accelerator-migration.missing", "Found %d InferenceService(s) referencing deprecated AcceleratorProfiles (%d missing): AcceleratorProfiles and InferenceService references are automatically migrated to HardwareProfiles (infrastructure.opendatahub.io) during upgrade")
+	msgAcceleratorProfilesMigrating = messages.New("workloads.kserve.accelerator-migration.migrating", "Found %d InferenceService(s) using deprecated AcceleratorProfiles: AcceleratorProfiles and InferenceService references are automatically migrated to HardwareProfiles (infrastructure.opendatahub.io) during upgrade")
 )
 
 const ConditionTypeISVCAcceleratorProfileCompatible = "AcceleratorProfileCompatible"
@@ -35,26 +42,14 @@ func NewAcceleratorMigrationCheck() *AcceleratorMigrationCheck {
 			CheckName:        "Workloads :: KServe :: AcceleratorProfile Migration (3.x)",
 			CheckDescription: "Detects InferenceService CRs referencing deprecated AcceleratorProfiles that will be auto-migrated to HardwareProfiles (infrastructure.opendatahub.io) during upgrade",
 			CheckRemediation: "Deprecated AcceleratorProfiles will be automatically migrated to HardwareProfiles (infrastructure.opendatahub.io) during upgrade - no manual action required",
+			CheckResources:   []resources.ResourceType{resources.InferenceService, resources.AcceleratorProfile},
+			CheckVersions:    check.VersionsUpgrade2xTo3x,
+			CheckComponents:  []string{constants.ComponentKServe, "modelmeshserving"},
+			CheckDependsOn:   []string{check.CheckIDDSCReadiness},
 		},
 	}
 }
 
-// CanApply returns whether this check should run for the given target.
-// Only applies when upgrading from 2.x to 3.x and KServe or ModelMesh is Managed.
-func (c *AcceleratorMigrationCheck) CanApply(ctx context.Context, target check.Target) (bool, error) {
-	if !version.IsUpgradeFrom2xTo3x(target.CurrentVersion, target.TargetVersion) {
-		return false, nil
-	}
-
-	dsc, err := client.GetDataScienceCluster(ctx, target.Client)
-	if err != nil {
-		return false, fmt.Errorf("getting DataScienceCluster: %w", err)
-	}
-
-	return components.HasManagementState(dsc, constants.ComponentKServe, constants.ManagementStateManaged) ||
-		components.HasManagementState(dsc, "modelmeshserving", constants.ManagementStateManaged), nil
-}
-
 // Validate executes the check against the provided target.
 func (c *AcceleratorMigrationCheck) Validate(
 	ctx context.Context,
@@ -95,7 +90,7 @@ func (c *AcceleratorMigrationCheck) newISVCAcceleratorMigrationCondition(
 			ConditionTypeISVCAcceleratorProfileCompatible,
 			metav1.ConditionTrue,
 			check.WithReason(check.ReasonVersionCompatible),
-			check.WithMessage("No InferenceServices found using deprecated AcceleratorProfiles - no migration needed"),
+			check.WithCatalogMessage(msgNoAcceleratorProfiles),
 		)
 	}
 
@@ -105,7 +100,7 @@ func (c *AcceleratorMigrationCheck) newISVCAcceleratorMigrationCondition(
 			ConditionTypeISVCAcceleratorProfileCompatible,
 			metav1.ConditionFalse,
 			check.WithReason(check.ReasonResourceNotFound),
-			check.WithMessage("Found %d InferenceService(s) referencing deprecated AcceleratorProfiles (%d missing): AcceleratorProfiles and InferenceService references are automatically migrated to HardwareProfiles (infrastructure.opendatahub.io) during upgrade", totalImpacted, totalMissing),
+			check.WithCatalogMessage(msgAcceleratorProfilesMissing, totalImpacted, totalMissing),
 			check.WithImpact(result.ImpactAdvisory),
 			check.WithRemediation(c.CheckRemediation),
 		)
@@ -116,7 +111,7 @@ func (c *AcceleratorMigrationCheck) newISVCAcceleratorMigrationCondition(
 		ConditionTypeISVCAcceleratorProfileCompatible,
 		metav1.ConditionFalse,
 		check.WithReason(check.ReasonConfigurationInvalid),
-		check.WithMessage("Found %d InferenceService(s) using deprecated AcceleratorProfiles: AcceleratorProfiles and InferenceService references are automatically migrated to HardwareProfiles (infrastructure.opendatahub.io) during upgrade", totalImpacted),
+		check.WithCatalogMessage(msgAcceleratorProfilesMigrating, totalImpacted),
 		check.WithImpact(result.ImpactAdvisory),
 		check.WithRemediation(c.CheckRemediation),
 	)
@@ -0,0 +1,140 @@
+package kserve
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/opendatahub-io/odh-cli/pkg/constants"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/messages"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/validate"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/version"
+)
+
+const ConditionTypeISVCAutoscalingCompatible = "AutoscalingAnnotationsCompatible"
+
+// knativeAutoscalingPrefix is the annotation prefix read only by the Knative Pod Autoscaler.
+const knativeAutoscalingPrefix = "autoscaling.knative.dev/"
+
+//nolint:gochecknoglobals // Message catalog entries
+var (
+	msgNoKnativeAutoscaling = messages.New("workloads.kserve.autoscaling-annotations.none", "No InferenceServices found with Knative autoscaling annotations")
+	msgKnativeAutoscaling   = messages.New("workloads.kserve.autoscaling-annotations.found", "Found %d InferenceService(s) with Knative autoscaling annotations that have no effect under RawDeployment in RHOAI %s: %s")
+)
+
+// rawDeploymentAutoscalingEquivalents maps Knative autoscaling annotations (without prefix) to the
+// InferenceService setting honoured by RawDeployment. Annotations missing here have no equivalent.
+//
+//nolint:gochecknoglobals // Read-only lookup table
+var rawDeploymentAutoscalingEquivalents = map[string]string{
+	"min-scale": "spec.predictor.minReplicas",
+	"minScale":  "spec.predictor.minReplicas",
+	"max-scale": "spec.predictor.maxReplicas",
+	"maxScale":  "spec.predictor.maxReplicas",
+	"target":    "spec.predictor.scaleTarget",
+	"metric":    "spec.predictor.scaleMetric",
+	"class":     "serving.kserve.io/autoscalerClass annotation",
+}
+
+// AutoscalingAnnotationsCheck detects InferenceServices configuring the Knative Pod Autoscaler
+// through autoscaling.knative.dev/* annotations. Serverless mode is removed in RHOAI 3.x, so these
+// annotations become no-ops once the InferenceService runs as a RawDeployment.
+type AutoscalingAnnotationsCheck struct {
+	check.BaseCheck
+}
+
+func NewAutoscalingAnnotationsCheck() *AutoscalingAnnotationsCheck {
+	return &AutoscalingAnnotationsCheck{
+		BaseCheck: check.BaseCheck{
+			CheckGroup:       check.GroupWorkload,
+			Kind:             constants.ComponentKServe,
+			Type:             check.CheckTypeConfigMigration,
+			CheckID:          "workloads.kserve.autoscaling-annotations",
+			CheckName:        "Workloads :: KServe :: Knative Autoscaling Annotations (3.x)",
+			CheckDescription: "Detects InferenceServices using Knative autoscaling annotations that have no effect under RawDeployment in RHOAI 3.x",
+			CheckRemediation: "Replace autoscaling.knative.dev/* annotations with the RawDeployment equivalents: spec.predictor.minReplicas, maxReplicas, scaleTarget and scaleMetric, and the serving.kserve.io/autoscalerClass annotation (hpa or keda)",
+			CheckResources:   []resources.ResourceType{resources.InferenceService},
+			CheckVersions:    check.VersionsUpgrade2xTo3x,
+			CheckComponents:  []string{constants.ComponentKServe},
+			CheckDependsOn:   []string{check.CheckIDDSCReadiness},
+		},
+	}
+}
+
+// Validate executes the check against the provided target.
+func (c *AutoscalingAnnotationsCheck) Validate(
+	ctx context.Context,
+	target check.Target,
+) (*result.DiagnosticResult, error) {
+	return validate.Workloads(c, target, resources.InferenceService).
+		Filter(func(isvc *unstructured.Unstructured) (bool, error) {
+			return len(knativeAutoscalingAnnotations(isvc)) > 0, nil
+		}).
+		Complete(ctx, c.newAutoscalingCondition)
+}
+
+func (c *AutoscalingAnnotationsCheck) newAutoscalingCondition(
+	_ context.Context,
+	req *validate.WorkloadRequest[*unstructured.Unstructured],
+) ([]result.Condition, error) {
+	if len(req.Items) == 0 {
+		return []result.Condition{check.NewCondition(
+			ConditionTypeISVCAutoscalingCompatible,
+			metav1.ConditionTrue,
+			check.WithReason(check.ReasonVersionCompatible),
+			check.WithCatalogMessage(msgNoKnativeAutoscaling),
+		)}, nil
+	}
+
+	found := make(map[string]struct{})
+	for _, isvc := range req.Items {
+		for _, key := range knativeAutoscalingAnnotations(isvc) {
+			found[key] = struct{}{}
+		}
+	}
+
+	advice := make([]string, 0, len(found))
+	for _, key := range slices.Sorted(maps.Keys(found)) {
+		equivalent, ok := rawDeploymentAutoscalingEquivalents[strings.TrimPrefix(key, knativeAutoscalingPrefix)]
+		if !ok {
+			equivalent = "no RawDeployment equivalent"
+		}
+
+		advice = append(advice, fmt.Sprintf("%s (%s)", key, equivalent))
+	}
+
+	return []result.Condition{check.NewCondition(
+		ConditionTypeISVCAutoscalingCompatible,
+		metav1.ConditionFalse,
+		check.WithReason(check.ReasonDeprecated),
+		check.WithCatalogMessage(msgKnativeAutoscaling, len(req.Items), version.MajorMinorLabel(req.TargetVersion), strings.Join(advice, ", ")),
+		check.WithImpact(result.ImpactAdvisory),
+		check.WithRemediation(c.CheckRemediation),
+	)}, nil
+}
+
+// knativeAutoscalingAnnotations returns the Knative autoscaling annotation keys set on the
+// InferenceService itself or on its predictor pod template.
+func knativeAutoscalingAnnotations(isvc *unstructured.Unstructured) []string {
+	predictorAnnotations, _, _ := unstructured.NestedStringMap(isvc.Object, "spec", "predictor", "annotations")
+
+	var keys []string
+
+	for _, annotations := range []map[string]string{isvc.GetAnnotations(), predictorAnnotations} {
+		for key := range annotations {
+			if strings.HasPrefix(key, knativeAutoscalingPrefix) && !slices.Contains(keys, key) {
+				keys = append(keys, key)
+			}
+		}
+	}
+
+	return keys
+}
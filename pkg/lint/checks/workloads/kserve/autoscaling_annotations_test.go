@@ -0,0 +1,132 @@
+package kserve_test
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/testutil"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/workloads/kserve"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+)
+
+//nolint:gochecknoglobals // Test fixture - shared across test functions
+var autoscalingListKinds = map[schema.GroupVersionResource]string{
+	resources.DataScienceCluster.GVR(): resources.DataScienceCluster.ListKind(),
+	resources.InferenceService.GVR():   resources.InferenceService.ListKind(),
+}
+
+func newAutoscalingISVC(name string, annotations map[string]any, predictorAnnotations map[string]any) *unstructured.Unstructured {
+	predictor := map[string]any{}
+	if predictorAnnotations != nil {
+		predictor["annotations"] = predictorAnnotations
+	}
+
+	metadata := map[string]any{
+		"name":      name,
+		"namespace": "test-ns",
+	}
+	if annotations != nil {
+		metadata["annotations"] = annotations
+	}
+
+	return &unstructured.Unstructured{
+		Object: map[string]any{
+			"apiVersion": resources.InferenceService.APIVersion(),
+			"kind":       resources.InferenceService.Kind,
+			"metadata":   metadata,
+			"spec":       map[string]any{"predictor": predictor},
+		},
+	}
+}
+
+func TestAutoscalingAnnotationsCheck_NoKnativeAnnotations(t *testing.T) {
+	g := NewWithT(t)
+
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds: autoscalingListKinds,
+		Objects: []*unstructured.Unstructured{
+			newAutoscalingISVC("raw", map[string]any{"serving.kserve.io/autoscalerClass": "hpa"}, nil),
+		},
+		CurrentVersion: "2.17.0",
+		TargetVersion:  "3.0.0",
+	})
+
+	dr, err := kserve.NewAutoscalingAnnotationsCheck().Validate(t.Context(), target)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(dr.Status.Conditions).To(HaveLen(1))
+	g.Expect(dr.Status.Conditions[0].Condition).To(MatchFields(IgnoreExtras, Fields{
+		"Type":   Equal(kserve.ConditionTypeISVCAutoscalingCompatible),
+		"Status": Equal(metav1.ConditionTrue),
+		"Reason": Equal(check.ReasonVersionCompatible),
+	}))
+	g.Expect(dr.Annotations).To(HaveKeyWithValue(check.AnnotationImpactedWorkloadCount, "0"))
+	g.Expect(dr.ImpactedObjects).To(BeEmpty())
+}
+
+func TestAutoscalingAnnotationsCheck_KnativeAnnotations(t *testing.T) {
+	g := NewWithT(t)
+
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds: autoscalingListKinds,
+		Objects: []*unstructured.Unstructured{
+			newAutoscalingISVC("metadata", map[string]any{"autoscaling.knative.dev/min-scale": "1"}, nil),
+			newAutoscalingISVC("predictor", nil, map[string]any{
+				"autoscaling.knative.dev/target":        "10",
+				"autoscaling.knative.dev/window":        "60s",
+				"serving.knative.dev/creator":           "admin",
+				"autoscaling.knative.dev/initial-scale": "0",
+			}),
+			newAutoscalingISVC("clean", nil, nil),
+		},
+		CurrentVersion: "2.17.0",
+		TargetVersion:  "3.0.0",
+	})
+
+	dr, err := kserve.NewAutoscalingAnnotationsCheck().Validate(t.Context(), target)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(dr.Status.Conditions).To(HaveLen(1))
+	g.Expect(dr.Status.Conditions[0].Condition).To(MatchFields(IgnoreExtras, Fields{
+		"Type":   Equal(kserve.ConditionTypeISVCAutoscalingCompatible),
+		"Status": Equal(metav1.ConditionFalse),
+		"Reason": Equal(check.ReasonDeprecated),
+		"Message": And(
+			ContainSubstring("Found 2 InferenceService(s)"),
+			ContainSubstring("autoscaling.knative.dev/min-scale (spec.predictor.minReplicas)"),
+			ContainSubstring("autoscaling.knative.dev/target (spec.predictor.scaleTarget)"),
+			ContainSubstring("autoscaling.knative.dev/window (no RawDeployment equivalent)"),
+			Not(ContainSubstring("serving.knative.dev/creator")),
+		),
+	}))
+	g.Expect(dr.Status.Conditions[0].Impact).To(Equal(result.ImpactAdvisory))
+	g.Expect(dr.Annotations).To(HaveKeyWithValue(check.AnnotationImpactedWorkloadCount, "2"))
+	g.Expect(dr.ImpactedObjects).To(HaveLen(2))
+}
+
+func TestAutoscalingAnnotationsCheck_CanApply(t *testing.T) {
+	g := NewWithT(t)
+
+	chk := kserve.NewAutoscalingAnnotationsCheck()
+
+	for state, expected := range map[string]bool{"Managed": true, "Removed": false} {
+		target := testutil.NewTarget(t, testutil.TargetConfig{
+			ListKinds:      autoscalingListKinds,
+			Objects:        []*unstructured.Unstructured{testutil.NewDSC(map[string]string{"kserve": state})},
+			CurrentVersion: "2.17.0",
+			TargetVersion:  "3.0.0",
+		})
+
+		canApply, err := chk.CanApply(t.Context(), target)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(canApply).To(Equal(expected), "state %s", state)
+	}
+}
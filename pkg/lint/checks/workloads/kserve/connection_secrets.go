@@ -0,0 +1,348 @@
+package kserve
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/opendatahub-io/odh-cli/pkg/constants"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/messages"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/validate"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+	"github.com/opendatahub-io/odh-cli/pkg/util/version"
+)
+
+// Messages of the connection Secrets check.
+//
+//nolint:gochecknoglobals // Message catalog entries
+var (
+	msgSecretsConform           = messages.New("workloads.kserve.connection-secrets.conform", "All %d connection Secret(s) conform to the RHOAI %s connection API")
+	msgSecretsUnverified        = messages.New("workloads.kserve.connection-secrets.unverified", "Could not verify the keys of %d of %d connection Secret(s) against the RHOAI %s connection API: they carry no data")
+	msgSecretsInvalid           = messages.New("workloads.kserve.connection-secrets.invalid", "Found %d connection Secret(s) not conforming to the RHOAI %s connection API, referenced by %d workload(s)")
+	msgSecretsInvalidUnverified = messages.New("workloads.kserve.connection-secrets.invalid-unverified", "Found %d connection Secret(s) not conforming to the RHOAI %s connection API, referenced by %d workload(s); the keys of %d more could not be verified as they carry no data")
+)
+
+const (
+	connectionSecretsCheckType = "connection-secrets"
+
+	ConditionTypeConnectionSecretsCompatible = "ConnectionSecretsCompatible"
+
+	// annotationLegacyConnectionType marks 2.x data connections, with value "s3".
+	annotationLegacyConnectionType = "opendatahub.io/connection-type"
+
+	// annotationConnectionTypeRef names the connection type of a Secret in the connection API.
+	annotationConnectionTypeRef = "opendatahub.io/connection-type-ref"
+
+	// annotationConnections lists the connections (namespace/name) used by a workload.
+	annotationConnections = "opendatahub.io/connections"
+
+	connectionTypeS3 = "s3"
+
+	msgConnectionSecretUnverified = "required keys could not be verified: the Secret carries no data"
+)
+
+// connectionRequiredKeys are the Secret keys the connection API requires for each built-in
+// connection type. Secrets of custom connection types are only checked for the type reference.
+//
+//nolint:gochecknoglobals // Read-only lookup table
+var connectionRequiredKeys = map[string][]string{
+	connectionTypeS3: {"AWS_ACCESS_KEY_ID", "AWS_SECRET_ACCESS_KEY", "AWS_S3_ENDPOINT", "AWS_S3_BUCKET"},
+	"uri-v1":         {"URI"},
+	"oci-v1":         {".dockerconfigjson", "OCI_HOST"},
+}
+
+// ConnectionSecretsCheck validates the data connection Secrets created by the Dashboard against
+// the connection API of 3.x: a connection must reference its type through the
+// opendatahub.io/connection-type-ref annotation, replacing the 2.x opendatahub.io/connection-type
+// one, and carry the keys its type requires. InferenceServices and workbenches referencing
+// non-conforming Secrets are listed as well, since their storage or environment is resolved
+// from the connection.
+type ConnectionSecretsCheck struct {
+	check.BaseCheck
+}
+
+// NewConnectionSecretsCheck creates a new ConnectionSecretsCheck instance.
+func NewConnectionSecretsCheck() *ConnectionSecretsCheck {
+	return &ConnectionSecretsCheck{
+		BaseCheck: check.BaseCheck{
+			CheckGroup:       check.GroupWorkload,
+			Kind:             constants.ComponentKServe,
+			Type:             connectionSecretsCheckType,
+			CheckID:          "workloads.kserve.connection-secrets",
+			CheckName:        "Workloads :: KServe :: Connection Secrets Format (3.x)",
+			CheckDescription: "Validates that data connection Secrets set the opendatahub.io/connection-type-ref annotation and the fields required by the RHOAI 3.x connection API, and lists the InferenceServices and workbenches referencing non-conforming Secrets",
+			CheckRemediation: "Set opendatahub.io/connection-type-ref on the listed Secrets (s3 for 2.x data connections) and add the missing keys, or recreate the connections from the Dashboard",
+			CheckResources:   []resources.ResourceType{resources.Secret, resources.InferenceService, resources.Notebook},
+			CheckVersions:    check.VersionsUpgrade2xTo3x,
+			CheckComponents:  []string{constants.ComponentKServe, constants.ComponentWorkbenches},
+			CheckDependsOn:   []string{check.CheckIDDSCReadiness},
+		},
+	}
+}
+
+// Validate executes the check against the provided target.
+func (c *ConnectionSecretsCheck) Validate(
+	ctx context.Context,
+	target check.Target,
+) (*result.DiagnosticResult, error) {
+	return validate.Workloads(c, target, resources.Secret).
+		MetadataFilter(isConnectionSecret).
+		Run(ctx, c.checkSecrets)
+}
+
+// isConnectionSecret selects the Secrets the Dashboard created as data connections.
+func isConnectionSecret(obj metav1.Object) bool {
+	if obj.GetLabels()[constants.LabelDashboardProject] != "true" {
+		return false
+	}
+
+	annotations := obj.GetAnnotations()
+
+	return annotations[annotationLegacyConnectionType] != "" || annotations[annotationConnectionTypeRef] != ""
+}
+
+func (c *ConnectionSecretsCheck) checkSecrets(
+	ctx context.Context,
+	req *validate.WorkloadRequest[*unstructured.Unstructured],
+) error {
+	dr := req.Result
+
+	// Always set, so the builder does not report every listed Secret as impacted
+	dr.ImpactedObjects = []metav1.PartialObjectMetadata{}
+
+	invalid := sets.New[types.NamespacedName]()
+	unverified := 0
+
+	for _, secret := range req.Items {
+		issues, verified := connectionSecretIssues(secret)
+
+		var objectContext string
+
+		switch {
+		case len(issues) > 0:
+			objectContext = strings.Join(issues, "; ")
+		case !verified:
+			objectContext = msgConnectionSecretUnverified
+			unverified++
+		default:
+			continue
+		}
+
+		ref := types.NamespacedName{Namespace: secret.GetNamespace(), Name: secret.GetName()}
+		if len(issues) > 0 {
+			invalid.Insert(ref)
+		}
+
+		dr.AddImpactedObjects(resources.Secret, []types.NamespacedName{ref})
+		dr.ImpactedObjects[len(dr.ImpactedObjects)-1].Annotations = map[string]string{
+			result.AnnotationObjectContext: objectContext,
+		}
+	}
+
+	tv := version.MajorMinorLabel(req.TargetVersion)
+
+	if invalid.Len() == 0 && unverified == 0 {
+		dr.SetCondition(check.NewCondition(
+			ConditionTypeConnectionSecretsCompatible,
+			metav1.ConditionTrue,
+			check.WithReason(check.ReasonVersionCompatible),
+			check.WithCatalogMessage(msgSecretsConform, len(req.Items), tv),
+		))
+
+		return nil
+	}
+
+	// Without data, e.g. read from a redacted snapshot, missing keys cannot be told apart
+	// from keys that were not exported
+	if invalid.Len() == 0 {
+		dr.SetCondition(check.NewCondition(
+			ConditionTypeConnectionSecretsCompatible,
+			metav1.ConditionUnknown,
+			check.WithReason(check.ReasonInsufficientData),
+			check.WithCatalogMessage(msgSecretsUnverified,
+				unverified, len(req.Items), tv),
+			check.WithImpact(result.ImpactAdvisory),
+			check.WithRemediation(c.CheckRemediation),
+		))
+
+		return nil
+	}
+
+	referencing := 0
+
+	for _, rt := range []resources.ResourceType{resources.InferenceService, resources.Notebook} {
+		n, err := addReferencingWorkloads(ctx, req.Client, dr, rt, invalid)
+		if err != nil {
+			return err
+		}
+
+		referencing += n
+	}
+
+	message := check.WithCatalogMessage(msgSecretsInvalid, invalid.Len(), tv, referencing)
+	if unverified > 0 {
+		message = check.WithCatalogMessage(msgSecretsInvalidUnverified, invalid.Len(), tv, referencing, unverified)
+	}
+
+	dr.SetCondition(check.NewCondition(
+		ConditionTypeConnectionSecretsCompatible,
+		metav1.ConditionFalse,
+		check.WithReason(check.ReasonConfigurationInvalid),
+		message,
+		check.WithImpact(result.ImpactAdvisory),
+		check.WithRemediation(c.CheckRemediation),
+	))
+
+	return nil
+}
+
+// connectionSecretIssues returns how a connection Secret falls short of the connection API, and
+// false when the keys its type requires could not be verified because it carries no data.
+func connectionSecretIssues(secret *unstructured.Unstructured) ([]string, bool) {
+	var issues []string
+
+	annotations := secret.GetAnnotations()
+
+	typeRef := annotations[annotationConnectionTypeRef]
+	if typeRef == "" {
+		typeRef = annotations[annotationLegacyConnectionType]
+		issues = append(issues, fmt.Sprintf("annotation %s is missing (legacy %s=%s)",
+			annotationConnectionTypeRef, annotationLegacyConnectionType, typeRef))
+	}
+
+	required := connectionRequiredKeys[typeRef]
+	if len(required) > 0 && !hasSecretData(secret) {
+		return issues, false
+	}
+
+	var missing []string
+
+	for _, key := range required {
+		if !hasSecretKey(secret, key) {
+			missing = append(missing, key)
+		}
+	}
+
+	if len(missing) > 0 {
+		issues = append(issues, fmt.Sprintf("%s connection is missing %s", typeRef, strings.Join(missing, ", ")))
+	}
+
+	return issues, true
+}
+
+// hasSecretData returns whether the Secret carries any data or stringData key.
+func hasSecretData(secret *unstructured.Unstructured) bool {
+	for _, field := range []string{"data", "stringData"} {
+		if data, _, _ := unstructured.NestedMap(secret.Object, field); len(data) > 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+func hasSecretKey(secret *unstructured.Unstructured, key string) bool {
+	for _, field := range []string{"data", "stringData"} {
+		if value, _, _ := unstructured.NestedString(secret.Object, field, key); value != "" {
+			return true
+		}
+	}
+
+	return false
+}
+
+// addReferencingWorkloads adds the workloads of the given type referencing one of the invalid
+// connection Secrets to the impacted objects, and returns how many were added.
+func addReferencingWorkloads(
+	ctx context.Context,
+	r client.Reader,
+	dr *result.DiagnosticResult,
+	rt resources.ResourceType,
+	invalid sets.Set[types.NamespacedName],
+) (int, error) {
+	items, err := r.List(ctx, rt)
+	if err != nil {
+		if client.IsResourceTypeNotFound(err) {
+			return 0, nil
+		}
+
+		return 0, fmt.Errorf("listing %s: %w", rt.Kind, err)
+	}
+
+	count := 0
+
+	for _, item := range items {
+		var refs []string
+
+		for _, name := range connectionRefs(item) {
+			if invalid.Has(types.NamespacedName{Namespace: item.GetNamespace(), Name: name}) && !slices.Contains(refs, name) {
+				refs = append(refs, name)
+			}
+		}
+
+		if len(refs) == 0 {
+			continue
+		}
+
+		count++
+
+		dr.AddImpactedObjects(rt, []types.NamespacedName{{Namespace: item.GetNamespace(), Name: item.GetName()}})
+		dr.ImpactedObjects[len(dr.ImpactedObjects)-1].Annotations = map[string]string{
+			result.AnnotationObjectContext: "references connection Secret(s) " + strings.Join(refs, ", "),
+		}
+	}
+
+	return count, nil
+}
+
+// connectionRefs returns the names of the Secrets in its own namespace a workload uses as
+// connections: those listed in the opendatahub.io/connections annotation, the storage key of an
+// InferenceService model, and the envFrom Secrets of workbench containers.
+func connectionRefs(obj *unstructured.Unstructured) []string {
+	var refs []string
+
+	for part := range strings.SplitSeq(obj.GetAnnotations()[annotationConnections], ",") {
+		ns, name, hasSep := strings.Cut(strings.TrimSpace(part), "/")
+		if !hasSep {
+			name = ns
+		} else if ns != "" && ns != obj.GetNamespace() {
+			continue
+		}
+
+		if name != "" {
+			refs = append(refs, name)
+		}
+	}
+
+	if key, _, _ := unstructured.NestedString(obj.Object, "spec", "predictor", "model", "storage", "key"); key != "" {
+		refs = append(refs, key)
+	}
+
+	containers, _, _ := unstructured.NestedSlice(obj.Object, "spec", "template", "spec", "containers")
+	for _, container := range containers {
+		m, ok := container.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		envFrom, _, _ := unstructured.NestedSlice(m, "envFrom")
+		for _, source := range envFrom {
+			if s, ok := source.(map[string]any); ok {
+				if name, _, _ := unstructured.NestedString(s, "secretRef", "name"); name != "" {
+					refs = append(refs, name)
+				}
+			}
+		}
+	}
+
+	return refs
+}
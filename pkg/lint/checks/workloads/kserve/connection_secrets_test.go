@@ -0,0 +1,179 @@
+package kserve_test
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/testutil"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/workloads/kserve"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+)
+
+//nolint:gochecknoglobals // Test fixture - shared across test functions
+var connectionListKinds = map[schema.GroupVersionResource]string{
+	resources.DataScienceCluster.GVR(): resources.DataScienceCluster.ListKind(),
+	resources.Secret.GVR():             resources.Secret.ListKind(),
+	resources.InferenceService.GVR():   resources.InferenceService.ListKind(),
+	resources.Notebook.GVR():           resources.Notebook.ListKind(),
+}
+
+func newConnectionSecret(name string, annotations map[string]any, keys ...string) *unstructured.Unstructured {
+	data := make(map[string]any, len(keys))
+	for _, key := range keys {
+		data[key] = "dmFsdWU="
+	}
+
+	return &unstructured.Unstructured{
+		Object: map[string]any{
+			"apiVersion": resources.Secret.APIVersion(),
+			"kind":       resources.Secret.Kind,
+			"metadata": map[string]any{
+				"name":        name,
+				"namespace":   "test-ns",
+				"labels":      map[string]any{"opendatahub.io/dashboard": "true"},
+				"annotations": annotations,
+			},
+			"data": data,
+		},
+	}
+}
+
+func s3Keys() []string {
+	return []string{"AWS_ACCESS_KEY_ID", "AWS_SECRET_ACCESS_KEY", "AWS_S3_ENDPOINT", "AWS_S3_BUCKET"}
+}
+
+func TestConnectionSecretsCheck_Validate_Conforming(t *testing.T) {
+	g := NewWithT(t)
+
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds: connectionListKinds,
+		Objects: []*unstructured.Unstructured{
+			newConnectionSecret("models", map[string]any{"opendatahub.io/connection-type-ref": "s3"}, s3Keys()...),
+			newConnectionSecret("hf", map[string]any{"opendatahub.io/connection-type-ref": "uri-v1"}, "URI"),
+		},
+		CurrentVersion: "2.17.0",
+		TargetVersion:  "3.0.0",
+	})
+
+	dr, err := kserve.NewConnectionSecretsCheck().Validate(t.Context(), target)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(dr.Status.Conditions).To(HaveLen(1))
+	g.Expect(dr.Status.Conditions[0].Condition).To(MatchFields(IgnoreExtras, Fields{
+		"Type":    Equal(kserve.ConditionTypeConnectionSecretsCompatible),
+		"Status":  Equal(metav1.ConditionTrue),
+		"Reason":  Equal(check.ReasonVersionCompatible),
+		"Message": ContainSubstring("All 2 connection Secret(s)"),
+	}))
+	g.Expect(dr.ImpactedObjects).To(BeEmpty())
+}
+
+func TestConnectionSecretsCheck_Validate_NonConforming(t *testing.T) {
+	g := NewWithT(t)
+
+	isvc := newAutoscalingISVC("model", nil, nil)
+	_ = unstructured.SetNestedField(isvc.Object, "legacy", "spec", "predictor", "model", "storage", "key")
+
+	nb := newAutoscalingISVC("workbench", map[string]any{"opendatahub.io/connections": "test-ns/hf"}, nil)
+	nb.SetAPIVersion(resources.Notebook.APIVersion())
+	nb.SetKind(resources.Notebook.Kind)
+
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds: connectionListKinds,
+		Objects: []*unstructured.Unstructured{
+			newConnectionSecret("legacy", map[string]any{"opendatahub.io/connection-type": "s3"},
+				"AWS_ACCESS_KEY_ID", "AWS_SECRET_ACCESS_KEY", "AWS_S3_ENDPOINT"),
+			newConnectionSecret("hf", map[string]any{"opendatahub.io/connection-type-ref": "uri-v1"}, "URL"),
+			newConnectionSecret("models", map[string]any{"opendatahub.io/connection-type-ref": "s3"}, s3Keys()...),
+			isvc,
+			nb,
+		},
+		CurrentVersion: "2.17.0",
+		TargetVersion:  "3.0.0",
+	})
+
+	dr, err := kserve.NewConnectionSecretsCheck().Validate(t.Context(), target)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(dr.Status.Conditions).To(HaveLen(1))
+	g.Expect(dr.Status.Conditions[0].Condition).To(MatchFields(IgnoreExtras, Fields{
+		"Type":    Equal(kserve.ConditionTypeConnectionSecretsCompatible),
+		"Status":  Equal(metav1.ConditionFalse),
+		"Reason":  Equal(check.ReasonConfigurationInvalid),
+		"Message": ContainSubstring("Found 2 connection Secret(s) not conforming to the RHOAI 3.0 connection API, referenced by 2 workload(s)"),
+	}))
+	g.Expect(dr.Status.Conditions[0].Impact).To(Equal(result.ImpactAdvisory))
+
+	contexts := make(map[string]string, len(dr.ImpactedObjects))
+	for _, obj := range dr.ImpactedObjects {
+		contexts[obj.Kind+"/"+obj.Name] = obj.Annotations[result.AnnotationObjectContext]
+	}
+
+	g.Expect(contexts).To(Equal(map[string]string{
+		"Secret/legacy": "annotation opendatahub.io/connection-type-ref is missing (legacy opendatahub.io/connection-type=s3); " +
+			"s3 connection is missing AWS_S3_BUCKET",
+		"Secret/hf":              "uri-v1 connection is missing URI",
+		"InferenceService/model": "references connection Secret(s) legacy",
+		"Notebook/workbench":     "references connection Secret(s) hf",
+	}))
+}
+
+func TestConnectionSecretsCheck_Validate_NoData(t *testing.T) {
+	g := NewWithT(t)
+
+	redacted := newConnectionSecret("redacted", map[string]any{"opendatahub.io/connection-type-ref": "s3"})
+	unstructured.RemoveNestedField(redacted.Object, "data")
+
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds: connectionListKinds,
+		Objects: []*unstructured.Unstructured{
+			redacted,
+			newConnectionSecret("models", map[string]any{"opendatahub.io/connection-type-ref": "s3"}, s3Keys()...),
+		},
+		CurrentVersion: "2.17.0",
+		TargetVersion:  "3.0.0",
+	})
+
+	dr, err := kserve.NewConnectionSecretsCheck().Validate(t.Context(), target)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(dr.Status.Conditions).To(HaveLen(1))
+	g.Expect(dr.Status.Conditions[0].Condition).To(MatchFields(IgnoreExtras, Fields{
+		"Type":    Equal(kserve.ConditionTypeConnectionSecretsCompatible),
+		"Status":  Equal(metav1.ConditionUnknown),
+		"Reason":  Equal(check.ReasonInsufficientData),
+		"Message": ContainSubstring("Could not verify the keys of 1 of 2 connection Secret(s)"),
+	}))
+	g.Expect(dr.ImpactedObjects).To(HaveLen(1))
+	g.Expect(dr.ImpactedObjects[0].Name).To(Equal("redacted"))
+	g.Expect(dr.ImpactedObjects[0].Annotations[result.AnnotationObjectContext]).ToNot(ContainSubstring("is missing"))
+}
+
+func TestConnectionSecretsCheck_CanApply(t *testing.T) {
+	g := NewWithT(t)
+
+	chk := kserve.NewConnectionSecretsCheck()
+
+	for state, expected := range map[string]bool{"Managed": true, "Removed": false} {
+		target := testutil.NewTarget(t, testutil.TargetConfig{
+			ListKinds: connectionListKinds,
+			Objects: []*unstructured.Unstructured{
+				testutil.NewDSC(map[string]string{"kserve": state, "workbenches": "Removed"}),
+			},
+			CurrentVersion: "2.17.0",
+			TargetVersion:  "3.0.0",
+		})
+
+		canApply, err := chk.CanApply(t.Context(), target)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(canApply).To(Equal(expected), "state %s", state)
+	}
+}
@@ -0,0 +1,189 @@
+package kserve
+
+import (
+	"context"
+	"net/url"
+	"slices"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/opendatahub-io/odh-cli/pkg/constants"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/messages"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/validate"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/kube"
+	"github.com/opendatahub-io/odh-cli/pkg/util/version"
+)
+
+const (
+	endpointExposureCheckType = "endpoint-exposure"
+
+	ConditionTypeISVCEndpointsStable = "ExternalEndpointsStable"
+
+	// AnnotationCheckCurrentURL is set on impacted InferenceServices to the external URL they are
+	// reachable at before upgrade.
+	AnnotationCheckCurrentURL = "check.opendatahub.io/current-url"
+
+	// labelVisibility selects whether a RawDeployment InferenceService is exposed through a Route.
+	labelVisibility        = "networking.kserve.io/visibility"
+	labelVisibilityExposed = "exposed"
+
+	// clusterLocalDomain is the domain suffix of endpoints reachable only inside the cluster.
+	clusterLocalDomain = ".svc.cluster.local"
+)
+
+//nolint:gochecknoglobals // Message catalog entries
+var (
+	msgNoExposedEndpoints = messages.New("workloads.kserve.endpoint-exposure.none", "No InferenceServices found exposed through Knative or custom routes")
+	msgExposedEndpoints   = messages.New("workloads.kserve.endpoint-exposure.found", "Found %d InferenceService(s) exposed through Knative or custom routes whose external URL changes under Gateway API routing in RHOAI %s - notify their consumers")
+)
+
+// customIngressAnnotationPrefixes are the annotation prefixes tuning how the 2.x Knative or
+// OpenShift Route exposing an InferenceService is created; they are not carried over to the
+// Gateway API routes of 3.x.
+//
+//nolint:gochecknoglobals // Read-only lookup table
+var customIngressAnnotationPrefixes = []string{
+	"haproxy.router.openshift.io/",
+	"route.openshift.io/",
+	"serving.knative.openshift.io/",
+	"networking.knative.dev/",
+}
+
+// EndpointExposureCheck lists InferenceServices reachable from outside the cluster through a
+// Knative route (Serverless) or an OpenShift Route customized by annotations. In 3.x model
+// endpoints are served through Gateway API, so their external host name, path and TLS
+// termination change and consumers must be told the new endpoint.
+type EndpointExposureCheck struct {
+	check.BaseCheck
+}
+
+// NewEndpointExposureCheck creates a new EndpointExposureCheck instance.
+func NewEndpointExposureCheck() *EndpointExposureCheck {
+	return &EndpointExposureCheck{
+		BaseCheck: check.BaseCheck{
+			CheckGroup:       check.GroupWorkload,
+			Kind:             constants.ComponentKServe,
+			Type:             endpointExposureCheckType,
+			CheckID:          "workloads.kserve.endpoint-exposure",
+			CheckName:        "Workloads :: KServe :: External Endpoint Changes (3.x)",
+			CheckDescription: "Lists InferenceServices exposed through Serverless Knative routes or custom route annotations whose external URL changes under Gateway API routing in RHOAI 3.x",
+			CheckRemediation: "Notify the consumers of the listed endpoints of the new Gateway API URL after upgrading, and recreate custom route settings (timeouts, TLS passthrough, allowlists) on the Gateway or HTTPRoute",
+			CheckResources:   []resources.ResourceType{resources.InferenceService},
+			CheckVersions:    check.VersionsUpgrade2xTo3x,
+			CheckComponents:  []string{constants.ComponentKServe},
+			CheckDependsOn:   []string{check.CheckIDDSCReadiness},
+		},
+	}
+}
+
+// Validate executes the check against the provided target.
+func (c *EndpointExposureCheck) Validate(
+	ctx context.Context,
+	target check.Target,
+) (*result.DiagnosticResult, error) {
+	return validate.Workloads(c, target, resources.InferenceService).
+		Filter(func(isvc *unstructured.Unstructured) (bool, error) {
+			return len(exposureReasons(isvc)) > 0, nil
+		}).
+		Run(ctx, c.checkEndpoints)
+}
+
+func (c *EndpointExposureCheck) checkEndpoints(
+	_ context.Context,
+	req *validate.WorkloadRequest[*unstructured.Unstructured],
+) error {
+	dr := req.Result
+
+	if len(req.Items) == 0 {
+		dr.SetCondition(check.NewCondition(
+			ConditionTypeISVCEndpointsStable,
+			metav1.ConditionTrue,
+			check.WithReason(check.ReasonVersionCompatible),
+			check.WithCatalogMessage(msgNoExposedEndpoints),
+		))
+
+		return nil
+	}
+
+	dr.ImpactedObjects = make([]metav1.PartialObjectMetadata, 0, len(req.Items))
+
+	for _, isvc := range req.Items {
+		dr.AddImpactedObjects(resources.InferenceService, []types.NamespacedName{
+			{Namespace: isvc.GetNamespace(), Name: isvc.GetName()},
+		})
+
+		annotations := map[string]string{
+			result.AnnotationObjectContext: strings.Join(exposureReasons(isvc), "; "),
+		}
+
+		if u := externalURL(isvc); u != "" {
+			annotations[AnnotationCheckCurrentURL] = u
+		}
+
+		dr.ImpactedObjects[len(dr.ImpactedObjects)-1].Annotations = annotations
+	}
+
+	dr.SetCondition(check.NewCondition(
+		ConditionTypeISVCEndpointsStable,
+		metav1.ConditionFalse,
+		check.WithReason(check.ReasonWorkloadsImpacted),
+		check.WithCatalogMessage(msgExposedEndpoints, len(req.Items), version.MajorMinorLabel(req.TargetVersion)),
+		check.WithImpact(result.ImpactAdvisory),
+		check.WithRemediation(c.CheckRemediation),
+	))
+
+	return nil
+}
+
+// exposureReasons returns why the external endpoint of an InferenceService changes in 3.x:
+// it is served by a Knative route, or by a Route exposed or customized through annotations.
+func exposureReasons(isvc *unstructured.Unstructured) []string {
+	var reasons []string
+
+	if kube.HasAnnotation(isvc, annotationDeploymentMode, deploymentModeServerless) && externalURL(isvc) != "" {
+		reasons = append(reasons, "served by a Knative route")
+	}
+
+	if isvc.GetLabels()[labelVisibility] == labelVisibilityExposed {
+		reasons = append(reasons, "exposed through an OpenShift Route")
+	}
+
+	var custom []string
+
+	for key := range isvc.GetAnnotations() {
+		if slices.ContainsFunc(customIngressAnnotationPrefixes, func(prefix string) bool {
+			return strings.HasPrefix(key, prefix)
+		}) {
+			custom = append(custom, key)
+		}
+	}
+
+	if len(custom) > 0 {
+		slices.Sort(custom)
+		reasons = append(reasons, "custom route annotations "+strings.Join(custom, ", "))
+	}
+
+	return reasons
+}
+
+// externalURL returns the status URL of an InferenceService when it is reachable from outside
+// the cluster, or "" otherwise.
+func externalURL(isvc *unstructured.Unstructured) string {
+	raw, _, _ := unstructured.NestedString(isvc.Object, "status", "url")
+	if raw == "" {
+		return ""
+	}
+
+	u, err := url.Parse(raw)
+	if err != nil || u.Hostname() == "" || strings.HasSuffix(u.Hostname(), clusterLocalDomain) {
+		return ""
+	}
+
+	return raw
+}
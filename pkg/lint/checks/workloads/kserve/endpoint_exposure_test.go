@@ -0,0 +1,131 @@
+package kserve_test
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/testutil"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/workloads/kserve"
+
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+)
+
+func newExposedISVC(name string, labels map[string]any, annotations map[string]any, url string) *unstructured.Unstructured {
+	isvc := newAutoscalingISVC(name, annotations, nil)
+
+	if labels != nil {
+		_ = unstructured.SetNestedMap(isvc.Object, labels, "metadata", "labels")
+	}
+
+	if url != "" {
+		_ = unstructured.SetNestedField(isvc.Object, url, "status", "url")
+	}
+
+	return isvc
+}
+
+func TestEndpointExposureCheck_Validate_NoExposedEndpoints(t *testing.T) {
+	g := NewWithT(t)
+
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds: autoscalingListKinds,
+		Objects: []*unstructured.Unstructured{
+			newExposedISVC("internal", nil,
+				map[string]any{"serving.kserve.io/deploymentMode": "Serverless"},
+				"http://internal.test-ns.svc.cluster.local"),
+			newExposedISVC("raw", nil,
+				map[string]any{"serving.kserve.io/deploymentMode": "RawDeployment"},
+				"http://raw-predictor.test-ns.svc.cluster.local"),
+		},
+		CurrentVersion: "2.17.0",
+		TargetVersion:  "3.0.0",
+	})
+
+	dr, err := kserve.NewEndpointExposureCheck().Validate(t.Context(), target)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(dr.Status.Conditions).To(HaveLen(1))
+	g.Expect(dr.Status.Conditions[0].Condition).To(MatchFields(IgnoreExtras, Fields{
+		"Type":   Equal(kserve.ConditionTypeISVCEndpointsStable),
+		"Status": Equal(metav1.ConditionTrue),
+		"Reason": Equal(check.ReasonVersionCompatible),
+	}))
+	g.Expect(dr.ImpactedObjects).To(BeEmpty())
+}
+
+func TestEndpointExposureCheck_Validate_ExposedEndpoints(t *testing.T) {
+	g := NewWithT(t)
+
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds: autoscalingListKinds,
+		Objects: []*unstructured.Unstructured{
+			newExposedISVC("serverless", nil,
+				map[string]any{"serving.kserve.io/deploymentMode": "Serverless"},
+				"https://serverless-test-ns.apps.example.com"),
+			newExposedISVC("raw-route",
+				map[string]any{"networking.kserve.io/visibility": "exposed"},
+				map[string]any{
+					"serving.kserve.io/deploymentMode":    "RawDeployment",
+					"haproxy.router.openshift.io/timeout": "5m",
+				},
+				"https://raw-route-test-ns.apps.example.com"),
+			newExposedISVC("raw-internal", nil,
+				map[string]any{"serving.kserve.io/deploymentMode": "RawDeployment"},
+				"http://raw-internal-predictor.test-ns.svc.cluster.local"),
+		},
+		CurrentVersion: "2.17.0",
+		TargetVersion:  "3.0.0",
+	})
+
+	dr, err := kserve.NewEndpointExposureCheck().Validate(t.Context(), target)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(dr.Status.Conditions).To(HaveLen(1))
+	g.Expect(dr.Status.Conditions[0].Condition).To(MatchFields(IgnoreExtras, Fields{
+		"Type":    Equal(kserve.ConditionTypeISVCEndpointsStable),
+		"Status":  Equal(metav1.ConditionFalse),
+		"Reason":  Equal(check.ReasonWorkloadsImpacted),
+		"Message": ContainSubstring("Found 2 InferenceService(s)"),
+	}))
+	g.Expect(dr.Status.Conditions[0].Impact).To(Equal(result.ImpactAdvisory))
+
+	annotations := make(map[string]map[string]string, len(dr.ImpactedObjects))
+	for _, obj := range dr.ImpactedObjects {
+		annotations[obj.Name] = obj.Annotations
+	}
+
+	g.Expect(annotations).To(Equal(map[string]map[string]string{
+		"serverless": {
+			result.AnnotationObjectContext:   "served by a Knative route",
+			kserve.AnnotationCheckCurrentURL: "https://serverless-test-ns.apps.example.com",
+		},
+		"raw-route": {
+			result.AnnotationObjectContext:   "exposed through an OpenShift Route; custom route annotations haproxy.router.openshift.io/timeout",
+			kserve.AnnotationCheckCurrentURL: "https://raw-route-test-ns.apps.example.com",
+		},
+	}))
+}
+
+func TestEndpointExposureCheck_CanApply(t *testing.T) {
+	g := NewWithT(t)
+
+	chk := kserve.NewEndpointExposureCheck()
+
+	for state, expected := range map[string]bool{"Managed": true, "Removed": false} {
+		target := testutil.NewTarget(t, testutil.TargetConfig{
+			ListKinds:      autoscalingListKinds,
+			Objects:        []*unstructured.Unstructured{testutil.NewDSC(map[string]string{"kserve": state})},
+			CurrentVersion: "2.17.0",
+			TargetVersion:  "3.0.0",
+		})
+
+		canApply, err := chk.CanApply(t.Context(), target)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(canApply).To(Equal(expected), "state %s", state)
+	}
+}
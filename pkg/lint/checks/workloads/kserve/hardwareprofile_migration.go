@@ -2,20 +2,26 @@ package kserve
 
 import (
 	"context"
-	"fmt"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/opendatahub-io/odh-cli/pkg/constants"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/messages"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check/validate"
 	"github.com/opendatahub-io/odh-cli/pkg/resources"
-	"github.com/opendatahub-io/odh-cli/pkg/util/client"
-	"github.com/opendatahub-io/odh-cli/pkg/util/components"
 	"github.com/opendatahub-io/odh-cli/pkg/util/kube"
 )
 
+// Messages of the InferenceService hardware profile migration check.
+//
+//nolint:gochecknoglobals // Message catalog entries
+var (
+	msgNoLegacyHardwareProfiles = messages.New("workloads.kserve.hardwareprofile-migration.none", "No InferenceServices found with legacy hardware profile annotation - no migration needed")
+	msgLegacyHardwareProfiles   = messages.New("workloads.kserve.hardwareprofile-migration.found", "Found %d InferenceService(s) with legacy hardware profile annotation that may need attention")
+)
+
 // ConditionTypeISVCHardwareProfileCompatible indicates whether InferenceServices reference legacy hardware profiles.
 const ConditionTypeISVCHardwareProfileCompatible = "HardwareProfileCompatible"
 
@@ -36,21 +42,14 @@ func NewHardwareProfileMigrationCheck() *HardwareProfileMigrationCheck {
 			CheckName:        "Workloads :: KServe :: Legacy HardwareProfile Migration",
 			CheckDescription: "Detects InferenceService CRs carrying the legacy opendatahub.io/legacy-hardware-profile-name annotation that may need attention",
 			CheckRemediation: "Update InferenceServices to use current HardwareProfiles and remove the legacy-hardware-profile-name annotation",
+			CheckResources:   []resources.ResourceType{resources.InferenceService},
+			CheckVersions:    check.VersionsAny,
+			CheckComponents:  []string{constants.ComponentKServe},
+			CheckDependsOn:   []string{check.CheckIDDSCReadiness},
 		},
 	}
 }
 
-// CanApply returns whether this check should run for the given target.
-// Only applies when KServe is in a Managed state.
-func (c *HardwareProfileMigrationCheck) CanApply(ctx context.Context, target check.Target) (bool, error) {
-	dsc, err := client.GetDataScienceCluster(ctx, target.Client)
-	if err != nil {
-		return false, fmt.Errorf("getting DataScienceCluster: %w", err)
-	}
-
-	return components.HasManagementState(dsc, constants.ComponentKServe, constants.ManagementStateManaged), nil
-}
-
 // Validate executes the check against the provided target.
 func (c *HardwareProfileMigrationCheck) Validate(
 	ctx context.Context,
@@ -78,7 +77,7 @@ func (c *HardwareProfileMigrationCheck) newCondition(
 			ConditionTypeISVCHardwareProfileCompatible,
 			metav1.ConditionTrue,
 			check.WithReason(check.ReasonNoMigrationRequired),
-			check.WithMessage("No InferenceServices found with legacy hardware profile annotation - no migration needed"),
+			check.WithCatalogMessage(msgNoLegacyHardwareProfiles),
 		)}, nil
 	}
 
@@ -86,7 +85,7 @@ func (c *HardwareProfileMigrationCheck) newCondition(
 		ConditionTypeISVCHardwareProfileCompatible,
 		metav1.ConditionFalse,
 		check.WithReason(check.ReasonMigrationPending),
-		check.WithMessage("Found %d InferenceService(s) with legacy hardware profile annotation that may need attention", count),
+		check.WithCatalogMessage(msgLegacyHardwareProfiles, count),
 		check.WithImpact(result.ImpactAdvisory),
 		check.WithRemediation(c.CheckRemediation),
 	)}, nil
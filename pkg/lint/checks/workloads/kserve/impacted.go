@@ -16,7 +16,6 @@ import (
 	"github.com/opendatahub-io/odh-cli/pkg/printer/table"
 	"github.com/opendatahub-io/odh-cli/pkg/resources"
 	"github.com/opendatahub-io/odh-cli/pkg/util/client"
-	"github.com/opendatahub-io/odh-cli/pkg/util/components"
 	"github.com/opendatahub-io/odh-cli/pkg/util/jq"
 	"github.com/opendatahub-io/odh-cli/pkg/util/kube"
 	"github.com/opendatahub-io/odh-cli/pkg/util/version"
@@ -24,6 +23,7 @@ import (
 
 const (
 	annotationDeploymentMode = "serving.kserve.io/deploymentMode"
+	annotationRuntime        = "serving.kserve.io/runtime"
 	deploymentModeModelMesh  = "ModelMesh"
 	deploymentModeServerless = "Serverless"
 )
@@ -42,12 +42,6 @@ const (
 	annotationHardwareProfileName = "opendatahub.io/hardware-profile-name"
 )
 
-const (
-	runtimeOVMS             = "ovms"
-	runtimeCaikitStandalone = "caikit-standalone-serving-template"
-	runtimeCaikitTGIS       = "caikit-tgis-serving-template"
-)
-
 // ImpactedWorkloadsCheck lists InferenceServices and ServingRuntimes using deprecated deployment modes.
 type ImpactedWorkloadsCheck struct {
 	check.BaseCheck
@@ -67,6 +61,10 @@ func NewImpactedWorkloadsCheck() *ImpactedWorkloadsCheck {
 			CheckName:        "Workloads :: KServe :: Impacted Workloads (3.x)",
 			CheckDescription: "Lists InferenceServices and ServingRuntimes using deprecated deployment modes (ModelMesh, Serverless), removed ServingRuntimes, or ServingRuntimes referencing deprecated AcceleratorProfiles that will be impacted in RHOAI 3.x",
 			CheckRemediation: "Migrate InferenceServices from Serverless/ModelMesh to RawDeployment mode, update ServingRuntimes to supported versions, and review AcceleratorProfile references before upgrading",
+			CheckResources:   []resources.ResourceType{resources.InferenceService, resources.ServingRuntime},
+			CheckVersions:    check.VersionsUpgrade2xTo3x,
+			CheckComponents:  []string{constants.ComponentKServe, "modelmeshserving"},
+			CheckDependsOn:   []string{check.CheckIDDSCReadiness},
 		},
 		deploymentModeFilter: "all", // Default to showing all deployment modes
 	}
@@ -78,22 +76,6 @@ func (c *ImpactedWorkloadsCheck) SetDeploymentModeFilter(filter string) {
 	c.deploymentModeFilter = filter
 }
 
-// CanApply returns whether this check should run for the given target.
-// Only applies when upgrading FROM 2.x TO 3.x and KServe or ModelMesh is Managed.
-func (c *ImpactedWorkloadsCheck) CanApply(ctx context.Context, target check.Target) (bool, error) {
-	if !version.IsUpgradeFrom2xTo3x(target.CurrentVersion, target.TargetVersion) {
-		return false, nil
-	}
-
-	dsc, err := client.GetDataScienceCluster(ctx, target.Client)
-	if err != nil {
-		return false, fmt.Errorf("getting DataScienceCluster: %w", err)
-	}
-
-	return components.HasManagementState(dsc, constants.ComponentKServe, constants.ManagementStateManaged) ||
-		components.HasManagementState(dsc, "modelmeshserving", constants.ManagementStateManaged), nil
-}
-
 // Validate executes the check against the provided target.
 func (c *ImpactedWorkloadsCheck) Validate(
 	ctx context.Context,
@@ -123,7 +105,8 @@ func (c *ImpactedWorkloadsCheck) Validate(
 
 	// Fetch InferenceServices referencing removed ServingRuntimes
 	removedRuntimeISVCs, err := client.List[*unstructured.Unstructured](
-		ctx, target.Client, resources.InferenceService, isUsingRemovedRuntime,
+		ctx, target.Client, resources.InferenceService,
+		isUsingRemovedRuntime(target.Compat().RemovedRuntimes(target.TargetVersion)),
 	)
 	if err != nil {
 		return nil, err
@@ -179,6 +162,23 @@ func (c *ImpactedWorkloadsCheck) Validate(
 	return dr, nil
 }
 
+// NewRuntimeGroupRenderer creates the verbose output renderer grouping InferenceServices by the
+// ServingRuntime they use, as reported by ImpactedWorkloadsCheck for removed and
+// AcceleratorProfile-linked runtimes.
+func NewRuntimeGroupRenderer() check.GroupRenderer {
+	return check.GroupRenderer{
+		AnnotationSchema: check.AnnotationSchema{
+			Kind:       resources.InferenceService.Kind,
+			Annotation: annotationRuntime,
+		},
+		Noun:    "InferenceServices",
+		Unknown: "(unknown runtime)",
+		Label: func(metav1.PartialObjectMetadata) string {
+			return "runtime"
+		},
+	}
+}
+
 // inferenceServiceRow represents a row in the InferenceService detail table.
 type inferenceServiceRow struct {
 	Name           string `mapstructure:"NAME"`
@@ -201,7 +201,7 @@ func (c *ImpactedWorkloadsCheck) FormatVerboseOutput(out io.Writer, dr *result.D
 		deploymentMode := obj.Annotations[annotationDeploymentMode]
 		if deploymentMode == "" {
 			// Check for runtime annotation (for removed runtime ISVCs)
-			if runtime := obj.Annotations["serving.kserve.io/runtime"]; runtime != "" {
+			if runtime := obj.Annotations[annotationRuntime]; runtime != "" {
 				deploymentMode = "RawDeployment"
 			} else {
 				deploymentMode = "Unknown"
@@ -1,14 +1,17 @@
 package kserve
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"slices"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/types"
 
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/messages"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check/validate"
 	"github.com/opendatahub-io/odh-cli/pkg/resources"
@@ -16,6 +19,25 @@ import (
 	"github.com/opendatahub-io/odh-cli/pkg/util/kube"
 )
 
+// Messages of the impacted workloads check. The desc* entries name the kind of workload a
+// condition reports on and are substituted into the other messages.
+//
+//nolint:gochecknoglobals // Message catalog entries
+var (
+	msgWorkloadsImpacted      = messages.New("workloads.kserve.impacted-workloads.impacted", "Found %d %s - will be impacted in RHOAI %s")
+	msgNoWorkloadsImpacted    = messages.New("workloads.kserve.impacted-workloads.none", "No %s found - ready for RHOAI %s upgrade")
+	msgAcceleratorWorkloads   = messages.New("workloads.kserve.impacted-workloads.accelerator", "Found %d %s - AcceleratorProfiles will be auto-migrated to HardwareProfiles during upgrade")
+	msgNoAcceleratorWorkloads = messages.New("workloads.kserve.impacted-workloads.accelerator-none", "No %s found")
+
+	descServerlessISVCs            = messages.New("workloads.kserve.impacted-workloads.serverless-isvcs", "Serverless InferenceService(s)")
+	descModelMeshISVCs             = messages.New("workloads.kserve.impacted-workloads.modelmesh-isvcs", "ModelMesh InferenceService(s)")
+	descModelMeshSRs               = messages.New("workloads.kserve.impacted-workloads.modelmesh-servingruntimes", "ModelMesh ServingRuntime(s)")
+	descRemovedRuntimeISVCs        = messages.New("workloads.kserve.impacted-workloads.removed-runtime-isvcs", "InferenceService(s) using removed ServingRuntime(s)")
+	descAcceleratorOnlySRs         = messages.New("workloads.kserve.impacted-workloads.accelerator-only-servingruntimes", "ServingRuntime(s) with AcceleratorProfile annotation only")
+	descAcceleratorAndHWProfileSRs = messages.New("workloads.kserve.impacted-workloads.accelerator-hwprofile-servingruntimes", "ServingRuntime(s) with both AcceleratorProfile and HardwareProfile annotations")
+	descAcceleratorSRISVCs         = messages.New("workloads.kserve.impacted-workloads.accelerator-servingruntime-isvcs", "InferenceService(s) referencing AcceleratorProfile-linked ServingRuntime(s)")
+)
+
 // isImpactedISVC returns true for InferenceServices with Serverless or ModelMesh deployment mode.
 func isImpactedISVC(obj *metav1.PartialObjectMetadata) (bool, error) {
 	return kube.HasAnnotation(obj, annotationDeploymentMode, deploymentModeServerless) ||
@@ -28,7 +50,7 @@ func isImpactedISVC(obj *metav1.PartialObjectMetadata) (bool, error) {
 func (c *ImpactedWorkloadsCheck) newWorkloadCompatibilityCondition(
 	conditionType string,
 	count int,
-	workloadDescription string,
+	workloadDescription messages.Message,
 	targetVersionLabel string,
 ) result.Condition {
 	if count > 0 {
@@ -36,7 +58,7 @@ func (c *ImpactedWorkloadsCheck) newWorkloadCompatibilityCondition(
 			conditionType,
 			metav1.ConditionFalse,
 			check.WithReason(check.ReasonVersionIncompatible),
-			check.WithMessage("Found %d %s - will be impacted in RHOAI %s", count, workloadDescription, targetVersionLabel),
+			check.WithCatalogMessage(msgWorkloadsImpacted, count, workloadDescription, targetVersionLabel),
 			check.WithImpact(result.ImpactBlocking),
 			check.WithRemediation(c.CheckRemediation),
 		)
@@ -46,7 +68,7 @@ func (c *ImpactedWorkloadsCheck) newWorkloadCompatibilityCondition(
 		conditionType,
 		metav1.ConditionTrue,
 		check.WithReason(check.ReasonVersionCompatible),
-		check.WithMessage("No %s found - ready for RHOAI %s upgrade", workloadDescription, targetVersionLabel),
+		check.WithCatalogMessage(msgNoWorkloadsImpacted, workloadDescription, targetVersionLabel),
 	)
 }
 
@@ -60,7 +82,7 @@ func (c *ImpactedWorkloadsCheck) appendServerlessISVCCondition(
 	c.appendISVCCondition(dr, allISVCs,
 		ConditionTypeServerlessISVCCompatible,
 		deploymentModeServerless,
-		"Serverless InferenceService(s)",
+		descServerlessISVCs,
 		targetVersionLabel,
 	)
 }
@@ -75,7 +97,7 @@ func (c *ImpactedWorkloadsCheck) appendModelMeshISVCCondition(
 	c.appendISVCCondition(dr, allISVCs,
 		ConditionTypeModelMeshISVCCompatible,
 		deploymentModeModelMesh,
-		"ModelMesh InferenceService(s)",
+		descModelMeshISVCs,
 		targetVersionLabel,
 	)
 }
@@ -87,7 +109,7 @@ func (c *ImpactedWorkloadsCheck) appendISVCCondition(
 	allISVCs []*metav1.PartialObjectMetadata,
 	conditionType string,
 	deploymentMode string,
-	workloadDescription string,
+	workloadDescription messages.Message,
 	targetVersionLabel string,
 ) {
 	var filtered []*metav1.PartialObjectMetadata
@@ -127,7 +149,7 @@ func (c *ImpactedWorkloadsCheck) appendModelMeshSRCondition(
 		c.newWorkloadCompatibilityCondition(
 			ConditionTypeModelMeshSRCompatible,
 			len(impactedSRs),
-			"ModelMesh ServingRuntime(s)",
+			descModelMeshSRs,
 			targetVersionLabel,
 		),
 	)
@@ -143,23 +165,20 @@ func (c *ImpactedWorkloadsCheck) appendModelMeshSRCondition(
 	}
 }
 
-// isUsingRemovedRuntime returns true for InferenceServices referencing a removed ServingRuntime.
-func isUsingRemovedRuntime(obj *unstructured.Unstructured) (bool, error) {
-	runtime, err := jq.Query[string](obj, ".spec.predictor.model.runtime")
-
-	switch {
-	case errors.Is(err, jq.ErrNotFound):
-		return false, nil
-	case err != nil:
-		return false, fmt.Errorf("querying runtime for %s/%s: %w", obj.GetNamespace(), obj.GetName(), err)
-	case runtime == runtimeOVMS:
-		return true, nil
-	case runtime == runtimeCaikitStandalone:
-		return true, nil
-	case runtime == runtimeCaikitTGIS:
-		return true, nil
-	default:
-		return false, nil
+// isUsingRemovedRuntime returns a filter matching InferenceServices that reference one of the
+// removed ServingRuntimes.
+func isUsingRemovedRuntime(removed []string) func(*unstructured.Unstructured) (bool, error) {
+	return func(obj *unstructured.Unstructured) (bool, error) {
+		runtime, err := jq.Query[string](obj, ".spec.predictor.model.runtime")
+
+		switch {
+		case errors.Is(err, jq.ErrNotFound):
+			return false, nil
+		case err != nil:
+			return false, fmt.Errorf("querying runtime for %s/%s: %w", obj.GetNamespace(), obj.GetName(), err)
+		default:
+			return slices.Contains(removed, runtime), nil
+		}
 	}
 }
 
@@ -174,7 +193,7 @@ func (c *ImpactedWorkloadsCheck) appendRemovedRuntimeISVCCondition(
 		c.newWorkloadCompatibilityCondition(
 			ConditionTypeRemovedSRCompatible,
 			len(items),
-			"InferenceService(s) using removed ServingRuntime(s)",
+			descRemovedRuntimeISVCs,
 			targetVersionLabel,
 		),
 	)
@@ -191,7 +210,7 @@ func (c *ImpactedWorkloadsCheck) appendRemovedRuntimeISVCCondition(
 				Namespace: r.GetNamespace(),
 				Name:      r.GetName(),
 				Annotations: map[string]string{
-					"serving.kserve.io/runtime": runtime,
+					annotationRuntime: runtime,
 				},
 			},
 		})
@@ -209,14 +228,14 @@ func hasAcceleratorAnnotation(obj *metav1.PartialObjectMetadata) (bool, error) {
 func (c *ImpactedWorkloadsCheck) newAcceleratorSRCondition(
 	conditionType string,
 	count int,
-	workloadDescription string,
+	workloadDescription messages.Message,
 ) result.Condition {
 	if count > 0 {
 		return check.NewCondition(
 			conditionType,
 			metav1.ConditionFalse,
 			check.WithReason(check.ReasonConfigurationInvalid),
-			check.WithMessage("Found %d %s - AcceleratorProfiles will be auto-migrated to HardwareProfiles during upgrade", count, workloadDescription),
+			check.WithCatalogMessage(msgAcceleratorWorkloads, count, workloadDescription),
 			check.WithImpact(result.ImpactAdvisory),
 			check.WithRemediation(c.CheckRemediation),
 		)
@@ -226,7 +245,7 @@ func (c *ImpactedWorkloadsCheck) newAcceleratorSRCondition(
 		conditionType,
 		metav1.ConditionTrue,
 		check.WithReason(check.ReasonVersionCompatible),
-		check.WithMessage("No %s found", workloadDescription),
+		check.WithCatalogMessage(msgNoAcceleratorWorkloads, workloadDescription),
 	)
 }
 
@@ -240,7 +259,7 @@ func (c *ImpactedWorkloadsCheck) appendAcceleratorOnlySRCondition(
 		c.newAcceleratorSRCondition(
 			ConditionTypeAcceleratorOnlySRCompatible,
 			len(items),
-			"ServingRuntime(s) with AcceleratorProfile annotation only",
+			descAcceleratorOnlySRs,
 		),
 	)
 
@@ -268,7 +287,7 @@ func (c *ImpactedWorkloadsCheck) appendAcceleratorAndHWProfileSRCondition(
 		c.newAcceleratorSRCondition(
 			ConditionTypeAcceleratorAndHWProfileSRCompat,
 			len(items),
-			"ServingRuntime(s) with both AcceleratorProfile and HardwareProfile annotations",
+			descAcceleratorAndHWProfileSRs,
 		),
 	)
 
@@ -323,7 +342,7 @@ func (c *ImpactedWorkloadsCheck) appendAcceleratorSRISVCCondition(
 		c.newAcceleratorSRCondition(
 			ConditionTypeAcceleratorSRISVCCompatible,
 			len(impacted),
-			"InferenceService(s) referencing AcceleratorProfile-linked ServingRuntime(s)",
+			descAcceleratorSRISVCs,
 		),
 	)
 
@@ -339,7 +358,7 @@ func (c *ImpactedWorkloadsCheck) appendAcceleratorSRISVCCondition(
 				Namespace: r.GetNamespace(),
 				Name:      r.GetName(),
 				Annotations: map[string]string{
-					"serving.kserve.io/runtime": runtime,
+					annotationRuntime: runtime,
 				},
 			},
 		})
@@ -347,3 +366,32 @@ func (c *ImpactedWorkloadsCheck) appendAcceleratorSRISVCCondition(
 
 	return nil
 }
+
+// Remediations removes the stale AcceleratorProfile annotation from ServingRuntimes that
+// already reference a HardwareProfile, since the HardwareProfile annotation supersedes it.
+func (c *ImpactedWorkloadsCheck) Remediations(
+	_ context.Context,
+	_ check.Target,
+	dr *result.DiagnosticResult,
+) ([]check.Remediation, error) {
+	var remediations []check.Remediation
+
+	for _, obj := range dr.ImpactedObjects {
+		if obj.Kind != resources.ServingRuntime.Kind || obj.Annotations[annotationHardwareProfileName] == "" {
+			continue
+		}
+
+		r, err := check.RemoveAnnotations(
+			resources.ServingRuntime,
+			types.NamespacedName{Namespace: obj.Namespace, Name: obj.Name},
+			validate.AnnotationAcceleratorName,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		remediations = append(remediations, r)
+	}
+
+	return remediations, nil
+}
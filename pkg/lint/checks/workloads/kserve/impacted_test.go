@@ -1253,6 +1253,15 @@ func TestImpactedWorkloadsCheck_AcceleratorSR_MixedAnnotations(t *testing.T) {
 
 	// 2 SRs + 1 ISVC = 3 impacted objects
 	g.Expect(result.Annotations).To(HaveKeyWithValue(check.AnnotationImpactedWorkloadCount, "3"))
+
+	// Only the dual-annotated SR has a stale accelerator annotation to remove
+	remediations, err := impactedCheck.Remediations(ctx, target, result)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(remediations).To(HaveExactElements(And(
+		HaveField("Namespace", "test-ns"),
+		HaveField("Name", "dual-runtime"),
+		HaveField("ResourceType", resources.ServingRuntime),
+	)))
 }
 
 func TestImpactedWorkloadsCheck_FormatVerboseOutput(t *testing.T) {
@@ -13,11 +13,11 @@ import (
 
 	"github.com/opendatahub-io/odh-cli/pkg/constants"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/messages"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check/validate"
 	"github.com/opendatahub-io/odh-cli/pkg/resources"
 	"github.com/opendatahub-io/odh-cli/pkg/util/client"
-	"github.com/opendatahub-io/odh-cli/pkg/util/components"
 	"github.com/opendatahub-io/odh-cli/pkg/util/jq"
 	"github.com/opendatahub-io/odh-cli/pkg/util/kube"
 	"github.com/opendatahub-io/odh-cli/pkg/util/version"
@@ -29,11 +29,12 @@ const inferenceServiceConfigName = "inferenceservice-config"
 // inferenceServiceDataKey is the ConfigMap data key containing InferenceService configuration.
 const inferenceServiceDataKey = "inferenceService"
 
-const (
-	msgConfigMapNotFound            = "inferenceservice-config ConfigMap not found in namespace %s - no migration needed"
-	msgManagedAnnotationMissing     = "inferenceservice-config ConfigMap must have %s=false and include hardware-profile annotations in serviceAnnotationDisallowedList, otherwise models may get restarted during upgrade to RHOAI %s"
-	msgDisallowedAnnotationsMissing = "inferenceservice-config ConfigMap must include the following annotations in serviceAnnotationDisallowedList to prevent models from being restarted during upgrade to RHOAI %s: %s"
-	msgConfigMapReady               = "inferenceservice-config ConfigMap has %s=false and serviceAnnotationDisallowedList includes required hardware-profile annotations - ready for RHOAI %s upgrade"
+//nolint:gochecknoglobals // Message catalog entries
+var (
+	msgConfigMapNotFound            = messages.New("workloads.kserve.inferenceservice-config.not-found", "inferenceservice-config ConfigMap not found in namespace %s - no migration needed")
+	msgManagedAnnotationMissing     = messages.New("workloads.kserve.inferenceservice-config.managed-annotation-missing", "inferenceservice-config ConfigMap must have %s=false and include hardware-profile annotations in serviceAnnotationDisallowedList, otherwise models may get restarted during upgrade to RHOAI %s")
+	msgDisallowedAnnotationsMissing = messages.New("workloads.kserve.inferenceservice-config.disallowed-annotations-missing", "inferenceservice-config ConfigMap must include the following annotations in serviceAnnotationDisallowedList to prevent models from being restarted during upgrade to RHOAI %s: %s")
+	msgConfigMapReady               = messages.New("workloads.kserve.inferenceservice-config.ready", "inferenceservice-config ConfigMap has %s=false and serviceAnnotationDisallowedList includes required hardware-profile annotations - ready for RHOAI %s upgrade")
 )
 
 // requiredDisallowedAnnotations lists annotations that must be present in the
@@ -68,25 +69,14 @@ func NewInferenceServiceConfigCheck() *InferenceServiceConfigCheck {
 			CheckName:        "Workloads :: KServe :: InferenceService Config Migration",
 			CheckDescription: "Validates that inferenceservice-config ConfigMap has opendatahub.io/managed=false and includes hardware-profile annotations in serviceAnnotationDisallowedList before upgrading to RHOAI 3.x",
 			CheckRemediation: "Set the annotation opendatahub.io/managed=false on the inferenceservice-config ConfigMap, and add opendatahub.io/hardware-profile-name and opendatahub.io/hardware-profile-namespace to the serviceAnnotationDisallowedList in the inferenceService data key",
+			CheckResources:   []resources.ResourceType{resources.ConfigMap},
+			CheckVersions:    check.VersionsUpgrade2xTo3x,
+			CheckComponents:  []string{constants.ComponentKServe},
+			CheckDependsOn:   []string{check.CheckIDDSCReadiness},
 		},
 	}
 }
 
-// CanApply returns whether this check should run for the given target.
-// This check only applies when upgrading FROM 2.x TO 3.x and KServe is Managed.
-func (c *InferenceServiceConfigCheck) CanApply(ctx context.Context, target check.Target) (bool, error) {
-	if !version.IsUpgradeFrom2xTo3x(target.CurrentVersion, target.TargetVersion) {
-		return false, nil
-	}
-
-	dsc, err := client.GetDataScienceCluster(ctx, target.Client)
-	if err != nil {
-		return false, fmt.Errorf("getting DataScienceCluster: %w", err)
-	}
-
-	return components.HasManagementState(dsc, constants.ComponentKServe, constants.ManagementStateManaged), nil
-}
-
 func (c *InferenceServiceConfigCheck) Validate(ctx context.Context, target check.Target) (*result.DiagnosticResult, error) {
 	return validate.Component(c, target).
 		WithApplicationsNamespace().
@@ -106,7 +96,7 @@ func (c *InferenceServiceConfigCheck) Validate(ctx context.Context, target check
 					check.ConditionTypeCompatible,
 					metav1.ConditionTrue,
 					check.WithReason(check.ReasonVersionCompatible),
-					check.WithMessage(msgConfigMapNotFound, req.ApplicationsNamespace),
+					check.WithCatalogMessage(msgConfigMapNotFound, req.ApplicationsNamespace),
 				))
 
 				return nil
@@ -119,7 +109,7 @@ func (c *InferenceServiceConfigCheck) Validate(ctx context.Context, target check
 					check.ConditionTypeConfigured,
 					metav1.ConditionFalse,
 					check.WithReason(check.ReasonConfigurationUnmanaged),
-					check.WithMessage(msgManagedAnnotationMissing, kube.AnnotationManaged, tv),
+					check.WithCatalogMessage(msgManagedAnnotationMissing, kube.AnnotationManaged, tv),
 					check.WithImpact(result.ImpactAdvisory),
 					check.WithRemediation(c.CheckRemediation),
 				))
@@ -139,7 +129,7 @@ func (c *InferenceServiceConfigCheck) Validate(ctx context.Context, target check
 					check.ConditionTypeConfigured,
 					metav1.ConditionFalse,
 					check.WithReason(check.ReasonConfigurationInvalid),
-					check.WithMessage(msgDisallowedAnnotationsMissing, tv, strings.Join(missing, ", ")),
+					check.WithCatalogMessage(msgDisallowedAnnotationsMissing, tv, strings.Join(missing, ", ")),
 					check.WithImpact(result.ImpactAdvisory),
 					check.WithRemediation(c.CheckRemediation),
 				))
@@ -151,7 +141,7 @@ func (c *InferenceServiceConfigCheck) Validate(ctx context.Context, target check
 				check.ConditionTypeCompatible,
 				metav1.ConditionTrue,
 				check.WithReason(check.ReasonVersionCompatible),
-				check.WithMessage(msgConfigMapReady, kube.AnnotationManaged, tv),
+				check.WithCatalogMessage(msgConfigMapReady, kube.AnnotationManaged, tv),
 			))
 
 			return nil
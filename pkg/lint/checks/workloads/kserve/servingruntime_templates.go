@@ -0,0 +1,234 @@
+package kserve
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/opendatahub-io/odh-cli/pkg/constants"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/messages"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/validate"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/version"
+)
+
+// Messages of the serving runtime templates check.
+//
+//nolint:gochecknoglobals // Message catalog entries
+var (
+	msgTemplates   = messages.New("workloads.kserve.servingruntime-templates.found", "Found %d %s in RHOAI %s")
+	msgNoTemplates = messages.New("workloads.kserve.servingruntime-templates.none", "No %s in RHOAI %s")
+
+	descRemovedTemplates    = messages.New("workloads.kserve.servingruntime-templates.removed", "serving runtime template(s) based on runtimes removed")
+	descIncompleteTemplates = messages.New("workloads.kserve.servingruntime-templates.incomplete", "serving runtime template(s) missing fields required")
+)
+
+const (
+	servingRuntimeTemplatesCheckType = "servingruntime-templates"
+
+	ConditionTypeRemovedRuntimeTemplatesCompatible = "RemovedRuntimeTemplatesCompatible"
+	ConditionTypeRuntimeTemplatesComplete          = "RuntimeTemplatesComplete"
+
+	// Dashboard annotations describing the ServingRuntime of a template, required in 3.x.
+	annotationAPIProtocol         = "opendatahub.io/apiProtocol"
+	annotationModelServingSupport = "opendatahub.io/modelServingSupport"
+
+	// modelServingPlatformMulti is the modelServingSupport value of the removed ModelMesh platform.
+	modelServingPlatformMulti = "multi"
+)
+
+// runtimeTemplateFindings holds the issues found on a serving runtime template.
+type runtimeTemplateFindings struct {
+	removed []string
+	missing []string
+}
+
+// ServingRuntimeTemplatesCheck lists the serving runtime templates of the Dashboard, OpenShift
+// Templates labelled opendatahub.io/dashboard wrapping a ServingRuntime, and flags those based
+// on runtimes removed in 3.x or missing fields the 3.x Dashboard requires. It complements the
+// detection of InferenceServices using removed runtimes in ImpactedWorkloadsCheck: templates
+// keep offering those runtimes for new deployments.
+type ServingRuntimeTemplatesCheck struct {
+	check.BaseCheck
+}
+
+// NewServingRuntimeTemplatesCheck creates a new ServingRuntimeTemplatesCheck instance.
+func NewServingRuntimeTemplatesCheck() *ServingRuntimeTemplatesCheck {
+	return &ServingRuntimeTemplatesCheck{
+		BaseCheck: check.BaseCheck{
+			CheckGroup:       check.GroupWorkload,
+			Kind:             constants.ComponentKServe,
+			Type:             servingRuntimeTemplatesCheckType,
+			CheckID:          "workloads.kserve.servingruntime-templates",
+			CheckName:        "Workloads :: KServe :: Serving Runtime Templates (3.x)",
+			CheckDescription: "Lists serving runtime templates based on runtimes removed in RHOAI 3.x (OVMS ModelMesh, Caikit-TGIS) or missing fields required by the RHOAI 3.x Dashboard",
+			CheckRemediation: "Delete templates of removed runtimes or rebase them on a supported single-model runtime, and set the opendatahub.io/apiProtocol and opendatahub.io/modelServingSupport annotations and the ServingRuntime containers and supportedModelFormats on the remaining templates",
+			CheckResources:   []resources.ResourceType{resources.Template},
+			CheckVersions:    check.VersionsUpgrade2xTo3x,
+		},
+	}
+}
+
+// Validate executes the check against the provided target.
+func (c *ServingRuntimeTemplatesCheck) Validate(
+	ctx context.Context,
+	target check.Target,
+) (*result.DiagnosticResult, error) {
+	return validate.Workloads(c, target, resources.Template).
+		MetadataFilter(isDashboardTemplate).
+		Filter(func(obj *unstructured.Unstructured) (bool, error) {
+			return servingRuntimeObject(obj) != nil, nil
+		}).
+		Run(ctx, c.checkTemplates)
+}
+
+func isDashboardTemplate(obj metav1.Object) bool {
+	return obj.GetLabels()[constants.LabelDashboardProject] == "true"
+}
+
+// servingRuntimeObject returns the ServingRuntime wrapped by a template, or nil.
+func servingRuntimeObject(template *unstructured.Unstructured) map[string]any {
+	objects, _, _ := unstructured.NestedSlice(template.Object, "objects")
+
+	for _, obj := range objects {
+		if m, ok := obj.(map[string]any); ok && m["kind"] == resources.ServingRuntime.Kind {
+			return m
+		}
+	}
+
+	return nil
+}
+
+func (c *ServingRuntimeTemplatesCheck) checkTemplates(
+	_ context.Context,
+	req *validate.WorkloadRequest[*unstructured.Unstructured],
+) error {
+	dr := req.Result
+	tv := version.MajorMinorLabel(req.TargetVersion)
+	removedRuntimes := req.Compat().RemovedRuntimes(req.TargetVersion)
+
+	// Always set, so the builder does not report every listed template as impacted
+	dr.ImpactedObjects = []metav1.PartialObjectMetadata{}
+
+	var removedCount, incompleteCount int
+
+	for _, tmpl := range req.Items {
+		findings, err := inspectRuntimeTemplate(tmpl, removedRuntimes)
+		if err != nil {
+			return err
+		}
+
+		if len(findings.removed) > 0 {
+			removedCount++
+		}
+
+		if len(findings.missing) > 0 {
+			incompleteCount++
+		}
+
+		issues := slices.Concat(findings.removed, findings.missing)
+		if len(issues) == 0 {
+			continue
+		}
+
+		dr.AddImpactedObjects(resources.Template, []types.NamespacedName{
+			{Namespace: tmpl.GetNamespace(), Name: tmpl.GetName()},
+		})
+		dr.ImpactedObjects[len(dr.ImpactedObjects)-1].Annotations = map[string]string{
+			result.AnnotationObjectContext: strings.Join(issues, "; "),
+		}
+	}
+
+	dr.SetCondition(c.newTemplatesCondition(
+		ConditionTypeRemovedRuntimeTemplatesCompatible,
+		removedCount,
+		descRemovedTemplates,
+		tv,
+	))
+	dr.SetCondition(c.newTemplatesCondition(
+		ConditionTypeRuntimeTemplatesComplete,
+		incompleteCount,
+		descIncompleteTemplates,
+		tv,
+	))
+
+	return nil
+}
+
+func (c *ServingRuntimeTemplatesCheck) newTemplatesCondition(
+	conditionType string,
+	count int,
+	description messages.Message,
+	targetVersionLabel string,
+) result.Condition {
+	if count == 0 {
+		return check.NewCondition(
+			conditionType,
+			metav1.ConditionTrue,
+			check.WithReason(check.ReasonVersionCompatible),
+			check.WithCatalogMessage(msgNoTemplates, description, targetVersionLabel),
+		)
+	}
+
+	return check.NewCondition(
+		conditionType,
+		metav1.ConditionFalse,
+		check.WithReason(check.ReasonConfigurationInvalid),
+		check.WithCatalogMessage(msgTemplates, count, description, targetVersionLabel),
+		check.WithImpact(result.ImpactAdvisory),
+		check.WithRemediation(c.CheckRemediation),
+	)
+}
+
+// inspectRuntimeTemplate reports whether a template is based on a removed runtime, by template
+// name, ServingRuntime name or ModelMesh platform, and which required fields it lacks.
+func inspectRuntimeTemplate(tmpl *unstructured.Unstructured, removedRuntimes []string) (runtimeTemplateFindings, error) {
+	var findings runtimeTemplateFindings
+
+	runtime := servingRuntimeObject(tmpl)
+	runtimeName, _, _ := unstructured.NestedString(runtime, "metadata", "name")
+
+	for _, name := range []string{tmpl.GetName(), runtimeName} {
+		if name != "" && slices.Contains(removedRuntimes, name) {
+			findings.removed = append(findings.removed, "runtime "+name+" is removed")
+
+			break
+		}
+	}
+
+	annotations := tmpl.GetAnnotations()
+
+	if support := annotations[annotationModelServingSupport]; support != "" {
+		var platforms []string
+		if err := json.Unmarshal([]byte(support), &platforms); err != nil {
+			return findings, fmt.Errorf("parsing %s of Template %s/%s: %w",
+				annotationModelServingSupport, tmpl.GetNamespace(), tmpl.GetName(), err)
+		}
+
+		if slices.Equal(platforms, []string{modelServingPlatformMulti}) {
+			findings.removed = append(findings.removed, "multi-model serving (ModelMesh) is removed")
+		}
+	}
+
+	for _, key := range []string{annotationAPIProtocol, annotationModelServingSupport} {
+		if annotations[key] == "" {
+			findings.missing = append(findings.missing, "annotation "+key+" is missing")
+		}
+	}
+
+	for _, field := range []string{"containers", "supportedModelFormats"} {
+		if items, _, _ := unstructured.NestedSlice(runtime, "spec", field); len(items) == 0 {
+			findings.missing = append(findings.missing, "ServingRuntime spec."+field+" is empty")
+		}
+	}
+
+	return findings, nil
+}
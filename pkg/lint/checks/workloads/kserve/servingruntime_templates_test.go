@@ -0,0 +1,149 @@
+package kserve_test
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/testutil"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/workloads/kserve"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+)
+
+//nolint:gochecknoglobals // Test fixture - shared across test functions
+var templateListKinds = map[schema.GroupVersionResource]string{
+	resources.Template.GVR(): resources.Template.ListKind(),
+}
+
+func newRuntimeTemplate(name string, runtimeName string, annotations map[string]any) *unstructured.Unstructured {
+	return &unstructured.Unstructured{
+		Object: map[string]any{
+			"apiVersion": resources.Template.APIVersion(),
+			"kind":       resources.Template.Kind,
+			"metadata": map[string]any{
+				"name":        name,
+				"namespace":   "redhat-ods-applications",
+				"labels":      map[string]any{"opendatahub.io/dashboard": "true"},
+				"annotations": annotations,
+			},
+			"objects": []any{
+				map[string]any{
+					"apiVersion": resources.ServingRuntime.APIVersion(),
+					"kind":       resources.ServingRuntime.Kind,
+					"metadata":   map[string]any{"name": runtimeName},
+					"spec": map[string]any{
+						"containers":            []any{map[string]any{"name": "kserve-container"}},
+						"supportedModelFormats": []any{map[string]any{"name": "onnx"}},
+					},
+				},
+			},
+		},
+	}
+}
+
+func singleModelAnnotations() map[string]any {
+	return map[string]any{
+		"opendatahub.io/apiProtocol":         "REST",
+		"opendatahub.io/modelServingSupport": `["single"]`,
+	}
+}
+
+func TestServingRuntimeTemplatesCheck_Validate_Supported(t *testing.T) {
+	g := NewWithT(t)
+
+	unlabelled := newRuntimeTemplate("unrelated", "ovms", nil)
+	unlabelled.SetLabels(nil)
+
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds: templateListKinds,
+		Objects: []*unstructured.Unstructured{
+			newRuntimeTemplate("vllm-runtime-template", "vllm-runtime", singleModelAnnotations()),
+			unlabelled,
+		},
+		CurrentVersion: "2.17.0",
+		TargetVersion:  "3.0.0",
+	})
+
+	dr, err := kserve.NewServingRuntimeTemplatesCheck().Validate(t.Context(), target)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(dr.Status.Conditions).To(HaveLen(2))
+	g.Expect(dr.Status.Conditions).To(HaveEach(MatchFields(IgnoreExtras, Fields{
+		"Condition": MatchFields(IgnoreExtras, Fields{
+			"Status": Equal(metav1.ConditionTrue),
+			"Reason": Equal(check.ReasonVersionCompatible),
+		}),
+	})))
+	g.Expect(dr.ImpactedObjects).To(BeEmpty())
+}
+
+func TestServingRuntimeTemplatesCheck_Validate_Flagged(t *testing.T) {
+	g := NewWithT(t)
+
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds: templateListKinds,
+		Objects: []*unstructured.Unstructured{
+			newRuntimeTemplate("vllm-runtime-template", "vllm-runtime", singleModelAnnotations()),
+			newRuntimeTemplate("caikit-tgis-serving-template", "caikit-tgis-runtime", singleModelAnnotations()),
+			newRuntimeTemplate("custom-ovms", "custom-ovms", map[string]any{
+				"opendatahub.io/apiProtocol":         "REST",
+				"opendatahub.io/modelServingSupport": `["multi"]`,
+			}),
+			newRuntimeTemplate("custom-runtime", "custom-runtime", map[string]any{
+				"opendatahub.io/apiProtocol": "REST",
+			}),
+		},
+		CurrentVersion: "2.17.0",
+		TargetVersion:  "3.0.0",
+	})
+
+	dr, err := kserve.NewServingRuntimeTemplatesCheck().Validate(t.Context(), target)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(dr.Status.Conditions).To(ConsistOf(
+		MatchFields(IgnoreExtras, Fields{
+			"Condition": MatchFields(IgnoreExtras, Fields{
+				"Type":    Equal(kserve.ConditionTypeRemovedRuntimeTemplatesCompatible),
+				"Status":  Equal(metav1.ConditionFalse),
+				"Message": ContainSubstring("Found 2 serving runtime template(s)"),
+			}),
+			"Impact": Equal(result.ImpactAdvisory),
+		}),
+		MatchFields(IgnoreExtras, Fields{
+			"Condition": MatchFields(IgnoreExtras, Fields{
+				"Type":    Equal(kserve.ConditionTypeRuntimeTemplatesComplete),
+				"Status":  Equal(metav1.ConditionFalse),
+				"Message": ContainSubstring("Found 1 serving runtime template(s)"),
+			}),
+			"Impact": Equal(result.ImpactAdvisory),
+		}),
+	))
+
+	contexts := make(map[string]string, len(dr.ImpactedObjects))
+	for _, obj := range dr.ImpactedObjects {
+		contexts[obj.Name] = obj.Annotations[result.AnnotationObjectContext]
+	}
+
+	g.Expect(contexts).To(Equal(map[string]string{
+		"caikit-tgis-serving-template": "runtime caikit-tgis-serving-template is removed",
+		"custom-ovms":                  "multi-model serving (ModelMesh) is removed",
+		"custom-runtime":               "annotation opendatahub.io/modelServingSupport is missing",
+	}))
+}
+
+func TestServingRuntimeTemplatesCheck_Metadata(t *testing.T) {
+	g := NewWithT(t)
+
+	chk := kserve.NewServingRuntimeTemplatesCheck()
+
+	g.Expect(chk.ID()).To(Equal("workloads.kserve.servingruntime-templates"))
+	g.Expect(chk.Group()).To(Equal(check.GroupWorkload))
+	g.Expect(chk.Description()).To(ContainSubstring("Caikit-TGIS"))
+}
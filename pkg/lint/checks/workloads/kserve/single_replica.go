@@ -0,0 +1,128 @@
+package kserve
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/opendatahub-io/odh-cli/pkg/constants"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/messages"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/validate"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/jq"
+	"github.com/opendatahub-io/odh-cli/pkg/util/kube"
+	"github.com/opendatahub-io/odh-cli/pkg/util/version"
+)
+
+const ConditionTypeISVCDisruptionTolerant = "DisruptionTolerant"
+
+const (
+	// labelInferenceService is set by KServe on every predictor pod of an InferenceService.
+	labelInferenceService = "serving.kserve.io/inferenceservice"
+
+	// annotationInferenceServiceStop marks an InferenceService whose predictor is scaled down.
+	annotationInferenceServiceStop = "serving.kserve.io/stop"
+)
+
+//nolint:gochecknoglobals // Message catalog entries
+var (
+	msgNoSingleReplicaISVCs = messages.New("workloads.kserve.single-replica.none", "No InferenceServices found running a single replica without a PodDisruptionBudget")
+	msgSingleReplicaISVCs   = messages.New("workloads.kserve.single-replica.found", "Found %d InferenceService(s) running a single replica without a PodDisruptionBudget - model endpoints will be unavailable while nodes are drained and controllers restart during the upgrade to RHOAI %s")
+)
+
+// SingleReplicaCheck detects InferenceServices whose predictor runs a single replica that is not
+// protected by a PodDisruptionBudget, so node drains during the upgrade take the endpoint down.
+type SingleReplicaCheck struct {
+	check.BaseCheck
+}
+
+func NewSingleReplicaCheck() *SingleReplicaCheck {
+	return &SingleReplicaCheck{
+		BaseCheck: check.BaseCheck{
+			CheckGroup:       check.GroupWorkload,
+			Kind:             constants.ComponentKServe,
+			Type:             check.CheckTypeDisruptionRisk,
+			CheckID:          "workloads.kserve.single-replica",
+			CheckName:        "Workloads :: KServe :: Single Replica Disruption Risk",
+			CheckDescription: "Detects InferenceServices running a single predictor replica without a PodDisruptionBudget that will incur downtime during the upgrade",
+			CheckRemediation: "Set spec.predictor.minReplicas to 2 or more and create a PodDisruptionBudget selecting the predictor pods, or schedule a maintenance window for affected model endpoints",
+			CheckResources: []resources.ResourceType{
+				resources.InferenceService,
+				resources.PodDisruptionBudget,
+			},
+			CheckVersions: check.VersionsUpgrade2xTo3x,
+		},
+	}
+}
+
+// Validate lists InferenceServices and reports those with a single unprotected predictor replica.
+func (c *SingleReplicaCheck) Validate(
+	ctx context.Context,
+	target check.Target,
+) (*result.DiagnosticResult, error) {
+	budgets, err := kube.ListDisruptionBudgets(ctx, target.Client)
+	if err != nil {
+		return nil, err
+	}
+
+	return validate.Workloads(c, target, resources.InferenceService).
+		ForComponent(constants.ComponentKServe).
+		MetadataFilter(func(isvc metav1.Object) bool {
+			return isvc.GetAnnotations()[annotationInferenceServiceStop] != "true"
+		}).
+		Filter(func(isvc *unstructured.Unstructured) (bool, error) {
+			return isSingleReplicaUnprotected(isvc, budgets)
+		}).
+		Complete(ctx, c.newSingleReplicaCondition)
+}
+
+func (c *SingleReplicaCheck) newSingleReplicaCondition(
+	_ context.Context,
+	req *validate.WorkloadRequest[*unstructured.Unstructured],
+) ([]result.Condition, error) {
+	if len(req.Items) == 0 {
+		return []result.Condition{check.NewCondition(
+			ConditionTypeISVCDisruptionTolerant,
+			metav1.ConditionTrue,
+			check.WithReason(check.ReasonRequirementsMet),
+			check.WithCatalogMessage(msgNoSingleReplicaISVCs),
+		)}, nil
+	}
+
+	return []result.Condition{check.NewCondition(
+		ConditionTypeISVCDisruptionTolerant,
+		metav1.ConditionFalse,
+		check.WithReason(check.ReasonWorkloadsImpacted),
+		check.WithCatalogMessage(msgSingleReplicaISVCs, len(req.Items), version.MajorMinorLabel(req.TargetVersion)),
+		check.WithImpact(result.ImpactAdvisory),
+		check.WithRemediation(c.CheckRemediation),
+	)}, nil
+}
+
+// isSingleReplicaUnprotected returns true when a running InferenceService keeps at most one
+// predictor replica (KServe defaults minReplicas to 1) and no PodDisruptionBudget selects its pods.
+func isSingleReplicaUnprotected(isvc *unstructured.Unstructured, budgets kube.DisruptionBudgets) (bool, error) {
+	if isvc.GetAnnotations()[annotationInferenceServiceStop] == "true" {
+		return false, nil
+	}
+
+	minReplicas, err := jq.Query[int64](isvc, ".spec.predictor.minReplicas")
+	switch {
+	case errors.Is(err, jq.ErrNotFound):
+		minReplicas = 1
+	case err != nil:
+		return false, fmt.Errorf("querying minReplicas of InferenceService %s/%s: %w", isvc.GetNamespace(), isvc.GetName(), err)
+	}
+
+	if minReplicas > 1 {
+		return false, nil
+	}
+
+	return !budgets.Covers(isvc.GetNamespace(), labels.Set{labelInferenceService: isvc.GetName()}), nil
+}
@@ -0,0 +1,151 @@
+package kserve_test
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/testutil"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/workloads/kserve"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+)
+
+//nolint:gochecknoglobals // Test fixture - shared across test functions
+var singleReplicaListKinds = map[schema.GroupVersionResource]string{
+	resources.DataScienceCluster.GVR():  resources.DataScienceCluster.ListKind(),
+	resources.InferenceService.GVR():    resources.InferenceService.ListKind(),
+	resources.PodDisruptionBudget.GVR(): resources.PodDisruptionBudget.ListKind(),
+}
+
+func newReplicaISVC(name string, minReplicas any, annotations map[string]any) *unstructured.Unstructured {
+	predictor := map[string]any{}
+	if minReplicas != nil {
+		predictor["minReplicas"] = minReplicas
+	}
+
+	metadata := map[string]any{
+		"name":      name,
+		"namespace": "models",
+	}
+	if annotations != nil {
+		metadata["annotations"] = annotations
+	}
+
+	return &unstructured.Unstructured{
+		Object: map[string]any{
+			"apiVersion": resources.InferenceService.APIVersion(),
+			"kind":       resources.InferenceService.Kind,
+			"metadata":   metadata,
+			"spec":       map[string]any{"predictor": predictor},
+		},
+	}
+}
+
+func newISVCDisruptionBudget(isvcName string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{
+		Object: map[string]any{
+			"apiVersion": resources.PodDisruptionBudget.APIVersion(),
+			"kind":       resources.PodDisruptionBudget.Kind,
+			"metadata": map[string]any{
+				"name":      isvcName + "-pdb",
+				"namespace": "models",
+			},
+			"spec": map[string]any{
+				"maxUnavailable": int64(0),
+				"selector": map[string]any{
+					"matchLabels": map[string]any{"serving.kserve.io/inferenceservice": isvcName},
+				},
+			},
+		},
+	}
+}
+
+func TestSingleReplicaCheck_CanApply(t *testing.T) {
+	g := NewWithT(t)
+
+	chk := kserve.NewSingleReplicaCheck()
+
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds:      singleReplicaListKinds,
+		CurrentVersion: "2.17.0",
+		TargetVersion:  "3.0.0",
+	})
+	canApply, err := chk.CanApply(t.Context(), target)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(canApply).To(BeTrue())
+
+	target = testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds:      singleReplicaListKinds,
+		CurrentVersion: "3.0.0",
+		TargetVersion:  "3.1.0",
+	})
+	canApply, err = chk.CanApply(t.Context(), target)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(canApply).To(BeFalse())
+}
+
+func TestSingleReplicaCheck_NoneAtRisk(t *testing.T) {
+	g := NewWithT(t)
+
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds: singleReplicaListKinds,
+		Objects: []*unstructured.Unstructured{
+			testutil.NewDSC(map[string]string{"kserve": "Managed"}),
+			newReplicaISVC("replicated", int64(2), nil),
+			newReplicaISVC("protected", nil, nil),
+			newISVCDisruptionBudget("protected"),
+			newReplicaISVC("stopped", nil, map[string]any{"serving.kserve.io/stop": "true"}),
+		},
+		CurrentVersion: "2.17.0",
+		TargetVersion:  "3.0.0",
+	})
+
+	dr, err := kserve.NewSingleReplicaCheck().Validate(t.Context(), target)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(dr.Status.Conditions).To(HaveLen(1))
+	g.Expect(dr.Status.Conditions[0].Condition).To(MatchFields(IgnoreExtras, Fields{
+		"Type":   Equal(kserve.ConditionTypeISVCDisruptionTolerant),
+		"Status": Equal(metav1.ConditionTrue),
+		"Reason": Equal(check.ReasonRequirementsMet),
+	}))
+	g.Expect(dr.ImpactedObjects).To(BeEmpty())
+}
+
+func TestSingleReplicaCheck_SingleReplicaWithoutBudget(t *testing.T) {
+	g := NewWithT(t)
+
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds: singleReplicaListKinds,
+		Objects: []*unstructured.Unstructured{
+			testutil.NewDSC(map[string]string{"kserve": "Managed"}),
+			newReplicaISVC("default-replicas", nil, nil),
+			newReplicaISVC("one-replica", int64(1), nil),
+			newReplicaISVC("replicated", int64(3), nil),
+			// A budget for another InferenceService does not protect these.
+			newISVCDisruptionBudget("other"),
+		},
+		CurrentVersion: "2.17.0",
+		TargetVersion:  "3.0.0",
+	})
+
+	dr, err := kserve.NewSingleReplicaCheck().Validate(t.Context(), target)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(dr.Status.Conditions).To(HaveLen(1))
+	g.Expect(dr.Status.Conditions[0].Condition).To(MatchFields(IgnoreExtras, Fields{
+		"Status":  Equal(metav1.ConditionFalse),
+		"Reason":  Equal(check.ReasonWorkloadsImpacted),
+		"Message": ContainSubstring("Found 2 InferenceService(s) running a single replica"),
+	}))
+	g.Expect(dr.Status.Conditions[0].Impact).To(Equal(result.ImpactAdvisory))
+	g.Expect(dr.Annotations).To(HaveKeyWithValue(check.AnnotationImpactedWorkloadCount, "2"))
+	g.Expect(dr.ImpactedObjects).To(HaveLen(2))
+}
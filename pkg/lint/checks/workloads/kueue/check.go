@@ -3,6 +3,7 @@ package kueue
 import (
 	"context"
 	"fmt"
+	"slices"
 	"strconv"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -12,6 +13,7 @@ import (
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
 	kueuediscovery "github.com/opendatahub-io/odh-cli/pkg/lint/checks/kueue/discovery"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
 	"github.com/opendatahub-io/odh-cli/pkg/util/client"
 )
 
@@ -32,9 +34,13 @@ func NewDataIntegrityCheck() *DataIntegrityCheck {
 			Kind:             constants.ComponentKueue,
 			Type:             check.CheckTypeDataIntegrity,
 			CheckID:          "workloads.kueue.data-integrity",
+			CheckTags:        []string{check.TagBlockingOnly},
 			CheckName:        "Workloads :: Kueue :: Data Integrity",
 			CheckDescription: "Verifies that kueue namespace labels and workload queue-name labels are consistent across the cluster",
 			CheckRemediation: remediationConsistency,
+			CheckResources:   slices.Concat([]resources.ResourceType{resources.Namespace}, kueuediscovery.MonitoredWorkloadTypes, intermediateTypes),
+			CheckVersions:    check.VersionsAny,
+			CheckDependsOn:   []string{check.CheckIDDSCReadiness},
 		},
 	}
 }
@@ -81,7 +87,7 @@ func (c *DataIntegrityCheck) Validate(
 			conditionTypeKueueConsistency,
 			metav1.ConditionTrue,
 			check.WithReason(check.ReasonRequirementsMet),
-			check.WithMessage(msgNoRelevantNamespaces),
+			check.WithCatalogMessage(msgNoRelevantNamespaces),
 		))
 		dr.Annotations[check.AnnotationImpactedWorkloadCount] = "0"
 
@@ -109,7 +115,7 @@ func (c *DataIntegrityCheck) Validate(
 			conditionTypeKueueConsistency,
 			metav1.ConditionTrue,
 			check.WithReason(check.ReasonRequirementsMet),
-			check.WithMessage(msgConsistent),
+			check.WithCatalogMessage(msgConsistent),
 		))
 
 		return dr, nil
@@ -119,7 +125,7 @@ func (c *DataIntegrityCheck) Validate(
 		conditionTypeKueueConsistency,
 		metav1.ConditionFalse,
 		check.WithReason(check.ReasonConfigurationInvalid),
-		check.WithMessage(msgInconsistent, len(violations)),
+		check.WithCatalogMessage(msgInconsistent, len(violations)),
 		check.WithImpact(result.ImpactProhibited),
 		check.WithRemediation(c.CheckRemediation),
 	))
@@ -0,0 +1,255 @@
+package kueue
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/opendatahub-io/odh-cli/pkg/constants"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/compat"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+	"github.com/opendatahub-io/odh-cli/pkg/util/inspect"
+	"github.com/opendatahub-io/odh-cli/pkg/util/jq"
+	"github.com/opendatahub-io/odh-cli/pkg/util/version"
+)
+
+// QueueConfigCheck detects ClusterQueue and LocalQueue configuration that the Kueue version
+// shipped with RHOAI 3.x no longer accepts: queue CRDs still storing removed API versions, and
+// ClusterQueues using fields or values removed from the Kueue API, both as listed by the
+// compatibility matrix. LocalQueues feeding a flagged ClusterQueue are reported alongside it
+// since their workloads can no longer be admitted.
+type QueueConfigCheck struct {
+	check.BaseCheck
+	check.EnhancedVerboseFormatter
+}
+
+func NewQueueConfigCheck() *QueueConfigCheck {
+	return &QueueConfigCheck{
+		BaseCheck: check.BaseCheck{
+			CheckGroup:       check.GroupWorkload,
+			Kind:             constants.ComponentKueue,
+			Type:             check.CheckTypeConfigMigration,
+			CheckID:          "workloads.kueue.queue-config-migration",
+			CheckName:        "Workloads :: Kueue :: Queue Config Migration (3.x)",
+			CheckDescription: "Detects ClusterQueue and LocalQueue configuration incompatible with the Kueue version shipped in RHOAI 3.x",
+			CheckRemediation: remediationQueueConfig,
+			CheckResources: []resources.ResourceType{
+				resources.ClusterQueue,
+				resources.LocalQueue,
+				resources.CustomResourceDefinition,
+			},
+			CheckVersions:        check.VersionsUpgrade2xTo3x,
+			CheckComponents:      []string{constants.ComponentKueue},
+			CheckComponentStates: []string{constants.ManagementStateManaged, constants.ManagementStateUnmanaged},
+			CheckDependsOn:       []string{check.CheckIDDSCReadiness},
+		},
+	}
+}
+
+// Validate inspects queue CRD stored versions, then every ClusterQueue and the LocalQueues
+// pointing at it.
+func (c *QueueConfigCheck) Validate(
+	ctx context.Context,
+	target check.Target,
+) (*result.DiagnosticResult, error) {
+	dr := c.NewResult()
+	tv := version.MajorMinorLabel(target.TargetVersion)
+
+	if target.TargetVersion != nil {
+		dr.Annotations[check.AnnotationCheckTargetVersion] = target.TargetVersion.String()
+	}
+
+	staleCRDs, err := queueCRDsWithRemovedVersions(ctx, target)
+	if err != nil {
+		return nil, err
+	}
+
+	removedFields := target.Compat().RemovedFields(target.TargetVersion,
+		resources.ClusterQueue.Group, resources.ClusterQueue.Resource)
+
+	clusterQueues, err := target.Client.List(ctx, resources.ClusterQueue)
+	if err != nil && !client.IsResourceTypeNotFound(err) {
+		return nil, fmt.Errorf("listing ClusterQueues: %w", err)
+	}
+
+	flaggedQueues := make([]types.NamespacedName, 0)
+	flaggedNames := sets.New[string]()
+
+	for _, cq := range clusterQueues {
+		deprecated, err := hasRemovedFields(cq, removedFields)
+		if err != nil {
+			return nil, fmt.Errorf("inspecting ClusterQueue %s: %w", cq.GetName(), err)
+		}
+
+		if deprecated {
+			flaggedQueues = append(flaggedQueues, types.NamespacedName{Name: cq.GetName()})
+			flaggedNames.Insert(cq.GetName())
+		}
+	}
+
+	localQueues, err := localQueuesFor(ctx, target.Client, flaggedNames)
+	if err != nil {
+		return nil, err
+	}
+
+	dr.Annotations[check.AnnotationImpactedWorkloadCount] = strconv.Itoa(len(flaggedQueues) + len(localQueues))
+
+	if len(staleCRDs) == 0 && len(flaggedQueues) == 0 {
+		dr.SetCondition(check.NewCondition(
+			check.ConditionTypeCompatible,
+			metav1.ConditionTrue,
+			check.WithReason(check.ReasonVersionCompatible),
+			check.WithCatalogMessage(msgQueueConfigCompatible, tv),
+		))
+
+		return dr, nil
+	}
+
+	var problems []string
+	if len(staleCRDs) > 0 {
+		problems = append(problems, fmt.Sprintf(msgQueueStoredVersions, strings.Join(staleCRDs, ", ")))
+	}
+
+	if len(flaggedQueues) > 0 {
+		problems = append(problems, fmt.Sprintf(msgQueueDeprecatedFields, len(flaggedQueues), len(localQueues)))
+	}
+
+	// Stored removed versions make the CRD upgrade fail; removed fields only need rewriting.
+	impact := result.ImpactAdvisory
+	if len(staleCRDs) > 0 {
+		impact = result.ImpactBlocking
+	}
+
+	dr.SetCondition(check.NewCondition(
+		check.ConditionTypeCompatible,
+		metav1.ConditionFalse,
+		check.WithReason(check.ReasonVersionIncompatible),
+		check.WithCatalogMessage(msgQueueConfigIncompatible, tv, strings.Join(problems, "; ")),
+		check.WithImpact(impact),
+		check.WithRemediation(c.CheckRemediation),
+	))
+
+	if len(flaggedQueues) > 0 {
+		dr.AddImpactedObjects(resources.ClusterQueue, flaggedQueues)
+	}
+
+	if len(localQueues) > 0 {
+		dr.AddImpactedObjects(resources.LocalQueue, localQueues)
+	}
+
+	return dr, nil
+}
+
+// queueCRDsWithRemovedVersions describes the queue CRDs whose status.storedVersions still include
+// an API version removed by the target release, with the removed versions they store.
+func queueCRDsWithRemovedVersions(ctx context.Context, target check.Target) ([]string, error) {
+	var stale []string
+
+	for _, rt := range []resources.ResourceType{resources.ClusterQueue, resources.LocalQueue} {
+		removedVersions := target.Compat().RemovedAPIVersions(target.TargetVersion, rt.Group, rt.Resource)
+		if len(removedVersions) == 0 {
+			continue
+		}
+
+		crdName := rt.Resource + "." + rt.Group
+
+		crd, err := target.Client.GetResource(ctx, resources.CustomResourceDefinition, crdName)
+
+		switch {
+		case apierrors.IsNotFound(err):
+			continue
+		case err != nil:
+			return nil, fmt.Errorf("getting CRD %s: %w", crdName, err)
+		case crd == nil:
+			continue
+		}
+
+		storedVersions, err := jq.Query[[]any](crd, ".status.storedVersions")
+		if err != nil && !errors.Is(err, jq.ErrNotFound) {
+			return nil, fmt.Errorf("querying status.storedVersions for CRD %s: %w", crdName, err)
+		}
+
+		var removed []string
+
+		for _, v := range storedVersions {
+			if s, ok := v.(string); ok && slices.Contains(removedVersions, s) && !slices.Contains(removed, s) {
+				removed = append(removed, s)
+			}
+		}
+
+		if len(removed) > 0 {
+			stale = append(stale, fmt.Sprintf("%s (%s)", crdName, strings.Join(removed, ", ")))
+		}
+	}
+
+	return stale, nil
+}
+
+// hasRemovedFields reports whether the ClusterQueue sets one of the removed fields, or one of
+// their removed values.
+func hasRemovedFields(cq *unstructured.Unstructured, removedFields []compat.RemovedField) (bool, error) {
+	for _, field := range removedFields {
+		if len(field.Values) == 0 {
+			found, err := inspect.HasFields(cq, field.Path)
+			if err != nil {
+				return false, err
+			}
+
+			if len(found) > 0 {
+				return true, nil
+			}
+
+			continue
+		}
+
+		value, err := jq.Query[string](cq, field.Path)
+		if err != nil && !errors.Is(err, jq.ErrNotFound) {
+			return false, fmt.Errorf("querying %s: %w", field.Path, err)
+		}
+
+		if slices.Contains(field.Values, value) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// localQueuesFor lists LocalQueues whose spec.clusterQueue is one of the given ClusterQueue names.
+func localQueuesFor(
+	ctx context.Context,
+	r client.Reader,
+	clusterQueues sets.Set[string],
+) ([]types.NamespacedName, error) {
+	if clusterQueues.Len() == 0 {
+		return nil, nil
+	}
+
+	localQueues, err := r.List(ctx, resources.LocalQueue)
+	if err != nil && !client.IsResourceTypeNotFound(err) {
+		return nil, fmt.Errorf("listing LocalQueues: %w", err)
+	}
+
+	var out []types.NamespacedName
+
+	for _, lq := range localQueues {
+		cq, _, _ := unstructured.NestedString(lq.Object, "spec", "clusterQueue")
+		if clusterQueues.Has(cq) {
+			out = append(out, types.NamespacedName{Namespace: lq.GetNamespace(), Name: lq.GetName()})
+		}
+	}
+
+	return out, nil
+}
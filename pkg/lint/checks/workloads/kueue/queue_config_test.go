@@ -0,0 +1,216 @@
+package kueue_test
+
+import (
+	"strings"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	resultpkg "github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/testutil"
+	kueuecheck "github.com/opendatahub-io/odh-cli/pkg/lint/checks/workloads/kueue"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/compat"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+)
+
+//nolint:gochecknoglobals // Test fixture - shared across test functions.
+var queueListKinds = map[schema.GroupVersionResource]string{
+	resources.DataScienceCluster.GVR():       resources.DataScienceCluster.ListKind(),
+	resources.ClusterQueue.GVR():             resources.ClusterQueue.ListKind(),
+	resources.LocalQueue.GVR():               resources.LocalQueue.ListKind(),
+	resources.CustomResourceDefinition.GVR(): resources.CustomResourceDefinition.ListKind(),
+}
+
+func newClusterQueue(name string, spec map[string]any) *unstructured.Unstructured {
+	return &unstructured.Unstructured{
+		Object: map[string]any{
+			"apiVersion": resources.ClusterQueue.APIVersion(),
+			"kind":       resources.ClusterQueue.Kind,
+			"metadata":   map[string]any{"name": name},
+			"spec":       spec,
+		},
+	}
+}
+
+func newLocalQueue(namespace string, name string, clusterQueue string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{
+		Object: map[string]any{
+			"apiVersion": resources.LocalQueue.APIVersion(),
+			"kind":       resources.LocalQueue.Kind,
+			"metadata":   map[string]any{"name": name, "namespace": namespace},
+			"spec":       map[string]any{"clusterQueue": clusterQueue},
+		},
+	}
+}
+
+func newQueueCRD(rt resources.ResourceType, storedVersions ...any) *unstructured.Unstructured {
+	return &unstructured.Unstructured{
+		Object: map[string]any{
+			"apiVersion": resources.CustomResourceDefinition.APIVersion(),
+			"kind":       resources.CustomResourceDefinition.Kind,
+			"metadata":   map[string]any{"name": rt.Resource + "." + rt.Group},
+			"status":     map[string]any{"storedVersions": storedVersions},
+		},
+	}
+}
+
+func TestQueueConfigCheck_CanApply(t *testing.T) {
+	g := NewWithT(t)
+
+	chk := kueuecheck.NewQueueConfigCheck()
+
+	for state, expected := range map[string]bool{"Managed": true, "Unmanaged": true, "Removed": false} {
+		target := testutil.NewTarget(t, testutil.TargetConfig{
+			ListKinds:      queueListKinds,
+			Objects:        []*unstructured.Unstructured{testutil.NewDSC(map[string]string{"kueue": state})},
+			CurrentVersion: "2.17.0",
+			TargetVersion:  "3.0.0",
+		})
+
+		canApply, err := chk.CanApply(t.Context(), target)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(canApply).To(Equal(expected), "state %s", state)
+	}
+}
+
+func TestQueueConfigCheck_Compatible(t *testing.T) {
+	g := NewWithT(t)
+
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds: queueListKinds,
+		Objects: []*unstructured.Unstructured{
+			newQueueCRD(resources.ClusterQueue, "v1beta1"),
+			newClusterQueue("cq", map[string]any{
+				"cohortName":        "team",
+				"flavorFungibility": map[string]any{"whenCanBorrow": "MayStopSearch"},
+			}),
+			newLocalQueue("ns1", "lq", "cq"),
+		},
+		CurrentVersion: "2.17.0",
+		TargetVersion:  "3.0.0",
+	})
+
+	dr, err := kueuecheck.NewQueueConfigCheck().Validate(t.Context(), target)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(dr.Status.Conditions).To(HaveLen(1))
+	g.Expect(dr.Status.Conditions[0].Condition).To(MatchFields(IgnoreExtras, Fields{
+		"Type":   Equal(check.ConditionTypeCompatible),
+		"Status": Equal(metav1.ConditionTrue),
+		"Reason": Equal(check.ReasonVersionCompatible),
+	}))
+	g.Expect(dr.Annotations).To(HaveKeyWithValue(check.AnnotationImpactedWorkloadCount, "0"))
+	g.Expect(dr.ImpactedObjects).To(BeEmpty())
+}
+
+func TestQueueConfigCheck_DeprecatedFields(t *testing.T) {
+	g := NewWithT(t)
+
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds: queueListKinds,
+		Objects: []*unstructured.Unstructured{
+			newClusterQueue("cohort-cq", map[string]any{"cohort": "team"}),
+			newClusterQueue("borrow-cq", map[string]any{
+				"flavorFungibility": map[string]any{"whenCanBorrow": "Borrow"},
+			}),
+			newClusterQueue("clean-cq", map[string]any{"cohortName": "team"}),
+			newLocalQueue("ns1", "lq-1", "cohort-cq"),
+			newLocalQueue("ns2", "lq-2", "clean-cq"),
+		},
+		CurrentVersion: "2.17.0",
+		TargetVersion:  "3.0.0",
+	})
+
+	dr, err := kueuecheck.NewQueueConfigCheck().Validate(t.Context(), target)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(dr.Status.Conditions).To(HaveLen(1))
+	g.Expect(dr.Status.Conditions[0].Condition).To(MatchFields(IgnoreExtras, Fields{
+		"Type":    Equal(check.ConditionTypeCompatible),
+		"Status":  Equal(metav1.ConditionFalse),
+		"Reason":  Equal(check.ReasonVersionIncompatible),
+		"Message": ContainSubstring("2 ClusterQueue(s) using removed fields (1 LocalQueue(s) affected)"),
+	}))
+	g.Expect(dr.Status.Conditions[0].Impact).To(Equal(resultpkg.ImpactAdvisory))
+	g.Expect(dr.Annotations).To(HaveKeyWithValue(check.AnnotationImpactedWorkloadCount, "3"))
+	g.Expect(dr.ImpactedObjects).To(HaveLen(3))
+	g.Expect(dr.ImpactedObjects[2].Kind).To(Equal(resources.LocalQueue.Kind))
+	g.Expect(dr.ImpactedObjects[2].Name).To(Equal("lq-1"))
+}
+
+func TestQueueConfigCheck_StoredAlphaVersion(t *testing.T) {
+	g := NewWithT(t)
+
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds: queueListKinds,
+		Objects: []*unstructured.Unstructured{
+			newQueueCRD(resources.ClusterQueue, "v1alpha1", "v1beta1"),
+			newQueueCRD(resources.LocalQueue, "v1beta1"),
+		},
+		CurrentVersion: "2.17.0",
+		TargetVersion:  "3.0.0",
+	})
+
+	dr, err := kueuecheck.NewQueueConfigCheck().Validate(t.Context(), target)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(dr.Status.Conditions).To(HaveLen(1))
+	g.Expect(dr.Status.Conditions[0].Condition).To(MatchFields(IgnoreExtras, Fields{
+		"Status":  Equal(metav1.ConditionFalse),
+		"Message": ContainSubstring("clusterqueues.kueue.x-k8s.io"),
+	}))
+	g.Expect(dr.Status.Conditions[0].Impact).To(Equal(resultpkg.ImpactBlocking))
+}
+
+func TestQueueConfigCheck_CompatMatrixOverride(t *testing.T) {
+	g := NewWithT(t)
+
+	matrix, err := compat.Parse(strings.NewReader(`
+releases:
+  - version: "3.0"
+    removedAPIs:
+      - group: kueue.x-k8s.io
+        resource: clusterqueues
+        versions: [v1beta1]
+    removedFields:
+      - group: kueue.x-k8s.io
+        resource: clusterqueues
+        path: .spec.queueingStrategy
+        values: [StrictFIFO]
+`))
+	g.Expect(err).ToNot(HaveOccurred())
+
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds: queueListKinds,
+		Objects: []*unstructured.Unstructured{
+			newQueueCRD(resources.ClusterQueue, "v1beta1"),
+			newClusterQueue("strict-cq", map[string]any{"queueingStrategy": "StrictFIFO"}),
+			// Not removed by the override matrix
+			newClusterQueue("cohort-cq", map[string]any{"cohort": "team"}),
+		},
+		CurrentVersion: "2.17.0",
+		TargetVersion:  "3.0.0",
+	})
+	target.CompatMatrix = matrix
+
+	dr, err := kueuecheck.NewQueueConfigCheck().Validate(t.Context(), target)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(dr.Status.Conditions).To(HaveLen(1))
+	g.Expect(dr.Status.Conditions[0].Condition).To(MatchFields(IgnoreExtras, Fields{
+		"Status": Equal(metav1.ConditionFalse),
+		"Message": And(
+			ContainSubstring("clusterqueues.kueue.x-k8s.io (v1beta1)"),
+			ContainSubstring("1 ClusterQueue(s) using removed fields"),
+		),
+	}))
+	g.Expect(dr.Status.Conditions[0].Impact).To(Equal(resultpkg.ImpactBlocking))
+	g.Expect(dr.ImpactedObjects).To(HaveLen(1))
+	g.Expect(dr.ImpactedObjects[0].Name).To(Equal("strict-cq"))
+}
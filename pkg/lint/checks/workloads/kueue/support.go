@@ -6,11 +6,20 @@ import (
 
 	"github.com/opendatahub-io/odh-cli/pkg/constants"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/messages"
 	"github.com/opendatahub-io/odh-cli/pkg/resources"
 	"github.com/opendatahub-io/odh-cli/pkg/util/client"
 	"github.com/opendatahub-io/odh-cli/pkg/util/components"
 )
 
+// Messages of the queue config migration check.
+//
+//nolint:gochecknoglobals // Message catalog entries
+var (
+	msgQueueConfigCompatible   = messages.New("workloads.kueue.queue-config-migration.compatible", "All ClusterQueues and LocalQueues are compatible with the Kueue version shipped in RHOAI %s")
+	msgQueueConfigIncompatible = messages.New("workloads.kueue.queue-config-migration.incompatible", "Kueue queue configuration is incompatible with the Kueue version shipped in RHOAI %s: %s")
+)
+
 // Intermediate resource types used to build the ownership graph.
 // These appear in ownership chains between top-level CRs and Pods.
 //
@@ -38,10 +47,12 @@ const (
 )
 
 // Messages for the consolidated KueueConsistency condition.
-const (
-	msgConsistent           = "All monitored workloads are consistent with kueue namespace configuration"
-	msgNoRelevantNamespaces = "No kueue-managed namespaces or kueue-labeled workloads found"
-	msgInconsistent         = "Found %d kueue consistency violation(s) across monitored workloads"
+//
+//nolint:gochecknoglobals // Message catalog entries
+var (
+	msgConsistent           = messages.New("workloads.kueue.data-integrity.consistent", "All monitored workloads are consistent with kueue namespace configuration")
+	msgNoRelevantNamespaces = messages.New("workloads.kueue.data-integrity.no-relevant-namespaces", "No kueue-managed namespaces or kueue-labeled workloads found")
+	msgInconsistent         = messages.New("workloads.kueue.data-integrity.inconsistent", "Found %d kueue consistency violation(s) across monitored workloads")
 )
 
 // Messages for individual violation descriptions.
@@ -58,6 +69,16 @@ const (
 	msgInvariant3Mismatch   = "%s %s/%s has kueue.x-k8s.io/queue-name=%s but root %s %s/%s has kueue.x-k8s.io/queue-name=%s"
 )
 
+// Remediation and messages for the queue config migration check.
+const (
+	remediationQueueConfig = "Migrate stored ClusterQueue/LocalQueue objects to v1beta1 and remove alpha versions from the CRD storedVersions, " +
+		"rename spec.cohort to spec.cohortName, replace spec.admissionChecks with spec.admissionChecksStrategy, " +
+		"and replace Borrow/Preempt flavorFungibility policies with MayStopSearch before upgrading"
+
+	msgQueueStoredVersions   = "CRDs storing removed API versions: %s"
+	msgQueueDeprecatedFields = "%d ClusterQueue(s) using removed fields (%d LocalQueue(s) affected)"
+)
+
 // IsKueueUnmanaged returns true when Kueue managementState is Unmanaged on the DSC.
 // Data integrity checks only apply when the user manages Kueue themselves (Unmanaged state).
 func IsKueueUnmanaged(
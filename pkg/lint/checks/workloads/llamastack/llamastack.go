@@ -2,19 +2,24 @@ package llamastack
 
 import (
 	"context"
-	"fmt"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
-	"github.com/opendatahub-io/odh-cli/pkg/constants"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/messages"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check/validate"
 	"github.com/opendatahub-io/odh-cli/pkg/resources"
-	"github.com/opendatahub-io/odh-cli/pkg/util/client"
-	"github.com/opendatahub-io/odh-cli/pkg/util/components"
-	"github.com/opendatahub-io/odh-cli/pkg/util/version"
+)
+
+// Messages of the LlamaStackDistribution config check.
+//
+//nolint:gochecknoglobals // Message catalog entries
+var (
+	msgNoDistributions    = messages.New("workloads.llamastack.config.none", "No LlamaStackDistribution resources found - upgrade can proceed without LlamaStack-specific actions")
+	msgRecreationRequired = messages.New("workloads.llamastack.config.recreation-required", "Found %d LlamaStackDistribution(s) that must be deleted and recreated after RHOAI 3.3+ upgrade. In-place upgrade is not supported. ALL DATA WILL BE LOST - Archive data before upgrade.")
+	remediationRecreation = messages.New("workloads.llamastack.config.recreation-required.remediation", "1. Run 'kubectl odh migrate prepare' to back up existing LlamaStack configurations and pod data. 2. Coordinate with LLSD owners about data loss and recreation requirements. 3. After RHOAI 3.3+ upgrade, delete old LLSDs and create new ones following RHOAI 3.3+ documentation.")
 )
 
 const (
@@ -37,27 +42,18 @@ func NewConfigCheck() *ConfigCheck {
 			Kind:             kind,
 			Type:             "config",
 			CheckID:          "workloads.llamastack.config",
+			CheckTags:        []string{check.TagBlockingOnly},
 			CheckName:        "Workloads :: LlamaStack :: Upgrade Preparation (2.x to 3.3+)",
 			CheckDescription: "Identifies LlamaStackDistribution resources that require deletion and recreation for RHOAI 3.3+ upgrade",
 			CheckRemediation: "Run 'kubectl odh migrate prepare' to back up LlamaStack resources, coordinate with owners about data loss, then delete and recreate LlamaStackDistributions after upgrade following RHOAI 3.3+ documentation",
+			CheckResources:   []resources.ResourceType{resources.LlamaStackDistribution},
+			CheckVersions:    check.VersionsUpgrade2xTo3x,
+			CheckComponents:  []string{"llamastackoperator"},
+			CheckDependsOn:   []string{check.CheckIDDSCReadiness},
 		},
 	}
 }
 
-// CanApply returns whether this check should run for the given target.
-func (c *ConfigCheck) CanApply(ctx context.Context, target check.Target) (bool, error) {
-	if !version.IsUpgradeFrom2xTo3x(target.CurrentVersion, target.TargetVersion) {
-		return false, nil
-	}
-
-	dsc, err := client.GetDataScienceCluster(ctx, target.Client)
-	if err != nil {
-		return false, fmt.Errorf("getting DataScienceCluster: %w", err)
-	}
-
-	return components.HasManagementState(dsc, "llamastackoperator", constants.ManagementStateManaged), nil
-}
-
 // Validate executes the check against the provided target.
 func (c *ConfigCheck) Validate(
 	ctx context.Context,
@@ -115,7 +111,7 @@ func newNoWorkloadsCondition() result.Condition {
 		ConditionTypeRequiresRecreation,
 		metav1.ConditionTrue,
 		check.WithReason(check.ReasonResourceNotFound),
-		check.WithMessage("No LlamaStackDistribution resources found - upgrade can proceed without LlamaStack-specific actions"),
+		check.WithCatalogMessage(msgNoDistributions),
 	)
 }
 
@@ -127,9 +123,9 @@ func (c *ConfigCheck) buildConditions(totalCount int) []result.Condition {
 		ConditionTypeRequiresRecreation,
 		metav1.ConditionFalse,
 		check.WithReason("ArchitecturalIncompatibility"),
-		check.WithMessage("Found %d LlamaStackDistribution(s) that must be deleted and recreated after RHOAI 3.3+ upgrade. In-place upgrade is not supported. ALL DATA WILL BE LOST - Archive data before upgrade.", totalCount),
+		check.WithCatalogMessage(msgRecreationRequired, totalCount),
 		check.WithImpact(result.ImpactBlocking),
-		check.WithRemediation("1. Run 'kubectl odh migrate prepare' to back up existing LlamaStack configurations and pod data. 2. Coordinate with LLSD owners about data loss and recreation requirements. 3. After RHOAI 3.3+ upgrade, delete old LLSDs and create new ones following RHOAI 3.3+ documentation."),
+		check.WithCatalogRemediation(remediationRecreation),
 	))
 
 	return conditions
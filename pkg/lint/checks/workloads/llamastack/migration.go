@@ -2,19 +2,23 @@ package llamastack
 
 import (
 	"context"
-	"fmt"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
-	"github.com/opendatahub-io/odh-cli/pkg/constants"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/messages"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check/validate"
 	"github.com/opendatahub-io/odh-cli/pkg/resources"
-	"github.com/opendatahub-io/odh-cli/pkg/util/client"
-	"github.com/opendatahub-io/odh-cli/pkg/util/components"
-	"github.com/opendatahub-io/odh-cli/pkg/util/version"
+)
+
+// Messages of the LlamaStackDistribution migration check.
+//
+//nolint:gochecknoglobals // Message catalog entries
+var (
+	msgNoDistributionsToMigrate = messages.New("workloads.llamastack.migration.none", "No LlamaStackDistribution resources found - no CR migration needed for 3.5 upgrade")
+	msgDistributionsToMigrate   = messages.New("workloads.llamastack.migration.found", "Found %d LlamaStackDistribution(s) that must be migrated to OGXServer v1beta1 after RHOAI 3.5 upgrade. The LlamaStackDistribution CRD is removed in 3.5 and replaced by OGXServer.")
 )
 
 const (
@@ -34,28 +38,18 @@ func NewMigrationCheck() *MigrationCheck {
 			Kind:             kind,
 			Type:             "migration",
 			CheckID:          "workloads.llamastack.migration",
+			CheckTags:        []string{check.TagBlockingOnly},
 			CheckName:        "Workloads :: LlamaStack :: CR Migration (3.4 to 3.5)",
 			CheckDescription: "Identifies LlamaStackDistribution resources that must be migrated to OGXServer v1beta1 for RHOAI 3.5 upgrade",
 			CheckRemediation: "Back up LlamaStack resources using 'odh-cli migrate prepare --migration llamastack.backup', then recreate as OGXServer v1beta1 CRs after upgrade following the OGX migration guide",
+			CheckResources:   []resources.ResourceType{resources.LlamaStackDistribution},
+			CheckVersions:    check.VersionsUpgrade34To35,
+			CheckComponents:  []string{"llamastackoperator"},
+			CheckDependsOn:   []string{check.CheckIDDSCReadiness},
 		},
 	}
 }
 
-// CanApply returns whether this check should run for the given target.
-// This check only applies when upgrading FROM 3.4.x TO 3.5.x and LlamaStack Operator is Managed.
-func (c *MigrationCheck) CanApply(ctx context.Context, target check.Target) (bool, error) {
-	if !version.IsUpgradeFrom34To35(target.CurrentVersion, target.TargetVersion) {
-		return false, nil
-	}
-
-	dsc, err := client.GetDataScienceCluster(ctx, target.Client)
-	if err != nil {
-		return false, fmt.Errorf("getting DataScienceCluster: %w", err)
-	}
-
-	return components.HasManagementState(dsc, "llamastackoperator", constants.ManagementStateManaged), nil
-}
-
 // Validate executes the check against the provided target.
 func (c *MigrationCheck) Validate(
 	ctx context.Context,
@@ -76,7 +70,7 @@ func (c *MigrationCheck) validateDistributions(
 			ConditionTypeRequiresMigration,
 			metav1.ConditionTrue,
 			check.WithReason(check.ReasonResourceNotFound),
-			check.WithMessage("No LlamaStackDistribution resources found - no CR migration needed for 3.5 upgrade"),
+			check.WithCatalogMessage(msgNoDistributionsToMigrate),
 		))
 
 		return nil
@@ -86,7 +80,7 @@ func (c *MigrationCheck) validateDistributions(
 		ConditionTypeRequiresMigration,
 		metav1.ConditionFalse,
 		check.WithReason("CRTypeMigration"),
-		check.WithMessage("Found %d LlamaStackDistribution(s) that must be migrated to OGXServer v1beta1 after RHOAI 3.5 upgrade. The LlamaStackDistribution CRD is removed in 3.5 and replaced by OGXServer.", count),
+		check.WithCatalogMessage(msgDistributionsToMigrate, count),
 		check.WithImpact(result.ImpactBlocking),
 		check.WithRemediation(c.CheckRemediation),
 	))
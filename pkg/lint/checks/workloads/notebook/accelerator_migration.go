@@ -11,7 +11,6 @@ import (
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check/validate"
 	"github.com/opendatahub-io/odh-cli/pkg/resources"
-	"github.com/opendatahub-io/odh-cli/pkg/util/version"
 )
 
 // AcceleratorMigrationCheck detects Notebook (workbench) CRs referencing deprecated AcceleratorProfiles
@@ -31,16 +30,12 @@ func NewAcceleratorMigrationCheck() *AcceleratorMigrationCheck {
 			CheckName:        "Workloads :: Notebook :: AcceleratorProfile Migration (3.x)",
 			CheckDescription: "Detects Notebook (workbench) CRs referencing deprecated AcceleratorProfiles that will be auto-migrated to HardwareProfiles (infrastructure.opendatahub.io) during upgrade",
 			CheckRemediation: "Deprecated AcceleratorProfiles will be automatically migrated to HardwareProfiles (infrastructure.opendatahub.io) during upgrade - no manual action required",
+			CheckResources:   []resources.ResourceType{resources.Notebook, resources.AcceleratorProfile},
+			CheckVersions:    check.VersionsUpgrade2xTo3x,
 		},
 	}
 }
 
-// CanApply returns whether this check should run for the given target.
-// Only applies when upgrading from 2.x to 3.x; component state is checked via ForComponent in Validate.
-func (c *AcceleratorMigrationCheck) CanApply(_ context.Context, target check.Target) (bool, error) {
-	return version.IsUpgradeFrom2xTo3x(target.CurrentVersion, target.TargetVersion), nil
-}
-
 // Validate executes the check against the provided target.
 func (c *AcceleratorMigrationCheck) Validate(
 	ctx context.Context,
@@ -84,7 +79,7 @@ func (c *AcceleratorMigrationCheck) newAcceleratorMigrationCondition(
 			ConditionTypeAcceleratorProfileCompatible,
 			metav1.ConditionTrue,
 			check.WithReason(check.ReasonVersionCompatible),
-			check.WithMessage(MsgNoAcceleratorProfiles),
+			check.WithCatalogMessage(MsgNoAcceleratorProfiles),
 		)
 	}
 
@@ -94,7 +89,7 @@ func (c *AcceleratorMigrationCheck) newAcceleratorMigrationCondition(
 			ConditionTypeAcceleratorProfileCompatible,
 			metav1.ConditionFalse,
 			check.WithReason(check.ReasonResourceNotFound),
-			check.WithMessage(MsgAcceleratorProfilesMissing, totalImpacted, totalMissing),
+			check.WithCatalogMessage(MsgAcceleratorProfilesMissing, totalImpacted, totalMissing),
 			check.WithImpact(result.ImpactAdvisory),
 			check.WithRemediation(c.CheckRemediation),
 		)
@@ -105,7 +100,7 @@ func (c *AcceleratorMigrationCheck) newAcceleratorMigrationCondition(
 		ConditionTypeAcceleratorProfileCompatible,
 		metav1.ConditionFalse,
 		check.WithReason(check.ReasonMigrationPending),
-		check.WithMessage(MsgAcceleratorProfilesMigrating, totalImpacted),
+		check.WithCatalogMessage(MsgAcceleratorProfilesMigrating, totalImpacted),
 		check.WithImpact(result.ImpactAdvisory),
 		check.WithRemediation(c.CheckRemediation),
 	)
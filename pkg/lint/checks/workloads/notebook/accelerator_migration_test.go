@@ -1,7 +1,6 @@
 package notebook_test
 
 import (
-	"fmt"
 	"testing"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -46,7 +45,7 @@ func TestAcceleratorMigrationCheck_NoNotebooks(t *testing.T) {
 		"Type":    Equal(notebook.ConditionTypeAcceleratorProfileCompatible),
 		"Status":  Equal(metav1.ConditionTrue),
 		"Reason":  Equal(check.ReasonVersionCompatible),
-		"Message": Equal(notebook.MsgNoAcceleratorProfiles),
+		"Message": Equal(notebook.MsgNoAcceleratorProfiles.Format),
 	}))
 	g.Expect(result.Annotations).To(HaveKeyWithValue(check.AnnotationImpactedWorkloadCount, "0"))
 	g.Expect(result.ImpactedObjects).To(BeEmpty())
@@ -120,7 +119,7 @@ func TestAcceleratorMigrationCheck_NotebookWithExistingAcceleratorProfile(t *tes
 		"Type":    Equal(notebook.ConditionTypeAcceleratorProfileCompatible),
 		"Status":  Equal(metav1.ConditionFalse),
 		"Reason":  Equal(check.ReasonMigrationPending),
-		"Message": Equal(fmt.Sprintf(notebook.MsgAcceleratorProfilesMigrating, 1)),
+		"Message": Equal(notebook.MsgAcceleratorProfilesMigrating.Sprintf(1)),
 	}))
 	g.Expect(result.Status.Conditions[0].Impact).To(Equal(resultpkg.ImpactAdvisory))
 	g.Expect(result.Status.Conditions[0].Remediation).To(ContainSubstring("HardwareProfiles"))
@@ -159,7 +158,7 @@ func TestAcceleratorMigrationCheck_NotebookWithMissingAcceleratorProfile(t *test
 		"Type":    Equal(notebook.ConditionTypeAcceleratorProfileCompatible),
 		"Status":  Equal(metav1.ConditionFalse),
 		"Reason":  Equal(check.ReasonResourceNotFound),
-		"Message": Equal(fmt.Sprintf(notebook.MsgAcceleratorProfilesMissing, 1, 1)),
+		"Message": Equal(notebook.MsgAcceleratorProfilesMissing.Sprintf(1, 1)),
 	}))
 	g.Expect(result.Status.Conditions[0].Impact).To(Equal(resultpkg.ImpactAdvisory))
 	g.Expect(result.Status.Conditions[0].Remediation).To(ContainSubstring("HardwareProfiles"))
@@ -218,7 +217,7 @@ func TestAcceleratorMigrationCheck_MixedNotebooks(t *testing.T) {
 		"Type":    Equal(notebook.ConditionTypeAcceleratorProfileCompatible),
 		"Status":  Equal(metav1.ConditionFalse),
 		"Reason":  Equal(check.ReasonResourceNotFound),
-		"Message": Equal(fmt.Sprintf(notebook.MsgAcceleratorProfilesMissing, 2, 1)),
+		"Message": Equal(notebook.MsgAcceleratorProfilesMissing.Sprintf(2, 1)),
 	}))
 	g.Expect(result.Status.Conditions[0].Impact).To(Equal(resultpkg.ImpactAdvisory))
 	g.Expect(result.Status.Conditions[0].Remediation).To(ContainSubstring("HardwareProfiles"))
@@ -34,9 +34,12 @@ func NewConnectionIntegrityCheck() *ConnectionIntegrityCheck {
 			Kind:             kind,
 			Type:             check.CheckTypeDataIntegrity,
 			CheckID:          "workloads.notebook.connection-integrity",
+			CheckTags:        []string{check.TagBlockingOnly},
 			CheckName:        "Workloads :: Notebook :: Connection Integrity",
 			CheckDescription: "Verifies that Notebooks referencing connections have backing Secrets that exist on the cluster",
 			CheckRemediation: "Create the missing connection Secret or update the Notebook annotations to reference an existing connection",
+			CheckResources:   []resources.ResourceType{resources.Notebook, resources.Secret},
+			CheckVersions:    check.VersionsAny,
 		},
 	}
 }
@@ -194,7 +197,7 @@ func (c *ConnectionIntegrityCheck) newCondition(totalImpacted int) result.Condit
 			ConditionTypeConnectionIntegrity,
 			metav1.ConditionTrue,
 			check.WithReason(check.ReasonRequirementsMet),
-			check.WithMessage(MsgAllConnectionsValid),
+			check.WithCatalogMessage(MsgAllConnectionsValid),
 		)
 	}
 
@@ -202,7 +205,7 @@ func (c *ConnectionIntegrityCheck) newCondition(totalImpacted int) result.Condit
 		ConditionTypeConnectionIntegrity,
 		metav1.ConditionFalse,
 		check.WithReason(check.ReasonResourceNotFound),
-		check.WithMessage(MsgConnectionsMissing, totalImpacted),
+		check.WithCatalogMessage(MsgConnectionsMissing, totalImpacted),
 		check.WithImpact(result.ImpactBlocking),
 		check.WithRemediation(c.CheckRemediation),
 	)
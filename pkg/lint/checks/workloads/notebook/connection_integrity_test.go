@@ -1,7 +1,6 @@
 package notebook_test
 
 import (
-	"fmt"
 	"testing"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -125,7 +124,7 @@ func TestConnectionIntegrityCheck_NoNotebooks(t *testing.T) {
 		"Type":    Equal(notebook.ConditionTypeConnectionIntegrity),
 		"Status":  Equal(metav1.ConditionTrue),
 		"Reason":  Equal(check.ReasonRequirementsMet),
-		"Message": Equal(notebook.MsgAllConnectionsValid),
+		"Message": Equal(notebook.MsgAllConnectionsValid.Format),
 	}))
 	g.Expect(result.Status.Conditions[0].Impact).To(Equal(resultpkg.ImpactNone))
 	g.Expect(result.Annotations).To(HaveKeyWithValue(check.AnnotationImpactedWorkloadCount, "0"))
@@ -209,7 +208,7 @@ func TestConnectionIntegrityCheck_SecretMissing(t *testing.T) {
 		"Type":    Equal(notebook.ConditionTypeConnectionIntegrity),
 		"Status":  Equal(metav1.ConditionFalse),
 		"Reason":  Equal(check.ReasonResourceNotFound),
-		"Message": Equal(fmt.Sprintf(notebook.MsgConnectionsMissing, 1)),
+		"Message": Equal(notebook.MsgConnectionsMissing.Sprintf(1)),
 	}))
 	g.Expect(result.Status.Conditions[0].Impact).To(Equal(resultpkg.ImpactBlocking))
 	g.Expect(result.Status.Conditions[0].Remediation).To(ContainSubstring("missing connection Secret"))
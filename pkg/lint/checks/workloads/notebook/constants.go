@@ -1,5 +1,7 @@
 package notebook
 
+import "github.com/opendatahub-io/odh-cli/pkg/lint/check/messages"
+
 const (
 	// kind is the check kind for all notebook checks.
 	kind = "notebook"
@@ -10,9 +12,12 @@ const (
 	ConditionTypeAcceleratorProfileCompatible = "AcceleratorProfileCompatible"
 	ConditionTypeConnectionIntegrity          = "ConnectionIntegrity"
 	ConditionTypeContainerNameValid           = "ContainerNameValid"
+	ConditionTypeDisruptionTolerant           = "DisruptionTolerant"
+	ConditionTypeElyraRuntimeCompatible       = "ElyraRuntimeCompatible"
 	ConditionTypeHardwareProfileCompatible    = "HardwareProfileCompatible"
 	ConditionTypeHardwareProfileIntegrity     = "HardwareProfileIntegrity"
 	ConditionTypeNotebooksCompatible          = "NotebooksCompatible"
+	ConditionTypeStorageCompatible            = "StorageCompatible"
 	ConditionTypeNonStoppedWorkloads          = "NonStoppedWorkloads"
 	ConditionTypeRunningWorkloads             = "RunningWorkloads"
 )
@@ -39,67 +44,164 @@ const (
 	AnnotationConnections = "opendatahub.io/connections"
 )
 
+// LabelNotebookName is set by the notebook controller on the pod of each Notebook.
+const LabelNotebookName = "notebook-name"
+
+// Elyra pipeline runtime configuration mounted into workbenches by the Dashboard.
+const (
+	// elyraRuntimeSecretName is the Secret holding the Elyra runtime configuration in each project.
+	elyraRuntimeSecretName = "ds-pipeline-config"
+
+	// elyraRuntimeSecretKey is the Secret key containing the runtime metadata JSON.
+	elyraRuntimeSecretKey = "odh_dsp.json"
+
+	// legacyPipelinesRoutePrefix is the host prefix of the per-DSPA Routes used in 2.x.
+	legacyPipelinesRoutePrefix = "ds-pipeline-"
+)
+
 // Annotation keys set on ImpactedObjects by the ImpactedWorkloads check.
 const (
 	AnnotationCheckImageStatus = "check.opendatahub.io/image-status"
 	AnnotationCheckImageRef    = "check.opendatahub.io/image-ref"
 	AnnotationCheckReason      = "check.opendatahub.io/reason"
+
+	// AnnotationCheckImageStream names the OOTB ImageStream the image was resolved to, when the
+	// image is an OOTB one.
+	AnnotationCheckImageStream = "check.opendatahub.io/image-stream"
 )
 
 // Annotation keys set on ImpactedObjects by the NonStoppedWorkloads check.
 const (
 	AnnotationCheckContainerState      = "check.opendatahub.io/container-state"
 	AnnotationCheckContainerWaitReason = "check.opendatahub.io/container-wait-reason"
+
+	// AnnotationCheckRestartCount is the number of restarts of a container of the Notebook's pod
+	// in a restart loop, set with --deep only.
+	AnnotationCheckRestartCount = "check.opendatahub.io/restart-count"
 )
 
+// WaitReasonRestartLoop is the wait reason of a Notebook whose pod keeps restarting without
+// currently waiting in CrashLoopBackOff, detected with --deep only.
+const WaitReasonRestartLoop = "RestartLoop"
+
 // Messages for ImpactedWorkloads check.
+//
+//nolint:gochecknoglobals // Message catalog entries
+var (
+	MsgNoNotebookInstances    = messages.New("workloads.notebook.impacted-workloads.none", "No Notebook (workbench) instances found")
+	MsgAllNotebooksCompatible = messages.New("workloads.notebook.impacted-workloads.compatible", "All %d Notebook(s) use compatible OOTB images")
+	MsgNotebookImageSummary   = messages.New("workloads.notebook.impacted-workloads.summary", "Found %d Notebook(s) using %d unique images:\n"+
+		"  - %d compatible (%d images, OOTB ready for %s)\n"+
+		"  - %d custom (%d images, user verification needed)\n"+
+		"  - %d incompatible (%d images, update recommended before upgrade)\n"+
+		"  - %d incompatible (%d images, must rebuild after upgrade to 3.x)\n"+
+		"  - %d unverified (%d images, could not determine status)")
+	MsgVerifyCustomImages = messages.New("workloads.notebook.impacted-workloads.verify-custom", "Verify custom images are compatible with RHOAI %s before upgrading")
+)
+
+// Reasons of the objects reported by the ImpactedWorkloads check.
 const (
-	MsgNoNotebookInstances    = "No Notebook (workbench) instances found"
-	MsgAllNotebooksCompatible = "All %d Notebook(s) use compatible OOTB images"
-	MsgNotebookImageSummary   = "Found %d Notebook(s) using %d unique images:"
-	MsgCompatibleCount        = "  - %d compatible (%d images, OOTB ready for %s)"
-	MsgCustomCount            = "  - %d custom (%d images, user verification needed)"
-	MsgIncompatibleCount      = "  - %d incompatible (%d images, update recommended before upgrade)"
-	MsgPostUpgradeCount       = "  - %d incompatible (%d images, must rebuild after upgrade to 3.x)"
-	MsgUnverifiedCount        = "  - %d unverified (%d images, could not determine status)"
-	MsgVerifyCustomImages     = "Verify custom images are compatible with RHOAI %s before upgrading"
+	// MsgStaleImage prefixes the reason of an image running in a pod not restarted since the
+	// Notebook spec changed, detected with --deep only.
+	MsgStaleImage = "pod %s still runs %s instead of the spec image %s (restart the workbench to apply the spec): %s"
+
+	// Suffixes of the reason of an image no lookup strategy correlates, verified in its registry
+	// with --verify-registry only.
+	MsgRegistryVerified  = "%s (verified in the registry: %s)"
+	MsgRegistryUnmatched = "%s (registry digest %s matches no OOTB image)"
+	MsgRegistryFailed    = "%s (registry verification failed: %v)"
 )
 
 // Messages for AcceleratorMigration check.
-const (
-	MsgNoAcceleratorProfiles        = "No Notebooks found using deprecated AcceleratorProfiles - no migration needed"
-	MsgAcceleratorProfilesMissing   = "Found %d Notebook(s) referencing deprecated AcceleratorProfiles (%d missing): AcceleratorProfiles and Notebook references are automatically migrated to HardwareProfiles (infrastructure.opendatahub.io) during upgrade"
-	MsgAcceleratorProfilesMigrating = "Found %d Notebook(s) using deprecated AcceleratorProfiles: AcceleratorProfiles and Notebook references are automatically migrated to HardwareProfiles (infrastructure.opendatahub.io) during upgrade"
+//
+//nolint:gochecknoglobals // Message catalog entries
+var (
+	MsgNoAcceleratorProfiles        = messages.New("workloads.notebook.accelerator-migration.none", "No Notebooks found using deprecated AcceleratorProfiles - no migration needed")
+	MsgAcceleratorProfilesMissing   = messages.New("workloads.notebook.accelerator-migration.missing", "Found %d Notebook(s) referencing deprecated AcceleratorProfiles (%d missing): AcceleratorProfiles and Notebook references are automatically migrated to HardwareProfiles (infrastructure.opendatahub.io) during upgrade")
+	MsgAcceleratorProfilesMigrating = messages.New("workloads.notebook.accelerator-migration.migrating", "Found %d Notebook(s) using deprecated AcceleratorProfiles: AcceleratorProfiles and Notebook references are automatically migrated to HardwareProfiles (infrastructure.opendatahub.io) during upgrade")
 )
 
-// Messages for NonStoppedWorkloads check.
-const (
-	MsgAllNotebooksStopped      = "All Notebooks are stopped"
-	MsgNonStoppedNotebooksFound = "Found %d non-stopped Notebook(s):"
-	MsgNonStoppedRunning        = "  - %d running (should be stopped before upgrade)"
-	MsgNonStoppedWaiting        = "  - %d waiting (should be stopped or deleted before upgrade)"
+// Messages for NonStoppedWorkloads check. The summary has a variant for each combination of
+// running and waiting Notebooks it breaks down.
+//
+//nolint:gochecknoglobals // Message catalog entries
+var (
+	MsgAllNotebooksStopped      = messages.New("workloads.notebook.non-stopped-workloads.none", "All Notebooks are stopped")
+	MsgNonStoppedNotebooksFound = messages.New("workloads.notebook.non-stopped-workloads.found", "Found %d non-stopped Notebook(s):")
+	MsgNonStoppedRunning        = messages.New("workloads.notebook.non-stopped-workloads.running", "Found %d non-stopped Notebook(s):\n"+
+		"  - %d running (should be stopped before upgrade)")
+	MsgNonStoppedWaiting = messages.New("workloads.notebook.non-stopped-workloads.waiting", "Found %d non-stopped Notebook(s):\n"+
+		"  - %d waiting (should be stopped or deleted before upgrade)")
+	MsgNonStoppedRunningWaiting = messages.New("workloads.notebook.non-stopped-workloads.running-waiting", "Found %d non-stopped Notebook(s):\n"+
+		"  - %d running (should be stopped before upgrade)\n"+
+		"  - %d waiting (should be stopped or deleted before upgrade)")
 )
 
 // Messages for HardwareProfileIntegrity check.
-const (
-	MsgAllHardwareProfilesValid = "All Notebooks reference existing HardwareProfiles"
-	MsgHardwareProfilesMissing  = "Found %d Notebook(s) referencing HardwareProfiles that do not exist on the cluster"
+//
+//nolint:gochecknoglobals // Message catalog entries
+var (
+	MsgAllHardwareProfilesValid = messages.New("workloads.notebook.hardware-profile-integrity.valid", "All Notebooks reference existing HardwareProfiles")
+	MsgHardwareProfilesMissing  = messages.New("workloads.notebook.hardware-profile-integrity.missing", "Found %d Notebook(s) referencing HardwareProfiles that do not exist on the cluster")
 )
 
 // Messages for ConnectionIntegrity check.
-const (
-	MsgAllConnectionsValid = "All Notebook connections reference existing Secrets"
-	MsgConnectionsMissing  = "Found %d Notebook(s) referencing connection Secrets that do not exist on the cluster"
+//
+//nolint:gochecknoglobals // Message catalog entries
+var (
+	MsgAllConnectionsValid = messages.New("workloads.notebook.connection-integrity.valid", "All Notebook connections reference existing Secrets")
+	MsgConnectionsMissing  = messages.New("workloads.notebook.connection-integrity.missing", "Found %d Notebook(s) referencing connection Secrets that do not exist on the cluster")
 )
 
 // Messages for ContainerName check.
-const (
-	MsgNoContainerNameMismatch = "No Notebooks found with container name mismatch"
-	MsgContainerNameMismatch   = "Found %d Notebook(s) where the primary container name does not match the Notebook CR name"
+//
+//nolint:gochecknoglobals // Message catalog entries
+var (
+	MsgNoContainerNameMismatch = messages.New("workloads.notebook.container-name-mismatch.none", "No Notebooks found with container name mismatch")
+	MsgContainerNameMismatch   = messages.New("workloads.notebook.container-name-mismatch.found", "Found %d Notebook(s) where the primary container name does not match the Notebook CR name")
 )
 
 // Messages for HardwareProfileMigration check.
+//
+//nolint:gochecknoglobals // Message catalog entries
+var (
+	MsgNoLegacyHardwareProfiles = messages.New("workloads.notebook.hardwareprofile-migration.none", "No Notebooks found with legacy hardware profile annotation - no migration needed")
+	MsgLegacyHardwareProfiles   = messages.New("workloads.notebook.hardwareprofile-migration.found", "Found %d Notebook(s) with legacy hardware profile annotation that may need attention")
+)
+
+// Messages for ElyraRuntime check.
+//
+//nolint:gochecknoglobals // Message catalog entries
+var (
+	MsgNoLegacyElyraRuntimes         = messages.New("workloads.notebook.elyra-runtime.none", "No Notebooks found with Elyra runtimes using legacy DataSciencePipelines Route endpoints")
+	MsgLegacyElyraRuntimes           = messages.New("workloads.notebook.elyra-runtime.legacy", "Found %d Notebook(s) with Elyra runtimes pointing at legacy DataSciencePipelines Route endpoints that change in RHOAI %s")
+	MsgLegacyElyraRuntimesUnverified = messages.New("workloads.notebook.elyra-runtime.legacy-unverified", "Found %d Notebook(s) with Elyra runtimes pointing at legacy DataSciencePipelines Route endpoints that change in RHOAI %s; the runtime of %d more could not be verified")
+	MsgUnverifiedElyraRuntimes       = messages.New("workloads.notebook.elyra-runtime.unverified", "Could not verify the Elyra runtime endpoint of %d Notebook(s): the runtime Secret is unreadable or carries no runtime configuration")
+)
+
+// msgElyraRuntimeUnverified is the reason of a Notebook whose Elyra runtime endpoint could not
+// be verified.
+const msgElyraRuntimeUnverified = "Elyra runtime endpoint could not be verified: the runtime Secret is unreadable or carries no runtime configuration"
+
+// Messages for SingleReplica check.
+//
+//nolint:gochecknoglobals // Message catalog entries
+var (
+	MsgNoSingleReplicaNotebooks = messages.New("workloads.notebook.single-replica.none", "No running Notebooks found without a PodDisruptionBudget")
+	MsgSingleReplicaNotebooks   = messages.New("workloads.notebook.single-replica.found", "Found %d running Notebook(s) without a PodDisruptionBudget - each workbench runs a single pod that is restarted while nodes are drained during the upgrade to RHOAI %s")
+)
+
+// Messages for StorageClass check.
+//
+//nolint:gochecknoglobals // Message catalog entries
+var (
+	MsgAllNotebookStorageCompatible = messages.New("workloads.notebook.storage-class.compatible", "All Notebook PVCs use existing storage classes with writable access modes")
+	MsgNotebookStorageIncompatible  = messages.New("workloads.notebook.storage-class.incompatible", "Found %d Notebook(s) with PVCs bound to removed or deprecated storage classes or lacking a writable access mode")
+)
+
+// Reasons of the PVCs reported by the StorageClass check.
 const (
-	MsgNoLegacyHardwareProfiles = "No Notebooks found with legacy hardware profile annotation - no migration needed"
-	MsgLegacyHardwareProfiles   = "Found %d Notebook(s) with legacy hardware profile annotation that may need attention"
+	MsgStorageClassMissing    = "PVC %s uses storage class %q which no longer exists"
+	MsgStorageClassDeprecated = "PVC %s uses storage class %q with deprecated in-tree provisioner %s"
+	MsgPVCNotWritable         = "PVC %s has no writable access mode (%s)"
 )
@@ -3,6 +3,8 @@ package notebook
 import (
 	"context"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
 	"github.com/opendatahub-io/odh-cli/pkg/constants"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
@@ -30,6 +32,8 @@ func NewContainerNameCheck() *ContainerNameCheck {
 			CheckName:        "Workloads :: Notebook :: Container Name Mismatch",
 			CheckDescription: "Detects Dashboard-managed Notebook (workbench) CRs where the primary container name does not match the Notebook CR name",
 			CheckRemediation: "Rename the primary container in the Notebook spec to match the Notebook CR name",
+			CheckResources:   []resources.ResourceType{resources.Notebook},
+			CheckVersions:    check.VersionsAny,
 		},
 	}
 }
@@ -47,6 +51,9 @@ func (c *ContainerNameCheck) Validate(
 ) (*result.DiagnosticResult, error) {
 	return validate.Workloads(c, target, resources.Notebook).
 		ForComponent(constants.ComponentWorkbenches).
+		MetadataFilter(func(nb metav1.Object) bool {
+			return hasDashboardAnnotation(nb.GetAnnotations())
+		}).
 		Filter(hasDashboardAnnotationAndNameMismatch).
 		Complete(ctx, c.newContainerNameCondition)
 }
@@ -61,7 +61,7 @@ func (c *ContainerNameCheck) newContainerNameCondition(
 			ConditionTypeContainerNameValid,
 			metav1.ConditionTrue,
 			check.WithReason(check.ReasonConfigurationValid),
-			check.WithMessage(MsgNoContainerNameMismatch),
+			check.WithCatalogMessage(MsgNoContainerNameMismatch),
 		)}, nil
 	}
 
@@ -69,7 +69,7 @@ func (c *ContainerNameCheck) newContainerNameCondition(
 		ConditionTypeContainerNameValid,
 		metav1.ConditionFalse,
 		check.WithReason(check.ReasonConfigurationInvalid),
-		check.WithMessage(MsgContainerNameMismatch, count),
+		check.WithCatalogMessage(MsgContainerNameMismatch, count),
 		check.WithImpact(result.ImpactAdvisory),
 		check.WithRemediation(c.CheckRemediation),
 	)}, nil
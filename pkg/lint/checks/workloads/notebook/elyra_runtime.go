@@ -0,0 +1,244 @@
+package notebook
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/opendatahub-io/odh-cli/pkg/constants"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/validate"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+	"github.com/opendatahub-io/odh-cli/pkg/util/jq"
+	"github.com/opendatahub-io/odh-cli/pkg/util/version"
+)
+
+// ElyraRuntimeCheck detects workbenches whose mounted Elyra pipeline runtime configuration
+// points at the legacy per-DSPA Route endpoint. DataSciencePipelines API endpoints are no
+// longer exposed through those Routes in 3.x, so Elyra cannot submit pipelines until the
+// runtime configuration is regenerated.
+type ElyraRuntimeCheck struct {
+	check.BaseCheck
+	check.EnhancedVerboseFormatter
+}
+
+func NewElyraRuntimeCheck() *ElyraRuntimeCheck {
+	return &ElyraRuntimeCheck{
+		BaseCheck: check.BaseCheck{
+			CheckGroup:       check.GroupWorkload,
+			Kind:             kind,
+			Type:             check.CheckTypeConfigMigration,
+			CheckID:          "workloads.notebook.elyra-runtime",
+			CheckName:        "Workloads :: Notebook :: Elyra Runtime Configuration (3.x)",
+			CheckDescription: "Detects workbenches whose Elyra pipeline runtime configuration points at legacy DataSciencePipelines Route endpoints removed in RHOAI 3.x",
+			CheckRemediation: "After upgrading, restart the listed workbenches so the Dashboard regenerates the Elyra runtime configuration, or update the runtime API endpoint in the Elyra runtime settings",
+			CheckResources:   []resources.ResourceType{resources.Notebook, resources.Secret},
+			CheckVersions:    check.VersionsUpgrade2xTo3x,
+		},
+	}
+}
+
+// Validate lists Notebooks mounting the Elyra runtime Secret and inspects the configured
+// pipelines API endpoint of each.
+func (c *ElyraRuntimeCheck) Validate(
+	ctx context.Context,
+	target check.Target,
+) (*result.DiagnosticResult, error) {
+	return validate.Workloads(c, target, resources.Notebook).
+		ForComponent(constants.ComponentWorkbenches).
+		Run(ctx, c.checkRuntimes)
+}
+
+// elyraRuntime is the pipelines API endpoint configured for Elyra in a namespace.
+type elyraRuntime struct {
+	endpoint string
+	// verified is false when the runtime Secret exists but its endpoint could not be read.
+	verified bool
+}
+
+// checkRuntimes resolves the Elyra runtime Secret of each Notebook and flags those whose
+// endpoint uses the legacy Route format. Secrets are fetched once per namespace.
+func (c *ElyraRuntimeCheck) checkRuntimes(
+	ctx context.Context,
+	req *validate.WorkloadRequest[*unstructured.Unstructured],
+) error {
+	dr := req.Result
+	runtimes := make(map[string]elyraRuntime)
+	impacted := make([]types.NamespacedName, 0)
+	unverified := make([]types.NamespacedName, 0)
+
+	for _, nb := range req.Items {
+		mounted, err := mountsElyraRuntime(nb)
+		if err != nil {
+			return fmt.Errorf("inspecting volumes of Notebook %s/%s: %w", nb.GetNamespace(), nb.GetName(), err)
+		}
+
+		if !mounted {
+			continue
+		}
+
+		runtime, seen := runtimes[nb.GetNamespace()]
+		if !seen {
+			runtime, err = elyraRuntimeEndpoint(ctx, req.Client, nb.GetNamespace())
+			if err != nil {
+				return err
+			}
+
+			runtimes[nb.GetNamespace()] = runtime
+		}
+
+		ref := types.NamespacedName{Namespace: nb.GetNamespace(), Name: nb.GetName()}
+
+		switch {
+		case !runtime.verified:
+			unverified = append(unverified, ref)
+		case isLegacyPipelinesEndpoint(runtime.endpoint):
+			impacted = append(impacted, ref)
+		}
+	}
+
+	totalImpacted := len(impacted)
+	dr.Annotations[check.AnnotationImpactedWorkloadCount] = strconv.Itoa(totalImpacted)
+
+	dr.SetCondition(c.newCondition(totalImpacted, len(unverified), version.MajorMinorLabel(req.TargetVersion)))
+	dr.SetImpactedObjects(resources.Notebook, impacted)
+
+	for _, ref := range unverified {
+		dr.AddImpactedObjects(resources.Notebook, []types.NamespacedName{ref})
+		dr.ImpactedObjects[len(dr.ImpactedObjects)-1].Annotations = map[string]string{
+			result.AnnotationObjectContext: msgElyraRuntimeUnverified,
+		}
+	}
+
+	return nil
+}
+
+// mountsElyraRuntime reports whether the Notebook pod template mounts the Elyra runtime Secret.
+func mountsElyraRuntime(nb *unstructured.Unstructured) (bool, error) {
+	secretNames, err := jq.Query[[]any](nb, "[.spec.template.spec.volumes[]?.secret.secretName // empty]")
+	if err != nil {
+		return false, fmt.Errorf("querying secret volumes: %w", err)
+	}
+
+	for _, name := range secretNames {
+		if name == elyraRuntimeSecretName {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// elyraRuntimeEndpoint returns the api_endpoint configured in the Elyra runtime Secret of the
+// given namespace. A missing Secret is verified with no endpoint, as the Dashboard creates it
+// on the next workbench start; an unreadable Secret or endpoint is reported as unverified.
+func elyraRuntimeEndpoint(ctx context.Context, r client.Reader, namespace string) (elyraRuntime, error) {
+	secret, err := r.GetResource(ctx, resources.Secret, elyraRuntimeSecretName, client.InNamespace(namespace))
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return elyraRuntime{verified: true}, nil
+		}
+
+		return elyraRuntime{}, fmt.Errorf("getting Secret %s/%s: %w", namespace, elyraRuntimeSecretName, err)
+	}
+
+	// Secret not returned (permission error returns nil)
+	if secret == nil {
+		return elyraRuntime{}, nil
+	}
+
+	endpoint, ok := decodeElyraEndpoint(secret)
+
+	return elyraRuntime{endpoint: endpoint, verified: ok}, nil
+}
+
+// decodeElyraEndpoint extracts metadata.api_endpoint from the runtime JSON stored in the Secret.
+// It returns false when the key is absent, e.g. in a redacted snapshot, or its content is malformed.
+func decodeElyraEndpoint(secret *unstructured.Unstructured) (string, bool) {
+	encoded, err := jq.Query[string](secret, fmt.Sprintf(".data[%q] // empty", elyraRuntimeSecretKey))
+	if err != nil || encoded == "" {
+		return "", false
+	}
+
+	raw, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return "", false
+	}
+
+	var runtime struct {
+		Metadata struct {
+			APIEndpoint string `json:"api_endpoint"`
+		} `json:"metadata"`
+	}
+
+	if err := json.Unmarshal(raw, &runtime); err != nil {
+		return "", false
+	}
+
+	return runtime.Metadata.APIEndpoint, true
+}
+
+// isLegacyPipelinesEndpoint reports whether the endpoint addresses a DSPA through its external
+// Route (ds-pipeline-<dspa>-<namespace>.<apps domain>) rather than the in-cluster Service.
+func isLegacyPipelinesEndpoint(endpoint string) bool {
+	if endpoint == "" {
+		return false
+	}
+
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return false
+	}
+
+	host := u.Hostname()
+
+	return strings.HasPrefix(host, legacyPipelinesRoutePrefix) &&
+		!strings.Contains(host, ".svc")
+}
+
+func (c *ElyraRuntimeCheck) newCondition(totalImpacted int, totalUnverified int, targetVersion string) result.Condition {
+	if totalImpacted == 0 && totalUnverified == 0 {
+		return check.NewCondition(
+			ConditionTypeElyraRuntimeCompatible,
+			metav1.ConditionTrue,
+			check.WithReason(check.ReasonConfigurationValid),
+			check.WithCatalogMessage(MsgNoLegacyElyraRuntimes),
+		)
+	}
+
+	if totalImpacted == 0 {
+		return check.NewCondition(
+			ConditionTypeElyraRuntimeCompatible,
+			metav1.ConditionUnknown,
+			check.WithReason(check.ReasonInsufficientData),
+			check.WithCatalogMessage(MsgUnverifiedElyraRuntimes, totalUnverified),
+			check.WithImpact(result.ImpactAdvisory),
+			check.WithRemediation(c.CheckRemediation),
+		)
+	}
+
+	message := check.WithCatalogMessage(MsgLegacyElyraRuntimes, totalImpacted, targetVersion)
+	if totalUnverified > 0 {
+		message = check.WithCatalogMessage(MsgLegacyElyraRuntimesUnverified, totalImpacted, targetVersion, totalUnverified)
+	}
+
+	return check.NewCondition(
+		ConditionTypeElyraRuntimeCompatible,
+		metav1.ConditionFalse,
+		check.WithReason(check.ReasonDeprecated),
+		message,
+		check.WithImpact(result.ImpactAdvisory),
+		check.WithRemediation(c.CheckRemediation),
+	)
+}
@@ -0,0 +1,166 @@
+package notebook_test
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/blang/semver/v4"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	resultpkg "github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/testutil"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/workloads/notebook"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+)
+
+func newElyraNotebook(name, namespace string) *unstructured.Unstructured {
+	nb := newNotebook(name, namespace, notebookOptions{})
+	nb.Object["spec"] = map[string]any{
+		"template": map[string]any{
+			"spec": map[string]any{
+				"volumes": []any{
+					map[string]any{
+						"name":   "elyra-dsp-details",
+						"secret": map[string]any{"secretName": "ds-pipeline-config"},
+					},
+				},
+			},
+		},
+	}
+
+	return nb
+}
+
+func newElyraSecret(namespace string, endpoint string) *unstructured.Unstructured {
+	runtime := `{"display_name":"Pipeline","metadata":{"api_endpoint":"` + endpoint + `"}}`
+
+	return &unstructured.Unstructured{
+		Object: map[string]any{
+			"apiVersion": resources.Secret.APIVersion(),
+			"kind":       resources.Secret.Kind,
+			"metadata": map[string]any{
+				"name":      "ds-pipeline-config",
+				"namespace": namespace,
+			},
+			"data": map[string]any{
+				"odh_dsp.json": base64.StdEncoding.EncodeToString([]byte(runtime)),
+			},
+		},
+	}
+}
+
+func TestElyraRuntimeCheck_CanApply(t *testing.T) {
+	g := NewWithT(t)
+
+	chk := notebook.NewElyraRuntimeCheck()
+
+	v2 := semver.MustParse("2.17.0")
+	v3 := semver.MustParse("3.0.0")
+
+	canApply, err := chk.CanApply(t.Context(), check.Target{CurrentVersion: &v2, TargetVersion: &v3})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(canApply).To(BeTrue())
+
+	canApply, err = chk.CanApply(t.Context(), check.Target{CurrentVersion: &v3, TargetVersion: &v3})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(canApply).To(BeFalse())
+}
+
+func TestElyraRuntimeCheck_NoLegacyEndpoints(t *testing.T) {
+	g := NewWithT(t)
+
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds: connIntegrityListKinds,
+		Objects: []*unstructured.Unstructured{
+			workbenchesDSC("Managed"),
+			newElyraNotebook("nb-svc", "ns1"),
+			newElyraSecret("ns1", "https://ds-pipeline-dspa.ns1.svc.cluster.local:8443"),
+			newNotebook("nb-plain", "ns2", notebookOptions{}),
+		},
+		CurrentVersion: "2.17.0",
+		TargetVersion:  "3.0.0",
+	})
+
+	dr, err := notebook.NewElyraRuntimeCheck().Validate(t.Context(), target)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(dr.Status.Conditions).To(HaveLen(1))
+	g.Expect(dr.Status.Conditions[0].Condition).To(MatchFields(IgnoreExtras, Fields{
+		"Type":   Equal(notebook.ConditionTypeElyraRuntimeCompatible),
+		"Status": Equal(metav1.ConditionTrue),
+		"Reason": Equal(check.ReasonConfigurationValid),
+	}))
+	g.Expect(dr.Annotations).To(HaveKeyWithValue(check.AnnotationImpactedWorkloadCount, "0"))
+	g.Expect(dr.ImpactedObjects).To(BeEmpty())
+}
+
+func TestElyraRuntimeCheck_LegacyRouteEndpoint(t *testing.T) {
+	g := NewWithT(t)
+
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds: connIntegrityListKinds,
+		Objects: []*unstructured.Unstructured{
+			workbenchesDSC("Managed"),
+			newElyraNotebook("nb-1", "ns1"),
+			newElyraNotebook("nb-2", "ns1"),
+			newElyraSecret("ns1", "https://ds-pipeline-dspa-ns1.apps.example.com"),
+			newElyraNotebook("nb-no-secret", "ns2"),
+		},
+		CurrentVersion: "2.17.0",
+		TargetVersion:  "3.0.0",
+	})
+
+	dr, err := notebook.NewElyraRuntimeCheck().Validate(t.Context(), target)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(dr.Status.Conditions).To(HaveLen(1))
+	g.Expect(dr.Status.Conditions[0].Condition).To(MatchFields(IgnoreExtras, Fields{
+		"Type":    Equal(notebook.ConditionTypeElyraRuntimeCompatible),
+		"Status":  Equal(metav1.ConditionFalse),
+		"Reason":  Equal(check.ReasonDeprecated),
+		"Message": ContainSubstring("Found 2 Notebook(s)"),
+	}))
+	g.Expect(dr.Status.Conditions[0].Impact).To(Equal(resultpkg.ImpactAdvisory))
+	g.Expect(dr.Annotations).To(HaveKeyWithValue(check.AnnotationImpactedWorkloadCount, "2"))
+	g.Expect(dr.ImpactedObjects).To(HaveLen(2))
+	g.Expect(dr.ImpactedObjects[0].Namespace).To(Equal("ns1"))
+}
+
+func TestElyraRuntimeCheck_SecretWithoutData(t *testing.T) {
+	g := NewWithT(t)
+
+	secret := newElyraSecret("ns1", "https://ds-pipeline-dspa-ns1.apps.example.com")
+	delete(secret.Object, "data")
+
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds: connIntegrityListKinds,
+		Objects: []*unstructured.Unstructured{
+			workbenchesDSC("Managed"),
+			newElyraNotebook("nb-1", "ns1"),
+			secret,
+		},
+		CurrentVersion: "2.17.0",
+		TargetVersion:  "3.0.0",
+	})
+
+	dr, err := notebook.NewElyraRuntimeCheck().Validate(t.Context(), target)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(dr.Status.Conditions).To(HaveLen(1))
+	g.Expect(dr.Status.Conditions[0].Condition).To(MatchFields(IgnoreExtras, Fields{
+		"Type":    Equal(notebook.ConditionTypeElyraRuntimeCompatible),
+		"Status":  Equal(metav1.ConditionUnknown),
+		"Reason":  Equal(check.ReasonInsufficientData),
+		"Message": ContainSubstring("Could not verify the Elyra runtime endpoint of 1 Notebook(s)"),
+	}))
+	g.Expect(dr.Status.Conditions[0].Impact).To(Equal(resultpkg.ImpactAdvisory))
+	g.Expect(dr.ImpactedObjects).To(HaveLen(1))
+	g.Expect(dr.ImpactedObjects[0].Name).To(Equal("nb-1"))
+	g.Expect(dr.ImpactedObjects[0].Annotations).To(HaveKey(resultpkg.AnnotationObjectContext))
+}
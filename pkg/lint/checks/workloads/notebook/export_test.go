@@ -0,0 +1,16 @@
+package notebook
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+)
+
+// SetImageInspector enables --verify-registry with the given inspector instead of registry clients.
+func (c *ImpactedWorkloadsCheck) SetImageInspector(inspector ImageInspector) {
+	c.verifyRegistry = true
+	c.newInspector = func(context.Context, client.Reader, *slog.Logger) ImageInspector {
+		return inspector
+	}
+}
@@ -33,9 +33,12 @@ func NewHardwareProfileIntegrityCheck() *HardwareProfileIntegrityCheck {
 			Kind:             kind,
 			Type:             check.CheckTypeDataIntegrity,
 			CheckID:          "workloads.notebook.hardware-profile-integrity",
+			CheckTags:        []string{check.TagBlockingOnly},
 			CheckName:        "Workloads :: Notebook :: HardwareProfile Integrity",
 			CheckDescription: "Verifies that Notebooks referencing infrastructure HardwareProfiles point to profiles that exist on the cluster",
 			CheckRemediation: "Create the missing HardwareProfile or update the Notebook annotations to reference an existing profile",
+			CheckResources:   []resources.ResourceType{resources.Notebook, resources.InfrastructureHardwareProfile},
+			CheckVersions:    check.VersionsAny,
 		},
 	}
 }
@@ -174,7 +177,7 @@ func (c *HardwareProfileIntegrityCheck) newCondition(totalImpacted int) result.C
 			ConditionTypeHardwareProfileIntegrity,
 			metav1.ConditionTrue,
 			check.WithReason(check.ReasonRequirementsMet),
-			check.WithMessage(MsgAllHardwareProfilesValid),
+			check.WithCatalogMessage(MsgAllHardwareProfilesValid),
 		)
 	}
 
@@ -182,7 +185,7 @@ func (c *HardwareProfileIntegrityCheck) newCondition(totalImpacted int) result.C
 		ConditionTypeHardwareProfileIntegrity,
 		metav1.ConditionFalse,
 		check.WithReason(check.ReasonResourceNotFound),
-		check.WithMessage(MsgHardwareProfilesMissing, totalImpacted),
+		check.WithCatalogMessage(MsgHardwareProfilesMissing, totalImpacted),
 		check.WithImpact(result.ImpactBlocking),
 		check.WithRemediation(c.CheckRemediation),
 	)
@@ -1,7 +1,6 @@
 package notebook_test
 
 import (
-	"fmt"
 	"testing"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -115,7 +114,7 @@ func TestHardwareProfileIntegrityCheck_NoNotebooks(t *testing.T) {
 		"Type":    Equal(notebook.ConditionTypeHardwareProfileIntegrity),
 		"Status":  Equal(metav1.ConditionTrue),
 		"Reason":  Equal(check.ReasonRequirementsMet),
-		"Message": Equal(notebook.MsgAllHardwareProfilesValid),
+		"Message": Equal(notebook.MsgAllHardwareProfilesValid.Format),
 	}))
 	g.Expect(result.Status.Conditions[0].Impact).To(Equal(resultpkg.ImpactNone))
 	g.Expect(result.Annotations).To(HaveKeyWithValue(check.AnnotationImpactedWorkloadCount, "0"))
@@ -215,7 +214,7 @@ func TestHardwareProfileIntegrityCheck_ProfileMissing(t *testing.T) {
 		"Type":    Equal(notebook.ConditionTypeHardwareProfileIntegrity),
 		"Status":  Equal(metav1.ConditionFalse),
 		"Reason":  Equal(check.ReasonResourceNotFound),
-		"Message": Equal(fmt.Sprintf(notebook.MsgHardwareProfilesMissing, 1)),
+		"Message": Equal(notebook.MsgHardwareProfilesMissing.Sprintf(1)),
 	}))
 	g.Expect(result.Status.Conditions[0].Impact).To(Equal(resultpkg.ImpactBlocking))
 	g.Expect(result.Status.Conditions[0].Remediation).To(ContainSubstring("missing HardwareProfile"))
@@ -277,7 +276,7 @@ func TestHardwareProfileIntegrityCheck_MixedExistingAndMissing(t *testing.T) {
 		"Type":    Equal(notebook.ConditionTypeHardwareProfileIntegrity),
 		"Status":  Equal(metav1.ConditionFalse),
 		"Reason":  Equal(check.ReasonResourceNotFound),
-		"Message": Equal(fmt.Sprintf(notebook.MsgHardwareProfilesMissing, 1)),
+		"Message": Equal(notebook.MsgHardwareProfilesMissing.Sprintf(1)),
 	}))
 	g.Expect(result.Status.Conditions[0].Impact).To(Equal(resultpkg.ImpactBlocking))
 	g.Expect(result.Annotations).To(HaveKeyWithValue(check.AnnotationImpactedWorkloadCount, "1"))
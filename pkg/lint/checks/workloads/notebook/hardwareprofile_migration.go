@@ -31,6 +31,8 @@ func NewHardwareProfileMigrationCheck() *HardwareProfileMigrationCheck {
 			CheckName:        "Workloads :: Notebook :: Legacy HardwareProfile Migration",
 			CheckDescription: "Detects Notebook CRs carrying the legacy opendatahub.io/legacy-hardware-profile-name annotation that may need attention",
 			CheckRemediation: "Update Notebooks to use current HardwareProfiles and remove the legacy-hardware-profile-name annotation",
+			CheckResources:   []resources.ResourceType{resources.Notebook},
+			CheckVersions:    check.VersionsAny,
 		},
 	}
 }
@@ -69,7 +71,7 @@ func (c *HardwareProfileMigrationCheck) newCondition(
 			ConditionTypeHardwareProfileCompatible,
 			metav1.ConditionTrue,
 			check.WithReason(check.ReasonNoMigrationRequired),
-			check.WithMessage(MsgNoLegacyHardwareProfiles),
+			check.WithCatalogMessage(MsgNoLegacyHardwareProfiles),
 		)}, nil
 	}
 
@@ -77,7 +79,7 @@ func (c *HardwareProfileMigrationCheck) newCondition(
 		ConditionTypeHardwareProfileCompatible,
 		metav1.ConditionFalse,
 		check.WithReason(check.ReasonMigrationPending),
-		check.WithMessage(MsgLegacyHardwareProfiles, count),
+		check.WithCatalogMessage(MsgLegacyHardwareProfiles, count),
 		check.WithImpact(result.ImpactAdvisory),
 		check.WithRemediation(c.CheckRemediation),
 	)}, nil
@@ -3,12 +3,14 @@ package notebook
 import (
 	"context"
 	"fmt"
-	iolib "io"
+	"log/slog"
 	"regexp"
-	"sort"
+	"slices"
 	"strconv"
 	"strings"
 
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
@@ -18,8 +20,8 @@ import (
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check/validate"
 	"github.com/opendatahub-io/odh-cli/pkg/resources"
 	"github.com/opendatahub-io/odh-cli/pkg/util/client"
-	"github.com/opendatahub-io/odh-cli/pkg/util/iostreams"
 	"github.com/opendatahub-io/odh-cli/pkg/util/jq"
+	"github.com/opendatahub-io/odh-cli/pkg/util/kube/mirror"
 	"github.com/opendatahub-io/odh-cli/pkg/util/version"
 )
 
@@ -70,17 +72,19 @@ type ootbImageStream struct {
 
 // notebookAnalysis contains the analysis result for a single notebook.
 type notebookAnalysis struct {
-	Namespace string
-	Name      string
-	Status    ImageStatus
-	Reason    string
-	ImageRef  string // Primary container image reference (for image-centric grouping)
+	Namespace   string
+	Name        string
+	Status      ImageStatus
+	Reason      string
+	ImageRef    string // Primary container image reference (for image-centric grouping)
+	ImageStream string // OOTB ImageStream the primary image was resolved to, if any
 }
 
 // imageAnalysis contains the analysis result for a single container image.
 type imageAnalysis struct {
 	ContainerName string
 	ImageRef      string
+	ImageStream   string // OOTB ImageStream the image was resolved to, if any
 	Status        ImageStatus
 	Reason        string
 }
@@ -105,6 +109,12 @@ type ootbImageInput struct {
 // due to nginx compatibility requirements in non-Jupyter images.
 type ImpactedWorkloadsCheck struct {
 	check.BaseCheck
+
+	registryMirrors mirror.Set
+	verifyRegistry  bool
+
+	// newInspector creates the ImageInspector of --verify-registry for a run of the check.
+	newInspector func(ctx context.Context, reader client.Reader, log *slog.Logger) ImageInspector
 }
 
 func NewImpactedWorkloadsCheck() *ImpactedWorkloadsCheck {
@@ -117,103 +127,37 @@ func NewImpactedWorkloadsCheck() *ImpactedWorkloadsCheck {
 			CheckName:        "Workloads :: Notebook :: Impacted Workloads (3.x)",
 			CheckDescription: "Identifies Notebook (workbench) instances with images that will not work in RHOAI 3.x",
 			CheckRemediation: "Update workbenches with incompatible images to use 2025.2+ versions before upgrading",
+			CheckResources:   []resources.ResourceType{resources.Notebook, resources.ImageStream, resources.ImageStreamTag},
+			CheckVersions:    check.VersionsUpgrade2xTo3x,
 		},
+		newInspector: newRegistryInspector,
 	}
 }
 
-// FormatVerboseOutput implements check.VerboseOutputFormatter.
-// Groups notebook impacted objects by image, then by namespace within each image group.
-//
-// Output format:
-//
-//	<status-label>: registry/path:tag (N notebooks)
-//	  - namespace: <ns>
-//	       - <crd-fqn>/<name>
-//	       - <crd-fqn>/<name>
-//	  - namespace: <ns>
-//	       - <crd-fqn>/<name>
-func (c *ImpactedWorkloadsCheck) FormatVerboseOutput(out iolib.Writer, dr *result.DiagnosticResult) {
-	crdName := check.CRDFullyQualifiedName(dr)
-
-	// Group notebooks by image reference, preserving insertion order.
-	// Within each image group, track notebooks per namespace.
-	var groups []imageGroup
-
-	imageIndex := make(map[string]int) // imageRef -> index in groups
-
-	for _, obj := range dr.ImpactedObjects {
-		imageRef := obj.Annotations[AnnotationCheckImageRef]
-		if imageRef == "" {
-			imageRef = "(unknown image)"
-		}
-
-		imageStatus := obj.Annotations[AnnotationCheckImageStatus]
-
-		ns := obj.Namespace
-		name := obj.Name
-
-		if idx, ok := imageIndex[imageRef]; ok {
-			groups[idx].namespaces[ns] = append(groups[idx].namespaces[ns], name)
-			groups[idx].count++
-		} else {
-			imageIndex[imageRef] = len(groups)
-			groups = append(groups, imageGroup{
-				imageRef:    imageRef,
-				imageStatus: imageStatus,
-				namespaces:  map[string][]string{ns: {name}},
-				count:       1,
-			})
-		}
-	}
-
-	// Sort image groups: problematic (incompatible) before custom, then by imageRef for determinism.
-	sort.SliceStable(groups, func(i, j int) bool {
-		oi, oj := imageStatusOrder(groups[i].imageStatus), imageStatusOrder(groups[j].imageStatus)
-		if oi != oj {
-			return oi < oj
-		}
-
-		return groups[i].imageRef < groups[j].imageRef
-	})
-
-	for _, g := range groups {
-		imageLabel := imageStatusLabel(g.imageStatus)
-		_, _ = fmt.Fprintf(out, "    %s: %s (%d notebooks)\n", imageLabel, g.imageRef, g.count)
-
-		// Sort namespaces for deterministic output.
-		namespaces := make([]string, 0, len(g.namespaces))
-		for ns := range g.namespaces {
-			namespaces = append(namespaces, ns)
-		}
-		sort.Strings(namespaces)
-
-		for _, ns := range namespaces {
-			names := g.namespaces[ns]
-			sort.Strings(names)
-
-			if ns == "" {
-				// Cluster-scoped objects listed without namespace header.
-				for _, name := range names {
-					_, _ = fmt.Fprintf(out, "      - %s/%s\n", crdName, name)
-				}
-			} else {
-				_, _ = fmt.Fprintf(out, "      - namespace: %s\n", ns)
-				for _, name := range names {
-					_, _ = fmt.Fprintf(out, "           - %s/%s\n", crdName, name)
-				}
-			}
-		}
-
-		_, _ = fmt.Fprintln(out)
-	}
+// SetRegistryMirrors sets the registry mirror mappings correlating mirrored notebook images, in
+// addition to those configured on the cluster by ImageDigestMirrorSets, ImageTagMirrorSets and
+// ImageContentSourcePolicies.
+func (c *ImpactedWorkloadsCheck) SetRegistryMirrors(mirrors mirror.Set) {
+	c.registryMirrors = mirrors
 }
 
-// imageGroup holds notebooks grouped by their image reference, with sub-grouping by namespace.
-type imageGroup struct {
-	imageRef    string
-	imageStatus string              // CUSTOM, PRE_UPGRADE_ACTION_REQUIRED, etc.
-	namespaces  map[string][]string // namespace -> []name
-	count       int                 // total notebook count across all namespaces
+// NewImageGroupRenderer creates the verbose output renderer grouping the notebooks reported by
+// ImpactedWorkloadsCheck by image, incompatible images before custom ones, then by namespace.
+func NewImageGroupRenderer() check.GroupRenderer {
+	return check.GroupRenderer{
+		AnnotationSchema: check.AnnotationSchema{
+			Kind:       resources.Notebook.Kind,
+			Annotation: AnnotationCheckImageRef,
+		},
+		Noun:    "notebooks",
+		Unknown: "(unknown image)",
+		Label: func(obj metav1.PartialObjectMetadata) string {
+			return imageStatusLabel(obj.Annotations[AnnotationCheckImageStatus])
+		},
+		Rank: func(obj metav1.PartialObjectMetadata) int {
+			return imageStatusOrder(obj.Annotations[AnnotationCheckImageStatus])
+		},
+	}
 }
 
 // Image status sort priorities (lower = higher severity).
@@ -259,12 +203,6 @@ func imageStatusLabel(status string) string {
 	return "image"
 }
 
-// CanApply returns whether this check should run for the given target.
-// Only applies when upgrading FROM 2.x TO 3.x; component state is checked via ForComponent in Validate.
-func (c *ImpactedWorkloadsCheck) CanApply(_ context.Context, target check.Target) (bool, error) {
-	return version.IsUpgradeFrom2xTo3x(target.CurrentVersion, target.TargetVersion), nil
-}
-
 // Validate executes the check against the provided target.
 func (c *ImpactedWorkloadsCheck) Validate(
 	ctx context.Context,
@@ -272,6 +210,7 @@ func (c *ImpactedWorkloadsCheck) Validate(
 ) (*result.DiagnosticResult, error) {
 	return validate.Workloads(c, target, resources.Notebook).
 		ForComponent(constants.ComponentWorkbenches).
+		WithPods(notebookPods).
 		Run(ctx, func(ctx context.Context, req *validate.WorkloadRequest[*unstructured.Unstructured]) error {
 			return c.analyzeNotebooks(ctx, req)
 		})
@@ -283,16 +222,16 @@ func (c *ImpactedWorkloadsCheck) analyzeNotebooks(
 	req *validate.WorkloadRequest[*unstructured.Unstructured],
 ) error {
 	notebooks := req.Items
-	log := newDebugLogger(req.IO, req.Debug)
+	log := req.Log()
 
-	log.logf("[notebook] Analyzing %d notebook(s)", len(notebooks))
+	log.Debug("analyzing notebooks", "count", len(notebooks))
 
 	if len(notebooks) == 0 {
 		req.Result.SetCondition(check.NewCondition(
 			ConditionTypeNotebooksCompatible,
 			metav1.ConditionTrue,
 			check.WithReason(check.ReasonVersionCompatible),
-			check.WithMessage(MsgNoNotebookInstances),
+			check.WithCatalogMessage(MsgNoNotebookInstances),
 		))
 
 		return nil
@@ -310,14 +249,40 @@ func (c *ImpactedWorkloadsCheck) analyzeNotebooks(
 		return fmt.Errorf("discovering OOTB ImageStreams: %w", err)
 	}
 
-	log.logf("[notebook] Discovered %d OOTB ImageStreams, %d total ImageStreams",
-		len(ootbImages), len(imageStreamData))
+	log.Debug("discovered ImageStreams", "ootb", len(ootbImages), "total", len(imageStreamData))
+
+	// The mirror configuration is not among the CheckResources: it only refines the correlation
+	// of images, so users who cannot read it should still get the check.
+	mirrors := slices.Clone(c.registryMirrors)
+
+	clusterMirrors, err := mirror.FromCluster(ctx, req.Client)
+	if err != nil {
+		log.Debug("could not read registry mirrors from the cluster", "error", err)
+	}
+
+	mirrors = append(mirrors, clusterMirrors...)
+
+	log.Debug("registry mirrors", "configured", len(c.registryMirrors), "cluster", len(clusterMirrors))
+
+	// Workbenches commonly share images, so an ImageStreamTag of the RStudio analysis is looked
+	// up once for all notebooks.
+	istCache := newImageStreamTagCache(req.Client, appNS, req.LowMemory)
+
+	var verifier *registryVerifier
+	if c.verifyRegistry {
+		verifier = newRegistryVerifier(c.newInspector(ctx, req.Client, log))
+	}
 
 	// Analyze each notebook.
 	var analyses []notebookAnalysis
 
 	for _, nb := range notebooks {
-		analysis := c.analyzeNotebook(ctx, req.Client, nb, ootbImages, imageStreamData, appNS, log)
+		pods, err := req.Pods(ctx, nb)
+		if err != nil {
+			return err
+		}
+
+		analysis := c.analyzeNotebook(ctx, istCache, nb, pods, ootbImages, imageStreamData, mirrors, verifier, log)
 		analyses = append(analyses, analysis)
 	}
 
@@ -335,7 +300,7 @@ func (c *ImpactedWorkloadsCheck) discoverOOTBImageStreams(
 	ctx context.Context,
 	reader client.Reader,
 	appNS string,
-	log debugLogger,
+	log *slog.Logger,
 ) (map[string]ootbImageStream, []*unstructured.Unstructured, error) {
 	imageStreams, err := reader.List(ctx, resources.ImageStream,
 		client.WithNamespace(appNS),
@@ -363,8 +328,7 @@ func (c *ImpactedWorkloadsCheck) discoverOOTBImageStreams(
 		// These are user-contributed custom images, not operator-managed OOTB images.
 		annotations := is.GetAnnotations()
 		if annotations == nil || annotations[ootbPlatformVersionAnnotation] == "" {
-			log.logf("[notebook]   ImageStream %s: skipped (no %s annotation - custom image)",
-				name, ootbPlatformVersionAnnotation)
+			log.Debug("skipping custom ImageStream", "imageStream", name, "missingAnnotation", ootbPlatformVersionAnnotation)
 
 			continue
 		}
@@ -377,7 +341,7 @@ func (c *ImpactedWorkloadsCheck) discoverOOTBImageStreams(
 			DockerImageRepository: dockerRepo,
 		}
 
-		log.logf("[notebook]   ImageStream %s: type=%s, dockerRepo=%s", name, nbType, dockerRepo)
+		log.Debug("OOTB ImageStream", "imageStream", name, "type", nbType, "dockerRepo", dockerRepo)
 	}
 
 	return ootbImages, imageStreams, nil
@@ -426,26 +390,30 @@ func (c *ImpactedWorkloadsCheck) hasAnnotationWithName(is *unstructured.Unstruct
 }
 
 // analyzeNotebook analyzes a single notebook for image compatibility.
-// All container images must be compatible for the notebook to be compatible.
+// All container images must be compatible for the notebook to be compatible. With --deep, the
+// images its pods actually run are analyzed too, as a pod not restarted since the spec changed
+// still runs the previous image.
 func (c *ImpactedWorkloadsCheck) analyzeNotebook(
 	ctx context.Context,
-	reader client.Reader,
+	istCache *imageStreamTagCache,
 	nb *unstructured.Unstructured,
+	pods []*corev1.Pod,
 	ootbImages map[string]ootbImageStream,
 	imageStreamData []*unstructured.Unstructured,
-	appNS string,
-	log debugLogger,
+	mirrors mirror.Set,
+	verifier *registryVerifier,
+	log *slog.Logger,
 ) notebookAnalysis {
 	ns := nb.GetNamespace()
 	name := nb.GetName()
 
-	log.logf("[notebook] Analyzing %s/%s", ns, name)
+	log = log.With("namespace", ns, "notebook", name)
+	log.Debug("analyzing notebook")
 
 	// Extract workload containers (infrastructure sidecars already filtered out).
 	containers, err := ExtractWorkloadContainers(nb)
 	if err != nil || len(containers) == 0 {
-		log.logf("[notebook]   %s/%s: VERIFY_FAILED - could not extract containers (err=%v, count=%d)",
-			ns, name, err, len(containers))
+		log.Debug("could not extract containers", "status", ImageStatusVerifyFailed, "error", err, "count", len(containers))
 
 		return notebookAnalysis{
 			Namespace: ns,
@@ -460,8 +428,7 @@ func (c *ImpactedWorkloadsCheck) analyzeNotebook(
 
 	for _, container := range containers {
 		if container.Image == "" {
-			log.logf("[notebook]   %s/%s: VERIFY_FAILED - container %s has no image",
-				ns, name, container.Name)
+			log.Debug("container has no image", "status", ImageStatusVerifyFailed, "container", container.Name)
 			imageAnalyses = append(imageAnalyses, imageAnalysis{
 				ContainerName: container.Name,
 				Status:        ImageStatusVerifyFailed,
@@ -471,12 +438,28 @@ func (c *ImpactedWorkloadsCheck) analyzeNotebook(
 			continue
 		}
 
-		analysis := c.analyzeImage(ctx, reader, container.Image, ootbImages, imageStreamData, appNS, log)
+		analysis := c.analyzeImage(ctx, istCache, container.Image, ootbImages, imageStreamData, mirrors, verifier, log)
 		analysis.ContainerName = container.Name
 		analysis.ImageRef = container.Image
 
-		log.logf("[notebook]   %s/%s container %s: status=%s reason=%q",
-			ns, name, container.Name, analysis.Status, analysis.Reason)
+		log.Debug("analyzed container image", "container", container.Name, "status", analysis.Status, "reason", analysis.Reason)
+
+		imageAnalyses = append(imageAnalyses, analysis)
+	}
+
+	specImages := make(map[string]string, len(containers))
+	for _, container := range containers {
+		specImages[container.Name] = container.Image
+	}
+
+	for _, stale := range validate.StaleImages(pods, specImages) {
+		analysis := c.analyzeImage(ctx, istCache, stale.Running, ootbImages, imageStreamData, mirrors, verifier, log)
+		analysis.ContainerName = stale.Container
+		analysis.ImageRef = stale.Running
+		analysis.Reason = fmt.Sprintf(MsgStaleImage, stale.Pod, stale.Running, stale.Spec, analysis.Reason)
+
+		log.Debug("analyzed stale running image", "pod", stale.Pod, "container", stale.Container,
+			"image", stale.Running, "status", analysis.Status)
 
 		imageAnalyses = append(imageAnalyses, analysis)
 	}
@@ -491,21 +474,72 @@ func (c *ImpactedWorkloadsCheck) analyzeNotebook(
 // 2. SHA lookup: Match SHA against .status.tags[*].items[*].image
 // 3. dockerImageRepository: Match path against .status.dockerImageRepository (internal registry)
 // 4. spec from.name: Exact match against .spec.tags[*].from.name (disconnected clusters)
+// Images pulled from a registry mirror are also correlated through the source references they
+// mirror, as ImageStreams on disconnected clusters keep referencing the source registry.
+// With --verify-registry, images still not correlated are verified in their registry (see verifyImage).
 // If none match, the image is classified as CUSTOM (user-provided image requiring manual verification).
 func (c *ImpactedWorkloadsCheck) analyzeImage(
 	ctx context.Context,
-	reader client.Reader,
+	istCache *imageStreamTagCache,
 	image string,
 	ootbImages map[string]ootbImageStream,
 	imageStreamData []*unstructured.Unstructured,
-	appNS string,
-	log debugLogger,
+	mirrors mirror.Set,
+	verifier *registryVerifier,
+	log *slog.Logger,
 ) imageAnalysis {
+	for _, candidate := range append([]string{image}, mirrors.Sources(image)...) {
+		if candidate != image {
+			log.Debug("correlating mirrored image through its source", "image", image, "source", candidate)
+		}
+
+		if analysis, found := c.correlateImage(ctx, istCache, candidate, ootbImages, imageStreamData, log); found {
+			return analysis
+		}
+	}
+
+	// No OOTB correlation found - mark as custom image requiring user verification.
+	// We intentionally do NOT use name-based matching as a fallback because an image
+	// from any registry could coincidentally have the same name as an OOTB ImageStream.
+	reason := fmt.Sprintf("Image '%s' is not a recognized OOTB notebook image", parseImageReference(image).Name)
+
+	// With --verify-registry, the registry metadata of the image may still correlate it.
+	if verifier != nil {
+		analysis, digest, found, err := c.verifyImage(ctx, istCache, verifier, image, ootbImages, imageStreamData, log)
+
+		switch {
+		case err != nil:
+			reason = fmt.Sprintf(MsgRegistryFailed, reason, err)
+		case found:
+			return analysis
+		default:
+			reason = fmt.Sprintf(MsgRegistryUnmatched, reason, truncateSHA(digest))
+		}
+	}
+
+	log.Debug("image matched no strategy", "status", ImageStatusCustom)
+
+	return imageAnalysis{
+		Status: ImageStatusCustom,
+		Reason: reason,
+	}
+}
+
+// correlateImage runs the lookup strategies of analyzeImage against a single image reference.
+// Returns false when no strategy correlates the image to an OOTB ImageStream.
+func (c *ImpactedWorkloadsCheck) correlateImage(
+	ctx context.Context,
+	istCache *imageStreamTagCache,
+	image string,
+	ootbImages map[string]ootbImageStream,
+	imageStreamData []*unstructured.Unstructured,
+	log *slog.Logger,
+) (imageAnalysis, bool) {
 	// Parse image reference to get name, tag, SHA, and full path.
 	ref := parseImageReference(image)
 
-	log.logf("[notebook]     image=%s parsed: name=%s tag=%s sha=%s fullPath=%s",
-		image, ref.Name, ref.Tag, truncateSHA(ref.SHA), ref.FullPath)
+	log.Debug("parsed image reference", "image", image, "name", ref.Name, "tag", ref.Tag,
+		"sha", truncateSHA(ref.SHA), "fullPath", ref.FullPath)
 
 	// Strategy 1: dockerImageReference lookup - exact match against external registry references.
 	// Matches container image like: registry.redhat.io/rhoai/...@sha256:xxx
@@ -514,58 +548,57 @@ func (c *ImpactedWorkloadsCheck) analyzeImage(
 	if lookup.Found {
 		ootbIS, isOOTB := ootbImages[lookup.ImageStreamName]
 		if isOOTB {
-			log.logf("[notebook]     Strategy 1 (dockerImageRef) matched: is=%s tag=%s type=%s",
-				lookup.ImageStreamName, lookup.Tag, ootbIS.Type)
+			log.Debug("image matched", "strategy", "dockerImageRef", "imageStream", lookup.ImageStreamName,
+				"tag", lookup.Tag, "type", ootbIS.Type)
 
-			return c.analyzeOOTBImage(ctx, reader, ootbImageInput{
+			return c.analyzeOOTBImage(ctx, istCache, ootbImageInput{
 				ImageStreamName: lookup.ImageStreamName,
 				Tag:             lookup.Tag,
 				SHA:             ref.SHA,
 				Type:            ootbIS.Type,
-			}, imageStreamData, appNS, log)
+			}, imageStreamData, log), true
 		}
 
-		log.logf("[notebook]     Strategy 1 matched is=%s but not in OOTB map (possibly runtime image)",
-			lookup.ImageStreamName)
+		log.Debug("image matched a non-OOTB ImageStream (possibly runtime image)", "strategy", "dockerImageRef",
+			"imageStream", lookup.ImageStreamName)
 	}
 
 	// Strategy 2: SHA lookup - search all OOTB ImageStreams for this SHA.
 	// Matches container image SHA against: .status.tags[*].items[*].image
 	if ref.SHA == "" {
-		log.logf("[notebook]     Strategy 2 skipped: no SHA in image reference")
+		log.Debug("image strategy skipped: no SHA in image reference", "strategy", "sha")
 	} else if lookup := c.findImageStreamForSHA(ref.SHA, imageStreamData); !lookup.Found {
-		log.logf("[notebook]     Strategy 2 (SHA lookup): no match for sha=%s", truncateSHA(ref.SHA))
+		log.Debug("image not matched", "strategy", "sha", "sha", truncateSHA(ref.SHA))
 	} else if ootbIS, isOOTB := ootbImages[lookup.ImageStreamName]; isOOTB {
-		log.logf("[notebook]     Strategy 2 (SHA lookup) matched: is=%s tag=%s type=%s",
-			lookup.ImageStreamName, lookup.Tag, ootbIS.Type)
+		log.Debug("image matched", "strategy", "sha", "imageStream", lookup.ImageStreamName,
+			"tag", lookup.Tag, "type", ootbIS.Type)
 
-		return c.analyzeOOTBImage(ctx, reader, ootbImageInput{
+		return c.analyzeOOTBImage(ctx, istCache, ootbImageInput{
 			ImageStreamName: lookup.ImageStreamName,
 			Tag:             lookup.Tag,
 			SHA:             ref.SHA,
 			Type:            ootbIS.Type,
-		}, imageStreamData, appNS, log)
+		}, imageStreamData, log), true
 	} else {
-		log.logf("[notebook]     Strategy 2 matched is=%s but not in OOTB map",
-			lookup.ImageStreamName)
+		log.Debug("image matched a non-OOTB ImageStream", "strategy", "sha", "imageStream", lookup.ImageStreamName)
 	}
 
 	// Strategy 3: dockerImageRepository lookup - match container image path against internal registry path.
 	// Matches container image like: image-registry.openshift-image-registry.svc:5000/ns/name:tag
 	// Against ImageStream's: .status.dockerImageRepository
 	if ootbIS, found := c.findImageStreamByDockerRepo(ref.FullPath, ootbImages); found {
-		log.logf("[notebook]     Strategy 3 (dockerImageRepo) matched: is=%s tag=%s type=%s",
-			ootbIS.Name, ref.Tag, ootbIS.Type)
+		log.Debug("image matched", "strategy", "dockerImageRepo", "imageStream", ootbIS.Name,
+			"tag", ref.Tag, "type", ootbIS.Type)
 
-		return c.analyzeOOTBImage(ctx, reader, ootbImageInput{
+		return c.analyzeOOTBImage(ctx, istCache, ootbImageInput{
 			ImageStreamName: ootbIS.Name,
 			Tag:             ref.Tag,
 			SHA:             ref.SHA,
 			Type:            ootbIS.Type,
-		}, imageStreamData, appNS, log)
+		}, imageStreamData, log), true
 	}
 
-	log.logf("[notebook]     Strategy 3 (dockerImageRepo): no match for path=%s", ref.FullPath)
+	log.Debug("image not matched", "strategy", "dockerImageRepo", "fullPath", ref.FullPath)
 
 	// Strategy 4: spec from.name lookup - exact match against source image references.
 	// Handles disconnected clusters where .status.tags[*].items is null (import failed)
@@ -574,48 +607,54 @@ func (c *ImpactedWorkloadsCheck) analyzeImage(
 	if lookup.Found {
 		ootbIS, isOOTB := ootbImages[lookup.ImageStreamName]
 		if isOOTB {
-			log.logf("[notebook]     Strategy 4 (specRef) matched: is=%s tag=%s type=%s",
-				lookup.ImageStreamName, lookup.Tag, ootbIS.Type)
+			log.Debug("image matched", "strategy", "specRef", "imageStream", lookup.ImageStreamName,
+				"tag", lookup.Tag, "type", ootbIS.Type)
 
-			return c.analyzeOOTBImage(ctx, reader, ootbImageInput{
+			return c.analyzeOOTBImage(ctx, istCache, ootbImageInput{
 				ImageStreamName: lookup.ImageStreamName,
 				Tag:             lookup.Tag,
 				SHA:             ref.SHA,
 				Type:            ootbIS.Type,
-			}, imageStreamData, appNS, log)
+			}, imageStreamData, log), true
 		}
 
-		log.logf("[notebook]     Strategy 4 matched is=%s but not in OOTB map", lookup.ImageStreamName)
+		log.Debug("image matched a non-OOTB ImageStream", "strategy", "specRef", "imageStream", lookup.ImageStreamName)
 	}
 
-	log.logf("[notebook]     Strategy 4 (specRef): no match for image=%s", image)
-
-	// No OOTB correlation found - mark as custom image requiring user verification.
-	// We intentionally do NOT use name-based matching as a fallback because an image
-	// from any registry could coincidentally have the same name as an OOTB ImageStream.
-	log.logf("[notebook]     All strategies failed -> CUSTOM")
+	log.Debug("image not matched", "strategy", "specRef", "image", image)
 
-	return imageAnalysis{
-		Status: ImageStatusCustom,
-		Reason: fmt.Sprintf("Image '%s' is not a recognized OOTB notebook image", ref.Name),
-	}
+	return imageAnalysis{}, false
 }
 
-// analyzeOOTBImage analyzes an OOTB notebook image for compatibility.
+// analyzeOOTBImage analyzes an OOTB notebook image for compatibility and records the ImageStream
+// it was resolved to.
 func (c *ImpactedWorkloadsCheck) analyzeOOTBImage(
 	ctx context.Context,
-	reader client.Reader,
+	istCache *imageStreamTagCache,
 	input ootbImageInput,
 	imageStreamData []*unstructured.Unstructured,
-	appNS string,
-	log debugLogger,
+	log *slog.Logger,
+) imageAnalysis {
+	analysis := c.classifyOOTBImage(ctx, istCache, input, imageStreamData, log)
+	analysis.ImageStream = input.ImageStreamName
+
+	return analysis
+}
+
+// classifyOOTBImage determines the compatibility status of an OOTB notebook image.
+func (c *ImpactedWorkloadsCheck) classifyOOTBImage(
+	ctx context.Context,
+	istCache *imageStreamTagCache,
+	input ootbImageInput,
+	imageStreamData []*unstructured.Unstructured,
+	log *slog.Logger,
 ) imageAnalysis {
-	log.logf("[notebook]     analyzeOOTBImage: is=%s tag=%s sha=%s type=%s",
-		input.ImageStreamName, input.Tag, truncateSHA(input.SHA), input.Type)
+	log.Debug("analyzing OOTB image", "imageStream", input.ImageStreamName, "tag", input.Tag,
+		"sha", truncateSHA(input.SHA), "type", input.Type)
 
 	// Jupyter images are always compatible.
 	if input.Type == NotebookTypeJupyter {
-		log.logf("[notebook]     -> GOOD (Jupyter always compatible)")
+		log.Debug("Jupyter images are always compatible", "status", ImageStatusGood)
 
 		return imageAnalysis{
 			Status: ImageStatusGood,
@@ -625,13 +664,13 @@ func (c *ImpactedWorkloadsCheck) analyzeOOTBImage(
 
 	// For RStudio, check build reference.
 	if input.Type == NotebookTypeRStudio {
-		log.logf("[notebook]     -> checking RStudio build reference")
+		log.Debug("checking RStudio build reference")
 
-		return c.analyzeRStudioImageCompat(ctx, reader, input.ImageStreamName, input.Tag, input.SHA, appNS, log)
+		return c.analyzeRStudioImageCompat(ctx, istCache, input.ImageStreamName, input.Tag, input.SHA, log)
 	}
 
 	// For CodeServer and other non-Jupyter images, check tag version.
-	log.logf("[notebook]     -> checking tag-based compatibility (type=%s)", input.Type)
+	log.Debug("checking tag-based compatibility", "type", input.Type)
 
 	return c.analyzeTagBasedImageCompat(input.ImageStreamName, input.Tag, input.SHA, input.Type, imageStreamData, log)
 }
@@ -808,18 +847,18 @@ func (c *ImpactedWorkloadsCheck) findImageStreamBySpecRef(
 	return imageLookupResult{}
 }
 
-// collectReasonsForStatus collects reasons and the first image ref for analyses matching the given status.
-func collectReasonsForStatus(analyses []imageAnalysis, status ImageStatus) ([]string, string) {
+// collectReasonsForStatus collects reasons and the first analysis for analyses matching the given status.
+func collectReasonsForStatus(analyses []imageAnalysis, status ImageStatus) ([]string, *imageAnalysis) {
 	var reasons []string
-	var imageRef string
+	var first *imageAnalysis
 
-	for _, a := range analyses {
+	for i, a := range analyses {
 		if a.Status != status {
 			continue
 		}
 
-		if imageRef == "" {
-			imageRef = a.ImageRef
+		if first == nil {
+			first = &analyses[i]
 		}
 
 		if a.ContainerName != "" {
@@ -829,7 +868,7 @@ func collectReasonsForStatus(analyses []imageAnalysis, status ImageStatus) ([]st
 		}
 	}
 
-	return reasons, imageRef
+	return reasons, first
 }
 
 // findFirstWithStatus returns the first analysis matching the given status, or nil if none found.
@@ -860,24 +899,26 @@ func (c *ImpactedWorkloadsCheck) aggregateImageAnalyses(
 	}
 
 	// Check for PRE_UPGRADE_ACTION_REQUIRED images - these block the upgrade.
-	if reasons, imageRef := collectReasonsForStatus(analyses, ImageStatusPreUpgradeActionRequired); len(reasons) > 0 {
+	if reasons, first := collectReasonsForStatus(analyses, ImageStatusPreUpgradeActionRequired); len(reasons) > 0 {
 		return notebookAnalysis{
-			Namespace: ns,
-			Name:      name,
-			Status:    ImageStatusPreUpgradeActionRequired,
-			Reason:    strings.Join(reasons, "; "),
-			ImageRef:  imageRef,
+			Namespace:   ns,
+			Name:        name,
+			Status:      ImageStatusPreUpgradeActionRequired,
+			Reason:      strings.Join(reasons, "; "),
+			ImageRef:    first.ImageRef,
+			ImageStream: first.ImageStream,
 		}
 	}
 
 	// Check for POST_UPGRADE_ACTION_REQUIRED images - advisory, fix after upgrade.
-	if reasons, imageRef := collectReasonsForStatus(analyses, ImageStatusPostUpgradeActionRequired); len(reasons) > 0 {
+	if reasons, first := collectReasonsForStatus(analyses, ImageStatusPostUpgradeActionRequired); len(reasons) > 0 {
 		return notebookAnalysis{
-			Namespace: ns,
-			Name:      name,
-			Status:    ImageStatusPostUpgradeActionRequired,
-			Reason:    strings.Join(reasons, "; "),
-			ImageRef:  imageRef,
+			Namespace:   ns,
+			Name:        name,
+			Status:      ImageStatusPostUpgradeActionRequired,
+			Reason:      strings.Join(reasons, "; "),
+			ImageRef:    first.ImageRef,
+			ImageStream: first.ImageStream,
 		}
 	}
 
@@ -916,10 +957,9 @@ func (c *ImpactedWorkloadsCheck) aggregateImageAnalyses(
 // analyzeRStudioImageCompat analyzes an RStudio image by checking its build reference.
 func (c *ImpactedWorkloadsCheck) analyzeRStudioImageCompat(
 	ctx context.Context,
-	reader client.Reader,
+	istCache *imageStreamTagCache,
 	imageName, imageTag, imageSHA string,
-	appNS string,
-	log debugLogger,
+	log *slog.Logger,
 ) imageAnalysis {
 	// Look up the ImageStreamTag to get build reference.
 	// Use the tag from the annotation, fall back to "latest" if not available.
@@ -930,10 +970,10 @@ func (c *ImpactedWorkloadsCheck) analyzeRStudioImageCompat(
 
 	istName := imageName + ":" + tag
 
-	ist, err := reader.GetResource(ctx, resources.ImageStreamTag, istName,
-		client.InNamespace(appNS))
+	ist, err := istCache.get(ctx, istName)
 	if err != nil {
-		log.logf("[notebook]     RStudio: VERIFY_FAILED - could not fetch ImageStreamTag %s: %v", istName, err)
+		log.Debug("could not fetch RStudio ImageStreamTag", "status", ImageStatusVerifyFailed,
+			"imageStreamTag", istName, "error", err)
 
 		return imageAnalysis{
 			Status: ImageStatusVerifyFailed,
@@ -944,7 +984,8 @@ func (c *ImpactedWorkloadsCheck) analyzeRStudioImageCompat(
 	// Extract OPENSHIFT_BUILD_REFERENCE from the image's environment variables.
 	buildRef := c.extractBuildReference(ist)
 	if buildRef == "" {
-		log.logf("[notebook]     RStudio: VERIFY_FAILED - no OPENSHIFT_BUILD_REFERENCE in %s", istName)
+		log.Debug("no OPENSHIFT_BUILD_REFERENCE in RStudio ImageStreamTag", "status", ImageStatusVerifyFailed,
+			"imageStreamTag", istName)
 
 		return imageAnalysis{
 			Status: ImageStatusVerifyFailed,
@@ -952,7 +993,7 @@ func (c *ImpactedWorkloadsCheck) analyzeRStudioImageCompat(
 		}
 	}
 
-	log.logf("[notebook]     RStudio: buildRef=%s", buildRef)
+	log.Debug("RStudio build reference", "buildRef", buildRef)
 
 	// Check if the current ImageStreamTag points to the same image SHA.
 	currentSHA, _ := jq.Query[string](ist, ".image.metadata.name")
@@ -978,26 +1019,76 @@ func (c *ImpactedWorkloadsCheck) analyzeRStudioImageCompat(
 	}
 }
 
+// imageStreamTagCache remembers the ImageStreamTags missing from the applications namespace
+// (e.g. a tag not mirrored to a disconnected cluster) for a run of the check. Found tags are
+// cached by the caching reader of the run; in low-memory mode the run has none, so they are
+// kept here. Other errors are retried on the next request.
+type imageStreamTagCache struct {
+	reader    client.Reader
+	namespace string
+	found     map[string]*unstructured.Unstructured
+	notFound  map[string]error
+}
+
+// newImageStreamTagCache returns a cache reading from the applications namespace. keepFound
+// keeps the tags found as well, for readers that do not cache them.
+func newImageStreamTagCache(reader client.Reader, namespace string, keepFound bool) *imageStreamTagCache {
+	c := &imageStreamTagCache{
+		reader:    reader,
+		namespace: namespace,
+		notFound:  make(map[string]error),
+	}
+
+	if keepFound {
+		c.found = make(map[string]*unstructured.Unstructured)
+	}
+
+	return c
+}
+
+// get returns the ImageStreamTag with the given name ("<imagestream>:<tag>"), looking up a
+// missing tag only once.
+func (c *imageStreamTagCache) get(ctx context.Context, name string) (*unstructured.Unstructured, error) {
+	if ist, ok := c.found[name]; ok {
+		return ist, nil
+	}
+
+	if err, ok := c.notFound[name]; ok {
+		return nil, err
+	}
+
+	ist, err := c.reader.GetResource(ctx, resources.ImageStreamTag, name, client.InNamespace(c.namespace))
+
+	switch {
+	case apierrors.IsNotFound(err):
+		c.notFound[name] = err
+	case err == nil && c.found != nil:
+		c.found[name] = ist
+	}
+
+	return ist, err //nolint:wrapcheck // callers report the ImageStreamTag name
+}
+
 // analyzeTagBasedImageCompat analyzes a non-RStudio image by checking its tag version.
 func (c *ImpactedWorkloadsCheck) analyzeTagBasedImageCompat(
 	imageName, imageTag, imageSHA string,
 	nbType NotebookType,
 	imageStreamData []*unstructured.Unstructured,
-	log debugLogger,
+	log *slog.Logger,
 ) imageAnalysis {
 	// Use tag from annotation if available, otherwise look up by SHA.
 	tag := imageTag
 	if tag == "" {
 		tag = c.findTagForSHA(imageSHA, imageName, imageStreamData)
-		log.logf("[notebook]     tag-based: imageTag empty, looked up by SHA -> tag=%q", tag)
+		log.Debug("image tag empty, looked up by SHA", "tag", tag)
 	}
 
-	log.logf("[notebook]     tag-based: using tag=%q for %s image %s", tag, nbType, imageName)
+	log.Debug("checking image tag", "tag", tag, "type", nbType, "imageStream", imageName)
 
 	// If we have a valid version tag, check if it's compliant.
 	if isValidVersionTag(tag) {
 		if isTagGTE(tag, nginxFixMinTag) {
-			log.logf("[notebook]     tag-based: tag %s >= %s -> GOOD", tag, nginxFixMinTag)
+			log.Debug("image tag at or above minimum", "tag", tag, "minTag", nginxFixMinTag, "status", ImageStatusGood)
 
 			return imageAnalysis{
 				Status: ImageStatusGood,
@@ -1005,12 +1096,12 @@ func (c *ImpactedWorkloadsCheck) analyzeTagBasedImageCompat(
 			}
 		}
 
-		log.logf("[notebook]     tag-based: tag %s < %s, checking SHA cross-reference", tag, nginxFixMinTag)
+		log.Debug("image tag below minimum, checking SHA cross-reference", "tag", tag, "minTag", nginxFixMinTag)
 
 		// Tag is below minimum - check if SHA is also tagged with a compliant version.
 		compliantTag := c.findCompliantTagForSHA(imageSHA, imageStreamData)
 		if compliantTag != "" {
-			log.logf("[notebook]     tag-based: SHA cross-ref found compliant tag %s -> GOOD", compliantTag)
+			log.Debug("SHA cross-reference found compliant tag", "tag", compliantTag, "status", ImageStatusGood)
 
 			return imageAnalysis{
 				Status: ImageStatusGood,
@@ -1018,7 +1109,7 @@ func (c *ImpactedWorkloadsCheck) analyzeTagBasedImageCompat(
 			}
 		}
 
-		log.logf("[notebook]     tag-based: no compliant SHA cross-ref -> PRE_UPGRADE_ACTION_REQUIRED")
+		log.Debug("no compliant SHA cross-reference", "status", ImageStatusPreUpgradeActionRequired)
 
 		return imageAnalysis{
 			Status: ImageStatusPreUpgradeActionRequired,
@@ -1026,15 +1117,15 @@ func (c *ImpactedWorkloadsCheck) analyzeTagBasedImageCompat(
 		}
 	}
 
-	log.logf("[notebook]     tag-based: tag %q not valid version format (expected YYYY.N)", tag)
+	log.Debug("image tag is not a YYYY.N version", "tag", tag)
 
 	// No valid version tag found - try SHA cross-reference.
 	if imageSHA != "" {
-		log.logf("[notebook]     tag-based: trying SHA cross-reference for sha=%s", truncateSHA(imageSHA))
+		log.Debug("trying SHA cross-reference", "sha", truncateSHA(imageSHA))
 
 		compliantTag := c.findCompliantTagForSHA(imageSHA, imageStreamData)
 		if compliantTag != "" {
-			log.logf("[notebook]     tag-based: SHA cross-ref found compliant tag %s -> GOOD", compliantTag)
+			log.Debug("SHA cross-reference found compliant tag", "tag", compliantTag, "status", ImageStatusGood)
 
 			return imageAnalysis{
 				Status: ImageStatusGood,
@@ -1042,12 +1133,12 @@ func (c *ImpactedWorkloadsCheck) analyzeTagBasedImageCompat(
 			}
 		}
 
-		log.logf("[notebook]     tag-based: SHA cross-ref found no compliant tag")
+		log.Debug("SHA cross-reference found no compliant tag")
 	} else {
-		log.logf("[notebook]     tag-based: no SHA available for cross-reference")
+		log.Debug("no SHA available for cross-reference")
 	}
 
-	log.logf("[notebook]     tag-based: -> VERIFY_FAILED (no valid tag, no SHA cross-ref)")
+	log.Debug("no valid tag and no SHA cross-reference", "status", ImageStatusVerifyFailed)
 
 	return imageAnalysis{
 		Status: ImageStatusVerifyFailed,
@@ -1220,16 +1311,13 @@ func (c *ImpactedWorkloadsCheck) setConditions(
 	totalImages := len(allImages)
 
 	// Build multi-line breakdown message with image counts.
-	lines := []string{
-		fmt.Sprintf(MsgNotebookImageSummary, totalCount, totalImages),
-		fmt.Sprintf(MsgCompatibleCount, counters[ImageStatusGood].count, len(counters[ImageStatusGood].images), targetVersionLabel),
-		fmt.Sprintf(MsgCustomCount, counters[ImageStatusCustom].count, len(counters[ImageStatusCustom].images)),
-		fmt.Sprintf(MsgIncompatibleCount, counters[ImageStatusPreUpgradeActionRequired].count, len(counters[ImageStatusPreUpgradeActionRequired].images)),
-		fmt.Sprintf(MsgPostUpgradeCount, counters[ImageStatusPostUpgradeActionRequired].count, len(counters[ImageStatusPostUpgradeActionRequired].images)),
-		fmt.Sprintf(MsgUnverifiedCount, counters[ImageStatusVerifyFailed].count, len(counters[ImageStatusVerifyFailed].images)),
-	}
-
-	message := strings.Join(lines, "\n")
+	message := check.WithCatalogMessage(MsgNotebookImageSummary,
+		totalCount, totalImages,
+		counters[ImageStatusGood].count, len(counters[ImageStatusGood].images), targetVersionLabel,
+		counters[ImageStatusCustom].count, len(counters[ImageStatusCustom].images),
+		counters[ImageStatusPreUpgradeActionRequired].count, len(counters[ImageStatusPreUpgradeActionRequired].images),
+		counters[ImageStatusPostUpgradeActionRequired].count, len(counters[ImageStatusPostUpgradeActionRequired].images),
+		counters[ImageStatusVerifyFailed].count, len(counters[ImageStatusVerifyFailed].images))
 
 	switch {
 	case counters[ImageStatusPreUpgradeActionRequired].count > 0:
@@ -1238,7 +1326,7 @@ func (c *ImpactedWorkloadsCheck) setConditions(
 			ConditionTypeNotebooksCompatible,
 			metav1.ConditionFalse,
 			check.WithReason(check.ReasonWorkloadsImpacted),
-			check.WithMessage("%s", message),
+			message,
 			check.WithImpact(result.ImpactAdvisory),
 			check.WithRemediation(c.CheckRemediation),
 		))
@@ -1251,9 +1339,9 @@ func (c *ImpactedWorkloadsCheck) setConditions(
 			ConditionTypeNotebooksCompatible,
 			metav1.ConditionFalse,
 			check.WithReason(check.ReasonWorkloadsImpacted),
-			check.WithMessage("%s", message),
+			message,
 			check.WithImpact(result.ImpactAdvisory),
-			check.WithRemediation(fmt.Sprintf(MsgVerifyCustomImages, targetVersionLabel)),
+			check.WithCatalogRemediation(MsgVerifyCustomImages, targetVersionLabel),
 		))
 
 	default:
@@ -1262,7 +1350,7 @@ func (c *ImpactedWorkloadsCheck) setConditions(
 			ConditionTypeNotebooksCompatible,
 			metav1.ConditionTrue,
 			check.WithReason(check.ReasonVersionCompatible),
-			check.WithMessage(MsgAllNotebooksCompatible, totalCount),
+			check.WithCatalogMessage(MsgAllNotebooksCompatible, totalCount),
 		))
 	}
 }
@@ -1284,16 +1372,22 @@ func (c *ImpactedWorkloadsCheck) setImpactedObjects(
 			continue
 		}
 
+		annotations := map[string]string{
+			AnnotationCheckImageStatus: string(a.Status),
+			AnnotationCheckImageRef:    a.ImageRef,
+			AnnotationCheckReason:      a.Reason,
+		}
+
+		if a.ImageStream != "" {
+			annotations[AnnotationCheckImageStream] = a.ImageStream
+		}
+
 		impacted = append(impacted, metav1.PartialObjectMetadata{
 			TypeMeta: resources.Notebook.TypeMeta(),
 			ObjectMeta: metav1.ObjectMeta{
-				Namespace: a.Namespace,
-				Name:      a.Name,
-				Annotations: map[string]string{
-					AnnotationCheckImageStatus: string(a.Status),
-					AnnotationCheckImageRef:    a.ImageRef,
-					AnnotationCheckReason:      a.Reason,
-				},
+				Namespace:   a.Namespace,
+				Name:        a.Name,
+				Annotations: annotations,
 			},
 		})
 	}
@@ -1426,25 +1520,6 @@ func mustParseVersionParts(v string) (int, int) {
 	return major, minor
 }
 
-// debugLogger provides debug logging when enabled.
-// Use debugLogger{} (zero value) for disabled logging.
-type debugLogger struct {
-	io      iostreams.Interface
-	enabled bool
-}
-
-// newDebugLogger creates a debugLogger that logs when enabled is true.
-func newDebugLogger(io iostreams.Interface, enabled bool) debugLogger {
-	return debugLogger{io: io, enabled: enabled}
-}
-
-// logf writes a debug message if logging is enabled and io is not nil.
-func (d debugLogger) logf(format string, args ...any) {
-	if d.enabled && d.io != nil {
-		d.io.Errorf(format, args...)
-	}
-}
-
 // truncateSHA returns a shortened version of a SHA for logging purposes.
 // Returns the first 12 characters of the SHA (after "sha256:" prefix if present).
 func truncateSHA(sha string) string {
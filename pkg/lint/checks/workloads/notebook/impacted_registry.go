@@ -0,0 +1,258 @@
+package notebook
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"log/slog"
+	"path"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+	"github.com/opendatahub-io/odh-cli/pkg/util/jq"
+	"github.com/opendatahub-io/odh-cli/pkg/util/registry"
+)
+
+const (
+	// Global pull secret of the cluster, used by nodes to pull every image.
+	clusterPullSecretNamespace = "openshift-config"
+	clusterPullSecretName      = "pull-secret"
+
+	// Annotation of OOTB ImageStream tags holding the commit their image was built from.
+	buildCommitAnnotation = "opendatahub.io/notebook-build-commit"
+)
+
+// buildCommitLabels are the image labels holding the commit an image was built from, in order
+// of preference.
+//
+//nolint:gochecknoglobals // Static label list; Go has no const slices.
+var buildCommitLabels = []string{"io.openshift.build.commit.id", "vcs-ref"}
+
+// ImageInspector reads the metadata of images from their registry (see registry.Client).
+type ImageInspector interface {
+	Inspect(ctx context.Context, image string) (*registry.Image, error)
+}
+
+// registryVerifier verifies images against their registry, inspecting each image once for a
+// run of the check as workbenches commonly share images.
+type registryVerifier struct {
+	inspector ImageInspector
+	images    map[string]inspectedImage
+}
+
+// inspectedImage is the outcome of inspecting an image.
+type inspectedImage struct {
+	image *registry.Image
+	err   error
+}
+
+func newRegistryVerifier(inspector ImageInspector) *registryVerifier {
+	return &registryVerifier{
+		inspector: inspector,
+		images:    make(map[string]inspectedImage),
+	}
+}
+
+func (v *registryVerifier) inspect(ctx context.Context, image string) (*registry.Image, error) {
+	if inspected, ok := v.images[image]; ok {
+		return inspected.image, inspected.err
+	}
+
+	img, err := v.inspector.Inspect(ctx, image)
+	v.images[image] = inspectedImage{image: img, err: err}
+
+	return img, err //nolint:wrapcheck // callers report the image
+}
+
+// SetVerifyRegistry enables the verification of images no lookup strategy correlates against
+// their registry, with the credentials of the cluster pull secret and of the local docker and
+// podman config files.
+func (c *ImpactedWorkloadsCheck) SetVerifyRegistry(enabled bool) {
+	c.verifyRegistry = enabled
+}
+
+// newRegistryInspector creates the registry client of --verify-registry. Credentials that cannot
+// be read are skipped, leaving registries that require them to fail verification.
+func newRegistryInspector(ctx context.Context, reader client.Reader, log *slog.Logger) ImageInspector {
+	clusterKeychain, err := clusterPullSecretKeychain(ctx, reader)
+	if err != nil {
+		log.Debug("could not read the cluster pull secret", "error", err)
+	}
+
+	localKeychain, err := registry.LoadLocalKeychain()
+	if err != nil {
+		log.Debug("could not read local registry credentials", "error", err)
+	}
+
+	// Local credentials are the user's own, so they win over those of the cluster
+	return registry.NewClient(clusterKeychain.Merge(localKeychain))
+}
+
+// clusterPullSecretKeychain reads the credentials of the global pull secret of the cluster.
+func clusterPullSecretKeychain(ctx context.Context, reader client.Reader) (registry.Keychain, error) {
+	secret, err := reader.GetResource(ctx, resources.Secret, clusterPullSecretName,
+		client.InNamespace(clusterPullSecretNamespace))
+	if err != nil {
+		return nil, fmt.Errorf("getting Secret %s/%s: %w", clusterPullSecretNamespace, clusterPullSecretName, err)
+	}
+
+	encoded, err := jq.Query[string](secret, `.data[".dockerconfigjson"]`)
+	if err != nil {
+		return nil, fmt.Errorf("querying .dockerconfigjson: %w", err)
+	}
+
+	data, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("decoding .dockerconfigjson: %w", err)
+	}
+
+	return registry.ParseDockerConfig(data) //nolint:wrapcheck // errors name the docker config
+}
+
+// verifyImage correlates an image to an OOTB ImageStream from its registry metadata:
+// 1. digest: the digests the image resolves to, against .status.tags[*].items[*].image and the
+// digests of .spec.tags[*].from.name (e.g. a custom tag of an OOTB image)
+// 2. build commit: the commit label and repository name of the image against the
+// opendatahub.io/notebook-build-commit annotation and .spec.tags[*].from.name (e.g. an OOTB image
+// copied to another registry)
+// Returns false, with the resolved digest, when neither matches.
+func (c *ImpactedWorkloadsCheck) verifyImage(
+	ctx context.Context,
+	istCache *imageStreamTagCache,
+	verifier *registryVerifier,
+	image string,
+	ootbImages map[string]ootbImageStream,
+	imageStreamData []*unstructured.Unstructured,
+	log *slog.Logger,
+) (imageAnalysis, string, bool, error) {
+	img, err := verifier.inspect(ctx, image)
+	if err != nil {
+		log.Debug("could not verify image in the registry", "image", image, "error", err)
+
+		return imageAnalysis{}, "", false, err
+	}
+
+	for _, digest := range img.Digests() {
+		lookup := c.findImageStreamForSHA(digest, imageStreamData)
+		if !lookup.Found {
+			lookup = findImageStreamBySpecDigest(digest, imageStreamData)
+		}
+
+		if ootbIS, isOOTB := ootbImages[lookup.ImageStreamName]; lookup.Found && isOOTB {
+			log.Debug("image matched", "strategy", "registryDigest", "imageStream", lookup.ImageStreamName,
+				"tag", lookup.Tag, "digest", truncateSHA(digest))
+
+			analysis := c.analyzeOOTBImage(ctx, istCache, ootbImageInput{
+				ImageStreamName: lookup.ImageStreamName,
+				Tag:             lookup.Tag,
+				SHA:             digest,
+				Type:            ootbIS.Type,
+			}, imageStreamData, log)
+			analysis.Reason = fmt.Sprintf(MsgRegistryVerified, analysis.Reason, "digest "+truncateSHA(digest))
+
+			return analysis, img.Digest, true, nil
+		}
+	}
+
+	for _, label := range buildCommitLabels {
+		commit := img.Labels[label]
+		if commit == "" {
+			continue
+		}
+
+		lookup := findImageStreamByBuildCommit(commit, img.Reference, imageStreamData)
+		if ootbIS, isOOTB := ootbImages[lookup.ImageStreamName]; lookup.Found && isOOTB {
+			log.Debug("image matched", "strategy", "registryBuildCommit", "imageStream", lookup.ImageStreamName,
+				"tag", lookup.Tag, "commit", commit)
+
+			// The image was rebuilt or copied, so its digest is not the one of the tag
+			analysis := c.analyzeOOTBImage(ctx, istCache, ootbImageInput{
+				ImageStreamName: lookup.ImageStreamName,
+				Tag:             lookup.Tag,
+				Type:            ootbIS.Type,
+			}, imageStreamData, log)
+			analysis.Reason = fmt.Sprintf(MsgRegistryVerified, analysis.Reason, "build commit "+commit)
+
+			return analysis, img.Digest, true, nil
+		}
+	}
+
+	log.Debug("image not matched", "strategy", "registry", "digest", truncateSHA(img.Digest))
+
+	return imageAnalysis{}, img.Digest, false, nil
+}
+
+// findImageStreamBySpecDigest finds the ImageStream tag whose .spec.tags[*].from.name references
+// the digest, on disconnected clusters where .status.tags[*].items is null.
+func findImageStreamBySpecDigest(digest string, imageStreams []*unstructured.Unstructured) imageLookupResult {
+	return findImageStreamSpecTag(imageStreams, func(tag map[string]any) bool {
+		from, _ := tag["from"].(map[string]any)
+		name, _ := from["name"].(string)
+
+		ref, err := registry.ParseReference(name)
+
+		return err == nil && ref.Digest == digest
+	})
+}
+
+// findImageStreamByBuildCommit finds the ImageStream tag whose build commit annotation is the
+// commit and whose source image has the same repository name as the image, as every OOTB image
+// of a release is built from the same commit. Abbreviated commits of at least 7 characters match
+// the full commit.
+func findImageStreamByBuildCommit(
+	commit string,
+	ref registry.Reference,
+	imageStreams []*unstructured.Unstructured,
+) imageLookupResult {
+	const minCommitLength = 7
+
+	if len(commit) < minCommitLength {
+		return imageLookupResult{}
+	}
+
+	return findImageStreamSpecTag(imageStreams, func(tag map[string]any) bool {
+		annotations, _ := tag["annotations"].(map[string]any)
+		tagCommit, _ := annotations[buildCommitAnnotation].(string)
+
+		if len(tagCommit) < minCommitLength ||
+			!strings.HasPrefix(tagCommit, commit) && !strings.HasPrefix(commit, tagCommit) {
+			return false
+		}
+
+		from, _ := tag["from"].(map[string]any)
+		name, _ := from["name"].(string)
+
+		source, err := registry.ParseReference(name)
+
+		return err == nil && path.Base(source.Repository) == path.Base(ref.Repository)
+	})
+}
+
+// findImageStreamSpecTag returns the first .spec.tags[*] entry of the ImageStreams matching.
+func findImageStreamSpecTag(
+	imageStreams []*unstructured.Unstructured,
+	matches func(tag map[string]any) bool,
+) imageLookupResult {
+	for _, is := range imageStreams {
+		specTags, err := jq.Query[[]any](is, ".spec.tags")
+		if err != nil {
+			continue
+		}
+
+		for _, tagData := range specTags {
+			tag, ok := tagData.(map[string]any)
+			if !ok || !matches(tag) {
+				continue
+			}
+
+			name, _ := tag["name"].(string)
+
+			return imageLookupResult{ImageStreamName: is.GetName(), Tag: name, Found: true}
+		}
+	}
+
+	return imageLookupResult{}
+}
@@ -1,18 +1,30 @@
 package notebook_test
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"maps"
 	"testing"
 
+	"github.com/blang/semver/v4"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	k8stesting "k8s.io/client-go/testing"
 
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
 	resultpkg "github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check/testutil"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/workloads/notebook"
 	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+	"github.com/opendatahub-io/odh-cli/pkg/util/kube/mirror"
+	"github.com/opendatahub-io/odh-cli/pkg/util/registry"
 
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
@@ -25,6 +37,10 @@ var listKinds = map[schema.GroupVersionResource]string{
 	resources.DSCInitialization.GVR():  resources.DSCInitialization.ListKind(),
 	resources.ImageStream.GVR():        resources.ImageStream.ListKind(),
 	resources.ImageStreamTag.GVR():     resources.ImageStreamTag.ListKind(),
+
+	resources.ImageDigestMirrorSet.GVR():     resources.ImageDigestMirrorSet.ListKind(),
+	resources.ImageTagMirrorSet.GVR():        resources.ImageTagMirrorSet.ListKind(),
+	resources.ImageContentSourcePolicy.GVR(): resources.ImageContentSourcePolicy.ListKind(),
 }
 
 // =============================================================================
@@ -373,7 +389,7 @@ func TestImpactedWorkloadsCheck_NoNotebooks(t *testing.T) {
 		"Type":    Equal(notebook.ConditionTypeNotebooksCompatible),
 		"Status":  Equal(metav1.ConditionTrue),
 		"Reason":  Equal(check.ReasonVersionCompatible),
-		"Message": Equal(notebook.MsgNoNotebookInstances),
+		"Message": Equal(notebook.MsgNoNotebookInstances.Format),
 	}))
 	g.Expect(result.Annotations).To(HaveKeyWithValue(check.AnnotationImpactedWorkloadCount, "0"))
 	g.Expect(result.ImpactedObjects).To(BeEmpty())
@@ -641,7 +657,7 @@ func TestImpactedWorkloadsCheck_MixedNotebooks(t *testing.T) {
 		"Type":    Equal(notebook.ConditionTypeNotebooksCompatible),
 		"Status":  Equal(metav1.ConditionFalse),
 		"Reason":  Equal(check.ReasonWorkloadsImpacted),
-		"Message": ContainSubstring(fmt.Sprintf(notebook.MsgNotebookImageSummary, 2, 2)),
+		"Message": ContainSubstring("Found 2 Notebook(s) using 2 unique images:"),
 	}))
 	g.Expect(result.Status.Conditions[0].Impact).To(Equal(resultpkg.ImpactAdvisory))
 
@@ -650,6 +666,79 @@ func TestImpactedWorkloadsCheck_MixedNotebooks(t *testing.T) {
 	g.Expect(result.ImpactedObjects[0].Name).To(Equal("rstudio-nb"))
 }
 
+func TestImpactedWorkloadsCheck_RStudioImageStreamTagLookups(t *testing.T) {
+	// lookup configures the ImageStreamTag of the RStudio image the notebooks share: whether it
+	// exists, the error its gets fail with, and whether the run is in low-memory mode.
+	type lookup struct {
+		exists    bool
+		getErr    error
+		lowMemory bool
+	}
+
+	// validate runs the check on notebooks sharing an RStudio image and returns the number of
+	// ImageStreamTag gets.
+	validate := func(g *WithT, t *testing.T, l lookup) int {
+		t.Helper()
+
+		objects := []runtime.Object{
+			testutil.NewDSC(map[string]string{"workbenches": "Managed"}),
+			testutil.NewDSCI(applicationsNS),
+			newImageStream(isRstudioRhel9, "rstudio"),
+		}
+		if l.exists {
+			objects = append(objects, newRStudioImageStreamTag(isRstudioRhel9, buildRefIncompatible, shaRstudioIncompatible))
+		}
+		for i := range 5 {
+			objects = append(objects, newNotebookWithImage(fmt.Sprintf("rstudio-nb-%d", i), "ns", rstudioIncompatibleSHA))
+		}
+
+		// testutil.NewTarget does not support reactor injection, so we construct the client manually.
+		scheme := runtime.NewScheme()
+		_ = metav1.AddMetaToScheme(scheme)
+
+		dynamicClient := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(scheme, listKinds, objects...)
+
+		var gets int
+
+		dynamicClient.PrependReactor("get", resources.ImageStreamTag.Resource, func(_ k8stesting.Action) (bool, runtime.Object, error) {
+			gets++
+
+			return l.getErr != nil, nil, l.getErr
+		})
+
+		currentVer, targetVer := semver.MustParse("2.17.0"), semver.MustParse("3.0.0")
+
+		_, err := notebook.NewImpactedWorkloadsCheck().Validate(t.Context(), check.Target{
+			Client:         client.NewForTesting(client.TestClientConfig{Dynamic: dynamicClient}),
+			CurrentVersion: &currentVer,
+			TargetVersion:  &targetVer,
+			LowMemory:      l.lowMemory,
+		})
+		g.Expect(err).ToNot(HaveOccurred())
+
+		return gets
+	}
+
+	t.Run("should look up a missing ImageStreamTag once", func(t *testing.T) {
+		g := NewWithT(t)
+
+		g.Expect(validate(g, t, lookup{})).To(Equal(1))
+	})
+
+	t.Run("should look up a found ImageStreamTag once without a caching reader", func(t *testing.T) {
+		g := NewWithT(t)
+
+		g.Expect(validate(g, t, lookup{exists: true, lowMemory: true})).To(Equal(1))
+	})
+
+	t.Run("should retry an ImageStreamTag that failed to be fetched", func(t *testing.T) {
+		g := NewWithT(t)
+
+		unavailable := apierrors.NewServiceUnavailable("apiserver is shutting down")
+		g.Expect(validate(g, t, lookup{getErr: unavailable})).To(BeNumerically(">", 1))
+	})
+}
+
 func TestImpactedWorkloadsCheck_Metadata(t *testing.T) {
 	g := NewWithT(t)
 
@@ -724,6 +813,38 @@ func TestImpactedWorkloadsCheck_AnnotationTargetVersion(t *testing.T) {
 	g.Expect(result.Annotations).To(HaveKeyWithValue(check.AnnotationCheckTargetVersion, "3.0.0"))
 }
 
+func TestImpactedWorkloadsCheck_AnnotationImageStream(t *testing.T) {
+	g := NewWithT(t)
+	ctx := t.Context()
+
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds: listKinds,
+		Objects: []*unstructured.Unstructured{
+			newImageStream(isCodeserverDatascience, "codeserver"),
+			newNotebookWithImage("codeserver-nb", "test-ns", codeserverIncompatibleSHA),
+			newNotebookWithImage("custom-nb", "test-ns", customImageTag),
+			testutil.NewDSC(map[string]string{"workbenches": "Managed"}),
+			testutil.NewDSCI(applicationsNS),
+		},
+		CurrentVersion: "2.17.0",
+		TargetVersion:  "3.0.0",
+	})
+
+	impactedCheck := notebook.NewImpactedWorkloadsCheck()
+	result, err := impactedCheck.Validate(ctx, target)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(result.ImpactedObjects).To(HaveLen(2))
+
+	for _, obj := range result.ImpactedObjects {
+		if obj.Name == "codeserver-nb" {
+			g.Expect(obj.Annotations).To(HaveKeyWithValue(notebook.AnnotationCheckImageStream, isCodeserverDatascience))
+		} else {
+			g.Expect(obj.Annotations).ToNot(HaveKey(notebook.AnnotationCheckImageStream))
+		}
+	}
+}
+
 // TestImpactedWorkloadsCheck_LookupStrategies tests all five image lookup strategies:
 // 1. dockerImageReference - exact match against .status.tags[*].items[*].dockerImageReference
 // 2. SHA lookup - match SHA against .status.tags[*].items[*].image
@@ -973,6 +1094,195 @@ func TestImpactedWorkloadsCheck_LookupStrategies(t *testing.T) {
 	}
 }
 
+// TestImpactedWorkloadsCheck_RegistryMirrors tests that images pulled from a registry mirror on
+// disconnected clusters are correlated through the source references their ImageStreams keep.
+func TestImpactedWorkloadsCheck_RegistryMirrors(t *testing.T) {
+	const mirrorRegistry = "mirror.example.com:5000/rhoai"
+
+	mirroredImage := mirrorRegistry + "/odh-" + isJupyterDatascience + "@" + shaCompatible
+
+	idms := &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": resources.ImageDigestMirrorSet.APIVersion(),
+		"kind":       resources.ImageDigestMirrorSet.Kind,
+		"metadata":   map[string]any{"name": "rhoai"},
+		"spec": map[string]any{
+			"imageDigestMirrors": []any{
+				map[string]any{"source": externalRegistry, "mirrors": []any{mirrorRegistry}},
+			},
+		},
+	}}
+
+	tests := []struct {
+		name           string
+		mirrors        []string
+		objects        []*unstructured.Unstructured
+		expectedStatus metav1.ConditionStatus
+		expectedImpact resultpkg.Impact
+	}{
+		{
+			name:           "unmapped mirror is CUSTOM",
+			expectedStatus: metav1.ConditionFalse,
+			expectedImpact: resultpkg.ImpactAdvisory,
+		},
+		{
+			name:           "mirror mapped by flag",
+			mirrors:        []string{externalRegistry + "=" + mirrorRegistry},
+			expectedStatus: metav1.ConditionTrue,
+			expectedImpact: resultpkg.ImpactNone,
+		},
+		{
+			name:           "mirror mapped by ImageDigestMirrorSet",
+			objects:        []*unstructured.Unstructured{idms},
+			expectedStatus: metav1.ConditionTrue,
+			expectedImpact: resultpkg.ImpactNone,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			objects := append([]*unstructured.Unstructured{
+				newDisconnectedImageStream(isJupyterDatascience, "jupyter"),
+				testutil.NewDSC(map[string]string{"workbenches": "Managed"}),
+				newNotebookWithImage("test-nb", "test-ns", mirroredImage),
+				testutil.NewDSCI(applicationsNS),
+			}, tc.objects...)
+
+			target := testutil.NewTarget(t, testutil.TargetConfig{
+				ListKinds:      listKinds,
+				Objects:        objects,
+				CurrentVersion: "2.17.0",
+				TargetVersion:  "3.0.0",
+			})
+
+			var mirrors mirror.Set
+
+			for _, m := range tc.mirrors {
+				mapping, err := mirror.Parse(m)
+				g.Expect(err).ToNot(HaveOccurred())
+
+				mirrors = append(mirrors, mapping)
+			}
+
+			impactedCheck := notebook.NewImpactedWorkloadsCheck()
+			impactedCheck.SetRegistryMirrors(mirrors)
+
+			result, err := impactedCheck.Validate(t.Context(), target)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(result.Status.Conditions).To(HaveLen(1))
+			g.Expect(result.Status.Conditions[0].Condition.Status).To(Equal(tc.expectedStatus))
+			g.Expect(result.Status.Conditions[0].Impact).To(Equal(tc.expectedImpact))
+		})
+	}
+}
+
+// fakeImageInspector serves the registry metadata of images, counting inspections.
+type fakeImageInspector struct {
+	images      map[string]*registry.Image
+	inspections int
+}
+
+func (f *fakeImageInspector) Inspect(_ context.Context, image string) (*registry.Image, error) {
+	f.inspections++
+
+	img, ok := f.images[image]
+	if !ok {
+		return nil, errors.New("HTTP 404")
+	}
+
+	ref, _ := registry.ParseReference(image)
+	img.Reference = ref
+
+	return img, nil
+}
+
+// TestImpactedWorkloadsCheck_VerifyRegistry tests that --verify-registry correlates custom images
+// to OOTB ImageStreams from the digest and build commit read from their registry.
+func TestImpactedWorkloadsCheck_VerifyRegistry(t *testing.T) {
+	const buildCommit = "0123456789abcdef"
+
+	g := NewWithT(t)
+
+	// The previous tag was built from buildCommit
+	codeserverIS := newImageStream(isCodeserverDatascience, "codeserver")
+	specTags, _, _ := unstructured.NestedSlice(codeserverIS.Object, "spec", "tags")
+	specTags[1].(map[string]any)["annotations"].(map[string]any)["opendatahub.io/notebook-build-commit"] = buildCommit
+	g.Expect(unstructured.SetNestedSlice(codeserverIS.Object, specTags, "spec", "tags")).To(Succeed())
+
+	inspector := &fakeImageInspector{images: map[string]*registry.Image{
+		// A custom tag of the current OOTB image
+		"quay.io/myorg/workbench:stable": {Digest: "sha256:index", ManifestDigest: shaCompatible},
+		// The previous OOTB image copied to another registry
+		"quay.io/myorg/odh-" + isCodeserverDatascience + ":copy": {
+			Digest: "sha256:copied",
+			Labels: map[string]string{"vcs-ref": buildCommit[:7]},
+		},
+		// The same build commit, but another image
+		"quay.io/myorg/other:copy": {
+			Digest: "sha256:other",
+			Labels: map[string]string{"vcs-ref": buildCommit},
+		},
+	}}
+
+	tests := []struct {
+		image          string
+		expectedStatus notebook.ImageStatus
+		expectedReason string
+	}{
+		{"quay.io/myorg/workbench:stable", notebook.ImageStatusGood, "verified in the registry: digest"},
+		{"quay.io/myorg/odh-" + isCodeserverDatascience + ":copy", notebook.ImageStatusPreUpgradeActionRequired,
+			"verified in the registry: build commit " + buildCommit[:7]},
+		{"quay.io/myorg/other:copy", notebook.ImageStatusCustom, "registry digest other matches no OOTB image"},
+		{"quay.io/myorg/missing:1.0", notebook.ImageStatusCustom, "registry verification failed: HTTP 404"},
+	}
+
+	objects := []*unstructured.Unstructured{
+		codeserverIS,
+		testutil.NewDSC(map[string]string{"workbenches": "Managed"}),
+		testutil.NewDSCI(applicationsNS),
+	}
+	for i, tc := range tests {
+		objects = append(objects, newNotebookWithImage(fmt.Sprintf("nb-%d", i), "test-ns", tc.image))
+	}
+
+	// A second notebook sharing an image does not inspect it again
+	objects = append(objects, newNotebookWithImage("nb-shared", "test-ns", tests[2].image))
+
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds:      listKinds,
+		Objects:        objects,
+		CurrentVersion: "2.17.0",
+		TargetVersion:  "3.0.0",
+	})
+
+	impactedCheck := notebook.NewImpactedWorkloadsCheck()
+	impactedCheck.SetImageInspector(inspector)
+
+	result, err := impactedCheck.Validate(t.Context(), target)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(inspector.inspections).To(Equal(len(tests)))
+
+	impacted := make(map[string]map[string]string, len(result.ImpactedObjects))
+	for _, obj := range result.ImpactedObjects {
+		impacted[obj.Name] = obj.Annotations
+	}
+
+	for i, tc := range tests {
+		name := fmt.Sprintf("nb-%d", i)
+
+		if tc.expectedStatus == notebook.ImageStatusGood {
+			g.Expect(impacted).ToNot(HaveKey(name), tc.image)
+
+			continue
+		}
+
+		g.Expect(impacted).To(HaveKey(name), tc.image)
+		g.Expect(impacted[name][notebook.AnnotationCheckImageStatus]).To(Equal(string(tc.expectedStatus)), tc.image)
+		g.Expect(impacted[name][notebook.AnnotationCheckReason]).To(ContainSubstring(tc.expectedReason), tc.image)
+	}
+}
+
 // TestImpactedWorkloadsCheck_InfrastructureContainerFiltering tests that oauth-proxy sidecars
 // are correctly filtered when BOTH container name AND image match, but NOT when only one matches.
 func TestImpactedWorkloadsCheck_InfrastructureContainerFiltering(t *testing.T) {
@@ -1056,3 +1366,56 @@ func TestImpactedWorkloadsCheck_InfrastructureContainerFiltering(t *testing.T) {
 		})
 	}
 }
+
+func TestImpactedWorkloadsCheck_DeepStaleImage(t *testing.T) {
+	g := NewWithT(t)
+	ctx := t.Context()
+
+	// The spec was updated to a compatible image, but the pod was not restarted since
+	pod := &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "v1",
+		"kind":       "Pod",
+		"metadata": map[string]any{
+			"name":      "codeserver-nb-0",
+			"namespace": "test-ns",
+			"labels":    map[string]any{notebook.LabelNotebookName: "codeserver-nb"},
+		},
+		"spec": map[string]any{
+			"containers": []any{map[string]any{"name": "notebook", "image": codeserverIncompatibleSHA}},
+		},
+	}}
+
+	deepListKinds := map[schema.GroupVersionResource]string{resources.Pod.GVR(): resources.Pod.ListKind()}
+	maps.Copy(deepListKinds, listKinds)
+
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds: deepListKinds,
+		Objects: []*unstructured.Unstructured{
+			testutil.NewDSC(map[string]string{"workbenches": "Managed"}),
+			testutil.NewDSCI(applicationsNS),
+			newImageStream(isCodeserverDatascience, "codeserver"),
+			newNotebookWithImage("codeserver-nb", "test-ns", codeserverCompatibleSHA),
+			pod,
+		},
+		CurrentVersion: "2.17.0",
+		TargetVersion:  "3.0.0",
+	})
+
+	impactedCheck := notebook.NewImpactedWorkloadsCheck()
+
+	result, err := impactedCheck.Validate(ctx, target)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(result.ImpactedObjects).To(BeEmpty())
+
+	target.Deep = true
+
+	result, err = impactedCheck.Validate(ctx, target)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(result.Status.Conditions[0].Status).To(Equal(metav1.ConditionFalse))
+	g.Expect(result.ImpactedObjects).To(HaveLen(1))
+	g.Expect(result.ImpactedObjects[0].Annotations).To(And(
+		HaveKeyWithValue(notebook.AnnotationCheckImageStatus, string(notebook.ImageStatusPreUpgradeActionRequired)),
+		HaveKeyWithValue(notebook.AnnotationCheckImageRef, codeserverIncompatibleSHA),
+		HaveKeyWithValue(notebook.AnnotationCheckReason, ContainSubstring("pod codeserver-nb-0 still runs")),
+	))
+}
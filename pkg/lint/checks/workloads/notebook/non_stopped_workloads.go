@@ -5,8 +5,9 @@ import (
 	"fmt"
 	"io"
 	"sort"
-	"strings"
+	"strconv"
 
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
@@ -16,7 +17,6 @@ import (
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check/validate"
 	"github.com/opendatahub-io/odh-cli/pkg/resources"
 	"github.com/opendatahub-io/odh-cli/pkg/util/jq"
-	"github.com/opendatahub-io/odh-cli/pkg/util/version"
 )
 
 // Container state values stored in AnnotationCheckContainerState.
@@ -47,16 +47,12 @@ func NewNonStoppedWorkloadsCheck() *NonStoppedWorkloadsCheck {
 			CheckName:        "Workloads :: Notebook :: Non-Stopped Workloads",
 			CheckDescription: "Detects Notebook CRs that are not stopped on the cluster",
 			CheckRemediation: "Save all pending work in running Notebooks, then stop them before upgrading",
+			CheckResources:   []resources.ResourceType{resources.Notebook},
+			CheckVersions:    check.VersionsUpgrade2xTo3x,
 		},
 	}
 }
 
-// CanApply returns whether this check should run for the given target.
-// Only applies when upgrading from 2.x to 3.x.
-func (c *NonStoppedWorkloadsCheck) CanApply(_ context.Context, target check.Target) (bool, error) {
-	return version.IsUpgradeFrom2xTo3x(target.CurrentVersion, target.TargetVersion), nil
-}
-
 // Validate lists all Notebooks and reports an advisory for any that are not stopped.
 func (c *NonStoppedWorkloadsCheck) Validate(
 	ctx context.Context,
@@ -64,26 +60,39 @@ func (c *NonStoppedWorkloadsCheck) Validate(
 ) (*result.DiagnosticResult, error) {
 	return validate.Workloads(c, target, resources.Notebook).
 		ForComponent(constants.ComponentWorkbenches).
-		Filter(isNotStopped).
+		MetadataFilter(isNotStopped).
+		WithPods(notebookPods).
 		Run(ctx, c.analyzeNonStoppedWorkloads)
 }
 
 // isNotStopped returns true when the Notebook does not have the kubeflow-resource-stopped annotation.
-func isNotStopped(nb *unstructured.Unstructured) (bool, error) {
-	annotations := nb.GetAnnotations()
-	if annotations == nil {
-		return true, nil
-	}
+func isNotStopped(nb metav1.Object) bool {
+	_, stopped := nb.GetAnnotations()[AnnotationKubeflowResourceStopped]
 
-	_, stopped := annotations[AnnotationKubeflowResourceStopped]
-
-	return !stopped, nil
+	return !stopped
 }
 
 // notebookState holds the classified state of a non-stopped notebook.
 type notebookState struct {
 	state      string // "running" or "waiting"
 	waitReason string // only set when state is "waiting"
+	restarts   int32  // restarts of a container in a restart loop, only set with --deep
+}
+
+// classifyNotebookPods refines the state of a notebook from its pod (--deep): a notebook whose
+// pod keeps restarting is waiting, even when its status last reported it running.
+func classifyNotebookPods(state notebookState, pods []*corev1.Pod) notebookState {
+	loops := validate.RestartLoops(pods)
+	if len(loops) == 0 {
+		return state
+	}
+
+	reason := loops[0].Reason
+	if reason == "" {
+		reason = WaitReasonRestartLoop
+	}
+
+	return notebookState{state: ContainerStateWaiting, waitReason: reason, restarts: loops[0].Restarts}
 }
 
 // classifyNotebook determines the state of a non-stopped notebook
@@ -108,7 +117,7 @@ func classifyNotebook(nb *unstructured.Unstructured) notebookState {
 
 // analyzeNonStoppedWorkloads classifies non-stopped notebooks and builds the diagnostic result.
 func (c *NonStoppedWorkloadsCheck) analyzeNonStoppedWorkloads(
-	_ context.Context,
+	ctx context.Context,
 	req *validate.WorkloadRequest[*unstructured.Unstructured],
 ) error {
 	notebooks := req.Items
@@ -118,7 +127,7 @@ func (c *NonStoppedWorkloadsCheck) analyzeNonStoppedWorkloads(
 			ConditionTypeNonStoppedWorkloads,
 			metav1.ConditionTrue,
 			check.WithReason(check.ReasonRequirementsMet),
-			check.WithMessage(MsgAllNotebooksStopped),
+			check.WithCatalogMessage(MsgAllNotebooksStopped),
 		))
 
 		return nil
@@ -131,6 +140,13 @@ func (c *NonStoppedWorkloadsCheck) analyzeNonStoppedWorkloads(
 	for _, nb := range notebooks {
 		state := classifyNotebook(nb)
 
+		pods, err := req.Pods(ctx, nb)
+		if err != nil {
+			return err
+		}
+
+		state = classifyNotebookPods(state, pods)
+
 		annotations := map[string]string{
 			AnnotationCheckContainerState: state.state,
 		}
@@ -139,6 +155,10 @@ func (c *NonStoppedWorkloadsCheck) analyzeNonStoppedWorkloads(
 			annotations[AnnotationCheckContainerWaitReason] = state.waitReason
 		}
 
+		if state.restarts > 0 {
+			annotations[AnnotationCheckRestartCount] = strconv.Itoa(int(state.restarts))
+		}
+
 		switch state.state {
 		case ContainerStateRunning:
 			runningCount++
@@ -160,22 +180,24 @@ func (c *NonStoppedWorkloadsCheck) analyzeNonStoppedWorkloads(
 	req.Result.Annotations[result.AnnotationResourceCRDName] = resources.Notebook.CRDFQN()
 
 	// Build summary message.
-	var msgParts []string
-	msgParts = append(msgParts, fmt.Sprintf(MsgNonStoppedNotebooksFound, len(notebooks)))
-
-	if runningCount > 0 {
-		msgParts = append(msgParts, fmt.Sprintf(MsgNonStoppedRunning, runningCount))
-	}
-
-	if waitingCount > 0 {
-		msgParts = append(msgParts, fmt.Sprintf(MsgNonStoppedWaiting, waitingCount))
+	var message check.ConditionOption
+
+	switch {
+	case runningCount > 0 && waitingCount > 0:
+		message = check.WithCatalogMessage(MsgNonStoppedRunningWaiting, len(notebooks), runningCount, waitingCount)
+	case runningCount > 0:
+		message = check.WithCatalogMessage(MsgNonStoppedRunning, len(notebooks), runningCount)
+	case waitingCount > 0:
+		message = check.WithCatalogMessage(MsgNonStoppedWaiting, len(notebooks), waitingCount)
+	default:
+		message = check.WithCatalogMessage(MsgNonStoppedNotebooksFound, len(notebooks))
 	}
 
 	req.Result.SetCondition(check.NewCondition(
 		ConditionTypeNonStoppedWorkloads,
 		metav1.ConditionFalse,
 		check.WithReason(check.ReasonWorkloadsImpacted),
-		check.WithMessage("%s", strings.Join(msgParts, "\n")),
+		message,
 		check.WithImpact(result.ImpactAdvisory),
 		check.WithRemediation(c.CheckRemediation),
 	))
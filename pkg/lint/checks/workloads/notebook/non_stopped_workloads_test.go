@@ -2,7 +2,7 @@ package notebook_test
 
 import (
 	"bytes"
-	"fmt"
+	"maps"
 	"testing"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -133,7 +133,7 @@ func TestNonStoppedWorkloadsCheck_NoNotebooks(t *testing.T) {
 		"Type":    Equal(notebook.ConditionTypeNonStoppedWorkloads),
 		"Status":  Equal(metav1.ConditionTrue),
 		"Reason":  Equal(check.ReasonRequirementsMet),
-		"Message": Equal(notebook.MsgAllNotebooksStopped),
+		"Message": Equal(notebook.MsgAllNotebooksStopped.Format),
 	}))
 	g.Expect(result.Status.Conditions[0].Impact).To(Equal(resultpkg.ImpactNone))
 	g.Expect(result.Annotations).To(HaveKeyWithValue(check.AnnotationImpactedWorkloadCount, "0"))
@@ -424,7 +424,7 @@ func TestNonStoppedWorkloadsCheck_AllRunning(t *testing.T) {
 		"Status": Equal(metav1.ConditionFalse),
 		"Reason": Equal(check.ReasonWorkloadsImpacted),
 	}))
-	g.Expect(result.Status.Conditions[0].Message).To(ContainSubstring(fmt.Sprintf(notebook.MsgNonStoppedNotebooksFound, 3)))
+	g.Expect(result.Status.Conditions[0].Message).To(ContainSubstring(notebook.MsgNonStoppedNotebooksFound.Sprintf(3)))
 	g.Expect(result.Status.Conditions[0].Message).To(ContainSubstring("3 running"))
 	g.Expect(result.Status.Conditions[0].Impact).To(Equal(resultpkg.ImpactAdvisory))
 	g.Expect(result.Annotations).To(HaveKeyWithValue(check.AnnotationImpactedWorkloadCount, "3"))
@@ -491,3 +491,60 @@ func TestNonStoppedWorkloadsCheck_FormatVerboseOutput_MixedStates(t *testing.T)
 	g.Expect(output).To(ContainSubstring("ImagePullBackOff (1):"))
 	g.Expect(output).To(ContainSubstring("notebooks.kubeflow.org/nb-bad-image"))
 }
+
+func TestNonStoppedWorkloadsCheck_DeepRestartLoop(t *testing.T) {
+	g := NewWithT(t)
+	ctx := t.Context()
+
+	nbRunning := newNotebook("flaky-notebook", "user-ns", notebookOptions{
+		Status: map[string]any{
+			"containerState": map[string]any{
+				"running": map[string]any{"startedAt": "2026-03-25T17:40:38Z"},
+			},
+		},
+	})
+
+	pod := &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "v1",
+		"kind":       "Pod",
+		"metadata": map[string]any{
+			"name":      "flaky-notebook-0",
+			"namespace": "user-ns",
+			"labels":    map[string]any{notebook.LabelNotebookName: "flaky-notebook"},
+		},
+		"status": map[string]any{
+			"containerStatuses": []any{
+				map[string]any{"name": "flaky-notebook", "restartCount": int64(12)},
+			},
+		},
+	}}
+
+	listKinds := map[schema.GroupVersionResource]string{resources.Pod.GVR(): resources.Pod.ListKind()}
+	maps.Copy(listKinds, nonStoppedWorkloadsListKinds)
+
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds:      listKinds,
+		Objects:        []*unstructured.Unstructured{workbenchesDSC(constants.ManagementStateManaged), nbRunning, pod},
+		CurrentVersion: "2.17.0",
+		TargetVersion:  "3.0.0",
+	})
+
+	chk := notebook.NewNonStoppedWorkloadsCheck()
+
+	// Without --deep, the notebook status is trusted
+	result, err := chk.Validate(ctx, target)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(result.ImpactedObjects[0].Annotations).To(HaveKeyWithValue(
+		notebook.AnnotationCheckContainerState, notebook.ContainerStateRunning))
+
+	target.Deep = true
+
+	result, err = chk.Validate(ctx, target)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(result.Status.Conditions[0].Message).To(ContainSubstring("1 waiting"))
+	g.Expect(result.ImpactedObjects[0].Annotations).To(And(
+		HaveKeyWithValue(notebook.AnnotationCheckContainerState, notebook.ContainerStateWaiting),
+		HaveKeyWithValue(notebook.AnnotationCheckContainerWaitReason, notebook.WaitReasonRestartLoop),
+		HaveKeyWithValue(notebook.AnnotationCheckRestartCount, "12"),
+	))
+}
@@ -0,0 +1,83 @@
+package notebook
+
+import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/opendatahub-io/odh-cli/pkg/constants"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/validate"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/kube"
+	"github.com/opendatahub-io/odh-cli/pkg/util/version"
+)
+
+// SingleReplicaCheck detects running Notebooks whose single workbench pod is not protected by a
+// PodDisruptionBudget, so node drains during the upgrade interrupt the user's session.
+type SingleReplicaCheck struct {
+	check.BaseCheck
+}
+
+func NewSingleReplicaCheck() *SingleReplicaCheck {
+	return &SingleReplicaCheck{
+		BaseCheck: check.BaseCheck{
+			CheckGroup:       check.GroupWorkload,
+			Kind:             kind,
+			Type:             check.CheckTypeDisruptionRisk,
+			CheckID:          "workloads.notebook.single-replica",
+			CheckName:        "Workloads :: Notebook :: Single Replica Disruption Risk",
+			CheckDescription: "Detects running Notebooks without a PodDisruptionBudget that will be restarted during node drains in the upgrade",
+			CheckRemediation: "Save pending work and notify workbench owners of the maintenance window, or stop the Notebooks before upgrading",
+			CheckResources: []resources.ResourceType{
+				resources.Notebook,
+				resources.PodDisruptionBudget,
+			},
+			CheckVersions: check.VersionsUpgrade2xTo3x,
+		},
+	}
+}
+
+// Validate lists running Notebooks and reports those whose pod no PodDisruptionBudget selects.
+func (c *SingleReplicaCheck) Validate(
+	ctx context.Context,
+	target check.Target,
+) (*result.DiagnosticResult, error) {
+	budgets, err := kube.ListDisruptionBudgets(ctx, target.Client)
+	if err != nil {
+		return nil, err
+	}
+
+	return validate.Workloads(c, target, resources.Notebook).
+		ForComponent(constants.ComponentWorkbenches).
+		MetadataFilter(func(nb metav1.Object) bool {
+			return isNotStopped(nb) && !budgets.Covers(nb.GetNamespace(), labels.Set{LabelNotebookName: nb.GetName()})
+		}).
+		Complete(ctx, c.newSingleReplicaCondition)
+}
+
+func (c *SingleReplicaCheck) newSingleReplicaCondition(
+	_ context.Context,
+	req *validate.WorkloadRequest[*unstructured.Unstructured],
+) ([]result.Condition, error) {
+	if len(req.Items) == 0 {
+		return []result.Condition{check.NewCondition(
+			ConditionTypeDisruptionTolerant,
+			metav1.ConditionTrue,
+			check.WithReason(check.ReasonRequirementsMet),
+			check.WithCatalogMessage(MsgNoSingleReplicaNotebooks),
+		)}, nil
+	}
+
+	return []result.Condition{check.NewCondition(
+		ConditionTypeDisruptionTolerant,
+		metav1.ConditionFalse,
+		check.WithReason(check.ReasonWorkloadsImpacted),
+		check.WithCatalogMessage(MsgSingleReplicaNotebooks, len(req.Items), version.MajorMinorLabel(req.TargetVersion)),
+		check.WithImpact(result.ImpactAdvisory),
+		check.WithRemediation(c.CheckRemediation),
+	)}, nil
+}
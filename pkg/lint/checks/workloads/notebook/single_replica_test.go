@@ -0,0 +1,113 @@
+package notebook_test
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	resultpkg "github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/testutil"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/workloads/notebook"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+)
+
+//nolint:gochecknoglobals
+var singleReplicaListKinds = map[schema.GroupVersionResource]string{
+	resources.Notebook.GVR():            resources.Notebook.ListKind(),
+	resources.DataScienceCluster.GVR():  resources.DataScienceCluster.ListKind(),
+	resources.PodDisruptionBudget.GVR(): resources.PodDisruptionBudget.ListKind(),
+}
+
+func newNotebookDisruptionBudget(namespace string, notebookName string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{
+		Object: map[string]any{
+			"apiVersion": resources.PodDisruptionBudget.APIVersion(),
+			"kind":       resources.PodDisruptionBudget.Kind,
+			"metadata": map[string]any{
+				"name":      notebookName + "-pdb",
+				"namespace": namespace,
+			},
+			"spec": map[string]any{
+				"minAvailable": int64(1),
+				"selector": map[string]any{
+					"matchLabels": map[string]any{notebook.LabelNotebookName: notebookName},
+				},
+			},
+		},
+	}
+}
+
+func TestSingleReplicaCheck_Metadata(t *testing.T) {
+	g := NewWithT(t)
+
+	chk := notebook.NewSingleReplicaCheck()
+
+	g.Expect(chk.ID()).To(Equal("workloads.notebook.single-replica"))
+	g.Expect(chk.Group()).To(Equal(check.GroupWorkload))
+	g.Expect(chk.CheckKind()).To(Equal("notebook"))
+	g.Expect(chk.CheckType()).To(Equal(string(check.CheckTypeDisruptionRisk)))
+}
+
+func TestSingleReplicaCheck_NoneAtRisk(t *testing.T) {
+	g := NewWithT(t)
+
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds: singleReplicaListKinds,
+		Objects: []*unstructured.Unstructured{
+			testutil.NewDSC(map[string]string{"workbenches": "Managed"}),
+			newNotebook("stopped", "ds-project", notebookOptions{
+				Annotations: map[string]any{notebook.AnnotationKubeflowResourceStopped: "2025-01-01T00:00:00Z"},
+			}),
+			newNotebook("protected", "ds-project", notebookOptions{}),
+			newNotebookDisruptionBudget("ds-project", "protected"),
+		},
+		CurrentVersion: "2.17.0",
+		TargetVersion:  "3.0.0",
+	})
+
+	dr, err := notebook.NewSingleReplicaCheck().Validate(t.Context(), target)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(dr.Status.Conditions).To(HaveLen(1))
+	g.Expect(dr.Status.Conditions[0].Condition).To(MatchFields(IgnoreExtras, Fields{
+		"Type":   Equal(notebook.ConditionTypeDisruptionTolerant),
+		"Status": Equal(metav1.ConditionTrue),
+		"Reason": Equal(check.ReasonRequirementsMet),
+	}))
+	g.Expect(dr.ImpactedObjects).To(BeEmpty())
+}
+
+func TestSingleReplicaCheck_RunningWithoutBudget(t *testing.T) {
+	g := NewWithT(t)
+
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds: singleReplicaListKinds,
+		Objects: []*unstructured.Unstructured{
+			testutil.NewDSC(map[string]string{"workbenches": "Managed"}),
+			newNotebook("running", "ds-project", notebookOptions{}),
+			// The budget lives in another namespace and does not protect the Notebook.
+			newNotebookDisruptionBudget("other-project", "running"),
+		},
+		CurrentVersion: "2.17.0",
+		TargetVersion:  "3.0.0",
+	})
+
+	dr, err := notebook.NewSingleReplicaCheck().Validate(t.Context(), target)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(dr.Status.Conditions).To(HaveLen(1))
+	g.Expect(dr.Status.Conditions[0].Condition).To(MatchFields(IgnoreExtras, Fields{
+		"Status":  Equal(metav1.ConditionFalse),
+		"Reason":  Equal(check.ReasonWorkloadsImpacted),
+		"Message": ContainSubstring("Found 1 running Notebook(s) without a PodDisruptionBudget"),
+	}))
+	g.Expect(dr.Status.Conditions[0].Impact).To(Equal(resultpkg.ImpactAdvisory))
+	g.Expect(dr.ImpactedObjects).To(HaveLen(1))
+	g.Expect(dr.ImpactedObjects[0].Name).To(Equal("running"))
+}
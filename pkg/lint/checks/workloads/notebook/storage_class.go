@@ -0,0 +1,285 @@
+package notebook
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/opendatahub-io/odh-cli/pkg/constants"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/validate"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+	"github.com/opendatahub-io/odh-cli/pkg/util/jq"
+	"github.com/opendatahub-io/odh-cli/pkg/util/kube"
+)
+
+// deprecatedProvisioners lists in-tree volume plugins that have been migrated to CSI drivers and
+// are removed from newer OpenShift releases.
+//
+//nolint:gochecknoglobals // Read-only lookup table
+var deprecatedProvisioners = []string{
+	"kubernetes.io/aws-ebs",
+	"kubernetes.io/azure-disk",
+	"kubernetes.io/azure-file",
+	"kubernetes.io/cinder",
+	"kubernetes.io/gce-pd",
+	"kubernetes.io/glusterfs",
+	"kubernetes.io/portworx-volume",
+	"kubernetes.io/rbd",
+	"kubernetes.io/vsphere-volume",
+}
+
+// writableAccessModes lists the PVC access modes a workbench can write its home directory with.
+//
+//nolint:gochecknoglobals // Read-only lookup table
+var writableAccessModes = []corev1.PersistentVolumeAccessMode{
+	corev1.ReadWriteOnce,
+	corev1.ReadWriteMany,
+	corev1.ReadWriteOncePod,
+}
+
+// StorageClassCheck verifies that the PVCs mounted by Notebooks use storage classes that still
+// exist, are not served by deprecated in-tree provisioners, and grant write access.
+type StorageClassCheck struct {
+	check.BaseCheck
+}
+
+func NewStorageClassCheck() *StorageClassCheck {
+	return &StorageClassCheck{
+		BaseCheck: check.BaseCheck{
+			CheckGroup:       check.GroupWorkload,
+			Kind:             kind,
+			Type:             check.CheckTypeDataIntegrity,
+			CheckID:          "workloads.notebook.storage-class",
+			CheckName:        "Workloads :: Notebook :: Storage Class Compatibility",
+			CheckDescription: "Verifies that Notebook PVCs use storage classes that still exist and support writable access modes",
+			CheckRemediation: "Migrate affected workbench data to a PVC on a supported CSI storage class, then update the Notebook volumes to reference the new PVC",
+			CheckResources: []resources.ResourceType{
+				resources.Notebook,
+				resources.PersistentVolumeClaim,
+				resources.StorageClass,
+			},
+			CheckVersions: check.VersionsAny,
+		},
+	}
+}
+
+// CanApply returns whether this check should run for the given target.
+// Applies regardless of version; component state is checked via ForComponent in Validate.
+func (c *StorageClassCheck) CanApply(_ context.Context, _ check.Target) (bool, error) {
+	return true, nil
+}
+
+// Validate lists Notebooks, resolves the PVCs they mount and checks each PVC's storage class.
+func (c *StorageClassCheck) Validate(
+	ctx context.Context,
+	target check.Target,
+) (*result.DiagnosticResult, error) {
+	return validate.Workloads(c, target, resources.Notebook).
+		ForComponent(constants.ComponentWorkbenches).
+		Run(ctx, c.checkStorage)
+}
+
+// checkStorage cross-references notebook PVCs against the cluster storage classes.
+func (c *StorageClassCheck) checkStorage(
+	ctx context.Context,
+	req *validate.WorkloadRequest[*unstructured.Unstructured],
+) error {
+	dr := req.Result
+
+	claims := make(map[types.NamespacedName][]string, len(req.Items))
+	namespaces := sets.New[string]()
+
+	for _, nb := range req.Items {
+		names, err := notebookPVCNames(nb)
+		if err != nil {
+			return err
+		}
+
+		if len(names) == 0 {
+			continue
+		}
+
+		claims[types.NamespacedName{Namespace: nb.GetNamespace(), Name: nb.GetName()}] = names
+		namespaces.Insert(nb.GetNamespace())
+	}
+
+	pvcs, err := buildPVCCache(ctx, req.Client, namespaces)
+	if err != nil {
+		return err
+	}
+
+	provisioners, err := buildStorageClassCache(ctx, req.Client)
+	if err != nil {
+		return err
+	}
+
+	impacted := make([]metav1.PartialObjectMetadata, 0)
+
+	for _, nb := range req.Items {
+		key := types.NamespacedName{Namespace: nb.GetNamespace(), Name: nb.GetName()}
+
+		var problems []string
+
+		for _, name := range claims[key] {
+			pvc, ok := pvcs[types.NamespacedName{Namespace: key.Namespace, Name: name}]
+			if !ok {
+				// Missing PVCs surface as pod scheduling failures, not storage class issues.
+				continue
+			}
+
+			problems = append(problems, pvcProblems(pvc, provisioners)...)
+		}
+
+		if len(problems) == 0 {
+			continue
+		}
+
+		impacted = append(impacted, metav1.PartialObjectMetadata{
+			TypeMeta: resources.Notebook.TypeMeta(),
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: key.Namespace,
+				Name:      key.Name,
+				Annotations: map[string]string{
+					AnnotationCheckReason: strings.Join(problems, "; "),
+				},
+			},
+		})
+	}
+
+	dr.Annotations[check.AnnotationImpactedWorkloadCount] = strconv.Itoa(len(impacted))
+	dr.Annotations[result.AnnotationResourceCRDName] = resources.Notebook.CRDFQN()
+	dr.ImpactedObjects = impacted
+	dr.SetCondition(c.newCondition(len(impacted)))
+
+	return nil
+}
+
+func (c *StorageClassCheck) newCondition(totalImpacted int) result.Condition {
+	if totalImpacted == 0 {
+		return check.NewCondition(
+			ConditionTypeStorageCompatible,
+			metav1.ConditionTrue,
+			check.WithReason(check.ReasonRequirementsMet),
+			check.WithCatalogMessage(MsgAllNotebookStorageCompatible),
+		)
+	}
+
+	return check.NewCondition(
+		ConditionTypeStorageCompatible,
+		metav1.ConditionFalse,
+		check.WithReason(check.ReasonWorkloadsImpacted),
+		check.WithCatalogMessage(MsgNotebookStorageIncompatible, totalImpacted),
+		check.WithImpact(result.ImpactAdvisory),
+		check.WithRemediation(c.CheckRemediation),
+	)
+}
+
+// notebookPVCNames returns the names of the PVCs mounted by the Notebook pod template.
+func notebookPVCNames(nb *unstructured.Unstructured) ([]string, error) {
+	volumes, err := jq.Query[[]corev1.Volume](nb, ".spec.template.spec.volumes")
+	if err != nil && !errors.Is(err, jq.ErrNotFound) {
+		return nil, fmt.Errorf("querying volumes of Notebook %s/%s: %w", nb.GetNamespace(), nb.GetName(), err)
+	}
+
+	return kube.ExtractPVCRefsFromVolumes(volumes), nil
+}
+
+// pvcProblems describes why a PVC may not work with the storage available after upgrade.
+// PVCs without a storage class are statically bound and are not checked against storage classes.
+func pvcProblems(pvc *corev1.PersistentVolumeClaim, provisioners map[string]string) []string {
+	var problems []string
+
+	if className := pvcStorageClassName(pvc); className != "" {
+		provisioner, exists := provisioners[className]
+
+		switch {
+		case !exists:
+			problems = append(problems, fmt.Sprintf(MsgStorageClassMissing, pvc.Name, className))
+		case slices.Contains(deprecatedProvisioners, provisioner):
+			problems = append(problems, fmt.Sprintf(MsgStorageClassDeprecated, pvc.Name, className, provisioner))
+		}
+	}
+
+	if !slices.ContainsFunc(pvc.Spec.AccessModes, func(m corev1.PersistentVolumeAccessMode) bool {
+		return slices.Contains(writableAccessModes, m)
+	}) {
+		modes := make([]string, 0, len(pvc.Spec.AccessModes))
+		for _, m := range pvc.Spec.AccessModes {
+			modes = append(modes, string(m))
+		}
+
+		problems = append(problems, fmt.Sprintf(MsgPVCNotWritable, pvc.Name, strings.Join(modes, ", ")))
+	}
+
+	return problems
+}
+
+// pvcStorageClassName returns the storage class of a PVC, falling back to the legacy
+// volume.beta.kubernetes.io/storage-class annotation.
+func pvcStorageClassName(pvc *corev1.PersistentVolumeClaim) string {
+	if pvc.Spec.StorageClassName != nil {
+		return *pvc.Spec.StorageClassName
+	}
+
+	return pvc.Annotations[corev1.BetaStorageClassAnnotation]
+}
+
+// buildPVCCache lists the PVCs in the given namespaces.
+func buildPVCCache(
+	ctx context.Context,
+	c client.Reader,
+	namespaces sets.Set[string],
+) (map[types.NamespacedName]*corev1.PersistentVolumeClaim, error) {
+	cache := make(map[types.NamespacedName]*corev1.PersistentVolumeClaim)
+
+	for ns := range namespaces {
+		items, err := c.List(ctx, resources.PersistentVolumeClaim, client.WithNamespace(ns))
+		if err != nil {
+			return nil, fmt.Errorf("listing PersistentVolumeClaims in namespace %s: %w", ns, err)
+		}
+
+		for _, item := range items {
+			pvc, err := kube.ConvertToTyped[*corev1.PersistentVolumeClaim](item.Object, "PersistentVolumeClaim")
+			if err != nil {
+				return nil, err
+			}
+
+			cache[types.NamespacedName{Namespace: ns, Name: item.GetName()}] = pvc
+		}
+	}
+
+	return cache, nil
+}
+
+// buildStorageClassCache maps storage class names to their provisioner.
+func buildStorageClassCache(ctx context.Context, c client.Reader) (map[string]string, error) {
+	items, err := c.List(ctx, resources.StorageClass)
+	if err != nil {
+		return nil, fmt.Errorf("listing StorageClasses: %w", err)
+	}
+
+	cache := make(map[string]string, len(items))
+
+	for _, item := range items {
+		provisioner, _, err := unstructured.NestedString(item.Object, "provisioner")
+		if err != nil {
+			return nil, fmt.Errorf("reading provisioner of StorageClass %s: %w", item.GetName(), err)
+		}
+
+		cache[item.GetName()] = provisioner
+	}
+
+	return cache, nil
+}
@@ -0,0 +1,150 @@
+package notebook_test
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	resultpkg "github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/testutil"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/workloads/notebook"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+)
+
+//nolint:gochecknoglobals
+var storageClassListKinds = map[schema.GroupVersionResource]string{
+	resources.Notebook.GVR():              resources.Notebook.ListKind(),
+	resources.DataScienceCluster.GVR():    resources.DataScienceCluster.ListKind(),
+	resources.PersistentVolumeClaim.GVR(): resources.PersistentVolumeClaim.ListKind(),
+	resources.StorageClass.GVR():          resources.StorageClass.ListKind(),
+}
+
+func newNotebookWithPVC(name string, namespace string, claimName string) *unstructured.Unstructured {
+	nb := newNotebook(name, namespace, notebookOptions{})
+	_ = unstructured.SetNestedSlice(nb.Object, []any{
+		map[string]any{
+			"name":                  name,
+			"persistentVolumeClaim": map[string]any{"claimName": claimName},
+		},
+	}, "spec", "template", "spec", "volumes")
+
+	return nb
+}
+
+func newPVC(name string, namespace string, storageClass string, accessModes ...any) *unstructured.Unstructured {
+	return &unstructured.Unstructured{
+		Object: map[string]any{
+			"apiVersion": resources.PersistentVolumeClaim.APIVersion(),
+			"kind":       resources.PersistentVolumeClaim.Kind,
+			"metadata": map[string]any{
+				"name":      name,
+				"namespace": namespace,
+			},
+			"spec": map[string]any{
+				"storageClassName": storageClass,
+				"accessModes":      accessModes,
+			},
+		},
+	}
+}
+
+func newStorageClass(name string, provisioner string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{
+		Object: map[string]any{
+			"apiVersion":  resources.StorageClass.APIVersion(),
+			"kind":        resources.StorageClass.Kind,
+			"metadata":    map[string]any{"name": name},
+			"provisioner": provisioner,
+		},
+	}
+}
+
+func TestStorageClassCheck_Metadata(t *testing.T) {
+	g := NewWithT(t)
+
+	chk := notebook.NewStorageClassCheck()
+
+	g.Expect(chk.ID()).To(Equal("workloads.notebook.storage-class"))
+	g.Expect(chk.Group()).To(Equal(check.GroupWorkload))
+	g.Expect(chk.CheckKind()).To(Equal("notebook"))
+	g.Expect(chk.CheckType()).To(Equal(string(check.CheckTypeDataIntegrity)))
+}
+
+func TestStorageClassCheck_AllCompatible(t *testing.T) {
+	g := NewWithT(t)
+
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds: storageClassListKinds,
+		Objects: []*unstructured.Unstructured{
+			testutil.NewDSC(map[string]string{"workbenches": "Managed"}),
+			newStorageClass("gp3-csi", "ebs.csi.aws.com"),
+			newNotebookWithPVC("wb", "ds-project", "wb-storage"),
+			newPVC("wb-storage", "ds-project", "gp3-csi", "ReadWriteOnce"),
+			newNotebook("no-volumes", "ds-project", notebookOptions{}),
+		},
+		CurrentVersion: "2.17.0",
+		TargetVersion:  "3.0.0",
+	})
+
+	dr, err := notebook.NewStorageClassCheck().Validate(t.Context(), target)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(dr.Status.Conditions).To(HaveLen(1))
+	g.Expect(dr.Status.Conditions[0].Condition).To(MatchFields(IgnoreExtras, Fields{
+		"Type":   Equal(notebook.ConditionTypeStorageCompatible),
+		"Status": Equal(metav1.ConditionTrue),
+		"Reason": Equal(check.ReasonRequirementsMet),
+	}))
+	g.Expect(dr.Annotations).To(HaveKeyWithValue(check.AnnotationImpactedWorkloadCount, "0"))
+	g.Expect(dr.ImpactedObjects).To(BeEmpty())
+}
+
+func TestStorageClassCheck_IncompatibleStorage(t *testing.T) {
+	g := NewWithT(t)
+
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds: storageClassListKinds,
+		Objects: []*unstructured.Unstructured{
+			testutil.NewDSC(map[string]string{"workbenches": "Managed"}),
+			newStorageClass("gp2", "kubernetes.io/aws-ebs"),
+			newStorageClass("gp3-csi", "ebs.csi.aws.com"),
+			newNotebookWithPVC("removed", "ds-project", "removed-storage"),
+			newPVC("removed-storage", "ds-project", "standard", "ReadWriteOnce"),
+			newNotebookWithPVC("in-tree", "ds-project", "in-tree-storage"),
+			newPVC("in-tree-storage", "ds-project", "gp2", "ReadWriteOnce"),
+			newNotebookWithPVC("read-only", "ds-project", "read-only-storage"),
+			newPVC("read-only-storage", "ds-project", "gp3-csi", "ReadOnlyMany"),
+		},
+		CurrentVersion: "2.17.0",
+		TargetVersion:  "3.0.0",
+	})
+
+	dr, err := notebook.NewStorageClassCheck().Validate(t.Context(), target)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(dr.Status.Conditions).To(HaveLen(1))
+	g.Expect(dr.Status.Conditions[0].Condition).To(MatchFields(IgnoreExtras, Fields{
+		"Status":  Equal(metav1.ConditionFalse),
+		"Reason":  Equal(check.ReasonWorkloadsImpacted),
+		"Message": ContainSubstring("Found 3 Notebook(s)"),
+	}))
+	g.Expect(dr.Status.Conditions[0].Impact).To(Equal(resultpkg.ImpactAdvisory))
+	g.Expect(dr.Annotations).To(HaveKeyWithValue(check.AnnotationImpactedWorkloadCount, "3"))
+
+	reasons := make(map[string]string, len(dr.ImpactedObjects))
+	for _, obj := range dr.ImpactedObjects {
+		reasons[obj.Name] = obj.Annotations[notebook.AnnotationCheckReason]
+	}
+
+	g.Expect(reasons).To(MatchAllKeys(Keys{
+		"removed":   ContainSubstring(`storage class "standard" which no longer exists`),
+		"in-tree":   ContainSubstring("deprecated in-tree provisioner kubernetes.io/aws-ebs"),
+		"read-only": ContainSubstring("no writable access mode (ReadOnlyMany)"),
+	}))
+}
@@ -6,12 +6,18 @@ import (
 	"strings"
 
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
 
 	"github.com/opendatahub-io/odh-cli/pkg/resources"
 	"github.com/opendatahub-io/odh-cli/pkg/util/client"
 	"github.com/opendatahub-io/odh-cli/pkg/util/jq"
 )
 
+// notebookPods selects the pod of a Notebook, for pod-level validation (--deep).
+func notebookPods(nb *unstructured.Unstructured) labels.Set {
+	return labels.Set{LabelNotebookName: nb.GetName()}
+}
+
 // NotebookContainer holds the parsed name and image of a container from a notebook spec.
 type NotebookContainer struct {
 	Name  string
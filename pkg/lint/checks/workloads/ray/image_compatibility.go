@@ -0,0 +1,173 @@
+package ray
+
+import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/messages"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/validate"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/version"
+)
+
+const (
+	ConditionTypeRayImagesCompatible = "RayImagesCompatible"
+)
+
+// Annotation keys set on ImpactedObjects by the ImageCompatibility check.
+const (
+	AnnotationCheckImageStatus = "check.opendatahub.io/image-status"
+	AnnotationCheckImageRef    = "check.opendatahub.io/image-ref"
+	AnnotationCheckReason      = "check.opendatahub.io/reason"
+)
+
+// Messages for ImageCompatibility check.
+//
+//nolint:gochecknoglobals // Message catalog entries
+var (
+	MsgNoRayClusters          = messages.New("workloads.ray.image-compatibility.none", "No RayCluster instances found")
+	MsgAllRayImagesCompatible = messages.New("workloads.ray.image-compatibility.compatible", "All %d RayCluster(s) use supported Ray runtime images")
+	MsgRayImageSummary        = messages.New("workloads.ray.image-compatibility.summary", "Found %d RayCluster(s) using %d unique images:\n"+
+		"  - %d compatible (%d images, supported runtime for %s)\n"+
+		"  - %d custom (%d images, user verification needed)\n"+
+		"  - %d problematic (%d images, Ray version older than %s)")
+	MsgVerifyCustomRayImages = messages.New("workloads.ray.image-compatibility.verify-custom", "Verify custom Ray images are built on Ray %s or later before upgrading to RHOAI %s")
+)
+
+// ImageStatus represents the compatibility status of a RayCluster's images.
+type ImageStatus string
+
+const (
+	ImageStatusGood        ImageStatus = "GOOD"
+	ImageStatusCustom      ImageStatus = "CUSTOM"
+	ImageStatusProblematic ImageStatus = "PROBLEMATIC"
+)
+
+// ImageCompatibilityCheck classifies the head and worker images of every RayCluster against the
+// Ray runtime images supported by the target release.
+type ImageCompatibilityCheck struct {
+	check.BaseCheck
+}
+
+func NewImageCompatibilityCheck() *ImageCompatibilityCheck {
+	return &ImageCompatibilityCheck{
+		BaseCheck: check.BaseCheck{
+			CheckGroup:       check.GroupWorkload,
+			Kind:             kind,
+			Type:             check.CheckTypeImpactedWorkloads,
+			CheckID:          "workloads.ray.image-compatibility",
+			CheckName:        "Workloads :: Ray :: Image Compatibility (3.x)",
+			CheckDescription: "Identifies RayClusters whose head or worker images are not supported Ray runtime images for RHOAI 3.x",
+			CheckRemediation: "Update RayClusters to a supported Ray runtime image (Ray " + minRayVersion3x + " or later) before upgrading",
+			CheckResources:   []resources.ResourceType{resources.RayCluster},
+			CheckVersions:    check.VersionsUpgrade2xTo3x,
+			CheckComponents:  []string{kind},
+			CheckDependsOn:   []string{check.CheckIDDSCReadiness},
+		},
+	}
+}
+
+// Validate executes the check against the provided target.
+func (c *ImageCompatibilityCheck) Validate(
+	ctx context.Context,
+	target check.Target,
+) (*result.DiagnosticResult, error) {
+	return validate.Workloads(c, target, resources.RayCluster).
+		Run(ctx, func(_ context.Context, req *validate.WorkloadRequest[*unstructured.Unstructured]) error {
+			analyses := make([]rayClusterAnalysis, 0, len(req.Items))
+
+			for _, rc := range req.Items {
+				a, err := analyzeRayCluster(rc)
+				if err != nil {
+					return err
+				}
+
+				analyses = append(analyses, a)
+			}
+
+			c.setConditions(req.Result, analyses, version.MajorMinorLabel(req.TargetVersion))
+			setImpactedObjects(req.Result, analyses)
+
+			return nil
+		})
+}
+
+func (c *ImageCompatibilityCheck) setConditions(
+	dr *result.DiagnosticResult,
+	analyses []rayClusterAnalysis,
+	targetVersionLabel string,
+) {
+	if len(analyses) == 0 {
+		dr.SetCondition(check.NewCondition(
+			ConditionTypeRayImagesCompatible,
+			metav1.ConditionTrue,
+			check.WithReason(check.ReasonVersionCompatible),
+			check.WithCatalogMessage(MsgNoRayClusters),
+		))
+
+		return
+	}
+
+	counters := countByStatus(analyses)
+
+	if counters[ImageStatusCustom].count == 0 && counters[ImageStatusProblematic].count == 0 {
+		dr.SetCondition(check.NewCondition(
+			ConditionTypeRayImagesCompatible,
+			metav1.ConditionTrue,
+			check.WithReason(check.ReasonVersionCompatible),
+			check.WithCatalogMessage(MsgAllRayImagesCompatible, len(analyses)),
+		))
+
+		return
+	}
+
+	totalImages := 0
+	for _, counter := range counters {
+		totalImages += counter.images.Len()
+	}
+
+	good := counters[ImageStatusGood]
+	custom := counters[ImageStatusCustom]
+	problematic := counters[ImageStatusProblematic]
+
+	remediation := check.WithRemediation(c.CheckRemediation)
+	if problematic.count == 0 {
+		remediation = check.WithCatalogRemediation(MsgVerifyCustomRayImages, minRayVersion3x, targetVersionLabel)
+	}
+
+	dr.SetCondition(check.NewCondition(
+		ConditionTypeRayImagesCompatible,
+		metav1.ConditionFalse,
+		check.WithReason(check.ReasonWorkloadsImpacted),
+		check.WithCatalogMessage(MsgRayImageSummary,
+			len(analyses), totalImages,
+			good.count, good.images.Len(), targetVersionLabel,
+			custom.count, custom.images.Len(),
+			problematic.count, problematic.images.Len(), minRayVersion3x),
+		check.WithImpact(result.ImpactAdvisory),
+		remediation,
+	))
+}
+
+// NewImageGroupRenderer creates the verbose output renderer grouping the RayClusters reported by
+// ImageCompatibilityCheck by image, problematic images first, then by namespace.
+func NewImageGroupRenderer() check.GroupRenderer {
+	return check.GroupRenderer{
+		AnnotationSchema: check.AnnotationSchema{
+			Kind:       resources.RayCluster.Kind,
+			Annotation: AnnotationCheckImageRef,
+		},
+		Noun:    "RayClusters",
+		Unknown: "(unknown image)",
+		Label: func(obj metav1.PartialObjectMetadata) string {
+			return imageStatusLabel(obj.Annotations[AnnotationCheckImageStatus])
+		},
+		Rank: func(obj metav1.PartialObjectMetadata) int {
+			return -statusSeverity(ImageStatus(obj.Annotations[AnnotationCheckImageStatus]))
+		},
+	}
+}
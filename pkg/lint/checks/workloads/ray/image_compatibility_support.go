@@ -0,0 +1,198 @@
+package ray
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/blang/semver/v4"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/jq"
+)
+
+// minRayVersion3x is the oldest Ray release supported by the KubeRay operator shipped in RHOAI 3.x.
+const minRayVersion3x = "2.47.1"
+
+// supportedRayImageRepositories lists the repositories publishing the supported Ray runtime images.
+//
+//nolint:gochecknoglobals // Read-only lookup table
+var supportedRayImageRepositories = []string{
+	"quay.io/modh/ray",
+	"quay.io/rhoai/ray",
+	"registry.redhat.io/rhoai/odh-ray-",
+}
+
+// rayTagVersion matches the Ray version leading a runtime image tag (2.47.1-py311-cu121).
+var rayTagVersion = regexp.MustCompile(`^v?(\d+\.\d+\.\d+)`)
+
+// rayClusterAnalysis contains the image classification for a single RayCluster.
+type rayClusterAnalysis struct {
+	Namespace string
+	Name      string
+	Status    ImageStatus
+	Reason    string
+	ImageRef  string // Worst-classified head or worker image (for image-centric grouping)
+}
+
+type statusCounter struct {
+	count  int
+	images sets.Set[string]
+}
+
+// analyzeRayCluster classifies every head and worker container image and reports the cluster under
+// its worst image: PROBLEMATIC before CUSTOM before GOOD.
+func analyzeRayCluster(rc *unstructured.Unstructured) (rayClusterAnalysis, error) {
+	a := rayClusterAnalysis{
+		Namespace: rc.GetNamespace(),
+		Name:      rc.GetName(),
+		Status:    ImageStatusGood,
+	}
+
+	images, err := jq.Query[[]any](rc, `[(.spec.headGroupSpec.template.spec.containers[]?.image), (.spec.workerGroupSpecs[]?.template.spec.containers[]?.image)]`)
+	if err != nil {
+		return a, fmt.Errorf("querying images of RayCluster %s/%s: %w", a.Namespace, a.Name, err)
+	}
+
+	// .spec.rayVersion is only a fallback for digest-pinned images without a version tag.
+	rayVersion, _, _ := unstructured.NestedString(rc.Object, "spec", "rayVersion")
+
+	for _, img := range images {
+		image, _ := img.(string)
+		if image == "" {
+			continue
+		}
+
+		status, reason := classifyImage(image, rayVersion)
+		if a.ImageRef == "" || statusSeverity(status) > statusSeverity(a.Status) {
+			a.Status = status
+			a.Reason = reason
+			a.ImageRef = image
+		}
+	}
+
+	return a, nil
+}
+
+// classifyImage returns GOOD for supported Ray runtime images at or above minRayVersion3x,
+// PROBLEMATIC for supported runtime images older than that, and CUSTOM for any other image.
+func classifyImage(image string, rayVersion string) (ImageStatus, string) {
+	repository, tag := splitImage(image)
+
+	supported := false
+	for _, repo := range supportedRayImageRepositories {
+		if strings.HasPrefix(repository, repo) {
+			supported = true
+
+			break
+		}
+	}
+
+	if !supported {
+		return ImageStatusCustom, "image is not a supported Ray runtime image"
+	}
+
+	if m := rayTagVersion.FindStringSubmatch(tag); m != nil {
+		rayVersion = m[1]
+	}
+
+	v, err := semver.ParseTolerant(rayVersion)
+	if err != nil {
+		return ImageStatusCustom, "Ray version of the runtime image could not be determined"
+	}
+
+	if v.LT(semver.MustParse(minRayVersion3x)) {
+		return ImageStatusProblematic, fmt.Sprintf("Ray %s is older than the minimum supported version %s", v, minRayVersion3x)
+	}
+
+	return ImageStatusGood, ""
+}
+
+// splitImage splits an image reference into its repository and tag, dropping any digest.
+func splitImage(image string) (string, string) {
+	repository, _, _ := strings.Cut(image, "@")
+
+	slash := strings.LastIndex(repository, "/")
+	if colon := strings.LastIndex(repository, ":"); colon > slash {
+		return repository[:colon], repository[colon+1:]
+	}
+
+	return repository, ""
+}
+
+func statusSeverity(status ImageStatus) int {
+	switch status {
+	case ImageStatusProblematic:
+		return 2
+	case ImageStatusCustom:
+		return 1
+	case ImageStatusGood:
+		return 0
+	}
+
+	return 0
+}
+
+func countByStatus(analyses []rayClusterAnalysis) map[ImageStatus]statusCounter {
+	counters := make(map[ImageStatus]statusCounter)
+
+	for _, a := range analyses {
+		c := counters[a.Status]
+		if c.images == nil {
+			c.images = sets.New[string]()
+		}
+
+		c.count++
+		c.images.Insert(a.ImageRef)
+		counters[a.Status] = c
+	}
+
+	return counters
+}
+
+// setImpactedObjects sets the ImpactedObjects to RayClusters with custom or problematic images.
+// Uses an empty slice (not nil) to prevent validate.Workloads from auto-populating.
+func setImpactedObjects(dr *result.DiagnosticResult, analyses []rayClusterAnalysis) {
+	impacted := make([]metav1.PartialObjectMetadata, 0)
+
+	for _, a := range analyses {
+		if a.Status == ImageStatusGood {
+			continue
+		}
+
+		impacted = append(impacted, metav1.PartialObjectMetadata{
+			TypeMeta: resources.RayCluster.TypeMeta(),
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: a.Namespace,
+				Name:      a.Name,
+				Annotations: map[string]string{
+					AnnotationCheckImageStatus: string(a.Status),
+					AnnotationCheckImageRef:    a.ImageRef,
+					AnnotationCheckReason:      a.Reason,
+				},
+			},
+		})
+	}
+
+	dr.Annotations[result.AnnotationResourceCRDName] = resources.RayCluster.CRDFQN()
+	dr.ImpactedObjects = impacted
+}
+
+// imageStatusLabel returns a user-friendly label for the image status.
+func imageStatusLabel(status string) string {
+	switch ImageStatus(status) {
+	case ImageStatusGood:
+		return "compatible image"
+	case ImageStatusCustom:
+		return "custom image"
+	case ImageStatusProblematic:
+		return "incompatible image"
+	}
+
+	return "image"
+}
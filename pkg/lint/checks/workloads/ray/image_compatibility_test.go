@@ -0,0 +1,181 @@
+package ray_test
+
+import (
+	"bytes"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/testutil"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/workloads/ray"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+)
+
+func newRayClusterWithImages(namespace string, name string, headImage string, workerImages ...string) *unstructured.Unstructured {
+	workers := make([]any, 0, len(workerImages))
+	for _, image := range workerImages {
+		workers = append(workers, map[string]any{
+			"groupName": "workers",
+			"template": map[string]any{
+				"spec": map[string]any{
+					"containers": []any{map[string]any{"name": "ray-worker", "image": image}},
+				},
+			},
+		})
+	}
+
+	return &unstructured.Unstructured{
+		Object: map[string]any{
+			"apiVersion": resources.RayCluster.APIVersion(),
+			"kind":       resources.RayCluster.Kind,
+			"metadata": map[string]any{
+				"name":      name,
+				"namespace": namespace,
+			},
+			"spec": map[string]any{
+				"headGroupSpec": map[string]any{
+					"template": map[string]any{
+						"spec": map[string]any{
+							"containers": []any{map[string]any{"name": "ray-head", "image": headImage}},
+						},
+					},
+				},
+				"workerGroupSpecs": workers,
+			},
+		},
+	}
+}
+
+func TestImageCompatibilityCheck_NoRayClusters(t *testing.T) {
+	g := NewWithT(t)
+
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds:      listKinds,
+		Objects:        []*unstructured.Unstructured{testutil.NewDSC(map[string]string{"ray": "Managed"})},
+		CurrentVersion: "2.17.0",
+		TargetVersion:  "3.0.0",
+	})
+
+	dr, err := ray.NewImageCompatibilityCheck().Validate(t.Context(), target)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(dr.Status.Conditions).To(HaveLen(1))
+	g.Expect(dr.Status.Conditions[0].Condition).To(MatchFields(IgnoreExtras, Fields{
+		"Type":    Equal(ray.ConditionTypeRayImagesCompatible),
+		"Status":  Equal(metav1.ConditionTrue),
+		"Reason":  Equal(check.ReasonVersionCompatible),
+		"Message": Equal(ray.MsgNoRayClusters.Format),
+	}))
+	g.Expect(dr.ImpactedObjects).To(BeEmpty())
+}
+
+func TestImageCompatibilityCheck_SupportedImages(t *testing.T) {
+	g := NewWithT(t)
+
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds: listKinds,
+		Objects: []*unstructured.Unstructured{
+			testutil.NewDSC(map[string]string{"ray": "Managed"}),
+			newRayClusterWithImages("ns1", "rc-1",
+				"quay.io/modh/ray:2.47.1-py311-cu121",
+				"quay.io/modh/ray:2.47.1-py311-cu121",
+			),
+		},
+		CurrentVersion: "2.17.0",
+		TargetVersion:  "3.0.0",
+	})
+
+	dr, err := ray.NewImageCompatibilityCheck().Validate(t.Context(), target)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(dr.Status.Conditions).To(HaveLen(1))
+	g.Expect(dr.Status.Conditions[0].Condition).To(MatchFields(IgnoreExtras, Fields{
+		"Status":  Equal(metav1.ConditionTrue),
+		"Message": ContainSubstring("All 1 RayCluster(s)"),
+	}))
+	g.Expect(dr.ImpactedObjects).To(BeEmpty())
+}
+
+func TestImageCompatibilityCheck_CustomAndProblematicImages(t *testing.T) {
+	g := NewWithT(t)
+
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds: listKinds,
+		Objects: []*unstructured.Unstructured{
+			testutil.NewDSC(map[string]string{"ray": "Managed"}),
+			newRayClusterWithImages("ns1", "good", "quay.io/modh/ray:2.47.1-py311-cu121"),
+			newRayClusterWithImages("ns1", "old-worker",
+				"quay.io/modh/ray:2.47.1-py311-cu121",
+				"quay.io/modh/ray:2.35.0-py311-cu121",
+			),
+			newRayClusterWithImages("ns2", "custom", "docker.io/rayproject/ray:2.47.1"),
+		},
+		CurrentVersion: "2.17.0",
+		TargetVersion:  "3.0.0",
+	})
+
+	chk := ray.NewImageCompatibilityCheck()
+	dr, err := chk.Validate(t.Context(), target)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(dr.Status.Conditions).To(HaveLen(1))
+	g.Expect(dr.Status.Conditions[0].Condition).To(MatchFields(IgnoreExtras, Fields{
+		"Type":   Equal(ray.ConditionTypeRayImagesCompatible),
+		"Status": Equal(metav1.ConditionFalse),
+		"Reason": Equal(check.ReasonWorkloadsImpacted),
+		"Message": And(
+			ContainSubstring("Found 3 RayCluster(s) using 3 unique images"),
+			ContainSubstring("1 custom"),
+			ContainSubstring("1 problematic"),
+		),
+	}))
+	g.Expect(dr.Status.Conditions[0].Impact).To(Equal(result.ImpactAdvisory))
+	g.Expect(dr.ImpactedObjects).To(HaveLen(2))
+	g.Expect(dr.ImpactedObjects[0].Name).To(Equal("old-worker"))
+	g.Expect(dr.ImpactedObjects[0].Annotations).To(MatchKeys(IgnoreExtras, Keys{
+		ray.AnnotationCheckImageStatus: Equal(string(ray.ImageStatusProblematic)),
+		ray.AnnotationCheckImageRef:    Equal("quay.io/modh/ray:2.35.0-py311-cu121"),
+	}))
+	g.Expect(dr.ImpactedObjects[1].Annotations).To(
+		HaveKeyWithValue(ray.AnnotationCheckImageStatus, string(ray.ImageStatusCustom)),
+	)
+
+	var buf bytes.Buffer
+	ray.NewImageGroupRenderer().FormatVerboseOutput(&buf, dr)
+
+	g.Expect(buf.String()).To(Equal(
+		"    incompatible image: quay.io/modh/ray:2.35.0-py311-cu121 (1 RayClusters)\n" +
+			"      - namespace: ns1\n" +
+			"           - " + resources.RayCluster.CRDFQN() + "/old-worker\n" +
+			"\n" +
+			"    custom image: docker.io/rayproject/ray:2.47.1 (1 RayClusters)\n" +
+			"      - namespace: ns2\n" +
+			"           - " + resources.RayCluster.CRDFQN() + "/custom\n" +
+			"\n",
+	))
+}
+
+func TestImageCompatibilityCheck_CanApply(t *testing.T) {
+	g := NewWithT(t)
+
+	chk := ray.NewImageCompatibilityCheck()
+
+	for state, expected := range map[string]bool{"Managed": true, "Removed": false} {
+		target := testutil.NewTarget(t, testutil.TargetConfig{
+			ListKinds:      listKinds,
+			Objects:        []*unstructured.Unstructured{testutil.NewDSC(map[string]string{"ray": state})},
+			CurrentVersion: "2.17.0",
+			TargetVersion:  "3.0.0",
+		})
+
+		canApply, err := chk.CanApply(t.Context(), target)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(canApply).To(Equal(expected), "state %s", state)
+	}
+}
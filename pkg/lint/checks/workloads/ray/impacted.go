@@ -2,20 +2,15 @@ package ray
 
 import (
 	"context"
-	"fmt"
 	"io"
 	"slices"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
-	"github.com/opendatahub-io/odh-cli/pkg/constants"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check/validate"
 	"github.com/opendatahub-io/odh-cli/pkg/resources"
-	"github.com/opendatahub-io/odh-cli/pkg/util/client"
-	"github.com/opendatahub-io/odh-cli/pkg/util/components"
-	"github.com/opendatahub-io/odh-cli/pkg/util/version"
 )
 
 const (
@@ -44,25 +39,14 @@ func NewImpactedWorkloadsCheck() *ImpactedWorkloadsCheck {
 			CheckName:        "Workloads :: Ray :: Impacted Workloads (3.x)",
 			CheckDescription: "Lists RayClusters managed by CodeFlare that will be impacted in RHOAI 3.x (CodeFlare not available)",
 			CheckRemediation: "Delete or back up CodeFlare-managed RayClusters before upgrading, as CodeFlare will not be available in RHOAI 3.x",
+			CheckResources:   []resources.ResourceType{resources.RayCluster},
+			CheckVersions:    check.VersionsUpgrade2xTo3x,
+			CheckComponents:  []string{kind},
+			CheckDependsOn:   []string{check.CheckIDDSCReadiness},
 		},
 	}
 }
 
-// CanApply returns whether this check should run for the given target.
-// Only applies when upgrading FROM 2.x TO 3.x and Ray is Managed.
-func (c *ImpactedWorkloadsCheck) CanApply(ctx context.Context, target check.Target) (bool, error) {
-	if !version.IsUpgradeFrom2xTo3x(target.CurrentVersion, target.TargetVersion) {
-		return false, nil
-	}
-
-	dsc, err := client.GetDataScienceCluster(ctx, target.Client)
-	if err != nil {
-		return false, fmt.Errorf("getting DataScienceCluster: %w", err)
-	}
-
-	return components.HasManagementState(dsc, kind, constants.ManagementStateManaged), nil
-}
-
 // validateRayClustersWithBackupStatus sets conditions and ImpactedObjects from full metadata
 // so the pre-upgrade backup annotation (odh.ray.io/pre-upgrade-backup-taken) can be displayed per cluster.
 func (c *ImpactedWorkloadsCheck) validateRayClustersWithBackupStatus(
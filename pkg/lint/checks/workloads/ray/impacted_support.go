@@ -8,11 +8,21 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/messages"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check/validate"
 	"github.com/opendatahub-io/odh-cli/pkg/util/version"
 )
 
+// Messages of the CodeFlare RayCluster impacted workloads check.
+//
+//nolint:gochecknoglobals // Message catalog entries
+var (
+	msgNoRayClusters       = messages.New("workloads.ray.impacted-workloads.none", "No CodeFlare-managed RayCluster(s) found - ready for RHOAI %s upgrade")
+	msgRayClustersReady    = messages.New("workloads.ray.impacted-workloads.ready", "All %d CodeFlare-managed RayCluster(s) have completed pre-upgrade steps - ready for RHOAI %s")
+	msgRayClustersNotReady = messages.New("workloads.ray.impacted-workloads.not-ready", "Found %d CodeFlare-managed RayCluster(s) which have not had pre-upgrade steps completed, not ready for RHOAI %s upgrade")
+)
+
 func (c *ImpactedWorkloadsCheck) newCodeFlareRayClusterCondition(
 	_ context.Context,
 	req *validate.WorkloadRequest[*metav1.PartialObjectMetadata],
@@ -31,7 +41,7 @@ func (c *ImpactedWorkloadsCheck) newCodeFlareRayClusterCondition(
 			ConditionTypeCodeFlareRayClusterCompatible,
 			metav1.ConditionTrue,
 			check.WithReason(check.ReasonVersionCompatible),
-			check.WithMessage("No CodeFlare-managed RayCluster(s) found - ready for RHOAI %s upgrade", targetLabel),
+			check.WithCatalogMessage(msgNoRayClusters, targetLabel),
 		)}
 	}
 	if withAnnotation == total {
@@ -39,7 +49,7 @@ func (c *ImpactedWorkloadsCheck) newCodeFlareRayClusterCondition(
 			ConditionTypeCodeFlareRayClusterCompatible,
 			metav1.ConditionTrue,
 			check.WithReason(check.ReasonVersionCompatible),
-			check.WithMessage("All %d CodeFlare-managed RayCluster(s) have completed pre-upgrade steps - ready for RHOAI %s", total, targetLabel),
+			check.WithCatalogMessage(msgRayClustersReady, total, targetLabel),
 		)}
 	}
 	withoutAnnotation := total - withAnnotation
@@ -48,7 +58,7 @@ func (c *ImpactedWorkloadsCheck) newCodeFlareRayClusterCondition(
 		ConditionTypeCodeFlareRayClusterCompatible,
 		metav1.ConditionFalse,
 		check.WithReason(check.ReasonVersionIncompatible),
-		check.WithMessage("Found %d CodeFlare-managed RayCluster(s) which have not had pre-upgrade steps completed, not ready for RHOAI %s upgrade", withoutAnnotation, targetLabel),
+		check.WithCatalogMessage(msgRayClustersNotReady, withoutAnnotation, targetLabel),
 		check.WithImpact(result.ImpactAdvisory),
 		check.WithRemediation(c.CheckRemediation),
 	)}
@@ -0,0 +1,202 @@
+package trainingoperator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/opendatahub-io/odh-cli/pkg/constants"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/messages"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+	"github.com/opendatahub-io/odh-cli/pkg/util/jq"
+	"github.com/opendatahub-io/odh-cli/pkg/util/version"
+)
+
+//nolint:gochecknoglobals // Message catalog entries
+var (
+	msgAPIVersionsCompatible   = messages.New("workloads.trainingoperator.api-version-deprecation.compatible", "No PyTorchJob or TFJob CRDs store API versions removed in RHOAI %s")
+	msgAPIVersionsIncompatible = messages.New("workloads.trainingoperator.api-version-deprecation.incompatible", "Training job CRDs store API versions removed in RHOAI %s (%s): %d job(s) affected (%d active, %d completed)")
+)
+
+// trainingJobTypes lists the training operator job kinds whose CRDs are inspected.
+//
+//nolint:gochecknoglobals // Read-only lookup table
+var trainingJobTypes = []resources.ResourceType{
+	resources.PyTorchJob,
+	resources.TFJob,
+}
+
+// APIVersionCheck detects PyTorchJob and TFJob CRDs whose status.storedVersions still include an API
+// version removed by the training operator in RHOAI 3.x, which only serves v1 (removed versions come
+// from the compatibility matrix). Jobs of an affected kind are listed as impacted; the upgrade is blocked while any of them is still running.
+type APIVersionCheck struct {
+	check.BaseCheck
+}
+
+func NewAPIVersionCheck() *APIVersionCheck {
+	return &APIVersionCheck{
+		BaseCheck: check.BaseCheck{
+			CheckGroup:       check.GroupWorkload,
+			Kind:             constants.ComponentTrainingOperator,
+			Type:             check.CheckTypeImpactedWorkloads,
+			CheckID:          "workloads.trainingoperator.api-version-deprecation",
+			CheckName:        "Workloads :: TrainingOperator :: API Version Deprecation (3.x)",
+			CheckDescription: "Detects PyTorchJob and TFJob resources stored at API versions removed by the training operator in RHOAI 3.x",
+			CheckRemediation: "Wait for active jobs to finish or delete them, then delete or re-create completed jobs so they are stored at v1 and remove the old versions from the CRD status.storedVersions",
+			CheckResources: []resources.ResourceType{
+				resources.PyTorchJob,
+				resources.TFJob,
+				resources.CustomResourceDefinition,
+			},
+			CheckVersions:   check.VersionsTarget3x,
+			CheckComponents: []string{constants.ComponentTrainingOperator},
+			CheckDependsOn:  []string{check.CheckIDDSCReadiness},
+		},
+	}
+}
+
+// Validate inspects the stored versions of each training job CRD and lists the jobs of every
+// affected kind, split into active and completed.
+func (c *APIVersionCheck) Validate(
+	ctx context.Context,
+	target check.Target,
+) (*result.DiagnosticResult, error) {
+	dr := c.NewResult()
+	tv := version.MajorMinorLabel(target.TargetVersion)
+
+	if target.TargetVersion != nil {
+		dr.Annotations[check.AnnotationCheckTargetVersion] = target.TargetVersion.String()
+	}
+
+	var (
+		staleCRDs         []string
+		activeCount       int
+		completedCount    int
+		impactedByJobType = make(map[string][]types.NamespacedName)
+	)
+
+	for _, rt := range trainingJobTypes {
+		removed, err := removedStoredVersions(ctx, target.Client, rt,
+			target.Compat().RemovedAPIVersions(target.TargetVersion, rt.Group, rt.Resource))
+		if err != nil {
+			return nil, err
+		}
+
+		if len(removed) == 0 {
+			continue
+		}
+
+		staleCRDs = append(staleCRDs, fmt.Sprintf("%s: %s", rt.Resource+"."+rt.Group, strings.Join(removed, ", ")))
+
+		jobs, err := target.Client.List(ctx, rt)
+		if err != nil && !client.IsResourceTypeNotFound(err) {
+			return nil, fmt.Errorf("listing %s: %w", rt.Kind, err)
+		}
+
+		for _, job := range jobs {
+			done, err := isJobCompleted(job)
+			if err != nil {
+				return nil, fmt.Errorf("checking job %s/%s completion: %w", job.GetNamespace(), job.GetName(), err)
+			}
+
+			if done {
+				completedCount++
+			} else {
+				activeCount++
+			}
+
+			impactedByJobType[rt.Kind] = append(impactedByJobType[rt.Kind], types.NamespacedName{
+				Namespace: job.GetNamespace(),
+				Name:      job.GetName(),
+			})
+		}
+	}
+
+	dr.Annotations[check.AnnotationImpactedWorkloadCount] = strconv.Itoa(activeCount + completedCount)
+
+	if len(staleCRDs) == 0 {
+		dr.SetCondition(check.NewCondition(
+			check.ConditionTypeCompatible,
+			metav1.ConditionTrue,
+			check.WithReason(check.ReasonVersionCompatible),
+			check.WithCatalogMessage(msgAPIVersionsCompatible, tv),
+		))
+
+		return dr, nil
+	}
+
+	// Running jobs cannot be migrated to v1 in place; completed ones only need cleaning up.
+	impact := result.ImpactAdvisory
+	if activeCount > 0 {
+		impact = result.ImpactBlocking
+	}
+
+	dr.SetCondition(check.NewCondition(
+		check.ConditionTypeCompatible,
+		metav1.ConditionFalse,
+		check.WithReason(check.ReasonVersionIncompatible),
+		check.WithCatalogMessage(msgAPIVersionsIncompatible, tv, strings.Join(staleCRDs, "; "),
+			activeCount+completedCount, activeCount, completedCount),
+		check.WithImpact(impact),
+		check.WithRemediation(c.CheckRemediation),
+	))
+
+	for _, rt := range trainingJobTypes {
+		if names := impactedByJobType[rt.Kind]; len(names) > 0 {
+			dr.AddImpactedObjects(rt, names)
+		}
+	}
+
+	return dr, nil
+}
+
+// removedStoredVersions returns the stored versions of the job CRD that the compatibility matrix
+// lists as removed by the target release.
+func removedStoredVersions(
+	ctx context.Context,
+	r client.Reader,
+	rt resources.ResourceType,
+	removedVersions []string,
+) ([]string, error) {
+	if len(removedVersions) == 0 {
+		return nil, nil
+	}
+
+	crdName := rt.Resource + "." + rt.Group
+
+	crd, err := r.GetResource(ctx, resources.CustomResourceDefinition, crdName)
+
+	switch {
+	case apierrors.IsNotFound(err):
+		return nil, nil
+	case err != nil:
+		return nil, fmt.Errorf("getting CRD %s: %w", crdName, err)
+	case crd == nil:
+		return nil, nil
+	}
+
+	storedVersions, err := jq.Query[[]any](crd, ".status.storedVersions")
+	if err != nil && !errors.Is(err, jq.ErrNotFound) {
+		return nil, fmt.Errorf("querying status.storedVersions for CRD %s: %w", crdName, err)
+	}
+
+	var removed []string
+
+	for _, v := range storedVersions {
+		if s, ok := v.(string); ok && slices.Contains(removedVersions, s) && !slices.Contains(removed, s) {
+			removed = append(removed, s)
+		}
+	}
+
+	return removed, nil
+}
@@ -0,0 +1,162 @@
+package trainingoperator_test
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	resultpkg "github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/testutil"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/workloads/trainingoperator"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+)
+
+//nolint:gochecknoglobals // Test fixture - shared across test functions.
+var apiVersionListKinds = map[schema.GroupVersionResource]string{
+	resources.PyTorchJob.GVR():               resources.PyTorchJob.ListKind(),
+	resources.TFJob.GVR():                    resources.TFJob.ListKind(),
+	resources.CustomResourceDefinition.GVR(): resources.CustomResourceDefinition.ListKind(),
+	resources.DataScienceCluster.GVR():       resources.DataScienceCluster.ListKind(),
+}
+
+func newJobCRD(rt resources.ResourceType, storedVersions ...any) *unstructured.Unstructured {
+	return &unstructured.Unstructured{
+		Object: map[string]any{
+			"apiVersion": resources.CustomResourceDefinition.APIVersion(),
+			"kind":       resources.CustomResourceDefinition.Kind,
+			"metadata":   map[string]any{"name": rt.Resource + "." + rt.Group},
+			"status":     map[string]any{"storedVersions": storedVersions},
+		},
+	}
+}
+
+func newTrainingJob(rt resources.ResourceType, name string, conditionType string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{
+		Object: map[string]any{
+			"apiVersion": rt.APIVersion(),
+			"kind":       rt.Kind,
+			"metadata": map[string]any{
+				"name":      name,
+				"namespace": "test-ns",
+			},
+			"status": map[string]any{
+				"conditions": []any{
+					map[string]any{"type": conditionType, "status": "True"},
+				},
+			},
+		},
+	}
+}
+
+func TestAPIVersionCheck_CanApply(t *testing.T) {
+	g := NewWithT(t)
+
+	chk := trainingoperator.NewAPIVersionCheck()
+
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds:      apiVersionListKinds,
+		Objects:        []*unstructured.Unstructured{testutil.NewDSC(map[string]string{"trainingoperator": "Managed"})},
+		CurrentVersion: "2.17.0",
+		TargetVersion:  "3.0.0",
+	})
+	canApply, err := chk.CanApply(t.Context(), target)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(canApply).To(BeTrue())
+
+	target = testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds:      apiVersionListKinds,
+		Objects:        []*unstructured.Unstructured{testutil.NewDSC(map[string]string{"trainingoperator": "Removed"})},
+		CurrentVersion: "2.17.0",
+		TargetVersion:  "3.0.0",
+	})
+	canApply, err = chk.CanApply(t.Context(), target)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(canApply).To(BeFalse())
+}
+
+func TestAPIVersionCheck_OnlyV1Stored(t *testing.T) {
+	g := NewWithT(t)
+
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds: apiVersionListKinds,
+		Objects: []*unstructured.Unstructured{
+			newJobCRD(resources.PyTorchJob, "v1"),
+			newJobCRD(resources.TFJob, "v1"),
+			newTrainingJob(resources.PyTorchJob, "running", "Running"),
+		},
+		TargetVersion: "3.0.0",
+	})
+
+	dr, err := trainingoperator.NewAPIVersionCheck().Validate(t.Context(), target)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(dr.Status.Conditions).To(HaveLen(1))
+	g.Expect(dr.Status.Conditions[0].Condition).To(MatchFields(IgnoreExtras, Fields{
+		"Type":   Equal(check.ConditionTypeCompatible),
+		"Status": Equal(metav1.ConditionTrue),
+		"Reason": Equal(check.ReasonVersionCompatible),
+	}))
+	g.Expect(dr.Annotations).To(HaveKeyWithValue(check.AnnotationImpactedWorkloadCount, "0"))
+	g.Expect(dr.ImpactedObjects).To(BeEmpty())
+}
+
+func TestAPIVersionCheck_CompletedJobsAdvisory(t *testing.T) {
+	g := NewWithT(t)
+
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds: apiVersionListKinds,
+		Objects: []*unstructured.Unstructured{
+			newJobCRD(resources.PyTorchJob, "v1"),
+			newJobCRD(resources.TFJob, "v1beta2", "v1"),
+			newTrainingJob(resources.PyTorchJob, "running", "Running"),
+			newTrainingJob(resources.TFJob, "done", "Succeeded"),
+		},
+		TargetVersion: "3.0.0",
+	})
+
+	dr, err := trainingoperator.NewAPIVersionCheck().Validate(t.Context(), target)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(dr.Status.Conditions).To(HaveLen(1))
+	g.Expect(dr.Status.Conditions[0].Condition).To(MatchFields(IgnoreExtras, Fields{
+		"Status":  Equal(metav1.ConditionFalse),
+		"Reason":  Equal(check.ReasonVersionIncompatible),
+		"Message": ContainSubstring("tfjobs.kubeflow.org: v1beta2): 1 job(s) affected (0 active, 1 completed)"),
+	}))
+	g.Expect(dr.Status.Conditions[0].Impact).To(Equal(resultpkg.ImpactAdvisory))
+	g.Expect(dr.ImpactedObjects).To(HaveLen(1))
+	g.Expect(dr.ImpactedObjects[0].Kind).To(Equal(resources.TFJob.Kind))
+	g.Expect(dr.ImpactedObjects[0].Name).To(Equal("done"))
+}
+
+func TestAPIVersionCheck_ActiveJobsBlocking(t *testing.T) {
+	g := NewWithT(t)
+
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds: apiVersionListKinds,
+		Objects: []*unstructured.Unstructured{
+			newJobCRD(resources.PyTorchJob, "v1beta1", "v1"),
+			newTrainingJob(resources.PyTorchJob, "running", "Running"),
+			newTrainingJob(resources.PyTorchJob, "failed", "Failed"),
+		},
+		TargetVersion: "3.0.0",
+	})
+
+	dr, err := trainingoperator.NewAPIVersionCheck().Validate(t.Context(), target)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(dr.Status.Conditions).To(HaveLen(1))
+	g.Expect(dr.Status.Conditions[0].Condition).To(MatchFields(IgnoreExtras, Fields{
+		"Status":  Equal(metav1.ConditionFalse),
+		"Message": ContainSubstring("2 job(s) affected (1 active, 1 completed)"),
+	}))
+	g.Expect(dr.Status.Conditions[0].Impact).To(Equal(resultpkg.ImpactBlocking))
+	g.Expect(dr.Annotations).To(HaveKeyWithValue(check.AnnotationImpactedWorkloadCount, "2"))
+	g.Expect(dr.ImpactedObjects).To(HaveLen(2))
+}
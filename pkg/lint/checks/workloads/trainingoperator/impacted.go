@@ -12,9 +12,6 @@ import (
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check/validate"
 	"github.com/opendatahub-io/odh-cli/pkg/resources"
-	"github.com/opendatahub-io/odh-cli/pkg/util/client"
-	"github.com/opendatahub-io/odh-cli/pkg/util/components"
-	"github.com/opendatahub-io/odh-cli/pkg/util/version"
 )
 
 const (
@@ -35,26 +32,14 @@ func NewImpactedWorkloadsCheck() *ImpactedWorkloadsCheck {
 			CheckName:        "Workloads :: TrainingOperator :: Impacted Workloads (3.3+)",
 			CheckDescription: "Lists PyTorchJobs using deprecated TrainingOperator (Kubeflow v1) that will be impacted by transition to Trainer v2",
 			CheckRemediation: "Complete or delete active PyTorchJobs before upgrading; plan migration to Trainer v2 API",
+			CheckResources:   []resources.ResourceType{resources.PyTorchJob},
+			CheckVersions:    check.VersionsTargetAtLeast33,
+			CheckComponents:  []string{constants.ComponentTrainingOperator},
+			CheckDependsOn:   []string{check.CheckIDDSCReadiness},
 		},
 	}
 }
 
-// CanApply returns whether this check should run for the given target.
-// Only applies when target version >= 3.3 and TrainingOperator is Managed.
-func (c *ImpactedWorkloadsCheck) CanApply(ctx context.Context, target check.Target) (bool, error) {
-	//nolint:mnd // Version numbers 3.3
-	if !version.IsVersionAtLeast(target.TargetVersion, 3, 3) {
-		return false, nil
-	}
-
-	dsc, err := client.GetDataScienceCluster(ctx, target.Client)
-	if err != nil {
-		return false, fmt.Errorf("getting DataScienceCluster: %w", err)
-	}
-
-	return components.HasManagementState(dsc, constants.ComponentTrainingOperator, constants.ManagementStateManaged), nil
-}
-
 func (c *ImpactedWorkloadsCheck) Validate(
 	ctx context.Context,
 	target check.Target,
@@ -8,10 +8,21 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/messages"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
 	"github.com/opendatahub-io/odh-cli/pkg/util/jq"
 )
 
+// Messages of the TrainingOperator impacted workloads check.
+//
+//nolint:gochecknoglobals // Message catalog entries
+var (
+	msgNoPyTorchJobs        = messages.New("workloads.trainingoperator.impacted-workloads.none", "No PyTorchJob(s) found - no workloads impacted by TrainingOperator deprecation")
+	msgPyTorchJobs          = messages.New("workloads.trainingoperator.impacted-workloads.found", "Found %d PyTorchJob(s) (%d active, %d completed) - workloads use deprecated TrainingOperator (Kubeflow v1) which will be replaced by Trainer v2")
+	msgActivePyTorchJobs    = messages.New("workloads.trainingoperator.impacted-workloads.active", "Found %d active PyTorchJob(s) - workloads use deprecated TrainingOperator (Kubeflow v1) which will be replaced by Trainer v2")
+	msgCompletedPyTorchJobs = messages.New("workloads.trainingoperator.impacted-workloads.completed", "Found %d completed PyTorchJob(s) - workloads previously used deprecated TrainingOperator (Kubeflow v1)")
+)
+
 func (c *ImpactedWorkloadsCheck) newPyTorchJobCondition(
 	activeCount int,
 	completedCount int,
@@ -23,7 +34,7 @@ func (c *ImpactedWorkloadsCheck) newPyTorchJobCondition(
 			ConditionTypePyTorchJobsCompatible,
 			metav1.ConditionTrue,
 			check.WithReason(check.ReasonVersionCompatible),
-			check.WithMessage("No PyTorchJob(s) found - no workloads impacted by TrainingOperator deprecation"),
+			check.WithCatalogMessage(msgNoPyTorchJobs),
 		)
 	}
 
@@ -32,7 +43,7 @@ func (c *ImpactedWorkloadsCheck) newPyTorchJobCondition(
 			ConditionTypePyTorchJobsCompatible,
 			metav1.ConditionFalse,
 			check.WithReason(check.ReasonWorkloadsImpacted),
-			check.WithMessage("Found %d PyTorchJob(s) (%d active, %d completed) - workloads use deprecated TrainingOperator (Kubeflow v1) which will be replaced by Trainer v2", totalCount, activeCount, completedCount),
+			check.WithCatalogMessage(msgPyTorchJobs, totalCount, activeCount, completedCount),
 			check.WithImpact(result.ImpactAdvisory),
 			check.WithRemediation(c.CheckRemediation),
 		)
@@ -43,7 +54,7 @@ func (c *ImpactedWorkloadsCheck) newPyTorchJobCondition(
 			ConditionTypePyTorchJobsCompatible,
 			metav1.ConditionFalse,
 			check.WithReason(check.ReasonWorkloadsImpacted),
-			check.WithMessage("Found %d active PyTorchJob(s) - workloads use deprecated TrainingOperator (Kubeflow v1) which will be replaced by Trainer v2", activeCount),
+			check.WithCatalogMessage(msgActivePyTorchJobs, activeCount),
 			check.WithImpact(result.ImpactAdvisory),
 			check.WithRemediation(c.CheckRemediation),
 		)
@@ -53,7 +64,7 @@ func (c *ImpactedWorkloadsCheck) newPyTorchJobCondition(
 		ConditionTypePyTorchJobsCompatible,
 		metav1.ConditionTrue,
 		check.WithReason(check.ReasonVersionCompatible),
-		check.WithMessage("Found %d completed PyTorchJob(s) - workloads previously used deprecated TrainingOperator (Kubeflow v1)", completedCount),
+		check.WithCatalogMessage(msgCompletedPyTorchJobs, completedCount),
 	)
 }
 
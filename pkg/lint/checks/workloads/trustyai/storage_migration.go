@@ -0,0 +1,48 @@
+package trustyai
+
+import (
+	"context"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/validate"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+)
+
+const (
+	ConditionTypeStorageConfigCompatible = "StorageConfigCompatible"
+)
+
+// StorageMigrationCheck detects TrustyAIService CRs using PVC-backed storage or the legacy
+// spec.data configuration, neither of which is supported in 3.x.
+type StorageMigrationCheck struct {
+	check.BaseCheck
+}
+
+func NewStorageMigrationCheck() *StorageMigrationCheck {
+	return &StorageMigrationCheck{
+		BaseCheck: check.BaseCheck{
+			CheckGroup:       check.GroupWorkload,
+			Kind:             kind,
+			Type:             check.CheckTypeConfigMigration,
+			CheckID:          "workloads.trustyai.storage-config-migration",
+			CheckName:        "Workloads :: TrustyAI :: Storage Config Migration (3.x)",
+			CheckDescription: "Detects TrustyAIService CRs using PVC storage or legacy data configuration removed in RHOAI 3.x",
+			CheckRemediation: "Run 'kubectl odh migrate prepare' to back up TrustyAI data, then switch '.spec.storage.format' to DATABASE with a 'databaseConfigurations' Secret and remove the '.spec.data' section before upgrading",
+			CheckResources:   []resources.ResourceType{resources.TrustyAIService},
+			CheckVersions:    check.VersionsUpgrade2xTo3x,
+			CheckComponents:  []string{kind},
+			CheckDependsOn:   []string{check.CheckIDDSCReadiness},
+		},
+	}
+}
+
+// Validate executes the check against the provided target.
+func (c *StorageMigrationCheck) Validate(
+	ctx context.Context,
+	target check.Target,
+) (*result.DiagnosticResult, error) {
+	return validate.Workloads(c, target, resources.TrustyAIService).
+		Filter(hasDeprecatedStorageConfig).
+		Complete(ctx, c.newStorageMigrationCondition)
+}
@@ -0,0 +1,85 @@
+package trustyai
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/messages"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/validate"
+	"github.com/opendatahub-io/odh-cli/pkg/util/inspect"
+	"github.com/opendatahub-io/odh-cli/pkg/util/jq"
+	"github.com/opendatahub-io/odh-cli/pkg/util/version"
+)
+
+// Messages of the TrustyAI storage migration check.
+//
+//nolint:gochecknoglobals // Message catalog entries
+var (
+	msgNoLegacyStorage = messages.New("workloads.trustyai.storage-config-migration.none", "No TrustyAIServices found using PVC storage or legacy data configuration")
+	msgLegacyStorage   = messages.New("workloads.trustyai.storage-config-migration.found", "Found %d TrustyAIService(s) using PVC storage or legacy data configuration removed in RHOAI %s - migrate to DATABASE storage before upgrading")
+)
+
+// storageFormatPVC is the file-based storage format removed in 3.x.
+const storageFormatPVC = "PVC"
+
+// hasDeprecatedStorageConfig returns true if the service stores data on a PVC, either explicitly
+// through spec.storage.format or implicitly by setting spec.storage.folder without a format,
+// or if it still carries the legacy spec.data section.
+func hasDeprecatedStorageConfig(obj *unstructured.Unstructured) (bool, error) {
+	legacyData, err := inspect.HasFields(obj, ".spec.data")
+	if err != nil {
+		return false, err
+	}
+
+	if len(legacyData) > 0 {
+		return true, nil
+	}
+
+	format, err := jq.Query[string](obj, ".spec.storage.format")
+
+	switch {
+	case errors.Is(err, jq.ErrNotFound):
+		folder, err := inspect.HasFields(obj, ".spec.storage.folder")
+		if err != nil {
+			return false, err
+		}
+
+		return len(folder) > 0, nil
+	case err != nil:
+		return false, err
+	default:
+		return strings.EqualFold(format, storageFormatPVC), nil
+	}
+}
+
+func (c *StorageMigrationCheck) newStorageMigrationCondition(
+	_ context.Context,
+	req *validate.WorkloadRequest[*unstructured.Unstructured],
+) ([]result.Condition, error) {
+	count := len(req.Items)
+
+	if count == 0 {
+		return []result.Condition{check.NewCondition(
+			ConditionTypeStorageConfigCompatible,
+			metav1.ConditionTrue,
+			check.WithReason(check.ReasonVersionCompatible),
+			check.WithCatalogMessage(msgNoLegacyStorage),
+		)}, nil
+	}
+
+	return []result.Condition{check.NewCondition(
+		ConditionTypeStorageConfigCompatible,
+		metav1.ConditionFalse,
+		check.WithReason(check.ReasonFeatureRemoved),
+		check.WithCatalogMessage(msgLegacyStorage,
+			count, version.MajorMinorLabel(req.TargetVersion)),
+		check.WithImpact(result.ImpactBlocking),
+		check.WithRemediation(c.CheckRemediation),
+	)}, nil
+}
@@ -0,0 +1,139 @@
+package trustyai_test
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	resultpkg "github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/testutil"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/workloads/trustyai"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+)
+
+//nolint:gochecknoglobals
+var listKinds = map[schema.GroupVersionResource]string{
+	resources.TrustyAIService.GVR():    resources.TrustyAIService.ListKind(),
+	resources.DataScienceCluster.GVR(): resources.DataScienceCluster.ListKind(),
+}
+
+func newTrustyAIService(name string, namespace string, spec map[string]any) *unstructured.Unstructured {
+	return &unstructured.Unstructured{
+		Object: map[string]any{
+			"apiVersion": resources.TrustyAIService.APIVersion(),
+			"kind":       resources.TrustyAIService.Kind,
+			"metadata": map[string]any{
+				"name":      name,
+				"namespace": namespace,
+			},
+			"spec": spec,
+		},
+	}
+}
+
+func TestStorageMigrationCheck_CanApply(t *testing.T) {
+	g := NewWithT(t)
+
+	chk := trustyai.NewStorageMigrationCheck()
+
+	testCases := []struct {
+		name     string
+		state    string
+		current  string
+		expected bool
+	}{
+		{name: "Managed 2.x to 3.x", state: "Managed", current: "2.17.0", expected: true},
+		{name: "Removed 2.x to 3.x", state: "Removed", current: "2.17.0", expected: false},
+		{name: "Managed 3.x to 3.x", state: "Managed", current: "3.0.0", expected: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			target := testutil.NewTarget(t, testutil.TargetConfig{
+				ListKinds:      listKinds,
+				Objects:        []*unstructured.Unstructured{testutil.NewDSC(map[string]string{"trustyai": tc.state})},
+				CurrentVersion: tc.current,
+				TargetVersion:  "3.1.0",
+			})
+
+			canApply, err := chk.CanApply(t.Context(), target)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(canApply).To(Equal(tc.expected))
+		})
+	}
+}
+
+func TestStorageMigrationCheck_DatabaseStorage(t *testing.T) {
+	g := NewWithT(t)
+
+	svc := newTrustyAIService("trustyai-service", "ns1", map[string]any{
+		"storage": map[string]any{
+			"format":                 "DATABASE",
+			"databaseConfigurations": "db-credentials",
+		},
+	})
+
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds:      listKinds,
+		Objects:        []*unstructured.Unstructured{svc},
+		CurrentVersion: "2.17.0",
+		TargetVersion:  "3.0.0",
+	})
+
+	dr, err := trustyai.NewStorageMigrationCheck().Validate(t.Context(), target)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(dr.Status.Conditions).To(HaveLen(1))
+	g.Expect(dr.Status.Conditions[0].Condition).To(MatchFields(IgnoreExtras, Fields{
+		"Type":   Equal(trustyai.ConditionTypeStorageConfigCompatible),
+		"Status": Equal(metav1.ConditionTrue),
+		"Reason": Equal(check.ReasonVersionCompatible),
+	}))
+	g.Expect(dr.Annotations).To(HaveKeyWithValue(check.AnnotationImpactedWorkloadCount, "0"))
+	g.Expect(dr.ImpactedObjects).To(BeEmpty())
+}
+
+func TestStorageMigrationCheck_DeprecatedStorage(t *testing.T) {
+	g := NewWithT(t)
+
+	pvc := newTrustyAIService("pvc-explicit", "ns1", map[string]any{
+		"storage": map[string]any{"format": "PVC", "folder": "/inputs", "size": "1Gi"},
+	})
+	implicitPVC := newTrustyAIService("pvc-implicit", "ns2", map[string]any{
+		"storage": map[string]any{"folder": "/inputs"},
+	})
+	legacyData := newTrustyAIService("legacy-data", "ns3", map[string]any{
+		"storage": map[string]any{"format": "DATABASE", "databaseConfigurations": "db-credentials"},
+		"data":    map[string]any{"filename": "data.csv", "format": "CSV"},
+	})
+	database := newTrustyAIService("database", "ns4", map[string]any{
+		"storage": map[string]any{"format": "DATABASE", "databaseConfigurations": "db-credentials"},
+	})
+
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds:      listKinds,
+		Objects:        []*unstructured.Unstructured{pvc, implicitPVC, legacyData, database},
+		CurrentVersion: "2.17.0",
+		TargetVersion:  "3.0.0",
+	})
+
+	dr, err := trustyai.NewStorageMigrationCheck().Validate(t.Context(), target)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(dr.Status.Conditions).To(HaveLen(1))
+	g.Expect(dr.Status.Conditions[0].Condition).To(MatchFields(IgnoreExtras, Fields{
+		"Type":    Equal(trustyai.ConditionTypeStorageConfigCompatible),
+		"Status":  Equal(metav1.ConditionFalse),
+		"Reason":  Equal(check.ReasonFeatureRemoved),
+		"Message": ContainSubstring("Found 3 TrustyAIService(s)"),
+	}))
+	g.Expect(dr.Status.Conditions[0].Impact).To(Equal(resultpkg.ImpactBlocking))
+	g.Expect(dr.Annotations).To(HaveKeyWithValue(check.AnnotationImpactedWorkloadCount, "3"))
+	g.Expect(dr.ImpactedObjects).To(HaveLen(3))
+}
@@ -0,0 +1,3 @@
+package trustyai
+
+const kind = "trustyai"
@@ -1,22 +1,31 @@
 package lint
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
+	"io"
+	"log/slog"
+	"maps"
+	"net/url"
 	"slices"
 	"strings"
+	"time"
 
 	"github.com/blang/semver/v4"
 	"github.com/fatih/color"
 	"github.com/spf13/pflag"
 
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/cli-runtime/pkg/genericclioptions"
 	"k8s.io/cli-runtime/pkg/genericiooptions"
+	"sigs.k8s.io/yaml"
 
 	"github.com/opendatahub-io/odh-cli/pkg/api"
 	"github.com/opendatahub-io/odh-cli/pkg/cmd"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/messages"
 	resultpkg "github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/components/dashboard"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/components/datasciencepipelines"
@@ -24,16 +33,24 @@ import (
 	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/components/kueue"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/components/llamastack"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/components/modelmesh"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/components/modelregistry"
 	raycomponent "github.com/opendatahub-io/odh-cli/pkg/lint/checks/components/ray"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/components/trainingoperator"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/components/workbenches"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/custom"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/dependencies/certmanager"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/dependencies/gpuoperator"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/dependencies/odhoperator"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/dependencies/openshift"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/dependencies/ossm34"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/dependencies/servicemesh"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/dependencies/sharedossm"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/dependencies/sharedserverless"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/platform/cluster"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/platform/datasciencecluster"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/platform/dscinitialization"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/services/auth"
+	servicemeshservices "github.com/opendatahub-io/odh-cli/pkg/lint/checks/services/servicemesh"
 	datasciencepipelinesworkloads "github.com/opendatahub-io/odh-cli/pkg/lint/checks/workloads/datasciencepipelines"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/workloads/guardrails"
 	kserveworkloads "github.com/opendatahub-io/odh-cli/pkg/lint/checks/workloads/kserve"
@@ -42,11 +59,15 @@ import (
 	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/workloads/notebook"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/workloads/ray"
 	trainingoperatorworkloads "github.com/opendatahub-io/odh-cli/pkg/lint/checks/workloads/trainingoperator"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/workloads/trustyai"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/compat"
 	"github.com/opendatahub-io/odh-cli/pkg/resources"
 	"github.com/opendatahub-io/odh-cli/pkg/schema"
 	"github.com/opendatahub-io/odh-cli/pkg/util/client"
 	clierrors "github.com/opendatahub-io/odh-cli/pkg/util/errors"
 	"github.com/opendatahub-io/odh-cli/pkg/util/iostreams"
+	"github.com/opendatahub-io/odh-cli/pkg/util/kube/mirror"
+	"github.com/opendatahub-io/odh-cli/pkg/util/logging"
 	"github.com/opendatahub-io/odh-cli/pkg/util/stdin"
 	"github.com/opendatahub-io/odh-cli/pkg/util/version"
 )
@@ -57,8 +78,12 @@ var _ cmd.Command = (*Command)(nil)
 const (
 	msgProhibitedOrBlocking = "prohibited or blocking findings detected: upgrade cannot proceed"
 	msgAdvisoryFindings     = "advisory findings detected: review recommended before upgrade"
+	msgBlockingThreshold    = "%d blocking finding(s) exceed --max-blocking %d: upgrade cannot proceed"
+	msgAdvisoryThreshold    = "%d advisory finding(s) exceed --max-advisory %d: review recommended before upgrade"
 	msgInfrastructureErrors = "one or more checks failed due to infrastructure errors"
 	msgCheckExecErrors      = "check execution errors detected: %w"
+	msgNoChecksExecuted     = "Current and target versions are the same (%s), no checks will be executed."
+	msgExecErrorSummary     = "Warning: %d check(s) failed to execute; their results are reported as unknown:"
 )
 
 // Command contains the lint command configuration.
@@ -71,19 +96,231 @@ type Command struct {
 	// If set, runs in upgrade mode (assesses upgrade readiness to target version).
 	TargetVersion string
 
+	// CurrentVersion overrides the detected cluster version, e.g. on disconnected clusters
+	// running custom operator builds where detection fails or reports a version that is not
+	// semver.
+	CurrentVersion string
+
+	// Contexts are the kubeconfig contexts whose clusters are linted in turn, with the
+	// results grouped per cluster. AllContexts lints every context of the kubeconfig.
+	Contexts    []string
+	AllContexts bool
+
+	// UntilVersion assesses every minor release offered by the operator catalog after the
+	// current version, up to and including this one. Mutually exclusive with TargetVersion.
+	UntilVersion string
+
+	// Fix applies remediations exposed by checks implementing check.Remediator
+	// after a dry-run preview and confirmation prompt.
+	Fix bool
+
+	// Yes skips the --fix confirmation prompt.
+	Yes bool
+
+	// CheckTimeout limits the time each check may take; zero disables the limit.
+	CheckTimeout time.Duration
+
+	// ConfigFile is the path of a YAML/JSON config file (see ConfigFile) applied before
+	// stdin input and explicit flags.
+	ConfigFile string
+
+	// CompatMatrixFile is the path of a YAML/JSON compatibility matrix replacing the one
+	// embedded in the binary, e.g. to test pre-release requirements.
+	CompatMatrixFile string
+
+	// CustomChecks is the path of a directory (or single file) of custom check descriptors
+	// registered alongside the built-in checks (see custom.Descriptor).
+	CustomChecks string
+
+	// RulesFile is the path of a YAML/JSON file of workload rules (see custom.Rule), each
+	// registered as a check alongside the built-in checks.
+	RulesFile string
+
+	// SeverityOverrides maps check IDs to the impact their findings are reported with.
+	// Populated from the config file and --severity-override entries (which take precedence).
+	SeverityOverrides map[string]resultpkg.Impact
+
+	// SeverityOverrideFlags holds the raw --severity-override entries (<check-id>=<impact>).
+	SeverityOverrideFlags []string
+
+	// Suppressions lists check selector patterns whose results are dropped from the output.
+	Suppressions []string
+
+	// BaselineWrite is the path of a baseline file to record the current findings in.
+	BaselineWrite string
+
+	// BaselineCompare is the path of a baseline file whose recorded findings are excluded
+	// from the output and verdict, so only new findings are reported.
+	BaselineCompare string
+
+	// MetricsFile is the path of a Prometheus textfile collector file to write gauges to.
+	MetricsFile string
+
+	// PushGateway is the base URL of a Prometheus Pushgateway to push gauges to.
+	PushGateway string
+
+	// Record stores the report of the run as a ConfigMap in the operator namespace, listed by
+	// `lint history`.
+	Record bool
+
+	// DryRunPermissions only verifies that the current user can read every resource the
+	// selected checks need, without running them.
+	DryRunPermissions bool
+
+	// ServiceAccountCheck verifies, before running, that the service account mounted in the
+	// pod can read every resource the selected checks need, failing otherwise.
+	ServiceAccountCheck bool
+
+	// Watch keeps re-running the upgrade checks every Interval and reports changes between runs.
+	Watch bool
+
+	// Interval is the time between runs in watch mode.
+	Interval time.Duration
+
+	// WatchFile is the path of a file the changes reported in watch mode are appended to
+	// instead of stdout.
+	WatchFile string
+
+	// ExitCodeMode selects the exit code scheme (default or outcome).
+	ExitCodeMode ExitCodeMode
+
+	// MaxBlocking and MaxAdvisory are the numbers of blocking and advisory findings tolerated
+	// before the run fails, so teams can ratchet findings down over time. Prohibited findings
+	// are never tolerated.
+	MaxBlocking int
+	MaxAdvisory int
+
+	// ShowSkipped also reports the checks that were not run because they do not apply to the
+	// cluster or versions, so users can confirm which checks were evaluated.
+	ShowSkipped bool
+
+	// ValidateOutput checks JSON and YAML output against the published DiagnosticResultList
+	// schema before writing it, failing the run when it does not conform.
+	ValidateOutput bool
+
+	// GroupBy adds an aggregation of the findings to table, JSON and YAML output.
+	GroupBy GroupBy
+
+	// GroupRender selects whether verbose output groups impacted objects with the registered
+	// group renderers, e.g. notebooks by image, or lists them by namespace.
+	GroupRender GroupRender
+
+	// SortBy selects the order of the findings in table output, e.g. highest risk score first.
+	SortBy SortBy
+
+	// SplitBy writes one report per recipient (e.g. namespace requester) to OutputDir.
+	SplitBy SplitBy
+
+	// OutputDir is the directory split reports are written to.
+	OutputDir string
+
+	// ResolveOwners resolves the owner of each impacted object (see check.OwnerResolver) and
+	// reports it with the object. Implied by SplitBy owner.
+	ResolveOwners bool
+
+	// DetectUsage detects whether impacted workloads are in use or idle (see
+	// check.UsageDetector) and reports it with the object, to prioritize remediation.
+	DetectUsage bool
+
+	// LabelSelector restricts the objects analyzed by workload checks (e.g. "app=team-a").
+	LabelSelector string
+
+	// Deep lets workload checks also inspect the Pods backing the resources they flag, e.g.
+	// for images running out of sync with the spec or restart loops.
+	Deep bool
+
+	// LowMemory trades API calls for memory on clusters with many workloads: workload checks
+	// list metadata and get only the objects they flag, and reads are not cached for the run.
+	LowMemory bool
+
+	// TraceFile receives a record of every API read of the run (GVR, namespace, selectors,
+	// duration and result size) when set.
+	TraceFile string
+
+	// AuditLogFile receives a JSON line per evaluated check (timing, CanApply decision,
+	// conditions, impacted count and errors) when set, as evidence of the validation.
+	AuditLogFile string
+
 	// ISVCDeploymentMode filters InferenceService display by deployment mode.
 	// Valid values: "all" (default), "serverless", "modelmesh".
 	ISVCDeploymentMode string
 
+	// RegistryMirrors holds the raw --registry-mirror entries (<source>=<mirror>), correlating
+	// notebook images pulled from registry mirrors on disconnected clusters.
+	RegistryMirrors []string
+
+	// VerifyRegistry verifies the notebook images no ImageStream correlates in their registry,
+	// promoting them from custom to compatible or incompatible when their digest or build commit
+	// identifies an OOTB image.
+	VerifyRegistry bool
+
+	// Filter is an expression selecting the conditions to output (see ResultFilter),
+	// e.g. "impact==blocking && group==workload".
+	Filter string
+
+	// compatMatrix is the loaded CompatMatrixFile, nil to use the embedded matrix
+	compatMatrix *compat.Matrix
+
+	// printer formats catalog messages in the language selected with Lang
+	printer messages.Printer
+
+	// resultFilter is the parsed Filter expression, nil when no filter is set
+	resultFilter *ResultFilter
+
+	// registryMirrors is the parsed RegistryMirrors
+	registryMirrors mirror.Set
+
 	// parsedTargetVersion is the parsed semver version (upgrade mode only)
 	parsedTargetVersion *semver.Version
 
+	// parsedCurrentVersion is the parsed CurrentVersion, nil to detect the cluster version
+	parsedCurrentVersion *semver.Version
+
+	// targetAlias is the TargetVersion alias (latest, next) to resolve in detectVersions
+	targetAlias string
+
+	// serviceAccountDir is where the service account token verified by ServiceAccountCheck
+	// is mounted
+	serviceAccountDir string
+
+	// ownerResolvers resolve the owners of impacted objects with ResolveOwners
+	ownerResolvers []check.OwnerResolver
+
+	// usageDetectors detect the usage of impacted objects with DetectUsage
+	usageDetectors []check.UsageDetector
+
+	// groupRenderers render the impacted objects of verbose output with GroupRender on
+	groupRenderers *check.GroupRendererRegistry
+
+	// logger receives the structured diagnostics of checks; set for the duration of Run
+	logger *slog.Logger
+
+	// tracer records the API reads of the run with TraceFile
+	tracer *client.Tracer
+
+	// audit records the checks evaluated with AuditLogFile; set for the duration of Run
+	audit *auditLog
+
+	// contextClients creates the clients of each context of a fan-out run; nil to create
+	// them from ConfigFlags
+	contextClients ContextClientFactory
+
+	// targetVersions are the target versions of a multi-target run, oldest first; parsed from
+	// a comma-separated TargetVersion or resolved from UntilVersion in detectVersions
+	targetVersions []semver.Version
+
 	// currentClusterVersion stores the detected OpenShift AI version (populated during Run)
 	currentClusterVersion string
 
+	// currentVersionSource is the source currentClusterVersion was detected from (populated during Run)
+	currentVersionSource version.VersionSource
+
 	// currentOpenShiftVersion stores the detected OpenShift platform version (populated during Run)
 	currentOpenShiftVersion string
 
+	// currentFlavor stores the detected product flavor, ODH or RHOAI (populated during Run)
+	currentFlavor version.Flavor
+
 	// registry is the check registry for this command instance.
 	// Explicitly populated to avoid global state and enable test isolation.
 	registry *check.CheckRegistry
@@ -92,27 +329,25 @@ type Command struct {
 	flags *pflag.FlagSet
 }
 
-// NewCommand creates a new Command with defaults.
-// Per FR-014, SharedOptions are initialized internally.
-// ConfigFlags must be provided to ensure CLI auth flags are properly propagated.
-// Optional configuration can be provided via functional options (e.g., WithTargetVersion).
-func NewCommand(
-	streams genericiooptions.IOStreams,
-	configFlags *genericclioptions.ConfigFlags,
-	options ...CommandOption,
-) *Command {
-	shared := NewSharedOptions(streams, configFlags)
+// newDefaultRegistry creates a registry populated with all lint checks.
+func newDefaultRegistry() *check.CheckRegistry {
 	registry := check.NewRegistry()
 
 	// Explicitly register all checks (no global state, full test isolation)
-	// Platform (2)
+	// Platform (3)
 	registry.MustRegister(dscinitialization.NewDSCInitializationReadinessCheck())
 	registry.MustRegister(datasciencecluster.NewDataScienceClusterReadinessCheck())
+	registry.MustRegister(cluster.NewResourceHeadroomCheck())
 
-	// Components (13)
+	// Components (20)
 	registry.MustRegister(raycomponent.NewCodeFlareRemovalCheck())
 	registry.MustRegister(dashboard.NewAcceleratorProfileMigrationCheck())
+	registry.MustRegister(dashboard.NewOrphanedAcceleratorProfileCheck())
 	registry.MustRegister(dashboard.NewHardwareProfileMigrationCheck())
+	registry.MustRegister(dashboard.NewHardwareProfileCoverageCheck())
+	registry.MustRegister(dashboard.NewProfileDriftCheck())
+	registry.MustRegister(dashboard.NewDashboardConfigMigrationCheck())
+	registry.MustRegister(dashboard.NewTilesRemovalCheck())
 	registry.MustRegister(datasciencepipelines.NewRenamingCheck())
 	registry.MustRegister(kserve.NewServerlessRemovalCheck())
 	registry.MustRegister(kserve.NewKuadrantReadinessCheck())
@@ -124,43 +359,101 @@ func NewCommand(
 	// registry.MustRegister(kueue.NewOperatorInstalledCheck())
 	registry.MustRegister(llamastack.NewRemovalCheck())
 	registry.MustRegister(modelmesh.NewRemovalCheck())
+	registry.MustRegister(modelregistry.NewReadinessCheck())
 	registry.MustRegister(trainingoperator.NewDeprecationCheck())
+	registry.MustRegister(workbenches.NewCullerConfigCheck())
 
-	// Dependencies (6)
+	// Dependencies (8)
 	registry.MustRegister(certmanager.NewCheck())
+	registry.MustRegister(gpuoperator.NewCheck())
+	registry.MustRegister(odhoperator.NewSubscriptionCheck())
 	registry.MustRegister(openshift.NewCheck())
 	registry.MustRegister(ossm34.NewCheck())
 	registry.MustRegister(servicemesh.NewCheck())
 	registry.MustRegister(sharedossm.NewCheck())
 	registry.MustRegister(sharedserverless.NewCheck())
 
-	// Workloads (21)
+	// Services (3)
+	registry.MustRegister(auth.NewMigrationCheck())
+	registry.MustRegister(auth.NewGroupsCheck())
+	registry.MustRegister(servicemeshservices.NewSidecarLeftoversCheck())
+
+	// Workloads (34)
 	registry.MustRegister(ray.NewAppWrapperCleanupCheck())
 	registry.MustRegister(datasciencepipelinesworkloads.NewInstructLabRemovalCheck())
 	registry.MustRegister(datasciencepipelinesworkloads.NewStoredVersionRemovalCheck())
+	registry.MustRegister(datasciencepipelinesworkloads.NewTektonRemovalCheck())
 	registry.MustRegister(guardrails.NewImpactedWorkloadsCheck())
 	registry.MustRegister(guardrails.NewOtelMigrationCheck())
 	registry.MustRegister(kserveworkloads.NewInferenceServiceConfigCheck())
 	registry.MustRegister(kserveworkloads.NewAcceleratorMigrationCheck())
+	registry.MustRegister(kserveworkloads.NewAutoscalingAnnotationsCheck())
 	registry.MustRegister(kserveworkloads.NewHardwareProfileMigrationCheck())
 	registry.MustRegister(kserveworkloads.NewImpactedWorkloadsCheck())
+	registry.MustRegister(kserveworkloads.NewSingleReplicaCheck())
+	registry.MustRegister(kserveworkloads.NewServingRuntimeTemplatesCheck())
+	registry.MustRegister(kserveworkloads.NewEndpointExposureCheck())
+	registry.MustRegister(kserveworkloads.NewConnectionSecretsCheck())
 	registry.MustRegister(kueueworkloads.NewDataIntegrityCheck())
+	registry.MustRegister(kueueworkloads.NewQueueConfigCheck())
 	registry.MustRegister(llamastackworkloads.NewConfigCheck())
 	registry.MustRegister(llamastackworkloads.NewMigrationCheck())
 	registry.MustRegister(notebook.NewAcceleratorMigrationCheck())
 	registry.MustRegister(notebook.NewContainerNameCheck())
+	registry.MustRegister(notebook.NewElyraRuntimeCheck())
 	registry.MustRegister(notebook.NewHardwareProfileMigrationCheck())
 	registry.MustRegister(notebook.NewConnectionIntegrityCheck())
 	registry.MustRegister(notebook.NewHardwareProfileIntegrityCheck())
 	registry.MustRegister(notebook.NewImpactedWorkloadsCheck())
 	registry.MustRegister(notebook.NewNonStoppedWorkloadsCheck())
+	registry.MustRegister(notebook.NewSingleReplicaCheck())
+	registry.MustRegister(notebook.NewStorageClassCheck())
 	registry.MustRegister(ray.NewImpactedWorkloadsCheck())
+	registry.MustRegister(ray.NewImageCompatibilityCheck())
+	registry.MustRegister(trainingoperatorworkloads.NewAPIVersionCheck())
 	registry.MustRegister(trainingoperatorworkloads.NewImpactedWorkloadsCheck())
+	registry.MustRegister(trustyai.NewStorageMigrationCheck())
+
+	if err := registry.ValidateDependencies(); err != nil {
+		panic(fmt.Sprintf("invalid check dependencies: %v", err))
+	}
+
+	return registry
+}
+
+// newDefaultGroupRenderers creates a registry populated with the group renderers of verbose output.
+func newDefaultGroupRenderers() *check.GroupRendererRegistry {
+	renderers := check.NewGroupRendererRegistry()
+
+	renderers.MustRegister(notebook.NewImageGroupRenderer())
+	renderers.MustRegister(ray.NewImageGroupRenderer())
+	renderers.MustRegister(kserveworkloads.NewRuntimeGroupRenderer())
+
+	return renderers
+}
+
+// NewCommand creates a new Command with defaults.
+// Per FR-014, SharedOptions are initialized internally.
+// ConfigFlags must be provided to ensure CLI auth flags are properly propagated.
+// Optional configuration can be provided via functional options (e.g., WithTargetVersion).
+func NewCommand(
+	streams genericiooptions.IOStreams,
+	configFlags *genericclioptions.ConfigFlags,
+	options ...CommandOption,
+) *Command {
+	shared := NewSharedOptions(streams, configFlags)
+	registry := newDefaultRegistry()
 
 	c := &Command{
 		SharedOptions:      shared,
 		registry:           registry,
 		ISVCDeploymentMode: "all",
+		Interval:           DefaultWatchInterval,
+		serviceAccountDir:  client.ServiceAccountDir,
+		ownerResolvers:     check.DefaultOwnerResolvers(),
+		usageDetectors:     check.DefaultUsageDetectors(),
+		groupRenderers:     newDefaultGroupRenderers(),
+		GroupRender:        GroupRenderOn,
 	}
 
 	// Apply functional options
@@ -173,28 +466,127 @@ func NewCommand(
 
 // AddFlags registers command-specific flags with the provided FlagSet.
 func (c *Command) AddFlags(fs *pflag.FlagSet) {
+	c.AddAssessmentFlags(fs)
+
+	fs.StringVarP((*string)(&c.OutputFormat), "output", "o", string(OutputFormatTable), flagDescOutput)
+	_ = fs.SetAnnotation("output", api.AnnotationValidValues, []string{"table", "wide", "json", "ndjson", "yaml", "junit", "html", "markdown"})
+	fs.BoolVarP(&c.Quiet, "quiet", "q", false, flagDescQuiet)
+	fs.BoolVar(&c.NoColor, "no-color", false, flagDescNoColor)
+	fs.StringVar(&c.Lang, "lang", messages.LangEnglish, flagDescLang)
+	_ = fs.SetAnnotation("lang", api.AnnotationValidValues, messages.Languages())
+	fs.BoolVar(&c.FromStdin, "from-stdin", false, stdin.FlagDesc)
+	fs.StringVar(&c.BaselineWrite, "baseline-write", "", flagDescBaselineWrite)
+	fs.StringVar(&c.BaselineCompare, "baseline-compare", "", flagDescBaselineCompare)
+	fs.StringVar(&c.MetricsFile, "metrics-file", "", flagDescMetricsFile)
+	fs.StringVar(&c.PushGateway, "push-gateway", "", flagDescPushGateway)
+	fs.BoolVar(&c.Record, "record", false, flagDescRecord)
+	fs.StringVar(&c.UntilVersion, "until-version", "", flagDescUntilVersion)
+	fs.StringSliceVar(&c.Contexts, "contexts", nil, flagDescContexts)
+	fs.BoolVar(&c.AllContexts, "all-contexts", false, flagDescAllContexts)
+	fs.StringVar((*string)(&c.GroupBy), "group-by", "", flagDescGroupBy)
+	_ = fs.SetAnnotation("group-by", api.AnnotationValidValues, []string{string(GroupByNamespace)})
+	fs.StringVar((*string)(&c.GroupRender), "group-render", string(GroupRenderOn), flagDescGroupRender)
+	_ = fs.SetAnnotation("group-render", api.AnnotationValidValues, []string{string(GroupRenderOn), string(GroupRenderOff)})
+	fs.StringVar((*string)(&c.SortBy), "sort-by", "", flagDescSortBy)
+	_ = fs.SetAnnotation("sort-by", api.AnnotationValidValues, []string{string(SortByScore)})
+	fs.StringVar((*string)(&c.SplitBy), "split-by", "", flagDescSplitBy)
+	_ = fs.SetAnnotation("split-by", api.AnnotationValidValues, []string{string(SplitByRequester), string(SplitByOwner)})
+	fs.StringVar(&c.OutputDir, "output-dir", "", flagDescOutputDir)
+	fs.BoolVar(&c.ResolveOwners, "resolve-owners", false, flagDescResolveOwners)
+	fs.BoolVar(&c.DetectUsage, "detect-usage", false, flagDescDetectUsage)
+	fs.BoolVar(&c.DryRunPermissions, "dry-run-permissions", false, flagDescDryRunPermissions)
+	fs.BoolVar(&c.ServiceAccountCheck, "service-account-check", false, flagDescServiceAcctCheck)
+	fs.BoolVar(&c.Watch, "watch", false, flagDescWatch)
+	fs.DurationVar(&c.Interval, "interval", c.Interval, flagDescInterval)
+	fs.StringVar(&c.WatchFile, "watch-file", "", flagDescWatchFile)
+	fs.StringVar((*string)(&c.ExitCodeMode), "exit-code-mode", string(ExitCodeModeDefault), flagDescExitCodeMode)
+	_ = fs.SetAnnotation("exit-code-mode", api.AnnotationValidValues,
+		[]string{string(ExitCodeModeDefault), string(ExitCodeModeOutcome)})
+	fs.IntVar(&c.MaxBlocking, "max-blocking", 0, flagDescMaxBlocking)
+	fs.IntVar(&c.MaxAdvisory, "max-advisory", 0, flagDescMaxAdvisory)
+	fs.BoolVar(&c.ShowSkipped, "show-skipped", false, flagDescShowSkipped)
+	fs.BoolVar(&c.Fix, "fix", false, flagDescFix)
+	fs.BoolVarP(&c.Yes, "yes", "y", false, flagDescYes)
+
+	// Schema output
+	c.OutputOptions.AddFlags(fs)
+	fs.BoolVar(&c.ValidateOutput, "validate-output", false, flagDescValidateOutput)
+}
+
+// AddAssessmentFlags registers the flags that select and scope the checks of a run.
+// Commands building on Assess (such as plan) register these instead of the full lint flag set.
+func (c *Command) AddAssessmentFlags(fs *pflag.FlagSet) {
 	c.flags = fs // Store for checking explicitly set flags in applyStdinInput
 	fs.StringVar(&c.TargetVersion, "target-version", "", flagDescTargetVersion)
-	fs.StringVarP((*string)(&c.OutputFormat), "output", "o", string(OutputFormatTable), flagDescOutput)
-	_ = fs.SetAnnotation("output", api.AnnotationValidValues, []string{"table", "json", "yaml"})
+	fs.StringVar(&c.CurrentVersion, "current-version", "", flagDescCurrentVersion)
 	fs.StringVar((*string)(&c.SeverityLevel), "severity", string(SeverityLevelInfo), flagDescSeverity)
 	_ = fs.SetAnnotation("severity", api.AnnotationValidValues, []string{"prohibited", "critical", "warning", "info"})
 	fs.StringArrayVar(&c.CheckSelectors, "checks", []string{"*"}, flagDescChecks)
 	fs.BoolVarP(&c.Verbose, "verbose", "v", false, flagDescVerbose)
-	fs.BoolVarP(&c.Quiet, "quiet", "q", false, flagDescQuiet)
 	fs.BoolVar(&c.Debug, "debug", false, flagDescDebug)
-	fs.BoolVar(&c.NoColor, "no-color", false, flagDescNoColor)
+	fs.StringVar(&c.LogLevel, "log-level", c.LogLevel, flagDescLogLevel)
+	_ = fs.SetAnnotation("log-level", api.AnnotationValidValues, logging.Levels())
+	fs.StringVar(&c.LogFormat, "log-format", c.LogFormat, flagDescLogFormat)
+	_ = fs.SetAnnotation("log-format", api.AnnotationValidValues, logging.Formats())
+	fs.StringVar(&c.LogFile, "log-file", "", flagDescLogFile)
+	fs.StringVar(&c.TraceFile, "trace", "", flagDescTrace)
+	fs.StringVar(&c.AuditLogFile, "audit-log", "", flagDescAuditLog)
 	fs.DurationVar(&c.Timeout, "timeout", c.Timeout, flagDescTimeout)
+	fs.DurationVar(&c.CheckTimeout, "check-timeout", 0, flagDescCheckTimeout)
+	fs.StringVarP(&c.LabelSelector, "selector", "l", "", flagDescSelector)
+	fs.BoolVar(&c.Deep, "deep", false, flagDescDeep)
+	fs.BoolVar(&c.LowMemory, "low-memory", false, flagDescLowMemory)
 	fs.StringVar(&c.ISVCDeploymentMode, "isvc-deployment-mode", "all", flagDescISVCDeploymentMode)
 	_ = fs.SetAnnotation("isvc-deployment-mode", api.AnnotationValidValues, []string{"all", "serverless", "modelmesh"})
-	fs.BoolVar(&c.FromStdin, "from-stdin", false, stdin.FlagDesc)
+	fs.StringArrayVar(&c.RegistryMirrors, "registry-mirror", nil, flagDescRegistryMirror)
+	fs.BoolVar(&c.VerifyRegistry, "verify-registry", false, flagDescVerifyRegistry)
+	fs.StringVar(&c.ConfigFile, "config", "", flagDescConfig)
+	fs.StringVar(&c.CompatMatrixFile, "compat-matrix", "", flagDescCompatMatrix)
+	fs.StringVar(&c.CustomChecks, "custom-checks", "", flagDescCustomChecks)
+	fs.StringVar(&c.RulesFile, "rules-file", "", flagDescRulesFile)
+	fs.StringArrayVar(&c.SeverityOverrideFlags, "severity-override", nil, flagDescSeverityOverride)
+	fs.StringVar(&c.Filter, "filter", "", flagDescFilter)
+	fs.StringVar(&c.FromDir, "from-dir", "", flagDescFromDir)
 
 	// Throttling settings
 	fs.Float32Var(&c.QPS, "qps", c.QPS, flagDescQPS)
 	fs.IntVar(&c.Burst, "burst", c.Burst, flagDescBurst)
+	fs.Int64Var(&c.PageSize, "page-size", c.PageSize, flagDescPageSize)
+}
 
-	// Schema output
-	c.OutputOptions.AddFlags(fs)
+// registerCustomChecks loads the custom check descriptors and rules and adds them to the registry.
+func (c *Command) registerCustomChecks() error {
+	var checks []check.Check
+
+	if c.CustomChecks != "" {
+		loaded, err := custom.LoadChecks(c.CustomChecks)
+		if err != nil {
+			return fmt.Errorf("loading custom checks: %w", err)
+		}
+
+		for _, chk := range loaded {
+			checks = append(checks, chk)
+		}
+	}
+
+	if c.RulesFile != "" {
+		rules, err := custom.LoadRules(c.RulesFile)
+		if err != nil {
+			return fmt.Errorf("loading rules: %w", err)
+		}
+
+		for _, chk := range rules {
+			checks = append(checks, chk)
+		}
+	}
+
+	for _, chk := range checks {
+		if err := c.registry.Register(chk); err != nil {
+			return fmt.Errorf("registering custom check: %w", err)
+		}
+	}
+
+	return nil
 }
 
 // parseStdinConfig reads and applies configuration from stdin.
@@ -262,6 +654,36 @@ func (c *Command) Complete() error {
 		return nil
 	}
 
+	// Apply the config file first so stdin values and explicit flags override it
+	if c.ConfigFile != "" {
+		cfg, err := LoadConfigFile(c.ConfigFile)
+		if err == nil {
+			err = c.applyConfigFile(cfg)
+		}
+
+		if err != nil {
+			//nolint:wrapcheck // NewExitCodeError is a same-module constructor
+			return clierrors.NewExitCodeError(clierrors.ExitValidation, err)
+		}
+	}
+
+	if c.CompatMatrixFile != "" {
+		matrix, err := compat.Load(c.CompatMatrixFile)
+		if err != nil {
+			//nolint:wrapcheck // NewExitCodeError is a same-module constructor
+			return clierrors.NewExitCodeError(clierrors.ExitValidation, err)
+		}
+
+		c.compatMatrix = matrix
+	}
+
+	if c.CustomChecks != "" || c.RulesFile != "" {
+		if err := c.registerCustomChecks(); err != nil {
+			//nolint:wrapcheck // NewExitCodeError is a same-module constructor
+			return clierrors.NewExitCodeError(clierrors.ExitValidation, err)
+		}
+	}
+
 	// Parse stdin configuration if --from-stdin is specified
 	if c.FromStdin {
 		if err := c.parseStdinConfig(); err != nil {
@@ -270,31 +692,103 @@ func (c *Command) Complete() error {
 		}
 	}
 
+	// Explicit --severity-override entries win over config file overrides for the same check
+	if len(c.SeverityOverrideFlags) > 0 {
+		overrides, err := parseSeverityOverrides(c.SeverityOverrideFlags)
+		if err != nil {
+			//nolint:wrapcheck // NewExitCodeError is a same-module constructor
+			return clierrors.NewExitCodeError(clierrors.ExitValidation, err)
+		}
+
+		merged := make(map[string]resultpkg.Impact, len(c.SeverityOverrides)+len(overrides))
+		maps.Copy(merged, c.SeverityOverrides)
+		maps.Copy(merged, overrides)
+		c.SeverityOverrides = merged
+	}
+
+	c.registryMirrors = nil
+
+	for _, entry := range c.RegistryMirrors {
+		m, err := mirror.Parse(entry)
+		if err != nil {
+			//nolint:wrapcheck // NewExitCodeError is a same-module constructor
+			return clierrors.NewExitCodeError(clierrors.ExitValidation, err)
+		}
+
+		c.registryMirrors = append(c.registryMirrors, m)
+	}
+
 	// Validate mutual exclusivity of verbose and quiet
 	if c.Verbose && c.Quiet {
 		return errors.New("--verbose and --quiet are mutually exclusive")
 	}
 
-	// Complete shared options (creates client)
-	if err := c.SharedOptions.Complete(); err != nil {
-		return fmt.Errorf("completing shared options: %w", err)
+	// --debug is a shorthand for --log-level debug
+	if c.Debug {
+		c.LogLevel = logging.LevelDebug
 	}
+
+	c.LogLevel = strings.ToLower(c.LogLevel)
+	c.Debug = c.LogLevel == logging.LevelDebug
+
+	// Complete shared options (creates client); fan-out runs create a client per context
+	if !c.isFanOut() {
+		if err := c.SharedOptions.Complete(); err != nil {
+			return fmt.Errorf("completing shared options: %w", err)
+		}
+	}
+
+	if c.TraceFile != "" {
+		c.tracer = client.NewTracer()
+	}
+
+	if c.Reader != nil {
+		c.Reader = c.instrumentReader(c.Reader)
+	}
+
 	// Disable color for structured output; fatih/color handles NO_COLOR env and non-TTY detection.
-	if c.OutputFormat == OutputFormatJSON || c.OutputFormat == OutputFormatYAML {
+	switch c.OutputFormat { //nolint:exhaustive // table output keeps the configured color setting
+	case OutputFormatJSON, OutputFormatNDJSON, OutputFormatYAML, OutputFormatJUnit, OutputFormatHTML, OutputFormatMarkdown:
 		c.NoColor = true
 	}
 	color.NoColor = c.NoColor
 
-	// Wrap IO based on verbosity settings
+	printer, err := messages.NewPrinter(c.Lang)
+	if err != nil {
+		//nolint:wrapcheck // NewExitCodeError is a same-module constructor
+		return clierrors.NewExitCodeError(clierrors.ExitValidation, err)
+	}
+
+	c.printer = printer
+
+	// Wrap IO based on verbosity settings. Quiet structured output keeps only the data on
+	// stdout, so it can be piped; errors are written by the caller to its own stderr.
 	switch {
-	case c.Quiet:
+	case c.Quiet && c.OutputFormat.isTable():
 		c.IO = iostreams.NewFullQuietWrapper(c.IO)
+	case c.Quiet:
+		c.IO = iostreams.NewDataOnlyWrapper(c.IO)
 	case !c.Verbose && !c.Debug:
 		c.IO = iostreams.NewQuietWrapper(c.IO)
 	}
 
-	// Parse target version if provided (upgrade mode)
-	if c.TargetVersion != "" {
+	// Parse target version if provided (upgrade mode). A comma-separated list assesses each
+	// version in turn; aliases are resolved against the operator catalog once the current
+	// version is detected
+	c.targetAlias = ""
+	c.targetVersions = nil
+
+	switch {
+	case strings.Contains(c.TargetVersion, ","):
+		targetVersions, err := parseTargetVersions(c.TargetVersion)
+		if err != nil {
+			return err
+		}
+
+		c.targetVersions = targetVersions
+	case isTargetVersionAlias(c.TargetVersion):
+		c.targetAlias = c.TargetVersion
+	case c.TargetVersion != "":
 		// Use ParseTolerant to accept partial versions (e.g., "3.0" → "3.0.0")
 		targetVer, err := semver.ParseTolerant(c.TargetVersion)
 		if err != nil {
@@ -304,6 +798,16 @@ func (c *Command) Complete() error {
 	}
 	// If no target version provided, we're in lint mode (will use current version)
 
+	c.parsedCurrentVersion = nil
+
+	if c.CurrentVersion != "" {
+		currentVer, err := semver.ParseTolerant(c.CurrentVersion)
+		if err != nil {
+			return fmt.Errorf("invalid current version %q: %w", c.CurrentVersion, err)
+		}
+		c.parsedCurrentVersion = &currentVer
+	}
+
 	return nil
 }
 
@@ -325,6 +829,139 @@ func (c *Command) Validate() error {
 		return fmt.Errorf("invalid isvc-deployment-mode: %s (must be one of: all, serverless, modelmesh)", c.ISVCDeploymentMode)
 	}
 
+	if err := c.GroupBy.Validate(); err != nil {
+		return err
+	}
+
+	if err := c.GroupRender.Validate(); err != nil {
+		return err
+	}
+
+	// Commands registering only the assessment flags (plan) leave the mode unset
+	if c.ExitCodeMode != "" {
+		if err := c.ExitCodeMode.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if c.GroupBy != GroupByNone && !c.OutputFormat.isTable() &&
+		!slices.Contains([]OutputFormat{OutputFormatJSON, OutputFormatYAML}, c.OutputFormat) {
+		return fmt.Errorf("--group-by is not supported with output format %s (must be one of: table, wide, json, yaml)", c.OutputFormat)
+	}
+
+	if err := c.SortBy.Validate(); err != nil {
+		return err
+	}
+
+	if c.SortBy != SortByDefault && !c.OutputFormat.isTable() {
+		return fmt.Errorf("--sort-by is not supported with output format %s (must be one of: table, wide)", c.OutputFormat)
+	}
+
+	if err := c.SplitBy.Validate(); err != nil {
+		return err
+	}
+
+	if (c.SplitBy != SplitByNone) != (c.OutputDir != "") {
+		return errors.New("--split-by and --output-dir must be used together")
+	}
+
+	if c.SplitBy != SplitByNone && c.Watch {
+		return errors.New("--split-by cannot be used with --watch")
+	}
+
+	if c.SplitBy == SplitByOwner {
+		c.ResolveOwners = true
+	}
+
+	// NDJSON results are streamed as the checks complete, before objects can be annotated
+	if c.ResolveOwners && c.OutputFormat == OutputFormatNDJSON {
+		return errors.New("--resolve-owners is not supported with output format ndjson")
+	}
+
+	if c.DetectUsage && c.OutputFormat == OutputFormatNDJSON {
+		return errors.New("--detect-usage is not supported with output format ndjson")
+	}
+
+	if c.ValidateOutput && !slices.Contains([]OutputFormat{OutputFormatJSON, OutputFormatYAML}, c.OutputFormat) {
+		return fmt.Errorf("--validate-output is not supported with output format %s (must be one of: json, yaml)", c.OutputFormat)
+	}
+
+	if c.MaxBlocking < 0 || c.MaxAdvisory < 0 {
+		return fmt.Errorf("invalid --max-blocking %d or --max-advisory %d (must not be negative)", c.MaxBlocking, c.MaxAdvisory)
+	}
+
+	if c.CheckTimeout < 0 {
+		return fmt.Errorf("invalid check-timeout: %s (must not be negative)", c.CheckTimeout)
+	}
+
+	if _, err := labels.Parse(c.LabelSelector); err != nil {
+		return fmt.Errorf("invalid selector %q: %w", c.LabelSelector, err)
+	}
+
+	resultFilter, err := ParseResultFilter(c.Filter)
+	if err != nil {
+		return err
+	}
+
+	c.resultFilter = resultFilter
+
+	if c.Yes && !c.Fix {
+		return errors.New("--yes can only be used with --fix")
+	}
+
+	if c.Fix && c.FromDir != "" {
+		return errors.New("--fix cannot be used with --from-dir (no cluster to remediate)")
+	}
+
+	// Quiet structured output discards stderr, where the --fix prompt is written
+	if c.Fix && c.Quiet && !c.Yes {
+		return errors.New("--fix with --quiet requires --yes")
+	}
+
+	// Stdin is consumed by --from-stdin, so the --fix prompt could never be answered
+	if c.Fix && c.FromStdin && !c.Yes {
+		return errors.New("--fix with --from-stdin requires --yes")
+	}
+
+	// Lint mode does not run the checks, so there would be no findings to remediate
+	if c.Fix && c.TargetVersion == "" && c.UntilVersion == "" {
+		return errors.New("--fix requires --target-version")
+	}
+
+	if c.BaselineWrite != "" && c.BaselineCompare != "" {
+		return errors.New("--baseline-write and --baseline-compare are mutually exclusive")
+	}
+
+	if c.Record && (c.FromDir != "" || c.Watch) {
+		return errors.New("--record cannot be used with --from-dir or --watch")
+	}
+
+	if c.DryRunPermissions && (c.FromDir != "" || c.Fix || c.Watch) {
+		return errors.New("--dry-run-permissions cannot be used with --from-dir, --fix or --watch")
+	}
+
+	if c.ServiceAccountCheck && (c.FromDir != "" || c.isFanOut()) {
+		return errors.New("--service-account-check cannot be used with --from-dir, --contexts or --all-contexts")
+	}
+
+	if err := c.validateWatch(); err != nil {
+		return err
+	}
+
+	if err := c.validateMultiTarget(); err != nil {
+		return err
+	}
+
+	if err := c.validateFanOut(); err != nil {
+		return err
+	}
+
+	if c.PushGateway != "" {
+		if u, err := url.Parse(c.PushGateway); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("invalid --push-gateway URL %q (must be an http or https URL)", c.PushGateway)
+		}
+	}
+
 	return nil
 }
 
@@ -339,27 +976,142 @@ func (c *Command) Run(ctx context.Context) error {
 		return nil
 	}
 
+	closeLog, err := c.openLog()
+	if err != nil {
+		return err
+	}
+	defer closeLog()
+
+	closeAuditLog, err := c.openAuditLog()
+	if err != nil {
+		return err
+	}
+	defer closeAuditLog()
+
+	// The trace is written even when the run fails, to debug what it read
+	defer c.writeTrace()
+
+	ctx, endTelemetry, err := c.startTelemetry(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = c.run(ctx)
+	endTelemetry(err)
+
+	return err
+}
+
+// run executes the lint command once its logging and tracing are set up.
+func (c *Command) run(ctx context.Context) error {
+	if c.ServiceAccountCheck {
+		if err := c.verifyServiceAccount(ctx); err != nil {
+			return err
+		}
+	}
+
+	if c.Watch {
+		return c.runWatchMode(ctx)
+	}
+
+	if c.DryRunPermissions {
+		return c.runPermissionsDryRun(ctx)
+	}
+
+	// Each cluster of a fan-out run has its own timeout
+	if c.isFanOut() {
+		return c.runFanOut(ctx)
+	}
+
 	// Create context with timeout to prevent hanging on slow clusters
 	ctx, cancel := context.WithTimeout(ctx, c.Timeout)
 	defer cancel()
 
+	if c.FromDir != "" {
+		c.IO.Errorf("Running offline against cluster snapshot: %s", c.FromDir)
+	}
+
 	// Detect current cluster version (needed for both modes)
-	currentVersion, err := version.Detect(ctx, c.Client)
+	currentVersion, err := c.detectVersions(ctx)
+	if err != nil {
+		return err
+	}
+
+	if len(c.targetVersions) > 0 {
+		return c.runMultiTargetMode(ctx, currentVersion)
+	}
+
+	upgrade, err := c.isUpgrade(currentVersion)
 	if err != nil {
-		return fmt.Errorf("detecting cluster version: %w", err)
+		return err
+	}
+
+	if !upgrade {
+		return c.runLintMode(ctx, currentVersion)
+	}
+
+	return c.runUpgradeMode(ctx, currentVersion)
+}
+
+// detectVersions detects the current RHOAI version, unless overridden with CurrentVersion,
+// and, on a best-effort basis, the OpenShift version, and records both for output formatting.
+func (c *Command) detectVersions(ctx context.Context) (*semver.Version, error) {
+	currentVersion, source, err := c.detectCurrentVersion(ctx)
+	if err != nil {
+		return nil, err
 	}
 
 	// Store current version for output formatting
 	c.currentClusterVersion = currentVersion.String()
+	c.currentVersionSource = source
 
 	// Detect OpenShift platform version (informational, non-fatal)
-	ocpVersion, err := version.DetectOpenShiftVersion(ctx, c.Client)
+	ocpVersion, err := version.DetectOpenShiftVersion(ctx, c.Reader)
 	if err != nil {
 		c.IO.Errorf("Warning: Failed to detect OpenShift version: %v", err)
 	} else {
 		c.currentOpenShiftVersion = ocpVersion.String()
 	}
 
+	// Detect the product flavor (informational, non-fatal); checks declaring flavors apply
+	// whatever the flavor when it is unknown
+	flavor, err := version.DetectFlavor(ctx, c.Reader)
+	if err != nil {
+		c.IO.Errorf("Warning: Failed to detect product flavor: %v", err)
+	}
+
+	c.currentFlavor = flavor
+
+	if err := c.resolveTargetAlias(ctx, currentVersion); err != nil {
+		return nil, err
+	}
+
+	if err := c.resolveUntilVersion(ctx, currentVersion); err != nil {
+		return nil, err
+	}
+
+	return currentVersion, nil
+}
+
+// detectCurrentVersion returns CurrentVersion when set, else the version detected from the
+// cluster, with its source.
+func (c *Command) detectCurrentVersion(ctx context.Context) (*semver.Version, version.VersionSource, error) {
+	if c.parsedCurrentVersion != nil {
+		currentVersion := *c.parsedCurrentVersion
+
+		return &currentVersion, version.SourceManual, nil
+	}
+
+	currentVersion, source, err := version.DetectWithSource(ctx, c.Reader)
+	if err != nil {
+		return nil, "", fmt.Errorf("detecting cluster version: %w", err)
+	}
+
+	return currentVersion, source, nil
+}
+
+// isUpgrade reports whether the target version requires upgrade checks and rejects downgrades.
+func (c *Command) isUpgrade(currentVersion *semver.Version) (bool, error) {
 	// Determine effective target version (defaults to current for lint mode)
 	targetVersion := currentVersion
 	if c.parsedTargetVersion != nil {
@@ -370,26 +1122,30 @@ func (c *Command) Run(ctx context.Context) error {
 	// the downgrade guard so that e.g. --target-version 2.25 with current
 	// 2.25.2 is treated as "same version", not as a downgrade).
 	if version.SameMajorMinor(currentVersion, targetVersion) {
-		return c.runLintMode(ctx, currentVersion)
+		return false, nil
 	}
 
 	// Reject downgrades when explicit --target-version is provided
 	if targetVersion.LT(*currentVersion) {
 		//nolint:wrapcheck // NewExitCodeError is a same-module constructor, not an external error
-		return clierrors.NewExitCodeError(clierrors.ExitValidation,
+		return false, clierrors.NewExitCodeError(clierrors.ExitValidation,
 			fmt.Errorf("target version %s is older than current version %s (downgrades not supported)",
 				c.TargetVersion, currentVersion.String()))
 	}
 
-	return c.runUpgradeMode(ctx, currentVersion)
+	return true, nil
 }
 
 // configureCheckSettings applies command-level settings to specific checks.
 func (c *Command) configureCheckSettings() {
-	// Apply ISVC deployment mode filter to the KServe impacted workloads check
 	for _, chk := range c.registry.ListAll() {
-		if isvcCheck, ok := chk.(*kserveworkloads.ImpactedWorkloadsCheck); ok {
-			isvcCheck.SetDeploymentModeFilter(c.ISVCDeploymentMode)
+		switch chk := chk.(type) {
+		case *kserveworkloads.ImpactedWorkloadsCheck:
+			// Apply ISVC deployment mode filter to the KServe impacted workloads check
+			chk.SetDeploymentModeFilter(c.ISVCDeploymentMode)
+		case *notebook.ImpactedWorkloadsCheck:
+			chk.SetRegistryMirrors(c.registryMirrors)
+			chk.SetVerifyRegistry(c.VerifyRegistry)
 		}
 	}
 }
@@ -398,21 +1154,36 @@ func (c *Command) configureCheckSettings() {
 //
 //nolint:unparam // keep explicit error return value
 func (c *Command) runLintMode(_ context.Context, currentVersion *semver.Version) error {
+	// Structured output only carries results; keep the notice out of stdout pipelines
+	if !c.OutputFormat.isTable() {
+		c.IO.Errorf(msgNoChecksExecuted, version.MajorMinorLabel(currentVersion))
+
+		return nil
+	}
+
 	c.IO.Fprintln()
 	outputVersionInfo(c.IO.Out(), &VersionInfo{
 		RHOAICurrentVersion: currentVersion.String(),
 		OpenShiftVersion:    c.currentOpenShiftVersion,
+		Flavor:              c.currentFlavor,
 	})
 
 	c.IO.Fprintln()
-	c.IO.Fprintf("Current and target versions are the same (%s), no checks will be executed.",
-		version.MajorMinorLabel(currentVersion))
+	c.IO.Fprintf(msgNoChecksExecuted, version.MajorMinorLabel(currentVersion))
 
 	return nil
 }
 
-// runUpgradeMode assesses upgrade readiness for a target version.
-func (c *Command) runUpgradeMode(ctx context.Context, currentVersion *semver.Version) error {
+// executeUpgradeChecks runs the selected checks for an upgrade and applies config file
+// overrides, suppressions, the baseline and the severity filter. It returns the results to
+// display, the highest-priority execution error, and the check target used by the run.
+// Live runs show the progress display for table output and stream NDJSON output as the
+// checks complete.
+func (c *Command) executeUpgradeChecks(
+	ctx context.Context,
+	currentVersion *semver.Version,
+	live bool,
+) ([]check.CheckExecution, execErrorSummary, check.Target, error) {
 	c.IO.Errorf("Assessing upgrade readiness: %s → %s\n", currentVersion.String(), c.TargetVersion)
 
 	// Configure check-specific settings
@@ -422,7 +1193,7 @@ func (c *Command) runUpgradeMode(ctx context.Context, currentVersion *semver.Ver
 	if !isDefaultSelector(c.CheckSelectors) {
 		matched, err := c.registry.MatchesAnyCheck(c.CheckSelectors)
 		if err != nil {
-			return fmt.Errorf("validating check selectors: %w", err)
+			return nil, execErrorSummary{}, check.Target{}, fmt.Errorf("validating check selectors: %w", err)
 		}
 
 		if !matched {
@@ -431,7 +1202,8 @@ func (c *Command) runUpgradeMode(ctx context.Context, currentVersion *semver.Ver
 				noun = "selectors"
 			}
 
-			return fmt.Errorf("no registered checks match %s: %v\n\nAvailable check IDs:\n  %s",
+			return nil, execErrorSummary{}, check.Target{}, fmt.Errorf(
+				"no registered checks match %s: %v\n\nAvailable check IDs:\n  %s",
 				noun, c.CheckSelectors, strings.Join(c.registry.AllCheckIDs(), "\n  "))
 		}
 	}
@@ -439,15 +1211,75 @@ func (c *Command) runUpgradeMode(ctx context.Context, currentVersion *semver.Ver
 	// Execute checks using target version for applicability filtering
 	c.IO.Errorf("Running upgrade compatibility checks...")
 	executor := check.NewExecutor(c.registry, c.IO)
+	executor.SetSeverityOverrides(c.SeverityOverrides)
+	executor.SetCheckTimeout(c.CheckTimeout)
+	executor.SetIncludeNotApplicable(c.ShowSkipped)
+
+	// Checks needing permissions the user lacks are skipped and reported by a single result
+	permissionsExec := c.preflightPermissions(ctx, executor)
+
+	// Create check target with BOTH current and target versions for upgrade checks.
+	// Reads are cached for the run so resources shared between checks are listed once,
+	// unless --low-memory asks not to hold them.
+	reader := c.Reader
+	if !c.LowMemory {
+		reader = client.NewCachingReader(reader)
+	}
 
-	// Create check target with BOTH current and target versions for upgrade checks
 	checkTarget := check.Target{
-		Client:         c.Client,
+		Client:         reader,
 		CurrentVersion: currentVersion,        // The version we're upgrading FROM
 		TargetVersion:  c.parsedTargetVersion, // The version we're upgrading TO
+		Flavor:         c.currentFlavor,
 		Resource:       nil,
+		LabelSelector:  c.LabelSelector,
+		Deep:           c.Deep,
+		LowMemory:      c.LowMemory,
+		CompatMatrix:   c.compatMatrix,
+		Messages:       c.printer,
 		IO:             c.IO,
 		Debug:          c.Debug,
+		Logger:         c.logger,
+	}
+
+	// Live progress for interactive table output; cleared before the results are rendered
+	var (
+		progress *checkProgress
+		stream   *resultStream
+	)
+
+	if live {
+		progress = c.newCheckProgress(c.countSelectedChecks())
+
+		var err error
+		if stream, err = c.newResultStream(); err != nil {
+			return nil, execErrorSummary{}, check.Target{}, err
+		}
+	}
+
+	var observers []func(check.CheckExecution)
+
+	switch {
+	case progress != nil:
+		observers = append(observers, progress.observe)
+	case stream != nil:
+		if permissionsExec != nil {
+			stream.observe(*permissionsExec)
+		}
+
+		observers = append(observers, stream.observe)
+	}
+
+	if audit := c.audit.observer(checkTarget); audit != nil {
+		observers = append(observers, audit)
+	}
+
+	if len(observers) > 0 {
+		executor.SetProgressFunc(func(exec check.CheckExecution) {
+			for _, observe := range observers {
+				observe(exec)
+			}
+		})
 	}
 
 	// Execute checks in canonical order: dependencies → services → platform → components → workloads
@@ -456,83 +1288,238 @@ func (c *Command) runUpgradeMode(ctx context.Context, currentVersion *semver.Ver
 	for _, group := range check.CanonicalGroupOrder {
 		results, err := executor.ExecuteSelective(ctx, checkTarget, c.CheckSelectors, group)
 		if err != nil {
-			return fmt.Errorf("executing %s checks: %w", group, err)
+			progress.stop()
+
+			return nil, execErrorSummary{}, check.Target{}, fmt.Errorf("executing %s checks: %w", group, err)
 		}
 
 		resultsByGroup[group] = results
 	}
 
+	progress.stop()
+
+	if err := stream.Err(); err != nil {
+		return nil, execErrorSummary{}, check.Target{}, err
+	}
+
 	// Flatten results and compute the highest-priority exit code from execution
 	// errors BEFORE filtering, so failures with Result == nil are not dropped.
 	flatResults := FlattenResults(resultsByGroup)
 	execSummary := highestPriorityExecError(flatResults)
+	execSummary.stats = executor.Stats()
+
+	if execSummary.stats.NotRun > 0 {
+		execSummary.interrupted = ctx.Err()
+	}
+
+	if permissionsExec != nil {
+		flatResults = append([]check.CheckExecution{*permissionsExec}, flatResults...)
+	}
 
 	// Strip nil results and apply severity filter for display/verdict
 	flatResults = slices.DeleteFunc(flatResults, func(exec check.CheckExecution) bool {
 		return exec.Result == nil
 	})
+
+	// Suppressions and severity overrides (applied by the executor) take effect before the
+	// severity filter so an overridden impact is filtered at its new level
+	flatResults, suppressedCount, err := c.applySuppressions(flatResults)
+	if err != nil {
+		return nil, execErrorSummary{}, check.Target{}, err
+	}
+
+	if suppressedCount > 0 {
+		c.IO.Errorf("Suppressed results of %d check(s) per config file", suppressedCount)
+	}
+
+	// Baselines record and compare all findings, independent of the --severity display filter
+	flatResults, err = c.applyBaseline(flatResults)
+	if err != nil {
+		return nil, execErrorSummary{}, check.Target{}, err
+	}
+
 	flatResults = FilterBySeverity(flatResults, c.SeverityLevel)
+	flatResults = FilterByExpression(flatResults, c.resultFilter)
+
+	// Only displayed objects are annotated, as resolving owners and usage costs reads per object
+	if c.ResolveOwners {
+		check.ResolveOwners(ctx, checkTarget.Client, flatResults, c.ownerResolvers)
+	}
+
+	if c.DetectUsage {
+		check.DetectUsage(ctx, checkTarget.Client, flatResults, c.usageDetectors)
+	}
+
+	check.AnnotateScores(flatResults)
+	check.AnnotateRemediationCommands(ctx, checkTarget, flatResults)
 
-	// Format and output results
-	if err := c.formatAndOutputUpgradeResults(ctx, currentVersion.String(), flatResults); err != nil {
+	return flatResults, execSummary, checkTarget, nil
+}
+
+// runUpgradeMode assesses upgrade readiness for a target version.
+func (c *Command) runUpgradeMode(ctx context.Context, currentVersion *semver.Version) error {
+	flatResults, execSummary, checkTarget, err := c.executeUpgradeChecks(ctx, currentVersion, true)
+	if err != nil {
 		return err
 	}
 
+	// Format and output results; NDJSON results were already streamed as the checks completed
+	if c.OutputFormat != OutputFormatNDJSON {
+		if err := c.formatAndOutputUpgradeResults(ctx, flatResults); err != nil {
+			return err
+		}
+	}
+
+	if err := c.writeSplitReports(ctx, flatResults); err != nil {
+		return fmt.Errorf("writing split reports: %w", err)
+	}
+
+	if err := c.publishMetrics(ctx, flatResults); err != nil {
+		return fmt.Errorf("publishing metrics: %w", err)
+	}
+
+	if err := c.recordReport(ctx, flatResults); err != nil {
+		return fmt.Errorf("recording report: %w", err)
+	}
+
+	// Only displayed findings are remediated, so --severity also scopes --fix
+	if c.Fix {
+		if err := runRemediations(ctx, c.IO, c.Client, checkTarget, flatResults, c.Yes); err != nil {
+			return fmt.Errorf("applying remediations: %w", err)
+		}
+	}
+
 	// Print verdict and determine exit code from findings
 	findingsErr := c.evaluateVerdict(flatResults)
+	c.printExecErrorSummary(execSummary)
+
+	if c.ExitCodeMode == ExitCodeModeOutcome {
+		return resolveOutcomeExitError(execSummary, findingsErr, c.OutputFormat)
+	}
 
 	return resolveExitError(execSummary, findingsErr, c.OutputFormat)
 }
 
-// evaluateVerdict prints a prominent result verdict for table output and returns
-// an error carrying the appropriate ExitCode when fail-on conditions are met.
-func (c *Command) evaluateVerdict(results []check.CheckExecution) error {
-	var hasProhibited, hasBlocking, hasAdvisory bool
+// findingCounts counts the conditions of a run by impact.
+type findingCounts struct {
+	prohibited int
+	blocking   int
+	advisory   int
+}
+
+func countFindings(results []check.CheckExecution) findingCounts {
+	var counts findingCounts
 
 	for _, exec := range results {
 		if exec.Result == nil {
 			continue
 		}
 
-		switch exec.Result.GetImpact() {
-		case resultpkg.ImpactProhibited:
-			hasProhibited = true
-		case resultpkg.ImpactBlocking:
-			hasBlocking = true
-		case resultpkg.ImpactAdvisory:
-			hasAdvisory = true
-		case resultpkg.ImpactNone:
-			// No impact on exit code
+		for _, cond := range exec.Result.Status.Conditions {
+			switch cond.Impact {
+			case resultpkg.ImpactProhibited:
+				counts.prohibited++
+			case resultpkg.ImpactBlocking:
+				counts.blocking++
+			case resultpkg.ImpactAdvisory:
+				counts.advisory++
+			case resultpkg.ImpactNone:
+				// No impact on exit code
+			}
 		}
 	}
 
-	if c.OutputFormat == OutputFormatTable {
-		printVerdict(c.IO.Out(), hasProhibited, hasBlocking, hasAdvisory)
+	return counts
+}
+
+// verdict is the outcome of a run against the --max-blocking and --max-advisory thresholds.
+type verdict struct {
+	findingCounts
+
+	maxBlocking int
+	maxAdvisory int
+}
+
+func (v verdict) blockingExceeded() bool { return v.blocking > v.maxBlocking }
+func (v verdict) advisoryExceeded() bool { return v.advisory > v.maxAdvisory }
+
+// evaluateVerdict prints a prominent result verdict for table output and returns
+// an error carrying the appropriate ExitCode when fail-on conditions are met.
+// Blocking and advisory findings within the --max-blocking and --max-advisory
+// thresholds do not fail the run.
+func (c *Command) evaluateVerdict(results []check.CheckExecution) error {
+	v := verdict{
+		findingCounts: countFindings(results),
+		maxBlocking:   c.MaxBlocking,
+		maxAdvisory:   c.MaxAdvisory,
+	}
+
+	if c.OutputFormat.isTable() {
+		printVerdict(c.IO.Out(), v)
 	}
 
-	if hasProhibited || hasBlocking {
+	switch {
+	case v.prohibited > 0:
 		//nolint:wrapcheck // NewExitCodeError is a same-module constructor
 		return clierrors.NewExitCodeError(
 			clierrors.ExitError,
 			fmt.Errorf("%w: %s", clierrors.ErrLintBlocked, msgProhibitedOrBlocking),
 		)
-	}
+	case v.blockingExceeded():
+		msg := msgProhibitedOrBlocking
+		if v.maxBlocking > 0 {
+			msg = fmt.Sprintf(msgBlockingThreshold, v.blocking, v.maxBlocking)
+		}
+
+		//nolint:wrapcheck // NewExitCodeError is a same-module constructor
+		return clierrors.NewExitCodeError(
+			clierrors.ExitError,
+			fmt.Errorf("%w: %s", clierrors.ErrLintBlocked, msg),
+		)
+	case v.advisoryExceeded():
+		msg := msgAdvisoryFindings
+		if v.maxAdvisory > 0 {
+			msg = fmt.Sprintf(msgAdvisoryThreshold, v.advisory, v.maxAdvisory)
+		}
 
-	if hasAdvisory {
 		//nolint:wrapcheck // NewExitCodeError is a same-module constructor
 		return clierrors.NewExitCodeError(
 			clierrors.ExitWarning,
-			fmt.Errorf("%w: %s", clierrors.ErrLintAdvisory, msgAdvisoryFindings),
+			fmt.Errorf("%w: %s", clierrors.ErrLintAdvisory, msg),
 		)
 	}
 
 	return nil
 }
 
+// printExecErrorSummary lists the checks that failed to execute. The run continues past
+// them and reports each with an Unknown condition, so the summary makes clear which
+// results are missing.
+func (c *Command) printExecErrorSummary(execSummary execErrorSummary) {
+	if len(execSummary.failures) == 0 {
+		return
+	}
+
+	_, _ = fmt.Fprintf(c.IO.ErrOut(), "\n"+msgExecErrorSummary+"\n", len(execSummary.failures))
+
+	for _, exec := range execSummary.failures {
+		_, _ = fmt.Fprintf(c.IO.ErrOut(), "  - %s: %v\n", exec.Check.ID(), exec.Error)
+	}
+}
+
 // execErrorSummary holds the highest-priority execution error info.
 type execErrorSummary struct {
 	exitCode clierrors.ExitCode
 	err      error
+
+	// stats counts completed, failed and unevaluated checks, independent of their findings
+	stats check.ExecutionStats
+
+	// interrupted is the context error that stopped the run before all checks ran
+	interrupted error
+
+	// failures lists the executions of the checks that failed to run
+	failures []check.CheckExecution
 }
 
 // highestPriorityExecError scans check executions for infrastructure errors
@@ -542,6 +1529,8 @@ func highestPriorityExecError(results []check.CheckExecution) execErrorSummary {
 
 	for _, exec := range results {
 		if exec.Error != nil {
+			summary.failures = append(summary.failures, exec)
+
 			code := clierrors.ExitCodeFromError(exec.Error)
 			if clierrors.IsHigherPriority(code, summary.exitCode) {
 				summary.exitCode = code
@@ -579,7 +1568,7 @@ func resolveExitError(execSummary execErrorSummary, findingsErr error, outputFor
 	}
 
 	if findingsErr != nil {
-		if outputFormat == OutputFormatTable {
+		if outputFormat.isTable() {
 			return clierrors.NewAlreadyHandledError(findingsErr) //nolint:wrapcheck // wrapping is done by NewAlreadyHandledError
 		}
 
@@ -598,55 +1587,148 @@ func (c *Command) openShiftVersionPtr() *string {
 	return &c.currentOpenShiftVersion
 }
 
+// resultListAnnotations returns the annotations describing the run on structured reports, or
+// nil when there are none.
+func (c *Command) resultListAnnotations() map[string]string {
+	annotations := make(map[string]string)
+
+	if c.currentVersionSource != "" {
+		annotations[resultpkg.AnnotationClusterVersionSource] = c.currentVersionSource.String()
+	}
+
+	if c.currentFlavor != version.FlavorUnknown {
+		annotations[resultpkg.AnnotationProductFlavor] = c.currentFlavor.String()
+	}
+
+	if len(annotations) == 0 {
+		return nil
+	}
+
+	return annotations
+}
+
 // formatAndOutputUpgradeResults formats upgrade assessment results.
-func (c *Command) formatAndOutputUpgradeResults(
-	ctx context.Context,
-	currentVer string,
-	results []check.CheckExecution,
-) error {
+func (c *Command) formatAndOutputUpgradeResults(ctx context.Context, results []check.CheckExecution) error {
+	return c.writeUpgradeResults(ctx, c.IO.Out(), results)
+}
+
+// writeUpgradeResults renders upgrade assessment results to out in the selected output format.
+func (c *Command) writeUpgradeResults(ctx context.Context, out io.Writer, results []check.CheckExecution) error {
 	clusterVer := &c.currentClusterVersion
 	targetVer := &c.TargetVersion
 	ocpVer := c.openShiftVersionPtr()
 
+	var namespaces []resultpkg.NamespaceSummary
+	if c.GroupBy == GroupByNamespace && !c.OutputFormat.isTable() {
+		namespaces = SummarizeByNamespace(results, collectNamespaceRequesters(ctx, c.Reader, results))
+	}
+
 	switch c.OutputFormat {
-	case OutputFormatTable:
-		return c.outputUpgradeTable(ctx, currentVer, results)
+	case OutputFormatTable, OutputFormatWide:
+		return c.outputUpgradeTable(ctx, out, results)
 	case OutputFormatJSON:
-		if err := OutputJSON(c.IO.Out(), results, clusterVer, targetVer, ocpVer); err != nil {
+		if err := c.writeValidated(out, func(w io.Writer) error {
+			return OutputJSON(w, results, c.printer, clusterVer, targetVer, ocpVer, namespaces, c.resultListAnnotations())
+		}); err != nil {
 			return fmt.Errorf("outputting JSON: %w", err)
 		}
 
 		return nil
 	case OutputFormatYAML:
-		if err := OutputYAML(c.IO.Out(), results, clusterVer, targetVer, ocpVer); err != nil {
+		if err := c.writeValidated(out, func(w io.Writer) error {
+			return OutputYAML(w, results, c.printer, clusterVer, targetVer, ocpVer, namespaces, c.resultListAnnotations())
+		}); err != nil {
 			return fmt.Errorf("outputting YAML: %w", err)
 		}
 
+		return nil
+	case OutputFormatJUnit:
+		if err := OutputJUnit(out, results, clusterVer, targetVer, ocpVer); err != nil {
+			return fmt.Errorf("outputting JUnit: %w", err)
+		}
+
+		return nil
+	case OutputFormatHTML:
+		if err := OutputHTML(out, results, clusterVer, targetVer, ocpVer); err != nil {
+			return fmt.Errorf("outputting HTML: %w", err)
+		}
+
+		return nil
+	case OutputFormatNDJSON:
+		if err := OutputNDJSON(out, results); err != nil {
+			return fmt.Errorf("outputting NDJSON: %w", err)
+		}
+
+		return nil
+	case OutputFormatMarkdown:
+		if err := OutputMarkdown(out, results, clusterVer, targetVer, ocpVer); err != nil {
+			return fmt.Errorf("outputting Markdown: %w", err)
+		}
+
 		return nil
 	default:
 		return fmt.Errorf("unsupported output format: %s", c.OutputFormat)
 	}
 }
 
+// writeValidated renders structured output with render and writes it to out. With
+// --validate-output the rendered document is first checked against the published
+// DiagnosticResultList schema, and nothing is written when it does not conform.
+func (c *Command) writeValidated(out io.Writer, render func(io.Writer) error) error {
+	if !c.ValidateOutput {
+		return render(out)
+	}
+
+	var buf bytes.Buffer
+	if err := render(&buf); err != nil {
+		return err
+	}
+
+	// YAML is a superset of JSON, so both formats validate through their JSON form
+	document, err := yaml.YAMLToJSON(buf.Bytes())
+	if err != nil {
+		return fmt.Errorf("converting output for validation: %w", err)
+	}
+
+	if err := schema.Validate(schema.SchemaDiagnosticResultList, document); err != nil {
+		return fmt.Errorf("validating output: %w", err)
+	}
+
+	if _, err := out.Write(buf.Bytes()); err != nil {
+		return fmt.Errorf("writing output: %w", err)
+	}
+
+	return nil
+}
+
 // outputUpgradeTable outputs upgrade results in table format with header.
-func (c *Command) outputUpgradeTable(ctx context.Context, _ string, results []check.CheckExecution) error {
-	c.IO.Fprintln()
+func (c *Command) outputUpgradeTable(ctx context.Context, out io.Writer, results []check.CheckExecution) error {
+	_, _ = fmt.Fprintln(out)
 
 	opts := TableOutputOptions{
 		ShowImpactedObjects: c.Verbose,
+		GroupBy:             c.GroupBy,
+		SortBy:              c.SortBy,
+		Wide:                c.OutputFormat == OutputFormatWide,
+		Messages:            c.printer,
 		VersionInfo: &VersionInfo{
 			RHOAICurrentVersion: c.currentClusterVersion,
 			RHOAITargetVersion:  c.TargetVersion,
 			OpenShiftVersion:    c.currentOpenShiftVersion,
+			Flavor:              c.currentFlavor,
 		},
 	}
 
-	if c.Verbose {
-		opts.NamespaceRequesters = collectNamespaceRequesters(ctx, c.Client, results)
+	if c.GroupRender == GroupRenderOn {
+		opts.GroupRenderers = c.groupRenderers
+	}
+
+	if c.Verbose || c.GroupBy == GroupByNamespace {
+		opts.NamespaceRequesters = collectNamespaceRequesters(ctx, c.Reader, results)
 	}
 
 	// Reuse the lint table output logic
-	if err := OutputTable(c.IO.Out(), results, opts); err != nil {
+	if err := OutputTable(out, results, opts); err != nil {
 		return fmt.Errorf("outputting table: %w", err)
 	}
 
@@ -688,7 +1770,7 @@ func collectNamespaceRequesters(
 			continue
 		}
 
-		if requester, ok := meta.Annotations["openshift.io/requester"]; ok {
+		if requester, ok := meta.Annotations[check.AnnotationNamespaceRequester]; ok {
 			requesters[ns] = requester
 		}
 	}
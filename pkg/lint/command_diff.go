@@ -0,0 +1,115 @@
+package lint
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+
+	"github.com/spf13/pflag"
+	"sigs.k8s.io/yaml"
+
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/opendatahub-io/odh-cli/pkg/api"
+	"github.com/opendatahub-io/odh-cli/pkg/cmd"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/util/iostreams"
+)
+
+var _ cmd.Command = (*DiffCommand)(nil)
+
+// DiffCommand compares two lint reports saved with `lint -o json` or `lint -o yaml`. It never
+// contacts a cluster.
+type DiffCommand struct {
+	IO iostreams.Interface
+
+	// OutputFormat specifies the output format (table, json, yaml)
+	OutputFormat OutputFormat
+
+	// OldFile and NewFile are the paths of the reports to compare.
+	OldFile string
+	NewFile string
+}
+
+// NewDiffCommand creates a new DiffCommand with defaults.
+func NewDiffCommand(streams genericiooptions.IOStreams) *DiffCommand {
+	return &DiffCommand{
+		IO:           iostreams.NewIOStreams(streams.In, streams.Out, streams.ErrOut),
+		OutputFormat: OutputFormatTable,
+	}
+}
+
+// AddFlags registers command-specific flags with the provided FlagSet.
+func (c *DiffCommand) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVarP((*string)(&c.OutputFormat), "output", "o", string(OutputFormatTable), flagDescDiffOutput)
+	_ = fs.SetAnnotation("output", api.AnnotationValidValues, []string{"table", "json", "yaml"})
+}
+
+// Complete performs no setup; the reports are read in Run.
+func (c *DiffCommand) Complete() error {
+	return nil
+}
+
+// Validate checks the output format and that both reports are given.
+func (c *DiffCommand) Validate() error {
+	if !slices.Contains([]OutputFormat{OutputFormatTable, OutputFormatJSON, OutputFormatYAML}, c.OutputFormat) {
+		return fmt.Errorf("invalid output format: %s (must be one of: table, json, yaml)", c.OutputFormat)
+	}
+
+	if c.OldFile == "" || c.NewFile == "" {
+		return errors.New("two report files are required")
+	}
+
+	return nil
+}
+
+// Run loads both reports and renders the changes between them.
+func (c *DiffCommand) Run(_ context.Context) error {
+	oldList, err := LoadReportFile(c.OldFile)
+	if err != nil {
+		return err
+	}
+
+	newList, err := LoadReportFile(c.NewFile)
+	if err != nil {
+		return err
+	}
+
+	comparison := CompareReports("lint diff",
+		NewReportFileSummary(c.OldFile, oldList), NewReportFileSummary(c.NewFile, newList), oldList, newList)
+
+	if c.OutputFormat != OutputFormatTable {
+		return renderStructured(c.IO.Out(), c.OutputFormat, comparison)
+	}
+
+	return writeReportComparison(c.IO.Out(), comparison)
+}
+
+// LoadReportFile reads a lint report written with `lint -o json` or `lint -o yaml`.
+func LoadReportFile(path string) (*result.DiagnosticResultList, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading report: %w", err)
+	}
+
+	// YAML is a superset of JSON, so both formats are converted to JSON first
+	data, err = yaml.YAMLToJSON(data)
+	if err != nil {
+		return nil, fmt.Errorf("parsing report %s: %w", path, err)
+	}
+
+	var list result.DiagnosticResultList
+	if err := json.Unmarshal(data, &list); err != nil {
+		return nil, fmt.Errorf("parsing report %s: %w", path, err)
+	}
+
+	if list.Kind != result.DiagnosticResultListKind {
+		return nil, fmt.Errorf("parsing report %s: unexpected kind %q (expected %s)",
+			path, list.Kind, result.DiagnosticResultListKind)
+	}
+
+	return &list, nil
+}
@@ -0,0 +1,108 @@
+package lint_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"sigs.k8s.io/yaml"
+
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+
+	. "github.com/onsi/gomega"
+)
+
+func writeReportFile(t *testing.T, name string, list *result.DiagnosticResultList, marshal func(any) ([]byte, error)) string {
+	t.Helper()
+
+	data, err := marshal(list)
+	NewWithT(t).Expect(err).ToNot(HaveOccurred())
+
+	path := filepath.Join(t.TempDir(), name)
+	NewWithT(t).Expect(os.WriteFile(path, data, 0o600)).To(Succeed())
+
+	return path
+}
+
+func newDiffCommand(t *testing.T, out *bytes.Buffer) *lint.DiffCommand {
+	t.Helper()
+
+	checks := []string{"a", "b", "c"}
+
+	command := lint.NewDiffCommand(genericiooptions.IOStreams{Out: out, ErrOut: &bytes.Buffer{}})
+	command.OldFile = writeReportFile(t, "old.json", newReportList("2026-10-01T08:00:00Z", checks,
+		map[string][]string{"a": {"nb1", "nb2"}, "c": {"nb3"}}), json.Marshal)
+	command.NewFile = writeReportFile(t, "new.yaml", newReportList("2026-10-08T08:00:00Z", checks,
+		map[string][]string{"a": {"nb2", "nb4"}, "b": nil}), yaml.Marshal)
+
+	return command
+}
+
+func TestDiffCommand(t *testing.T) {
+	t.Run("should report changes with impacted object deltas", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var out bytes.Buffer
+
+		command := newDiffCommand(t, &out)
+		g.Expect(command.Validate()).To(Succeed())
+		g.Expect(command.Run(t.Context())).To(Succeed())
+
+		g.Expect(out.String()).To(ContainSubstring("3 change(s)"))
+		g.Expect(out.String()).To(ContainSubstring(
+			"  ~ workload/notebook/a: blocking, 1 new / 1 resolved finding(s), impacted objects 2 → 2\n" +
+				"      + Notebook ns/nb4\n" +
+				"      - Notebook ns/nb1\n"))
+		g.Expect(out.String()).To(ContainSubstring("  + workload/notebook/b: newly failing (blocking, 1 finding(s), 0 impacted object(s))"))
+		g.Expect(out.String()).To(ContainSubstring("  - workload/notebook/c: resolved (was blocking, 1 finding(s) resolved)\n" +
+			"      - Notebook ns/nb3\n"))
+	})
+
+	t.Run("should render the comparison as JSON", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var out bytes.Buffer
+
+		command := newDiffCommand(t, &out)
+		command.OutputFormat = lint.OutputFormatJSON
+		g.Expect(command.Run(t.Context())).To(Succeed())
+
+		var comparison lint.ReportComparison
+		g.Expect(json.Unmarshal(out.Bytes(), &comparison)).To(Succeed())
+		g.Expect(comparison.Kind).To(Equal("LintReportComparison"))
+		g.Expect(comparison.From).To(And(HaveField("GeneratedAt", "2026-10-01T08:00:00Z"), HaveField("Errors", 2)))
+		g.Expect(comparison.Changes).To(HaveLen(3))
+		g.Expect(comparison.ObjectDeltas).To(ConsistOf(
+			lint.ImpactedObjectDelta{CheckID: "workload/notebook/a", Added: []string{"Notebook ns/nb4"}, Removed: []string{"Notebook ns/nb1"}},
+			lint.ImpactedObjectDelta{CheckID: "workload/notebook/c", Removed: []string{"Notebook ns/nb3"}},
+		))
+	})
+
+	t.Run("should report no changes between identical reports", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var out bytes.Buffer
+
+		command := newDiffCommand(t, &out)
+		command.NewFile = command.OldFile
+		g.Expect(command.Run(t.Context())).To(Succeed())
+		g.Expect(out.String()).To(HaveSuffix(": no changes\n"))
+	})
+
+	t.Run("should reject files that are not lint reports", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var out bytes.Buffer
+
+		command := newDiffCommand(t, &out)
+		command.NewFile = filepath.Join(t.TempDir(), "baseline.json")
+		g.Expect(os.WriteFile(command.NewFile, []byte(`{"kind":"LintBaseline"}`), 0o600)).To(Succeed())
+
+		g.Expect(command.Run(t.Context())).To(MatchError(ContainSubstring(`unexpected kind "LintBaseline"`)))
+	})
+}
@@ -0,0 +1,329 @@
+package lint
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"time"
+
+	"github.com/spf13/pflag"
+	"sigs.k8s.io/yaml"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/opendatahub-io/odh-cli/pkg/cmd"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+	"github.com/opendatahub-io/odh-cli/pkg/util/iostreams"
+)
+
+var _ cmd.Command = (*ExportSnapshotCommand)(nil)
+
+const (
+	// DefaultSnapshotFile is the default archive written by export-snapshot.
+	DefaultSnapshotFile = "odh-lint-snapshot.tar.gz"
+
+	snapshotFilePermission = 0o644
+
+	// redactedSecretValue replaces the values of exported Secrets; redactedSecretData is its
+	// base64 encoding, used for .data so the redacted Secret remains valid.
+	redactedSecretValue = "REDACTED"
+	redactedSecretData  = "UkVEQUNURUQ="
+
+	msgSnapshotCollecting = "Collecting %d resource type(s) required by %d check(s)..."
+	msgSnapshotSkipped    = "  %s: not served by the cluster, skipping"
+	msgSnapshotCollected  = "  %s: %d object(s)"
+	msgSnapshotWarning    = "Warning: listing %s: %v"
+	msgSnapshotWritten    = "Snapshot with %d object(s) written to %s"
+)
+
+// ExportSnapshotCommand collects the resources required by the selected lint checks into a
+// gzipped tarball that can be passed to `lint --from-dir` for offline assessment.
+type ExportSnapshotCommand struct {
+	*SharedOptions
+
+	// OutputFile is the path of the tarball to write.
+	OutputFile string
+
+	registry *check.CheckRegistry
+}
+
+// NewExportSnapshotCommand creates a new ExportSnapshotCommand with defaults.
+func NewExportSnapshotCommand(
+	streams genericiooptions.IOStreams,
+	configFlags *genericclioptions.ConfigFlags,
+) *ExportSnapshotCommand {
+	return &ExportSnapshotCommand{
+		SharedOptions: NewSharedOptions(streams, configFlags),
+		OutputFile:    DefaultSnapshotFile,
+		registry:      newDefaultRegistry(),
+	}
+}
+
+// AddFlags registers command-specific flags with the provided FlagSet.
+func (c *ExportSnapshotCommand) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVarP(&c.OutputFile, "output-file", "f", c.OutputFile, flagDescSnapshotOutputFile)
+	fs.StringArrayVar(&c.CheckSelectors, "checks", []string{"*"}, flagDescChecks)
+	fs.BoolVarP(&c.Verbose, "verbose", "v", false, flagDescVerbose)
+	fs.DurationVar(&c.Timeout, "timeout", c.Timeout, flagDescTimeout)
+	fs.Float32Var(&c.QPS, "qps", c.QPS, flagDescQPS)
+	fs.IntVar(&c.Burst, "burst", c.Burst, flagDescBurst)
+	fs.Int64Var(&c.PageSize, "page-size", c.PageSize, flagDescPageSize)
+}
+
+// Complete creates the client and configures output.
+func (c *ExportSnapshotCommand) Complete() error {
+	if err := c.SharedOptions.Complete(); err != nil {
+		return fmt.Errorf("completing shared options: %w", err)
+	}
+
+	if !c.Verbose {
+		c.IO = iostreams.NewQuietWrapper(c.IO)
+	}
+
+	return nil
+}
+
+// Validate checks that all required options are valid.
+func (c *ExportSnapshotCommand) Validate() error {
+	if err := c.SharedOptions.Validate(); err != nil {
+		return fmt.Errorf("validating shared options: %w", err)
+	}
+
+	if c.OutputFile == "" {
+		return errors.New("--output-file is required")
+	}
+
+	return nil
+}
+
+// Run lists every resource type required by the selected checks and writes them to the tarball.
+func (c *ExportSnapshotCommand) Run(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, c.Timeout)
+	defer cancel()
+
+	required, err := c.registry.RequiredResources(c.CheckSelectors)
+	if err != nil {
+		return fmt.Errorf("resolving required resources: %w", err)
+	}
+
+	if len(required) == 0 {
+		return fmt.Errorf("no registered checks match selectors: %v", c.CheckSelectors)
+	}
+
+	groups := snapshotResourceGroups(required)
+	c.IO.Errorf(msgSnapshotCollecting, len(groups), len(required))
+
+	f, err := os.OpenFile(c.OutputFile, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, snapshotFilePermission)
+	if err != nil {
+		return fmt.Errorf("creating snapshot file: %w", err)
+	}
+	defer func() { _ = f.Close() }()
+
+	total, err := c.writeSnapshot(ctx, f, groups)
+	if err != nil {
+		return err
+	}
+
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("closing snapshot file: %w", err)
+	}
+
+	_, _ = fmt.Fprintf(c.IO.ErrOut(), msgSnapshotWritten+"\n", total, c.OutputFile)
+
+	return nil
+}
+
+// writeSnapshot writes one List document per resource into a gzipped tar stream.
+func (c *ExportSnapshotCommand) writeSnapshot(
+	ctx context.Context,
+	out io.Writer,
+	groups [][]resources.ResourceType,
+) (int, error) {
+	gz := gzip.NewWriter(out)
+	tw := tar.NewWriter(gz)
+	now := time.Now()
+	total := 0
+
+	for _, versions := range groups {
+		items, found := c.listFirstServed(ctx, versions)
+		if !found {
+			continue
+		}
+
+		data, err := marshalSnapshotList(items)
+		if err != nil {
+			return 0, fmt.Errorf("marshaling %s: %w", versions[0].Kind, err)
+		}
+
+		if err := tw.WriteHeader(&tar.Header{
+			Name:     snapshotEntryName(versions[0]),
+			Mode:     snapshotFilePermission,
+			Size:     int64(len(data)),
+			ModTime:  now,
+			Typeflag: tar.TypeReg,
+		}); err != nil {
+			return 0, fmt.Errorf("writing archive header: %w", err)
+		}
+
+		if _, err := tw.Write(data); err != nil {
+			return 0, fmt.Errorf("writing archive entry: %w", err)
+		}
+
+		total += len(items)
+	}
+
+	if err := tw.Close(); err != nil {
+		return 0, fmt.Errorf("finalizing archive: %w", err)
+	}
+
+	if err := gz.Close(); err != nil {
+		return 0, fmt.Errorf("finalizing gzip stream: %w", err)
+	}
+
+	return total, nil
+}
+
+// listFirstServed lists the resource using the first API version served by the cluster.
+// Listing failures other than an unserved API are reported as warnings so one inaccessible
+// resource type does not prevent the remaining state from being exported.
+func (c *ExportSnapshotCommand) listFirstServed(
+	ctx context.Context,
+	versions []resources.ResourceType,
+) ([]*unstructured.Unstructured, bool) {
+	for _, rt := range versions {
+		items, err := c.Client.List(ctx, rt)
+
+		switch {
+		case err == nil:
+			c.IO.Errorf(msgSnapshotCollected, rt.GVR().String(), len(items))
+
+			return redactSnapshotObjects(rt, items), true
+		case client.IsResourceTypeNotFound(err):
+			continue
+		default:
+			_, _ = fmt.Fprintf(c.IO.ErrOut(), msgSnapshotWarning+"\n", rt.GVR().String(), err)
+
+			return nil, false
+		}
+	}
+
+	c.IO.Errorf(msgSnapshotSkipped, schema.GroupResource{Group: versions[0].Group, Resource: versions[0].Resource}.String())
+
+	return nil, false
+}
+
+// snapshotResourceGroups merges PlatformResources and the per-check requirements into groups of
+// API versions for the same group/resource, preserving declaration order. Checks are visited
+// in sorted ID order so the archive layout is deterministic.
+func snapshotResourceGroups(required map[string][]resources.ResourceType) [][]resources.ResourceType {
+	all := slices.Clone(check.PlatformResources)
+
+	ids := make([]string, 0, len(required))
+	for id := range required {
+		ids = append(ids, id)
+	}
+
+	slices.Sort(ids)
+
+	for _, id := range ids {
+		all = append(all, required[id]...)
+	}
+
+	index := make(map[schema.GroupResource]int)
+
+	var groups [][]resources.ResourceType
+
+	for _, rt := range all {
+		gr := schema.GroupResource{Group: rt.Group, Resource: rt.Resource}
+
+		i, ok := index[gr]
+		if !ok {
+			index[gr] = len(groups)
+			groups = append(groups, []resources.ResourceType{rt})
+
+			continue
+		}
+
+		if !slices.ContainsFunc(groups[i], func(existing resources.ResourceType) bool {
+			return existing.Version == rt.Version
+		}) {
+			groups[i] = append(groups[i], rt)
+		}
+	}
+
+	return groups
+}
+
+// redactSnapshotObjects replaces the values of Secrets with a placeholder. The keys are kept,
+// since checks such as the connection Secrets one validate which keys a Secret carries; checks
+// decoding a value (e.g. the Elyra runtime configuration) report it as unverified. The
+// last-applied-configuration annotation and the managed fields, which can carry the values of
+// Secrets created with kubectl apply, are dropped.
+func redactSnapshotObjects(rt resources.ResourceType, items []*unstructured.Unstructured) []*unstructured.Unstructured {
+	if rt.Group != resources.Secret.Group || rt.Resource != resources.Secret.Resource {
+		return items
+	}
+
+	for _, item := range items {
+		unstructured.RemoveNestedField(item.Object, "metadata", "annotations", corev1.LastAppliedConfigAnnotation)
+		unstructured.RemoveNestedField(item.Object, "metadata", "managedFields")
+
+		for field, placeholder := range map[string]string{
+			"data":       redactedSecretData,
+			"stringData": redactedSecretValue,
+		} {
+			values, found, _ := unstructured.NestedMap(item.Object, field)
+			if !found {
+				continue
+			}
+
+			for key := range values {
+				values[key] = placeholder
+			}
+
+			item.Object[field] = values
+		}
+	}
+
+	return items
+}
+
+// snapshotEntryName returns the archive path for a resource, e.g. "kubeflow.org/notebooks.yaml".
+func snapshotEntryName(rt resources.ResourceType) string {
+	group := rt.Group
+	if group == "" {
+		group = "core"
+	}
+
+	return group + "/" + rt.Resource + ".yaml"
+}
+
+// marshalSnapshotList renders the objects as a single v1 List document.
+func marshalSnapshotList(items []*unstructured.Unstructured) ([]byte, error) {
+	objects := make([]any, 0, len(items))
+	for _, item := range items {
+		objects = append(objects, item.Object)
+	}
+
+	data, err := yaml.Marshal(map[string]any{
+		"apiVersion": "v1",
+		"kind":       "List",
+		"items":      objects,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("marshaling to YAML: %w", err)
+	}
+
+	return data, nil
+}
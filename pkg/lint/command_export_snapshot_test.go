@@ -0,0 +1,254 @@
+//nolint:testpackage // internal test: injects a registry and fake client into ExportSnapshotCommand
+package lint
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"path/filepath"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+
+	. "github.com/onsi/gomega"
+)
+
+type snapshotTestCheck struct {
+	check.BaseCheck
+}
+
+func (c *snapshotTestCheck) CanApply(_ context.Context, _ check.Target) (bool, error) {
+	return true, nil
+}
+
+func (c *snapshotTestCheck) Validate(_ context.Context, _ check.Target) (*result.DiagnosticResult, error) {
+	return c.NewResult(), nil
+}
+
+func newSnapshotObject(rt resources.ResourceType, namespace, name string) *unstructured.Unstructured {
+	obj := rt.Unstructured()
+	obj.SetNamespace(namespace)
+	obj.SetName(name)
+
+	return &obj
+}
+
+func newSnapshotClient(objs ...runtime.Object) client.Client {
+	scheme := runtime.NewScheme()
+	_ = metav1.AddMetaToScheme(scheme)
+
+	listKinds := map[schema.GroupVersionResource]string{
+		resources.Notebook.GVR():         resources.Notebook.ListKind(),
+		resources.Secret.GVR():           resources.Secret.ListKind(),
+		resources.InferenceService.GVR(): resources.InferenceService.ListKind(),
+	}
+	for _, rt := range check.PlatformResources {
+		listKinds[rt.GVR()] = rt.ListKind()
+	}
+
+	dyn := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(scheme, listKinds, objs...)
+
+	// Simulate a cluster that no longer serves the v1 DataScienceCluster API.
+	dyn.PrependReactor("list", resources.DataScienceClusterV1.Resource,
+		func(action k8stesting.Action) (bool, runtime.Object, error) {
+			if action.GetResource().Version != resources.DataScienceClusterV1.Version {
+				return false, nil, nil
+			}
+
+			return true, nil, apierrors.NewNotFound(resources.DataScienceClusterV1.GVR().GroupResource(), "")
+		},
+	)
+
+	return client.NewForTesting(client.TestClientConfig{Dynamic: dyn})
+}
+
+func newTestExportSnapshotCommand(t *testing.T, c client.Client, errOut *bytes.Buffer) *ExportSnapshotCommand {
+	t.Helper()
+
+	registry := check.NewRegistry()
+	if err := registry.Register(&snapshotTestCheck{BaseCheck: check.BaseCheck{
+		CheckGroup:     check.GroupWorkload,
+		CheckID:        "workloads.notebook.snapshot-test",
+		CheckResources: []resources.ResourceType{resources.Notebook, resources.Secret},
+	}}); err != nil {
+		t.Fatal(err)
+	}
+
+	command := NewExportSnapshotCommand(genericiooptions.IOStreams{
+		In: &bytes.Buffer{}, Out: &bytes.Buffer{}, ErrOut: errOut,
+	}, nil)
+	command.registry = registry
+	command.Client = c
+	command.OutputFile = filepath.Join(t.TempDir(), DefaultSnapshotFile)
+
+	return command
+}
+
+func TestExportSnapshotCommand_Run(t *testing.T) {
+	t.Run("should write an archive readable by the file reader", func(t *testing.T) {
+		g := NewWithT(t)
+		ctx := t.Context()
+
+		secret := newSnapshotObject(resources.Secret, "user-ns", "creds")
+		secret.Object["data"] = map[string]any{"password": "c2VjcmV0"}
+
+		var errOut bytes.Buffer
+		command := newTestExportSnapshotCommand(t, newSnapshotClient(
+			newSnapshotObject(resources.DataScienceCluster, "", "default-dsc"),
+			newSnapshotObject(resources.Notebook, "user-ns", "wb"),
+			secret,
+		), &errOut)
+
+		g.Expect(command.Validate()).To(Succeed())
+		g.Expect(command.Run(ctx)).To(Succeed())
+		g.Expect(errOut.String()).To(ContainSubstring("Snapshot with 3 object(s) written to"))
+
+		reader, err := client.NewFileReader(command.OutputFile)
+		g.Expect(err).ToNot(HaveOccurred())
+
+		dscs, err := reader.List(ctx, resources.DataScienceCluster)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(dscs).To(HaveLen(1))
+
+		notebooks, err := reader.List(ctx, resources.Notebook)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(notebooks).To(HaveLen(1))
+		g.Expect(notebooks[0].GetName()).To(Equal("wb"))
+
+		secrets, err := reader.List(ctx, resources.Secret)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(secrets).To(HaveLen(1))
+		g.Expect(secrets[0].Object).To(HaveKeyWithValue("data", map[string]any{"password": redactedSecretData}))
+	})
+
+	t.Run("should drop the applied configuration of Secrets", func(t *testing.T) {
+		g := NewWithT(t)
+		ctx := t.Context()
+
+		// A Secret created with kubectl apply carries its values in plaintext in the annotation
+		secret := newSnapshotObject(resources.Secret, "user-ns", "applied")
+		secret.Object["stringData"] = map[string]any{"password": "secret"}
+		secret.SetAnnotations(map[string]string{
+			corev1.LastAppliedConfigAnnotation: `{"apiVersion":"v1","kind":"Secret","stringData":{"password":"secret"}}`,
+			"team":                             "a",
+		})
+		secret.SetManagedFields([]metav1.ManagedFieldsEntry{{
+			Manager:    "kubectl-client-side-apply",
+			Operation:  metav1.ManagedFieldsOperationUpdate,
+			FieldsType: "FieldsV1",
+			FieldsV1:   &metav1.FieldsV1{Raw: []byte(`{"f:stringData":{"f:password":{}}}`)},
+		}})
+
+		var errOut bytes.Buffer
+		command := newTestExportSnapshotCommand(t, newSnapshotClient(
+			newSnapshotObject(resources.DataScienceCluster, "", "default-dsc"),
+			secret,
+		), &errOut)
+
+		g.Expect(command.Validate()).To(Succeed())
+		g.Expect(command.Run(ctx)).To(Succeed())
+
+		reader, err := client.NewFileReader(command.OutputFile)
+		g.Expect(err).ToNot(HaveOccurred())
+
+		secrets, err := reader.List(ctx, resources.Secret)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(secrets).To(HaveLen(1))
+		g.Expect(secrets[0].GetAnnotations()).To(Equal(map[string]string{"team": "a"}))
+		g.Expect(secrets[0].GetManagedFields()).To(BeEmpty())
+		g.Expect(secrets[0].Object).To(HaveKeyWithValue("stringData", map[string]any{"password": redactedSecretValue}))
+	})
+
+	t.Run("should keep the Secret keys the checks validate offline", func(t *testing.T) {
+		g := NewWithT(t)
+
+		dsc := newSnapshotObject(resources.DataScienceCluster, "", "default-dsc")
+		dsc.Object["spec"] = map[string]any{
+			"components": map[string]any{"kserve": map[string]any{"managementState": "Managed"}},
+		}
+		dsc.Object["status"] = map[string]any{"release": map[string]any{"version": "2.25.0"}}
+
+		secret := newSnapshotObject(resources.Secret, "user-ns", "models")
+		secret.SetLabels(map[string]string{"opendatahub.io/dashboard": "true"})
+		secret.SetAnnotations(map[string]string{"opendatahub.io/connection-type-ref": "s3"})
+		secret.Object["data"] = map[string]any{
+			"AWS_ACCESS_KEY_ID":     "a2V5",
+			"AWS_SECRET_ACCESS_KEY": "c2VjcmV0",
+			"AWS_S3_ENDPOINT":       "aHR0cHM6Ly9zMw==",
+			"AWS_S3_BUCKET":         "YnVja2V0",
+		}
+
+		export := newTestExportSnapshotCommand(t, newSnapshotClient(dsc, secret), &bytes.Buffer{})
+		export.registry = newDefaultRegistry()
+		export.CheckSelectors = []string{"workloads.kserve.connection-secrets"}
+
+		g.Expect(export.Run(t.Context())).To(Succeed())
+
+		var out bytes.Buffer
+		command := NewCommand(genericiooptions.IOStreams{
+			In: &bytes.Buffer{}, Out: &out, ErrOut: &bytes.Buffer{},
+		}, nil)
+		command.FromDir = export.OutputFile
+		command.TargetVersion = "3.0.0"
+		command.CheckSelectors = []string{"workloads.kserve.connection-secrets"}
+		command.OutputFormat = OutputFormatJSON
+
+		g.Expect(command.Complete()).To(Succeed())
+		g.Expect(command.Validate()).To(Succeed())
+		g.Expect(command.Run(t.Context())).To(Succeed())
+
+		var report struct {
+			Results []struct {
+				Name   string `json:"name"`
+				Status struct {
+					Conditions []struct {
+						Type   string `json:"type"`
+						Status string `json:"status"`
+					} `json:"conditions"`
+				} `json:"status"`
+			} `json:"results"`
+		}
+		g.Expect(json.Unmarshal(out.Bytes(), &report)).To(Succeed())
+		g.Expect(report.Results).To(HaveLen(1))
+		g.Expect(report.Results[0].Name).To(Equal("connection-secrets"))
+		g.Expect(report.Results[0].Status.Conditions).To(HaveLen(1))
+		g.Expect(report.Results[0].Status.Conditions[0].Type).To(Equal("ConnectionSecretsCompatible"))
+		g.Expect(report.Results[0].Status.Conditions[0].Status).To(Equal("True"))
+	})
+
+	t.Run("should fail when no checks match", func(t *testing.T) {
+		g := NewWithT(t)
+
+		command := newTestExportSnapshotCommand(t, newSnapshotClient(), &bytes.Buffer{})
+		command.CheckSelectors = []string{"components.*"}
+
+		g.Expect(command.Run(t.Context())).To(MatchError(ContainSubstring("no registered checks")))
+	})
+}
+
+func TestSnapshotResourceGroups(t *testing.T) {
+	g := NewWithT(t)
+
+	groups := snapshotResourceGroups(map[string][]resources.ResourceType{
+		"b": {resources.Notebook, resources.DataScienceCluster},
+		"a": {resources.Notebook},
+	})
+
+	g.Expect(groups).To(HaveLen(len(check.PlatformResources) - 2 + 1))
+	g.Expect(groups[0]).To(Equal([]resources.ResourceType{resources.DataScienceCluster, resources.DataScienceClusterV1}))
+	g.Expect(groups[len(groups)-1]).To(Equal([]resources.ResourceType{resources.Notebook}))
+	g.Expect(snapshotEntryName(resources.Secret)).To(Equal("core/secrets.yaml"))
+}
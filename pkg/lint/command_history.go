@@ -0,0 +1,234 @@
+package lint
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/pflag"
+
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/opendatahub-io/odh-cli/pkg/api"
+	"github.com/opendatahub-io/odh-cli/pkg/cmd"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/output"
+	"github.com/opendatahub-io/odh-cli/pkg/printer/table"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+)
+
+var _ cmd.Command = (*HistoryCommand)(nil)
+
+const (
+	reportHistoryKind = "LintReportHistory"
+
+	msgHistoryEmpty = "No lint reports recorded in %s (run 'lint --record' to record one)"
+)
+
+// ReportHistory is the structured output of `lint history`.
+type ReportHistory struct {
+	output.Envelope
+
+	// Reports are the recorded reports, oldest first.
+	Reports []ReportSummary `json:"reports" yaml:"reports"`
+}
+
+type reportRow struct {
+	Name      string
+	Generated string
+	Current   string
+	Target    string
+	Result    string
+	Errors    string
+	Warnings  string
+}
+
+// HistoryCommand lists the lint reports recorded with `lint --record`, or compares two of them.
+type HistoryCommand struct {
+	*SharedOptions
+
+	// Namespace is where reports are read from; defaults to the operator namespace.
+	Namespace string
+
+	// Reports holds the names of two reports to compare, oldest first. When empty, the
+	// recorded reports are listed.
+	Reports []string
+}
+
+// NewHistoryCommand creates a new HistoryCommand with defaults.
+func NewHistoryCommand(
+	streams genericiooptions.IOStreams,
+	configFlags *genericclioptions.ConfigFlags,
+) *HistoryCommand {
+	return &HistoryCommand{
+		SharedOptions: NewSharedOptions(streams, configFlags),
+	}
+}
+
+// AddFlags registers command-specific flags with the provided FlagSet.
+func (c *HistoryCommand) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVarP((*string)(&c.OutputFormat), "output", "o", string(OutputFormatTable), flagDescHistoryOutput)
+	_ = fs.SetAnnotation("output", api.AnnotationValidValues, []string{"table", "json", "yaml"})
+	fs.DurationVar(&c.Timeout, "timeout", c.Timeout, flagDescTimeout)
+	fs.Float32Var(&c.QPS, "qps", c.QPS, flagDescQPS)
+	fs.IntVar(&c.Burst, "burst", c.Burst, flagDescBurst)
+}
+
+// Complete creates the client and resolves the namespace from the -n flag.
+func (c *HistoryCommand) Complete() error {
+	if c.ConfigFlags != nil && c.ConfigFlags.Namespace != nil {
+		c.Namespace = *c.ConfigFlags.Namespace
+	}
+
+	// The client may already be set, e.g. by tests
+	if c.Client != nil {
+		return nil
+	}
+
+	if err := c.SharedOptions.Complete(); err != nil {
+		return fmt.Errorf("completing shared options: %w", err)
+	}
+
+	return nil
+}
+
+// Validate checks the output format and the reports to compare.
+func (c *HistoryCommand) Validate() error {
+	if !slices.Contains([]OutputFormat{OutputFormatTable, OutputFormatJSON, OutputFormatYAML}, c.OutputFormat) {
+		return fmt.Errorf("invalid output format: %s (must be one of: table, json, yaml)", c.OutputFormat)
+	}
+
+	if len(c.Reports) != 0 && len(c.Reports) != 2 {
+		return errors.New("exactly two reports are required to compare")
+	}
+
+	if c.Timeout <= 0 {
+		return errors.New("timeout must be greater than 0")
+	}
+
+	return nil
+}
+
+// Run lists the recorded reports, or compares the two given ones.
+func (c *HistoryCommand) Run(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, c.Timeout)
+	defer cancel()
+
+	namespace := c.Namespace
+	if namespace == "" {
+		ns, err := client.DiscoverOperatorNamespace(ctx, c.Client)
+		if err != nil {
+			return fmt.Errorf("discovering operator namespace: %w", err)
+		}
+
+		namespace = ns
+	}
+
+	if len(c.Reports) == 2 {
+		return c.compare(ctx, namespace)
+	}
+
+	items, err := c.Client.List(ctx, resources.ConfigMap,
+		client.WithNamespace(namespace), client.WithLabelSelector(ReportLabelSelector))
+	if err != nil {
+		return fmt.Errorf("listing lint reports: %w", err)
+	}
+
+	history := &ReportHistory{
+		Envelope: output.NewEnvelope(reportHistoryKind, "lint history"),
+		Reports:  make([]ReportSummary, 0, len(items)),
+	}
+
+	for _, item := range items {
+		history.Reports = append(history.Reports, NewReportSummary(item))
+	}
+
+	// Names embed the generation time, so they break ties between reports of the same second
+	slices.SortFunc(history.Reports, func(a, b ReportSummary) int {
+		if a.GeneratedAt != b.GeneratedAt {
+			return strings.Compare(a.GeneratedAt, b.GeneratedAt)
+		}
+
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	if c.OutputFormat != OutputFormatTable {
+		return renderStructured(c.IO.Out(), c.OutputFormat, history)
+	}
+
+	if len(history.Reports) == 0 {
+		c.IO.Errorf(msgHistoryEmpty, namespace)
+
+		return nil
+	}
+
+	return c.printTable(history.Reports)
+}
+
+// compare loads both reports and renders the changes between them.
+func (c *HistoryCommand) compare(ctx context.Context, namespace string) error {
+	summaries := make([]ReportSummary, 0, len(c.Reports))
+	lists := make([]*result.DiagnosticResultList, 0, len(c.Reports))
+
+	for _, name := range c.Reports {
+		if !strings.HasPrefix(name, ReportNamePrefix) {
+			name = ReportNamePrefix + name
+		}
+
+		obj, err := c.Client.GetResource(ctx, resources.ConfigMap, name, client.InNamespace(namespace))
+		if err != nil {
+			return fmt.Errorf("getting lint report %s/%s: %w", namespace, name, err)
+		}
+
+		list, err := LoadReport(obj)
+		if err != nil {
+			return err
+		}
+
+		summaries = append(summaries, NewReportSummary(obj))
+		lists = append(lists, list)
+	}
+
+	comparison := CompareReports("lint history", summaries[0], summaries[1], lists[0], lists[1])
+
+	if c.OutputFormat != OutputFormatTable {
+		return renderStructured(c.IO.Out(), c.OutputFormat, comparison)
+	}
+
+	return writeReportComparison(c.IO.Out(), comparison)
+}
+
+func (c *HistoryCommand) printTable(reports []ReportSummary) error {
+	renderer := table.NewRenderer(
+		table.WithWriter[reportRow](c.IO.Out()),
+		table.WithHeaders[reportRow]("NAME", "GENERATED", "CURRENT", "TARGET", "RESULT", "ERRORS", "WARNINGS"),
+		table.WithTableOptions[reportRow](table.DefaultTableOptions...),
+	)
+
+	for _, r := range reports {
+		row := reportRow{
+			Name:      r.Name,
+			Generated: r.GeneratedAt,
+			Current:   r.ClusterVersion,
+			Target:    r.TargetVersion,
+			Result:    r.Result,
+			Errors:    strconv.Itoa(r.Errors),
+			Warnings:  strconv.Itoa(r.Warnings),
+		}
+
+		if err := renderer.Append(row); err != nil {
+			return fmt.Errorf("failed to append row: %w", err)
+		}
+	}
+
+	if err := renderer.Render(); err != nil {
+		return fmt.Errorf("failed to render table: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,156 @@
+package lint_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+
+	. "github.com/onsi/gomega"
+)
+
+// newHistoryClient records a report where check a fails on nb1, then one where a also fails
+// on nb2 and check b starts failing, and one where a is resolved.
+func newHistoryClient(t *testing.T) client.Client {
+	t.Helper()
+
+	c := newReportClient()
+	checks := []string{"a", "b"}
+
+	for generatedAt, failing := range map[string]map[string][]string{
+		"2026-10-01T08:00:00Z": {"a": {"nb1"}},
+		"2026-10-08T08:00:00Z": {"a": {"nb1", "nb2"}, "b": nil},
+		"2026-10-15T08:00:00Z": {"b": nil},
+	} {
+		_, err := lint.RecordReport(t.Context(), c, reportNamespace, newReportList(generatedAt, checks, failing))
+		NewWithT(t).Expect(err).ToNot(HaveOccurred())
+	}
+
+	return c
+}
+
+func newHistoryCommand(c client.Client, out, errOut *bytes.Buffer) *lint.HistoryCommand {
+	command := lint.NewHistoryCommand(genericiooptions.IOStreams{Out: out, ErrOut: errOut}, testConfigFlags())
+	command.Client = c
+	command.Namespace = reportNamespace
+
+	return command
+}
+
+func TestHistoryCommand(t *testing.T) {
+	t.Run("should list recorded reports oldest first", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var out, errOut bytes.Buffer
+
+		command := newHistoryCommand(newHistoryClient(t), &out, &errOut)
+		command.OutputFormat = lint.OutputFormatJSON
+
+		g.Expect(command.Run(t.Context())).To(Succeed())
+
+		var history lint.ReportHistory
+		g.Expect(json.Unmarshal(out.Bytes(), &history)).To(Succeed())
+		g.Expect(history.Kind).To(Equal("LintReportHistory"))
+		g.Expect(history.Reports).To(HaveExactElements(
+			HaveField("Name", "odh-lint-report-20261001-080000"),
+			HaveField("Name", "odh-lint-report-20261008-080000"),
+			HaveField("Name", "odh-lint-report-20261015-080000"),
+		))
+		g.Expect(history.Reports[1]).To(And(HaveField("Result", "failure"), HaveField("Errors", 2)))
+	})
+
+	t.Run("should print a table of reports", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var out, errOut bytes.Buffer
+
+		command := newHistoryCommand(newHistoryClient(t), &out, &errOut)
+
+		g.Expect(command.Run(t.Context())).To(Succeed())
+		g.Expect(out.String()).To(ContainSubstring("odh-lint-report-20261008-080000"))
+		g.Expect(out.String()).To(ContainSubstring("2.25.0"))
+	})
+
+	t.Run("should report when no reports are recorded", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var out, errOut bytes.Buffer
+
+		command := newHistoryCommand(newReportClient(), &out, &errOut)
+
+		g.Expect(command.Run(t.Context())).To(Succeed())
+		g.Expect(out.String()).To(BeEmpty())
+		g.Expect(errOut.String()).To(ContainSubstring("No lint reports recorded in " + reportNamespace))
+	})
+
+	t.Run("should compare two reports", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var out, errOut bytes.Buffer
+
+		command := newHistoryCommand(newHistoryClient(t), &out, &errOut)
+		command.Reports = []string{"20261001-080000", "odh-lint-report-20261008-080000"}
+
+		g.Expect(command.Run(t.Context())).To(Succeed())
+		g.Expect(out.String()).To(ContainSubstring("2 change(s)"))
+		g.Expect(out.String()).To(ContainSubstring("  ~ workload/notebook/a: blocking, 1 new / 0 resolved finding(s), impacted objects 1 → 2\n" +
+			"      + Notebook ns/nb2\n"))
+		g.Expect(out.String()).To(ContainSubstring("  + workload/notebook/b: newly failing"))
+	})
+
+	t.Run("should compare two reports as JSON", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var out, errOut bytes.Buffer
+
+		command := newHistoryCommand(newHistoryClient(t), &out, &errOut)
+		command.Reports = []string{"20261008-080000", "20261015-080000"}
+		command.OutputFormat = lint.OutputFormatJSON
+
+		g.Expect(command.Run(t.Context())).To(Succeed())
+
+		var comparison lint.ReportComparison
+		g.Expect(json.Unmarshal(out.Bytes(), &comparison)).To(Succeed())
+		g.Expect(comparison.From.Name).To(Equal("odh-lint-report-20261008-080000"))
+		g.Expect(comparison.Changes).To(HaveExactElements(And(
+			HaveField("Type", lint.WatchChangeResolved),
+			HaveField("CheckID", "workload/notebook/a"),
+			HaveField("ResolvedFindings", 2),
+		)))
+	})
+
+	t.Run("should discover the operator namespace", func(t *testing.T) {
+		g := NewWithT(t)
+
+		operator := resources.Deployment.Unstructured()
+		operator.SetNamespace(reportNamespace)
+		operator.SetName("rhods-operator")
+
+		c := newReportClient(&operator)
+		_, err := lint.RecordReport(t.Context(), c, reportNamespace,
+			newReportList("2026-10-01T08:00:00Z", []string{"a"}, nil))
+		g.Expect(err).ToNot(HaveOccurred())
+
+		var out, errOut bytes.Buffer
+
+		command := newHistoryCommand(c, &out, &errOut)
+		command.Namespace = ""
+
+		g.Expect(command.Run(t.Context())).To(Succeed())
+		g.Expect(out.String()).To(ContainSubstring("odh-lint-report-20261001-080000"))
+	})
+
+	t.Run("should require two reports to compare", func(t *testing.T) {
+		g := NewWithT(t)
+
+		command := lint.NewHistoryCommand(genericiooptions.IOStreams{}, testConfigFlags())
+		command.Reports = []string{"a"}
+
+		g.Expect(command.Validate()).To(MatchError(ContainSubstring("exactly two reports")))
+	})
+}
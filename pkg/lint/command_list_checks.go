@@ -0,0 +1,168 @@
+package lint
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/spf13/pflag"
+
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/opendatahub-io/odh-cli/pkg/api"
+	"github.com/opendatahub-io/odh-cli/pkg/cmd"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/output"
+	printerjson "github.com/opendatahub-io/odh-cli/pkg/printer/json"
+	"github.com/opendatahub-io/odh-cli/pkg/printer/table"
+	printeryaml "github.com/opendatahub-io/odh-cli/pkg/printer/yaml"
+	"github.com/opendatahub-io/odh-cli/pkg/util/iostreams"
+)
+
+var _ cmd.Command = (*ListChecksCommand)(nil)
+
+const checkCatalogKind = "CheckCatalog"
+
+// CheckCatalog is the structured output of `lint list-checks`.
+type CheckCatalog struct {
+	output.Envelope
+
+	// PlatformResources are read by every lint run regardless of the selected checks.
+	PlatformResources []check.ResourcePermission `json:"platformResources" yaml:"platformResources"`
+
+	// Checks describes each registered check matching the selectors, sorted by group and ID.
+	Checks []check.Metadata `json:"checks" yaml:"checks"`
+}
+
+type checkRow struct {
+	ID          string
+	Group       string
+	Versions    string
+	Fixable     string
+	Description string
+}
+
+// ListChecksCommand prints the catalog of registered lint checks. It never contacts a cluster.
+type ListChecksCommand struct {
+	IO iostreams.Interface
+
+	// OutputFormat specifies the output format (table, json, yaml)
+	OutputFormat OutputFormat
+
+	// CheckSelectors filters which checks are listed (glob patterns, repeatable)
+	CheckSelectors []string
+
+	registry *check.CheckRegistry
+}
+
+// NewListChecksCommand creates a new ListChecksCommand with defaults.
+func NewListChecksCommand(streams genericiooptions.IOStreams) *ListChecksCommand {
+	return &ListChecksCommand{
+		IO:             iostreams.NewIOStreams(streams.In, streams.Out, streams.ErrOut),
+		OutputFormat:   OutputFormatTable,
+		CheckSelectors: []string{"*"},
+		registry:       newDefaultRegistry(),
+	}
+}
+
+// AddFlags registers command-specific flags with the provided FlagSet.
+func (c *ListChecksCommand) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVarP((*string)(&c.OutputFormat), "output", "o", string(OutputFormatTable), flagDescListChecksOutput)
+	_ = fs.SetAnnotation("output", api.AnnotationValidValues, []string{"table", "json", "yaml"})
+	fs.StringArrayVar(&c.CheckSelectors, "checks", []string{"*"}, flagDescChecks)
+}
+
+// Complete performs no setup; the catalog is static.
+func (c *ListChecksCommand) Complete() error {
+	return nil
+}
+
+// Validate checks the output format and selectors.
+func (c *ListChecksCommand) Validate() error {
+	if !slices.Contains([]OutputFormat{OutputFormatTable, OutputFormatJSON, OutputFormatYAML}, c.OutputFormat) {
+		return fmt.Errorf("invalid output format: %s (must be one of: table, json, yaml)", c.OutputFormat)
+	}
+
+	return ValidateCheckSelectors(c.CheckSelectors)
+}
+
+// Run renders the metadata of every check matching the selectors.
+func (c *ListChecksCommand) Run(_ context.Context) error {
+	checks, err := c.registry.ListByPatterns(c.CheckSelectors, "")
+	if err != nil {
+		return fmt.Errorf("listing checks: %w", err)
+	}
+
+	catalog := &CheckCatalog{
+		Envelope:          output.NewEnvelope(checkCatalogKind, "lint list-checks"),
+		PlatformResources: check.ResourcePermissions(check.PlatformResources),
+		Checks:            make([]check.Metadata, 0, len(checks)),
+	}
+
+	for _, chk := range checks {
+		catalog.Checks = append(catalog.Checks, check.DescribeCheck(chk))
+	}
+
+	slices.SortFunc(catalog.Checks, func(a, b check.Metadata) int {
+		if a.Group != b.Group {
+			return strings.Compare(string(a.Group), string(b.Group))
+		}
+
+		return strings.Compare(a.ID, b.ID)
+	})
+
+	switch c.OutputFormat { //nolint:exhaustive // Validate restricts formats to table, json and yaml
+	case OutputFormatJSON:
+		renderer := printerjson.NewRenderer[*CheckCatalog](printerjson.WithWriter[*CheckCatalog](c.IO.Out()))
+		if err := renderer.Render(catalog); err != nil {
+			return fmt.Errorf("rendering JSON output: %w", err)
+		}
+
+		return nil
+	case OutputFormatYAML:
+		renderer := printeryaml.NewRenderer[*CheckCatalog](printeryaml.WithWriter[*CheckCatalog](c.IO.Out()))
+		if err := renderer.Render(catalog); err != nil {
+			return fmt.Errorf("rendering YAML output: %w", err)
+		}
+
+		return nil
+	default:
+		return c.printTable(catalog.Checks)
+	}
+}
+
+func (c *ListChecksCommand) printTable(checks []check.Metadata) error {
+	renderer := table.NewRenderer(
+		table.WithWriter[checkRow](c.IO.Out()),
+		table.WithHeaders[checkRow]("ID", "GROUP", "VERSIONS", "FIXABLE", "DESCRIPTION"),
+		table.WithTableOptions[checkRow](table.DefaultTableOptions...),
+	)
+
+	for _, md := range checks {
+		fixable := "No"
+		if md.Fixable {
+			fixable = "Yes"
+		}
+
+		row := checkRow{
+			ID:          md.ID,
+			Group:       string(md.Group),
+			Versions:    md.ApplicableVersions,
+			Fixable:     fixable,
+			Description: strings.TrimSpace(md.Description),
+		}
+
+		if err := renderer.Append(row); err != nil {
+			return fmt.Errorf("failed to append row: %w", err)
+		}
+	}
+
+	if err := renderer.Render(); err != nil {
+		return fmt.Errorf("failed to render table: %w", err)
+	}
+
+	c.IO.Fprintf("\n%d check(s). Use -o json or -o yaml for remediation, dependencies and required permissions.\n", len(checks))
+
+	return nil
+}
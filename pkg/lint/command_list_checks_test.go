@@ -0,0 +1,80 @@
+package lint_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+
+	. "github.com/onsi/gomega"
+)
+
+func newListChecksTestCommand(out *bytes.Buffer) *lint.ListChecksCommand {
+	return lint.NewListChecksCommand(genericiooptions.IOStreams{
+		In: &bytes.Buffer{}, Out: out, ErrOut: &bytes.Buffer{},
+	})
+}
+
+func TestListChecksCommand(t *testing.T) {
+	t.Run("JSON output should describe every registered check", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var out bytes.Buffer
+		command := newListChecksTestCommand(&out)
+		command.OutputFormat = lint.OutputFormatJSON
+
+		g.Expect(command.Complete()).To(Succeed())
+		g.Expect(command.Validate()).To(Succeed())
+		g.Expect(command.Run(t.Context())).To(Succeed())
+
+		var catalog lint.CheckCatalog
+		g.Expect(json.Unmarshal(out.Bytes(), &catalog)).To(Succeed())
+		g.Expect(catalog.Kind).To(Equal("CheckCatalog"))
+		g.Expect(catalog.PlatformResources).To(HaveLen(len(check.PlatformResources)))
+		g.Expect(catalog.Checks).ToNot(BeEmpty())
+
+		for _, md := range catalog.Checks {
+			g.Expect(md.ID).ToNot(BeEmpty())
+			g.Expect(md.Name).ToNot(BeEmpty(), md.ID)
+			g.Expect(md.ApplicableVersions).ToNot(BeEmpty(), "%s should set CheckVersions", md.ID)
+		}
+	})
+
+	t.Run("should honour check selectors", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var out bytes.Buffer
+		command := newListChecksTestCommand(&out)
+		command.OutputFormat = lint.OutputFormatYAML
+		command.CheckSelectors = []string{"dependencies.*"}
+
+		g.Expect(command.Validate()).To(Succeed())
+		g.Expect(command.Run(t.Context())).To(Succeed())
+		g.Expect(out.String()).To(ContainSubstring("id: dependencies."))
+		g.Expect(out.String()).ToNot(ContainSubstring("id: workloads."))
+	})
+
+	t.Run("table output should list check IDs", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var out bytes.Buffer
+		command := newListChecksTestCommand(&out)
+
+		g.Expect(command.Validate()).To(Succeed())
+		g.Expect(command.Run(t.Context())).To(Succeed())
+		g.Expect(out.String()).To(ContainSubstring("components.modelmesh.removal"))
+	})
+
+	t.Run("should reject junit output", func(t *testing.T) {
+		g := NewWithT(t)
+
+		command := newListChecksTestCommand(&bytes.Buffer{})
+		command.OutputFormat = lint.OutputFormatJUnit
+
+		g.Expect(command.Validate()).To(MatchError(ContainSubstring("invalid output format")))
+	})
+}
@@ -5,18 +5,23 @@ import (
 	"fmt"
 	"io"
 	"path"
+	"slices"
 	"sort"
+	"strings"
 	"time"
 
 	"k8s.io/cli-runtime/pkg/genericclioptions"
 	"k8s.io/cli-runtime/pkg/genericiooptions"
 
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/messages"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
 	printerjson "github.com/opendatahub-io/odh-cli/pkg/printer/json"
 	printeryaml "github.com/opendatahub-io/odh-cli/pkg/printer/yaml"
 	"github.com/opendatahub-io/odh-cli/pkg/util/client"
 	"github.com/opendatahub-io/odh-cli/pkg/util/iostreams"
+	"github.com/opendatahub-io/odh-cli/pkg/util/logging"
+	"github.com/opendatahub-io/odh-cli/pkg/util/version"
 )
 
 // StdinInput defines the JSON/YAML schema for stdin input to the lint command.
@@ -46,9 +51,14 @@ type StdinInput struct {
 type OutputFormat string
 
 const (
-	OutputFormatTable OutputFormat = "table"
-	OutputFormatJSON  OutputFormat = "json"
-	OutputFormatYAML  OutputFormat = "yaml"
+	OutputFormatTable    OutputFormat = "table"
+	OutputFormatWide     OutputFormat = "wide"
+	OutputFormatJSON     OutputFormat = "json"
+	OutputFormatNDJSON   OutputFormat = "ndjson"
+	OutputFormatYAML     OutputFormat = "yaml"
+	OutputFormatJUnit    OutputFormat = "junit"
+	OutputFormatHTML     OutputFormat = "html"
+	OutputFormatMarkdown OutputFormat = "markdown"
 
 	// DefaultTimeout is the default timeout for lint commands.
 	DefaultTimeout = 5 * time.Minute
@@ -68,13 +78,19 @@ const (
 // Validate checks if the output format is valid.
 func (o OutputFormat) Validate() error {
 	switch o {
-	case OutputFormatTable, OutputFormatJSON, OutputFormatYAML:
+	case OutputFormatTable, OutputFormatWide, OutputFormatJSON, OutputFormatNDJSON, OutputFormatYAML,
+		OutputFormatJUnit, OutputFormatHTML, OutputFormatMarkdown:
 		return nil
 	default:
-		return fmt.Errorf("invalid output format: %s (must be one of: table, json, yaml)", o)
+		return fmt.Errorf("invalid output format: %s (must be one of: table, wide, json, ndjson, yaml, junit, html, markdown)", o)
 	}
 }
 
+// isTable reports whether the format renders the human-readable table, plain or wide.
+func (o OutputFormat) isTable() bool {
+	return o == OutputFormatTable || o == OutputFormatWide
+}
+
 // Validate checks if the severity level is valid.
 func (s SeverityLevel) Validate() error {
 	switch s {
@@ -93,7 +109,7 @@ type SharedOptions struct {
 	// ConfigFlags provides access to kubeconfig and context
 	ConfigFlags *genericclioptions.ConfigFlags
 
-	// OutputFormat specifies the output format (table, json, yaml)
+	// OutputFormat specifies the output format (table, json, yaml, junit, html, markdown)
 	OutputFormat OutputFormat
 
 	// CheckSelectors filters which checks to run (glob patterns, repeatable)
@@ -112,21 +128,44 @@ type SharedOptions struct {
 	// Debug enables detailed diagnostic logging for troubleshooting (default: false)
 	Debug bool
 
+	// LogLevel is the minimum level of the structured check diagnostics (default: info);
+	// debug is the same as Debug
+	LogLevel string
+
+	// LogFormat is the format of the structured check diagnostics: text or json
+	LogFormat string
+
+	// LogFile receives the structured check diagnostics instead of stderr (optional)
+	LogFile string
+
 	// NoColor disables color output (default: false)
 	NoColor bool
 
+	// Lang is the language of condition messages and remediation (en, ja, zh; default: en)
+	Lang string
+
 	// FromStdin reads configuration from stdin (JSON/YAML) instead of flags
 	FromStdin bool
 
 	// Timeout is the maximum duration for command execution
 	Timeout time.Duration
 
-	// Client is the Kubernetes client (populated during Complete)
+	// FromDir runs checks against a directory of exported resource manifests instead of a live cluster
+	FromDir string
+
+	// Client is the Kubernetes client (populated during Complete, nil when FromDir is set)
 	Client client.Client
 
+	// Reader is used for all read access by checks and version detection (populated during Complete).
+	// It is backed by Client for live clusters and by the snapshot directory when FromDir is set.
+	Reader client.Reader
+
 	// Throttling settings for Kubernetes API client
 	QPS   float32
 	Burst int
+
+	// PageSize is the number of items requested per page when listing; 0 disables pagination.
+	PageSize int64
 }
 
 // NewSharedOptions creates a new SharedOptions with defaults.
@@ -144,24 +183,40 @@ func NewSharedOptions(
 		IO:             iostreams.NewIOStreams(streams.In, streams.Out, streams.ErrOut),
 		QPS:            client.DefaultQPS,
 		Burst:          client.DefaultBurst,
+		PageSize:       client.DefaultPageSize,
+		LogLevel:       logging.LevelInfo,
+		LogFormat:      logging.FormatText,
 	}
 }
 
 // Complete populates the client and performs pre-validation setup.
 func (o *SharedOptions) Complete() error {
+	// Offline mode reads a resource snapshot and never contacts the cluster
+	if o.FromDir != "" {
+		r, err := client.NewFileReader(o.FromDir)
+		if err != nil {
+			return fmt.Errorf("failed to load cluster snapshot: %w", err)
+		}
+
+		o.Reader = r
+
+		return nil
+	}
+
 	// Create REST config with user-specified throttling
 	restConfig, err := client.NewRESTConfig(o.ConfigFlags, o.QPS, o.Burst)
 	if err != nil {
 		return fmt.Errorf("failed to create REST config: %w", err)
 	}
 
-	// Create client with configured throttling
-	c, err := client.NewClientWithConfig(restConfig)
+	// Create client with configured throttling and page size
+	c, err := client.NewClientWithConfig(restConfig, client.WithPageSize(o.PageSize))
 	if err != nil {
 		return fmt.Errorf("failed to create Kubernetes client: %w", err)
 	}
 
 	o.Client = c
+	o.Reader = c
 
 	return nil
 }
@@ -188,6 +243,18 @@ func (o *SharedOptions) Validate() error {
 		return errors.New("timeout must be greater than 0")
 	}
 
+	if o.PageSize < 0 {
+		return errors.New("page size must not be negative")
+	}
+
+	if _, err := logging.ParseLevel(o.LogLevel); err != nil {
+		return err
+	}
+
+	if !slices.Contains(logging.Formats(), o.LogFormat) {
+		return fmt.Errorf("invalid log format: %s (must be one of: %s)", o.LogFormat, strings.Join(logging.Formats(), ", "))
+	}
+
 	return nil
 }
 
@@ -206,14 +273,19 @@ func ValidateCheckSelectors(selectors []string) error {
 	return nil
 }
 
-// ValidateCheckSelector validates a single check selector pattern.
+// ValidateCheckSelector validates a single check selector pattern, optionally negated with "!".
 func ValidateCheckSelector(selector string) error {
 	if selector == "" {
 		return errors.New("check selector cannot be empty")
 	}
 
+	pattern := strings.TrimPrefix(selector, check.SelectorExclude)
+	if pattern == "" || pattern == check.SelectorTag {
+		return fmt.Errorf("invalid check selector %q: missing pattern", selector)
+	}
+
 	// Validate glob pattern
-	_, err := path.Match(selector, "test.check")
+	_, err := path.Match(pattern, "test.check")
 	if err != nil {
 		return fmt.Errorf("invalid check selector pattern %q: %w", selector, err)
 	}
@@ -238,6 +310,38 @@ func WithTargetVersion(version string) CommandOption {
 	}
 }
 
+// WithContextClientFactory returns a CommandOption that sets how the clients of each
+// kubeconfig context are created when running against several contexts.
+func WithContextClientFactory(factory ContextClientFactory) CommandOption {
+	return func(c *Command) {
+		c.contextClients = factory
+	}
+}
+
+// WithOwnerResolvers returns a CommandOption that sets the resolvers used by --resolve-owners,
+// replacing check.DefaultOwnerResolvers.
+func WithOwnerResolvers(resolvers ...check.OwnerResolver) CommandOption {
+	return func(c *Command) {
+		c.ownerResolvers = resolvers
+	}
+}
+
+// WithGroupRenderers returns a CommandOption that sets the renderers used by verbose output,
+// replacing the default ones.
+func WithGroupRenderers(renderers *check.GroupRendererRegistry) CommandOption {
+	return func(c *Command) {
+		c.groupRenderers = renderers
+	}
+}
+
+// WithUsageDetectors returns a CommandOption that sets the detectors used by --detect-usage,
+// replacing check.DefaultUsageDetectors.
+func WithUsageDetectors(detectors ...check.UsageDetector) CommandOption {
+	return func(c *Command) {
+		c.usageDetectors = detectors
+	}
+}
+
 // CheckResultOutput represents a check result for JSON/YAML output.
 type CheckResultOutput struct {
 	CheckID     string         `json:"checkId"               yaml:"checkId"`
@@ -260,6 +364,13 @@ type CheckResultTableRow struct {
 	Impact      string
 	Message     string
 	Description string
+
+	// Remediation and ImpactedCount are only rendered by the wide table.
+	Remediation   string
+	ImpactedCount string `mapstructure:"IMPACTED-COUNT"`
+
+	// Score is only rendered by tables sorted by score.
+	Score string
 }
 
 // LintOutput represents the full lint output for JSON/YAML.
@@ -346,6 +457,365 @@ func FilterBySeverity(results []check.CheckExecution, minLevel SeverityLevel) []
 	return filtered
 }
 
+// Fields available in --filter expressions.
+const (
+	filterFieldCheck  = "check"
+	filterFieldGroup  = "group"
+	filterFieldKind   = "kind"
+	filterFieldName   = "name"
+	filterFieldType   = "type"
+	filterFieldStatus = "status"
+	filterFieldReason = "reason"
+	filterFieldImpact = "impact"
+
+	// filterImpactNone is how passing conditions (no impact) are matched in filter expressions.
+	filterImpactNone = "none"
+)
+
+//nolint:gochecknoglobals // Static list of filter fields for validation and error messages
+var filterFields = []string{
+	filterFieldCheck, filterFieldGroup, filterFieldKind, filterFieldName,
+	filterFieldType, filterFieldStatus, filterFieldReason, filterFieldImpact,
+}
+
+// ResultFilter is a parsed --filter expression, evaluated against every condition of every
+// result. Expressions compare fields with == and != and combine comparisons with &&, || and !,
+// using parentheses for grouping, e.g. `impact==blocking && (group==workload || kind==kserve)`.
+// Values containing * are matched as glob patterns (path.Match), and values with spaces or
+// operator characters can be quoted with single or double quotes.
+type ResultFilter struct {
+	expr string
+	root filterNode
+}
+
+// filterNode is a node of a parsed filter expression.
+type filterNode interface {
+	eval(fields map[string]string) bool
+}
+
+type filterAnd struct{ left, right filterNode }
+
+func (n filterAnd) eval(fields map[string]string) bool {
+	return n.left.eval(fields) && n.right.eval(fields)
+}
+
+type filterOr struct{ left, right filterNode }
+
+func (n filterOr) eval(fields map[string]string) bool {
+	return n.left.eval(fields) || n.right.eval(fields)
+}
+
+type filterNot struct{ operand filterNode }
+
+func (n filterNot) eval(fields map[string]string) bool {
+	return !n.operand.eval(fields)
+}
+
+type filterCompare struct {
+	field  string
+	value  string
+	negate bool
+}
+
+func (n filterCompare) eval(fields map[string]string) bool {
+	actual := fields[n.field]
+
+	matched := strings.EqualFold(actual, n.value)
+	if !matched && strings.Contains(n.value, "*") {
+		matched, _ = path.Match(n.value, actual)
+	}
+
+	return matched != n.negate
+}
+
+// ParseResultFilter parses a --filter expression. An empty expression yields a nil filter,
+// which keeps every result.
+func ParseResultFilter(expr string) (*ResultFilter, error) {
+	if strings.TrimSpace(expr) == "" {
+		return nil, nil //nolint:nilnil // nil filter means no filtering
+	}
+
+	tokens, err := tokenizeFilter(expr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid filter %q: %w", expr, err)
+	}
+
+	p := &filterParser{tokens: tokens}
+
+	root, err := p.parseOr()
+	if err == nil && p.pos < len(p.tokens) {
+		err = fmt.Errorf("unexpected %q", p.tokens[p.pos].text)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("invalid filter %q: %w", expr, err)
+	}
+
+	return &ResultFilter{expr: expr, root: root}, nil
+}
+
+// String returns the original expression.
+func (f *ResultFilter) String() string {
+	return f.expr
+}
+
+// Matches reports whether a condition of the result matches the filter.
+func (f *ResultFilter) Matches(exec check.CheckExecution, cond result.Condition) bool {
+	impact := string(cond.Impact)
+	if cond.Impact == result.ImpactNone {
+		impact = filterImpactNone
+	}
+
+	checkID := ""
+	if exec.Check != nil {
+		checkID = exec.Check.ID()
+	}
+
+	return f.root.eval(map[string]string{
+		filterFieldCheck:  checkID,
+		filterFieldGroup:  exec.Result.Group,
+		filterFieldKind:   exec.Result.Kind,
+		filterFieldName:   exec.Result.Name,
+		filterFieldType:   cond.Type,
+		filterFieldStatus: string(cond.Status),
+		filterFieldReason: cond.Reason,
+		filterFieldImpact: impact,
+	})
+}
+
+// FilterByExpression returns a filtered copy of results containing only conditions matching
+// the filter, like FilterBySeverity. Results with no remaining conditions are excluded
+// entirely. A nil filter returns the results unchanged.
+func FilterByExpression(results []check.CheckExecution, filter *ResultFilter) []check.CheckExecution {
+	if filter == nil {
+		return results
+	}
+
+	filtered := make([]check.CheckExecution, 0, len(results))
+
+	for _, exec := range results {
+		if exec.Result == nil {
+			continue
+		}
+
+		var kept []result.Condition
+		for _, cond := range exec.Result.Status.Conditions {
+			if filter.Matches(exec, cond) {
+				kept = append(kept, cond)
+			}
+		}
+
+		if len(kept) == 0 {
+			continue
+		}
+
+		filteredResult := *exec.Result
+		filteredResult.Status.Conditions = kept
+
+		filtered = append(filtered, check.CheckExecution{
+			Check:  exec.Check,
+			Result: &filteredResult,
+			Error:  exec.Error,
+		})
+	}
+
+	return filtered
+}
+
+type filterTokenKind int
+
+const (
+	filterTokenValue filterTokenKind = iota
+	filterTokenOperator
+)
+
+type filterToken struct {
+	kind filterTokenKind
+	text string
+}
+
+// filterOperators lists the operator tokens, longest first so "!=" wins over "!".
+//
+//nolint:gochecknoglobals // Static operator table for the filter tokenizer
+var filterOperators = []string{"==", "!=", "&&", "||", "!", "(", ")"}
+
+// tokenizeFilter splits an expression into operators and values (bare words or quoted strings).
+func tokenizeFilter(expr string) ([]filterToken, error) {
+	var tokens []filterToken
+
+	for i := 0; i < len(expr); {
+		ch := expr[i]
+
+		switch {
+		case ch == ' ' || ch == '\t':
+			i++
+
+			continue
+		case ch == '"' || ch == '\'':
+			end := strings.IndexByte(expr[i+1:], ch)
+			if end < 0 {
+				return nil, fmt.Errorf("unterminated quoted value at position %d", i)
+			}
+
+			tokens = append(tokens, filterToken{kind: filterTokenValue, text: expr[i+1 : i+1+end]})
+			i += end + 2
+
+			continue
+		}
+
+		if op := filterOperatorAt(expr[i:]); op != "" {
+			tokens = append(tokens, filterToken{kind: filterTokenOperator, text: op})
+			i += len(op)
+
+			continue
+		}
+
+		start := i
+		for i < len(expr) && expr[i] != ' ' && expr[i] != '\t' && expr[i] != '"' && expr[i] != '\'' &&
+			filterOperatorAt(expr[i:]) == "" {
+			i++
+		}
+
+		tokens = append(tokens, filterToken{kind: filterTokenValue, text: expr[start:i]})
+	}
+
+	return tokens, nil
+}
+
+func filterOperatorAt(s string) string {
+	for _, op := range filterOperators {
+		if strings.HasPrefix(s, op) {
+			return op
+		}
+	}
+
+	return ""
+}
+
+// filterParser is a recursive descent parser for filter expressions:
+//
+//	or      = and { "||" and }
+//	and     = unary { "&&" unary }
+//	unary   = "!" unary | "(" or ")" | compare
+//	compare = field ( "==" | "!=" ) value
+type filterParser struct {
+	tokens []filterToken
+	pos    int
+}
+
+func (p *filterParser) peekOperator(op string) bool {
+	return p.pos < len(p.tokens) && p.tokens[p.pos].kind == filterTokenOperator && p.tokens[p.pos].text == op
+}
+
+func (p *filterParser) parseOr() (filterNode, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.peekOperator("||") {
+		p.pos++
+
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+
+		left = filterOr{left: left, right: right}
+	}
+
+	return left, nil
+}
+
+func (p *filterParser) parseAnd() (filterNode, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.peekOperator("&&") {
+		p.pos++
+
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+
+		left = filterAnd{left: left, right: right}
+	}
+
+	return left, nil
+}
+
+func (p *filterParser) parseUnary() (filterNode, error) {
+	switch {
+	case p.peekOperator("!"):
+		p.pos++
+
+		operand, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+
+		return filterNot{operand: operand}, nil
+	case p.peekOperator("("):
+		p.pos++
+
+		inner, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+
+		if !p.peekOperator(")") {
+			return nil, errors.New("missing closing parenthesis")
+		}
+
+		p.pos++
+
+		return inner, nil
+	}
+
+	return p.parseCompare()
+}
+
+func (p *filterParser) parseCompare() (filterNode, error) {
+	if p.pos >= len(p.tokens) {
+		return nil, errors.New("unexpected end of expression")
+	}
+
+	field := p.tokens[p.pos]
+	if field.kind != filterTokenValue {
+		return nil, fmt.Errorf("expected a field name, got %q", field.text)
+	}
+
+	if !slices.Contains(filterFields, field.text) {
+		return nil, fmt.Errorf("unknown field %q (must be one of: %s)", field.text, strings.Join(filterFields, ", "))
+	}
+
+	p.pos++
+
+	var negate bool
+
+	switch {
+	case p.peekOperator("=="):
+	case p.peekOperator("!="):
+		negate = true
+	default:
+		return nil, fmt.Errorf("expected == or != after %q", field.text)
+	}
+
+	p.pos++
+
+	if p.pos >= len(p.tokens) || p.tokens[p.pos].kind != filterTokenValue {
+		return nil, fmt.Errorf("expected a value for %q", field.text)
+	}
+
+	value := p.tokens[p.pos].text
+	p.pos++
+
+	return filterCompare{field: field.text, value: value, negate: negate}, nil
+}
+
 // meetsMinSeverity returns true if the given impact level is at or above the
 // minimum severity threshold.
 func meetsMinSeverity(impact result.Impact, minLevel SeverityLevel) bool {
@@ -443,6 +913,7 @@ type VersionInfo struct {
 	RHOAICurrentVersion string
 	RHOAITargetVersion  string // empty in lint mode
 	OpenShiftVersion    string
+	Flavor              version.Flavor // FlavorUnknown when not detected
 }
 
 // TableOutputOptions configures the behavior of OutputTable.
@@ -454,17 +925,41 @@ type TableOutputOptions struct {
 	ShowImpactedObjects bool
 
 	// NamespaceRequesters maps namespace names to their openshift.io/requester annotation value.
-	// Used when ShowImpactedObjects is true to display the requester for each namespace group.
+	// Used when ShowImpactedObjects is true or GroupBy is GroupByNamespace to display the
+	// requester for each namespace group.
 	NamespaceRequesters map[string]string
+
+	// GroupBy adds an aggregation of the findings after the summary (e.g. per namespace).
+	GroupBy GroupBy
+
+	// SortBy selects the order of the findings; SortByScore lists them in a single table
+	// instead of one per check group.
+	SortBy SortBy
+
+	// Wide adds the REMEDIATION and IMPACTED-COUNT columns to the table.
+	Wide bool
+
+	// Messages formats the group summaries; the zero value formats English.
+	Messages messages.Printer
+
+	// GroupRenderers render impacted objects grouped by annotation where one applies, in
+	// place of the check's own formatting. Nil lists them by namespace.
+	GroupRenderers *check.GroupRendererRegistry
 }
 
-// OutputJSON outputs diagnostic results in List format.
+// OutputJSON outputs diagnostic results in List format. Namespace summaries, if any, are
+// included as the namespaces field (see SummarizeByNamespace) and group summaries as the
+// summaries field (see SummarizeByGroup). Annotations, if any, describe the run (see
+// result.AnnotationClusterVersionSource).
 func OutputJSON(
 	out io.Writer,
 	results []check.CheckExecution,
+	p messages.Printer,
 	clusterVersion *string,
 	targetVersion *string,
 	openShiftVersion *string,
+	namespaces []result.NamespaceSummary,
+	annotations map[string]string,
 ) error {
 	// Create the list
 	list := result.NewDiagnosticResultList(clusterVersion, targetVersion, openShiftVersion)
@@ -477,6 +972,9 @@ func OutputJSON(
 	}
 
 	list.ComputeStatus()
+	list.Namespaces = namespaces
+	list.Summaries = SummarizeByGroup(results, p)
+	list.Annotations = annotations
 
 	renderer := printerjson.NewRenderer[*result.DiagnosticResultList](
 		printerjson.WithWriter[*result.DiagnosticResultList](out),
@@ -489,13 +987,44 @@ func OutputJSON(
 	return nil
 }
 
-// OutputYAML outputs diagnostic results in List format.
+// newResultList builds a DiagnosticResultList of the non-nil results, with its status and
+// group summaries computed.
+func newResultList(
+	results []check.CheckExecution,
+	p messages.Printer,
+	clusterVersion *string,
+	targetVersion *string,
+	openShiftVersion *string,
+	annotations map[string]string,
+) *result.DiagnosticResultList {
+	list := result.NewDiagnosticResultList(clusterVersion, targetVersion, openShiftVersion)
+
+	for _, exec := range results {
+		if exec.Result != nil {
+			list.Results = append(list.Results, exec.Result)
+		}
+	}
+
+	list.ComputeStatus()
+	list.Summaries = SummarizeByGroup(results, p)
+	list.Annotations = annotations
+
+	return list
+}
+
+// OutputYAML outputs diagnostic results in List format. Namespace summaries, if any, are
+// included as the namespaces field (see SummarizeByNamespace) and group summaries as the
+// summaries field (see SummarizeByGroup). Annotations, if any, describe the run (see
+// result.AnnotationClusterVersionSource).
 func OutputYAML(
 	out io.Writer,
 	results []check.CheckExecution,
+	p messages.Printer,
 	clusterVersion *string,
 	targetVersion *string,
 	openShiftVersion *string,
+	namespaces []result.NamespaceSummary,
+	annotations map[string]string,
 ) error {
 	// Create the list
 	list := result.NewDiagnosticResultList(clusterVersion, targetVersion, openShiftVersion)
@@ -508,6 +1037,9 @@ func OutputYAML(
 	}
 
 	list.ComputeStatus()
+	list.Namespaces = namespaces
+	list.Summaries = SummarizeByGroup(results, p)
+	list.Annotations = annotations
 
 	renderer := printeryaml.NewRenderer[*result.DiagnosticResultList](
 		printeryaml.WithWriter[*result.DiagnosticResultList](out),
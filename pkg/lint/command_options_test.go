@@ -148,6 +148,36 @@ func TestValidateCheckSelector(t *testing.T) {
 			selector: "\\",
 			wantErr:  true,
 		},
+		{
+			name:     "exclusion valid",
+			selector: "!workloads.notebook.*",
+			wantErr:  false,
+		},
+		{
+			name:     "tag valid",
+			selector: "@fast",
+			wantErr:  false,
+		},
+		{
+			name:     "excluded tag valid",
+			selector: "!@blocking-only",
+			wantErr:  false,
+		},
+		{
+			name:     "exclusion without pattern invalid",
+			selector: "!",
+			wantErr:  true,
+		},
+		{
+			name:     "tag without name invalid",
+			selector: "@",
+			wantErr:  true,
+		},
+		{
+			name:     "invalid excluded glob pattern",
+			selector: "![",
+			wantErr:  true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -367,3 +397,105 @@ func TestFilterBySeverity_NilResultSkipped(t *testing.T) {
 	g.Expect(filtered).To(HaveLen(1))
 	g.Expect(filtered[0].Result.Kind).To(Equal("kserve"))
 }
+
+func TestParseResultFilter(t *testing.T) {
+	tests := []struct {
+		name    string
+		expr    string
+		wantNil bool
+		wantErr string
+	}{
+		{name: "empty expression", expr: "  ", wantNil: true},
+		{name: "single comparison", expr: "impact==blocking"},
+		{name: "and/or with parentheses", expr: "impact==blocking && (group==workload || kind!=kserve)"},
+		{name: "negation and quoted value", expr: `!(reason == "Some Reason") && name=='a b'`},
+		{name: "unknown field", expr: "severity==blocking", wantErr: `unknown field "severity"`},
+		{name: "missing operator", expr: "impact blocking", wantErr: "expected == or !="},
+		{name: "missing value", expr: "impact==", wantErr: `expected a value for "impact"`},
+		{name: "unbalanced parenthesis", expr: "(impact==blocking", wantErr: "missing closing parenthesis"},
+		{name: "trailing tokens", expr: "impact==blocking kind==kserve", wantErr: `unexpected "kind"`},
+		{name: "unterminated quote", expr: `name=="abc`, wantErr: "unterminated quoted value"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			filter, err := lint.ParseResultFilter(tt.expr)
+
+			switch {
+			case tt.wantErr != "":
+				g.Expect(err).To(MatchError(ContainSubstring(tt.wantErr)))
+			case tt.wantNil:
+				g.Expect(err).ToNot(HaveOccurred())
+				g.Expect(filter).To(BeNil())
+			default:
+				g.Expect(err).ToNot(HaveOccurred())
+				g.Expect(filter).ToNot(BeNil())
+			}
+		})
+	}
+}
+
+func TestFilterByExpression(t *testing.T) {
+	results := []check.CheckExecution{
+		makeExec("kserve",
+			makeCondition(result.ImpactBlocking, "kserve-blocking"),
+			makeCondition(result.ImpactNone, "kserve-passed"),
+		),
+		makeExec("kueue", makeCondition(result.ImpactAdvisory, "kueue-advisory")),
+		makeExec("dashboard", makeCondition(result.ImpactNone, "dashboard-passed")),
+	}
+
+	tests := []struct {
+		name string
+		expr string
+		want []string
+	}{
+		{name: "impact", expr: "impact==blocking", want: []string{"kserve-blocking"}},
+		{name: "passed conditions", expr: "impact==none", want: []string{"kserve-passed", "dashboard-passed"}},
+		{name: "and", expr: "kind==kserve && impact!=none", want: []string{"kserve-blocking"}},
+		{name: "or", expr: "impact==blocking || kind==kueue", want: []string{"kserve-blocking", "kueue-advisory"}},
+		{name: "not", expr: "!(impact==none)", want: []string{"kserve-blocking", "kueue-advisory"}},
+		{name: "glob", expr: "kind==k*e", want: []string{"kserve-blocking", "kserve-passed", "kueue-advisory"}},
+		{name: "case insensitive", expr: "impact==BLOCKING", want: []string{"kserve-blocking"}},
+		{name: "no match", expr: "group==workload", want: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			filter, err := lint.ParseResultFilter(tt.expr)
+			g.Expect(err).ToNot(HaveOccurred())
+
+			var messages []string
+			for _, exec := range lint.FilterByExpression(results, filter) {
+				g.Expect(exec.Result.Status.Conditions).ToNot(BeEmpty())
+
+				for _, cond := range exec.Result.Status.Conditions {
+					messages = append(messages, cond.Message)
+				}
+			}
+
+			g.Expect(messages).To(Equal(tt.want))
+		})
+	}
+
+	t.Run("nil filter returns results unchanged", func(t *testing.T) {
+		g := NewWithT(t)
+
+		g.Expect(lint.FilterByExpression(results, nil)).To(HaveLen(3))
+	})
+
+	t.Run("does not mutate original", func(t *testing.T) {
+		g := NewWithT(t)
+
+		filter, err := lint.ParseResultFilter("impact==blocking")
+		g.Expect(err).ToNot(HaveOccurred())
+
+		_ = lint.FilterByExpression(results, filter)
+
+		g.Expect(results[0].Result.Status.Conditions).To(HaveLen(2))
+	})
+}
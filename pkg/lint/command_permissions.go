@@ -0,0 +1,102 @@
+package lint
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/spf13/pflag"
+
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/opendatahub-io/odh-cli/pkg/api"
+	"github.com/opendatahub-io/odh-cli/pkg/cmd"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	printerjson "github.com/opendatahub-io/odh-cli/pkg/printer/json"
+	printeryaml "github.com/opendatahub-io/odh-cli/pkg/printer/yaml"
+	"github.com/opendatahub-io/odh-cli/pkg/util/iostreams"
+)
+
+var _ cmd.Command = (*PermissionsCommand)(nil)
+
+// PermissionsCommand prints the minimal ClusterRole needed to run the selected checks.
+// It never contacts a cluster.
+type PermissionsCommand struct {
+	IO iostreams.Interface
+
+	// OutputFormat specifies the output format (yaml, json)
+	OutputFormat OutputFormat
+
+	// CheckSelectors selects the checks the ClusterRole must cover (glob patterns, repeatable)
+	CheckSelectors []string
+
+	// Name is the name of the generated ClusterRole
+	Name string
+
+	registry *check.CheckRegistry
+}
+
+// NewPermissionsCommand creates a new PermissionsCommand with defaults.
+func NewPermissionsCommand(streams genericiooptions.IOStreams) *PermissionsCommand {
+	return &PermissionsCommand{
+		IO:             iostreams.NewIOStreams(streams.In, streams.Out, streams.ErrOut),
+		OutputFormat:   OutputFormatYAML,
+		CheckSelectors: []string{"*"},
+		Name:           DefaultClusterRoleName,
+		registry:       newDefaultRegistry(),
+	}
+}
+
+// AddFlags registers command-specific flags with the provided FlagSet.
+func (c *PermissionsCommand) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVarP((*string)(&c.OutputFormat), "output", "o", string(OutputFormatYAML), flagDescPermissionsOutput)
+	_ = fs.SetAnnotation("output", api.AnnotationValidValues, []string{"yaml", "json"})
+	fs.StringArrayVar(&c.CheckSelectors, "checks", []string{"*"}, flagDescChecks)
+	fs.StringVar(&c.Name, "name", DefaultClusterRoleName, flagDescClusterRoleName)
+}
+
+// Complete performs no setup; the required permissions are static.
+func (c *PermissionsCommand) Complete() error {
+	return nil
+}
+
+// Validate checks the output format, name and selectors.
+func (c *PermissionsCommand) Validate() error {
+	if !slices.Contains([]OutputFormat{OutputFormatYAML, OutputFormatJSON}, c.OutputFormat) {
+		return fmt.Errorf("invalid output format: %s (must be one of: yaml, json)", c.OutputFormat)
+	}
+
+	if c.Name == "" {
+		return errors.New("--name must not be empty")
+	}
+
+	return ValidateCheckSelectors(c.CheckSelectors)
+}
+
+// Run renders the ClusterRole covering the checks matching the selectors.
+func (c *PermissionsCommand) Run(_ context.Context) error {
+	required, err := RequiredPermissions(c.registry, c.CheckSelectors)
+	if err != nil {
+		return err
+	}
+
+	role := NewClusterRole(c.Name, required)
+
+	if c.OutputFormat == OutputFormatJSON {
+		renderer := printerjson.NewRenderer[*rbacv1.ClusterRole](printerjson.WithWriter[*rbacv1.ClusterRole](c.IO.Out()))
+		if err := renderer.Render(role); err != nil {
+			return fmt.Errorf("rendering JSON output: %w", err)
+		}
+
+		return nil
+	}
+
+	renderer := printeryaml.NewRenderer[*rbacv1.ClusterRole](printeryaml.WithWriter[*rbacv1.ClusterRole](c.IO.Out()))
+	if err := renderer.Render(role); err != nil {
+		return fmt.Errorf("rendering YAML output: %w", err)
+	}
+
+	return nil
+}
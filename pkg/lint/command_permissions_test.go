@@ -0,0 +1,69 @@
+package lint_test
+
+import (
+	"bytes"
+	"testing"
+
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+	"sigs.k8s.io/yaml"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint"
+
+	. "github.com/onsi/gomega"
+)
+
+func newPermissionsTestCommand(out *bytes.Buffer) *lint.PermissionsCommand {
+	return lint.NewPermissionsCommand(genericiooptions.IOStreams{
+		In: &bytes.Buffer{}, Out: out, ErrOut: &bytes.Buffer{},
+	})
+}
+
+func TestPermissionsCommand(t *testing.T) {
+	t.Run("should print a read-only ClusterRole", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var out bytes.Buffer
+		command := newPermissionsTestCommand(&out)
+		command.Name = "lint-reader"
+
+		g.Expect(command.Complete()).To(Succeed())
+		g.Expect(command.Validate()).To(Succeed())
+		g.Expect(command.Run(t.Context())).To(Succeed())
+
+		var role rbacv1.ClusterRole
+		g.Expect(yaml.Unmarshal(out.Bytes(), &role)).To(Succeed())
+		g.Expect(role.Kind).To(Equal("ClusterRole"))
+		g.Expect(role.Name).To(Equal("lint-reader"))
+		g.Expect(role.Rules).ToNot(BeEmpty())
+
+		for _, rule := range role.Rules {
+			g.Expect(rule.Verbs).To(Equal([]string{"get", "list"}))
+		}
+	})
+
+	t.Run("should cover fewer resources for narrower selectors", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var all, workloads bytes.Buffer
+
+		command := newPermissionsTestCommand(&all)
+		g.Expect(command.Run(t.Context())).To(Succeed())
+
+		command = newPermissionsTestCommand(&workloads)
+		command.CheckSelectors = []string{"workloads.notebook.*"}
+		g.Expect(command.Run(t.Context())).To(Succeed())
+
+		g.Expect(workloads.Len()).To(BeNumerically("<", all.Len()))
+		g.Expect(workloads.String()).To(ContainSubstring("notebooks"))
+	})
+
+	t.Run("should reject unsupported output formats", func(t *testing.T) {
+		g := NewWithT(t)
+
+		command := newPermissionsTestCommand(&bytes.Buffer{})
+		command.OutputFormat = lint.OutputFormatTable
+
+		g.Expect(command.Validate()).To(MatchError(ContainSubstring("invalid output format")))
+	})
+}
@@ -0,0 +1,217 @@
+package lint
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/spf13/pflag"
+
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/opendatahub-io/odh-cli/pkg/api"
+	"github.com/opendatahub-io/odh-cli/pkg/cmd"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	printerjson "github.com/opendatahub-io/odh-cli/pkg/printer/json"
+	printeryaml "github.com/opendatahub-io/odh-cli/pkg/printer/yaml"
+	"github.com/opendatahub-io/odh-cli/pkg/util/iostreams"
+)
+
+var _ cmd.Command = (*RBACCommand)(nil)
+
+// DefaultServiceAccount is the service account bound by `lint rbac` when no subject is given.
+const DefaultServiceAccount = "odh-lint:odh-lint"
+
+// RBACCommand prints a ClusterRole and ClusterRoleBinding granting the read access needed to run
+// the selected checks to a user, group or service account. It never contacts a cluster.
+type RBACCommand struct {
+	IO iostreams.Interface
+
+	// OutputFormat specifies the output format (yaml, json)
+	OutputFormat OutputFormat
+
+	// CheckSelectors selects the checks the ClusterRole must cover (glob patterns, repeatable)
+	CheckSelectors []string
+
+	// Name is the name of the generated ClusterRole and ClusterRoleBinding
+	Name string
+
+	// ServiceAccounts are bound as "namespace:name"; defaults to DefaultServiceAccount when no
+	// subject is given
+	ServiceAccounts []string
+
+	// Users and Groups are bound by name
+	Users  []string
+	Groups []string
+
+	registry *check.CheckRegistry
+}
+
+// NewRBACCommand creates a new RBACCommand with defaults.
+func NewRBACCommand(streams genericiooptions.IOStreams) *RBACCommand {
+	return &RBACCommand{
+		IO:             iostreams.NewIOStreams(streams.In, streams.Out, streams.ErrOut),
+		OutputFormat:   OutputFormatYAML,
+		CheckSelectors: []string{"*"},
+		Name:           DefaultClusterRoleName,
+		registry:       newDefaultRegistry(),
+	}
+}
+
+// AddFlags registers command-specific flags with the provided FlagSet.
+func (c *RBACCommand) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVarP((*string)(&c.OutputFormat), "output", "o", string(OutputFormatYAML), flagDescPermissionsOutput)
+	_ = fs.SetAnnotation("output", api.AnnotationValidValues, []string{"yaml", "json"})
+	fs.StringArrayVar(&c.CheckSelectors, "checks", []string{"*"}, flagDescChecks)
+	fs.StringVar(&c.Name, "name", DefaultClusterRoleName, flagDescRBACName)
+	fs.StringArrayVar(&c.ServiceAccounts, "serviceaccount", nil, flagDescRBACServiceAccount)
+	fs.StringArrayVar(&c.Users, "user", nil, flagDescRBACUser)
+	fs.StringArrayVar(&c.Groups, "group", nil, flagDescRBACGroup)
+}
+
+// Complete binds the default service account when no subject is given.
+func (c *RBACCommand) Complete() error {
+	if len(c.ServiceAccounts) == 0 && len(c.Users) == 0 && len(c.Groups) == 0 {
+		c.ServiceAccounts = []string{DefaultServiceAccount}
+	}
+
+	return nil
+}
+
+// Validate checks the output format, name, subjects and selectors.
+func (c *RBACCommand) Validate() error {
+	if !slices.Contains([]OutputFormat{OutputFormatYAML, OutputFormatJSON}, c.OutputFormat) {
+		return fmt.Errorf("invalid output format: %s (must be one of: yaml, json)", c.OutputFormat)
+	}
+
+	if c.Name == "" {
+		return errors.New("--name must not be empty")
+	}
+
+	for _, sa := range c.ServiceAccounts {
+		if _, _, err := parseServiceAccount(sa); err != nil {
+			return err
+		}
+	}
+
+	return ValidateCheckSelectors(c.CheckSelectors)
+}
+
+// Run renders a List holding the ClusterRole and its ClusterRoleBinding.
+func (c *RBACCommand) Run(_ context.Context) error {
+	required, err := RequiredPermissions(c.registry, c.CheckSelectors)
+	if err != nil {
+		return err
+	}
+
+	role := NewClusterRole(c.Name, required)
+
+	binding, err := c.newClusterRoleBinding(role.Name)
+	if err != nil {
+		return err
+	}
+
+	list, err := newObjectList(role, binding)
+	if err != nil {
+		return err
+	}
+
+	if c.OutputFormat == OutputFormatJSON {
+		renderer := printerjson.NewRenderer[*metav1.List](printerjson.WithWriter[*metav1.List](c.IO.Out()))
+		if err := renderer.Render(list); err != nil {
+			return fmt.Errorf("rendering JSON output: %w", err)
+		}
+
+		return nil
+	}
+
+	renderer := printeryaml.NewRenderer[*metav1.List](printeryaml.WithWriter[*metav1.List](c.IO.Out()))
+	if err := renderer.Render(list); err != nil {
+		return fmt.Errorf("rendering YAML output: %w", err)
+	}
+
+	return nil
+}
+
+// newClusterRoleBinding binds the ClusterRole to the configured subjects.
+func (c *RBACCommand) newClusterRoleBinding(roleName string) (*rbacv1.ClusterRoleBinding, error) {
+	binding := &rbacv1.ClusterRoleBinding{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: rbacv1.SchemeGroupVersion.String(),
+			Kind:       "ClusterRoleBinding",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: roleName,
+		},
+		RoleRef: rbacv1.RoleRef{
+			APIGroup: rbacv1.GroupName,
+			Kind:     "ClusterRole",
+			Name:     roleName,
+		},
+	}
+
+	for _, sa := range c.ServiceAccounts {
+		namespace, name, err := parseServiceAccount(sa)
+		if err != nil {
+			return nil, err
+		}
+
+		binding.Subjects = append(binding.Subjects, rbacv1.Subject{
+			Kind:      rbacv1.ServiceAccountKind,
+			Namespace: namespace,
+			Name:      name,
+		})
+	}
+
+	for _, user := range c.Users {
+		binding.Subjects = append(binding.Subjects, rbacv1.Subject{
+			Kind:     rbacv1.UserKind,
+			APIGroup: rbacv1.GroupName,
+			Name:     user,
+		})
+	}
+
+	for _, group := range c.Groups {
+		binding.Subjects = append(binding.Subjects, rbacv1.Subject{
+			Kind:     rbacv1.GroupKind,
+			APIGroup: rbacv1.GroupName,
+			Name:     group,
+		})
+	}
+
+	return binding, nil
+}
+
+// parseServiceAccount splits a "namespace:name" service account reference.
+func parseServiceAccount(ref string) (string, string, error) {
+	namespace, name, ok := strings.Cut(ref, ":")
+	if !ok || namespace == "" || name == "" {
+		return "", "", fmt.Errorf("invalid --serviceaccount %q (must be namespace:name)", ref)
+	}
+
+	return namespace, name, nil
+}
+
+// newObjectList wraps objects in a v1 List accepted by `kubectl apply -f`.
+func newObjectList(objects ...runtime.Object) (*metav1.List, error) {
+	list := &metav1.List{
+		TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "List"},
+	}
+
+	for _, obj := range objects {
+		raw, err := json.Marshal(obj)
+		if err != nil {
+			return nil, fmt.Errorf("marshaling %s: %w", obj.GetObjectKind().GroupVersionKind().Kind, err)
+		}
+
+		list.Items = append(list.Items, runtime.RawExtension{Raw: raw})
+	}
+
+	return list, nil
+}
@@ -0,0 +1,99 @@
+package lint_test
+
+import (
+	"bytes"
+	"testing"
+
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+	"sigs.k8s.io/yaml"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint"
+
+	. "github.com/onsi/gomega"
+)
+
+func newRBACTestCommand(out *bytes.Buffer) *lint.RBACCommand {
+	return lint.NewRBACCommand(genericiooptions.IOStreams{
+		In: &bytes.Buffer{}, Out: out, ErrOut: &bytes.Buffer{},
+	})
+}
+
+func decodeRBACList(g Gomega, data []byte) (*rbacv1.ClusterRole, *rbacv1.ClusterRoleBinding) {
+	var list metav1.List
+	g.Expect(yaml.Unmarshal(data, &list)).To(Succeed())
+	g.Expect(list.Kind).To(Equal("List"))
+	g.Expect(list.Items).To(HaveLen(2))
+
+	var role rbacv1.ClusterRole
+	g.Expect(yaml.Unmarshal(list.Items[0].Raw, &role)).To(Succeed())
+
+	var binding rbacv1.ClusterRoleBinding
+	g.Expect(yaml.Unmarshal(list.Items[1].Raw, &binding)).To(Succeed())
+
+	return &role, &binding
+}
+
+func TestRBACCommand(t *testing.T) {
+	t.Run("should bind the ClusterRole to the default service account", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var out bytes.Buffer
+		command := newRBACTestCommand(&out)
+
+		g.Expect(command.Complete()).To(Succeed())
+		g.Expect(command.Validate()).To(Succeed())
+		g.Expect(command.Run(t.Context())).To(Succeed())
+
+		role, binding := decodeRBACList(g, out.Bytes())
+		g.Expect(role.Kind).To(Equal("ClusterRole"))
+		g.Expect(role.Name).To(Equal(lint.DefaultClusterRoleName))
+		g.Expect(role.Rules).ToNot(BeEmpty())
+
+		g.Expect(binding.Kind).To(Equal("ClusterRoleBinding"))
+		g.Expect(binding.RoleRef).To(Equal(rbacv1.RoleRef{
+			APIGroup: rbacv1.GroupName,
+			Kind:     "ClusterRole",
+			Name:     lint.DefaultClusterRoleName,
+		}))
+		g.Expect(binding.Subjects).To(ConsistOf(rbacv1.Subject{
+			Kind:      rbacv1.ServiceAccountKind,
+			Namespace: "odh-lint",
+			Name:      "odh-lint",
+		}))
+	})
+
+	t.Run("should bind the given subjects only", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var out bytes.Buffer
+		command := newRBACTestCommand(&out)
+		command.Name = "ci-lint"
+		command.ServiceAccounts = []string{"ci:runner"}
+		command.Groups = []string{"upgrade-admins"}
+		command.OutputFormat = lint.OutputFormatJSON
+
+		g.Expect(command.Complete()).To(Succeed())
+		g.Expect(command.Validate()).To(Succeed())
+		g.Expect(command.Run(t.Context())).To(Succeed())
+
+		role, binding := decodeRBACList(g, out.Bytes())
+		g.Expect(role.Name).To(Equal("ci-lint"))
+		g.Expect(binding.RoleRef.Name).To(Equal("ci-lint"))
+		g.Expect(binding.Subjects).To(ConsistOf(
+			rbacv1.Subject{Kind: rbacv1.ServiceAccountKind, Namespace: "ci", Name: "runner"},
+			rbacv1.Subject{Kind: rbacv1.GroupKind, APIGroup: rbacv1.GroupName, Name: "upgrade-admins"},
+		))
+	})
+
+	t.Run("should reject malformed service accounts", func(t *testing.T) {
+		g := NewWithT(t)
+
+		command := newRBACTestCommand(&bytes.Buffer{})
+		command.ServiceAccounts = []string{"odh-lint"}
+
+		g.Expect(command.Complete()).To(Succeed())
+		g.Expect(command.Validate()).To(MatchError(ContainSubstring("must be namespace:name")))
+	})
+}
@@ -2,6 +2,9 @@ package lint_test
 
 import (
 	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/spf13/pflag"
@@ -86,6 +89,56 @@ func TestUpgradeMode_WithVersionFlag(t *testing.T) {
 	})
 }
 
+func TestCommand_LabelSelector(t *testing.T) {
+	newCommand := func(selector string) *lint.Command {
+		command := lint.NewCommand(genericiooptions.IOStreams{
+			In: &bytes.Buffer{}, Out: &bytes.Buffer{}, ErrOut: &bytes.Buffer{},
+		}, testConfigFlags())
+		command.TargetVersion = "3.0.0"
+		command.LabelSelector = selector
+
+		return command
+	}
+
+	t.Run("should accept a valid selector", func(t *testing.T) {
+		g := NewWithT(t)
+
+		g.Expect(newCommand("app=team-a,tier!=dev").Validate()).To(Succeed())
+	})
+
+	t.Run("should reject an invalid selector", func(t *testing.T) {
+		g := NewWithT(t)
+
+		g.Expect(newCommand("app in team-a").Validate()).To(MatchError(ContainSubstring("invalid selector")))
+	})
+}
+
+func TestCommand_ValidateOutput(t *testing.T) {
+	newCommand := func(format lint.OutputFormat) *lint.Command {
+		command := lint.NewCommand(genericiooptions.IOStreams{
+			In: &bytes.Buffer{}, Out: &bytes.Buffer{}, ErrOut: &bytes.Buffer{},
+		}, testConfigFlags())
+		command.OutputFormat = format
+		command.ValidateOutput = true
+
+		return command
+	}
+
+	t.Run("should accept structured output", func(t *testing.T) {
+		g := NewWithT(t)
+
+		g.Expect(newCommand(lint.OutputFormatJSON).Validate()).To(Succeed())
+		g.Expect(newCommand(lint.OutputFormatYAML).Validate()).To(Succeed())
+	})
+
+	t.Run("should reject other output formats", func(t *testing.T) {
+		g := NewWithT(t)
+
+		g.Expect(newCommand(lint.OutputFormatTable).Validate()).To(
+			MatchError(ContainSubstring("--validate-output is not supported")))
+	})
+}
+
 // T024: Test CheckTarget.CurrentVersion == CheckTarget.TargetVersion in lint mode.
 func TestLintMode_CheckTargetVersionMatches(t *testing.T) {
 	t.Run("lint mode should pass same version for CurrentVersion and TargetVersion", func(t *testing.T) {
@@ -488,3 +541,142 @@ func TestCommand_StdinInput(t *testing.T) {
 		g.Expect(err.Error()).To(ContainSubstring("invalid"))
 	})
 }
+
+const (
+	fixtureSnapshotDSC = `
+apiVersion: datasciencecluster.opendatahub.io/v1
+kind: DataScienceCluster
+metadata:
+  name: default-dsc
+status:
+  release:
+    version: 2.25.0
+`
+
+	fixtureSnapshotDSCI = `
+apiVersion: dscinitialization.opendatahub.io/v1
+kind: DSCInitialization
+metadata:
+  name: default-dsci
+spec:
+  applicationsNamespace: redhat-ods-applications
+status:
+  release:
+    version: 2.25.0
+`
+)
+
+func TestCommand_FromDir(t *testing.T) {
+	t.Run("should run checks against a snapshot directory", func(t *testing.T) {
+		g := NewWithT(t)
+
+		dir := t.TempDir()
+		g.Expect(os.WriteFile(filepath.Join(dir, "dsc.yaml"), []byte(fixtureSnapshotDSC), 0o600)).To(Succeed())
+		g.Expect(os.WriteFile(filepath.Join(dir, "dsci.yaml"), []byte(fixtureSnapshotDSCI), 0o600)).To(Succeed())
+
+		var out, errOut bytes.Buffer
+		streams := genericiooptions.IOStreams{In: &bytes.Buffer{}, Out: &out, ErrOut: &errOut}
+
+		command := lint.NewCommand(streams, testConfigFlags())
+		command.FromDir = dir
+		command.TargetVersion = "3.0.0"
+		command.OutputFormat = lint.OutputFormatJSON
+
+		g.Expect(command.Complete()).To(Succeed())
+		g.Expect(command.Validate()).To(Succeed())
+		g.Expect(command.Client).To(BeNil())
+
+		// Findings may produce a non-zero exit error; the report itself must still be rendered.
+		_ = command.Run(t.Context())
+
+		var report map[string]any
+		g.Expect(json.Unmarshal(out.Bytes(), &report)).To(Succeed())
+		g.Expect(report).To(HaveKeyWithValue("clusterVersion", "2.25.0"))
+		g.Expect(report).To(HaveKey("results"))
+	})
+
+	t.Run("should reject --fix", func(t *testing.T) {
+		g := NewWithT(t)
+
+		command := lint.NewCommand(genericiooptions.IOStreams{
+			In: &bytes.Buffer{}, Out: &bytes.Buffer{}, ErrOut: &bytes.Buffer{},
+		}, testConfigFlags())
+		command.FromDir = t.TempDir()
+		command.Fix = true
+
+		g.Expect(command.Validate()).To(MatchError(ContainSubstring("--from-dir")))
+	})
+
+	t.Run("should fail for a missing directory", func(t *testing.T) {
+		g := NewWithT(t)
+
+		command := lint.NewCommand(genericiooptions.IOStreams{
+			In: &bytes.Buffer{}, Out: &bytes.Buffer{}, ErrOut: &bytes.Buffer{},
+		}, testConfigFlags())
+		command.FromDir = filepath.Join(t.TempDir(), "missing")
+
+		g.Expect(command.Complete()).To(MatchError(ContainSubstring("snapshot")))
+	})
+}
+
+func TestCommand_FixRequiresTargetVersion(t *testing.T) {
+	g := NewWithT(t)
+
+	command := lint.NewCommand(genericiooptions.IOStreams{
+		In: &bytes.Buffer{}, Out: &bytes.Buffer{}, ErrOut: &bytes.Buffer{},
+	}, testConfigFlags())
+	command.Fix = true
+
+	g.Expect(command.Validate()).To(MatchError(ContainSubstring("--fix requires --target-version")))
+
+	command.TargetVersion = "3.0"
+	g.Expect(command.Validate()).To(Succeed())
+}
+
+func TestCommand_QuietStructuredOutput(t *testing.T) {
+	newCommand := func(output lint.OutputFormat) (*lint.Command, *bytes.Buffer, *bytes.Buffer) {
+		var out, errOut bytes.Buffer
+
+		command := lint.NewCommand(genericiooptions.IOStreams{
+			In: &bytes.Buffer{}, Out: &out, ErrOut: &errOut,
+		}, testConfigFlags())
+		command.Quiet = true
+		command.OutputFormat = output
+
+		return command, &out, &errOut
+	}
+
+	t.Run("should keep results on stdout and silence stderr", func(t *testing.T) {
+		g := NewWithT(t)
+
+		command, out, errOut := newCommand(lint.OutputFormatJSON)
+		g.Expect(command.Complete()).To(Succeed())
+
+		command.IO.Errorf("Assessing upgrade readiness")
+		_, _ = command.IO.ErrOut().Write([]byte("level=INFO msg=discovered\n"))
+		_, _ = command.IO.Out().Write([]byte(`{"items":[]}`))
+
+		g.Expect(out.String()).To(Equal(`{"items":[]}`))
+		g.Expect(errOut.String()).To(BeEmpty())
+	})
+
+	t.Run("should suppress table output", func(t *testing.T) {
+		g := NewWithT(t)
+
+		command, out, _ := newCommand(lint.OutputFormatTable)
+		g.Expect(command.Complete()).To(Succeed())
+
+		_, _ = command.IO.Out().Write([]byte("table"))
+
+		g.Expect(out.String()).To(BeEmpty())
+	})
+
+	t.Run("should require --yes with --fix", func(t *testing.T) {
+		g := NewWithT(t)
+
+		command, _, _ := newCommand(lint.OutputFormatJSON)
+		command.Fix = true
+
+		g.Expect(command.Validate()).To(MatchError(ContainSubstring("--fix with --quiet requires --yes")))
+	})
+}
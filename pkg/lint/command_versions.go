@@ -0,0 +1,189 @@
+package lint
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/blang/semver/v4"
+	"github.com/spf13/pflag"
+
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/opendatahub-io/odh-cli/pkg/api"
+	"github.com/opendatahub-io/odh-cli/pkg/cmd"
+	"github.com/opendatahub-io/odh-cli/pkg/output"
+	"github.com/opendatahub-io/odh-cli/pkg/printer/table"
+	"github.com/opendatahub-io/odh-cli/pkg/util/kube/olm"
+	"github.com/opendatahub-io/odh-cli/pkg/util/version"
+)
+
+var _ cmd.Command = (*VersionsCommand)(nil)
+
+const (
+	operatorVersionListKind = "OperatorVersionList"
+
+	msgVersionsEmpty = "No RHOAI or ODH operator versions found in the cluster catalogs"
+)
+
+// OperatorVersionList is the structured output of `lint versions`.
+type OperatorVersionList struct {
+	output.Envelope
+
+	// CurrentVersion is the installed version, empty when it could not be detected.
+	CurrentVersion string `json:"currentVersion,omitempty" yaml:"currentVersion,omitempty"`
+
+	// Latest and Next are the versions --target-version latest and next resolve to.
+	Latest string `json:"latest,omitempty" yaml:"latest,omitempty"`
+	Next   string `json:"next,omitempty"   yaml:"next,omitempty"`
+
+	// Versions are the versions offered by the catalog, oldest first.
+	Versions []olm.CatalogVersion `json:"versions" yaml:"versions"`
+}
+
+type versionRow struct {
+	Version  string
+	Channels string
+	CSV      string
+	Note     string
+}
+
+// VersionsCommand lists the operator versions offered by the cluster's OLM catalog, i.e. the
+// candidates for --target-version.
+type VersionsCommand struct {
+	*SharedOptions
+}
+
+// NewVersionsCommand creates a new VersionsCommand with defaults.
+func NewVersionsCommand(
+	streams genericiooptions.IOStreams,
+	configFlags *genericclioptions.ConfigFlags,
+) *VersionsCommand {
+	return &VersionsCommand{
+		SharedOptions: NewSharedOptions(streams, configFlags),
+	}
+}
+
+// AddFlags registers command-specific flags with the provided FlagSet.
+func (c *VersionsCommand) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVarP((*string)(&c.OutputFormat), "output", "o", string(OutputFormatTable), flagDescVersionsOutput)
+	_ = fs.SetAnnotation("output", api.AnnotationValidValues, []string{"table", "json", "yaml"})
+	fs.DurationVar(&c.Timeout, "timeout", c.Timeout, flagDescTimeout)
+	fs.Float32Var(&c.QPS, "qps", c.QPS, flagDescQPS)
+	fs.IntVar(&c.Burst, "burst", c.Burst, flagDescBurst)
+}
+
+// Complete creates the client.
+func (c *VersionsCommand) Complete() error {
+	// The client may already be set, e.g. by tests
+	if c.Client != nil {
+		return nil
+	}
+
+	if err := c.SharedOptions.Complete(); err != nil {
+		return fmt.Errorf("completing shared options: %w", err)
+	}
+
+	return nil
+}
+
+// Validate checks the output format and timeout.
+func (c *VersionsCommand) Validate() error {
+	if !slices.Contains([]OutputFormat{OutputFormatTable, OutputFormatJSON, OutputFormatYAML}, c.OutputFormat) {
+		return fmt.Errorf("invalid output format: %s (must be one of: table, json, yaml)", c.OutputFormat)
+	}
+
+	if c.Timeout <= 0 {
+		return errors.New("timeout must be greater than 0")
+	}
+
+	return nil
+}
+
+// Run lists the catalog versions and marks the installed version and the versions the
+// latest and next aliases resolve to.
+func (c *VersionsCommand) Run(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, c.Timeout)
+	defer cancel()
+
+	versions, err := olm.ListOperatorCatalogVersions(ctx, c.Client)
+	if err != nil {
+		return fmt.Errorf("listing operator catalog versions: %w", err)
+	}
+
+	list := &OperatorVersionList{
+		Envelope: output.NewEnvelope(operatorVersionListKind, "lint versions"),
+		Versions: versions,
+	}
+
+	if list.Versions == nil {
+		list.Versions = []olm.CatalogVersion{}
+	}
+
+	if latest, err := ResolveTargetVersion(TargetVersionLatest, semver.Version{}, versions); err == nil {
+		list.Latest = latest.String()
+	}
+
+	// The installed version is informational; without it, next cannot be resolved
+	if current, err := version.Detect(ctx, c.Client); err == nil {
+		list.CurrentVersion = current.String()
+
+		if next, err := ResolveTargetVersion(TargetVersionNext, *current, versions); err == nil {
+			list.Next = next.String()
+		}
+	}
+
+	if c.OutputFormat != OutputFormatTable {
+		return renderStructured(c.IO.Out(), c.OutputFormat, list)
+	}
+
+	if len(list.Versions) == 0 {
+		c.IO.Errorf(msgVersionsEmpty)
+
+		return nil
+	}
+
+	return c.printTable(list)
+}
+
+func (c *VersionsCommand) printTable(list *OperatorVersionList) error {
+	renderer := table.NewRenderer(
+		table.WithWriter[versionRow](c.IO.Out()),
+		table.WithHeaders[versionRow]("VERSION", "CHANNELS", "CSV", "NOTE"),
+		table.WithTableOptions[versionRow](table.DefaultTableOptions...),
+	)
+
+	for _, v := range list.Versions {
+		notes := make([]string, 0)
+
+		for _, marker := range []struct{ version, note string }{
+			{list.CurrentVersion, "installed"},
+			{list.Latest, TargetVersionLatest},
+			{list.Next, TargetVersionNext},
+		} {
+			if marker.version == v.Version.String() {
+				notes = append(notes, marker.note)
+			}
+		}
+
+		row := versionRow{
+			Version:  v.Version.String(),
+			Channels: strings.Join(v.Channels, ","),
+			CSV:      v.CSV,
+			Note:     strings.Join(notes, ","),
+		}
+
+		if err := renderer.Append(row); err != nil {
+			return fmt.Errorf("failed to append row: %w", err)
+		}
+	}
+
+	if err := renderer.Render(); err != nil {
+		return fmt.Errorf("failed to render table: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,97 @@
+package lint_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"sigs.k8s.io/yaml"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+
+	. "github.com/onsi/gomega"
+)
+
+// newVersionsCommand returns a VersionsCommand reading the given fixtures.
+func newVersionsCommand(t *testing.T, out, errOut *bytes.Buffer, fixtures ...string) *lint.VersionsCommand {
+	t.Helper()
+
+	objs := make([]runtime.Object, 0, len(fixtures))
+
+	for _, fixture := range fixtures {
+		obj := &unstructured.Unstructured{}
+		NewWithT(t).Expect(yaml.Unmarshal([]byte(fixture), &obj.Object)).To(Succeed())
+		objs = append(objs, obj)
+	}
+
+	dyn := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), map[schema.GroupVersionResource]string{
+		resources.PackageManifest.GVR():      resources.PackageManifest.ListKind(),
+		resources.DataScienceCluster.GVR():   resources.DataScienceCluster.ListKind(),
+		resources.DataScienceClusterV1.GVR(): resources.DataScienceClusterV1.ListKind(),
+		resources.DSCInitialization.GVR():    resources.DSCInitialization.ListKind(),
+		resources.DSCInitializationV1.GVR():  resources.DSCInitializationV1.ListKind(),
+	}, objs...)
+
+	command := lint.NewVersionsCommand(genericiooptions.IOStreams{Out: out, ErrOut: errOut}, testConfigFlags())
+	command.Client = client.NewForTesting(client.TestClientConfig{Dynamic: dyn})
+
+	return command
+}
+
+func TestVersionsCommand(t *testing.T) {
+	t.Run("should list catalog versions as JSON", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var out, errOut bytes.Buffer
+
+		command := newVersionsCommand(t, &out, &errOut, fixtureSnapshotPackageManifest, fixtureSnapshotDSC)
+		command.OutputFormat = lint.OutputFormatJSON
+
+		g.Expect(command.Run(t.Context())).To(Succeed())
+
+		var list map[string]any
+		g.Expect(json.Unmarshal(out.Bytes(), &list)).To(Succeed())
+		g.Expect(list).To(HaveKeyWithValue("kind", "OperatorVersionList"))
+		g.Expect(list).To(HaveKeyWithValue("currentVersion", "2.25.0"))
+		g.Expect(list).To(HaveKeyWithValue("latest", "3.1.0"))
+		g.Expect(list).To(HaveKeyWithValue("next", "3.0.1"))
+		g.Expect(list["versions"]).To(HaveLen(5))
+		g.Expect(list["versions"]).To(ContainElement(And(
+			HaveKeyWithValue("version", "2.25.2"),
+			HaveKeyWithValue("csv", "rhods-operator.2.25.2"),
+			HaveKeyWithValue("channels", ConsistOf("stable-2.25")),
+		)))
+	})
+
+	t.Run("should mark the installed and alias versions in the table", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var out, errOut bytes.Buffer
+
+		command := newVersionsCommand(t, &out, &errOut, fixtureSnapshotPackageManifest, fixtureSnapshotDSC)
+
+		g.Expect(command.Run(t.Context())).To(Succeed())
+		g.Expect(out.String()).To(MatchRegexp(`2\.25\.0\s+stable-2\.25\s+rhods-operator\.2\.25\.0\s+installed`))
+		g.Expect(out.String()).To(MatchRegexp(`3\.0\.1\s+fast-3\.x\s+rhods-operator\.3\.0\.1\s+next`))
+		g.Expect(out.String()).To(MatchRegexp(`3\.1\.0\s+fast-3\.x\s+rhods-operator\.3\.1\.0\s+latest`))
+	})
+
+	t.Run("should report when the catalog has no operator versions", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var out, errOut bytes.Buffer
+
+		command := newVersionsCommand(t, &out, &errOut)
+
+		g.Expect(command.Run(t.Context())).To(Succeed())
+		g.Expect(out.String()).To(BeEmpty())
+		g.Expect(errOut.String()).To(ContainSubstring("No RHOAI or ODH operator versions found"))
+	})
+}
@@ -0,0 +1,249 @@
+package compat
+
+import (
+	"bytes"
+	_ "embed"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"sync"
+
+	"github.com/blang/semver/v4"
+
+	"github.com/opendatahub-io/odh-cli/pkg/util/stdin"
+)
+
+// Operator names used as keys of Release.Operators.
+const (
+	OperatorCertManager = "cert-manager"
+	OperatorNvidiaGPU   = "nvidia-gpu-operator"
+	OperatorAMDGPU      = "amd-gpu-operator"
+)
+
+//go:embed data/matrix.yaml
+var embeddedMatrix []byte
+
+// Matrix is the versioned compatibility matrix: per-release minimum operator versions, removed
+// ServingRuntimes, removed API versions and removed fields. Checks consult it instead of
+// hard-coding release data.
+type Matrix struct {
+	// Releases lists the releases that change compatibility requirements.
+	Releases []Release `json:"releases" yaml:"releases"`
+}
+
+// Release holds the compatibility changes introduced by a major.minor release.
+type Release struct {
+	// Version is the major.minor release, e.g. "3.0".
+	Version string `json:"version" yaml:"version"`
+
+	// Operators maps operator names to the minimum version required from this release onwards.
+	Operators map[string]string `json:"operators,omitempty" yaml:"operators,omitempty"`
+
+	// RemovedRuntimes lists ServingRuntime names no longer shipped from this release onwards.
+	RemovedRuntimes []string `json:"removedRuntimes,omitempty" yaml:"removedRuntimes,omitempty"`
+
+	// RemovedAPIs lists API versions no longer served from this release onwards.
+	RemovedAPIs []RemovedAPI `json:"removedAPIs,omitempty" yaml:"removedAPIs,omitempty"`
+
+	// RemovedFields lists fields, or values of fields, no longer accepted from this release onwards.
+	RemovedFields []RemovedField `json:"removedFields,omitempty" yaml:"removedFields,omitempty"`
+
+	// version is the parsed Version
+	version semver.Version
+}
+
+// RemovedAPI identifies the removed versions of an API resource.
+type RemovedAPI struct {
+	Group    string   `json:"group"    yaml:"group"`
+	Resource string   `json:"resource" yaml:"resource"`
+	Versions []string `json:"versions" yaml:"versions"`
+}
+
+// RemovedField identifies a removed field of an API resource, or removed values of it.
+type RemovedField struct {
+	Group    string `json:"group"    yaml:"group"`
+	Resource string `json:"resource" yaml:"resource"`
+
+	// Path is the jq path of the field, e.g. ".spec.cohort".
+	Path string `json:"path" yaml:"path"`
+
+	// Values lists the removed values of the field; empty when the field itself is removed.
+	Values []string `json:"values,omitempty" yaml:"values,omitempty"`
+}
+
+//nolint:gochecknoglobals // Parsed once from the embedded matrix
+var defaultMatrix = sync.OnceValue(func() *Matrix {
+	m, err := Parse(bytes.NewReader(embeddedMatrix))
+	if err != nil {
+		panic(fmt.Sprintf("parsing embedded compatibility matrix: %v", err))
+	}
+
+	return m
+})
+
+// Default returns the compatibility matrix embedded in the binary.
+func Default() *Matrix {
+	return defaultMatrix()
+}
+
+// Load reads and validates a compatibility matrix file, e.g. one passed with --compat-matrix.
+func Load(path string) (*Matrix, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("opening compatibility matrix: %w", err)
+	}
+	defer func() { _ = f.Close() }()
+
+	m, err := Parse(f)
+	if err != nil {
+		return nil, fmt.Errorf("loading compatibility matrix %s: %w", path, err)
+	}
+
+	return m, nil
+}
+
+// Parse strictly parses a YAML/JSON compatibility matrix and validates its versions.
+// Releases are sorted in ascending version order.
+func Parse(r io.Reader) (*Matrix, error) {
+	var m Matrix
+	if err := stdin.Parse(r, &m); err != nil {
+		return nil, fmt.Errorf("parsing compatibility matrix: %w", err)
+	}
+
+	if len(m.Releases) == 0 {
+		return nil, errors.New("compatibility matrix has no releases")
+	}
+
+	for i := range m.Releases {
+		rel := &m.Releases[i]
+
+		v, err := semver.ParseTolerant(rel.Version)
+		if err != nil {
+			return nil, fmt.Errorf("release %q: invalid version: %w", rel.Version, err)
+		}
+
+		rel.version = semver.Version{Major: v.Major, Minor: v.Minor}
+
+		for name, minimum := range rel.Operators {
+			if _, err := semver.ParseTolerant(minimum); err != nil {
+				return nil, fmt.Errorf("release %q: operator %s: invalid minimum version %q: %w", rel.Version, name, minimum, err)
+			}
+		}
+
+		for _, api := range rel.RemovedAPIs {
+			if api.Resource == "" || len(api.Versions) == 0 {
+				return nil, fmt.Errorf("release %q: removed API %q must set resource and versions", rel.Version, api.Group)
+			}
+		}
+
+		for _, field := range rel.RemovedFields {
+			if field.Resource == "" || field.Path == "" {
+				return nil, fmt.Errorf("release %q: removed field %q must set resource and path", rel.Version, field.Path)
+			}
+		}
+	}
+
+	slices.SortStableFunc(m.Releases, func(a, b Release) int {
+		return a.version.Compare(b.version)
+	})
+
+	return &m, nil
+}
+
+// LatestRelease returns the version of the newest release the matrix covers, identifying
+// the matrix embedded in a build.
+func (m *Matrix) LatestRelease() string {
+	return m.Releases[len(m.Releases)-1].Version
+}
+
+// MinimumOperatorVersion returns the minimum version of the named operator required by the target
+// release: the minimum of the latest release at or before the target that lists the operator.
+func (m *Matrix) MinimumOperatorVersion(name string, target *semver.Version) (semver.Version, bool) {
+	var (
+		minimum semver.Version
+		found   bool
+	)
+
+	for _, rel := range m.releasesUpTo(target) {
+		if raw, ok := rel.Operators[name]; ok {
+			// Versions were validated by Parse
+			minimum, _ = semver.ParseTolerant(raw)
+			found = true
+		}
+	}
+
+	return minimum, found
+}
+
+// RemovedRuntimes returns the ServingRuntime names removed in any release up to the target.
+func (m *Matrix) RemovedRuntimes(target *semver.Version) []string {
+	var removed []string
+
+	for _, rel := range m.releasesUpTo(target) {
+		for _, name := range rel.RemovedRuntimes {
+			if !slices.Contains(removed, name) {
+				removed = append(removed, name)
+			}
+		}
+	}
+
+	return removed
+}
+
+// RemovedAPIVersions returns the versions of the group/resource removed in any release up to the target.
+func (m *Matrix) RemovedAPIVersions(target *semver.Version, group string, resource string) []string {
+	var removed []string
+
+	for _, rel := range m.releasesUpTo(target) {
+		for _, api := range rel.RemovedAPIs {
+			if api.Group != group || api.Resource != resource {
+				continue
+			}
+
+			for _, v := range api.Versions {
+				if !slices.Contains(removed, v) {
+					removed = append(removed, v)
+				}
+			}
+		}
+	}
+
+	return removed
+}
+
+// RemovedFields returns the fields of the group/resource removed in any release up to the target.
+func (m *Matrix) RemovedFields(target *semver.Version, group string, resource string) []RemovedField {
+	var removed []RemovedField
+
+	for _, rel := range m.releasesUpTo(target) {
+		for _, field := range rel.RemovedFields {
+			if field.Group == group && field.Resource == resource {
+				removed = append(removed, field)
+			}
+		}
+	}
+
+	return removed
+}
+
+// releasesUpTo returns the releases whose major.minor is at or before the target.
+// A nil target matches no release.
+func (m *Matrix) releasesUpTo(target *semver.Version) []Release {
+	if target == nil {
+		return nil
+	}
+
+	limit := semver.Version{Major: target.Major, Minor: target.Minor}
+
+	var releases []Release
+
+	for _, rel := range m.Releases {
+		if rel.version.LTE(limit) {
+			releases = append(releases, rel)
+		}
+	}
+
+	return releases
+}
@@ -0,0 +1,169 @@
+package compat_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/blang/semver/v4"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/compat"
+
+	. "github.com/onsi/gomega"
+)
+
+const fixtureMatrix = `
+releases:
+  - version: "3.2"
+    operators:
+      cert-manager: 1.17.0
+    removedRuntimes: [tgis]
+  - version: "3.0"
+    operators:
+      cert-manager: 1.16.0
+      nvidia-gpu-operator: 24.6.0
+    removedRuntimes: [ovms]
+    removedAPIs:
+      - group: kubeflow.org
+        resource: pytorchjobs
+        versions: [v1beta1]
+    removedFields:
+      - group: kueue.x-k8s.io
+        resource: clusterqueues
+        path: .spec.cohort
+      - group: kueue.x-k8s.io
+        resource: clusterqueues
+        path: .spec.flavorFungibility.whenCanBorrow
+        values: [Borrow]
+`
+
+func ver(s string) *semver.Version {
+	v := semver.MustParse(s)
+
+	return &v
+}
+
+func TestDefault(t *testing.T) {
+	g := NewWithT(t)
+
+	m := compat.Default()
+
+	g.Expect(m.Releases).ToNot(BeEmpty())
+
+	minimum, found := m.MinimumOperatorVersion(compat.OperatorCertManager, ver("3.0.0"))
+	g.Expect(found).To(BeTrue())
+	g.Expect(minimum.String()).To(Equal("1.16.0"))
+}
+
+func TestMatrix_MinimumOperatorVersion(t *testing.T) {
+	g := NewWithT(t)
+
+	m, err := compat.Parse(strings.NewReader(fixtureMatrix))
+	g.Expect(err).ToNot(HaveOccurred())
+
+	_, found := m.MinimumOperatorVersion(compat.OperatorCertManager, ver("2.25.0"))
+	g.Expect(found).To(BeFalse())
+
+	minimum, found := m.MinimumOperatorVersion(compat.OperatorCertManager, ver("3.1.0"))
+	g.Expect(found).To(BeTrue())
+	g.Expect(minimum.String()).To(Equal("1.16.0"))
+
+	minimum, found = m.MinimumOperatorVersion(compat.OperatorCertManager, ver("3.3.1"))
+	g.Expect(found).To(BeTrue())
+	g.Expect(minimum.String()).To(Equal("1.17.0"))
+
+	// Minimums carry forward from earlier releases that are not overridden
+	minimum, found = m.MinimumOperatorVersion(compat.OperatorNvidiaGPU, ver("3.2.0"))
+	g.Expect(found).To(BeTrue())
+	g.Expect(minimum.String()).To(Equal("24.6.0"))
+
+	_, found = m.MinimumOperatorVersion(compat.OperatorCertManager, nil)
+	g.Expect(found).To(BeFalse())
+}
+
+func TestMatrix_LatestRelease(t *testing.T) {
+	g := NewWithT(t)
+
+	m, err := compat.Parse(strings.NewReader(fixtureMatrix))
+	g.Expect(err).ToNot(HaveOccurred())
+
+	g.Expect(m.LatestRelease()).To(Equal("3.2"))
+}
+
+func TestMatrix_Removals(t *testing.T) {
+	g := NewWithT(t)
+
+	m, err := compat.Parse(strings.NewReader(fixtureMatrix))
+	g.Expect(err).ToNot(HaveOccurred())
+
+	g.Expect(m.RemovedRuntimes(ver("2.25.0"))).To(BeEmpty())
+	g.Expect(m.RemovedRuntimes(ver("3.0.0"))).To(ConsistOf("ovms"))
+	g.Expect(m.RemovedRuntimes(ver("3.2.0"))).To(ConsistOf("ovms", "tgis"))
+
+	g.Expect(m.RemovedAPIVersions(ver("3.0.0"), "kubeflow.org", "pytorchjobs")).To(ConsistOf("v1beta1"))
+	g.Expect(m.RemovedAPIVersions(ver("3.0.0"), "kubeflow.org", "tfjobs")).To(BeEmpty())
+
+	g.Expect(m.RemovedFields(ver("2.25.0"), "kueue.x-k8s.io", "clusterqueues")).To(BeEmpty())
+	g.Expect(m.RemovedFields(ver("3.2.0"), "kueue.x-k8s.io", "clusterqueues")).To(ConsistOf(
+		compat.RemovedField{Group: "kueue.x-k8s.io", Resource: "clusterqueues", Path: ".spec.cohort"},
+		compat.RemovedField{
+			Group:    "kueue.x-k8s.io",
+			Resource: "clusterqueues",
+			Path:     ".spec.flavorFungibility.whenCanBorrow",
+			Values:   []string{"Borrow"},
+		},
+	))
+	g.Expect(m.RemovedFields(ver("3.0.0"), "kueue.x-k8s.io", "localqueues")).To(BeEmpty())
+}
+
+func TestParse_Invalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		wantErr string
+	}{
+		{name: "empty releases", input: "releases: []", wantErr: "no releases"},
+		{name: "unknown field", input: "releases:\n  - version: \"3.0\"\n    runtimes: [ovms]", wantErr: "unknown field"},
+		{name: "invalid release version", input: "releases:\n  - version: three", wantErr: "invalid version"},
+		{
+			name:    "invalid operator version",
+			input:   "releases:\n  - version: \"3.0\"\n    operators:\n      cert-manager: latest",
+			wantErr: "invalid minimum version",
+		},
+		{
+			name:    "removed API without versions",
+			input:   "releases:\n  - version: \"3.0\"\n    removedAPIs:\n      - group: kubeflow.org\n        resource: tfjobs",
+			wantErr: "must set resource and versions",
+		},
+		{
+			name:    "removed field without path",
+			input:   "releases:\n  - version: \"3.0\"\n    removedFields:\n      - group: kueue.x-k8s.io\n        resource: clusterqueues",
+			wantErr: "must set resource and path",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			_, err := compat.Parse(strings.NewReader(tt.input))
+			g.Expect(err).To(MatchError(ContainSubstring(tt.wantErr)))
+		})
+	}
+}
+
+func TestLoad(t *testing.T) {
+	g := NewWithT(t)
+
+	path := filepath.Join(t.TempDir(), "matrix.yaml")
+	g.Expect(os.WriteFile(path, []byte(fixtureMatrix), 0o600)).To(Succeed())
+
+	m, err := compat.Load(path)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(m.Releases).To(HaveLen(2))
+	g.Expect(m.Releases[0].Version).To(Equal("3.0"))
+
+	_, err = compat.Load(filepath.Join(t.TempDir(), "missing.yaml"))
+	g.Expect(err).To(HaveOccurred())
+}
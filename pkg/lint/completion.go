@@ -0,0 +1,52 @@
+package lint
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/compat"
+)
+
+// CheckSelectorValues returns the --checks values offered by shell completion: the group
+// shortcuts and tags followed by every registered check ID.
+func CheckSelectorValues() []string {
+	values := []string{
+		check.SelectorComponents,
+		check.SelectorDependencies,
+		check.SelectorPlatform,
+		check.SelectorServices,
+		check.SelectorWorkloads,
+		check.SelectorTag + check.TagFast,
+		check.SelectorTag + check.TagBlockingOnly,
+	}
+
+	return append(values, newDefaultRegistry().AllCheckIDs()...)
+}
+
+// TargetVersionValues returns the --target-version values offered by shell completion for the
+// word being completed: the latest/next aliases and the releases of the embedded compatibility
+// matrix. Within a comma-separated list, the versions already given are kept as prefix.
+func TargetVersionValues(toComplete string) []string {
+	releases := compat.Default().Releases
+
+	prefix := ""
+	if i := strings.LastIndex(toComplete, ","); i >= 0 {
+		prefix = toComplete[:i+1]
+	}
+
+	values := make([]string, 0, len(releases)+2)
+
+	// Aliases cannot be part of a list of versions
+	if prefix == "" {
+		values = append(values, TargetVersionLatest, TargetVersionNext)
+	}
+
+	for _, rel := range releases {
+		if !slices.Contains(strings.Split(prefix, ","), rel.Version) {
+			values = append(values, prefix+rel.Version)
+		}
+	}
+
+	return values
+}
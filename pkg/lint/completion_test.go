@@ -0,0 +1,34 @@
+package lint_test
+
+import (
+	"testing"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestCheckSelectorValues(t *testing.T) {
+	g := NewWithT(t)
+
+	values := lint.CheckSelectorValues()
+
+	g.Expect(values).To(ContainElements("components", "workloads", "@fast", "platform.dsc.readiness"))
+}
+
+func TestTargetVersionValues(t *testing.T) {
+	t.Run("should offer the aliases and the compatibility matrix releases", func(t *testing.T) {
+		g := NewWithT(t)
+
+		g.Expect(lint.TargetVersionValues("")).To(ContainElements("latest", "next", "3.0", "3.2"))
+	})
+
+	t.Run("should keep the versions already given in a list", func(t *testing.T) {
+		g := NewWithT(t)
+
+		values := lint.TargetVersionValues("3.0,")
+
+		g.Expect(values).To(ContainElement("3.0,3.2"))
+		g.Expect(values).ToNot(ContainElements("latest", "3.0,3.0"))
+	})
+}
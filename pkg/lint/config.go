@@ -0,0 +1,296 @@
+package lint
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/spf13/pflag"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/util/stdin"
+)
+
+// ConfigFile defines the JSON/YAML schema of the file passed with --config (e.g. .odh-lint.yaml).
+// It lets repeated runs, such as CI jobs, share settings without long flag lists.
+// Precedence: defaults < config file < stdin values (--from-stdin) < explicit CLI flags.
+type ConfigFile struct {
+	// Checks specifies check selector patterns (replaces --checks flag)
+	Checks []string `json:"checks,omitempty" yaml:"checks,omitempty"`
+
+	// Severity sets the minimum severity level (replaces --severity flag)
+	Severity string `json:"severity,omitempty" yaml:"severity,omitempty"`
+
+	// TargetVersion sets the target version for upgrade checks (replaces --target-version flag)
+	TargetVersion string `json:"targetVersion,omitempty" yaml:"targetVersion,omitempty"`
+
+	// CurrentVersion overrides the detected cluster version (replaces --current-version flag)
+	CurrentVersion string `json:"currentVersion,omitempty" yaml:"currentVersion,omitempty"`
+
+	// Output sets the output format (replaces --output flag)
+	Output string `json:"output,omitempty" yaml:"output,omitempty"`
+
+	// Verbose enables verbose output (replaces --verbose flag)
+	Verbose bool `json:"verbose,omitempty" yaml:"verbose,omitempty"`
+
+	// Quiet suppresses non-essential output (replaces --quiet flag)
+	Quiet bool `json:"quiet,omitempty" yaml:"quiet,omitempty"`
+
+	// NoColor disables colored output (replaces --no-color flag)
+	NoColor bool `json:"noColor,omitempty" yaml:"noColor,omitempty"`
+
+	// Lang sets the language of condition messages and remediation, e.g. "ja" (replaces --lang flag)
+	Lang string `json:"lang,omitempty" yaml:"lang,omitempty"`
+
+	// Timeout sets the operation timeout, e.g. "10m" (replaces --timeout flag)
+	Timeout *metav1.Duration `json:"timeout,omitempty" yaml:"timeout,omitempty"`
+
+	// CheckTimeout sets the per-check timeout, e.g. "2m" (replaces --check-timeout flag)
+	CheckTimeout *metav1.Duration `json:"checkTimeout,omitempty" yaml:"checkTimeout,omitempty"`
+
+	// QPS sets the Kubernetes API QPS limit (replaces --qps flag)
+	QPS float32 `json:"qps,omitempty" yaml:"qps,omitempty"`
+
+	// Burst sets the Kubernetes API burst capacity (replaces --burst flag)
+	Burst int `json:"burst,omitempty" yaml:"burst,omitempty"`
+
+	// PageSize sets the number of items requested per page when listing (replaces --page-size flag)
+	PageSize *int64 `json:"pageSize,omitempty" yaml:"pageSize,omitempty"`
+
+	// Selector restricts the objects analyzed by workload checks (replaces --selector flag)
+	Selector string `json:"selector,omitempty" yaml:"selector,omitempty"`
+
+	// Deep enables pod-level validation of workloads (replaces --deep flag)
+	Deep bool `json:"deep,omitempty" yaml:"deep,omitempty"`
+
+	// LowMemory lowers memory use on large clusters (replaces --low-memory flag)
+	LowMemory bool `json:"lowMemory,omitempty" yaml:"lowMemory,omitempty"`
+
+	// LogLevel sets the minimum level of check diagnostics (replaces --log-level flag)
+	LogLevel string `json:"logLevel,omitempty" yaml:"logLevel,omitempty"`
+
+	// LogFormat sets the format of check diagnostics (replaces --log-format flag)
+	LogFormat string `json:"logFormat,omitempty" yaml:"logFormat,omitempty"`
+
+	// LogFile sets the file receiving check diagnostics (replaces --log-file flag)
+	LogFile string `json:"logFile,omitempty" yaml:"logFile,omitempty"`
+
+	// ISVCDeploymentMode filters InferenceService display (replaces --isvc-deployment-mode flag)
+	ISVCDeploymentMode string `json:"isvcDeploymentMode,omitempty" yaml:"isvcDeploymentMode,omitempty"`
+
+	// RegistryMirrors lists <source>=<mirror> registry mirror mappings (replaces --registry-mirror flag)
+	RegistryMirrors []string `json:"registryMirrors,omitempty" yaml:"registryMirrors,omitempty"`
+
+	// VerifyRegistry verifies custom notebook images in their registry (replaces --verify-registry flag)
+	VerifyRegistry bool `json:"verifyRegistry,omitempty" yaml:"verifyRegistry,omitempty"`
+
+	// SortBy orders the findings of table output, e.g. score (replaces --sort-by flag)
+	SortBy string `json:"sortBy,omitempty" yaml:"sortBy,omitempty"`
+
+	// GroupRender turns group rendering of verbose output on or off (replaces --group-render flag)
+	GroupRender string `json:"groupRender,omitempty" yaml:"groupRender,omitempty"`
+
+	// SeverityOverrides maps check IDs to the impact (prohibited, blocking, advisory) their
+	// findings are reported with, overriding the impact assigned by the check.
+	SeverityOverrides map[string]result.Impact `json:"severityOverrides,omitempty" yaml:"severityOverrides,omitempty"`
+
+	// Suppressions lists check selector patterns whose findings are acknowledged: matching
+	// checks still run, but their results are dropped from the output and the verdict.
+	Suppressions []string `json:"suppressions,omitempty" yaml:"suppressions,omitempty"`
+}
+
+// LoadConfigFile reads and strictly parses a lint config file; unknown fields are rejected.
+func LoadConfigFile(path string) (*ConfigFile, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("opening config file: %w", err)
+	}
+	defer func() { _ = f.Close() }()
+
+	var cfg ConfigFile
+	if err := stdin.Parse(f, &cfg); err != nil {
+		return nil, fmt.Errorf("parsing config file %s: %w", path, err)
+	}
+
+	return &cfg, nil
+}
+
+// applyConfigFile merges config file values into command options.
+// Explicit CLI flags take precedence over config file values.
+func (c *Command) applyConfigFile(cfg *ConfigFile) error {
+	if len(cfg.Checks) > 0 && !stdin.FlagChanged(c.flags, "checks") {
+		c.CheckSelectors = cfg.Checks
+	}
+
+	if cfg.Severity != "" && !stdin.FlagChanged(c.flags, "severity") {
+		level := SeverityLevel(cfg.Severity)
+		if err := level.Validate(); err != nil {
+			return fmt.Errorf("config file: %w", err)
+		}
+		c.SeverityLevel = level
+	}
+
+	if cfg.TargetVersion != "" && !stdin.FlagChanged(c.flags, "target-version") {
+		c.TargetVersion = cfg.TargetVersion
+	}
+
+	if cfg.CurrentVersion != "" && !stdin.FlagChanged(c.flags, "current-version") {
+		c.CurrentVersion = cfg.CurrentVersion
+	}
+
+	if cfg.Output != "" && !stdin.FlagChanged(c.flags, "output") {
+		format := OutputFormat(cfg.Output)
+		if err := format.Validate(); err != nil {
+			return fmt.Errorf("config file: %w", err)
+		}
+		c.OutputFormat = format
+	}
+
+	applyConfigBool(c.flags, "verbose", cfg.Verbose, &c.Verbose)
+	applyConfigBool(c.flags, "quiet", cfg.Quiet, &c.Quiet)
+	applyConfigBool(c.flags, "no-color", cfg.NoColor, &c.NoColor)
+
+	if cfg.Lang != "" && !stdin.FlagChanged(c.flags, "lang") {
+		c.Lang = cfg.Lang
+	}
+
+	if cfg.Timeout != nil && !stdin.FlagChanged(c.flags, "timeout") {
+		c.Timeout = cfg.Timeout.Duration
+	}
+
+	if cfg.CheckTimeout != nil && !stdin.FlagChanged(c.flags, "check-timeout") {
+		c.CheckTimeout = cfg.CheckTimeout.Duration
+	}
+
+	if cfg.QPS != 0 && !stdin.FlagChanged(c.flags, "qps") {
+		c.QPS = cfg.QPS
+	}
+
+	if cfg.Burst != 0 && !stdin.FlagChanged(c.flags, "burst") {
+		c.Burst = cfg.Burst
+	}
+
+	if cfg.PageSize != nil && !stdin.FlagChanged(c.flags, "page-size") {
+		c.PageSize = *cfg.PageSize
+	}
+
+	if cfg.Selector != "" && !stdin.FlagChanged(c.flags, "selector") {
+		c.LabelSelector = cfg.Selector
+	}
+
+	applyConfigBool(c.flags, "deep", cfg.Deep, &c.Deep)
+	applyConfigBool(c.flags, "low-memory", cfg.LowMemory, &c.LowMemory)
+	applyConfigBool(c.flags, "verify-registry", cfg.VerifyRegistry, &c.VerifyRegistry)
+
+	if cfg.LogLevel != "" && !stdin.FlagChanged(c.flags, "log-level") {
+		c.LogLevel = cfg.LogLevel
+	}
+
+	if cfg.LogFormat != "" && !stdin.FlagChanged(c.flags, "log-format") {
+		c.LogFormat = cfg.LogFormat
+	}
+
+	if cfg.LogFile != "" && !stdin.FlagChanged(c.flags, "log-file") {
+		c.LogFile = cfg.LogFile
+	}
+
+	if cfg.ISVCDeploymentMode != "" && !stdin.FlagChanged(c.flags, "isvc-deployment-mode") {
+		c.ISVCDeploymentMode = cfg.ISVCDeploymentMode
+	}
+
+	if len(cfg.RegistryMirrors) > 0 && !stdin.FlagChanged(c.flags, "registry-mirror") {
+		c.RegistryMirrors = cfg.RegistryMirrors
+	}
+
+	if cfg.SortBy != "" && !stdin.FlagChanged(c.flags, "sort-by") {
+		c.SortBy = SortBy(cfg.SortBy)
+	}
+
+	if cfg.GroupRender != "" && !stdin.FlagChanged(c.flags, "group-render") {
+		c.GroupRender = GroupRender(cfg.GroupRender)
+	}
+
+	for id, impact := range cfg.SeverityOverrides {
+		if err := validateOverrideImpact(id, impact); err != nil {
+			return fmt.Errorf("config file: %w", err)
+		}
+	}
+
+	if len(cfg.Suppressions) > 0 {
+		if err := ValidateCheckSelectors(cfg.Suppressions); err != nil {
+			return fmt.Errorf("config file: suppressions: %w", err)
+		}
+	}
+
+	c.SeverityOverrides = cfg.SeverityOverrides
+	c.Suppressions = cfg.Suppressions
+
+	return nil
+}
+
+func applyConfigBool(fs *pflag.FlagSet, name string, value bool, target *bool) {
+	if value && !stdin.FlagChanged(fs, name) {
+		*target = true
+	}
+}
+
+// parseSeverityOverrides parses --severity-override entries of the form <check-id>=<impact>.
+func parseSeverityOverrides(entries []string) (map[string]result.Impact, error) {
+	overrides := make(map[string]result.Impact, len(entries))
+
+	for _, entry := range entries {
+		id, value, ok := strings.Cut(entry, "=")
+		if !ok || id == "" {
+			return nil, fmt.Errorf("invalid severity override %q (must be <check-id>=<impact>)", entry)
+		}
+
+		impact := result.Impact(value)
+		if err := validateOverrideImpact(id, impact); err != nil {
+			return nil, err
+		}
+
+		overrides[id] = impact
+	}
+
+	return overrides, nil
+}
+
+func validateOverrideImpact(id string, impact result.Impact) error {
+	if !slices.Contains([]result.Impact{result.ImpactProhibited, result.ImpactBlocking, result.ImpactAdvisory}, impact) {
+		return fmt.Errorf("invalid severity override %q for %s (must be one of: prohibited, blocking, advisory)",
+			impact, id)
+	}
+
+	return nil
+}
+
+// applySuppressions removes results of checks matching any of the suppression patterns and
+// returns the remaining results with the number of suppressed check results.
+func (c *Command) applySuppressions(results []check.CheckExecution) ([]check.CheckExecution, int, error) {
+	if len(c.Suppressions) == 0 {
+		return results, 0, nil
+	}
+
+	suppressed, err := c.registry.ListByPatterns(c.Suppressions, "")
+	if err != nil {
+		return nil, 0, fmt.Errorf("resolving suppressions: %w", err)
+	}
+
+	ids := make(map[string]struct{}, len(suppressed))
+	for _, chk := range suppressed {
+		ids[chk.ID()] = struct{}{}
+	}
+
+	before := len(results)
+	results = slices.DeleteFunc(results, func(exec check.CheckExecution) bool {
+		_, ok := ids[exec.Check.ID()]
+
+		return ok
+	})
+
+	return results, before - len(results), nil
+}
@@ -0,0 +1,223 @@
+package lint_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/spf13/pflag"
+
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+
+	. "github.com/onsi/gomega"
+)
+
+const (
+	fixtureConfigYAML = `
+checks:
+  - "dependencies.*"
+severity: critical
+output: json
+timeout: 10m
+qps: 20
+severityOverrides:
+  dependencies.certmanager.installed: advisory
+suppressions:
+  - "*openshift*"
+`
+	fixtureConfigRun = `
+checks: ["dependencies.*"]
+output: json
+severityOverrides:
+  dependencies.certmanager.installed: advisory
+suppressions: ["*openshift*"]
+`
+	fixtureConfigUnknownField = `cheks: ["*"]`
+	fixtureConfigBadOverride  = `
+severityOverrides:
+  dependencies.certmanager.installed: fatal
+`
+)
+
+func writeConfigFile(t *testing.T, content string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), ".odh-lint.yaml")
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	return path
+}
+
+func newConfigTestCommand(in *bytes.Buffer, out *bytes.Buffer) *lint.Command {
+	return lint.NewCommand(genericiooptions.IOStreams{
+		In: in, Out: out, ErrOut: &bytes.Buffer{},
+	}, testConfigFlags())
+}
+
+func TestLoadConfigFile(t *testing.T) {
+	t.Run("should parse all fields", func(t *testing.T) {
+		g := NewWithT(t)
+
+		cfg, err := lint.LoadConfigFile(writeConfigFile(t, fixtureConfigYAML))
+
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(cfg.Checks).To(Equal([]string{"dependencies.*"}))
+		g.Expect(cfg.Timeout.Duration).To(Equal(10 * time.Minute))
+		g.Expect(cfg.SeverityOverrides).To(HaveKeyWithValue("dependencies.certmanager.installed", result.ImpactAdvisory))
+		g.Expect(cfg.Suppressions).To(Equal([]string{"*openshift*"}))
+	})
+
+	t.Run("should reject unknown fields", func(t *testing.T) {
+		g := NewWithT(t)
+
+		_, err := lint.LoadConfigFile(writeConfigFile(t, fixtureConfigUnknownField))
+
+		g.Expect(err).To(MatchError(ContainSubstring("cheks")))
+	})
+
+	t.Run("should fail for a missing file", func(t *testing.T) {
+		g := NewWithT(t)
+
+		_, err := lint.LoadConfigFile(filepath.Join(t.TempDir(), "missing.yaml"))
+
+		g.Expect(err).To(HaveOccurred())
+	})
+}
+
+func TestCommand_ConfigFile(t *testing.T) {
+	t.Run("Complete should apply config file values", func(t *testing.T) {
+		g := NewWithT(t)
+
+		command := newConfigTestCommand(&bytes.Buffer{}, &bytes.Buffer{})
+		command.ConfigFile = writeConfigFile(t, fixtureConfigYAML)
+
+		g.Expect(command.Complete()).To(Succeed())
+		g.Expect(command.CheckSelectors).To(Equal([]string{"dependencies.*"}))
+		g.Expect(command.SeverityLevel).To(Equal(lint.SeverityLevelCritical))
+		g.Expect(command.OutputFormat).To(Equal(lint.OutputFormatJSON))
+		g.Expect(command.Timeout).To(Equal(10 * time.Minute))
+		g.Expect(command.QPS).To(BeNumerically("==", 20))
+	})
+
+	t.Run("explicit flags should override config file values", func(t *testing.T) {
+		g := NewWithT(t)
+
+		command := newConfigTestCommand(&bytes.Buffer{}, &bytes.Buffer{})
+		fs := pflag.NewFlagSet("test", pflag.ContinueOnError)
+		command.AddFlags(fs)
+		g.Expect(fs.Parse([]string{
+			"--config", writeConfigFile(t, fixtureConfigYAML),
+			"--severity", "warning",
+			"--output", "yaml",
+		})).To(Succeed())
+
+		g.Expect(command.Complete()).To(Succeed())
+		g.Expect(command.SeverityLevel).To(Equal(lint.SeverityLevelWarning))
+		g.Expect(command.OutputFormat).To(Equal(lint.OutputFormatYAML))
+		g.Expect(command.CheckSelectors).To(Equal([]string{"dependencies.*"}))
+	})
+
+	t.Run("stdin values should override config file values", func(t *testing.T) {
+		g := NewWithT(t)
+
+		command := newConfigTestCommand(bytes.NewBufferString(`{"severity": "prohibited"}`), &bytes.Buffer{})
+		command.ConfigFile = writeConfigFile(t, fixtureConfigYAML)
+		command.FromStdin = true
+
+		g.Expect(command.Complete()).To(Succeed())
+		g.Expect(command.SeverityLevel).To(Equal(lint.SeverityLevelProhibited))
+		g.Expect(command.OutputFormat).To(Equal(lint.OutputFormatJSON))
+	})
+
+	t.Run("Complete should reject invalid severity overrides", func(t *testing.T) {
+		g := NewWithT(t)
+
+		command := newConfigTestCommand(&bytes.Buffer{}, &bytes.Buffer{})
+		command.ConfigFile = writeConfigFile(t, fixtureConfigBadOverride)
+
+		g.Expect(command.Complete()).To(MatchError(ContainSubstring("invalid severity override")))
+	})
+
+	t.Run("Run should apply severity overrides and suppressions", func(t *testing.T) {
+		g := NewWithT(t)
+
+		dir := t.TempDir()
+		g.Expect(os.WriteFile(filepath.Join(dir, "dsc.yaml"), []byte(fixtureSnapshotDSC), 0o600)).To(Succeed())
+		g.Expect(os.WriteFile(filepath.Join(dir, "dsci.yaml"), []byte(fixtureSnapshotDSCI), 0o600)).To(Succeed())
+
+		var out bytes.Buffer
+		command := newConfigTestCommand(&bytes.Buffer{}, &out)
+		command.ConfigFile = writeConfigFile(t, fixtureConfigRun)
+		command.FromDir = dir
+		command.TargetVersion = "3.0.0"
+
+		g.Expect(command.Complete()).To(Succeed())
+		g.Expect(command.Validate()).To(Succeed())
+
+		_ = command.Run(t.Context())
+
+		var report struct {
+			Results []result.DiagnosticResult `json:"results"`
+		}
+		g.Expect(json.Unmarshal(out.Bytes(), &report)).To(Succeed())
+
+		kinds := make(map[string]result.Impact)
+		for _, r := range report.Results {
+			kinds[r.Kind] = r.GetImpact()
+
+			if r.Kind == "cert-manager" {
+				g.Expect(r.Annotations).To(HaveKey(result.AnnotationOriginalImpact))
+			}
+		}
+
+		g.Expect(kinds).To(HaveKeyWithValue("cert-manager", result.ImpactAdvisory))
+		g.Expect(kinds).ToNot(HaveKey("openshift-platform"))
+	})
+}
+
+func TestCommand_SeverityOverrideFlag(t *testing.T) {
+	t.Run("flag should take precedence over config file overrides", func(t *testing.T) {
+		g := NewWithT(t)
+
+		command := newConfigTestCommand(&bytes.Buffer{}, &bytes.Buffer{})
+		fs := pflag.NewFlagSet("test", pflag.ContinueOnError)
+		command.AddFlags(fs)
+		g.Expect(fs.Parse([]string{
+			"--config", writeConfigFile(t, fixtureConfigYAML),
+			"--severity-override", "dependencies.certmanager.installed=blocking",
+			"--severity-override", "workloads.notebook.impacted-workloads=advisory",
+		})).To(Succeed())
+
+		g.Expect(command.Complete()).To(Succeed())
+		g.Expect(command.SeverityOverrides).To(Equal(map[string]result.Impact{
+			"dependencies.certmanager.installed":    result.ImpactBlocking,
+			"workloads.notebook.impacted-workloads": result.ImpactAdvisory,
+		}))
+	})
+
+	t.Run("Complete should reject malformed entries", func(t *testing.T) {
+		g := NewWithT(t)
+
+		command := newConfigTestCommand(&bytes.Buffer{}, &bytes.Buffer{})
+		command.SeverityOverrideFlags = []string{"workloads.notebook.impacted-workloads"}
+
+		g.Expect(command.Complete()).To(MatchError(ContainSubstring("must be <check-id>=<impact>")))
+	})
+
+	t.Run("Complete should reject unknown impacts", func(t *testing.T) {
+		g := NewWithT(t)
+
+		command := newConfigTestCommand(&bytes.Buffer{}, &bytes.Buffer{})
+		command.SeverityOverrideFlags = []string{"workloads.notebook.impacted-workloads=critical"}
+
+		g.Expect(command.Complete()).To(MatchError(ContainSubstring("invalid severity override")))
+	})
+}
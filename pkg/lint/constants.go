@@ -2,19 +2,81 @@ package lint
 
 // Flag descriptions for the lint command.
 const (
-	flagDescTargetVersion      = "target version for upgrade readiness checks (e.g., 2.25.0, 3.0.0)"
-	flagDescOutput             = "output format (table|json|yaml)"
+	flagDescTargetVersion      = "target version for upgrade readiness checks (e.g., 2.25.0, 3.0.0), latest/next to resolve it from the operator catalog, or a comma-separated list of versions to assess each in turn"
+	flagDescServiceAcctCheck   = "when running in a pod, verify the mounted service account can read every resource the selected checks need, and fail otherwise"
+	flagDescContexts           = "kubeconfig contexts to lint in turn, with results grouped per cluster (comma-separated)"
+	flagDescAllContexts        = "lint the cluster of every kubeconfig context, with results grouped per cluster"
+	flagDescCurrentVersion     = "override the detected cluster version (e.g., 2.25.0), for clusters where detection fails or reports a downstream-specific version"
+	flagDescUntilVersion       = "assess every minor release offered by the operator catalog after the current version, up to and including this one (or latest)"
+	flagDescOutput             = "output format (table|wide|json|ndjson|yaml|junit|html|markdown); wide adds remediation and impacted-count columns, ndjson streams one result per line"
 	flagDescSeverity           = "minimum severity level to display (prohibited|critical|warning|info)"
 	flagDescVerbose            = "show impacted objects and summary information"
-	flagDescQuiet              = "suppress all non-essential output (only show structured data or errors)"
-	flagDescDebug              = "show detailed diagnostic logs for troubleshooting"
+	flagDescQuiet              = "suppress all non-essential output: progress, discovery messages and logs on stderr; with structured output (-o json, yaml, ...) only the results are written to stdout, with table output nothing is"
+	flagDescDebug              = "show detailed diagnostic logs for troubleshooting (same as --log-level debug)"
+	flagDescLogLevel           = "minimum level of the structured check diagnostics (debug|info|warn|error)"
+	flagDescLogFormat          = "format of the structured check diagnostics (text|json)"
+	flagDescTrace              = "write every API read of the run (GVR, namespace, selectors, duration, result size) as JSON to this file"
+	flagDescAuditLog           = "append a JSON line per evaluated check (start/end time, CanApply decision, conditions, impacted count, errors) to this file as an audit trail of the run"
+	flagDescLogFile            = "write the structured check diagnostics to this file instead of stderr"
 	flagDescTimeout            = "operation timeout (e.g., 10m, 30m)"
+	flagDescCheckTimeout       = "maximum time a single check may take (e.g., 2m); a check exceeding it is reported as failed (0 disables)"
 	flagDescQPS                = "Kubernetes API QPS limit (queries per second)"
 	flagDescBurst              = "Kubernetes API burst capacity"
+	flagDescPageSize           = "number of items requested per page when listing resources; pages are aggregated, 0 lists in a single request"
+	flagDescGroupBy            = "aggregate findings in table, JSON and YAML output (namespace: impacted objects per namespace with its requester)"
+	flagDescGroupRender        = "group impacted objects in verbose output by their key annotation, e.g. notebooks and RayClusters by image, InferenceServices by runtime (on|off: list them by namespace)"
+	flagDescSortBy             = "order of the findings in table output (score: highest risk score first, from impact, impacted object count and usage)"
+	flagDescSplitBy            = "also write one report per recipient to --output-dir (requester: per namespace openshift.io/requester, owner: per resolved object owner)"
+	flagDescOutputDir          = "directory split reports are written to, in the --output format"
+	flagDescDetectUsage        = "detect whether impacted workloads are in use or idle (stopped or inactive notebooks, scaled-down InferenceServices, completed jobs) and report it"
+	flagDescResolveOwners      = "resolve the owner of each impacted object (owner label, notebook user, Argo CD application, namespace requester) and report it"
+	flagDescSelector           = "label selector restricting the objects analyzed by workload checks (e.g. app=team-a)"
+	flagDescDeep               = "also inspect the pods backing flagged workloads (images running out of sync with the spec, restart loops); lists the pods of impacted namespaces"
+	flagDescLowMemory          = "lower memory use on clusters with many workloads: list workload metadata and get only the objects checks flag, without caching reads for the run (more API requests)"
 	flagDescISVCDeploymentMode = "filter InferenceService display by deployment mode (all|serverless|modelmesh)"
+	flagDescVerifyRegistry     = "verify notebook images no ImageStream correlates in their registry (digest, build commit label) with the cluster pull secret and local docker/podman credentials; requires registry access"
+	flagDescRegistryMirror     = "map a source registry or repository to the mirror notebook images are pulled from as <source>=<mirror> (repeatable), in addition to the cluster's ImageDigestMirrorSets and ImageTagMirrorSets"
+	flagDescFromDir            = "run checks against a directory or tarball (.tar, .tar.gz) of YAML/JSON resource dumps instead of a live cluster"
+	flagDescSnapshotOutputFile = "path of the snapshot tarball to write"
 	flagDescNoColor            = "disable colored output (also respects NO_COLOR env var)"
+	flagDescLang               = "language of condition messages and remediation (en|ja|zh); impacted object details and custom check texts stay in English, and structured output reports stable message IDs in every language"
+	flagDescExitCodeMode       = "exit code scheme: default (by error category) or outcome (0=clean, 2=advisory, 3=blocking, 4=execution errors, 5=partial results)"
+	flagDescShowSkipped        = "also report the checks not run because they do not apply to the cluster or versions, with a skipped status"
+	flagDescValidateOutput     = "check JSON/YAML output against the published schema (see 'lint --schema') and fail if it does not conform"
+	flagDescMaxBlocking        = "number of blocking findings tolerated before the run fails (prohibited findings always fail)"
+	flagDescMaxAdvisory        = "number of advisory findings tolerated before the run reports advisory findings"
+	flagDescFix                = "apply available remediations for reported findings after a dry-run preview and confirmation (requires --target-version)"
+	flagDescYes                = "skip the confirmation prompt when used with --fix"
+	flagDescSeverityOverride   = "override the impact of a check's findings as <check-id>=<prohibited|blocking|advisory> (repeatable)"
+	flagDescFilter             = "only output conditions matching an expression, e.g. 'impact==blocking && group==workload' (fields: check, group, kind, name, type, status, reason, impact)"
+	flagDescConfig             = "path to a YAML/JSON lint config file (e.g. .odh-lint.yaml); CLI flags override file values"
+	flagDescCustomChecks       = "path to a directory (or file) of YAML/JSON custom check descriptors evaluated with Rego policies alongside the built-in checks"
+	flagDescRulesFile          = "path to a YAML/JSON file of workload rules (resource, CEL expression, message template, impact) run as additional checks"
+	flagDescCompatMatrix       = "path to a YAML/JSON compatibility matrix overriding the embedded one (e.g. to test pre-release requirements)"
+	flagDescBaselineWrite      = "record the current findings in a baseline file (e.g. baseline.json)"
+	flagDescBaselineCompare    = "only report findings that are not recorded in the given baseline file"
+	flagDescMetricsFile        = "write findings as Prometheus gauges to a textfile collector file (e.g. odh_lint.prom)"
+	flagDescPushGateway        = "push findings as Prometheus gauges to a Pushgateway (e.g. http://pushgateway:9091)"
+	flagDescRecord             = "record the report as a ConfigMap in the operator namespace (see 'lint history')"
+	flagDescDryRunPermissions  = "only verify that the current user can read every resource the selected checks need"
+	flagDescWatch              = "keep re-running upgrade checks and report only changes between runs"
+	flagDescInterval           = "time between runs in watch mode (e.g. 30m, 1h)"
+	flagDescWatchFile          = "append the changes reported in watch mode to a file instead of stdout"
+	flagDescPermissionsOutput  = "output format (yaml|json)"
+	flagDescClusterRoleName    = "name of the generated ClusterRole"
+	flagDescRBACName           = "name of the generated ClusterRole and ClusterRoleBinding"
+	flagDescRBACServiceAccount = "service account to bind, as namespace:name (repeatable; default odh-lint:odh-lint when no subject is given)"
+	flagDescRBACUser           = "user to bind (repeatable)"
+	flagDescRBACGroup          = "group to bind (repeatable)"
+	flagDescListChecksOutput   = "output format (table|json|yaml)"
+	flagDescHistoryOutput      = "output format (table|json|yaml)"
+	flagDescDiffOutput         = "output format (table|json|yaml)"
+	flagDescVersionsOutput     = "output format (table|json|yaml)"
 )
 
+// fieldOwnerLint is the field manager recorded on resources patched by --fix.
+const fieldOwnerLint = "odh-cli-lint"
+
 const flagDescChecks = `check selector patterns (glob patterns or categories):
   - '*'             : all checks
   - 'components.*'  : all component checks
@@ -24,4 +86,6 @@ const flagDescChecks = `check selector patterns (glob patterns or categories):
   - 'workloads.*'   : all workload checks
   - '*dashboard*'   : all checks with 'dashboard' in ID
   - 'exact.id'      : exact check ID
-Can be specified multiple times`
+  - '@fast'         : checks tagged fast (also '@blocking-only')
+  - '!pattern'      : exclude the checks matching pattern (e.g. '!workloads.notebook.*')
+Can be specified multiple times; exclusions alone apply to all checks`
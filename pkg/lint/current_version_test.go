@@ -0,0 +1,84 @@
+package lint_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestCommand_CurrentVersion(t *testing.T) {
+	run := func(t *testing.T, currentVersion string) result.DiagnosticResultList {
+		t.Helper()
+
+		g := NewWithT(t)
+
+		dir := t.TempDir()
+		g.Expect(os.WriteFile(filepath.Join(dir, "dsc.yaml"), []byte(fixtureSnapshotDSC), 0o600)).To(Succeed())
+		g.Expect(os.WriteFile(filepath.Join(dir, "dsci.yaml"), []byte(fixtureSnapshotDSCI), 0o600)).To(Succeed())
+
+		var out bytes.Buffer
+		command := lint.NewCommand(genericiooptions.IOStreams{
+			In: &bytes.Buffer{}, Out: &out, ErrOut: &bytes.Buffer{},
+		}, testConfigFlags())
+		command.FromDir = dir
+		command.TargetVersion = "3.0.0"
+		command.CurrentVersion = currentVersion
+		command.OutputFormat = lint.OutputFormatJSON
+
+		g.Expect(command.Complete()).To(Succeed())
+		g.Expect(command.Validate()).To(Succeed())
+
+		// Findings may produce a non-zero exit error; the report itself must still be rendered.
+		_ = command.Run(t.Context())
+
+		var report result.DiagnosticResultList
+		g.Expect(json.Unmarshal(out.Bytes(), &report)).To(Succeed())
+
+		return report
+	}
+
+	t.Run("should record the source of the detected version", func(t *testing.T) {
+		g := NewWithT(t)
+
+		report := run(t, "")
+		g.Expect(report.ClusterVersion).To(HaveValue(Equal("2.25.0")))
+		g.Expect(report.Annotations).To(HaveKeyWithValue(result.AnnotationClusterVersionSource, "DataScienceCluster"))
+	})
+
+	t.Run("should record the product flavor", func(t *testing.T) {
+		g := NewWithT(t)
+
+		// Identified by the default RHOAI applications namespace of the DSCInitialization
+		report := run(t, "")
+		g.Expect(report.Annotations).To(HaveKeyWithValue(result.AnnotationProductFlavor, "RHOAI"))
+	})
+
+	t.Run("should override the detected version", func(t *testing.T) {
+		g := NewWithT(t)
+
+		// Partial versions are accepted, as with --target-version
+		report := run(t, "2.24")
+		g.Expect(report.ClusterVersion).To(HaveValue(Equal("2.24.0")))
+		g.Expect(report.Annotations).To(HaveKeyWithValue(result.AnnotationClusterVersionSource, "Manual"))
+	})
+
+	t.Run("should reject a version that is not semver", func(t *testing.T) {
+		g := NewWithT(t)
+
+		command := lint.NewCommand(genericiooptions.IOStreams{
+			In: &bytes.Buffer{}, Out: &bytes.Buffer{}, ErrOut: &bytes.Buffer{},
+		}, testConfigFlags())
+		command.CurrentVersion = "rhoai-custom-build"
+
+		g.Expect(command.Complete()).To(MatchError(ContainSubstring("invalid current version")))
+	})
+}
@@ -0,0 +1,89 @@
+package lint
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	clierrors "github.com/opendatahub-io/odh-cli/pkg/util/errors"
+)
+
+// ExitCodeMode selects the exit code scheme of the lint command.
+type ExitCodeMode string
+
+const (
+	// ExitCodeModeDefault uses the CLI-wide exit codes, where infrastructure errors are
+	// reported by category (validation, auth, connection) and blocking findings exit with 1.
+	ExitCodeModeDefault ExitCodeMode = "default"
+
+	// ExitCodeModeOutcome reports the outcome class of the run, so CI pipelines can branch
+	// on it (see the ExitOutcome codes).
+	ExitCodeModeOutcome ExitCodeMode = "outcome"
+)
+
+// Exit codes of ExitCodeModeOutcome.
+const (
+	ExitOutcomeClean      clierrors.ExitCode = 0 // All checks ran, no findings
+	ExitOutcomeAdvisory   clierrors.ExitCode = 2 // All checks ran, advisory findings only
+	ExitOutcomeBlocking   clierrors.ExitCode = 3 // All checks ran, prohibited or blocking findings
+	ExitOutcomeExecErrors clierrors.ExitCode = 4 // One or more checks failed to execute
+	ExitOutcomePartial    clierrors.ExitCode = 5 // The run was interrupted before all checks ran
+)
+
+const msgPartialResults = "%d check(s) were not run: results are partial"
+
+// Validate checks if the exit code mode is valid.
+func (m ExitCodeMode) Validate() error {
+	switch m {
+	case ExitCodeModeDefault, ExitCodeModeOutcome:
+		return nil
+	default:
+		return fmt.Errorf("invalid exit-code-mode: %s (must be one of: default, outcome)", m)
+	}
+}
+
+// outcomeExitCode classifies a run into its ExitCodeModeOutcome exit code. Incomplete runs
+// take precedence over findings, since the findings of an incomplete run may be missing
+// blocking issues.
+func outcomeExitCode(stats check.ExecutionStats, findingsErr error) clierrors.ExitCode {
+	switch {
+	case stats.NotRun > 0:
+		return ExitOutcomePartial
+	case stats.Failed > 0:
+		return ExitOutcomeExecErrors
+	case errors.Is(findingsErr, clierrors.ErrLintBlocked):
+		return ExitOutcomeBlocking
+	case errors.Is(findingsErr, clierrors.ErrLintAdvisory):
+		return ExitOutcomeAdvisory
+	default:
+		return ExitOutcomeClean
+	}
+}
+
+// resolveOutcomeExitError is the ExitCodeModeOutcome counterpart of resolveExitError: it
+// returns the error carrying the outcome exit code of the run, or nil for a clean run.
+func resolveOutcomeExitError(execSummary execErrorSummary, findingsErr error, outputFormat OutputFormat) error {
+	code := outcomeExitCode(execSummary.stats, findingsErr)
+
+	switch code { //nolint:exhaustive // ExitCode is an open set; only outcome codes occur here
+	case ExitOutcomeClean:
+		return nil
+	case ExitOutcomePartial:
+		cause := execSummary.interrupted
+		if cause == nil {
+			cause = context.Canceled
+		}
+
+		return clierrors.WithExitCode(code, fmt.Errorf(msgPartialResults+": %w", execSummary.stats.NotRun, cause))
+	case ExitOutcomeExecErrors:
+		return clierrors.WithExitCode(code, fmt.Errorf(msgInfrastructureErrors+": %w", execSummary.err))
+	}
+
+	// Findings are already summarized by the verdict in table output
+	if outputFormat.isTable() {
+		return clierrors.NewAlreadyHandledError(clierrors.WithExitCode(code, findingsErr)) //nolint:wrapcheck // wrapping is done by NewAlreadyHandledError
+	}
+
+	return clierrors.WithExitCode(code, findingsErr)
+}
@@ -0,0 +1,343 @@
+package lint
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/messages"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/output"
+	"github.com/opendatahub-io/odh-cli/pkg/printer/table"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+	clierrors "github.com/opendatahub-io/odh-cli/pkg/util/errors"
+	"github.com/opendatahub-io/odh-cli/pkg/util/version"
+)
+
+const clusterReportListKind = "LintClusterReportList"
+
+// ContextClientFactory creates the client and reader used to lint the cluster of a
+// kubeconfig context. The client may be nil for read-only access.
+type ContextClientFactory func(contextName string) (client.Client, client.Reader, error)
+
+// ClusterReportList is the structured output of a run against several kubeconfig contexts.
+type ClusterReportList struct {
+	output.Envelope
+
+	// Clusters holds one report per context, in the order the contexts were given.
+	Clusters []ClusterReport `json:"clusters" yaml:"clusters"`
+}
+
+// ClusterReport is the assessment of the cluster of one kubeconfig context.
+type ClusterReport struct {
+	Context string `json:"context" yaml:"context"`
+
+	// Error is set when the cluster could not be assessed, e.g. because it is unreachable.
+	Error string `json:"error,omitempty" yaml:"error,omitempty"`
+
+	Report *result.DiagnosticResultList `json:"report,omitempty" yaml:"report,omitempty"`
+}
+
+type clusterSummaryRow struct {
+	Context    string
+	Current    string
+	Target     string
+	Result     string
+	Prohibited string
+	Blocking   string
+	Advisory   string
+}
+
+// clusterRun holds the outcome of linting the cluster of one context.
+type clusterRun struct {
+	context          string
+	clusterVersion   string
+	targetVersion    string
+	openShiftVersion string
+	flavor           version.Flavor
+	annotations      map[string]string
+	results          []check.CheckExecution
+	err              error
+}
+
+// isFanOut reports whether the run lints several kubeconfig contexts.
+func (c *Command) isFanOut() bool {
+	return len(c.Contexts) > 0 || c.AllContexts
+}
+
+// validateFanOut checks the options of a run against several kubeconfig contexts.
+func (c *Command) validateFanOut() error {
+	if !c.isFanOut() {
+		return nil
+	}
+
+	switch {
+	case len(c.Contexts) > 0 && c.AllContexts:
+		return errors.New("--contexts and --all-contexts are mutually exclusive")
+	case !c.OutputFormat.isTable() && !slices.Contains([]OutputFormat{OutputFormatJSON, OutputFormatYAML}, c.OutputFormat):
+		return fmt.Errorf("--contexts is not supported with output format %s (must be one of: table, wide, json, yaml)",
+			c.OutputFormat)
+	case c.FromDir != "" || c.Watch || c.Fix || c.isMultiTarget() || c.SplitBy != SplitByNone ||
+		c.BaselineWrite != "" || c.MetricsFile != "" || c.PushGateway != "" || c.ValidateOutput || c.DryRunPermissions:
+		return errors.New("--contexts and --all-contexts cannot be used with --from-dir, --watch, --fix, --until-version, " +
+			"multiple target versions, --split-by, --baseline-write, --metrics-file, --push-gateway, --validate-output " +
+			"or --dry-run-permissions")
+	}
+
+	return nil
+}
+
+// contextNames returns the contexts to lint: those given with --contexts, or every context
+// of the kubeconfig with --all-contexts.
+func (c *Command) contextNames() ([]string, error) {
+	if !c.AllContexts {
+		return c.Contexts, nil
+	}
+
+	names, err := client.ListContexts(c.ConfigFlags)
+	if err != nil {
+		return nil, fmt.Errorf("listing kubeconfig contexts: %w", err)
+	}
+
+	if len(names) == 0 {
+		return nil, errors.New("no contexts found in kubeconfig")
+	}
+
+	return names, nil
+}
+
+// newContextClient creates the client of a kubeconfig context from the command's kubeconfig
+// and throttling settings.
+func (c *Command) newContextClient(contextName string) (client.Client, client.Reader, error) {
+	restConfig, err := client.NewRESTConfigForContext(c.ConfigFlags, contextName, c.QPS, c.Burst)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	cl, err := client.NewClientWithConfig(restConfig, client.WithPageSize(c.PageSize))
+	if err != nil {
+		return nil, nil, fmt.Errorf("creating Kubernetes client: %w", err)
+	}
+
+	return cl, cl, nil
+}
+
+// runFanOut lints the cluster of each context in turn and renders the results grouped per
+// cluster. A cluster that cannot be assessed is reported and does not stop the others; the
+// exit code reflects the worst outcome across all clusters.
+func (c *Command) runFanOut(ctx context.Context) error {
+	names, err := c.contextNames()
+	if err != nil {
+		return err
+	}
+
+	factory := c.contextClients
+	if factory == nil {
+		factory = c.newContextClient
+	}
+
+	runs := make([]clusterRun, 0, len(names))
+	execSummary := execErrorSummary{exitCode: clierrors.ExitSuccess}
+
+	var allResults []check.CheckExecution
+
+	targetVersion, parsedTargetVersion := c.TargetVersion, c.parsedTargetVersion
+
+	for _, name := range names {
+		// Each cluster starts from the requested target, so aliases resolve per cluster
+		c.TargetVersion, c.parsedTargetVersion = targetVersion, parsedTargetVersion
+		c.currentClusterVersion, c.currentOpenShiftVersion, c.currentVersionSource = "", "", ""
+		c.currentFlavor = version.FlavorUnknown
+
+		c.IO.Errorf("Linting context %s", name)
+
+		run, summary := c.lintContext(ctx, name, factory)
+		if run.err != nil {
+			c.IO.Errorf("Warning: failed to lint context %s: %v", name, run.err)
+		}
+
+		runs = append(runs, run)
+		allResults = append(allResults, run.results...)
+		execSummary = mergeExecSummaries(execSummary, summary)
+	}
+
+	if err := c.writeClusterRuns(ctx, runs); err != nil {
+		return err
+	}
+
+	findingsErr := c.evaluateVerdict(allResults)
+	c.printExecErrorSummary(execSummary)
+
+	if c.ExitCodeMode == ExitCodeModeOutcome {
+		return resolveOutcomeExitError(execSummary, findingsErr, c.OutputFormat)
+	}
+
+	return resolveExitError(execSummary, findingsErr, c.OutputFormat)
+}
+
+// lintContext lints the cluster of one context with its own timeout. Errors preventing the
+// assessment are recorded in the run and in the returned summary rather than returned.
+func (c *Command) lintContext(
+	ctx context.Context,
+	name string,
+	factory ContextClientFactory,
+) (clusterRun, execErrorSummary) {
+	run := clusterRun{context: name}
+
+	fail := func(err error) (clusterRun, execErrorSummary) {
+		run.err = err
+
+		return run, execErrorSummary{exitCode: clierrors.ExitCodeFromError(err), err: err}
+	}
+
+	cl, reader, err := factory(name)
+	if err != nil {
+		return fail(err)
+	}
+
+	c.Client, c.Reader = cl, c.instrumentReader(reader)
+
+	ctx, cancel := context.WithTimeout(ctx, c.Timeout)
+	defer cancel()
+
+	currentVersion, err := c.detectVersions(ctx)
+	if err != nil {
+		return fail(err)
+	}
+
+	run.clusterVersion = c.currentClusterVersion
+	run.openShiftVersion = c.currentOpenShiftVersion
+	run.flavor = c.currentFlavor
+	run.annotations = c.resultListAnnotations()
+	run.targetVersion = c.TargetVersion
+
+	upgrade, err := c.isUpgrade(currentVersion)
+	if err != nil {
+		return fail(err)
+	}
+
+	if !upgrade {
+		return run, execErrorSummary{exitCode: clierrors.ExitSuccess}
+	}
+
+	results, summary, _, err := c.executeUpgradeChecks(ctx, currentVersion, true)
+	if err != nil {
+		return fail(err)
+	}
+
+	run.results = results
+
+	if err := c.recordReport(ctx, results); err != nil {
+		c.IO.Errorf("Warning: failed to record report for context %s: %v", name, err)
+	}
+
+	return run, summary
+}
+
+// writeClusterRuns renders the runs of a multi-cluster assessment in the selected output format.
+func (c *Command) writeClusterRuns(ctx context.Context, runs []clusterRun) error {
+	out := c.IO.Out()
+
+	if !c.OutputFormat.isTable() {
+		list := &ClusterReportList{
+			Envelope: output.NewEnvelope(clusterReportListKind, "lint"),
+			Clusters: make([]ClusterReport, 0, len(runs)),
+		}
+
+		for _, run := range runs {
+			report := ClusterReport{Context: run.context}
+
+			if run.err != nil {
+				report.Error = run.err.Error()
+			} else {
+				report.Report = newResultList(run.results, c.printer,
+					&run.clusterVersion, &run.targetVersion, stringPtrOrNil(run.openShiftVersion), run.annotations)
+			}
+
+			list.Clusters = append(list.Clusters, report)
+		}
+
+		return renderStructured(out, c.OutputFormat, list)
+	}
+
+	for _, run := range runs {
+		_, _ = fmt.Fprintf(out, "\n=== Cluster %s ===\n", run.context)
+
+		switch {
+		case run.err != nil:
+			_, _ = fmt.Fprintf(out, "Error: %v\n", run.err)
+		case run.results == nil:
+			_, _ = fmt.Fprintf(out, "Current and target versions are the same (%s), no checks were executed.\n",
+				run.clusterVersion)
+		default:
+			c.currentClusterVersion, c.currentOpenShiftVersion = run.clusterVersion, run.openShiftVersion
+			c.currentFlavor = run.flavor
+			c.TargetVersion = run.targetVersion
+
+			if err := c.outputUpgradeTable(ctx, out, run.results); err != nil {
+				return err
+			}
+		}
+	}
+
+	return writeClusterSummary(out, runs)
+}
+
+// writeClusterSummary writes a table with the versions, result and impact counts of each
+// cluster.
+func writeClusterSummary(out io.Writer, runs []clusterRun) error {
+	_, _ = fmt.Fprintln(out, "\nSummary by cluster:")
+
+	renderer := table.NewRenderer(
+		table.WithWriter[clusterSummaryRow](out),
+		table.WithHeaders[clusterSummaryRow]("CONTEXT", "CURRENT", "TARGET", "RESULT", "PROHIBITED", "BLOCKING", "ADVISORY"),
+		table.WithTableOptions[clusterSummaryRow](table.DefaultTableOptions...),
+	)
+
+	for _, run := range runs {
+		row := clusterSummaryRow{
+			Context: run.context,
+			Current: run.clusterVersion,
+			Target:  run.targetVersion,
+			Result:  "error",
+		}
+
+		if run.err == nil {
+			counts := make(map[result.Impact]int)
+
+			for _, exec := range run.results {
+				if exec.Result != nil {
+					counts[exec.Result.GetImpact()]++
+				}
+			}
+
+			row.Result = newResultList(run.results, messages.Printer{}, nil, nil, nil, nil).Status.Result
+			row.Prohibited = strconv.Itoa(counts[result.ImpactProhibited])
+			row.Blocking = strconv.Itoa(counts[result.ImpactBlocking])
+			row.Advisory = strconv.Itoa(counts[result.ImpactAdvisory])
+		}
+
+		if err := renderer.Append(row); err != nil {
+			return fmt.Errorf("failed to append row: %w", err)
+		}
+	}
+
+	if err := renderer.Render(); err != nil {
+		return fmt.Errorf("failed to render table: %w", err)
+	}
+
+	return nil
+}
+
+// stringPtrOrNil returns a pointer to s, or nil if s is empty.
+func stringPtrOrNil(s string) *string {
+	if s == "" {
+		return nil
+	}
+
+	return &s
+}
@@ -0,0 +1,139 @@
+package lint_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+
+	. "github.com/onsi/gomega"
+)
+
+// snapshotClients returns a factory reading the cluster of each context from a snapshot of a
+// 2.25.0 cluster; contexts not in healthy fail to connect.
+func snapshotClients(t *testing.T, healthy ...string) lint.ContextClientFactory {
+	t.Helper()
+
+	g := NewWithT(t)
+
+	dir := t.TempDir()
+	g.Expect(os.WriteFile(filepath.Join(dir, "dsc.yaml"), []byte(fixtureSnapshotDSC), 0o600)).To(Succeed())
+	g.Expect(os.WriteFile(filepath.Join(dir, "dsci.yaml"), []byte(fixtureSnapshotDSCI), 0o600)).To(Succeed())
+
+	return func(contextName string) (client.Client, client.Reader, error) {
+		for _, name := range healthy {
+			if name == contextName {
+				r, err := client.NewFileReader(dir)
+
+				return nil, r, err
+			}
+		}
+
+		return nil, nil, errors.New("connection refused")
+	}
+}
+
+func newFanOutCommand(factory lint.ContextClientFactory, out, errOut *bytes.Buffer) *lint.Command {
+	streams := genericiooptions.IOStreams{In: &bytes.Buffer{}, Out: out, ErrOut: errOut}
+
+	command := lint.NewCommand(streams, testConfigFlags(), lint.WithContextClientFactory(factory))
+	command.TargetVersion = "3.0.0"
+
+	return command
+}
+
+func TestCommand_Contexts(t *testing.T) {
+	t.Run("should group results per cluster", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var out, errOut bytes.Buffer
+
+		command := newFanOutCommand(snapshotClients(t, "east", "west"), &out, &errOut)
+		command.Contexts = []string{"east", "west"}
+		command.OutputFormat = lint.OutputFormatJSON
+
+		g.Expect(command.Complete()).To(Succeed())
+		g.Expect(command.Validate()).To(Succeed())
+
+		// Findings may produce a non-zero exit error; the report itself must still be rendered.
+		_ = command.Run(t.Context())
+
+		var list lint.ClusterReportList
+		g.Expect(json.Unmarshal(out.Bytes(), &list)).To(Succeed())
+		g.Expect(list.Kind).To(Equal("LintClusterReportList"))
+		g.Expect(list.Clusters).To(HaveExactElements(
+			And(HaveField("Context", "east"), HaveField("Error", BeEmpty())),
+			And(HaveField("Context", "west"), HaveField("Error", BeEmpty())),
+		))
+		g.Expect(*list.Clusters[0].Report.ClusterVersion).To(Equal("2.25.0"))
+		g.Expect(*list.Clusters[1].Report.TargetVersion).To(Equal("3.0.0"))
+	})
+
+	t.Run("should report unreachable clusters and keep going", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var out, errOut bytes.Buffer
+
+		command := newFanOutCommand(snapshotClients(t, "west"), &out, &errOut)
+		command.Contexts = []string{"east", "west"}
+
+		g.Expect(command.Complete()).To(Succeed())
+		g.Expect(command.Validate()).To(Succeed())
+
+		err := command.Run(t.Context())
+		g.Expect(err).To(HaveOccurred())
+
+		g.Expect(out.String()).To(ContainSubstring("=== Cluster east ===\nError: connection refused"))
+		g.Expect(out.String()).To(ContainSubstring("=== Cluster west ==="))
+		g.Expect(out.String()).To(ContainSubstring("Summary by cluster:"))
+
+		summary := out.String()[strings.Index(out.String(), "Summary by cluster:"):]
+		g.Expect(summary).To(MatchRegexp(`east\s+error`))
+		g.Expect(summary).To(MatchRegexp(`west\s+2\.25\.0\s+3\.0\.0`))
+	})
+
+	t.Run("should reject unsupported options", func(t *testing.T) {
+		tests := []struct {
+			name   string
+			modify func(c *lint.Command)
+			err    string
+		}{
+			{
+				name:   "both flags",
+				modify: func(c *lint.Command) { c.Contexts = []string{"east"}; c.AllContexts = true },
+				err:    "mutually exclusive",
+			},
+			{
+				name:   "html output",
+				modify: func(c *lint.Command) { c.AllContexts = true; c.OutputFormat = lint.OutputFormatHTML },
+				err:    "output format html",
+			},
+			{
+				name:   "from-dir",
+				modify: func(c *lint.Command) { c.AllContexts = true; c.FromDir = t.TempDir() },
+				err:    "--from-dir",
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				g := NewWithT(t)
+
+				var out, errOut bytes.Buffer
+
+				command := newFanOutCommand(snapshotClients(t), &out, &errOut)
+				tt.modify(command)
+
+				g.Expect(command.Validate()).To(MatchError(ContainSubstring(tt.err)))
+			})
+		}
+	})
+}
@@ -0,0 +1,218 @@
+package lint
+
+import (
+	"cmp"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/printer/table"
+)
+
+// GroupBy selects an additional aggregation of the findings in table, JSON and YAML output.
+type GroupBy string
+
+const (
+	// GroupByNone disables aggregation.
+	GroupByNone GroupBy = ""
+
+	// GroupByNamespace aggregates the impacted objects of failing checks per namespace.
+	GroupByNamespace GroupBy = "namespace"
+)
+
+// Validate checks if the group-by value is valid.
+func (g GroupBy) Validate() error {
+	switch g {
+	case GroupByNone, GroupByNamespace:
+		return nil
+	default:
+		return fmt.Errorf("invalid group-by: %s (must be: namespace)", g)
+	}
+}
+
+// GroupRender selects whether verbose table output renders impacted objects with the group
+// renderer registered for their annotations (see check.GroupRendererRegistry).
+type GroupRender string
+
+const (
+	// GroupRenderOn groups impacted objects by annotation where a renderer applies.
+	GroupRenderOn GroupRender = "on"
+
+	// GroupRenderOff lists the impacted objects of grouped results by namespace instead.
+	GroupRenderOff GroupRender = "off"
+)
+
+// Validate checks if the group-render value is valid.
+func (g GroupRender) Validate() error {
+	switch g {
+	case GroupRenderOn, GroupRenderOff:
+		return nil
+	default:
+		return fmt.Errorf("invalid group-render: %s (must be one of: on, off)", g)
+	}
+}
+
+// SortBy selects the order of the findings in table output.
+type SortBy string
+
+const (
+	// SortByDefault lists the findings in one table per check group, most severe first.
+	SortByDefault SortBy = ""
+
+	// SortByScore lists the findings in a single table, highest risk score first (see
+	// result.DiagnosticResult.ScoreFor), to remediate the most valuable findings first.
+	SortByScore SortBy = "score"
+)
+
+// Validate checks if the sort-by value is valid.
+func (s SortBy) Validate() error {
+	switch s {
+	case SortByDefault, SortByScore:
+		return nil
+	default:
+		return fmt.Errorf("invalid sort-by: %s (must be: score)", s)
+	}
+}
+
+// SummarizeByNamespace aggregates the impacted objects of failing checks per namespace, so
+// each project owner can be given the list of their own workloads to act on. Cluster-scoped
+// objects and passing checks are ignored. Namespaces are sorted by name and their findings
+// by impact (most severe first), check ID and object.
+func SummarizeByNamespace(results []check.CheckExecution, requesters map[string]string) []result.NamespaceSummary {
+	index := make(map[string]*result.NamespaceSummary)
+
+	for _, exec := range results {
+		if exec.Result == nil {
+			continue
+		}
+
+		impact := exec.Result.GetImpact()
+		if impact == result.ImpactNone {
+			continue
+		}
+
+		for _, obj := range exec.Result.ImpactedObjects {
+			if obj.Namespace == "" {
+				continue
+			}
+
+			summary, ok := index[obj.Namespace]
+			if !ok {
+				summary = &result.NamespaceSummary{
+					Namespace: obj.Namespace,
+					Requester: requesters[obj.Namespace],
+				}
+				index[obj.Namespace] = summary
+			}
+
+			finding := result.NamespaceFinding{
+				Check:  exec.Check.ID(),
+				Impact: impact,
+				Kind:   obj.Kind,
+				Name:   obj.Name,
+			}
+
+			if slices.Contains(summary.Findings, finding) {
+				continue
+			}
+
+			summary.Findings = append(summary.Findings, finding)
+
+			switch impact {
+			case result.ImpactProhibited:
+				summary.Prohibited++
+			case result.ImpactBlocking:
+				summary.Blocking++
+			case result.ImpactAdvisory:
+				summary.Advisory++
+			case result.ImpactNone:
+				// Filtered out above.
+			}
+		}
+	}
+
+	summaries := make([]result.NamespaceSummary, 0, len(index))
+
+	for _, summary := range index {
+		slices.SortFunc(summary.Findings, func(a, b result.NamespaceFinding) int {
+			return cmp.Or(
+				cmp.Compare(impactSortPriority(a.Impact), impactSortPriority(b.Impact)),
+				cmp.Compare(a.Check, b.Check),
+				cmp.Compare(a.Kind, b.Kind),
+				cmp.Compare(a.Name, b.Name),
+			)
+		})
+
+		summaries = append(summaries, *summary)
+	}
+
+	slices.SortFunc(summaries, func(a, b result.NamespaceSummary) int {
+		return cmp.Compare(a.Namespace, b.Namespace)
+	})
+
+	return summaries
+}
+
+type namespaceSummaryRow struct {
+	Namespace  string
+	Requester  string
+	Prohibited string
+	Blocking   string
+	Advisory   string
+}
+
+// outputNamespaceSummaries prints a table with the finding counts of each namespace followed
+// by the impacted objects of each namespace.
+func outputNamespaceSummaries(out io.Writer, summaries []result.NamespaceSummary) error {
+	_, _ = fmt.Fprintln(out)
+	_, _ = fmt.Fprintln(out, "Findings by Namespace:")
+
+	if len(summaries) == 0 {
+		_, _ = fmt.Fprintln(out, "  No namespaced objects are impacted.")
+
+		return nil
+	}
+
+	_, _ = fmt.Fprintln(out)
+
+	renderer := table.NewRenderer[namespaceSummaryRow](
+		table.WithWriter[namespaceSummaryRow](out),
+		table.WithHeaders[namespaceSummaryRow]("NAMESPACE", "REQUESTER", "PROHIBITED", "BLOCKING", "ADVISORY"),
+		table.WithTableOptions[namespaceSummaryRow](table.DefaultTableOptions...),
+	)
+
+	for _, s := range summaries {
+		if err := renderer.Append(namespaceSummaryRow{
+			Namespace:  s.Namespace,
+			Requester:  s.Requester,
+			Prohibited: strconv.Itoa(s.Prohibited),
+			Blocking:   strconv.Itoa(s.Blocking),
+			Advisory:   strconv.Itoa(s.Advisory),
+		}); err != nil {
+			return fmt.Errorf("appending table row: %w", err)
+		}
+	}
+
+	if err := renderer.Render(); err != nil {
+		return fmt.Errorf("rendering table: %w", err)
+	}
+
+	for _, s := range summaries {
+		_, _ = fmt.Fprintln(out)
+
+		if s.Requester != "" {
+			_, _ = fmt.Fprintf(out, "namespace: %s | requester: %s\n", s.Namespace, s.Requester)
+		} else {
+			_, _ = fmt.Fprintf(out, "namespace: %s\n", s.Namespace)
+		}
+
+		for _, f := range s.Findings {
+			_, _ = fmt.Fprintf(out, "  %s %-10s %s/%s (%s)\n", statusSymbol(f.Impact), f.Impact, f.Kind, f.Name, f.Check)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,154 @@
+package lint_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/messages"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+
+	. "github.com/onsi/gomega"
+)
+
+func newGroupByResults() []check.CheckExecution {
+	return []check.CheckExecution{
+		newMetricsExecution("workloads.notebook.stopped", result.ImpactAdvisory,
+			newMetricsObject("Notebook", "team-a", "nb-1"),
+			newMetricsObject("Notebook", "team-b", "nb-2"),
+		),
+		newMetricsExecution("workloads.kserve.modelmesh", result.ImpactBlocking,
+			newMetricsObject("InferenceService", "team-a", "model"),
+			newMetricsObject("InferenceService", "team-a", "model"),
+			newMetricsObject("ClusterServingRuntime", "", "cluster-scoped"),
+		),
+		newMetricsExecution("workloads.ray.passing", result.ImpactNone,
+			newMetricsObject("RayCluster", "team-c", "ray"),
+		),
+	}
+}
+
+func TestSummarizeByNamespace(t *testing.T) {
+	g := NewWithT(t)
+
+	summaries := lint.SummarizeByNamespace(newGroupByResults(), map[string]string{"team-a": "alice@example.com"})
+
+	g.Expect(summaries).To(Equal([]result.NamespaceSummary{
+		{
+			Namespace: "team-a",
+			Requester: "alice@example.com",
+			Blocking:  1,
+			Advisory:  1,
+			Findings: []result.NamespaceFinding{
+				{Check: "workloads.kserve.modelmesh", Impact: result.ImpactBlocking, Kind: "InferenceService", Name: "model"},
+				{Check: "workloads.notebook.stopped", Impact: result.ImpactAdvisory, Kind: "Notebook", Name: "nb-1"},
+			},
+		},
+		{
+			Namespace: "team-b",
+			Advisory:  1,
+			Findings: []result.NamespaceFinding{
+				{Check: "workloads.notebook.stopped", Impact: result.ImpactAdvisory, Kind: "Notebook", Name: "nb-2"},
+			},
+		},
+	}))
+}
+
+func TestOutputTable_GroupByNamespace(t *testing.T) {
+	g := NewWithT(t)
+
+	var buf bytes.Buffer
+	err := lint.OutputTable(&buf, newGroupByResults(), lint.TableOutputOptions{
+		GroupBy:             lint.GroupByNamespace,
+		NamespaceRequesters: map[string]string{"team-a": "alice@example.com"},
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+
+	output := buf.String()
+	g.Expect(output).To(ContainSubstring("Findings by Namespace:"))
+	g.Expect(output).To(ContainSubstring("namespace: team-a | requester: alice@example.com"))
+	g.Expect(output).To(ContainSubstring("InferenceService/model (workloads.kserve.modelmesh)"))
+	g.Expect(output).To(ContainSubstring("namespace: team-b\n"))
+	g.Expect(output).ToNot(ContainSubstring("team-c"))
+}
+
+func TestOutputJSON_GroupByNamespace(t *testing.T) {
+	g := NewWithT(t)
+
+	results := newGroupByResults()
+
+	var buf bytes.Buffer
+	g.Expect(lint.OutputJSON(&buf, results, messages.Printer{}, nil, nil, nil, lint.SummarizeByNamespace(results, nil), nil)).To(Succeed())
+
+	var list result.DiagnosticResultList
+	g.Expect(json.Unmarshal(buf.Bytes(), &list)).To(Succeed())
+	g.Expect(list.Namespaces).To(HaveLen(2))
+	g.Expect(list.Namespaces[0].Namespace).To(Equal("team-a"))
+	g.Expect(list.Namespaces[0].Blocking).To(Equal(1))
+}
+
+func TestGroupBy_Validate(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(lint.GroupByNone.Validate()).To(Succeed())
+	g.Expect(lint.GroupByNamespace.Validate()).To(Succeed())
+	g.Expect(lint.GroupBy("owner").Validate()).To(MatchError(ContainSubstring("invalid group-by")))
+}
+
+func TestOutputTable_SortByScore(t *testing.T) {
+	g := NewWithT(t)
+
+	prohibited := newReportExecution("component", "prohibited-removal", result.ImpactProhibited)
+
+	blocking := newReportExecution("workload", "blocking-workloads", result.ImpactBlocking)
+	for _, name := range []string{"a", "b", "c", "d", "e"} {
+		blocking.Result.ImpactedObjects = append(blocking.Result.ImpactedObjects, newMetricsObject("InferenceService", "team-a", name))
+	}
+
+	inUse := newReportExecution("workload", "advisory-in-use", result.ImpactAdvisory)
+	inUse.Result.Annotations[result.AnnotationImpactedCount] = "40"
+	inUse.Result.ImpactedObjects = []metav1.PartialObjectMetadata{newMetricsObject("Notebook", "team-a", "wb")}
+	inUse.Result.ImpactedObjects[0].Annotations = map[string]string{result.AnnotationObjectUsage: result.ObjectUsageActive}
+
+	results := []check.CheckExecution{
+		newReportExecution("dependency", "advisory-dependency", result.ImpactAdvisory),
+		newReportExecution("service", "passing-service", result.ImpactNone),
+		blocking,
+		inUse,
+		prohibited,
+	}
+
+	var buf bytes.Buffer
+	g.Expect(lint.OutputTable(&buf, results, lint.TableOutputOptions{SortBy: lint.SortByScore})).To(Succeed())
+
+	output := buf.String()
+
+	// A single table, highest score first: 100, 80, 50, 1, then the passing check
+	order := []string{"prohibited-removal finding", "advisory-in-use finding", "blocking-workloads finding",
+		"advisory-dependency finding", "passing-service finding"}
+	last := -1
+
+	for _, message := range order {
+		idx := strings.Index(output, message)
+		g.Expect(idx).To(BeNumerically(">", last), message)
+		last = idx
+	}
+
+	g.Expect(strings.Count(output, "STATUS")).To(Equal(1))
+	g.Expect(output).To(ContainSubstring("SCORE"))
+	g.Expect(output).To(MatchRegexp(`100\s+\S*\s*prohibited-removal finding`))
+	g.Expect(output).To(ContainSubstring("Total: 5 | Passed: 1 | Warnings: 2 | Failed: 1 | Prohibited: 1"))
+}
+
+func TestSortBy_Validate(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(lint.SortByDefault.Validate()).To(Succeed())
+	g.Expect(lint.SortByScore.Validate()).To(Succeed())
+	g.Expect(lint.SortBy("impact").Validate()).To(MatchError(ContainSubstring("invalid sort-by")))
+}
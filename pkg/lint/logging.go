@@ -0,0 +1,40 @@
+package lint
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/opendatahub-io/odh-cli/pkg/util/logging"
+)
+
+// openLog creates the logger receiving the structured diagnostics of checks, writing to
+// --log-file or stderr. The returned function closes the log file, if any.
+func (c *Command) openLog() (func(), error) {
+	var (
+		w       io.Writer = c.IO.ErrOut()
+		closeFn           = func() {}
+	)
+
+	if c.LogFile != "" {
+		//nolint:gosec // The log file path is provided by the user
+		f, err := os.OpenFile(c.LogFile, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o600)
+		if err != nil {
+			return nil, fmt.Errorf("opening log file: %w", err)
+		}
+
+		w = f
+		closeFn = func() { _ = f.Close() }
+	}
+
+	logger, err := logging.New(w, c.LogLevel, c.LogFormat)
+	if err != nil {
+		closeFn()
+
+		return nil, err
+	}
+
+	c.logger = logger
+
+	return closeFn, nil
+}
@@ -0,0 +1,62 @@
+//nolint:testpackage // internal test: verifies the translations against the checks of newDefaultRegistry
+package lint
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/messages"
+
+	. "github.com/onsi/gomega"
+)
+
+// missingArg matches the placeholder fmt renders for a verb without argument, e.g. %!d(MISSING).
+//
+//nolint:gochecknoglobals // Test fixture - shared across test functions
+var missingArg = regexp.MustCompile(`%!.\(MISSING\)`)
+
+func TestTranslations_CoverCatalog(t *testing.T) {
+	registry := newDefaultRegistry()
+
+	for _, lang := range messages.Languages()[1:] {
+		t.Run(lang, func(t *testing.T) {
+			g := NewWithT(t)
+
+			printer, err := messages.NewPrinter(lang)
+			g.Expect(err).ToNot(HaveOccurred())
+
+			for _, id := range messages.IDs() {
+				m, _ := messages.Lookup(id)
+
+				// Formatting without arguments renders every verb as a placeholder, in order
+				text, ok := printer.Translate(id)
+				g.Expect(ok).To(BeTrue(), "%s: missing translation of %s", lang, id)
+				g.Expect(missingArg.FindAllString(text, -1)).To(Equal(missingArg.FindAllString(m.Sprintf(), -1)),
+					"%s: %s must use the verbs of the English message", lang, id)
+			}
+
+			known := make(map[string]bool)
+			for _, id := range messages.IDs() {
+				known[id] = true
+			}
+
+			for _, chk := range registry.ListAll() {
+				provider, ok := chk.(check.RemediationProvider)
+				if !ok || provider.Remediation() == "" {
+					continue
+				}
+
+				id := messages.RemediationID(chk.ID())
+				known[id] = true
+
+				_, ok = printer.Translate(id)
+				g.Expect(ok).To(BeTrue(), "%s: missing translation of the remediation of %s", lang, chk.ID())
+			}
+
+			for _, id := range messages.Translated(lang) {
+				g.Expect(known).To(HaveKey(id), "%s: unknown message ID %s", lang, id)
+			}
+		})
+	}
+}
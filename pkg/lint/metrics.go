@@ -0,0 +1,273 @@
+package lint
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+)
+
+const (
+	metricsJob            = "odh_lint"
+	metricsContentType    = "text/plain; version=0.0.4"
+	metricsPushTimeout    = 30 * time.Second
+	metricsMaxErrorBody   = 1024
+	metricsFilePermission = 0o644
+
+	msgMetricsWritten = "Metrics written to %s"
+	msgMetricsPushed  = "Metrics pushed to %s"
+)
+
+// metricFamily describes one gauge of the lint metrics.
+type metricFamily struct {
+	name    string
+	help    string
+	samples []metricSample
+}
+
+type metricSample struct {
+	labels [][2]string
+	value  float64
+}
+
+// WriteMetrics writes lint results as Prometheus gauges in the text exposition format, as
+// read by the node_exporter textfile collector and accepted by the Pushgateway.
+//
+// Finding gauges are emitted for every executed check, including passing ones with a value of
+// 0, so alerts resolve once findings are fixed. A finding is a non-passing condition scoped to
+// one impacted object, as recorded in lint baselines.
+func WriteMetrics(w io.Writer, results []check.CheckExecution, clusterVersion string, targetVersion string, now time.Time) error {
+	findings := map[result.Impact]*metricFamily{
+		result.ImpactProhibited: {
+			name: "odh_lint_prohibited_findings",
+			help: "Number of prohibited findings reported by a lint check.",
+		},
+		result.ImpactBlocking: {
+			name: "odh_lint_blocking_findings",
+			help: "Number of blocking findings reported by a lint check.",
+		},
+		result.ImpactAdvisory: {
+			name: "odh_lint_advisory_findings",
+			help: "Number of advisory findings reported by a lint check.",
+		},
+	}
+
+	workloads := make(map[string]map[string]struct{})
+	executed := 0
+
+	for _, exec := range results {
+		if exec.Result == nil || exec.Check == nil {
+			continue
+		}
+
+		executed++
+
+		counts := make(map[result.Impact]int)
+		for _, f := range exec.Result.Findings(exec.Check.ID()) {
+			counts[f.Impact]++
+		}
+
+		labels := [][2]string{{"check", exec.Check.ID()}, {"group", string(exec.Check.Group())}}
+		for impact, family := range findings {
+			family.samples = append(family.samples, metricSample{labels: labels, value: float64(counts[impact])})
+		}
+
+		if exec.Result.GetImpact() == result.ImpactNone {
+			continue
+		}
+
+		for i := range exec.Result.ImpactedObjects {
+			obj := &exec.Result.ImpactedObjects[i]
+			if workloads[obj.Kind] == nil {
+				workloads[obj.Kind] = make(map[string]struct{})
+			}
+
+			workloads[obj.Kind][result.ObjectIdentity(obj)] = struct{}{}
+		}
+	}
+
+	impacted := &metricFamily{
+		name: "odh_lint_impacted_workloads",
+		help: "Number of distinct objects of a kind impacted by non-passing lint checks.",
+	}
+	for kind, objects := range workloads {
+		impacted.samples = append(impacted.samples, metricSample{labels: [][2]string{{"kind", kind}}, value: float64(len(objects))})
+	}
+
+	families := []*metricFamily{
+		{
+			name: "odh_lint_info",
+			help: "Versions assessed by the last lint run.",
+			samples: []metricSample{{
+				labels: [][2]string{{"current_version", clusterVersion}, {"target_version", targetVersion}},
+				value:  1,
+			}},
+		},
+		{
+			name:    "odh_lint_checks",
+			help:    "Number of lint checks executed by the last run.",
+			samples: []metricSample{{value: float64(executed)}},
+		},
+		{
+			name:    "odh_lint_last_run_timestamp_seconds",
+			help:    "Unix time of the last lint run.",
+			samples: []metricSample{{value: float64(now.Unix())}},
+		},
+		findings[result.ImpactProhibited],
+		findings[result.ImpactBlocking],
+		findings[result.ImpactAdvisory],
+		impacted,
+	}
+
+	var b strings.Builder
+
+	for _, family := range families {
+		writeMetricFamily(&b, family)
+	}
+
+	if _, err := io.WriteString(w, b.String()); err != nil {
+		return fmt.Errorf("writing metrics: %w", err)
+	}
+
+	return nil
+}
+
+func writeMetricFamily(b *strings.Builder, family *metricFamily) {
+	fmt.Fprintf(b, "# HELP %s %s\n", family.name, family.help)
+	fmt.Fprintf(b, "# TYPE %s gauge\n", family.name)
+
+	lines := make([]string, 0, len(family.samples))
+
+	for _, sample := range family.samples {
+		var line strings.Builder
+
+		line.WriteString(family.name)
+
+		if len(sample.labels) > 0 {
+			pairs := make([]string, 0, len(sample.labels))
+			for _, label := range sample.labels {
+				pairs = append(pairs, label[0]+`="`+escapeLabelValue(label[1])+`"`)
+			}
+
+			line.WriteString("{" + strings.Join(pairs, ",") + "}")
+		}
+
+		line.WriteString(" " + strconv.FormatFloat(sample.value, 'f', -1, 64))
+		lines = append(lines, line.String())
+	}
+
+	slices.Sort(lines)
+
+	for _, line := range lines {
+		b.WriteString(line)
+		b.WriteString("\n")
+	}
+}
+
+// escapeLabelValue escapes a label value for the Prometheus text format.
+func escapeLabelValue(v string) string {
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(v)
+}
+
+// WriteMetricsFile writes the metrics to path atomically, so the textfile collector never
+// reads a partially written file.
+func WriteMetricsFile(path string, metrics []byte) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".tmp-*")
+	if err != nil {
+		return fmt.Errorf("creating metrics file: %w", err)
+	}
+
+	defer func() { _ = os.Remove(tmp.Name()) }()
+
+	if _, err := tmp.Write(metrics); err != nil {
+		_ = tmp.Close()
+
+		return fmt.Errorf("writing metrics file: %w", err)
+	}
+
+	if err := tmp.Chmod(metricsFilePermission); err != nil {
+		_ = tmp.Close()
+
+		return fmt.Errorf("writing metrics file: %w", err)
+	}
+
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("writing metrics file: %w", err)
+	}
+
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("writing metrics file: %w", err)
+	}
+
+	return nil
+}
+
+// PushMetrics replaces the metrics of the odh_lint job on a Prometheus Pushgateway.
+func PushMetrics(ctx context.Context, gatewayURL string, metrics []byte) error {
+	ctx, cancel := context.WithTimeout(ctx, metricsPushTimeout)
+	defer cancel()
+
+	url := strings.TrimSuffix(gatewayURL, "/") + "/metrics/job/" + metricsJob
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, url, bytes.NewReader(metrics))
+	if err != nil {
+		return fmt.Errorf("creating push request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", metricsContentType)
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("pushing metrics: %w", err)
+	}
+
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode/100 != 2 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, metricsMaxErrorBody))
+
+		return fmt.Errorf("pushing metrics to %s: HTTP %d: %s", url, resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+
+	return nil
+}
+
+// publishMetrics writes and/or pushes the metrics selected by --metrics-file and --push-gateway.
+func (c *Command) publishMetrics(ctx context.Context, results []check.CheckExecution) error {
+	if c.MetricsFile == "" && c.PushGateway == "" {
+		return nil
+	}
+
+	var buf bytes.Buffer
+	if err := WriteMetrics(&buf, results, c.currentClusterVersion, c.TargetVersion, time.Now()); err != nil {
+		return err
+	}
+
+	if c.MetricsFile != "" {
+		if err := WriteMetricsFile(c.MetricsFile, buf.Bytes()); err != nil {
+			return err
+		}
+
+		c.IO.Errorf(msgMetricsWritten, c.MetricsFile)
+	}
+
+	if c.PushGateway != "" {
+		if err := PushMetrics(ctx, c.PushGateway, buf.Bytes()); err != nil {
+			return err
+		}
+
+		c.IO.Errorf(msgMetricsPushed, c.PushGateway)
+	}
+
+	return nil
+}
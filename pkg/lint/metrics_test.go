@@ -0,0 +1,146 @@
+package lint_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+
+	. "github.com/onsi/gomega"
+)
+
+type metricsTestCheck struct {
+	check.BaseCheck
+}
+
+func (c *metricsTestCheck) CanApply(_ context.Context, _ check.Target) (bool, error) {
+	return true, nil
+}
+
+func (c *metricsTestCheck) Validate(_ context.Context, _ check.Target) (*result.DiagnosticResult, error) {
+	return c.NewResult(), nil
+}
+
+func newMetricsExecution(id string, impact result.Impact, objects ...metav1.PartialObjectMetadata) check.CheckExecution {
+	exec := newReportExecution("workload", id, impact)
+	exec.Check = &metricsTestCheck{BaseCheck: check.BaseCheck{CheckGroup: check.GroupWorkload, CheckID: id}}
+	exec.Result.ImpactedObjects = objects
+
+	return exec
+}
+
+func newMetricsObject(kind, namespace, name string) metav1.PartialObjectMetadata {
+	return metav1.PartialObjectMetadata{
+		TypeMeta:   metav1.TypeMeta{Kind: kind},
+		ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+	}
+}
+
+func TestWriteMetrics(t *testing.T) {
+	g := NewWithT(t)
+
+	results := []check.CheckExecution{
+		newMetricsExecution("workloads.kserve.blocking", result.ImpactBlocking,
+			newMetricsObject("InferenceService", "team-a", "model"),
+			newMetricsObject("InferenceService", "team-b", "model"),
+		),
+		newMetricsExecution("workloads.notebook.advisory", result.ImpactAdvisory,
+			newMetricsObject("Notebook", "team-a", "wb"),
+			newMetricsObject("InferenceService", "team-a", "model"),
+		),
+		newMetricsExecution("workloads.notebook.passing", result.ImpactNone,
+			newMetricsObject("Notebook", "team-a", "ignored"),
+		),
+		{Result: nil},
+	}
+
+	var buf bytes.Buffer
+	err := lint.WriteMetrics(&buf, results, "2.25.0", "3.0.0", time.Unix(1700000000, 0))
+	g.Expect(err).ToNot(HaveOccurred())
+
+	out := buf.String()
+
+	g.Expect(out).To(ContainSubstring("# TYPE odh_lint_blocking_findings gauge\n"))
+	g.Expect(out).To(ContainSubstring(`odh_lint_info{current_version="2.25.0",target_version="3.0.0"} 1` + "\n"))
+	g.Expect(out).To(ContainSubstring("odh_lint_checks 3\n"))
+	g.Expect(out).To(ContainSubstring("odh_lint_last_run_timestamp_seconds 1700000000\n"))
+	g.Expect(out).To(ContainSubstring(`odh_lint_blocking_findings{check="workloads.kserve.blocking",group="workload"} 2` + "\n"))
+	g.Expect(out).To(ContainSubstring(`odh_lint_blocking_findings{check="workloads.notebook.passing",group="workload"} 0` + "\n"))
+	g.Expect(out).To(ContainSubstring(`odh_lint_advisory_findings{check="workloads.notebook.advisory",group="workload"} 2` + "\n"))
+	g.Expect(out).To(ContainSubstring(`odh_lint_impacted_workloads{kind="InferenceService"} 2` + "\n"))
+	g.Expect(out).To(ContainSubstring(`odh_lint_impacted_workloads{kind="Notebook"} 1` + "\n"))
+}
+
+func TestWriteMetricsFile(t *testing.T) {
+	g := NewWithT(t)
+
+	path := filepath.Join(t.TempDir(), "odh_lint.prom")
+	g.Expect(lint.WriteMetricsFile(path, []byte("odh_lint_checks 1\n"))).To(Succeed())
+
+	data, err := os.ReadFile(path)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(string(data)).To(Equal("odh_lint_checks 1\n"))
+
+	entries, err := os.ReadDir(filepath.Dir(path))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(entries).To(HaveLen(1))
+}
+
+func TestPushMetrics(t *testing.T) {
+	t.Run("should PUT metrics to the job endpoint", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var method, path, contentType, body string
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			data, _ := io.ReadAll(r.Body)
+			method, path, contentType, body = r.Method, r.URL.Path, r.Header.Get("Content-Type"), string(data)
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer server.Close()
+
+		g.Expect(lint.PushMetrics(t.Context(), server.URL+"/", []byte("odh_lint_checks 1\n"))).To(Succeed())
+		g.Expect(method).To(Equal(http.MethodPut))
+		g.Expect(path).To(Equal("/metrics/job/odh_lint"))
+		g.Expect(contentType).To(HavePrefix("text/plain"))
+		g.Expect(body).To(Equal("odh_lint_checks 1\n"))
+	})
+
+	t.Run("should report gateway errors", func(t *testing.T) {
+		g := NewWithT(t)
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			http.Error(w, "bad metrics", http.StatusBadRequest)
+		}))
+		defer server.Close()
+
+		err := lint.PushMetrics(t.Context(), server.URL, []byte("x"))
+		g.Expect(err).To(MatchError(ContainSubstring("HTTP 400: bad metrics")))
+	})
+}
+
+func TestCommand_ValidatePushGateway(t *testing.T) {
+	g := NewWithT(t)
+
+	command := lint.NewCommand(genericiooptions.IOStreams{
+		In: &bytes.Buffer{}, Out: &bytes.Buffer{}, ErrOut: &bytes.Buffer{},
+	}, testConfigFlags())
+	command.PushGateway = "pushgateway:9091"
+
+	g.Expect(command.Validate()).To(MatchError(ContainSubstring("invalid --push-gateway URL")))
+
+	command.PushGateway = "http://pushgateway:9091"
+	g.Expect(command.Validate()).To(Succeed())
+}
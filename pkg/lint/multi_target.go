@@ -0,0 +1,380 @@
+package lint
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/blang/semver/v4"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/output"
+	"github.com/opendatahub-io/odh-cli/pkg/printer/table"
+	clierrors "github.com/opendatahub-io/odh-cli/pkg/util/errors"
+	"github.com/opendatahub-io/odh-cli/pkg/util/kube/olm"
+	"github.com/opendatahub-io/odh-cli/pkg/util/version"
+)
+
+const targetReportListKind = "LintTargetReportList"
+
+// TargetReportList is the structured output of a run assessing several target versions.
+type TargetReportList struct {
+	output.Envelope
+
+	ClusterVersion   string `json:"clusterVersion"             yaml:"clusterVersion"`
+	OpenShiftVersion string `json:"openShiftVersion,omitempty" yaml:"openShiftVersion,omitempty"`
+
+	// Targets holds one report per target version, oldest first.
+	Targets []TargetReport `json:"targets" yaml:"targets"`
+}
+
+// TargetReport is the assessment of one target version of a multi-target run.
+type TargetReport struct {
+	TargetVersion string `json:"targetVersion" yaml:"targetVersion"`
+
+	// NewBlockers lists the IDs of the checks blocking the upgrade to this target but not to
+	// the previous one, sorted. For the first target, every blocking check is new.
+	NewBlockers []string `json:"newBlockers,omitempty" yaml:"newBlockers,omitempty"`
+
+	Report *result.DiagnosticResultList `json:"report" yaml:"report"`
+}
+
+type targetSummaryRow struct {
+	Target      string
+	Prohibited  string
+	Blocking    string
+	Advisory    string
+	NewBlockers string
+}
+
+// targetRun holds the results of one target version of a multi-target run.
+type targetRun struct {
+	target      semver.Version
+	results     []check.CheckExecution
+	newBlockers []string
+}
+
+// isMultiTarget reports whether the run assesses several target versions.
+func (c *Command) isMultiTarget() bool {
+	return c.UntilVersion != "" || strings.Contains(c.TargetVersion, ",")
+}
+
+// parseTargetVersions parses a comma-separated list of target versions, returned sorted and
+// without duplicates.
+func parseTargetVersions(list string) ([]semver.Version, error) {
+	versions := make([]semver.Version, 0)
+
+	for raw := range strings.SplitSeq(list, ",") {
+		raw = strings.TrimSpace(raw)
+		if raw == "" {
+			continue
+		}
+
+		if isTargetVersionAlias(raw) {
+			return nil, fmt.Errorf("target version alias %q cannot be combined with other target versions", raw)
+		}
+
+		v, err := semver.ParseTolerant(raw)
+		if err != nil {
+			return nil, fmt.Errorf("invalid target version %q: %w", raw, err)
+		}
+
+		versions = append(versions, v)
+	}
+
+	slices.SortFunc(versions, func(a, b semver.Version) int { return a.Compare(b) })
+
+	return slices.CompactFunc(versions, func(a, b semver.Version) bool { return a.EQ(b) }), nil
+}
+
+// UpgradeHops returns the target versions from current up to until: the newest patch of
+// every minor release of the catalog after the current one, up to and including the minor
+// release of until. The catalog versions must be sorted from oldest to newest.
+func UpgradeHops(current, until semver.Version, versions []olm.CatalogVersion) []semver.Version {
+	hops := make([]semver.Version, 0)
+
+	for _, cv := range versions {
+		v := cv.Version
+		if !minorAfter(v, current) || minorAfter(v, until) {
+			continue
+		}
+
+		// A newer patch of the same minor replaces the previous hop
+		if n := len(hops); n > 0 && version.SameMajorMinor(&hops[n-1], &v) {
+			hops[n-1] = v
+
+			continue
+		}
+
+		hops = append(hops, v)
+	}
+
+	return hops
+}
+
+// minorAfter reports whether the minor release of a is after the minor release of b.
+func minorAfter(a, b semver.Version) bool {
+	return a.Major > b.Major || (a.Major == b.Major && a.Minor > b.Minor)
+}
+
+// resolveUntilVersion expands --until-version into the upgrade hops offered by the operator
+// catalog once the current version is known. Later calls keep the first resolution.
+func (c *Command) resolveUntilVersion(ctx context.Context, currentVersion *semver.Version) error {
+	if c.UntilVersion == "" || len(c.targetVersions) > 0 {
+		return nil
+	}
+
+	versions, err := olm.ListOperatorCatalogVersions(ctx, c.Reader)
+	if err != nil {
+		return fmt.Errorf("listing operator catalog versions: %w", err)
+	}
+
+	var until semver.Version
+	if isTargetVersionAlias(c.UntilVersion) {
+		until, err = ResolveTargetVersion(c.UntilVersion, *currentVersion, versions)
+	} else {
+		until, err = semver.ParseTolerant(c.UntilVersion)
+	}
+
+	if err != nil {
+		return fmt.Errorf("resolving --until-version %s: %w", c.UntilVersion, err)
+	}
+
+	c.targetVersions = UpgradeHops(*currentVersion, until, versions)
+	if len(c.targetVersions) == 0 {
+		return fmt.Errorf("resolving --until-version %s: no version between %s and %s found in the catalog",
+			c.UntilVersion, currentVersion, until)
+	}
+
+	hops := make([]string, 0, len(c.targetVersions))
+	for _, v := range c.targetVersions {
+		hops = append(hops, v.String())
+	}
+
+	c.IO.Errorf("Resolved --until-version %s to target versions %s from the operator catalog",
+		c.UntilVersion, strings.Join(hops, ", "))
+
+	return nil
+}
+
+// validateMultiTarget checks the options of a run assessing several target versions.
+func (c *Command) validateMultiTarget() error {
+	if !c.isMultiTarget() {
+		return nil
+	}
+
+	switch {
+	case c.UntilVersion != "" && c.TargetVersion != "":
+		return errors.New("--until-version and --target-version are mutually exclusive")
+	case !c.OutputFormat.isTable() && !slices.Contains([]OutputFormat{OutputFormatJSON, OutputFormatYAML}, c.OutputFormat):
+		return fmt.Errorf("multiple target versions are not supported with output format %s (must be one of: table, wide, json, yaml)",
+			c.OutputFormat)
+	case c.Watch || c.Fix || c.Record || c.SplitBy != SplitByNone || c.BaselineWrite != "" ||
+		c.MetricsFile != "" || c.PushGateway != "" || c.ValidateOutput:
+		return errors.New("multiple target versions cannot be used with --watch, --fix, --record, --split-by, " +
+			"--baseline-write, --metrics-file, --push-gateway or --validate-output")
+	}
+
+	return nil
+}
+
+// runMultiTargetMode assesses upgrade readiness for each target version in turn, from the
+// current version, and renders one section per target.
+func (c *Command) runMultiTargetMode(ctx context.Context, currentVersion *semver.Version) error {
+	runs := make([]targetRun, 0, len(c.targetVersions))
+	execSummary := execErrorSummary{exitCode: clierrors.ExitSuccess}
+
+	var allResults []check.CheckExecution
+
+	for _, target := range c.targetVersions {
+		c.TargetVersion = target.String()
+		c.parsedTargetVersion = &target
+
+		upgrade, err := c.isUpgrade(currentVersion)
+		if err != nil {
+			return err
+		}
+
+		if !upgrade {
+			//nolint:wrapcheck // NewExitCodeError is a same-module constructor, not an external error
+			return clierrors.NewExitCodeError(clierrors.ExitValidation,
+				fmt.Errorf("target version %s is the current version %s (each target must be a later minor release)",
+					target, currentVersion))
+		}
+
+		results, summary, _, err := c.executeUpgradeChecks(ctx, currentVersion, true)
+		if err != nil {
+			return err
+		}
+
+		runs = append(runs, targetRun{target: target, results: results})
+		allResults = append(allResults, results...)
+		execSummary = mergeExecSummaries(execSummary, summary)
+	}
+
+	markNewBlockers(runs)
+
+	if err := c.writeTargetRuns(ctx, runs); err != nil {
+		return err
+	}
+
+	findingsErr := c.evaluateVerdict(allResults)
+	c.printExecErrorSummary(execSummary)
+
+	if c.ExitCodeMode == ExitCodeModeOutcome {
+		return resolveOutcomeExitError(execSummary, findingsErr, c.OutputFormat)
+	}
+
+	return resolveExitError(execSummary, findingsErr, c.OutputFormat)
+}
+
+// mergeExecSummaries combines the execution errors and statistics of two runs, keeping the
+// highest-priority error.
+func mergeExecSummaries(a, b execErrorSummary) execErrorSummary {
+	merged := a
+
+	if clierrors.IsHigherPriority(b.exitCode, a.exitCode) {
+		merged.exitCode = b.exitCode
+		merged.err = b.err
+	}
+
+	merged.failures = append(slices.Clip(a.failures), b.failures...)
+	merged.stats.Completed += b.stats.Completed
+	merged.stats.Failed += b.stats.Failed
+	merged.stats.NotRun += b.stats.NotRun
+	merged.stats.Skipped += b.stats.Skipped
+
+	if merged.interrupted == nil {
+		merged.interrupted = b.interrupted
+	}
+
+	return merged
+}
+
+// blockingCheckIDs returns the IDs of the checks whose results block the upgrade.
+func blockingCheckIDs(results []check.CheckExecution) map[string]struct{} {
+	ids := make(map[string]struct{})
+
+	for _, exec := range results {
+		if exec.Result == nil || exec.Check == nil {
+			continue
+		}
+
+		switch exec.Result.GetImpact() { //nolint:exhaustive // only blocking impacts are collected
+		case result.ImpactProhibited, result.ImpactBlocking:
+			ids[exec.Check.ID()] = struct{}{}
+		}
+	}
+
+	return ids
+}
+
+// markNewBlockers records, for each run, the blocking checks the previous run did not have.
+func markNewBlockers(runs []targetRun) {
+	previous := map[string]struct{}{}
+
+	for i := range runs {
+		current := blockingCheckIDs(runs[i].results)
+
+		for id := range current {
+			if _, ok := previous[id]; !ok {
+				runs[i].newBlockers = append(runs[i].newBlockers, id)
+			}
+		}
+
+		slices.Sort(runs[i].newBlockers)
+		previous = current
+	}
+}
+
+// writeTargetRuns renders the runs of a multi-target assessment in the selected output format.
+func (c *Command) writeTargetRuns(ctx context.Context, runs []targetRun) error {
+	out := c.IO.Out()
+
+	if !c.OutputFormat.isTable() {
+		list := &TargetReportList{
+			Envelope:         output.NewEnvelope(targetReportListKind, "lint"),
+			ClusterVersion:   c.currentClusterVersion,
+			OpenShiftVersion: c.currentOpenShiftVersion,
+			Targets:          make([]TargetReport, 0, len(runs)),
+		}
+
+		for _, run := range runs {
+			targetVersion := run.target.String()
+			report := newResultList(run.results, c.printer, &c.currentClusterVersion, &targetVersion, c.openShiftVersionPtr(),
+				c.resultListAnnotations())
+
+			if c.GroupBy == GroupByNamespace {
+				report.Namespaces = SummarizeByNamespace(run.results, collectNamespaceRequesters(ctx, c.Reader, run.results))
+			}
+
+			list.Targets = append(list.Targets, TargetReport{
+				TargetVersion: targetVersion,
+				NewBlockers:   run.newBlockers,
+				Report:        report,
+			})
+		}
+
+		return renderStructured(out, c.OutputFormat, list)
+	}
+
+	for _, run := range runs {
+		c.TargetVersion = run.target.String()
+
+		_, _ = fmt.Fprintf(out, "\n=== Upgrade %s → %s ===\n", c.currentClusterVersion, c.TargetVersion)
+
+		if len(run.newBlockers) > 0 {
+			_, _ = fmt.Fprintf(out, "New blockers in this hop: %s\n", strings.Join(run.newBlockers, ", "))
+		}
+
+		if err := c.outputUpgradeTable(ctx, out, run.results); err != nil {
+			return err
+		}
+	}
+
+	return writeTargetSummary(out, runs)
+}
+
+// writeTargetSummary writes a table with the impact counts and new blockers of each target.
+func writeTargetSummary(out io.Writer, runs []targetRun) error {
+	_, _ = fmt.Fprintln(out, "\nSummary by target version:")
+
+	renderer := table.NewRenderer(
+		table.WithWriter[targetSummaryRow](out),
+		table.WithHeaders[targetSummaryRow]("TARGET", "PROHIBITED", "BLOCKING", "ADVISORY", "NEW BLOCKERS"),
+		table.WithTableOptions[targetSummaryRow](table.DefaultTableOptions...),
+	)
+
+	for _, run := range runs {
+		counts := make(map[result.Impact]int)
+
+		for _, exec := range run.results {
+			if exec.Result != nil {
+				counts[exec.Result.GetImpact()]++
+			}
+		}
+
+		row := targetSummaryRow{
+			Target:      run.target.String(),
+			Prohibited:  strconv.Itoa(counts[result.ImpactProhibited]),
+			Blocking:    strconv.Itoa(counts[result.ImpactBlocking]),
+			Advisory:    strconv.Itoa(counts[result.ImpactAdvisory]),
+			NewBlockers: strings.Join(run.newBlockers, ","),
+		}
+
+		if err := renderer.Append(row); err != nil {
+			return fmt.Errorf("failed to append row: %w", err)
+		}
+	}
+
+	if err := renderer.Render(); err != nil {
+		return fmt.Errorf("failed to render table: %w", err)
+	}
+
+	return nil
+}
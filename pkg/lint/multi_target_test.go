@@ -0,0 +1,180 @@
+package lint_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/blang/semver/v4"
+
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestUpgradeHops(t *testing.T) {
+	versions := catalogVersions("2.25.0", "2.25.2", "3.0.0", "3.0.1", "3.1.0", "3.2.0", "3.2.1")
+
+	tests := []struct {
+		name     string
+		current  string
+		until    string
+		expected []string
+	}{
+		{name: "newest patch of each minor release", current: "2.25.0", until: "3.2", expected: []string{"3.0.1", "3.1.0", "3.2.1"}},
+		{name: "stops at the until minor release", current: "2.25.2", until: "3.1.0", expected: []string{"3.0.1", "3.1.0"}},
+		{name: "skips the current minor release", current: "3.0.0", until: "3.1", expected: []string{"3.1.0"}},
+		{name: "empty when until is not newer", current: "3.2.0", until: "3.2", expected: []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			until, err := semver.ParseTolerant(tt.until)
+			g.Expect(err).ToNot(HaveOccurred())
+
+			hops := lint.UpgradeHops(semver.MustParse(tt.current), until, versions)
+
+			actual := make([]string, 0, len(hops))
+			for _, h := range hops {
+				actual = append(actual, h.String())
+			}
+
+			g.Expect(actual).To(Equal(tt.expected))
+		})
+	}
+}
+
+// newMultiTargetCommand returns a command linting a snapshot of a 2.25.0 cluster whose
+// catalog offers the versions of fixtureSnapshotPackageManifest.
+func newMultiTargetCommand(t *testing.T, out, errOut *bytes.Buffer) *lint.Command {
+	t.Helper()
+
+	g := NewWithT(t)
+
+	dir := t.TempDir()
+	g.Expect(os.WriteFile(filepath.Join(dir, "dsc.yaml"), []byte(fixtureSnapshotDSC), 0o600)).To(Succeed())
+	g.Expect(os.WriteFile(filepath.Join(dir, "dsci.yaml"), []byte(fixtureSnapshotDSCI), 0o600)).To(Succeed())
+	g.Expect(os.WriteFile(filepath.Join(dir, "packagemanifest.yaml"), []byte(fixtureSnapshotPackageManifest), 0o600)).To(Succeed())
+
+	streams := genericiooptions.IOStreams{In: &bytes.Buffer{}, Out: out, ErrOut: errOut}
+
+	command := lint.NewCommand(streams, testConfigFlags())
+	command.FromDir = dir
+
+	return command
+}
+
+func TestCommand_MultipleTargetVersions(t *testing.T) {
+	t.Run("should render one report per target version", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var out, errOut bytes.Buffer
+
+		command := newMultiTargetCommand(t, &out, &errOut)
+		command.TargetVersion = "3.1, 3.0"
+		command.OutputFormat = lint.OutputFormatJSON
+
+		g.Expect(command.Complete()).To(Succeed())
+		g.Expect(command.Validate()).To(Succeed())
+
+		// Findings may produce a non-zero exit error; the report itself must still be rendered.
+		_ = command.Run(t.Context())
+
+		var list lint.TargetReportList
+		g.Expect(json.Unmarshal(out.Bytes(), &list)).To(Succeed())
+		g.Expect(list.Kind).To(Equal("LintTargetReportList"))
+		g.Expect(list.ClusterVersion).To(Equal("2.25.0"))
+		g.Expect(list.Targets).To(HaveExactElements(
+			HaveField("TargetVersion", "3.0.0"),
+			HaveField("TargetVersion", "3.1.0"),
+		))
+		g.Expect(*list.Targets[1].Report.TargetVersion).To(Equal("3.1.0"))
+	})
+
+	t.Run("should resolve --until-version from the catalog", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var out, errOut bytes.Buffer
+
+		command := newMultiTargetCommand(t, &out, &errOut)
+		command.UntilVersion = "3.1"
+		command.Verbose = true
+
+		g.Expect(command.Complete()).To(Succeed())
+		g.Expect(command.Validate()).To(Succeed())
+
+		_ = command.Run(t.Context())
+
+		g.Expect(errOut.String()).To(ContainSubstring("Resolved --until-version 3.1 to target versions 3.0.1, 3.1.0"))
+		g.Expect(out.String()).To(ContainSubstring("=== Upgrade 2.25.0 → 3.0.1 ==="))
+		g.Expect(out.String()).To(ContainSubstring("=== Upgrade 2.25.0 → 3.1.0 ==="))
+		g.Expect(out.String()).To(ContainSubstring("Summary by target version:"))
+	})
+
+	t.Run("should reject a target version of the current minor release", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var out, errOut bytes.Buffer
+
+		command := newMultiTargetCommand(t, &out, &errOut)
+		command.TargetVersion = "2.25,3.0"
+
+		g.Expect(command.Complete()).To(Succeed())
+		g.Expect(command.Validate()).To(Succeed())
+		g.Expect(command.Run(t.Context())).To(MatchError(ContainSubstring("later minor release")))
+	})
+
+	t.Run("should reject aliases in a list", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var out, errOut bytes.Buffer
+
+		command := newMultiTargetCommand(t, &out, &errOut)
+		command.TargetVersion = "3.0,latest"
+
+		g.Expect(command.Complete()).To(MatchError(ContainSubstring("cannot be combined")))
+	})
+
+	t.Run("should reject unsupported options", func(t *testing.T) {
+		tests := []struct {
+			name   string
+			modify func(c *lint.Command)
+			err    string
+		}{
+			{
+				name:   "both flags",
+				modify: func(c *lint.Command) { c.TargetVersion = "3.0"; c.UntilVersion = "3.1" },
+				err:    "mutually exclusive",
+			},
+			{
+				name:   "junit output",
+				modify: func(c *lint.Command) { c.TargetVersion = "3.0,3.1"; c.OutputFormat = lint.OutputFormatJUnit },
+				err:    "output format junit",
+			},
+			{
+				name:   "record",
+				modify: func(c *lint.Command) { c.UntilVersion = "3.1"; c.Record = true; c.FromDir = "" },
+				err:    "--record",
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				g := NewWithT(t)
+
+				var out, errOut bytes.Buffer
+
+				command := newMultiTargetCommand(t, &out, &errOut)
+				tt.modify(command)
+
+				g.Expect(command.Validate()).To(MatchError(ContainSubstring(tt.err)))
+			})
+		}
+	})
+}
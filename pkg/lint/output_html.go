@@ -0,0 +1,190 @@
+package lint
+
+import (
+	"cmp"
+	_ "embed"
+	"fmt"
+	"io"
+	"slices"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/output"
+	"github.com/opendatahub-io/odh-cli/pkg/printer/html"
+)
+
+//go:embed templates/report.html.tmpl
+var htmlReportTemplate string
+
+// htmlReport is the view model of the HTML report template.
+type htmlReport struct {
+	output.Metadata
+
+	ClusterVersion   string
+	TargetVersion    string
+	OpenShiftVersion string
+	Summary          htmlSummary
+	Groups           []htmlGroup
+}
+
+// htmlSummary holds the counts shown in the summary cards. Conditions are counted the
+// same way as in the table summary.
+type htmlSummary struct {
+	Total      int
+	Passed     int
+	Advisory   int
+	Blocking   int
+	Prohibited int
+	Suppressed int
+}
+
+// htmlGroup is a report section holding the checks of one check group.
+type htmlGroup struct {
+	Name   string
+	Checks []htmlCheck
+}
+
+// htmlCheck is a single check of a report section.
+type htmlCheck struct {
+	Name        string
+	Kind        string
+	Description string
+
+	// Impact is the highest impact of the check's conditions, empty when passing.
+	Impact     result.Impact
+	Conditions []htmlCondition
+	Objects    []string
+}
+
+type htmlCondition struct {
+	Type        string
+	Impact      result.Impact
+	Message     string
+	Remediation string
+}
+
+// OutputHTML outputs diagnostic results as a standalone HTML report.
+//
+// The report has summary cards, one section per check group in canonical order and, for each
+// check, its condition messages, remediation guidance and a collapsible list of impacted
+// objects. Styles are inlined so the file can be attached to change requests as is.
+func OutputHTML(
+	out io.Writer,
+	results []check.CheckExecution,
+	clusterVersion *string,
+	targetVersion *string,
+	openShiftVersion *string,
+) error {
+	tmpl, err := html.Parse("report", htmlReportTemplate)
+	if err != nil {
+		return err //nolint:wrapcheck // Parse already adds the template context
+	}
+
+	renderer := html.NewRenderer[*htmlReport](
+		html.WithWriter[*htmlReport](out),
+		html.WithTemplate[*htmlReport](tmpl),
+	)
+
+	report := newHTMLReport(results)
+	report.ClusterVersion = derefString(clusterVersion)
+	report.TargetVersion = derefString(targetVersion)
+	report.OpenShiftVersion = derefString(openShiftVersion)
+
+	if err := renderer.Render(report); err != nil {
+		return fmt.Errorf("rendering HTML report: %w", err)
+	}
+
+	return nil
+}
+
+// newHTMLReport builds the report view model, grouping checks in canonical group order.
+func newHTMLReport(results []check.CheckExecution) *htmlReport {
+	report := &htmlReport{
+		Metadata: output.NewMetadata("lint"),
+	}
+
+	report.Summary.Suppressed = countSuppressed(results)
+
+	groups := make(map[string][]htmlCheck)
+
+	for _, exec := range results {
+		if exec.Result == nil {
+			continue
+		}
+
+		hc := htmlCheck{
+			Name:        exec.Result.Name,
+			Kind:        exec.Result.Kind,
+			Description: exec.Result.Spec.Description,
+			Impact:      exec.Result.GetImpact(),
+		}
+
+		for _, cond := range exec.Result.Status.Conditions {
+			report.Summary.Total++
+
+			switch cond.Impact {
+			case result.ImpactProhibited:
+				report.Summary.Prohibited++
+			case result.ImpactBlocking:
+				report.Summary.Blocking++
+			case result.ImpactAdvisory:
+				report.Summary.Advisory++
+			case result.ImpactNone:
+				// Checks skipped for a failed prerequisite are not counted as passed
+				if cond.Reason != check.ReasonPrerequisiteFailed {
+					report.Summary.Passed++
+				}
+			}
+
+			hc.Conditions = append(hc.Conditions, htmlCondition{
+				Type:        cond.Type,
+				Impact:      cond.Impact,
+				Message:     cond.Message,
+				Remediation: cond.Remediation,
+			})
+		}
+
+		for i := range exec.Result.ImpactedObjects {
+			obj := &exec.Result.ImpactedObjects[i]
+			if obj.Namespace == "" {
+				hc.Objects = append(hc.Objects, obj.Kind+" "+obj.Name)
+			} else {
+				hc.Objects = append(hc.Objects, obj.Kind+" "+obj.Namespace+"/"+obj.Name)
+			}
+		}
+
+		groups[exec.Result.Group] = append(groups[exec.Result.Group], hc)
+	}
+
+	names := make([]string, 0, len(groups))
+	for name := range groups {
+		names = append(names, name)
+	}
+
+	slices.SortFunc(names, func(a, b string) int {
+		if pa, pb := groupSortPriority(a), groupSortPriority(b); pa != pb {
+			return pa - pb
+		}
+
+		return cmp.Compare(a, b)
+	})
+
+	for _, name := range names {
+		checks := groups[name]
+		slices.SortStableFunc(checks, func(a, b htmlCheck) int {
+			return impactSortPriority(a.Impact) - impactSortPriority(b.Impact)
+		})
+
+		report.Groups = append(report.Groups, htmlGroup{Name: name, Checks: checks})
+	}
+
+	return report
+}
+
+func derefString(s *string) string {
+	if s == nil {
+		return ""
+	}
+
+	return *s
+}
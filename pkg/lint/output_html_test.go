@@ -0,0 +1,117 @@
+package lint_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+
+	. "github.com/onsi/gomega"
+)
+
+func newReportExecution(group string, name string, impact result.Impact, opts ...check.ConditionOption) check.CheckExecution {
+	status := metav1.ConditionFalse
+	if impact == result.ImpactNone {
+		status = metav1.ConditionTrue
+	}
+
+	dr := result.New(group, "kserve", name, "description of "+name)
+	dr.SetCondition(check.NewCondition(
+		check.ConditionTypeCompatible,
+		status,
+		append([]check.ConditionOption{
+			check.WithReason(check.ReasonMigrationPending),
+			check.WithMessage("%s finding", name),
+			check.WithImpact(impact),
+		}, opts...)...,
+	))
+
+	return check.CheckExecution{Result: dr}
+}
+
+func TestOutputHTML(t *testing.T) {
+	g := NewWithT(t)
+
+	blocking := newReportExecution("workload", "blocking", result.ImpactBlocking,
+		check.WithRemediation("Migrate the <InferenceService> resources"))
+	blocking.Result.ImpactedObjects = []metav1.PartialObjectMetadata{
+		{
+			TypeMeta:   metav1.TypeMeta{Kind: "InferenceService"},
+			ObjectMeta: metav1.ObjectMeta{Namespace: "team-a", Name: "model"},
+		},
+	}
+
+	results := []check.CheckExecution{
+		blocking,
+		newReportExecution("component", "passing", result.ImpactNone),
+		newReportExecution("dependency", "advisory", result.ImpactAdvisory),
+		{Result: nil},
+	}
+
+	clusterVer := "2.25.0"
+	targetVer := "3.0.0"
+
+	var buf bytes.Buffer
+	err := lint.OutputHTML(&buf, results, &clusterVer, &targetVer, nil)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	out := buf.String()
+
+	t.Run("is a standalone document", func(t *testing.T) {
+		g := NewWithT(t)
+
+		g.Expect(out).To(HavePrefix("<!DOCTYPE html>"))
+		g.Expect(out).To(ContainSubstring("<style>"))
+		g.Expect(out).To(ContainSubstring("Target version: <strong>3.0.0</strong>"))
+		g.Expect(out).ToNot(ContainSubstring("OpenShift version:"))
+	})
+
+	t.Run("renders summary cards", func(t *testing.T) {
+		g := NewWithT(t)
+
+		g.Expect(out).To(ContainSubstring(`<div class="count">3</div><div class="label">Total</div>`))
+		g.Expect(out).To(ContainSubstring(`<div class="count">1</div><div class="label">Blocking</div>`))
+		g.Expect(out).To(ContainSubstring(`<div class="count">0</div><div class="label">Prohibited</div>`))
+		g.Expect(out).ToNot(ContainSubstring("Suppressed"))
+	})
+
+	t.Run("orders group sections canonically", func(t *testing.T) {
+		g := NewWithT(t)
+
+		dependency := strings.Index(out, `id="group-dependency"`)
+		component := strings.Index(out, `id="group-component"`)
+		workload := strings.Index(out, `id="group-workload"`)
+
+		g.Expect(dependency).To(BeNumerically(">", 0))
+		g.Expect(component).To(BeNumerically(">", dependency))
+		g.Expect(workload).To(BeNumerically(">", component))
+	})
+
+	t.Run("lists impacted objects and escaped remediation", func(t *testing.T) {
+		g := NewWithT(t)
+
+		g.Expect(out).To(ContainSubstring("<summary>1 impacted object(s)</summary>"))
+		g.Expect(out).To(ContainSubstring("<li>InferenceService team-a/model</li>"))
+		g.Expect(out).To(ContainSubstring("Migrate the &lt;InferenceService&gt; resources"))
+	})
+}
+
+func TestOutputHTML_NoResults(t *testing.T) {
+	g := NewWithT(t)
+
+	var buf bytes.Buffer
+	err := lint.OutputHTML(&buf, nil, nil, nil, nil)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(buf.String()).To(ContainSubstring("No checks were run."))
+}
+
+func TestOutputFormat_ValidateHTML(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(lint.OutputFormatHTML.Validate()).To(Succeed())
+}
@@ -0,0 +1,215 @@
+package lint
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+)
+
+const (
+	junitSuitesName = "odh-lint"
+
+	junitPropertyClusterVersion   = "clusterVersion"
+	junitPropertyTargetVersion    = "targetVersion"
+	junitPropertyOpenShiftVersion = "openShiftVersion"
+)
+
+// junitTestSuites is the root element of a JUnit XML report.
+type junitTestSuites struct {
+	XMLName  xml.Name         `xml:"testsuites"`
+	Name     string           `xml:"name,attr"`
+	Tests    int              `xml:"tests,attr"`
+	Failures int              `xml:"failures,attr"`
+	Errors   int              `xml:"errors,attr"`
+	Skipped  int              `xml:"skipped,attr"`
+	Suites   []junitTestSuite `xml:"testsuite"`
+}
+
+// junitTestSuite groups the test cases of a single check group.
+type junitTestSuite struct {
+	Name       string          `xml:"name,attr"`
+	Tests      int             `xml:"tests,attr"`
+	Failures   int             `xml:"failures,attr"`
+	Errors     int             `xml:"errors,attr"`
+	Skipped    int             `xml:"skipped,attr"`
+	Properties []junitProperty `xml:"properties>property,omitempty"`
+	TestCases  []junitTestCase `xml:"testcase"`
+}
+
+type junitProperty struct {
+	Name  string `xml:"name,attr"`
+	Value string `xml:"value,attr"`
+}
+
+// junitTestCase represents a single condition reported by a check.
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Failure   *junitProblem `xml:"failure,omitempty"`
+	Error     *junitProblem `xml:"error,omitempty"`
+	Skipped   *junitSkipped `xml:"skipped,omitempty"`
+	SystemOut string        `xml:"system-out,omitempty"`
+}
+
+// junitSkipped marks a test case whose check was skipped because a prerequisite failed or it
+// does not apply.
+type junitSkipped struct {
+	Message string `xml:"message,attr"`
+}
+
+// junitProblem is the payload of a <failure> or <error> element.
+type junitProblem struct {
+	Message string `xml:"message,attr"`
+	Type    string `xml:"type,attr"`
+	Body    string `xml:",chardata"`
+}
+
+// OutputJUnit outputs diagnostic results as a JUnit XML report.
+//
+// Each condition of each check becomes a test case, grouped into one test suite per
+// check group. Prohibited and blocking findings are reported as failures and conditions
+// with Unknown status as errors, so CI systems fail the build exactly when the lint
+// command exits with a blocking verdict. Advisory findings pass and carry their message
+// in system-out.
+func OutputJUnit(
+	out io.Writer,
+	results []check.CheckExecution,
+	clusterVersion *string,
+	targetVersion *string,
+	openShiftVersion *string,
+) error {
+	properties := junitVersionProperties(clusterVersion, targetVersion, openShiftVersion)
+
+	report := junitTestSuites{Name: junitSuitesName}
+	suiteIndex := make(map[string]int)
+
+	for _, exec := range results {
+		if exec.Result == nil {
+			continue
+		}
+
+		group := exec.Result.Group
+
+		idx, ok := suiteIndex[group]
+		if !ok {
+			idx = len(report.Suites)
+			suiteIndex[group] = idx
+			report.Suites = append(report.Suites, junitTestSuite{
+				Name:       junitSuitesName + "." + group,
+				Properties: properties,
+			})
+		}
+
+		suite := &report.Suites[idx]
+
+		for _, cond := range exec.Result.Status.Conditions {
+			tc := newJUnitTestCase(exec.Result, cond)
+
+			suite.Tests++
+
+			switch {
+			case tc.Failure != nil:
+				suite.Failures++
+			case tc.Error != nil:
+				suite.Errors++
+			case tc.Skipped != nil:
+				suite.Skipped++
+			}
+
+			suite.TestCases = append(suite.TestCases, tc)
+		}
+	}
+
+	for _, suite := range report.Suites {
+		report.Tests += suite.Tests
+		report.Failures += suite.Failures
+		report.Errors += suite.Errors
+		report.Skipped += suite.Skipped
+	}
+
+	if _, err := io.WriteString(out, xml.Header); err != nil {
+		return fmt.Errorf("writing JUnit header: %w", err)
+	}
+
+	enc := xml.NewEncoder(out)
+	enc.Indent("", "  ")
+
+	if err := enc.Encode(report); err != nil {
+		return fmt.Errorf("rendering JUnit output: %w", err)
+	}
+
+	if _, err := fmt.Fprintln(out); err != nil {
+		return fmt.Errorf("writing JUnit output: %w", err)
+	}
+
+	return nil
+}
+
+// newJUnitTestCase converts a single condition into a JUnit test case.
+func newJUnitTestCase(dr *result.DiagnosticResult, cond result.Condition) junitTestCase {
+	tc := junitTestCase{
+		Name:      dr.Name + "/" + cond.Type,
+		ClassName: dr.Group + "." + dr.Kind,
+	}
+
+	switch {
+	case cond.Reason == check.ReasonPrerequisiteFailed, cond.Reason == check.ReasonNotApplicable:
+		tc.Skipped = &junitSkipped{Message: cond.Message}
+	case cond.Status == metav1.ConditionUnknown:
+		tc.Error = &junitProblem{
+			Message: cond.Message,
+			Type:    cond.Reason,
+			Body:    junitProblemBody(cond),
+		}
+	case cond.Impact == result.ImpactProhibited || cond.Impact == result.ImpactBlocking:
+		tc.Failure = &junitProblem{
+			Message: cond.Message,
+			Type:    string(cond.Impact),
+			Body:    junitProblemBody(cond),
+		}
+	case cond.Impact == result.ImpactAdvisory:
+		tc.SystemOut = fmt.Sprintf("%s: %s", cond.Impact, cond.Message)
+	}
+
+	return tc
+}
+
+// junitProblemBody renders the detail text of a failure or error element.
+func junitProblemBody(cond result.Condition) string {
+	var sb strings.Builder
+
+	_, _ = fmt.Fprintf(&sb, "Reason: %s\n", cond.Reason)
+
+	if cond.Message != "" {
+		_, _ = fmt.Fprintf(&sb, "Message: %s\n", cond.Message)
+	}
+
+	if cond.Remediation != "" {
+		_, _ = fmt.Fprintf(&sb, "Remediation: %s\n", cond.Remediation)
+	}
+
+	return sb.String()
+}
+
+// junitVersionProperties builds suite properties from the known version information.
+func junitVersionProperties(clusterVersion, targetVersion, openShiftVersion *string) []junitProperty {
+	var props []junitProperty
+
+	add := func(name string, value *string) {
+		if value != nil && *value != "" {
+			props = append(props, junitProperty{Name: name, Value: *value})
+		}
+	}
+
+	add(junitPropertyClusterVersion, clusterVersion)
+	add(junitPropertyTargetVersion, targetVersion)
+	add(junitPropertyOpenShiftVersion, openShiftVersion)
+
+	return props
+}
@@ -0,0 +1,134 @@
+package lint_test
+
+import (
+	"bytes"
+	"encoding/xml"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+
+	. "github.com/onsi/gomega"
+)
+
+const (
+	testJUnitClusterVersion = "2.25.0"
+	testJUnitTargetVersion  = "3.0.0"
+)
+
+type junitReport struct {
+	Tests    int `xml:"tests,attr"`
+	Failures int `xml:"failures,attr"`
+	Errors   int `xml:"errors,attr"`
+	Skipped  int `xml:"skipped,attr"`
+	Suites   []struct {
+		Name       string `xml:"name,attr"`
+		Properties []struct {
+			Name  string `xml:"name,attr"`
+			Value string `xml:"value,attr"`
+		} `xml:"properties>property"`
+		TestCases []struct {
+			Name      string `xml:"name,attr"`
+			ClassName string `xml:"classname,attr"`
+			Failure   *struct {
+				Type    string `xml:"type,attr"`
+				Message string `xml:"message,attr"`
+			} `xml:"failure"`
+			Error *struct {
+				Type string `xml:"type,attr"`
+			} `xml:"error"`
+			Skipped *struct {
+				Message string `xml:"message,attr"`
+			} `xml:"skipped"`
+			SystemOut string `xml:"system-out"`
+		} `xml:"testcase"`
+	} `xml:"testsuite"`
+}
+
+func newJUnitExecution(group string, name string, status metav1.ConditionStatus, impact result.Impact) check.CheckExecution {
+	dr := result.New(group, "kserve", name, "test check")
+	dr.SetCondition(check.NewCondition(
+		check.ConditionTypeCompatible,
+		status,
+		check.WithReason(check.ReasonMigrationPending),
+		check.WithMessage("%s finding", name),
+		check.WithImpact(impact),
+	))
+
+	return check.CheckExecution{Result: dr}
+}
+
+func newJUnitSkippedExecution(group string, name string) check.CheckExecution {
+	dr := result.New(group, "kserve", name, "test check")
+	dr.SetCondition(check.NewCondition(
+		check.ConditionTypeValidated,
+		metav1.ConditionUnknown,
+		check.WithReason(check.ReasonPrerequisiteFailed),
+		check.WithMessage("Skipped: prerequisite check %s failed", check.CheckIDDSCReadiness),
+	))
+
+	return check.CheckExecution{Result: dr}
+}
+
+func TestOutputJUnit(t *testing.T) {
+	g := NewWithT(t)
+
+	results := []check.CheckExecution{
+		newJUnitExecution("component", "passing", metav1.ConditionTrue, result.ImpactNone),
+		newJUnitExecution("component", "blocking", metav1.ConditionFalse, result.ImpactBlocking),
+		newJUnitExecution("workload", "advisory", metav1.ConditionFalse, result.ImpactAdvisory),
+		newJUnitExecution("workload", "unknown", metav1.ConditionUnknown, result.ImpactBlocking),
+		newJUnitSkippedExecution("workload", "skipped"),
+		{Result: nil},
+	}
+
+	clusterVer := testJUnitClusterVersion
+	targetVer := testJUnitTargetVersion
+
+	var buf bytes.Buffer
+	err := lint.OutputJUnit(&buf, results, &clusterVer, &targetVer, nil)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(buf.String()).To(HavePrefix(xml.Header))
+
+	var report junitReport
+	g.Expect(xml.Unmarshal(buf.Bytes(), &report)).To(Succeed())
+
+	g.Expect(report).To(And(
+		HaveField("Tests", 5),
+		HaveField("Failures", 1),
+		HaveField("Errors", 1),
+		HaveField("Skipped", 1),
+	))
+	g.Expect(report.Suites).To(HaveLen(2))
+	g.Expect(report.Suites[0].Name).To(Equal("odh-lint.component"))
+	g.Expect(report.Suites[0].Properties).To(HaveLen(2))
+	g.Expect(report.Suites[1].Name).To(Equal("odh-lint.workload"))
+
+	component := report.Suites[0].TestCases
+	g.Expect(component).To(HaveLen(2))
+	g.Expect(component[0].Name).To(Equal("passing/" + check.ConditionTypeCompatible))
+	g.Expect(component[0].ClassName).To(Equal("component.kserve"))
+	g.Expect(component[0].Failure).To(BeNil())
+	g.Expect(component[1].Failure).ToNot(BeNil())
+	g.Expect(component[1].Failure.Type).To(Equal(string(result.ImpactBlocking)))
+	g.Expect(component[1].Failure.Message).To(Equal("blocking finding"))
+
+	workload := report.Suites[1].TestCases
+	g.Expect(workload).To(HaveLen(3))
+	g.Expect(workload[0].Failure).To(BeNil())
+	g.Expect(workload[0].SystemOut).To(ContainSubstring("advisory finding"))
+	g.Expect(workload[1].Error).ToNot(BeNil())
+	g.Expect(workload[1].Failure).To(BeNil())
+	g.Expect(workload[2].Error).To(BeNil())
+	g.Expect(workload[2].Skipped).ToNot(BeNil())
+	g.Expect(workload[2].Skipped.Message).To(Equal("Skipped: prerequisite check platform.dsc.readiness failed"))
+}
+
+func TestOutputFormat_ValidateJUnit(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(lint.OutputFormatJUnit.Validate()).To(Succeed())
+}
@@ -0,0 +1,202 @@
+package lint
+
+import (
+	"cmp"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+)
+
+// markdownRow is a single condition rendered as a row of a group table.
+type markdownRow struct {
+	group     string
+	check     string
+	kind      string
+	condition result.Condition
+}
+
+// OutputMarkdown outputs diagnostic results as GitHub-flavored Markdown, suitable for
+// posting as a pull request comment or pasting into a wiki.
+//
+// The output starts with the version information and a summary table with the same counts
+// as the table output, followed by one table per check group in canonical order listing
+// every condition with its impact, message and remediation.
+func OutputMarkdown(
+	out io.Writer,
+	results []check.CheckExecution,
+	clusterVersion *string,
+	targetVersion *string,
+	openShiftVersion *string,
+) error {
+	var b strings.Builder
+
+	b.WriteString("# OpenShift AI Lint Results\n\n")
+	writeMarkdownVersions(&b, clusterVersion, targetVersion, openShiftVersion)
+
+	rows := collectMarkdownRows(results)
+
+	var passed, advisory, blocking, prohibited int
+
+	for _, row := range rows {
+		// Checks skipped for a failed prerequisite are not counted as passed
+		if row.condition.Reason == check.ReasonPrerequisiteFailed {
+			continue
+		}
+
+		switch row.condition.Impact {
+		case result.ImpactProhibited:
+			prohibited++
+		case result.ImpactBlocking:
+			blocking++
+		case result.ImpactAdvisory:
+			advisory++
+		case result.ImpactNone:
+			passed++
+		}
+	}
+
+	b.WriteString("## Summary\n\n")
+
+	suppressed := countSuppressed(results)
+	if suppressed > 0 {
+		b.WriteString("| Total | Passed | Advisory | Blocking | Prohibited | Suppressed |\n")
+		b.WriteString("|------:|-------:|---------:|---------:|-----------:|-----------:|\n")
+		fmt.Fprintf(&b, "| %d | %d | %d | %d | %d | %d |\n", len(rows), passed, advisory, blocking, prohibited, suppressed)
+	} else {
+		b.WriteString("| Total | Passed | Advisory | Blocking | Prohibited |\n")
+		b.WriteString("|------:|-------:|---------:|---------:|-----------:|\n")
+		fmt.Fprintf(&b, "| %d | %d | %d | %d | %d |\n", len(rows), passed, advisory, blocking, prohibited)
+	}
+
+	switch {
+	case prohibited > 0:
+		b.WriteString("\n:no_entry: **Upgrade is not possible until prohibited findings are resolved.**\n")
+	case blocking > 0:
+		b.WriteString("\n:x: **Blocking findings must be resolved before upgrading.**\n")
+	case advisory > 0:
+		b.WriteString("\n:warning: No blocking findings; review the advisory findings.\n")
+	default:
+		b.WriteString("\n:white_check_mark: All checks passed.\n")
+	}
+
+	currentGroup := ""
+
+	for _, row := range rows {
+		if row.group != currentGroup {
+			currentGroup = row.group
+
+			fmt.Fprintf(&b, "\n## %s\n\n", groupTitle(row.group))
+			b.WriteString("| Status | Check | Kind | Impact | Message | Remediation |\n")
+			b.WriteString("|:------:|-------|------|--------|---------|-------------|\n")
+		}
+
+		impact := string(row.condition.Impact)
+		if impact == "" {
+			impact = "-"
+		}
+
+		fmt.Fprintf(&b, "| %s | %s | %s | %s | %s | %s |\n",
+			markdownStatus(row.condition),
+			markdownCell(row.check),
+			markdownCell(row.kind),
+			impact,
+			markdownCell(row.condition.Message),
+			markdownCell(row.condition.Remediation),
+		)
+	}
+
+	if _, err := io.WriteString(out, b.String()); err != nil {
+		return fmt.Errorf("writing markdown output: %w", err)
+	}
+
+	return nil
+}
+
+// collectMarkdownRows flattens results into condition rows sorted by
+// Group (canonical) -> Kind -> Impact -> Check.
+func collectMarkdownRows(results []check.CheckExecution) []markdownRow {
+	var rows []markdownRow
+
+	for _, exec := range results {
+		if exec.Result == nil {
+			continue
+		}
+
+		for _, cond := range exec.Result.Status.Conditions {
+			rows = append(rows, markdownRow{
+				group:     exec.Result.Group,
+				check:     exec.Result.Name,
+				kind:      exec.Result.Kind,
+				condition: cond,
+			})
+		}
+	}
+
+	slices.SortStableFunc(rows, func(a, b markdownRow) int {
+		return cmp.Or(
+			cmp.Compare(groupSortPriority(a.group), groupSortPriority(b.group)),
+			cmp.Compare(a.group, b.group),
+			cmp.Compare(a.kind, b.kind),
+			cmp.Compare(impactSortPriority(a.condition.Impact), impactSortPriority(b.condition.Impact)),
+			cmp.Compare(a.check, b.check),
+		)
+	})
+
+	return rows
+}
+
+func writeMarkdownVersions(b *strings.Builder, clusterVersion, targetVersion, openShiftVersion *string) {
+	var parts []string
+
+	if v := derefString(clusterVersion); v != "" {
+		parts = append(parts, "**Current version:** "+v)
+	}
+
+	if v := derefString(targetVersion); v != "" {
+		parts = append(parts, "**Target version:** "+v)
+	}
+
+	if v := derefString(openShiftVersion); v != "" {
+		parts = append(parts, "**OpenShift version:** "+v)
+	}
+
+	if len(parts) > 0 {
+		b.WriteString(strings.Join(parts, " | "))
+		b.WriteString("\n\n")
+	}
+}
+
+// markdownStatus returns the status emoji of a condition, marking checks skipped for a failed
+// prerequisite or because they do not apply apart from the impact of their findings.
+func markdownStatus(condition result.Condition) string {
+	if condition.Reason == check.ReasonPrerequisiteFailed || condition.Reason == check.ReasonNotApplicable {
+		return ":fast_forward:"
+	}
+
+	switch condition.Impact {
+	case result.ImpactProhibited:
+		return ":no_entry:"
+	case result.ImpactBlocking:
+		return ":x:"
+	case result.ImpactAdvisory:
+		return ":warning:"
+	case result.ImpactNone:
+		return ":white_check_mark:"
+	}
+
+	return ":white_check_mark:"
+}
+
+// markdownCell escapes a value for use inside a Markdown table cell: pipes are escaped and
+// line breaks are replaced with <br> so a cell never spans several table rows.
+func markdownCell(s string) string {
+	s = strings.ReplaceAll(s, "|", `\|`)
+	s = strings.ReplaceAll(s, "\r\n", "<br>")
+	s = strings.ReplaceAll(s, "\n", "<br>")
+
+	return s
+}
@@ -0,0 +1,83 @@
+package lint_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestOutputMarkdown(t *testing.T) {
+	g := NewWithT(t)
+
+	results := []check.CheckExecution{
+		newReportExecution("workload", "blocking", result.ImpactBlocking,
+			check.WithRemediation("Run a | b\nthen retry")),
+		newReportExecution("component", "passing", result.ImpactNone),
+		newReportExecution("dependency", "advisory", result.ImpactAdvisory),
+		{Result: nil},
+	}
+
+	clusterVer := "2.25.0"
+	targetVer := "3.0.0"
+
+	var buf bytes.Buffer
+	err := lint.OutputMarkdown(&buf, results, &clusterVer, &targetVer, nil)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	out := buf.String()
+
+	t.Run("renders versions and summary", func(t *testing.T) {
+		g := NewWithT(t)
+
+		g.Expect(out).To(HavePrefix("# OpenShift AI Lint Results\n\n"))
+		g.Expect(out).To(ContainSubstring("**Current version:** 2.25.0 | **Target version:** 3.0.0\n"))
+		g.Expect(out).ToNot(ContainSubstring("OpenShift version"))
+		g.Expect(out).To(ContainSubstring("| Total | Passed | Advisory | Blocking | Prohibited |\n"))
+		g.Expect(out).To(ContainSubstring("| 3 | 1 | 1 | 1 | 0 |\n"))
+		g.Expect(out).To(ContainSubstring("Blocking findings must be resolved before upgrading."))
+	})
+
+	t.Run("orders group tables canonically", func(t *testing.T) {
+		g := NewWithT(t)
+
+		dependency := strings.Index(out, "## Dependency")
+		component := strings.Index(out, "## Component")
+		workload := strings.Index(out, "## Workload")
+
+		g.Expect(dependency).To(BeNumerically(">", 0))
+		g.Expect(component).To(BeNumerically(">", dependency))
+		g.Expect(workload).To(BeNumerically(">", component))
+	})
+
+	t.Run("escapes table cells", func(t *testing.T) {
+		g := NewWithT(t)
+
+		g.Expect(out).To(ContainSubstring(
+			"| :x: | blocking | kserve | blocking | blocking finding | Run a \\| b<br>then retry |\n"))
+		g.Expect(out).To(ContainSubstring("| :white_check_mark: | passing | kserve | - | passing finding |  |\n"))
+	})
+}
+
+func TestOutputMarkdown_AllPassing(t *testing.T) {
+	g := NewWithT(t)
+
+	var buf bytes.Buffer
+	err := lint.OutputMarkdown(&buf, []check.CheckExecution{
+		newReportExecution("component", "passing", result.ImpactNone),
+	}, nil, nil, nil)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(buf.String()).To(ContainSubstring(":white_check_mark: All checks passed."))
+	g.Expect(buf.String()).ToNot(ContainSubstring("version:**"))
+}
+
+func TestOutputFormat_ValidateMarkdown(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(lint.OutputFormatMarkdown.Validate()).To(Succeed())
+}
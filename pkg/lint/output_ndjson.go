@@ -0,0 +1,111 @@
+package lint
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+)
+
+// OutputNDJSON outputs diagnostic results as newline-delimited JSON: one compact
+// DiagnosticResult document per line, in execution order, without a list envelope.
+func OutputNDJSON(out io.Writer, results []check.CheckExecution) error {
+	enc := json.NewEncoder(out)
+
+	for _, exec := range results {
+		if exec.Result == nil {
+			continue
+		}
+
+		if err := enc.Encode(exec.Result); err != nil {
+			return fmt.Errorf("rendering NDJSON output: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// resultStream writes each result as an NDJSON line the moment its check completes, so
+// consumers can process results incrementally instead of waiting for the whole run. The
+// display filters of the run (suppressions, --baseline-compare, --severity and --filter)
+// are applied per result. A nil stream ignores all calls.
+type resultStream struct {
+	out        io.Writer
+	suppressed map[string]struct{}
+	baseline   *Baseline
+	severity   SeverityLevel
+	filter     *ResultFilter
+
+	// err is the first write error; later results are dropped once writing failed
+	err error
+}
+
+// newResultStream returns the stream of a run with NDJSON output, or nil for other formats.
+func (c *Command) newResultStream() (*resultStream, error) {
+	if c.OutputFormat != OutputFormatNDJSON {
+		return nil, nil //nolint:nilnil // a nil stream disables streaming
+	}
+
+	s := &resultStream{
+		out:        c.IO.Out(),
+		suppressed: make(map[string]struct{}),
+		severity:   c.SeverityLevel,
+		filter:     c.resultFilter,
+	}
+
+	if len(c.Suppressions) > 0 {
+		checks, err := c.registry.ListByPatterns(c.Suppressions, "")
+		if err != nil {
+			return nil, fmt.Errorf("resolving suppressions: %w", err)
+		}
+
+		for _, chk := range checks {
+			s.suppressed[chk.ID()] = struct{}{}
+		}
+	}
+
+	if c.BaselineCompare != "" {
+		b, err := LoadBaseline(c.BaselineCompare)
+		if err != nil {
+			return nil, err
+		}
+
+		s.baseline = b
+	}
+
+	return s, nil
+}
+
+// observe writes the result of an evaluated check when it passes the display filters.
+func (s *resultStream) observe(exec check.CheckExecution) {
+	if s == nil || s.err != nil || exec.Result == nil {
+		return
+	}
+
+	if exec.Check != nil {
+		if _, ok := s.suppressed[exec.Check.ID()]; ok {
+			return
+		}
+	}
+
+	results := []check.CheckExecution{exec}
+
+	if s.baseline != nil {
+		results, _ = ExcludeBaseline(results, s.baseline)
+	}
+
+	results = FilterBySeverity(results, s.severity)
+	results = FilterByExpression(results, s.filter)
+
+	s.err = OutputNDJSON(s.out, results)
+}
+
+// Err returns the first error writing to the stream.
+func (s *resultStream) Err() error {
+	if s == nil {
+		return nil
+	}
+
+	return s.err
+}
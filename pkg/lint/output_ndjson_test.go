@@ -0,0 +1,112 @@
+//nolint:testpackage // internal test: exercises the unexported result stream
+package lint
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+
+	. "github.com/onsi/gomega"
+)
+
+// decodeNDJSON decodes each line of NDJSON output into a DiagnosticResult.
+func decodeNDJSON(g Gomega, output string) []result.DiagnosticResult {
+	var results []result.DiagnosticResult
+
+	for line := range strings.SplitSeq(strings.TrimSuffix(output, "\n"), "\n") {
+		if line == "" {
+			continue
+		}
+
+		var dr result.DiagnosticResult
+		g.Expect(json.Unmarshal([]byte(line), &dr)).To(Succeed())
+		results = append(results, dr)
+	}
+
+	return results
+}
+
+func TestOutputNDJSON(t *testing.T) {
+	g := NewWithT(t)
+
+	var buf bytes.Buffer
+	err := OutputNDJSON(&buf, []check.CheckExecution{
+		buildExecution(result.ImpactBlocking),
+		{},
+		buildPassingExecution(),
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+
+	g.Expect(strings.Count(buf.String(), "\n")).To(Equal(2))
+
+	results := decodeNDJSON(g, buf.String())
+	g.Expect(results).To(HaveLen(2))
+	g.Expect(results[0].GetImpact()).To(Equal(result.ImpactBlocking))
+	g.Expect(results[1].GetImpact()).To(Equal(result.ImpactNone))
+}
+
+func TestResultStream(t *testing.T) {
+	t.Run("is disabled for other output formats", func(t *testing.T) {
+		g := NewWithT(t)
+
+		c := newTestCommand()
+		c.OutputFormat = OutputFormatJSON
+
+		stream, err := c.newResultStream()
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(stream).To(BeNil())
+
+		// A nil stream ignores results
+		stream.observe(buildExecution(result.ImpactBlocking))
+		g.Expect(stream.Err()).ToNot(HaveOccurred())
+	})
+
+	t.Run("writes each result as it is observed", func(t *testing.T) {
+		g := NewWithT(t)
+
+		c := newTestCommand()
+		c.OutputFormat = OutputFormatNDJSON
+		out, _ := c.IO.Out().(*bytes.Buffer)
+
+		stream, err := c.newResultStream()
+		g.Expect(err).ToNot(HaveOccurred())
+
+		stream.observe(buildExecution(result.ImpactAdvisory))
+		g.Expect(decodeNDJSON(g, out.String())).To(HaveLen(1))
+
+		// Excluded and non-applicable checks have no result
+		stream.observe(check.CheckExecution{})
+		stream.observe(buildPassingExecution())
+		g.Expect(decodeNDJSON(g, out.String())).To(HaveLen(2))
+		g.Expect(stream.Err()).ToNot(HaveOccurred())
+	})
+
+	t.Run("applies the display filters", func(t *testing.T) {
+		g := NewWithT(t)
+
+		c := newTestCommand()
+		c.registry = newPermissionsTestRegistry(t)
+		c.OutputFormat = OutputFormatNDJSON
+		c.SeverityLevel = SeverityLevelCritical
+		c.Suppressions = []string{"workloads.ray.*"}
+		out, _ := c.IO.Out().(*bytes.Buffer)
+
+		stream, err := c.newResultStream()
+		g.Expect(err).ToNot(HaveOccurred())
+
+		suppressed := buildExecution(result.ImpactBlocking)
+		suppressed.Check, _ = c.registry.Get("workloads.ray.test")
+
+		stream.observe(suppressed)
+		stream.observe(buildExecution(result.ImpactAdvisory))
+		stream.observe(buildExecution(result.ImpactBlocking))
+
+		results := decodeNDJSON(g, out.String())
+		g.Expect(results).To(HaveLen(1))
+		g.Expect(results[0].GetImpact()).To(Equal(result.ImpactBlocking))
+	})
+}
@@ -0,0 +1,54 @@
+package lint_test
+
+import (
+	"bytes"
+	"testing"
+
+	"sigs.k8s.io/yaml"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/messages"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/schema"
+
+	. "github.com/onsi/gomega"
+)
+
+// The published DiagnosticResultList schema is the contract downstream tooling relies on, so
+// the documents emitted by the JSON and YAML outputs must always conform to it.
+
+func TestOutputJSON_ConformsToSchema(t *testing.T) {
+	g := NewWithT(t)
+
+	results := newGroupByResults()
+	clusterVersion, targetVersion, ocpVersion := "2.25.0", "3.0.0", "4.19.0"
+
+	var buf bytes.Buffer
+	g.Expect(lint.OutputJSON(&buf, results, messages.Printer{}, &clusterVersion, &targetVersion, &ocpVersion,
+		lint.SummarizeByNamespace(results, map[string]string{"team-a": "alice@example.com"}),
+		map[string]string{result.AnnotationClusterVersionSource: "OLM"})).To(Succeed())
+
+	g.Expect(schema.Validate(schema.SchemaDiagnosticResultList, buf.Bytes())).To(Succeed())
+}
+
+func TestOutputJSON_EmptyResultsConformToSchema(t *testing.T) {
+	g := NewWithT(t)
+
+	var buf bytes.Buffer
+	g.Expect(lint.OutputJSON(&buf, nil, messages.Printer{}, nil, nil, nil, nil, nil)).To(Succeed())
+
+	g.Expect(schema.Validate(schema.SchemaDiagnosticResultList, buf.Bytes())).To(Succeed())
+}
+
+func TestOutputYAML_ConformsToSchema(t *testing.T) {
+	g := NewWithT(t)
+
+	results := newGroupByResults()
+
+	var buf bytes.Buffer
+	g.Expect(lint.OutputYAML(&buf, results, messages.Printer{}, nil, nil, nil, lint.SummarizeByNamespace(results, nil), nil)).To(Succeed())
+
+	document, err := yaml.YAMLToJSON(buf.Bytes())
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(schema.Validate(schema.SchemaDiagnosticResultList, document)).To(Succeed())
+}
@@ -2,50 +2,76 @@ package lint
 
 import (
 	"bytes"
+	"cmp"
 	"fmt"
 	"io"
 	"regexp"
+	"slices"
 	"sort"
+	"strconv"
 	"strings"
 	"unicode/utf8"
 
+	"github.com/olekukonko/tablewriter"
+	"github.com/olekukonko/tablewriter/tw"
+
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
 	"github.com/opendatahub-io/odh-cli/pkg/printer/table"
 	utilcolor "github.com/opendatahub-io/odh-cli/pkg/util/color"
+	"github.com/opendatahub-io/odh-cli/pkg/util/version"
 )
 
 //nolint:gochecknoglobals
 var (
 	// Table headers.
-	tableHeaders        = []string{"STATUS", "KIND", "GROUP", "CHECK", "IMPACT", "MESSAGE"}
-	verboseTableHeaders = []string{"STATUS", "KIND", "GROUP", "CHECK", "IMPACT"}
+	tableHeaders          = []string{"STATUS", "KIND", "GROUP", "CHECK", "IMPACT", "MESSAGE"}
+	wideTableHeaders      = []string{"STATUS", "KIND", "GROUP", "CHECK", "IMPACT", "IMPACTED-COUNT", "MESSAGE", "REMEDIATION"}
+	scoreTableHeaders     = []string{"STATUS", "KIND", "GROUP", "CHECK", "IMPACT", "SCORE", "MESSAGE"}
+	wideScoreTableHeaders = []string{"STATUS", "KIND", "GROUP", "CHECK", "IMPACT", "SCORE", "IMPACTED-COUNT", "MESSAGE", "REMEDIATION"}
+	verboseTableHeaders   = []string{"STATUS", "KIND", "GROUP", "CHECK", "IMPACT"}
 
 	// ansiEscapeRegex matches ANSI escape sequences for stripping when computing visible width.
 	ansiEscapeRegex = regexp.MustCompile(`\x1b\[[0-9;]*m`)
 )
 
-// printVerdict prints the Result section after the summary.
-func printVerdict(out io.Writer, hasProhibited bool, hasBlocking bool, hasAdvisory bool) {
+// printVerdict prints the Result section after the summary. Blocking and advisory findings
+// within the --max-blocking and --max-advisory thresholds are reported as passing, with their
+// counts, so the ratchet is visible in the output.
+func printVerdict(out io.Writer, v verdict) {
 	_, _ = fmt.Fprintln(out)
 	_, _ = fmt.Fprintln(out, "Result:")
 
 	switch {
-	case hasProhibited:
+	case v.prohibited > 0:
 		verdict := utilcolor.VerdictProhibited()
 		_, _ = fmt.Fprintf(out, "  %s - upgrade is not possible\n", verdict)
-	case hasBlocking:
+	case v.blockingExceeded():
 		verdict := utilcolor.VerdictFail()
-		_, _ = fmt.Fprintf(out, "  %s - blocking findings detected\n", verdict)
-	case hasAdvisory:
+		_, _ = fmt.Fprintf(out, "  %s - blocking findings detected%s\n", verdict, thresholdSuffix(v.blocking, v.maxBlocking))
+	case v.advisoryExceeded():
 		verdict := utilcolor.VerdictWarning()
-		_, _ = fmt.Fprintf(out, "  %s - advisory findings detected\n", verdict)
+		_, _ = fmt.Fprintf(out, "  %s - advisory findings detected%s\n", verdict, thresholdSuffix(v.advisory, v.maxAdvisory))
+	case v.blocking > 0 || v.advisory > 0:
+		verdict := utilcolor.VerdictPass()
+		_, _ = fmt.Fprintf(out, "  %s - %d blocking and %d advisory finding(s) within thresholds (max %d blocking, %d advisory)\n",
+			verdict, v.blocking, v.advisory, v.maxBlocking, v.maxAdvisory)
 	default:
 		verdict := utilcolor.VerdictPass()
 		_, _ = fmt.Fprintf(out, "  %s - all checks passed\n", verdict)
 	}
 }
 
+// thresholdSuffix describes how a finding count exceeds its threshold, or "" when no
+// threshold is set.
+func thresholdSuffix(count int, maxCount int) string {
+	if maxCount == 0 {
+		return ""
+	}
+
+	return fmt.Sprintf(" (%d, max %d)", count, maxCount)
+}
+
 // outputProhibitedBanner renders a prominent warning banner above the summary table
 // listing all prohibited findings. Each prohibited condition is shown so that none
 // can be overlooked when multiple checks report prohibited-level impact.
@@ -71,6 +97,9 @@ func outputProhibitedBanner(out io.Writer, findings []sortableRow) {
 type sortableRow struct {
 	row    CheckResultTableRow
 	impact result.Impact
+	score  int
+	// skipped marks the condition of a check skipped for a failed prerequisite, not counted as passed.
+	skipped bool
 }
 
 // collectSortedRows builds table rows from check executions and sorts them
@@ -92,18 +121,27 @@ func collectSortedRows(results []check.CheckExecution) []sortableRow {
 			continue
 		}
 
+		impactedCount := impactedCountColumn(exec.Result)
+
 		for _, condition := range exec.Result.Status.Conditions {
+			score := exec.Result.ScoreFor(condition.Impact)
+
 			rows = append(rows, sortableRow{
 				row: CheckResultTableRow{
-					Status:      statusSymbol(condition.Impact),
-					Kind:        exec.Result.Kind,
-					Group:       exec.Result.Group,
-					Check:       exec.Result.Name,
-					Impact:      getImpactString(&condition, utilcolor.SeverityProhibited(), utilcolor.SeverityCritical(), utilcolor.SeverityWarning(), utilcolor.SeverityInfo()),
-					Message:     condition.Message,
-					Description: exec.Result.Spec.Description,
+					Status:        conditionSymbol(condition),
+					Kind:          exec.Result.Kind,
+					Group:         exec.Result.Group,
+					Check:         exec.Result.Name,
+					Impact:        getImpactString(&condition, utilcolor.SeverityProhibited(), utilcolor.SeverityCritical(), utilcolor.SeverityWarning(), utilcolor.SeverityInfo()),
+					Message:       condition.Message,
+					Description:   exec.Result.Spec.Description,
+					Remediation:   remediationColumn(exec, condition),
+					ImpactedCount: impactedCount,
+					Score:         strconv.Itoa(score),
 				},
-				impact: condition.Impact,
+				impact:  condition.Impact,
+				score:   score,
+				skipped: condition.Reason == check.ReasonPrerequisiteFailed,
 			})
 		}
 	}
@@ -129,6 +167,79 @@ func collectSortedRows(results []check.CheckExecution) []sortableRow {
 	return rows
 }
 
+// sortRowsByScore orders rows by descending risk score, keeping the canonical order of rows
+// with the same score.
+func sortRowsByScore(rows []sortableRow) {
+	slices.SortStableFunc(rows, func(a, b sortableRow) int {
+		return cmp.Compare(b.score, a.score)
+	})
+}
+
+// remediationColumn returns the remediation shown for a condition in the wide table. Findings
+// without a condition-specific remediation fall back to the check's; passing conditions and
+// findings without any remediation show "-". Checks skipped for a failed prerequisite show how
+// to resolve it.
+func remediationColumn(exec check.CheckExecution, condition result.Condition) string {
+	if condition.Impact == result.ImpactNone && condition.Reason != check.ReasonPrerequisiteFailed {
+		return "-"
+	}
+
+	if condition.Remediation != "" {
+		return condition.Remediation
+	}
+
+	if provider, ok := exec.Check.(check.RemediationProvider); ok && provider.Remediation() != "" {
+		return provider.Remediation()
+	}
+
+	return "-"
+}
+
+// impactedCountColumn returns the number of impacted workloads shown in the wide table: the
+// impacted-count annotation set by workload checks, else the number of impacted objects, or
+// "-" when the check reports neither. With --detect-usage, the number of impacted objects in
+// use is appended, e.g. "5 (2 in use)".
+func impactedCountColumn(dr *result.DiagnosticResult) string {
+	count, ok := dr.Annotations[check.AnnotationImpactedWorkloadCount]
+
+	switch {
+	case ok:
+	case len(dr.ImpactedObjects) > 0:
+		count = strconv.Itoa(len(dr.ImpactedObjects))
+	default:
+		return "-"
+	}
+
+	detected, active := 0, 0
+
+	for _, obj := range dr.ImpactedObjects {
+		switch check.Usage(obj.Annotations[result.AnnotationObjectUsage]) {
+		case check.UsageActive:
+			detected++
+			active++
+		case check.UsageIdle:
+			detected++
+		case check.UsageUnknown:
+		}
+	}
+
+	if detected == 0 {
+		return count
+	}
+
+	return fmt.Sprintf("%s (%d in use)", count, active)
+}
+
+// conditionSymbol returns the colored status symbol for a condition, marking checks skipped for
+// a failed prerequisite or because they do not apply apart from the impact of their findings.
+func conditionSymbol(condition result.Condition) string {
+	if condition.Reason == check.ReasonPrerequisiteFailed || condition.Reason == check.ReasonNotApplicable {
+		return utilcolor.StatusSkip()
+	}
+
+	return statusSymbol(condition.Impact)
+}
+
 // statusSymbol returns the colored status symbol for the given impact level.
 func statusSymbol(impact result.Impact) string {
 	switch impact {
@@ -162,10 +273,23 @@ func padRight(s string, visibleWidth int) string {
 }
 
 // OutputTable is a shared function for outputting check results in table format.
-// When opts.ShowImpactedObjects is true, impacted objects are listed after the summary.
+// When opts.Wide is true, the REMEDIATION and IMPACTED-COUNT columns are added.
+// When opts.ShowImpactedObjects is true, impacted objects are listed after the summary, and
+// when opts.GroupBy is set, the findings are aggregated after them. When opts.SortBy is
+// SortByScore, the findings are listed in a single table with a SCORE column, highest risk
+// score first.
 func OutputTable(out io.Writer, results []check.CheckExecution, opts TableOutputOptions) error {
 	rows := collectSortedRows(results)
 
+	var sections [][]sortableRow
+
+	if opts.SortBy == SortByScore {
+		sortRowsByScore(rows)
+		sections = [][]sortableRow{rows}
+	} else {
+		sections = splitRowsByGroup(rows)
+	}
+
 	// Collect prohibited findings for the warning banner before the table.
 	var prohibitedFindings []sortableRow
 	for _, sr := range rows {
@@ -178,11 +302,27 @@ func OutputTable(out io.Writer, results []check.CheckExecution, opts TableOutput
 		outputProhibitedBanner(out, prohibitedFindings)
 	}
 
-	renderer := table.NewRenderer[CheckResultTableRow](
-		table.WithWriter[CheckResultTableRow](out),
-		table.WithHeaders[CheckResultTableRow](tableHeaders...),
-		table.WithTableOptions[CheckResultTableRow](table.DefaultTableOptions...),
-	)
+	headers := tableHeaders
+	tableOptions := table.DefaultTableOptions
+
+	switch {
+	case opts.Wide && opts.SortBy == SortByScore:
+		headers = wideScoreTableHeaders
+	case opts.Wide:
+		headers = wideTableHeaders
+	case opts.SortBy == SortByScore:
+		headers = scoreTableHeaders
+	}
+
+	if opts.Wide {
+		// Keep the hyphen in IMPACTED-COUNT; auto-formatting would render it as "IMPACTED - COUNT".
+		tableOptions = append(slices.Clone(tableOptions), tablewriter.WithHeaderAutoFormat(tw.Off))
+	}
+
+	summaries := make(map[string]*result.DiagnosticResult)
+	for _, summary := range SummarizeByGroup(results, opts.Messages) {
+		summaries[summary.Group] = summary
+	}
 
 	totalChecks := 0
 	totalPassed := 0
@@ -190,27 +330,46 @@ func OutputTable(out io.Writer, results []check.CheckExecution, opts TableOutput
 	totalFailed := 0
 	totalProhibited := 0
 
-	for _, sr := range rows {
-		totalChecks++
-
-		switch sr.impact {
-		case result.ImpactProhibited:
-			totalProhibited++
-		case result.ImpactBlocking:
-			totalFailed++
-		case result.ImpactAdvisory:
-			totalWarnings++
-		case result.ImpactNone:
-			totalPassed++
+	// One table per group, each under a header rolling up the checks of the group
+	for i, section := range sections {
+		if i > 0 {
+			_, _ = fmt.Fprintln(out)
 		}
 
-		if err := renderer.Append(sr.row); err != nil {
-			return fmt.Errorf("appending table row: %w", err)
+		// Score-sorted rows mix the groups, so a group header would not apply
+		if len(section) > 0 && opts.SortBy != SortByScore {
+			outputGroupHeader(out, summaries[section[0].row.Group])
 		}
-	}
 
-	if err := renderer.Render(); err != nil {
-		return fmt.Errorf("rendering table: %w", err)
+		renderer := table.NewRenderer[CheckResultTableRow](
+			table.WithWriter[CheckResultTableRow](out),
+			table.WithHeaders[CheckResultTableRow](headers...),
+			table.WithTableOptions[CheckResultTableRow](tableOptions...),
+		)
+
+		for _, sr := range section {
+			totalChecks++
+
+			switch {
+			case sr.skipped:
+			case sr.impact == result.ImpactProhibited:
+				totalProhibited++
+			case sr.impact == result.ImpactBlocking:
+				totalFailed++
+			case sr.impact == result.ImpactAdvisory:
+				totalWarnings++
+			default:
+				totalPassed++
+			}
+
+			if err := renderer.Append(sr.row); err != nil {
+				return fmt.Errorf("appending table row: %w", err)
+			}
+		}
+
+		if err := renderer.Render(); err != nil {
+			return fmt.Errorf("rendering table: %w", err)
+		}
 	}
 
 	if opts.VersionInfo != nil {
@@ -220,19 +379,79 @@ func OutputTable(out io.Writer, results []check.CheckExecution, opts TableOutput
 
 	_, _ = fmt.Fprintln(out)
 	_, _ = fmt.Fprintln(out, "Summary:")
-	_, _ = fmt.Fprintf(out, "  Total: %d | Passed: %d | Warnings: %d | Failed: %d | Prohibited: %d\n", totalChecks, totalPassed, totalWarnings, totalFailed, totalProhibited)
+	_, _ = fmt.Fprintf(out, "  Total: %d | Passed: %d | Warnings: %d | Failed: %d | Prohibited: %d", totalChecks, totalPassed, totalWarnings, totalFailed, totalProhibited)
+
+	// Objects excluded via lint-ignore annotations are only reported when present
+	if suppressed := countSuppressed(results); suppressed > 0 {
+		_, _ = fmt.Fprintf(out, " | Suppressed: %d", suppressed)
+	}
+
+	_, _ = fmt.Fprintln(out)
 
 	if opts.ShowImpactedObjects {
-		outputImpactedObjects(out, results, opts.NamespaceRequesters)
+		outputImpactedObjects(out, results, opts.NamespaceRequesters, opts.GroupRenderers)
+	}
+
+	if opts.GroupBy == GroupByNamespace {
+		if err := outputNamespaceSummaries(out, SummarizeByNamespace(results, opts.NamespaceRequesters)); err != nil {
+			return err
+		}
 	}
 
 	return nil
 }
 
+// splitRowsByGroup splits rows sorted by group into one section per group. Without rows, a
+// single empty section is returned so an empty table is still rendered.
+func splitRowsByGroup(rows []sortableRow) [][]sortableRow {
+	if len(rows) == 0 {
+		return [][]sortableRow{nil}
+	}
+
+	var sections [][]sortableRow
+
+	start := 0
+	for i := 1; i <= len(rows); i++ {
+		if i == len(rows) || rows[i].row.Group != rows[start].row.Group {
+			sections = append(sections, rows[start:i])
+			start = i
+		}
+	}
+
+	return sections
+}
+
+// outputGroupHeader prints the section header of a group from its summary result.
+func outputGroupHeader(out io.Writer, summary *result.DiagnosticResult) {
+	if summary == nil || len(summary.Status.Conditions) == 0 {
+		return
+	}
+
+	_, _ = fmt.Fprintf(out, "%s %s: %s\n",
+		statusSymbol(summary.GetImpact()), groupTitle(summary.Group), summary.Status.Conditions[0].Message)
+}
+
+// countSuppressed sums the objects excluded via lint-ignore annotations across results.
+func countSuppressed(results []check.CheckExecution) int {
+	total := 0
+
+	for _, exec := range results {
+		if exec.Result != nil {
+			total += exec.Result.SuppressedCount()
+		}
+	}
+
+	return total
+}
+
 // outputVersionInfo prints the Environment section with version details.
 func outputVersionInfo(out io.Writer, info *VersionInfo) {
 	_, _ = fmt.Fprintln(out, "Environment:")
 
+	if info.Flavor != version.FlavorUnknown {
+		_, _ = fmt.Fprintf(out, "  Product:              %s\n", info.Flavor.DisplayName())
+	}
+
 	if info.RHOAITargetVersion != "" {
 		_, _ = fmt.Fprintf(out, "  OpenShift AI version: %s -> %s\n", info.RHOAICurrentVersion, info.RHOAITargetVersion)
 	} else {
@@ -269,6 +488,7 @@ const borderPadding = 2
 func buildVerboseRows(
 	results []check.CheckExecution,
 	namespaceRequesters map[string]string,
+	groupRenderers *check.GroupRendererRegistry,
 ) []*verboseRow {
 	defaultFmt := &check.DefaultVerboseFormatter{
 		NamespaceRequesters: namespaceRequesters,
@@ -294,17 +514,26 @@ func buildVerboseRows(
 			exec: exec,
 		}
 
-		// Pre-render verbose detail to a buffer so we can measure line widths.
-		if f, ok := exec.Check.(check.VerboseOutputFormatter); ok {
+		// Pre-render verbose detail to a buffer so we can measure line widths. A group renderer
+		// registered for the objects' annotations takes precedence over the check's formatting.
+		renderer, grouped := groupRenderers.For(exec.Result)
+		f, custom := exec.Check.(check.VerboseOutputFormatter)
+
+		switch {
+		case grouped:
+			renderer.FormatVerboseOutput(&r.detailBuf, exec.Result)
+		case custom:
 			if nrs, ok := exec.Check.(namespaceRequesterSetter); ok {
 				nrs.SetNamespaceRequesters(namespaceRequesters)
 			}
 
 			f.FormatVerboseOutput(&r.detailBuf, exec.Result)
-		} else {
+		default:
 			defaultFmt.FormatVerboseOutput(&r.detailBuf, exec.Result)
 		}
 
+		writeRemediationCommands(&r.detailBuf, exec.Result)
+
 		rows = append(rows, r)
 	}
 
@@ -431,6 +660,28 @@ func formatVerboseDetailLine(line string, innerWidth int) string {
 	return b.String()
 }
 
+// writeRemediationCommands lists the remediation commands of the impacted objects of a result,
+// after the detail of its formatter so checks with custom formatting show them too.
+func writeRemediationCommands(out io.Writer, dr *result.DiagnosticResult) {
+	var commands []string
+
+	for _, obj := range dr.ImpactedObjects {
+		if cmd := obj.Annotations[result.AnnotationObjectRemediationCmd]; cmd != "" {
+			commands = append(commands, cmd)
+		}
+	}
+
+	if len(commands) == 0 {
+		return
+	}
+
+	_, _ = fmt.Fprintln(out, "\n    Remediation commands:")
+
+	for _, cmd := range commands {
+		_, _ = fmt.Fprintf(out, "      $ %s\n", cmd)
+	}
+}
+
 // outputImpactedObjects prints impacted objects in a bordered 5-column table
 // matching the summary table's style (STATUS, KIND, GROUP, CHECK, IMPACT).
 // Verbose detail lines from VerboseOutputFormatter appear beneath each data row,
@@ -440,8 +691,9 @@ func outputImpactedObjects(
 	out io.Writer,
 	results []check.CheckExecution,
 	namespaceRequesters map[string]string,
+	groupRenderers *check.GroupRendererRegistry,
 ) {
-	rows := buildVerboseRows(results, namespaceRequesters)
+	rows := buildVerboseRows(results, namespaceRequesters, groupRenderers)
 	if len(rows) == 0 {
 		return
 	}
@@ -229,6 +229,95 @@ func TestOutputTable_VerboseClusterScopedObject(t *testing.T) {
 	g.Expect(output).ToNot(ContainSubstring("/my-cluster-resource"))
 }
 
+func TestOutputTable_VerboseRemediationCommands(t *testing.T) {
+	g := NewWithT(t)
+
+	results := []check.CheckExecution{
+		{
+			Result: &result.DiagnosticResult{
+				Group: "workloads",
+				Kind:  "kserve",
+				Name:  "hardwareprofile-migration",
+				Status: result.DiagnosticStatus{
+					Conditions: []result.Condition{passCondition()},
+				},
+				ImpactedObjects: []metav1.PartialObjectMetadata{
+					{
+						TypeMeta: metav1.TypeMeta{Kind: "InferenceService", APIVersion: "serving.kserve.io/v1beta1"},
+						ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "isvc-1", Annotations: map[string]string{
+							result.AnnotationObjectRemediationCmd: "kubectl annotate inferenceservices.serving.kserve.io isvc-1 -n ns1 x-",
+						}},
+					},
+					{
+						TypeMeta:   metav1.TypeMeta{Kind: "InferenceService", APIVersion: "serving.kserve.io/v1beta1"},
+						ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "isvc-2"},
+					},
+				},
+			},
+		},
+	}
+
+	var buf bytes.Buffer
+	err := lint.OutputTable(&buf, results, lint.TableOutputOptions{ShowImpactedObjects: true})
+	g.Expect(err).ToNot(HaveOccurred())
+
+	output := buf.String()
+	g.Expect(output).To(ContainSubstring("Remediation commands:"))
+	g.Expect(output).To(ContainSubstring("$ kubectl annotate inferenceservices.serving.kserve.io isvc-1 -n ns1 x-"))
+	g.Expect(strings.Count(output, "$ kubectl")).To(Equal(1))
+}
+
+func TestOutputTable_VerboseGroupRenderers(t *testing.T) {
+	const annotationImageRef = "check.opendatahub.io/image-ref"
+
+	results := []check.CheckExecution{
+		{
+			Result: &result.DiagnosticResult{
+				Group: "workloads",
+				Kind:  "notebook",
+				Name:  "impacted-workloads",
+				Status: result.DiagnosticStatus{
+					Conditions: []result.Condition{passCondition()},
+				},
+				ImpactedObjects: []metav1.PartialObjectMetadata{
+					{
+						TypeMeta: metav1.TypeMeta{Kind: "Notebook", APIVersion: "kubeflow.org/v1"},
+						ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "nb-1", Annotations: map[string]string{
+							annotationImageRef: "quay.io/custom/image:1.0",
+						}},
+					},
+				},
+			},
+		},
+	}
+
+	renderers := check.NewGroupRendererRegistry()
+	renderers.MustRegister(check.GroupRenderer{
+		AnnotationSchema: check.AnnotationSchema{Kind: "Notebook", Annotation: annotationImageRef},
+		Noun:             "notebooks",
+	})
+
+	t.Run("should group objects with the renderer of their annotation", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var buf bytes.Buffer
+		err := lint.OutputTable(&buf, results, lint.TableOutputOptions{ShowImpactedObjects: true, GroupRenderers: renderers})
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(buf.String()).To(ContainSubstring(annotationImageRef + ": quay.io/custom/image:1.0 (1 notebooks)"))
+	})
+
+	t.Run("should list objects by namespace without renderers", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var buf bytes.Buffer
+		err := lint.OutputTable(&buf, results, lint.TableOutputOptions{ShowImpactedObjects: true})
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(buf.String()).ToNot(ContainSubstring("(1 notebooks)"))
+		g.Expect(buf.String()).To(ContainSubstring("ns1:"))
+		g.Expect(buf.String()).To(ContainSubstring("- nb-1 (Notebook)"))
+	})
+}
+
 func TestOutputTable_VerboseNamespaceRequester(t *testing.T) {
 	g := NewWithT(t)
 
@@ -720,3 +809,147 @@ func TestOutputTable_NoBannerWhenNoProhibitedFindings(t *testing.T) {
 
 	g.Expect(buf.String()).ToNot(ContainSubstring("Prohibited Violations Detected"))
 }
+
+func TestOutputTable_SummaryShowsSuppressedCount(t *testing.T) {
+	g := NewWithT(t)
+
+	withSuppressed := result.New("workload", "notebook", "impacted-workloads", "description")
+	withSuppressed.Annotations[result.AnnotationSuppressedCount] = "3"
+	withSuppressed.Status.Conditions = []result.Condition{passCondition()}
+
+	var buf bytes.Buffer
+	err := lint.OutputTable(&buf, []check.CheckExecution{{Result: withSuppressed}}, lint.TableOutputOptions{})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(buf.String()).To(ContainSubstring("Prohibited: 0 | Suppressed: 3\n"))
+
+	buf.Reset()
+	err = lint.OutputTable(&buf, []check.CheckExecution{{Result: &result.DiagnosticResult{
+		Group:  "component",
+		Kind:   "dashboard",
+		Name:   "version-check",
+		Status: result.DiagnosticStatus{Conditions: []result.Condition{passCondition()}},
+	}}}, lint.TableOutputOptions{})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(buf.String()).ToNot(ContainSubstring("Suppressed"))
+}
+
+func TestOutputTable_WideShowsRemediationAndImpactedCount(t *testing.T) {
+	g := NewWithT(t)
+
+	results := []check.CheckExecution{
+		{
+			Result: &result.DiagnosticResult{
+				Group:       "workloads",
+				Kind:        "kueue",
+				Name:        "labels",
+				Annotations: map[string]string{check.AnnotationImpactedWorkloadCount: "7"},
+				Status: result.DiagnosticStatus{
+					Conditions: []result.Condition{{
+						Condition: metav1.Condition{
+							Type:    "Compatible",
+							Status:  metav1.ConditionFalse,
+							Reason:  "MissingLabels",
+							Message: "workloads missing queue label",
+						},
+						Impact:      result.ImpactBlocking,
+						Remediation: "Add the kueue.x-k8s.io/queue-name label",
+					}},
+				},
+			},
+		},
+		{
+			Result: &result.DiagnosticResult{
+				Group: "workloads",
+				Kind:  "notebook",
+				Name:  "accelerator-migration",
+				Status: result.DiagnosticStatus{
+					Conditions: []result.Condition{passCondition()},
+				},
+				ImpactedObjects: []metav1.PartialObjectMetadata{
+					{ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "nb-1"}},
+					{ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "nb-2"}},
+				},
+			},
+		},
+	}
+
+	var buf bytes.Buffer
+	err := lint.OutputTable(&buf, results, lint.TableOutputOptions{Wide: true})
+	g.Expect(err).ToNot(HaveOccurred())
+
+	lines := strings.Split(buf.String(), "\n")
+
+	var header, kueueRow, notebookRow string
+	for _, line := range lines {
+		switch {
+		case strings.Contains(line, "IMPACTED-COUNT"):
+			header = line
+		case strings.Contains(line, "kueue"):
+			kueueRow = line
+		case strings.Contains(line, "notebook"):
+			notebookRow = line
+		}
+	}
+
+	g.Expect(header).To(ContainSubstring("REMEDIATION"))
+	g.Expect(kueueRow).To(ContainSubstring("Add the kueue.x-k8s.io/queue-name label"))
+	g.Expect(kueueRow).To(MatchRegexp(`\b7\b`))
+	g.Expect(notebookRow).To(MatchRegexp(`\b2\b`))
+	g.Expect(notebookRow).To(ContainSubstring("-"))
+}
+
+func TestOutputTable_WideImpactedCountWithUsage(t *testing.T) {
+	g := NewWithT(t)
+
+	usage := func(u check.Usage) map[string]string {
+		return map[string]string{result.AnnotationObjectUsage: string(u)}
+	}
+
+	results := []check.CheckExecution{
+		{
+			Result: &result.DiagnosticResult{
+				Group: "workloads",
+				Kind:  "notebook",
+				Name:  "running-workloads",
+				Status: result.DiagnosticStatus{
+					Conditions: []result.Condition{passCondition()},
+				},
+				ImpactedObjects: []metav1.PartialObjectMetadata{
+					{ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "nb-1", Annotations: usage(check.UsageActive)}},
+					{ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "nb-2", Annotations: usage(check.UsageIdle)}},
+					{ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "nb-3"}},
+				},
+			},
+		},
+	}
+
+	var buf bytes.Buffer
+	err := lint.OutputTable(&buf, results, lint.TableOutputOptions{Wide: true})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(buf.String()).To(ContainSubstring("3 (1 in use)"))
+}
+
+func TestOutputTable_DefaultOmitsWideColumns(t *testing.T) {
+	g := NewWithT(t)
+
+	results := []check.CheckExecution{
+		{
+			Result: &result.DiagnosticResult{
+				Group: "components",
+				Kind:  "kserve",
+				Name:  "serverless-removal",
+				Status: result.DiagnosticStatus{
+					Conditions: []result.Condition{passCondition()},
+				},
+			},
+		},
+	}
+
+	var buf bytes.Buffer
+	err := lint.OutputTable(&buf, results, lint.TableOutputOptions{})
+	g.Expect(err).ToNot(HaveOccurred())
+
+	output := buf.String()
+	g.Expect(output).ToNot(ContainSubstring("REMEDIATION"))
+	g.Expect(output).ToNot(ContainSubstring("IMPACTED-COUNT"))
+}
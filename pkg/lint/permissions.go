@@ -0,0 +1,422 @@
+package lint
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	authorizationv1client "k8s.io/client-go/kubernetes/typed/authorization/v1"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/messages"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/printer/table"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+	clierrors "github.com/opendatahub-io/odh-cli/pkg/util/errors"
+	"github.com/opendatahub-io/odh-cli/pkg/util/kube/rbac"
+)
+
+const (
+	// DefaultClusterRoleName is the name of the ClusterRole generated for lint.
+	DefaultClusterRoleName = "odh-lint-reader"
+
+	permissionsCheckID   = "platform.rbac.permissions"
+	permissionsCheckKind = "rbac"
+
+	msgPermissionsDenied = "Warning: %d required permission(s) denied; skipping %d check(s) that need them. " +
+		"Run 'kubectl odh lint permissions' for the ClusterRole lint needs."
+	msgPermissionsVerifyFailed = "Warning: failed to verify permissions, running all checks: %v"
+	msgServiceAccountGranted   = "Service account %s can read all %d required resource(s)"
+	msgServiceAccountDenied    = "Service account %s is denied %d required permission(s):"
+)
+
+// Messages of the permissions check.
+//
+//nolint:gochecknoglobals // Message catalog entries
+var (
+	msgPermissionsMissing  = messages.New(permissionsCheckID+".missing", "Missing permissions: %s; skipped checks: %s")
+	remediationPermissions = messages.New(messages.RemediationID(permissionsCheckID), "Grant the ClusterRole printed by 'kubectl odh lint permissions' to the user running lint")
+)
+
+// RequiredPermission is read access lint needs on one resource, along with the checks needing it.
+type RequiredPermission struct {
+	Group    string `json:"group"    yaml:"group"`
+	Resource string `json:"resource" yaml:"resource"`
+
+	// Platform is true for resources read by every run (see check.PlatformResources).
+	Platform bool `json:"platform" yaml:"platform"`
+
+	// Checks lists the IDs of the selected checks reading the resource.
+	Checks []string `json:"checks,omitempty" yaml:"checks,omitempty"`
+}
+
+// PermissionStatus is the outcome of verifying a required permission for the current user.
+type PermissionStatus struct {
+	RequiredPermission
+
+	// Denied lists the read verbs the current user is not allowed to use.
+	Denied []string `json:"denied,omitempty" yaml:"denied,omitempty"`
+}
+
+// Allowed reports whether all read verbs are granted.
+func (s PermissionStatus) Allowed() bool {
+	return len(s.Denied) == 0
+}
+
+// RequiredPermissions returns the read permissions needed to run the checks matching the
+// selectors, including the platform resources read by every run. Resource versions are
+// irrelevant to RBAC, so permissions are deduplicated by API group and resource and sorted.
+func RequiredPermissions(registry *check.CheckRegistry, selectors []string) ([]RequiredPermission, error) {
+	required, err := registry.RequiredResources(selectors)
+	if err != nil {
+		return nil, err //nolint:wrapcheck // RequiredResources already adds context
+	}
+
+	index := make(map[string]*RequiredPermission)
+
+	add := func(rt resources.ResourceType) *RequiredPermission {
+		key := rt.Group + "/" + rt.Resource
+		if p, ok := index[key]; ok {
+			return p
+		}
+
+		p := &RequiredPermission{Group: rt.Group, Resource: rt.Resource}
+		index[key] = p
+
+		return p
+	}
+
+	for _, rt := range check.PlatformResources {
+		add(rt).Platform = true
+	}
+
+	for checkID, types := range required {
+		for _, rt := range types {
+			p := add(rt)
+			if !slices.Contains(p.Checks, checkID) {
+				p.Checks = append(p.Checks, checkID)
+			}
+		}
+	}
+
+	perms := make([]RequiredPermission, 0, len(index))
+	for _, p := range index {
+		slices.Sort(p.Checks)
+		perms = append(perms, *p)
+	}
+
+	slices.SortFunc(perms, func(a, b RequiredPermission) int {
+		if a.Group != b.Group {
+			return strings.Compare(a.Group, b.Group)
+		}
+
+		return strings.Compare(a.Resource, b.Resource)
+	})
+
+	return perms, nil
+}
+
+// VerifyPermissions checks the read verbs of each required permission for the current user
+// with SelfSubjectAccessReviews.
+func VerifyPermissions(
+	ctx context.Context,
+	authClient authorizationv1client.AuthorizationV1Interface,
+	required []RequiredPermission,
+) ([]PermissionStatus, error) {
+	reviews := make([]rbac.PermissionCheck, 0, len(required)*len(check.ReadVerbs))
+	for _, p := range required {
+		for _, verb := range check.ReadVerbs {
+			reviews = append(reviews, rbac.PermissionCheck{Verb: verb, Group: p.Group, Resource: p.Resource})
+		}
+	}
+
+	denied, err := rbac.CheckPermissions(ctx, authClient, reviews)
+	if err != nil {
+		return nil, fmt.Errorf("verifying permissions: %w", err)
+	}
+
+	statuses := make([]PermissionStatus, 0, len(required))
+	for _, p := range required {
+		status := PermissionStatus{RequiredPermission: p}
+
+		for _, d := range denied {
+			if d.Group == p.Group && d.Resource == p.Resource {
+				status.Denied = append(status.Denied, d.Verb)
+			}
+		}
+
+		statuses = append(statuses, status)
+	}
+
+	return statuses, nil
+}
+
+// skippedChecks returns the sorted IDs of the checks needing a denied permission.
+func skippedChecks(statuses []PermissionStatus) []string {
+	var ids []string
+
+	for _, s := range statuses {
+		if s.Allowed() {
+			continue
+		}
+
+		for _, id := range s.Checks {
+			if !slices.Contains(ids, id) {
+				ids = append(ids, id)
+			}
+		}
+	}
+
+	slices.Sort(ids)
+
+	return ids
+}
+
+// deniedPermissions returns the statuses with at least one denied verb.
+func deniedPermissions(statuses []PermissionStatus) []PermissionStatus {
+	return slices.DeleteFunc(slices.Clone(statuses), func(s PermissionStatus) bool {
+		return s.Allowed()
+	})
+}
+
+// NewClusterRole builds a ClusterRole granting read access to the required permissions,
+// with one rule per API group.
+func NewClusterRole(name string, required []RequiredPermission) *rbacv1.ClusterRole {
+	role := &rbacv1.ClusterRole{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: rbacv1.SchemeGroupVersion.String(),
+			Kind:       "ClusterRole",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+	}
+
+	for _, p := range required {
+		n := len(role.Rules)
+		if n > 0 && role.Rules[n-1].APIGroups[0] == p.Group {
+			if !slices.Contains(role.Rules[n-1].Resources, p.Resource) {
+				role.Rules[n-1].Resources = append(role.Rules[n-1].Resources, p.Resource)
+			}
+
+			continue
+		}
+
+		role.Rules = append(role.Rules, rbacv1.PolicyRule{
+			APIGroups: []string{p.Group},
+			Resources: []string{p.Resource},
+			Verbs:     slices.Clone(check.ReadVerbs),
+		})
+	}
+
+	return role
+}
+
+// permissionsCheck describes the dedicated result reporting missing permissions. It is not
+// registered: the result is produced by the preflight rather than by running the check.
+type permissionsCheck struct {
+	check.BaseCheck
+}
+
+func (c *permissionsCheck) CanApply(_ context.Context, _ check.Target) (bool, error) {
+	return false, nil
+}
+
+func (c *permissionsCheck) Validate(_ context.Context, _ check.Target) (*result.DiagnosticResult, error) {
+	return nil, nil
+}
+
+// newPermissionsExecution builds the diagnostic result listing denied permissions and the
+// checks skipped because of them, in the language of the printer.
+func newPermissionsExecution(denied []PermissionStatus, skipped []string, p messages.Printer) check.CheckExecution {
+	chk := &permissionsCheck{BaseCheck: check.BaseCheck{
+		CheckGroup:       check.GroupPlatform,
+		Kind:             permissionsCheckKind,
+		Type:             check.CheckTypePermissions,
+		CheckID:          permissionsCheckID,
+		CheckName:        "Platform :: RBAC :: Permissions",
+		CheckDescription: "Verifies that the current user can read every resource needed by the selected checks",
+		CheckRemediation: remediationPermissions.Format,
+		CheckVersions:    check.VersionsAny,
+	}}
+
+	missing := make([]string, 0, len(denied))
+	for _, d := range denied {
+		missing = append(missing, strings.Join(d.Denied, "/")+" "+qualifiedResource(d.Group, d.Resource))
+	}
+
+	dr := chk.NewResult()
+	dr.SetCondition(check.NewCondition(
+		check.ConditionTypeAuthorized,
+		metav1.ConditionFalse,
+		check.WithReason(check.ReasonPermissionDenied),
+		check.WithCatalogMessage(msgPermissionsMissing, strings.Join(missing, ", "), strings.Join(skipped, ", ")),
+		check.WithImpact(result.ImpactAdvisory),
+		check.WithCatalogRemediation(remediationPermissions),
+	))
+	check.Localize(dr, p)
+
+	return check.CheckExecution{Check: chk, Result: dr}
+}
+
+func qualifiedResource(group, resource string) string {
+	if group == "" {
+		return resource
+	}
+
+	return resource + "." + group
+}
+
+// preflightPermissions verifies the permissions needed by the selected checks before they run.
+// Checks needing a denied permission are excluded from the executor and reported, together
+// with the missing permissions, by the returned execution (nil when nothing is denied).
+// Offline runs have nothing to verify.
+func (c *Command) preflightPermissions(ctx context.Context, executor *check.Executor) *check.CheckExecution {
+	if c.Client == nil {
+		return nil
+	}
+
+	required, err := RequiredPermissions(c.registry, c.CheckSelectors)
+	if err != nil {
+		c.IO.Errorf(msgPermissionsVerifyFailed, err)
+
+		return nil
+	}
+
+	statuses, err := VerifyPermissions(ctx, c.Client.AuthorizationV1(), required)
+	if err != nil {
+		c.IO.Errorf(msgPermissionsVerifyFailed, err)
+
+		return nil
+	}
+
+	denied := deniedPermissions(statuses)
+	if len(denied) == 0 {
+		return nil
+	}
+
+	skipped := skippedChecks(statuses)
+	executor.Exclude(skipped...)
+
+	_, _ = fmt.Fprintf(c.IO.ErrOut(), msgPermissionsDenied+"\n", len(denied), len(skipped))
+
+	exec := newPermissionsExecution(denied, skipped, c.printer)
+
+	return &exec
+}
+
+type permissionRow struct {
+	Resource string
+	Verbs    string
+	Allowed  string
+	Checks   string
+}
+
+// runPermissionsDryRun verifies the permissions needed by the selected checks without running
+// them and prints one row per resource. It fails with ExitAuth when any permission is denied.
+func (c *Command) runPermissionsDryRun(ctx context.Context) error {
+	required, err := RequiredPermissions(c.registry, c.CheckSelectors)
+	if err != nil {
+		return err
+	}
+
+	statuses, err := VerifyPermissions(ctx, c.Client.AuthorizationV1(), required)
+	if err != nil {
+		return err
+	}
+
+	renderer := table.NewRenderer(
+		table.WithWriter[permissionRow](c.IO.Out()),
+		table.WithHeaders[permissionRow]("RESOURCE", "VERBS", "ALLOWED", "CHECKS"),
+		table.WithTableOptions[permissionRow](table.DefaultTableOptions...),
+	)
+
+	for _, s := range statuses {
+		allowed := "Yes"
+		if !s.Allowed() {
+			allowed = "No (" + strings.Join(s.Denied, ", ") + ")"
+		}
+
+		checks := fmt.Sprintf("%d", len(s.Checks))
+		if s.Platform {
+			checks = "all"
+		}
+
+		if err := renderer.Append(permissionRow{
+			Resource: qualifiedResource(s.Group, s.Resource),
+			Verbs:    strings.Join(check.ReadVerbs, ", "),
+			Allowed:  allowed,
+			Checks:   checks,
+		}); err != nil {
+			return fmt.Errorf("failed to append row: %w", err)
+		}
+	}
+
+	if err := renderer.Render(); err != nil {
+		return fmt.Errorf("failed to render table: %w", err)
+	}
+
+	denied := deniedPermissions(statuses)
+	if len(denied) == 0 {
+		c.IO.Fprintf("\nAll %d required permission(s) are granted.\n", len(statuses))
+
+		return nil
+	}
+
+	skipped := skippedChecks(statuses)
+	c.IO.Fprintf("\n%d of %d required permission(s) denied; %d check(s) would be skipped: %s\n",
+		len(denied), len(statuses), len(skipped), strings.Join(skipped, ", "))
+
+	//nolint:wrapcheck // NewAlreadyHandledError is a same-module constructor
+	return clierrors.NewAlreadyHandledError(clierrors.NewExitCodeError(clierrors.ExitAuth,
+		fmt.Errorf("%d required permission(s) denied", len(denied))))
+}
+
+// verifyServiceAccount checks, before a run, that the service account mounted in the pod can
+// read every resource the selected checks need. Scheduled scans thus fail with ExitAuth and
+// the missing permissions instead of silently skipping checks.
+func (c *Command) verifyServiceAccount(ctx context.Context) error {
+	sa, err := client.ReadServiceAccount(c.serviceAccountDir)
+	if errors.Is(err, client.ErrNoServiceAccount) {
+		//nolint:wrapcheck // NewExitCodeError is a same-module constructor
+		return clierrors.NewExitCodeError(clierrors.ExitValidation,
+			errors.New("--service-account-check requires running in a pod with a mounted service account token"))
+	}
+
+	if err != nil {
+		return err
+	}
+
+	required, err := RequiredPermissions(c.registry, c.CheckSelectors)
+	if err != nil {
+		return err
+	}
+
+	statuses, err := VerifyPermissions(ctx, c.Client.AuthorizationV1(), required)
+	if err != nil {
+		return err
+	}
+
+	denied := deniedPermissions(statuses)
+	if len(denied) == 0 {
+		c.IO.Errorf(msgServiceAccountGranted, sa, len(statuses))
+
+		return nil
+	}
+
+	_, _ = fmt.Fprintf(c.IO.ErrOut(), msgServiceAccountDenied+"\n", sa, len(denied))
+
+	for _, s := range denied {
+		_, _ = fmt.Fprintf(c.IO.ErrOut(), "  - %s (%s)\n", qualifiedResource(s.Group, s.Resource), strings.Join(s.Denied, ", "))
+	}
+
+	//nolint:wrapcheck // NewExitCodeError is a same-module constructor
+	return clierrors.NewExitCodeError(clierrors.ExitAuth,
+		fmt.Errorf("service account %s lacks %d required permission(s); grant them with "+
+			"'kubectl odh lint rbac --serviceaccount %s | kubectl apply -f -'", sa, len(denied), sa))
+}
@@ -0,0 +1,292 @@
+//nolint:testpackage // internal test: exercises the unexported permission preflight
+package lint
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"testing"
+
+	authorizationv1 "k8s.io/api/authorization/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+	clierrors "github.com/opendatahub-io/odh-cli/pkg/util/errors"
+
+	. "github.com/onsi/gomega"
+)
+
+const testServiceAccountClaims = `{"sub":"system:serviceaccount:odh-lint:odh-lint"}`
+
+type permissionsTestCheck struct {
+	check.BaseCheck
+}
+
+func (c *permissionsTestCheck) CanApply(_ context.Context, _ check.Target) (bool, error) {
+	return true, nil
+}
+
+func (c *permissionsTestCheck) Validate(_ context.Context, _ check.Target) (*result.DiagnosticResult, error) {
+	return c.NewResult(), nil
+}
+
+func newPermissionsTestRegistry(t *testing.T) *check.CheckRegistry {
+	t.Helper()
+
+	registry := check.NewRegistry()
+
+	for _, chk := range []*permissionsTestCheck{
+		{BaseCheck: check.BaseCheck{
+			CheckGroup: check.GroupWorkload, Kind: "notebook", Type: check.CheckTypeImpactedWorkloads,
+			CheckID: "workloads.notebook.test", CheckResources: []resources.ResourceType{resources.Notebook, resources.Secret},
+		}},
+		{BaseCheck: check.BaseCheck{
+			CheckGroup: check.GroupWorkload, Kind: "ray", Type: check.CheckTypeImpactedWorkloads,
+			CheckID: "workloads.ray.test", CheckResources: []resources.ResourceType{resources.RayCluster, resources.Secret},
+		}},
+	} {
+		if err := registry.Register(chk); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return registry
+}
+
+// newAccessReviewClient returns a client whose SelfSubjectAccessReviews deny the given resources.
+func newAccessReviewClient(deniedResources ...string) client.Client {
+	kube := kubefake.NewSimpleClientset() //nolint:staticcheck // Need PrependReactor for SelfSubjectAccessReview responses
+	kube.PrependReactor("create", "selfsubjectaccessreviews",
+		func(action k8stesting.Action) (bool, runtime.Object, error) {
+			review, _ := action.(k8stesting.CreateAction).GetObject().(*authorizationv1.SelfSubjectAccessReview)
+
+			allowed := true
+
+			for _, r := range deniedResources {
+				if review.Spec.ResourceAttributes.Resource == r {
+					allowed = false
+				}
+			}
+
+			return true, &authorizationv1.SelfSubjectAccessReview{
+				Status: authorizationv1.SubjectAccessReviewStatus{Allowed: allowed},
+			}, nil
+		},
+	)
+
+	return client.NewForTesting(client.TestClientConfig{Kubernetes: kube})
+}
+
+func TestRequiredPermissions(t *testing.T) {
+	g := NewWithT(t)
+
+	perms, err := RequiredPermissions(newPermissionsTestRegistry(t), []string{"*"})
+	g.Expect(err).ToNot(HaveOccurred())
+
+	byResource := make(map[string]RequiredPermission)
+	for _, p := range perms {
+		byResource[p.Resource] = p
+	}
+
+	// DataScienceCluster v1 and v2 share one permission
+	g.Expect(byResource).To(HaveKey("datascienceclusters"))
+	g.Expect(byResource["datascienceclusters"].Platform).To(BeTrue())
+	g.Expect(byResource["secrets"].Checks).To(Equal([]string{"workloads.notebook.test", "workloads.ray.test"}))
+	g.Expect(byResource["notebooks"].Checks).To(Equal([]string{"workloads.notebook.test"}))
+	g.Expect(byResource["notebooks"].Platform).To(BeFalse())
+
+	count := 0
+	for _, p := range perms {
+		if p.Resource == "datascienceclusters" {
+			count++
+		}
+	}
+
+	g.Expect(count).To(Equal(1))
+}
+
+func TestNewClusterRole(t *testing.T) {
+	g := NewWithT(t)
+
+	role := NewClusterRole("reader", []RequiredPermission{
+		{Group: "", Resource: "secrets"},
+		{Group: "ray.io", Resource: "rayclusters"},
+		{Group: "ray.io", Resource: "rayjobs"},
+	})
+
+	g.Expect(role.Kind).To(Equal("ClusterRole"))
+	g.Expect(role.APIVersion).To(Equal("rbac.authorization.k8s.io/v1"))
+	g.Expect(role.Name).To(Equal("reader"))
+	g.Expect(role.Rules).To(HaveLen(2))
+	g.Expect(role.Rules[1].APIGroups).To(Equal([]string{"ray.io"}))
+	g.Expect(role.Rules[1].Resources).To(Equal([]string{"rayclusters", "rayjobs"}))
+	g.Expect(role.Rules[1].Verbs).To(Equal([]string{"get", "list"}))
+}
+
+func TestCommand_PreflightPermissions(t *testing.T) {
+	newCommand := func(c client.Client, errOut *bytes.Buffer) *Command {
+		command := NewCommand(genericiooptions.IOStreams{
+			In: &bytes.Buffer{}, Out: &bytes.Buffer{}, ErrOut: errOut,
+		}, nil)
+		command.registry = newPermissionsTestRegistry(t)
+		command.Client = c
+
+		return command
+	}
+
+	t.Run("should skip checks needing denied permissions and report them", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var errOut bytes.Buffer
+		command := newCommand(newAccessReviewClient("rayclusters"), &errOut)
+		executor := check.NewExecutor(command.registry, command.IO)
+
+		exec := command.preflightPermissions(t.Context(), executor)
+		g.Expect(exec).ToNot(BeNil())
+		g.Expect(exec.Check.ID()).To(Equal(permissionsCheckID))
+		g.Expect(exec.Result.GetImpact()).To(Equal(result.ImpactAdvisory))
+		g.Expect(exec.Result.Status.Conditions[0].Reason).To(Equal(check.ReasonPermissionDenied))
+		g.Expect(exec.Result.Status.Conditions[0].Message).To(ContainSubstring("get/list rayclusters.ray.io"))
+		g.Expect(exec.Result.Status.Conditions[0].Message).To(ContainSubstring("skipped checks: workloads.ray.test"))
+		g.Expect(errOut.String()).To(ContainSubstring("1 required permission(s) denied; skipping 1 check(s)"))
+
+		results, err := executor.ExecuteSelective(t.Context(), check.Target{}, []string{"*"}, check.GroupWorkload)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(results).To(HaveLen(1))
+		g.Expect(results[0].Check.ID()).To(Equal("workloads.notebook.test"))
+	})
+
+	t.Run("should report nothing when all permissions are granted", func(t *testing.T) {
+		g := NewWithT(t)
+
+		command := newCommand(newAccessReviewClient(), &bytes.Buffer{})
+
+		g.Expect(command.preflightPermissions(t.Context(), check.NewExecutor(command.registry, command.IO))).To(BeNil())
+	})
+
+	t.Run("should skip verification offline", func(t *testing.T) {
+		g := NewWithT(t)
+
+		command := newCommand(nil, &bytes.Buffer{})
+
+		g.Expect(command.preflightPermissions(t.Context(), check.NewExecutor(command.registry, command.IO))).To(BeNil())
+	})
+}
+
+func TestCommand_RunPermissionsDryRun(t *testing.T) {
+	t.Run("should fail with ExitAuth when permissions are denied", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var out bytes.Buffer
+		command := NewCommand(genericiooptions.IOStreams{
+			In: &bytes.Buffer{}, Out: &out, ErrOut: &bytes.Buffer{},
+		}, nil)
+		command.registry = newPermissionsTestRegistry(t)
+		command.Client = newAccessReviewClient("secrets")
+
+		err := command.runPermissionsDryRun(t.Context())
+		g.Expect(err).To(MatchError(clierrors.ErrAlreadyHandled))
+		g.Expect(clierrors.ExitCodeFromError(err)).To(Equal(clierrors.ExitAuth))
+		g.Expect(out.String()).To(ContainSubstring("No (get, list)"))
+		g.Expect(out.String()).To(ContainSubstring("2 check(s) would be skipped: workloads.notebook.test, workloads.ray.test"))
+	})
+
+	t.Run("should succeed when all permissions are granted", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var out bytes.Buffer
+		command := NewCommand(genericiooptions.IOStreams{
+			In: &bytes.Buffer{}, Out: &out, ErrOut: &bytes.Buffer{},
+		}, nil)
+		command.registry = newPermissionsTestRegistry(t)
+		command.Client = newAccessReviewClient()
+
+		g.Expect(command.runPermissionsDryRun(t.Context())).To(Succeed())
+		g.Expect(out.String()).To(ContainSubstring("required permission(s) are granted"))
+	})
+}
+
+func TestCommand_VerifyServiceAccount(t *testing.T) {
+	newCommand := func(t *testing.T, errOut *bytes.Buffer, cl client.Client, dir string) *Command {
+		t.Helper()
+
+		command := NewCommand(genericiooptions.IOStreams{
+			In: &bytes.Buffer{}, Out: &bytes.Buffer{}, ErrOut: errOut,
+		}, nil)
+		command.registry = newPermissionsTestRegistry(t)
+		command.Client = cl
+		command.serviceAccountDir = dir
+
+		return command
+	}
+
+	t.Run("should fail with ExitValidation without a mounted token", func(t *testing.T) {
+		g := NewWithT(t)
+
+		command := newCommand(t, &bytes.Buffer{}, newAccessReviewClient(), t.TempDir())
+
+		err := command.verifyServiceAccount(t.Context())
+		g.Expect(err).To(MatchError(ContainSubstring("requires running in a pod")))
+		g.Expect(clierrors.ExitCodeFromError(err)).To(Equal(clierrors.ExitValidation))
+	})
+
+	t.Run("should fail with ExitAuth listing the denied permissions", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var errOut bytes.Buffer
+		command := newCommand(t, &errOut, newAccessReviewClient("secrets"), writeServiceAccountToken(t))
+
+		err := command.verifyServiceAccount(t.Context())
+		g.Expect(err).To(MatchError(ContainSubstring("lint rbac --serviceaccount odh-lint:odh-lint")))
+		g.Expect(clierrors.ExitCodeFromError(err)).To(Equal(clierrors.ExitAuth))
+		g.Expect(errOut.String()).To(ContainSubstring("Service account odh-lint:odh-lint is denied 1 required permission(s)"))
+		g.Expect(errOut.String()).To(ContainSubstring("  - secrets (get, list)"))
+	})
+
+	t.Run("should succeed when all permissions are granted", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var errOut bytes.Buffer
+		command := newCommand(t, &errOut, newAccessReviewClient(), writeServiceAccountToken(t))
+
+		g.Expect(command.verifyServiceAccount(t.Context())).To(Succeed())
+		g.Expect(errOut.String()).To(ContainSubstring("Service account odh-lint:odh-lint can read all"))
+	})
+}
+
+func TestCommand_ValidateServiceAccountCheck(t *testing.T) {
+	g := NewWithT(t)
+
+	command := NewCommand(genericiooptions.IOStreams{
+		In: &bytes.Buffer{}, Out: &bytes.Buffer{}, ErrOut: &bytes.Buffer{},
+	}, nil)
+	command.TargetVersion = "3.0.0"
+	command.FromDir = t.TempDir()
+	command.ServiceAccountCheck = true
+
+	g.Expect(command.Validate()).To(MatchError(ContainSubstring("--service-account-check cannot be used with --from-dir")))
+}
+
+// writeServiceAccountToken writes a token of the odh-lint:odh-lint service account to a
+// temporary directory and returns it.
+func writeServiceAccountToken(t *testing.T) string {
+	t.Helper()
+
+	dir := t.TempDir()
+	token := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"RS256"}`)) + "." +
+		base64.RawURLEncoding.EncodeToString([]byte(testServiceAccountClaims)) + ".signature"
+
+	if err := os.WriteFile(filepath.Join(dir, "token"), []byte(token), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	return dir
+}
@@ -0,0 +1,82 @@
+package lint
+
+import (
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/util/iostreams"
+)
+
+const msgCheckProgress = "%d/%d checks complete, %d blocking so far"
+
+// checkProgress shows how many of the selected checks were evaluated, and how many of them
+// reported prohibited or blocking findings, on a live progress display.
+type checkProgress struct {
+	display  *iostreams.Progress
+	total    int
+	done     int
+	blocking int
+}
+
+// newCheckProgress starts a progress display for a run of total checks. The display is only
+// shown for table output when both stdout and stderr are terminals; quiet, debug and
+// non-interactive runs get nil, which reports nothing.
+func (c *Command) newCheckProgress(total int) *checkProgress {
+	if !c.OutputFormat.isTable() || c.Quiet || c.Debug || !iostreams.IsTerminal(c.IO.Out()) {
+		return nil
+	}
+
+	display := iostreams.NewTerminalProgress(c.IO.ErrOut())
+	if display == nil {
+		return nil
+	}
+
+	p := &checkProgress{display: display, total: total}
+	p.render()
+
+	return p
+}
+
+// countSelectedChecks returns the number of checks selected for a run, in all groups.
+func (c *Command) countSelectedChecks() int {
+	var total int
+
+	for _, group := range check.CanonicalGroupOrder {
+		checks, err := c.registry.ListByPatterns(c.CheckSelectors, group)
+		if err == nil {
+			total += len(checks)
+		}
+	}
+
+	return total
+}
+
+// observe records an evaluated check; it is registered with check.Executor.SetProgressFunc.
+func (p *checkProgress) observe(exec check.CheckExecution) {
+	if p == nil {
+		return
+	}
+
+	p.done++
+
+	if exec.Result != nil {
+		switch exec.Result.GetImpact() { //nolint:exhaustive // only prohibited and blocking findings are counted
+		case result.ImpactProhibited, result.ImpactBlocking:
+			p.blocking++
+		}
+	}
+
+	p.render()
+}
+
+// stop ends the display and clears its line before results are printed.
+func (p *checkProgress) stop() {
+	if p == nil {
+		return
+	}
+
+	p.display.Stop()
+}
+
+func (p *checkProgress) render() {
+	p.display.Update(msgCheckProgress, p.done, p.total, p.blocking)
+}
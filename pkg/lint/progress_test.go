@@ -0,0 +1,50 @@
+//nolint:testpackage // internal test: exercises the unexported progress reporting
+package lint
+
+import (
+	"bytes"
+	"testing"
+
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/util/iostreams"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestCheckProgress(t *testing.T) {
+	t.Run("counts evaluated checks and blocking findings", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var out bytes.Buffer
+		progress := &checkProgress{display: iostreams.NewProgress(&out), total: 3}
+
+		progress.observe(buildExecution(result.ImpactBlocking))
+		progress.observe(buildExecution(result.ImpactAdvisory))
+		progress.observe(check.CheckExecution{})
+		progress.stop()
+
+		g.Expect(progress.done).To(Equal(3))
+		g.Expect(progress.blocking).To(Equal(1))
+		g.Expect(out.String()).To(ContainSubstring("3/3 checks complete, 1 blocking so far"))
+	})
+
+	t.Run("is disabled when output is not a terminal", func(t *testing.T) {
+		g := NewWithT(t)
+
+		command := NewCommand(
+			genericiooptions.IOStreams{In: &bytes.Buffer{}, Out: &bytes.Buffer{}, ErrOut: &bytes.Buffer{}},
+			genericclioptions.NewConfigFlags(true),
+		)
+
+		progress := command.newCheckProgress(5)
+		g.Expect(progress).To(BeNil())
+
+		// A nil progress is a no-op
+		progress.observe(buildPassingExecution())
+		progress.stop()
+	})
+}
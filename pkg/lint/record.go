@@ -0,0 +1,276 @@
+package lint
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilrand "k8s.io/apimachinery/pkg/util/rand"
+
+	"github.com/opendatahub-io/odh-cli/internal/version"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+)
+
+const (
+	// ReportNamePrefix prefixes the names of the ConfigMaps lint reports are recorded in.
+	ReportNamePrefix = "odh-lint-report-"
+
+	// ReportLabelSelector selects the ConfigMaps holding recorded lint reports.
+	ReportLabelSelector = labelManagedBy + "=" + reportManagedBy + "," + labelComponent + "=" + reportComponent
+
+	labelManagedBy  = "app.kubernetes.io/managed-by"
+	labelComponent  = "app.kubernetes.io/component"
+	reportManagedBy = "odh-cli"
+	reportComponent = "lint-report"
+
+	annotationReportGeneratedAt      = "lint.opendatahub.io/generated-at"
+	annotationReportClusterVersion   = "lint.opendatahub.io/cluster-version"
+	annotationReportTargetVersion    = "lint.opendatahub.io/target-version"
+	annotationReportOpenShiftVersion = "lint.opendatahub.io/openshift-version"
+	annotationReportCLIVersion       = "lint.opendatahub.io/cli-version"
+	annotationReportCLICommit        = "lint.opendatahub.io/cli-commit"
+	annotationReportResult           = "lint.opendatahub.io/result"
+	annotationReportErrors           = "lint.opendatahub.io/errors"
+	annotationReportWarnings         = "lint.opendatahub.io/warnings"
+
+	// reportDataKey holds the report JSON; reportBinaryDataKey holds it gzipped when the JSON
+	// would not fit in a ConfigMap.
+	reportDataKey       = "report.json"
+	reportBinaryDataKey = "report.json.gz"
+
+	// reportMaxDataSize leaves headroom below the 1MiB ConfigMap limit for metadata.
+	reportMaxDataSize = 900 * 1024
+
+	reportNameTimeFormat = "20060102-150405"
+
+	// reportNameAttempts bounds the names tried for a report when reports recorded in the same
+	// second already took its name; retries append reportNameSuffixLength random characters.
+	reportNameAttempts     = 5
+	reportNameSuffixLength = 5
+
+	msgReportRecorded = "Lint report recorded as ConfigMap %s/%s"
+)
+
+// ReportSummary describes a lint report: a recorded one, as read from the annotations of its
+// ConfigMap, or a report file.
+type ReportSummary struct {
+	Name             string `json:"name"                       yaml:"name"`
+	Namespace        string `json:"namespace,omitempty"        yaml:"namespace,omitempty"`
+	GeneratedAt      string `json:"generatedAt"                yaml:"generatedAt"`
+	ClusterVersion   string `json:"clusterVersion,omitempty"   yaml:"clusterVersion,omitempty"`
+	TargetVersion    string `json:"targetVersion,omitempty"    yaml:"targetVersion,omitempty"`
+	OpenShiftVersion string `json:"openShiftVersion,omitempty" yaml:"openShiftVersion,omitempty"`
+	CLIVersion       string `json:"cliVersion,omitempty"       yaml:"cliVersion,omitempty"`
+	CLICommit        string `json:"cliCommit,omitempty"        yaml:"cliCommit,omitempty"`
+	Result           string `json:"result"                     yaml:"result"`
+	Errors           int    `json:"errors"                     yaml:"errors"`
+	Warnings         int    `json:"warnings"                   yaml:"warnings"`
+}
+
+// NewReportConfigMap builds the ConfigMap recording a lint report in the given namespace. It is
+// named after the report's generation time, and its annotations carry the versions, the CLI
+// build and the status so reports can be listed without decoding them. The report is stored as
+// JSON, gzipped when it would not fit otherwise.
+func NewReportConfigMap(namespace string, list *result.DiagnosticResultList) (*corev1.ConfigMap, error) {
+	generatedAt, err := time.Parse(time.RFC3339, list.Metadata.GeneratedAt)
+	if err != nil {
+		return nil, fmt.Errorf("parsing report generation time: %w", err)
+	}
+
+	data, err := json.Marshal(list)
+	if err != nil {
+		return nil, fmt.Errorf("marshaling report: %w", err)
+	}
+
+	annotations := map[string]string{
+		annotationReportGeneratedAt: list.Metadata.GeneratedAt,
+		annotationReportCLIVersion:  list.Metadata.CLIVersion,
+		annotationReportCLICommit:   version.GetCommit(),
+	}
+
+	for key, value := range map[string]*string{
+		annotationReportClusterVersion:   list.ClusterVersion,
+		annotationReportTargetVersion:    list.TargetVersion,
+		annotationReportOpenShiftVersion: list.OpenShiftVersion,
+	} {
+		if value != nil && *value != "" {
+			annotations[key] = *value
+		}
+	}
+
+	if list.Status != nil {
+		annotations[annotationReportResult] = list.Status.Result
+		annotations[annotationReportErrors] = strconv.Itoa(list.Status.Errors)
+		annotations[annotationReportWarnings] = strconv.Itoa(list.Status.Warnings)
+	}
+
+	cm := &corev1.ConfigMap{
+		TypeMeta: metav1.TypeMeta{APIVersion: resources.ConfigMap.APIVersion(), Kind: resources.ConfigMap.Kind},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        ReportNamePrefix + generatedAt.UTC().Format(reportNameTimeFormat),
+			Namespace:   namespace,
+			Labels:      map[string]string{labelManagedBy: reportManagedBy, labelComponent: reportComponent},
+			Annotations: annotations,
+		},
+	}
+
+	if len(data) <= reportMaxDataSize {
+		cm.Data = map[string]string{reportDataKey: string(data)}
+
+		return cm, nil
+	}
+
+	var buf bytes.Buffer
+
+	zw := gzip.NewWriter(&buf)
+	if _, err := zw.Write(data); err != nil {
+		return nil, fmt.Errorf("compressing report: %w", err)
+	}
+
+	if err := zw.Close(); err != nil {
+		return nil, fmt.Errorf("compressing report: %w", err)
+	}
+
+	if buf.Len() > reportMaxDataSize {
+		return nil, fmt.Errorf("report is too large to record (%d bytes compressed)", buf.Len())
+	}
+
+	cm.BinaryData = map[string][]byte{reportBinaryDataKey: buf.Bytes()}
+
+	return cm, nil
+}
+
+// RecordReport creates the ConfigMap recording the report in the given namespace and returns
+// its name. When a report recorded in the same second already holds the name, a random suffix
+// is appended to it.
+func RecordReport(ctx context.Context, c client.Client, namespace string, list *result.DiagnosticResultList) (string, error) {
+	cm, err := NewReportConfigMap(namespace, list)
+	if err != nil {
+		return "", err
+	}
+
+	obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(cm)
+	if err != nil {
+		return "", fmt.Errorf("converting report ConfigMap: %w", err)
+	}
+
+	u := &unstructured.Unstructured{Object: obj}
+
+	for attempt := 1; ; attempt++ {
+		_, err = c.Dynamic().Resource(resources.ConfigMap.GVR()).Namespace(namespace).Create(
+			ctx, u, metav1.CreateOptions{FieldManager: fieldOwnerLint})
+		if !apierrors.IsAlreadyExists(err) || attempt == reportNameAttempts {
+			break
+		}
+
+		u.SetName(cm.Name + "-" + utilrand.String(reportNameSuffixLength))
+	}
+
+	if err != nil {
+		return "", fmt.Errorf("creating ConfigMap %s/%s: %w", namespace, u.GetName(), err)
+	}
+
+	return u.GetName(), nil
+}
+
+// NewReportSummary reads the summary of a recorded report from its ConfigMap.
+func NewReportSummary(obj *unstructured.Unstructured) ReportSummary {
+	annotations := obj.GetAnnotations()
+
+	errs, _ := strconv.Atoi(annotations[annotationReportErrors])
+	warnings, _ := strconv.Atoi(annotations[annotationReportWarnings])
+
+	return ReportSummary{
+		Name:             obj.GetName(),
+		Namespace:        obj.GetNamespace(),
+		GeneratedAt:      annotations[annotationReportGeneratedAt],
+		ClusterVersion:   annotations[annotationReportClusterVersion],
+		TargetVersion:    annotations[annotationReportTargetVersion],
+		OpenShiftVersion: annotations[annotationReportOpenShiftVersion],
+		CLIVersion:       annotations[annotationReportCLIVersion],
+		CLICommit:        annotations[annotationReportCLICommit],
+		Result:           annotations[annotationReportResult],
+		Errors:           errs,
+		Warnings:         warnings,
+	}
+}
+
+// LoadReport decodes the lint report recorded in a ConfigMap.
+func LoadReport(obj *unstructured.Unstructured) (*result.DiagnosticResultList, error) {
+	var cm corev1.ConfigMap
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, &cm); err != nil {
+		return nil, fmt.Errorf("converting ConfigMap %s: %w", obj.GetName(), err)
+	}
+
+	var data []byte
+
+	switch {
+	case cm.Data[reportDataKey] != "":
+		data = []byte(cm.Data[reportDataKey])
+	case len(cm.BinaryData[reportBinaryDataKey]) > 0:
+		zr, err := gzip.NewReader(bytes.NewReader(cm.BinaryData[reportBinaryDataKey]))
+		if err != nil {
+			return nil, fmt.Errorf("decompressing report %s: %w", cm.Name, err)
+		}
+
+		data, err = io.ReadAll(zr)
+		if err != nil {
+			return nil, fmt.Errorf("decompressing report %s: %w", cm.Name, err)
+		}
+	default:
+		return nil, fmt.Errorf("ConfigMap %s does not contain a lint report", cm.Name)
+	}
+
+	var list result.DiagnosticResultList
+	if err := json.Unmarshal(data, &list); err != nil {
+		return nil, fmt.Errorf("parsing report %s: %w", cm.Name, err)
+	}
+
+	return &list, nil
+}
+
+// recordReport records the results of the run in the operator namespace when --record is set.
+func (c *Command) recordReport(ctx context.Context, results []check.CheckExecution) error {
+	if !c.Record {
+		return nil
+	}
+
+	namespace, err := client.DiscoverOperatorNamespace(ctx, c.Reader)
+	if err != nil {
+		return fmt.Errorf("discovering operator namespace: %w", err)
+	}
+
+	list := result.NewDiagnosticResultList(&c.currentClusterVersion, &c.TargetVersion, c.openShiftVersionPtr())
+
+	for _, exec := range results {
+		if exec.Result != nil {
+			list.Results = append(list.Results, exec.Result)
+		}
+	}
+
+	list.ComputeStatus()
+	list.Summaries = SummarizeByGroup(results, c.printer)
+	list.Annotations = c.resultListAnnotations()
+
+	name, err := RecordReport(ctx, c.Client, namespace, list)
+	if err != nil {
+		return err
+	}
+
+	c.IO.Errorf(msgReportRecorded, namespace, name)
+
+	return nil
+}
@@ -0,0 +1,186 @@
+package lint_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+
+	. "github.com/onsi/gomega"
+)
+
+const reportNamespace = "redhat-ods-operator"
+
+// newReportList builds a report with one result per check name; names listed in failing
+// report a blocking finding on each of the given notebooks.
+func newReportList(generatedAt string, checks []string, failing map[string][]string) *result.DiagnosticResultList {
+	clusterVersion, targetVersion := "2.25.0", "3.0.0"
+	list := result.NewDiagnosticResultList(&clusterVersion, &targetVersion, nil)
+	list.Metadata.GeneratedAt = generatedAt
+
+	for _, name := range checks {
+		dr := result.New("workload", "notebook", name, "test check")
+
+		notebooks, isFailing := failing[name]
+		if !isFailing {
+			dr.SetCondition(check.NewCondition(check.ConditionTypeCompatible, metav1.ConditionTrue,
+				check.WithReason(check.ReasonRequirementsMet)))
+		} else {
+			dr.SetCondition(check.NewCondition(check.ConditionTypeCompatible, metav1.ConditionFalse,
+				check.WithReason(check.ReasonMigrationPending), check.WithImpact(result.ImpactBlocking)))
+		}
+
+		for _, nb := range notebooks {
+			dr.ImpactedObjects = append(dr.ImpactedObjects, metav1.PartialObjectMetadata{
+				TypeMeta:   metav1.TypeMeta{Kind: "Notebook"},
+				ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: nb},
+			})
+		}
+
+		list.Results = append(list.Results, dr)
+	}
+
+	list.ComputeStatus()
+
+	return list
+}
+
+func newReportClient(objs ...runtime.Object) client.Client {
+	scheme := runtime.NewScheme()
+	_ = metav1.AddMetaToScheme(scheme)
+
+	dyn := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(scheme, map[schema.GroupVersionResource]string{
+		resources.ConfigMap.GVR():  resources.ConfigMap.ListKind(),
+		resources.Deployment.GVR(): resources.Deployment.ListKind(),
+	}, objs...)
+
+	return client.NewForTesting(client.TestClientConfig{Dynamic: dyn})
+}
+
+func TestNewReportConfigMap(t *testing.T) {
+	t.Run("should store the report as JSON with summary annotations", func(t *testing.T) {
+		g := NewWithT(t)
+
+		list := newReportList("2026-10-01T08:00:00Z", []string{"a", "b"}, map[string][]string{"a": {"nb1"}})
+
+		cm, err := lint.NewReportConfigMap(reportNamespace, list)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(cm.Name).To(Equal("odh-lint-report-20261001-080000"))
+		g.Expect(cm.Namespace).To(Equal(reportNamespace))
+		g.Expect(cm.Labels).To(HaveKeyWithValue("app.kubernetes.io/component", "lint-report"))
+		g.Expect(cm.Annotations).To(And(
+			HaveKeyWithValue("lint.opendatahub.io/cluster-version", "2.25.0"),
+			HaveKeyWithValue("lint.opendatahub.io/target-version", "3.0.0"),
+			HaveKeyWithValue("lint.opendatahub.io/result", "failure"),
+			HaveKeyWithValue("lint.opendatahub.io/errors", "1"),
+			HaveKey("lint.opendatahub.io/cli-commit"),
+			Not(HaveKey("lint.opendatahub.io/openshift-version")),
+		))
+		g.Expect(cm.Data).To(HaveKey("report.json"))
+		g.Expect(cm.BinaryData).To(BeEmpty())
+	})
+
+	t.Run("should gzip reports too large for a ConfigMap", func(t *testing.T) {
+		g := NewWithT(t)
+
+		notebooks := make([]string, 0, 20000)
+		for i := range 20000 {
+			notebooks = append(notebooks, "notebook-"+strings.Repeat("x", 20)+"-"+string(rune('a'+i%26)))
+		}
+
+		list := newReportList("2026-10-01T08:00:00Z", []string{"a"}, map[string][]string{"a": notebooks})
+
+		cm, err := lint.NewReportConfigMap(reportNamespace, list)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(cm.Data).To(BeEmpty())
+		g.Expect(cm.BinaryData).To(HaveKey("report.json.gz"))
+
+		obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(cm)
+		g.Expect(err).ToNot(HaveOccurred())
+
+		loaded, err := lint.LoadReport(&unstructured.Unstructured{Object: obj})
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(loaded.Results).To(HaveLen(1))
+		g.Expect(loaded.Results[0].ImpactedObjects).To(HaveLen(len(notebooks)))
+	})
+}
+
+func TestRecordReport(t *testing.T) {
+	g := NewWithT(t)
+
+	c := newReportClient()
+	list := newReportList("2026-10-01T08:00:00Z", []string{"a"}, map[string][]string{"a": {"nb1"}})
+
+	name, err := lint.RecordReport(t.Context(), c, reportNamespace, list)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(name).To(Equal("odh-lint-report-20261001-080000"))
+
+	obj, err := c.GetResource(t.Context(), resources.ConfigMap, name, client.InNamespace(reportNamespace))
+	g.Expect(err).ToNot(HaveOccurred())
+
+	loaded, err := lint.LoadReport(obj)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(*loaded.TargetVersion).To(Equal("3.0.0"))
+	g.Expect(loaded.Results).To(HaveLen(1))
+	g.Expect(loaded.Results[0].ImpactedObjects).To(HaveLen(1))
+
+	g.Expect(lint.NewReportSummary(obj)).To(And(
+		HaveField("Name", name),
+		HaveField("GeneratedAt", "2026-10-01T08:00:00Z"),
+		HaveField("Result", "failure"),
+		HaveField("Errors", 1),
+	))
+}
+
+func TestRecordReport_SameSecond(t *testing.T) {
+	g := NewWithT(t)
+
+	c := newReportClient()
+
+	names := make([]string, 0, 2)
+
+	for _, failing := range []map[string][]string{{"a": {"nb1"}}, nil} {
+		list := newReportList("2026-10-01T08:00:00Z", []string{"a"}, failing)
+
+		name, err := lint.RecordReport(t.Context(), c, reportNamespace, list)
+		g.Expect(err).ToNot(HaveOccurred())
+
+		names = append(names, name)
+	}
+
+	g.Expect(names[0]).To(Equal("odh-lint-report-20261001-080000"))
+	g.Expect(names[1]).To(MatchRegexp(`^odh-lint-report-20261001-080000-[a-z0-9]{5}$`))
+
+	for i, name := range names {
+		obj, err := c.GetResource(t.Context(), resources.ConfigMap, name, client.InNamespace(reportNamespace))
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(lint.NewReportSummary(obj).Errors).To(Equal(1 - i))
+	}
+}
+
+func TestCommand_ValidateRecord(t *testing.T) {
+	g := NewWithT(t)
+
+	command := lint.NewCommand(genericiooptions.IOStreams{
+		In: &bytes.Buffer{}, Out: &bytes.Buffer{}, ErrOut: &bytes.Buffer{},
+	}, testConfigFlags())
+	command.Record = true
+	command.FromDir = "snapshot.tar.gz"
+
+	g.Expect(command.Validate()).To(MatchError(ContainSubstring("--record cannot be used with --from-dir")))
+
+	command.FromDir = ""
+	g.Expect(command.Validate()).To(Succeed())
+}
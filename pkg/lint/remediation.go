@@ -0,0 +1,162 @@
+package lint
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+	"github.com/opendatahub-io/odh-cli/pkg/util/confirmation"
+	"github.com/opendatahub-io/odh-cli/pkg/util/iostreams"
+)
+
+const (
+	msgNoRemediations       = "No automatic remediations available for the reported findings."
+	msgRemediationPreview   = "The following %d remediation(s) will be applied:"
+	msgRemediationPrompt    = "Apply these remediations to the cluster?"
+	msgRemediationCancelled = "Remediation cancelled; no changes were applied."
+	msgRemediationSummary   = "Applied %d/%d remediation(s)."
+	msgRemediationFailed    = "%d remediation(s) failed to apply"
+)
+
+// plannedRemediation pairs a remediation with the check that proposed it.
+type plannedRemediation struct {
+	checkID     string
+	remediation check.Remediation
+	dryRunErr   error
+}
+
+// planRemediations collects remediations from failing results whose checks implement
+// check.Remediator. Planning errors are reported and skipped so a single misbehaving
+// check does not prevent the remaining fixes from being offered.
+func planRemediations(
+	ctx context.Context,
+	target check.Target,
+	results []check.CheckExecution,
+	errOut io.Writer,
+) []plannedRemediation {
+	var planned []plannedRemediation
+
+	for _, exec := range results {
+		if exec.Result == nil || exec.Error != nil || !exec.Result.IsFailing() {
+			continue
+		}
+
+		remediator, ok := exec.Check.(check.Remediator)
+		if !ok {
+			continue
+		}
+
+		remediations, err := remediator.Remediations(ctx, target, exec.Result)
+		if err != nil {
+			_, _ = fmt.Fprintf(errOut, "Warning: planning remediations for %s: %v\n", exec.Check.ID(), err)
+
+			continue
+		}
+
+		for _, r := range remediations {
+			planned = append(planned, plannedRemediation{checkID: exec.Check.ID(), remediation: r})
+		}
+	}
+
+	return planned
+}
+
+// applyRemediation patches a single resource, optionally as a server-side dry run.
+func applyRemediation(ctx context.Context, writer client.Writer, r check.Remediation, dryRun bool) error {
+	opts := []client.PatchOption{
+		client.WithFieldOwner(fieldOwnerLint),
+	}
+
+	if r.Namespace != "" {
+		opts = append(opts, client.PatchInNamespace(r.Namespace))
+	}
+
+	if dryRun {
+		opts = append(opts, client.WithDryRun())
+	}
+
+	if _, err := writer.Patch(ctx, r.ResourceType, r.Name, r.PatchType, r.Patch, opts...); err != nil {
+		return fmt.Errorf("patching %s: %w", r.Reference(), err)
+	}
+
+	return nil
+}
+
+// runRemediations previews remediations for the given results using a server-side dry run,
+// asks for confirmation unless skipConfirm is set, and applies them.
+// All progress is written to stderr so structured stdout output remains parseable.
+func runRemediations(
+	ctx context.Context,
+	io iostreams.Interface,
+	writer client.Writer,
+	target check.Target,
+	results []check.CheckExecution,
+	skipConfirm bool,
+) error {
+	errOut := io.ErrOut()
+
+	planned := planRemediations(ctx, target, results, errOut)
+
+	_, _ = fmt.Fprintln(errOut)
+
+	if len(planned) == 0 {
+		_, _ = fmt.Fprintln(errOut, msgNoRemediations)
+
+		return nil
+	}
+
+	_, _ = fmt.Fprintf(errOut, msgRemediationPreview+"\n", len(planned))
+
+	for i := range planned {
+		p := &planned[i]
+		p.dryRunErr = applyRemediation(ctx, writer, p.remediation, true)
+
+		status := "ok"
+		if p.dryRunErr != nil {
+			status = fmt.Sprintf("dry-run failed: %v", p.dryRunErr)
+		}
+
+		_, _ = fmt.Fprintf(errOut, "  - [%s] %s: %s (%s)\n",
+			p.checkID, p.remediation.Reference(), p.remediation.Description, status)
+	}
+
+	_, _ = fmt.Fprintln(errOut)
+
+	if !skipConfirm && !confirmation.Prompt(io, msgRemediationPrompt) {
+		_, _ = fmt.Fprintln(errOut, msgRemediationCancelled)
+
+		return nil
+	}
+
+	applied := 0
+
+	var errs []error
+
+	for _, p := range planned {
+		if p.dryRunErr != nil {
+			errs = append(errs, p.dryRunErr)
+
+			continue
+		}
+
+		if err := applyRemediation(ctx, writer, p.remediation, false); err != nil {
+			_, _ = fmt.Fprintf(errOut, "  - %s: %v\n", p.remediation.Reference(), err)
+			errs = append(errs, err)
+
+			continue
+		}
+
+		applied++
+	}
+
+	_, _ = fmt.Fprintf(errOut, msgRemediationSummary+"\n", applied, len(planned))
+
+	if len(errs) > 0 {
+		return fmt.Errorf(msgRemediationFailed+": %w", len(errs), errors.Join(errs...))
+	}
+
+	return nil
+}
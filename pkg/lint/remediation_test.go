@@ -0,0 +1,176 @@
+//nolint:testpackage // internal test: exercises unexported runRemediations
+package lint
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+
+	"github.com/opendatahub-io/odh-cli/pkg/constants"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+	"github.com/opendatahub-io/odh-cli/pkg/util/iostreams"
+
+	. "github.com/onsi/gomega"
+)
+
+const (
+	testFixNamespace = "user-ns"
+	testFixNotebook  = "legacy-notebook"
+	testFixProfile   = "small"
+)
+
+func newFixNotebook() *unstructured.Unstructured {
+	return &unstructured.Unstructured{
+		Object: map[string]any{
+			"apiVersion": resources.Notebook.APIVersion(),
+			"kind":       resources.Notebook.Kind,
+			"metadata": map[string]any{
+				"name":      testFixNotebook,
+				"namespace": testFixNamespace,
+				"annotations": map[string]any{
+					constants.AnnotationLegacyHardwareProfile: testFixProfile,
+				},
+			},
+		},
+	}
+}
+
+func newFixClient(objs ...runtime.Object) client.Client {
+	scheme := runtime.NewScheme()
+	_ = metav1.AddMetaToScheme(scheme)
+
+	return client.NewForTesting(client.TestClientConfig{
+		Dynamic: dynamicfake.NewSimpleDynamicClientWithCustomListKinds(
+			scheme,
+			map[schema.GroupVersionResource]string{
+				resources.Notebook.GVR(): resources.Notebook.ListKind(),
+			},
+			objs...,
+		),
+	})
+}
+
+// fixTestCheck removes the legacy hardware profile annotation from the impacted Notebooks.
+type fixTestCheck struct {
+	snapshotTestCheck
+}
+
+func (c *fixTestCheck) Remediations(
+	_ context.Context,
+	_ check.Target,
+	dr *result.DiagnosticResult,
+) ([]check.Remediation, error) {
+	return check.RemoveAnnotationsFromImpacted(dr, resources.Notebook, constants.AnnotationLegacyHardwareProfile)
+}
+
+func newFixExecution(failing bool) check.CheckExecution {
+	chk := &fixTestCheck{snapshotTestCheck{BaseCheck: check.BaseCheck{
+		CheckGroup: check.GroupWorkload,
+		Kind:       "notebook",
+		Type:       check.CheckTypeConfigMigration,
+		CheckID:    "workloads.notebook.fix-test",
+	}}}
+	dr := chk.NewResult()
+
+	status := metav1.ConditionTrue
+	if failing {
+		status = metav1.ConditionFalse
+	}
+
+	dr.SetCondition(check.NewCondition(
+		check.ConditionTypeValidated,
+		status,
+		check.WithReason(check.ReasonMigrationPending),
+	))
+	dr.SetImpactedObjects(resources.Notebook, []types.NamespacedName{
+		{Namespace: testFixNamespace, Name: testFixNotebook},
+	})
+
+	return check.CheckExecution{Check: chk, Result: dr}
+}
+
+func TestRunRemediations(t *testing.T) {
+	t.Run("applies remediations when confirmation is skipped", func(t *testing.T) {
+		g := NewWithT(t)
+		ctx := t.Context()
+
+		var out, errOut bytes.Buffer
+		io := iostreams.NewIOStreams(&bytes.Buffer{}, &out, &errOut)
+		c := newFixClient(newFixNotebook())
+
+		err := runRemediations(ctx, io, c, check.Target{Client: c}, []check.CheckExecution{newFixExecution(true)}, true)
+
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(errOut.String()).To(ContainSubstring("Notebook user-ns/legacy-notebook"))
+		g.Expect(errOut.String()).To(ContainSubstring("Applied 1/1 remediation(s)."))
+		g.Expect(out.String()).To(BeEmpty())
+
+		nb, err := c.GetResource(ctx, resources.Notebook, testFixNotebook, client.InNamespace(testFixNamespace))
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(nb.GetAnnotations()).ToNot(HaveKey(constants.AnnotationLegacyHardwareProfile))
+	})
+
+	t.Run("does not apply when the prompt is declined", func(t *testing.T) {
+		g := NewWithT(t)
+		ctx := t.Context()
+
+		var out, errOut bytes.Buffer
+		io := iostreams.NewIOStreams(strings.NewReader("n\n"), &out, &errOut)
+		c := newFixClient(newFixNotebook())
+
+		err := runRemediations(ctx, io, c, check.Target{Client: c}, []check.CheckExecution{newFixExecution(true)}, false)
+
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(errOut.String()).To(ContainSubstring(msgRemediationCancelled))
+	})
+
+	t.Run("skips passing results", func(t *testing.T) {
+		g := NewWithT(t)
+		ctx := t.Context()
+
+		var out, errOut bytes.Buffer
+		io := iostreams.NewIOStreams(&bytes.Buffer{}, &out, &errOut)
+		c := newFixClient(newFixNotebook())
+
+		err := runRemediations(ctx, io, c, check.Target{Client: c}, []check.CheckExecution{newFixExecution(false)}, true)
+
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(errOut.String()).To(ContainSubstring(msgNoRemediations))
+	})
+
+	t.Run("reports failures for missing resources", func(t *testing.T) {
+		g := NewWithT(t)
+		ctx := t.Context()
+
+		var out, errOut bytes.Buffer
+		io := iostreams.NewIOStreams(&bytes.Buffer{}, &out, &errOut)
+		c := newFixClient()
+
+		err := runRemediations(ctx, io, c, check.Target{Client: c}, []check.CheckExecution{newFixExecution(true)}, true)
+
+		g.Expect(err).To(HaveOccurred())
+		g.Expect(errOut.String()).To(ContainSubstring("dry-run failed"))
+		g.Expect(errOut.String()).To(ContainSubstring("Applied 0/1 remediation(s)."))
+	})
+}
+
+func TestPlanRemediations_IgnoresChecksWithoutRemediator(t *testing.T) {
+	g := NewWithT(t)
+
+	exec := buildExecution(result.ImpactAdvisory)
+
+	planned := planRemediations(t.Context(), check.Target{}, []check.CheckExecution{exec}, &bytes.Buffer{})
+
+	g.Expect(planned).To(BeEmpty())
+}
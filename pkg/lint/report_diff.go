@@ -0,0 +1,230 @@
+package lint
+
+import (
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/output"
+	printerjson "github.com/opendatahub-io/odh-cli/pkg/printer/json"
+	printeryaml "github.com/opendatahub-io/odh-cli/pkg/printer/yaml"
+)
+
+const reportComparisonKind = "LintReportComparison"
+
+// ReportComparison is the structured output of `lint diff` and `lint history <from> <to>`.
+type ReportComparison struct {
+	output.Envelope
+
+	From ReportSummary `json:"from" yaml:"from"`
+	To   ReportSummary `json:"to"   yaml:"to"`
+
+	// Changes lists the checks that started or stopped failing, or whose findings changed,
+	// sorted by check.
+	Changes []WatchChange `json:"changes" yaml:"changes"`
+
+	// ObjectDeltas lists, per check, the impacted objects that appeared or disappeared.
+	ObjectDeltas []ImpactedObjectDelta `json:"objectDeltas,omitempty" yaml:"objectDeltas,omitempty"`
+}
+
+// ImpactedObjectDelta lists the impacted objects of a check that appeared or disappeared
+// between two reports. Objects of passing results are not counted as impacted.
+type ImpactedObjectDelta struct {
+	CheckID string `json:"checkId" yaml:"checkId"`
+
+	// Added and Removed are sorted object references ("Kind namespace/name").
+	Added   []string `json:"added,omitempty"   yaml:"added,omitempty"`
+	Removed []string `json:"removed,omitempty" yaml:"removed,omitempty"`
+}
+
+// NewReportFileSummary summarizes a report read from a file.
+func NewReportFileSummary(name string, list *result.DiagnosticResultList) ReportSummary {
+	summary := ReportSummary{
+		Name:        name,
+		GeneratedAt: list.Metadata.GeneratedAt,
+		CLIVersion:  list.Metadata.CLIVersion,
+	}
+
+	if list.ClusterVersion != nil {
+		summary.ClusterVersion = *list.ClusterVersion
+	}
+
+	if list.TargetVersion != nil {
+		summary.TargetVersion = *list.TargetVersion
+	}
+
+	if list.OpenShiftVersion != nil {
+		summary.OpenShiftVersion = *list.OpenShiftVersion
+	}
+
+	if list.Status != nil {
+		summary.Result = list.Status.Result
+		summary.Errors = list.Status.Errors
+		summary.Warnings = list.Status.Warnings
+	}
+
+	return summary
+}
+
+// CompareReports compares two lint reports. Reports do not record check IDs, so checks are
+// identified by the group, kind and name of their results, and findings are matched by their
+// baseline fingerprint.
+func CompareReports(command string, from, to ReportSummary, fromList, toList *result.DiagnosticResultList) *ReportComparison {
+	comparison := &ReportComparison{
+		Envelope: output.NewEnvelope(reportComparisonKind, command),
+		From:     from,
+		To:       to,
+		Changes:  diffWatchSnapshots(newReportSnapshot(fromList), newReportSnapshot(toList)),
+	}
+
+	if comparison.Changes == nil {
+		comparison.Changes = []WatchChange{}
+	}
+
+	fromObjects, toObjects := reportImpactedObjects(fromList), reportImpactedObjects(toList)
+
+	for _, change := range comparison.Changes {
+		before, after := fromObjects[change.CheckID], toObjects[change.CheckID]
+		delta := ImpactedObjectDelta{CheckID: change.CheckID}
+
+		for ref := range after {
+			if _, ok := before[ref]; !ok {
+				delta.Added = append(delta.Added, ref)
+			}
+		}
+
+		for ref := range before {
+			if _, ok := after[ref]; !ok {
+				delta.Removed = append(delta.Removed, ref)
+			}
+		}
+
+		if len(delta.Added) == 0 && len(delta.Removed) == 0 {
+			continue
+		}
+
+		slices.Sort(delta.Added)
+		slices.Sort(delta.Removed)
+		comparison.ObjectDeltas = append(comparison.ObjectDeltas, delta)
+	}
+
+	return comparison
+}
+
+// reportCheckKey identifies the check of a recorded result.
+func reportCheckKey(r *result.DiagnosticResult) string {
+	return strings.Join([]string{r.Group, r.Kind, r.Name}, "/")
+}
+
+// newReportSnapshot records the state of the checks of a report, keyed by reportCheckKey, so
+// two reports can be compared like two watch runs.
+func newReportSnapshot(list *result.DiagnosticResultList) watchSnapshot {
+	snapshot := make(watchSnapshot, len(list.Results))
+
+	for _, r := range list.Results {
+		if r == nil {
+			continue
+		}
+
+		key := reportCheckKey(r)
+		state := watchCheckState{
+			impact:   r.GetImpact(),
+			findings: make(map[string]struct{}),
+		}
+
+		for _, f := range r.Findings(key) {
+			state.findings[f.Fingerprint] = struct{}{}
+		}
+
+		if state.impact != result.ImpactNone {
+			state.objects = len(r.ImpactedObjects)
+		}
+
+		snapshot[key] = state
+	}
+
+	return snapshot
+}
+
+// reportImpactedObjects returns the references of the impacted objects of each failing check.
+func reportImpactedObjects(list *result.DiagnosticResultList) map[string]map[string]struct{} {
+	objects := make(map[string]map[string]struct{})
+
+	for _, r := range list.Results {
+		if r == nil || r.GetImpact() == result.ImpactNone {
+			continue
+		}
+
+		refs := make(map[string]struct{}, len(r.ImpactedObjects))
+		for _, obj := range r.ImpactedObjects {
+			ref := obj.Kind + " " + obj.Name
+			if obj.Namespace != "" {
+				ref = obj.Kind + " " + obj.Namespace + "/" + obj.Name
+			}
+
+			refs[ref] = struct{}{}
+		}
+
+		objects[reportCheckKey(r)] = refs
+	}
+
+	return objects
+}
+
+// writeReportComparison writes the changes between two reports as one line per check, followed
+// by the impacted objects that appeared (+) or disappeared (-).
+func writeReportComparison(w io.Writer, comparison *ReportComparison) error {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "Comparing %s (%s) with %s (%s): ",
+		comparison.From.Name, comparison.From.GeneratedAt, comparison.To.Name, comparison.To.GeneratedAt)
+
+	if len(comparison.Changes) == 0 {
+		b.WriteString("no changes\n")
+	} else {
+		fmt.Fprintf(&b, "%d change(s)\n", len(comparison.Changes))
+	}
+
+	for _, change := range comparison.Changes {
+		writeChangeLines(&b, []WatchChange{change})
+
+		for _, delta := range comparison.ObjectDeltas {
+			if delta.CheckID != change.CheckID {
+				continue
+			}
+
+			for _, ref := range delta.Added {
+				fmt.Fprintf(&b, "      + %s\n", ref)
+			}
+
+			for _, ref := range delta.Removed {
+				fmt.Fprintf(&b, "      - %s\n", ref)
+			}
+		}
+	}
+
+	if _, err := io.WriteString(w, b.String()); err != nil {
+		return fmt.Errorf("writing comparison: %w", err)
+	}
+
+	return nil
+}
+
+// renderStructured renders report history and comparison output as JSON or YAML.
+func renderStructured[T any](out io.Writer, format OutputFormat, v T) error {
+	if format == OutputFormatJSON {
+		if err := printerjson.NewRenderer[T](printerjson.WithWriter[T](out)).Render(v); err != nil {
+			return fmt.Errorf("rendering JSON output: %w", err)
+		}
+
+		return nil
+	}
+
+	if err := printeryaml.NewRenderer[T](printeryaml.WithWriter[T](out)).Render(v); err != nil {
+		return fmt.Errorf("rendering YAML output: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,176 @@
+package lint
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+)
+
+// SplitBy selects how --output-dir splits the results into one report per recipient.
+type SplitBy string
+
+const (
+	// SplitByNone disables split reports.
+	SplitByNone SplitBy = ""
+
+	// SplitByRequester writes one report per namespace requester (openshift.io/requester).
+	SplitByRequester SplitBy = "requester"
+
+	// SplitByOwner writes one report per owner of the impacted objects, as resolved by the
+	// owner resolvers (see check.DefaultOwnerResolvers).
+	SplitByOwner SplitBy = "owner"
+
+	// UnassignedRequester is the report name used for objects without a requester or owner.
+	UnassignedRequester = "unassigned"
+
+	splitDirPermission  = 0o755
+	splitFilePermission = 0o644
+
+	msgSplitWritten = "Wrote %d report(s) to %s"
+)
+
+//nolint:gochecknoglobals // read-only lookup tables
+var (
+	// splitFileExtensions maps output formats to the extension of split report files.
+	splitFileExtensions = map[OutputFormat]string{
+		OutputFormatTable:    ".txt",
+		OutputFormatWide:     ".txt",
+		OutputFormatJSON:     ".json",
+		OutputFormatNDJSON:   ".ndjson",
+		OutputFormatYAML:     ".yaml",
+		OutputFormatJUnit:    ".xml",
+		OutputFormatHTML:     ".html",
+		OutputFormatMarkdown: ".md",
+	}
+
+	// unsafeFileNameChars matches characters not kept in report file names.
+	unsafeFileNameChars = regexp.MustCompile(`[^A-Za-z0-9@._-]+`)
+)
+
+// Validate checks if the split-by value is valid.
+func (s SplitBy) Validate() error {
+	switch s {
+	case SplitByNone, SplitByRequester, SplitByOwner:
+		return nil
+	default:
+		return fmt.Errorf("invalid split-by: %s (must be one of: requester, owner)", s)
+	}
+}
+
+// SplitByNamespaceRequester splits the results into one set per namespace requester, keyed by
+// the requester (UnassignedRequester for namespaces without one). Each set holds the failing
+// checks impacting objects in the requester's namespaces, restricted to those objects, so a
+// team only receives what it can act on. Passing checks and cluster-scoped objects are left out.
+func SplitByNamespaceRequester(
+	results []check.CheckExecution,
+	requesters map[string]string,
+) map[string][]check.CheckExecution {
+	return splitByRecipient(results, func(obj metav1.PartialObjectMetadata) (string, bool) {
+		return requesters[obj.Namespace], obj.Namespace != ""
+	})
+}
+
+// SplitByObjectOwner splits the results into one set per owner of the impacted objects, as
+// recorded in their result.AnnotationObjectOwner annotation by check.ResolveOwners, keyed by
+// the owner (UnassignedRequester for objects without one). Unlike SplitByNamespaceRequester,
+// cluster-scoped objects are kept, since resolvers may find an owner for them too.
+func SplitByObjectOwner(results []check.CheckExecution) map[string][]check.CheckExecution {
+	return splitByRecipient(results, func(obj metav1.PartialObjectMetadata) (string, bool) {
+		return obj.Annotations[result.AnnotationObjectOwner], true
+	})
+}
+
+// splitByRecipient splits the failing checks by the recipient of each impacted object, as
+// returned by recipientOf. Objects recipientOf does not keep are left out; those without a
+// recipient go to UnassignedRequester.
+func splitByRecipient(
+	results []check.CheckExecution,
+	recipientOf func(obj metav1.PartialObjectMetadata) (string, bool),
+) map[string][]check.CheckExecution {
+	split := make(map[string][]check.CheckExecution)
+
+	for _, exec := range results {
+		if exec.Result == nil || exec.Result.GetImpact() == result.ImpactNone {
+			continue
+		}
+
+		byRecipient := make(map[string][]metav1.PartialObjectMetadata)
+		for _, obj := range exec.Result.ImpactedObjects {
+			recipient, keep := recipientOf(obj)
+			if !keep {
+				continue
+			}
+
+			if recipient == "" {
+				recipient = UnassignedRequester
+			}
+
+			byRecipient[recipient] = append(byRecipient[recipient], obj)
+		}
+
+		for recipient, objects := range byRecipient {
+			dr := *exec.Result
+			dr.Annotations = maps.Clone(exec.Result.Annotations)
+			dr.ImpactedObjects = objects
+
+			if _, ok := dr.Annotations[check.AnnotationImpactedWorkloadCount]; ok {
+				dr.Annotations[check.AnnotationImpactedWorkloadCount] = strconv.Itoa(len(objects))
+			}
+
+			split[recipient] = append(split[recipient], check.CheckExecution{Check: exec.Check, Result: &dr})
+		}
+	}
+
+	return split
+}
+
+// reportFileName returns the file name of the split report of a recipient.
+func reportFileName(recipient string, format OutputFormat) string {
+	return unsafeFileNameChars.ReplaceAllString(recipient, "_") + splitFileExtensions[format]
+}
+
+// writeSplitReports writes one report per namespace requester or object owner to c.OutputDir,
+// in the selected output format.
+func (c *Command) writeSplitReports(ctx context.Context, results []check.CheckExecution) error {
+	var split map[string][]check.CheckExecution
+
+	switch c.SplitBy {
+	case SplitByRequester:
+		split = SplitByNamespaceRequester(results, collectNamespaceRequesters(ctx, c.Reader, results))
+	case SplitByOwner:
+		split = SplitByObjectOwner(results)
+	case SplitByNone:
+		return nil
+	}
+
+	if err := os.MkdirAll(c.OutputDir, splitDirPermission); err != nil {
+		return fmt.Errorf("creating output directory: %w", err)
+	}
+
+	for _, requester := range slices.Sorted(maps.Keys(split)) {
+		var buf bytes.Buffer
+		if err := c.writeUpgradeResults(ctx, &buf, split[requester]); err != nil {
+			return fmt.Errorf("rendering report for %s: %w", requester, err)
+		}
+
+		path := filepath.Join(c.OutputDir, reportFileName(requester, c.OutputFormat))
+		if err := os.WriteFile(path, buf.Bytes(), splitFilePermission); err != nil {
+			return fmt.Errorf("writing report for %s: %w", requester, err)
+		}
+	}
+
+	_, _ = fmt.Fprintf(c.IO.ErrOut(), msgSplitWritten+"\n", len(split), c.OutputDir)
+
+	return nil
+}
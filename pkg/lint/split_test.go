@@ -0,0 +1,144 @@
+package lint_test
+
+import (
+	"bytes"
+	"testing"
+
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestSplitByNamespaceRequester(t *testing.T) {
+	g := NewWithT(t)
+
+	results := newGroupByResults()
+	results[0].Result.Annotations[check.AnnotationImpactedWorkloadCount] = "2"
+
+	split := lint.SplitByNamespaceRequester(results, map[string]string{"team-a": "alice@example.com"})
+
+	g.Expect(split).To(HaveLen(2))
+	g.Expect(split).To(HaveKey("alice@example.com"))
+	g.Expect(split).To(HaveKey(lint.UnassignedRequester))
+
+	alice := split["alice@example.com"]
+	g.Expect(alice).To(HaveLen(2))
+	g.Expect(alice[0].Check.ID()).To(Equal("workloads.notebook.stopped"))
+	g.Expect(alice[0].Result.ImpactedObjects).To(HaveLen(1))
+	g.Expect(alice[0].Result.ImpactedObjects[0].Name).To(Equal("nb-1"))
+	g.Expect(alice[0].Result.Annotations).To(HaveKeyWithValue(check.AnnotationImpactedWorkloadCount, "1"))
+
+	// Cluster-scoped objects are not forwarded to namespace owners
+	g.Expect(alice[1].Result.ImpactedObjects).To(HaveLen(2))
+	g.Expect(alice[1].Result.ImpactedObjects[0].Namespace).To(Equal("team-a"))
+
+	unassigned := split[lint.UnassignedRequester]
+	g.Expect(unassigned).To(HaveLen(1))
+	g.Expect(unassigned[0].Result.ImpactedObjects[0].Name).To(Equal("nb-2"))
+
+	// The original results are left untouched
+	g.Expect(results[0].Result.ImpactedObjects).To(HaveLen(2))
+	g.Expect(results[0].Result.Annotations).To(HaveKeyWithValue(check.AnnotationImpactedWorkloadCount, "2"))
+}
+
+func TestSplitByObjectOwner(t *testing.T) {
+	g := NewWithT(t)
+
+	results := newGroupByResults()
+	results[0].Result.ImpactedObjects[0].Annotations = map[string]string{result.AnnotationObjectOwner: "alice"}
+	results[1].Result.ImpactedObjects[2].Annotations = map[string]string{result.AnnotationObjectOwner: "platform"}
+
+	split := lint.SplitByObjectOwner(results)
+
+	g.Expect(split).To(HaveLen(3))
+
+	alice := split["alice"]
+	g.Expect(alice).To(HaveLen(1))
+	g.Expect(alice[0].Result.ImpactedObjects).To(HaveLen(1))
+	g.Expect(alice[0].Result.ImpactedObjects[0].Name).To(Equal("nb-1"))
+
+	// Cluster-scoped objects are routed to their owner too
+	platform := split["platform"]
+	g.Expect(platform).To(HaveLen(1))
+	g.Expect(platform[0].Result.ImpactedObjects[0].Name).To(Equal("cluster-scoped"))
+
+	unassigned := split[lint.UnassignedRequester]
+	g.Expect(unassigned).To(HaveLen(2))
+	g.Expect(unassigned[0].Result.ImpactedObjects[0].Name).To(Equal("nb-2"))
+	g.Expect(unassigned[1].Result.ImpactedObjects).To(HaveLen(2))
+}
+
+func TestCommand_SplitByValidation(t *testing.T) {
+	newCommand := func() *lint.Command {
+		command := lint.NewCommand(genericiooptions.IOStreams{
+			In: &bytes.Buffer{}, Out: &bytes.Buffer{}, ErrOut: &bytes.Buffer{},
+		}, testConfigFlags())
+		command.TargetVersion = "3.0.0"
+
+		return command
+	}
+
+	t.Run("should accept --split-by with --output-dir", func(t *testing.T) {
+		g := NewWithT(t)
+
+		command := newCommand()
+		command.SplitBy = lint.SplitByRequester
+		command.OutputDir = t.TempDir()
+
+		g.Expect(command.Validate()).To(Succeed())
+	})
+
+	t.Run("should require --output-dir", func(t *testing.T) {
+		g := NewWithT(t)
+
+		command := newCommand()
+		command.SplitBy = lint.SplitByRequester
+
+		g.Expect(command.Validate()).To(MatchError(ContainSubstring("must be used together")))
+	})
+
+	t.Run("should resolve owners when splitting by owner", func(t *testing.T) {
+		g := NewWithT(t)
+
+		command := newCommand()
+		command.SplitBy = lint.SplitByOwner
+		command.OutputDir = t.TempDir()
+
+		g.Expect(command.Validate()).To(Succeed())
+		g.Expect(command.ResolveOwners).To(BeTrue())
+	})
+
+	t.Run("should reject --resolve-owners with ndjson output", func(t *testing.T) {
+		g := NewWithT(t)
+
+		command := newCommand()
+		command.ResolveOwners = true
+		command.OutputFormat = lint.OutputFormatNDJSON
+
+		g.Expect(command.Validate()).To(MatchError(ContainSubstring("--resolve-owners")))
+	})
+
+	t.Run("should reject --detect-usage with ndjson output", func(t *testing.T) {
+		g := NewWithT(t)
+
+		command := newCommand()
+		command.DetectUsage = true
+		command.OutputFormat = lint.OutputFormatNDJSON
+
+		g.Expect(command.Validate()).To(MatchError(ContainSubstring("--detect-usage")))
+	})
+
+	t.Run("should reject unknown split keys", func(t *testing.T) {
+		g := NewWithT(t)
+
+		command := newCommand()
+		command.SplitBy = "team"
+		command.OutputDir = t.TempDir()
+
+		g.Expect(command.Validate()).To(MatchError(ContainSubstring("invalid split-by")))
+	})
+}
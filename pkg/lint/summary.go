@@ -0,0 +1,143 @@
+package lint
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/messages"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+)
+
+const (
+	groupSummaryKind = "summary"
+	groupSummaryName = "group-summary"
+)
+
+//nolint:gochecknoglobals // Message catalog entry
+var msgGroupSummary = messages.New("summary.group", "%d check(s): %d passed, %d advisory, %d blocking, %d prohibited")
+
+// groupTally counts the checks of a group by the highest impact they reported.
+type groupTally struct {
+	passed, advisory, blocking, prohibited int
+}
+
+func (t *groupTally) add(impact result.Impact) {
+	switch impact {
+	case result.ImpactProhibited:
+		t.prohibited++
+	case result.ImpactBlocking:
+		t.blocking++
+	case result.ImpactAdvisory:
+		t.advisory++
+	case result.ImpactNone:
+		t.passed++
+	}
+}
+
+func (t *groupTally) total() int {
+	return t.passed + t.advisory + t.blocking + t.prohibited
+}
+
+func (t *groupTally) impact() result.Impact {
+	switch {
+	case t.prohibited > 0:
+		return result.ImpactProhibited
+	case t.blocking > 0:
+		return result.ImpactBlocking
+	case t.advisory > 0:
+		return result.ImpactAdvisory
+	default:
+		return result.ImpactNone
+	}
+}
+
+// SummarizeByGroup synthesizes a result per check group present in results, in canonical group
+// order, rolling up how many checks passed or reported advisory, blocking or prohibited findings.
+// Its single condition passes when every check of the group passed and otherwise carries the
+// highest impact reported in the group, formatted in the language of p. The counts are also
+// recorded as AnnotationSummary* annotations.
+func SummarizeByGroup(results []check.CheckExecution, p messages.Printer) []*result.DiagnosticResult {
+	tallies := make(map[string]*groupTally)
+	groups := make([]string, 0, len(check.CanonicalGroupOrder))
+
+	for _, exec := range results {
+		// Checks that do not apply or were skipped were not run and are not counted as passed
+		if exec.Result == nil || check.IsNotApplicable(exec.Result) || check.IsSkipped(exec.Result) {
+			continue
+		}
+
+		tally, ok := tallies[exec.Result.Group]
+		if !ok {
+			tally = &groupTally{}
+			tallies[exec.Result.Group] = tally
+			groups = append(groups, exec.Result.Group)
+		}
+
+		tally.add(exec.Result.GetImpact())
+	}
+
+	sortGroups(groups)
+
+	summaries := make([]*result.DiagnosticResult, 0, len(groups))
+	for _, group := range groups {
+		summaries = append(summaries, newGroupSummary(group, tallies[group], p))
+	}
+
+	return summaries
+}
+
+func newGroupSummary(group string, tally *groupTally, p messages.Printer) *result.DiagnosticResult {
+	dr := result.New(group, groupSummaryKind, groupSummaryName,
+		fmt.Sprintf("Summary of the %s checks", group))
+
+	dr.Annotations[result.AnnotationSummaryPassed] = strconv.Itoa(tally.passed)
+	dr.Annotations[result.AnnotationSummaryAdvisory] = strconv.Itoa(tally.advisory)
+	dr.Annotations[result.AnnotationSummaryBlocking] = strconv.Itoa(tally.blocking)
+	dr.Annotations[result.AnnotationSummaryProhibited] = strconv.Itoa(tally.prohibited)
+
+	msg := check.WithCatalogMessage(msgGroupSummary,
+		tally.total(), tally.passed, tally.advisory, tally.blocking, tally.prohibited)
+
+	impact := tally.impact()
+	if impact == result.ImpactNone {
+		dr.SetCondition(check.NewCondition(
+			check.ConditionTypeValidated,
+			metav1.ConditionTrue,
+			check.WithReason(check.ReasonRequirementsMet),
+			msg,
+		))
+	} else {
+		dr.SetCondition(check.NewCondition(
+			check.ConditionTypeValidated,
+			metav1.ConditionFalse,
+			check.WithReason(check.ReasonFindingsReported),
+			msg,
+			check.WithImpact(impact),
+		))
+	}
+
+	check.Localize(dr, p)
+
+	return dr
+}
+
+// sortGroups sorts group names in canonical group order.
+func sortGroups(groups []string) {
+	slices.SortStableFunc(groups, func(a, b string) int {
+		return groupSortPriority(a) - groupSortPriority(b)
+	})
+}
+
+// groupTitle capitalizes a group name for use as a section title.
+func groupTitle(group string) string {
+	if group == "" {
+		return group
+	}
+
+	return strings.ToUpper(group[:1]) + group[1:]
+}
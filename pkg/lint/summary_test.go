@@ -0,0 +1,121 @@
+package lint_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/messages"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+
+	. "github.com/onsi/gomega"
+)
+
+func newSummaryResults() []check.CheckExecution {
+	return []check.CheckExecution{
+		newReportExecution("workload", "wl-advisory", result.ImpactAdvisory),
+		newReportExecution("dependency", "dep-passing", result.ImpactNone),
+		newReportExecution("workload", "wl-blocking", result.ImpactBlocking),
+		newReportExecution("workload", "wl-passing", result.ImpactNone),
+		newReportExecution("dependency", "dep-passing-2", result.ImpactNone),
+		{Result: nil},
+	}
+}
+
+func TestSummarizeByGroup(t *testing.T) {
+	g := NewWithT(t)
+
+	summaries := lint.SummarizeByGroup(newSummaryResults(), messages.Printer{})
+
+	g.Expect(summaries).To(HaveLen(2))
+
+	dependencies := summaries[0]
+	g.Expect(dependencies.Group).To(Equal("dependency"))
+	g.Expect(dependencies.Kind).To(Equal("summary"))
+	g.Expect(dependencies.Validate()).To(Succeed())
+	g.Expect(dependencies.Status.Conditions).To(HaveLen(1))
+	g.Expect(dependencies.Status.Conditions[0].Status).To(Equal(metav1.ConditionTrue))
+	g.Expect(dependencies.Status.Conditions[0].Message).To(Equal("2 check(s): 2 passed, 0 advisory, 0 blocking, 0 prohibited"))
+
+	workloads := summaries[1]
+	g.Expect(workloads.Group).To(Equal("workload"))
+	g.Expect(workloads.Validate()).To(Succeed())
+	g.Expect(workloads.Status.Conditions[0].Status).To(Equal(metav1.ConditionFalse))
+	g.Expect(workloads.Status.Conditions[0].Reason).To(Equal(check.ReasonFindingsReported))
+	g.Expect(workloads.Status.Conditions[0].Impact).To(Equal(result.ImpactBlocking))
+	g.Expect(workloads.Status.Conditions[0].Message).To(Equal("3 check(s): 1 passed, 1 advisory, 1 blocking, 0 prohibited"))
+	g.Expect(workloads.Annotations).To(And(
+		HaveKeyWithValue(result.AnnotationSummaryPassed, "1"),
+		HaveKeyWithValue(result.AnnotationSummaryAdvisory, "1"),
+		HaveKeyWithValue(result.AnnotationSummaryBlocking, "1"),
+		HaveKeyWithValue(result.AnnotationSummaryProhibited, "0"),
+	))
+}
+
+func TestSummarizeByGroup_NotApplicable(t *testing.T) {
+	g := NewWithT(t)
+
+	notApplicable := newReportExecution("workload", "wl-not-applicable", result.ImpactNone)
+	notApplicable.Result.Status.Conditions = []result.Condition{
+		check.NewCondition(check.ConditionTypeValidated, metav1.ConditionTrue, check.WithReason(check.ReasonNotApplicable)),
+	}
+
+	summaries := lint.SummarizeByGroup(append(newSummaryResults(), notApplicable), messages.Printer{})
+
+	g.Expect(summaries).To(HaveLen(2))
+	g.Expect(summaries[1].Status.Conditions[0].Message).To(Equal("3 check(s): 1 passed, 1 advisory, 1 blocking, 0 prohibited"))
+}
+
+func TestSummarizeByGroup_Skipped(t *testing.T) {
+	g := NewWithT(t)
+
+	skipped := newReportExecution("workload", "wl-skipped", result.ImpactNone)
+	skipped.Result.Status.Conditions = []result.Condition{
+		check.NewCondition(check.ConditionTypeValidated, metav1.ConditionUnknown, check.WithReason(check.ReasonPrerequisiteFailed)),
+	}
+	// Skipped checks carry no impact (see check.Executor)
+	skipped.Result.Status.Conditions[0].Impact = result.ImpactNone
+
+	summaries := lint.SummarizeByGroup(append(newSummaryResults(), skipped), messages.Printer{})
+
+	g.Expect(summaries).To(HaveLen(2))
+	g.Expect(summaries[1].Status.Conditions[0].Message).To(Equal("3 check(s): 1 passed, 1 advisory, 1 blocking, 0 prohibited"))
+}
+
+func TestOutputJSON_GroupSummaries(t *testing.T) {
+	g := NewWithT(t)
+
+	var buf bytes.Buffer
+	g.Expect(lint.OutputJSON(&buf, newSummaryResults(), messages.Printer{}, nil, nil, nil, nil, nil)).To(Succeed())
+
+	var list result.DiagnosticResultList
+	g.Expect(json.Unmarshal(buf.Bytes(), &list)).To(Succeed())
+	g.Expect(list.Results).To(HaveLen(5))
+	g.Expect(list.Summaries).To(HaveLen(2))
+	g.Expect(list.Summaries[1].Group).To(Equal("workload"))
+	g.Expect(list.Summaries[1].Status.Conditions[0].Impact).To(Equal(result.ImpactBlocking))
+}
+
+func TestOutputTable_GroupSectionHeaders(t *testing.T) {
+	g := NewWithT(t)
+
+	var buf bytes.Buffer
+	g.Expect(lint.OutputTable(&buf, newSummaryResults(), lint.TableOutputOptions{})).To(Succeed())
+
+	output := buf.String()
+
+	dependencyHeader := strings.Index(output, "Dependency: 2 check(s): 2 passed")
+	workloadHeader := strings.Index(output, "Workload: 3 check(s): 1 passed, 1 advisory, 1 blocking")
+
+	g.Expect(dependencyHeader).To(BeNumerically(">=", 0))
+	g.Expect(workloadHeader).To(BeNumerically(">", dependencyHeader))
+	g.Expect(strings.Index(output, "dep-passing finding")).To(BeNumerically(">", dependencyHeader))
+	g.Expect(strings.Index(output, "dep-passing finding")).To(BeNumerically("<", workloadHeader))
+	g.Expect(strings.Index(output, "wl-blocking finding")).To(BeNumerically(">", workloadHeader))
+	g.Expect(output).To(ContainSubstring("Total: 5 | Passed: 3 | Warnings: 1 | Failed: 1 | Prohibited: 0"))
+}
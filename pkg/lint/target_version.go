@@ -0,0 +1,88 @@
+package lint
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/blang/semver/v4"
+
+	"github.com/opendatahub-io/odh-cli/pkg/util/kube/olm"
+)
+
+// Target version aliases resolved against the versions offered by the operator catalog.
+const (
+	// TargetVersionLatest resolves to the newest version offered by the catalog.
+	TargetVersionLatest = "latest"
+
+	// TargetVersionNext resolves to the newest patch of the first minor release after the
+	// current version.
+	TargetVersionNext = "next"
+)
+
+// isTargetVersionAlias reports whether v is a target version alias rather than a version.
+func isTargetVersionAlias(v string) bool {
+	return v == TargetVersionLatest || v == TargetVersionNext
+}
+
+// ResolveTargetVersion resolves a target version alias against the catalog versions, which
+// must be sorted from oldest to newest as returned by olm.ListOperatorCatalogVersions.
+func ResolveTargetVersion(alias string, current semver.Version, versions []olm.CatalogVersion) (semver.Version, error) {
+	if len(versions) == 0 {
+		return semver.Version{}, errors.New("no operator versions found in the catalog")
+	}
+
+	switch alias {
+	case TargetVersionLatest:
+		return versions[len(versions)-1].Version, nil
+	case TargetVersionNext:
+		var next *semver.Version
+
+		for i := range versions {
+			v := versions[i].Version
+			if v.Major < current.Major || (v.Major == current.Major && v.Minor <= current.Minor) {
+				continue
+			}
+
+			// Keep the newest patch of the first minor release after current
+			if next == nil || (v.Major == next.Major && v.Minor == next.Minor) {
+				next = &v
+			}
+		}
+
+		if next == nil {
+			return semver.Version{}, fmt.Errorf("no version newer than %d.%d found in the catalog",
+				current.Major, current.Minor)
+		}
+
+		return *next, nil
+	default:
+		return semver.Version{}, fmt.Errorf("unknown target version alias %q (must be one of: %s, %s)",
+			alias, TargetVersionLatest, TargetVersionNext)
+	}
+}
+
+// resolveTargetAlias resolves a --target-version alias once the current version is known.
+// Later calls, e.g. from watch iterations, keep the first resolution.
+func (c *Command) resolveTargetAlias(ctx context.Context, currentVersion *semver.Version) error {
+	if c.targetAlias == "" || c.parsedTargetVersion != nil {
+		return nil
+	}
+
+	versions, err := olm.ListOperatorCatalogVersions(ctx, c.Reader)
+	if err != nil {
+		return fmt.Errorf("listing operator catalog versions: %w", err)
+	}
+
+	resolved, err := ResolveTargetVersion(c.targetAlias, *currentVersion, versions)
+	if err != nil {
+		return fmt.Errorf("resolving --target-version %s: %w", c.targetAlias, err)
+	}
+
+	c.TargetVersion = resolved.String()
+	c.parsedTargetVersion = &resolved
+
+	c.IO.Errorf("Resolved --target-version %s to %s from the operator catalog", c.targetAlias, c.TargetVersion)
+
+	return nil
+}
@@ -0,0 +1,119 @@
+package lint_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/blang/semver/v4"
+
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint"
+	"github.com/opendatahub-io/odh-cli/pkg/util/kube/olm"
+
+	. "github.com/onsi/gomega"
+)
+
+const fixtureSnapshotPackageManifest = `
+apiVersion: packages.operators.coreos.com/v1
+kind: PackageManifest
+metadata:
+  name: rhods-operator
+  namespace: openshift-marketplace
+status:
+  catalogSource: redhat-operators
+  channels:
+    - name: stable-2.25
+      currentCSV: rhods-operator.2.25.2
+      entries:
+        - name: rhods-operator.2.25.2
+        - name: rhods-operator.2.25.0
+    - name: fast-3.x
+      currentCSV: rhods-operator.3.1.0
+      entries:
+        - name: rhods-operator.3.1.0
+        - name: rhods-operator.3.0.1
+        - name: rhods-operator.3.0.0
+`
+
+func catalogVersions(versions ...string) []olm.CatalogVersion {
+	result := make([]olm.CatalogVersion, 0, len(versions))
+	for _, v := range versions {
+		result = append(result, olm.CatalogVersion{Version: semver.MustParse(v)})
+	}
+
+	return result
+}
+
+func TestResolveTargetVersion(t *testing.T) {
+	versions := catalogVersions("2.25.0", "2.25.2", "3.0.0", "3.0.1", "3.1.0")
+
+	tests := []struct {
+		name     string
+		alias    string
+		current  string
+		expected string
+		err      string
+	}{
+		{name: "latest is the newest version", alias: lint.TargetVersionLatest, current: "2.25.0", expected: "3.1.0"},
+		{name: "next is the newest patch of the next minor", alias: lint.TargetVersionNext, current: "2.25.2", expected: "3.0.1"},
+		{name: "next skips patches of the current minor", alias: lint.TargetVersionNext, current: "3.0.0", expected: "3.1.0"},
+		{name: "next fails on the newest minor", alias: lint.TargetVersionNext, current: "3.1.0", err: "no version newer than 3.1"},
+		{name: "unknown alias", alias: "previous", current: "3.0.0", err: "unknown target version alias"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			resolved, err := lint.ResolveTargetVersion(tt.alias, semver.MustParse(tt.current), versions)
+			if tt.err != "" {
+				g.Expect(err).To(MatchError(ContainSubstring(tt.err)))
+
+				return
+			}
+
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(resolved.String()).To(Equal(tt.expected))
+		})
+	}
+
+	t.Run("should fail without catalog versions", func(t *testing.T) {
+		g := NewWithT(t)
+
+		_, err := lint.ResolveTargetVersion(lint.TargetVersionLatest, semver.MustParse("2.25.0"), nil)
+		g.Expect(err).To(MatchError(ContainSubstring("no operator versions found")))
+	})
+}
+
+func TestCommand_TargetVersionAlias(t *testing.T) {
+	g := NewWithT(t)
+
+	dir := t.TempDir()
+	g.Expect(os.WriteFile(filepath.Join(dir, "dsc.yaml"), []byte(fixtureSnapshotDSC), 0o600)).To(Succeed())
+	g.Expect(os.WriteFile(filepath.Join(dir, "dsci.yaml"), []byte(fixtureSnapshotDSCI), 0o600)).To(Succeed())
+	g.Expect(os.WriteFile(filepath.Join(dir, "packagemanifest.yaml"), []byte(fixtureSnapshotPackageManifest), 0o600)).To(Succeed())
+
+	var out, errOut bytes.Buffer
+	streams := genericiooptions.IOStreams{In: &bytes.Buffer{}, Out: &out, ErrOut: &errOut}
+
+	command := lint.NewCommand(streams, testConfigFlags())
+	command.FromDir = dir
+	command.TargetVersion = lint.TargetVersionNext
+	command.OutputFormat = lint.OutputFormatJSON
+	command.Verbose = true
+
+	g.Expect(command.Complete()).To(Succeed())
+	g.Expect(command.Validate()).To(Succeed())
+
+	// Findings may produce a non-zero exit error; the report itself must still be rendered.
+	_ = command.Run(t.Context())
+
+	var report map[string]any
+	g.Expect(json.Unmarshal(out.Bytes(), &report)).To(Succeed())
+	g.Expect(report).To(HaveKeyWithValue("targetVersion", "3.0.1"))
+	g.Expect(errOut.String()).To(ContainSubstring("Resolved --target-version next to 3.0.1"))
+}
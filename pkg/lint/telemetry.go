@@ -0,0 +1,62 @@
+package lint
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+	"github.com/opendatahub-io/odh-cli/pkg/util/telemetry"
+)
+
+// telemetryFlushTimeout bounds the export of pending spans when the run ends.
+const telemetryFlushTimeout = 5 * time.Second
+
+// instrumentReader wraps the reader of the run to record its API reads for --trace and, when
+// OTLP export is configured, to export each of them as a span.
+func (c *Command) instrumentReader(reader client.Reader) client.Reader {
+	if c.tracer != nil {
+		reader = client.NewTracingReader(reader, c.tracer)
+	}
+
+	if telemetry.Enabled() {
+		reader = client.NewSpanReader(reader, telemetry.Tracer())
+	}
+
+	return reader
+}
+
+// startTelemetry installs the OTLP span exporter configured by the standard OTEL_*
+// environment variables and starts the root span of the run. The returned function ends the
+// span with the run's error and flushes the spans; export failures are reported as warnings
+// so they do not mask the outcome of the run.
+func (c *Command) startTelemetry(ctx context.Context) (context.Context, func(error), error) {
+	shutdown, err := telemetry.Setup(ctx)
+	if err != nil {
+		return ctx, nil, err
+	}
+
+	ctx, span := telemetry.Tracer().Start(ctx, "lint", trace.WithAttributes(
+		attribute.String("lint.target_version", c.TargetVersion),
+		attribute.String("lint.output", string(c.OutputFormat)),
+	))
+
+	return ctx, func(runErr error) {
+		if runErr != nil {
+			span.RecordError(runErr)
+			span.SetStatus(codes.Error, runErr.Error())
+		}
+
+		span.End()
+
+		flushCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), telemetryFlushTimeout)
+		defer cancel()
+
+		if err := shutdown(flushCtx); err != nil {
+			c.IO.Errorf("Warning: failed to export telemetry: %v", err)
+		}
+	}, nil
+}
@@ -0,0 +1,53 @@
+package lint
+
+import (
+	"encoding/json"
+	"os"
+
+	"github.com/opendatahub-io/odh-cli/pkg/output"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+)
+
+const apiCallTraceKind = "APICallTrace"
+
+// APICallTrace is the document written by --trace.
+type APICallTrace struct {
+	output.Envelope
+
+	// Calls are the API reads of the run in the order they started. Reads served by the
+	// run's cache are not API calls and are not recorded.
+	Calls []client.APICall `json:"calls" yaml:"calls"`
+}
+
+// writeTrace writes the API reads recorded during the run to the --trace file. Failures
+// are reported as warnings so they do not mask the outcome of the run.
+func (c *Command) writeTrace() {
+	if c.tracer == nil {
+		return
+	}
+
+	doc := APICallTrace{
+		Envelope: output.NewEnvelope(apiCallTraceKind, "lint"),
+		Calls:    c.tracer.Calls(),
+	}
+
+	if doc.Calls == nil {
+		doc.Calls = []client.APICall{}
+	}
+
+	data, err := json.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		c.IO.Errorf("Warning: failed to encode API call trace: %v", err)
+
+		return
+	}
+
+	//nolint:gosec // The trace file path is provided by the user
+	if err := os.WriteFile(c.TraceFile, append(data, '\n'), 0o600); err != nil {
+		c.IO.Errorf("Warning: failed to write API call trace: %v", err)
+
+		return
+	}
+
+	c.IO.Errorf("Wrote %d API call(s) to %s", len(doc.Calls), c.TraceFile)
+}
@@ -0,0 +1,50 @@
+package lint_test
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestCommand_Trace(t *testing.T) {
+	g := NewWithT(t)
+
+	dir := t.TempDir()
+	g.Expect(os.WriteFile(filepath.Join(dir, "dsc.yaml"), []byte(fixtureSnapshotDSC), 0o600)).To(Succeed())
+	g.Expect(os.WriteFile(filepath.Join(dir, "dsci.yaml"), []byte(fixtureSnapshotDSCI), 0o600)).To(Succeed())
+
+	tracePath := filepath.Join(t.TempDir(), "api-calls.json")
+
+	_, errOut := runBaselineCommand(t, dir, func(c *lint.Command) {
+		c.TraceFile = tracePath
+		c.Verbose = true
+	})
+	g.Expect(errOut).To(ContainSubstring("API call(s) to " + tracePath))
+
+	data, err := os.ReadFile(tracePath)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	var trace lint.APICallTrace
+	g.Expect(json.Unmarshal(data, &trace)).To(Succeed())
+	g.Expect(trace.Kind).To(Equal("APICallTrace"))
+	g.Expect(trace.Calls).ToNot(BeEmpty())
+
+	// Reads of version detection and of the checks are both recorded
+	dscReads := 0
+	for _, call := range trace.Calls {
+		g.Expect(call.Verb).To(BeElementOf(client.TraceVerbGet, client.TraceVerbList))
+
+		if call.Resource == resources.DataScienceCluster.Resource && call.Verb == client.TraceVerbList {
+			dscReads++
+		}
+	}
+
+	g.Expect(dscReads).To(BeNumerically(">=", 1))
+}
@@ -3,6 +3,7 @@ package lint
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"fmt"
 	"testing"
@@ -65,6 +66,8 @@ func TestEvaluateVerdict(t *testing.T) {
 	cases := []struct {
 		name              string
 		results           []check.CheckExecution
+		maxBlocking       int
+		maxAdvisory       int
 		wantErr           bool
 		wantCode          clierrors.ExitCode
 		notAlreadyHandled bool
@@ -119,6 +122,46 @@ func TestEvaluateVerdict(t *testing.T) {
 			wantErr:  true,
 			wantCode: clierrors.ExitWarning,
 		},
+		{
+			name: "should tolerate blocking and advisory findings within thresholds",
+			results: []check.CheckExecution{
+				buildExecution(result.ImpactBlocking),
+				buildExecution(result.ImpactAdvisory),
+				buildExecution(result.ImpactAdvisory),
+			},
+			maxBlocking: 1,
+			maxAdvisory: 2,
+		},
+		{
+			name: "should return ExitError when blocking findings exceed max-blocking",
+			results: []check.CheckExecution{
+				buildExecution(result.ImpactBlocking),
+				buildExecution(result.ImpactBlocking),
+			},
+			maxBlocking: 1,
+			wantErr:     true,
+			wantCode:    clierrors.ExitError,
+		},
+		{
+			name: "should return ExitWarning when advisory findings exceed max-advisory",
+			results: []check.CheckExecution{
+				buildExecution(result.ImpactBlocking),
+				buildExecution(result.ImpactAdvisory),
+				buildExecution(result.ImpactAdvisory),
+			},
+			maxBlocking: 1,
+			maxAdvisory: 1,
+			wantErr:     true,
+			wantCode:    clierrors.ExitWarning,
+		},
+		{
+			name:        "should return ExitError for prohibited findings regardless of thresholds",
+			results:     []check.CheckExecution{buildExecution(result.ImpactProhibited)},
+			maxBlocking: 5,
+			maxAdvisory: 5,
+			wantErr:     true,
+			wantCode:    clierrors.ExitError,
+		},
 	}
 
 	for _, tc := range cases {
@@ -126,6 +169,8 @@ func TestEvaluateVerdict(t *testing.T) {
 			g := NewWithT(t)
 			cmd := newTestCommand()
 			cmd.OutputFormat = OutputFormatJSON
+			cmd.MaxBlocking = tc.maxBlocking
+			cmd.MaxAdvisory = tc.maxAdvisory
 
 			err := cmd.evaluateVerdict(tc.results)
 			if tc.wantErr {
@@ -142,6 +187,33 @@ func TestEvaluateVerdict(t *testing.T) {
 	}
 }
 
+func TestPrintVerdict_Thresholds(t *testing.T) {
+	t.Run("should report findings within thresholds as passing", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var out bytes.Buffer
+		printVerdict(&out, verdict{
+			findingCounts: findingCounts{blocking: 2, advisory: 3},
+			maxBlocking:   2,
+			maxAdvisory:   5,
+		})
+
+		g.Expect(out.String()).To(ContainSubstring("2 blocking and 3 advisory finding(s) within thresholds (max 2 blocking, 5 advisory)"))
+	})
+
+	t.Run("should report the exceeded threshold", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var out bytes.Buffer
+		printVerdict(&out, verdict{
+			findingCounts: findingCounts{blocking: 4},
+			maxBlocking:   2,
+		})
+
+		g.Expect(out.String()).To(ContainSubstring("blocking findings detected (4, max 2)"))
+	})
+}
+
 func buildExecutionWithError(execErr error) check.CheckExecution {
 	return check.CheckExecution{
 		Result: nil,
@@ -318,3 +390,125 @@ func TestResolveExitError(t *testing.T) {
 		g.Expect(errors.Is(err, clierrors.ErrAlreadyHandled)).To(BeTrue())
 	})
 }
+
+func TestResolveOutcomeExitError(t *testing.T) {
+	execErr := errors.New("listing notebooks: timeout")
+	blockedErr := clierrors.NewExitCodeError(clierrors.ExitError,
+		fmt.Errorf("%w: blocked", clierrors.ErrLintBlocked))
+	advisoryErr := clierrors.NewExitCodeError(clierrors.ExitWarning,
+		fmt.Errorf("%w: advisory", clierrors.ErrLintAdvisory))
+
+	cases := []struct {
+		name        string
+		execSummary execErrorSummary
+		findingsErr error
+		wantCode    clierrors.ExitCode
+	}{
+		{
+			name:        "should exit clean without findings or errors",
+			execSummary: execErrorSummary{stats: check.ExecutionStats{Completed: 3}},
+			wantCode:    ExitOutcomeClean,
+		},
+		{
+			name:        "should exit advisory for advisory-only findings",
+			execSummary: execErrorSummary{stats: check.ExecutionStats{Completed: 3}},
+			findingsErr: advisoryErr,
+			wantCode:    ExitOutcomeAdvisory,
+		},
+		{
+			name:        "should exit blocking for blocking findings",
+			execSummary: execErrorSummary{stats: check.ExecutionStats{Completed: 3}},
+			findingsErr: blockedErr,
+			wantCode:    ExitOutcomeBlocking,
+		},
+		{
+			name: "should prefer execution errors over findings",
+			execSummary: execErrorSummary{
+				exitCode: clierrors.ExitConnection,
+				err:      execErr,
+				stats:    check.ExecutionStats{Completed: 2, Failed: 1},
+			},
+			findingsErr: blockedErr,
+			wantCode:    ExitOutcomeExecErrors,
+		},
+		{
+			name: "should prefer partial results over execution errors",
+			execSummary: execErrorSummary{
+				exitCode:    clierrors.ExitConnection,
+				err:         execErr,
+				stats:       check.ExecutionStats{Completed: 1, Failed: 1, NotRun: 4},
+				interrupted: context.DeadlineExceeded,
+			},
+			findingsErr: advisoryErr,
+			wantCode:    ExitOutcomePartial,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			err := resolveOutcomeExitError(tc.execSummary, tc.findingsErr, OutputFormatJSON)
+
+			if tc.wantCode == ExitOutcomeClean {
+				g.Expect(err).ToNot(HaveOccurred())
+
+				return
+			}
+
+			g.Expect(err).To(HaveOccurred())
+			g.Expect(clierrors.ExitCodeFromError(err)).To(Equal(tc.wantCode))
+			g.Expect(clierrors.Classify(err).ExitCode).To(Equal(int(tc.wantCode)))
+		})
+	}
+
+	t.Run("should wrap findings as AlreadyHandled for table format", func(t *testing.T) {
+		g := NewWithT(t)
+		err := resolveOutcomeExitError(
+			execErrorSummary{stats: check.ExecutionStats{Completed: 1}},
+			blockedErr,
+			OutputFormatTable,
+		)
+
+		g.Expect(errors.Is(err, clierrors.ErrAlreadyHandled)).To(BeTrue())
+		g.Expect(clierrors.ExitCodeFromError(err)).To(Equal(ExitOutcomeBlocking))
+	})
+}
+
+func TestExitCodeModeValidate(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(ExitCodeModeDefault.Validate()).To(Succeed())
+	g.Expect(ExitCodeModeOutcome.Validate()).To(Succeed())
+	g.Expect(ExitCodeMode("strict").Validate()).To(MatchError(ContainSubstring("invalid exit-code-mode")))
+}
+
+func TestPrintExecErrorSummary(t *testing.T) {
+	t.Run("should list the checks that failed to execute", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var errOut bytes.Buffer
+		command := NewCommand(genericiooptions.IOStreams{In: &bytes.Buffer{}, Out: &bytes.Buffer{}, ErrOut: &errOut},
+			genericclioptions.NewConfigFlags(true))
+
+		failed := buildPassingExecution()
+		failed.Check = &permissionsTestCheck{BaseCheck: check.BaseCheck{CheckID: "workloads.notebook.impacted-workloads"}}
+		failed.Error = errors.New("listing notebooks: timeout")
+
+		command.printExecErrorSummary(highestPriorityExecError([]check.CheckExecution{buildPassingExecution(), failed}))
+
+		g.Expect(errOut.String()).To(ContainSubstring("1 check(s) failed to execute"))
+		g.Expect(errOut.String()).To(ContainSubstring("workloads.notebook.impacted-workloads: listing notebooks: timeout"))
+	})
+
+	t.Run("should print nothing without failures", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var errOut bytes.Buffer
+		command := NewCommand(genericiooptions.IOStreams{In: &bytes.Buffer{}, Out: &bytes.Buffer{}, ErrOut: &errOut},
+			genericclioptions.NewConfigFlags(true))
+
+		command.printExecErrorSummary(highestPriorityExecError([]check.CheckExecution{buildPassingExecution()}))
+
+		g.Expect(errOut.String()).To(BeEmpty())
+	})
+}
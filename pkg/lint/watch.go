@@ -0,0 +1,321 @@
+package lint
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+)
+
+const (
+	// DefaultWatchInterval is the default time between runs in watch mode.
+	DefaultWatchInterval = 30 * time.Minute
+
+	watchFilePermission = 0o644
+
+	msgWatchStarted  = "Watching upgrade readiness every %s (press Ctrl+C to stop)"
+	msgWatchRunError = "Warning: watch run failed, retrying in %s: %v"
+)
+
+// watchCheckState is the state of one check recorded between watch runs.
+type watchCheckState struct {
+	impact   result.Impact
+	findings map[string]struct{}
+	objects  int
+}
+
+// watchSnapshot records the state of every executed check, keyed by check ID.
+type watchSnapshot map[string]watchCheckState
+
+// WatchChangeType classifies a change between two watch runs.
+type WatchChangeType string
+
+const (
+	WatchChangeNewlyFailing WatchChangeType = "newly-failing"
+	WatchChangeResolved     WatchChangeType = "resolved"
+	WatchChangeChanged      WatchChangeType = "changed"
+)
+
+// WatchChange is the change of a single check between two watch runs.
+type WatchChange struct {
+	Type    WatchChangeType `json:"type"    yaml:"type"`
+	CheckID string          `json:"checkId" yaml:"checkId"`
+
+	// PreviousImpact and Impact are the highest impacts of the check in both runs.
+	PreviousImpact result.Impact `json:"previousImpact" yaml:"previousImpact"`
+	Impact         result.Impact `json:"impact"         yaml:"impact"`
+
+	// NewFindings and ResolvedFindings count findings (by fingerprint) that appeared or disappeared.
+	NewFindings      int `json:"newFindings"      yaml:"newFindings"`
+	ResolvedFindings int `json:"resolvedFindings" yaml:"resolvedFindings"`
+
+	// PreviousObjects and Objects are the impacted object counts in both runs.
+	PreviousObjects int `json:"previousObjects" yaml:"previousObjects"`
+	Objects         int `json:"objects"         yaml:"objects"`
+}
+
+// newWatchSnapshot records the state of the checks of a run.
+func newWatchSnapshot(results []check.CheckExecution) watchSnapshot {
+	snapshot := make(watchSnapshot, len(results))
+
+	for _, exec := range results {
+		if exec.Result == nil || exec.Check == nil {
+			continue
+		}
+
+		state := watchCheckState{
+			impact:   exec.Result.GetImpact(),
+			findings: make(map[string]struct{}),
+		}
+
+		for _, f := range exec.Result.Findings(exec.Check.ID()) {
+			state.findings[f.Fingerprint] = struct{}{}
+		}
+
+		if state.impact != result.ImpactNone {
+			state.objects = len(exec.Result.ImpactedObjects)
+		}
+
+		snapshot[exec.Check.ID()] = state
+	}
+
+	return snapshot
+}
+
+// diffWatchSnapshots returns the changes between two runs, sorted by check ID. Checks that
+// pass in both runs, or fail identically, are not reported.
+func diffWatchSnapshots(previous, current watchSnapshot) []WatchChange {
+	ids := make(map[string]struct{}, len(previous)+len(current))
+	for id := range previous {
+		ids[id] = struct{}{}
+	}
+
+	for id := range current {
+		ids[id] = struct{}{}
+	}
+
+	var changes []WatchChange
+
+	for id := range ids {
+		prev, cur := previous[id], current[id]
+
+		change := WatchChange{
+			CheckID:         id,
+			PreviousImpact:  prev.impact,
+			Impact:          cur.impact,
+			PreviousObjects: prev.objects,
+			Objects:         cur.objects,
+		}
+
+		for fp := range cur.findings {
+			if _, ok := prev.findings[fp]; !ok {
+				change.NewFindings++
+			}
+		}
+
+		for fp := range prev.findings {
+			if _, ok := cur.findings[fp]; !ok {
+				change.ResolvedFindings++
+			}
+		}
+
+		wasFailing, isFailing := prev.impact != result.ImpactNone, cur.impact != result.ImpactNone
+
+		switch {
+		case !wasFailing && isFailing:
+			change.Type = WatchChangeNewlyFailing
+		case wasFailing && !isFailing:
+			change.Type = WatchChangeResolved
+		case isFailing && (prev.impact != cur.impact || prev.objects != cur.objects ||
+			change.NewFindings > 0 || change.ResolvedFindings > 0):
+			change.Type = WatchChangeChanged
+		default:
+			continue
+		}
+
+		changes = append(changes, change)
+	}
+
+	slices.SortFunc(changes, func(a, b WatchChange) int {
+		return strings.Compare(a.CheckID, b.CheckID)
+	})
+
+	return changes
+}
+
+// writeWatchChanges writes the changes of a run as one line per check, prefixed with a
+// timestamped header line.
+func writeWatchChanges(w io.Writer, now time.Time, changes []WatchChange, failing int) error {
+	var b strings.Builder
+
+	timestamp := now.UTC().Format(time.RFC3339)
+
+	if len(changes) == 0 {
+		fmt.Fprintf(&b, "[%s] No changes since last run (%d failing check(s))\n", timestamp, failing)
+	} else {
+		fmt.Fprintf(&b, "[%s] %d change(s) since last run (%d failing check(s)):\n", timestamp, len(changes), failing)
+	}
+
+	writeChangeLines(&b, changes)
+
+	if _, err := io.WriteString(w, b.String()); err != nil {
+		return fmt.Errorf("writing watch changes: %w", err)
+	}
+
+	return nil
+}
+
+// writeChangeLines writes one line per changed check.
+func writeChangeLines(b *strings.Builder, changes []WatchChange) {
+	for _, change := range changes {
+		switch change.Type {
+		case WatchChangeNewlyFailing:
+			fmt.Fprintf(b, "  + %s: newly failing (%s, %d finding(s), %d impacted object(s))\n",
+				change.CheckID, change.Impact, change.NewFindings, change.Objects)
+		case WatchChangeResolved:
+			fmt.Fprintf(b, "  - %s: resolved (was %s, %d finding(s) resolved)\n",
+				change.CheckID, change.PreviousImpact, change.ResolvedFindings)
+		case WatchChangeChanged:
+			fmt.Fprintf(b, "  ~ %s: %s", change.CheckID, change.Impact)
+
+			if change.PreviousImpact != change.Impact {
+				fmt.Fprintf(b, " (was %s)", change.PreviousImpact)
+			}
+
+			fmt.Fprintf(b, ", %d new / %d resolved finding(s), impacted objects %d → %d\n",
+				change.NewFindings, change.ResolvedFindings, change.PreviousObjects, change.Objects)
+		}
+	}
+}
+
+// validateWatch checks the options of watch mode.
+func (c *Command) validateWatch() error {
+	if !c.Watch {
+		if c.WatchFile != "" {
+			return errors.New("--watch-file can only be used with --watch")
+		}
+
+		return nil
+	}
+
+	switch {
+	case c.TargetVersion == "":
+		return errors.New("--watch requires --target-version")
+	case c.Interval <= 0:
+		return fmt.Errorf("invalid --interval %s (must be positive)", c.Interval)
+	case !c.OutputFormat.isTable():
+		return errors.New("--watch only supports table output")
+	case c.Fix:
+		return errors.New("--watch cannot be used with --fix")
+	case c.FromDir != "":
+		return errors.New("--watch cannot be used with --from-dir (snapshots do not change)")
+	}
+
+	return nil
+}
+
+// countFailingChecks returns the number of checks of a snapshot with a non-passing impact.
+func countFailingChecks(snapshot watchSnapshot) int {
+	failing := 0
+
+	for _, state := range snapshot {
+		if state.impact != result.ImpactNone {
+			failing++
+		}
+	}
+
+	return failing
+}
+
+// runWatchMode re-runs the upgrade checks every Interval until ctx is cancelled. The first run
+// is rendered in full; later runs only report changes, to stdout or appended to WatchFile.
+// Failed runs (e.g. transient API errors) are reported and retried at the next interval.
+func (c *Command) runWatchMode(ctx context.Context) error {
+	c.IO.Errorf(msgWatchStarted, c.Interval)
+
+	var previous watchSnapshot
+
+	for {
+		snapshot, err := c.runWatchIteration(ctx, previous)
+
+		switch {
+		case err != nil && ctx.Err() != nil:
+			return nil
+		case err != nil:
+			_, _ = fmt.Fprintf(c.IO.ErrOut(), msgWatchRunError+"\n", c.Interval, err)
+		case snapshot == nil:
+			// Current and target versions match: nothing to watch
+			return nil
+		default:
+			previous = snapshot
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(c.Interval):
+		}
+	}
+}
+
+// runWatchIteration executes a single watch run and reports its results against previous.
+// It returns a nil snapshot when no upgrade checks apply.
+func (c *Command) runWatchIteration(ctx context.Context, previous watchSnapshot) (watchSnapshot, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Timeout)
+	defer cancel()
+
+	currentVersion, err := c.detectVersions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	upgrade, err := c.isUpgrade(currentVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	if !upgrade {
+		return nil, c.runLintMode(ctx, currentVersion)
+	}
+
+	results, _, _, err := c.executeUpgradeChecks(ctx, currentVersion, false)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.publishMetrics(ctx, results); err != nil {
+		return nil, fmt.Errorf("publishing metrics: %w", err)
+	}
+
+	snapshot := newWatchSnapshot(results)
+
+	if previous == nil {
+		if err := c.formatAndOutputUpgradeResults(ctx, results); err != nil {
+			return nil, err
+		}
+
+		return snapshot, nil
+	}
+
+	changes := diffWatchSnapshots(previous, snapshot)
+
+	if c.WatchFile == "" {
+		return snapshot, writeWatchChanges(c.IO.Out(), time.Now(), changes, countFailingChecks(snapshot))
+	}
+
+	f, err := os.OpenFile(c.WatchFile, os.O_APPEND|os.O_CREATE|os.O_WRONLY, watchFilePermission)
+	if err != nil {
+		return nil, fmt.Errorf("opening watch file: %w", err)
+	}
+
+	defer func() { _ = f.Close() }()
+
+	return snapshot, writeWatchChanges(f, time.Now(), changes, countFailingChecks(snapshot))
+}
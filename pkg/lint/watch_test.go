@@ -0,0 +1,175 @@
+//nolint:testpackage // internal test: exercises unexported watch snapshot diffing
+package lint
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+
+	. "github.com/onsi/gomega"
+)
+
+type watchTestCheck struct {
+	check.BaseCheck
+}
+
+func (c *watchTestCheck) CanApply(_ context.Context, _ check.Target) (bool, error) {
+	return true, nil
+}
+
+func (c *watchTestCheck) Validate(_ context.Context, _ check.Target) (*result.DiagnosticResult, error) {
+	return c.NewResult(), nil
+}
+
+func newWatchExecution(id string, impact result.Impact, objectNames ...string) check.CheckExecution {
+	status := metav1.ConditionFalse
+	if impact == result.ImpactNone {
+		status = metav1.ConditionTrue
+	}
+
+	dr := result.New("workload", "notebook", id, "test check")
+	dr.SetCondition(check.NewCondition(
+		check.ConditionTypeCompatible,
+		status,
+		check.WithReason(check.ReasonMigrationPending),
+		check.WithMessage("finding"),
+		check.WithImpact(impact),
+	))
+
+	for _, name := range objectNames {
+		dr.ImpactedObjects = append(dr.ImpactedObjects, metav1.PartialObjectMetadata{
+			TypeMeta:   metav1.TypeMeta{Kind: "Notebook"},
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: name},
+		})
+	}
+
+	return check.CheckExecution{
+		Check:  &watchTestCheck{BaseCheck: check.BaseCheck{CheckGroup: check.GroupWorkload, CheckID: id}},
+		Result: dr,
+	}
+}
+
+func TestDiffWatchSnapshots(t *testing.T) {
+	g := NewWithT(t)
+
+	previous := newWatchSnapshot([]check.CheckExecution{
+		newWatchExecution("a.unchanged", result.ImpactBlocking, "nb1"),
+		newWatchExecution("b.resolved", result.ImpactBlocking, "nb1", "nb2"),
+		newWatchExecution("c.progress", result.ImpactBlocking, "nb1", "nb2", "nb3"),
+		newWatchExecution("d.new", result.ImpactNone),
+		newWatchExecution("e.passing", result.ImpactNone),
+	})
+
+	current := newWatchSnapshot([]check.CheckExecution{
+		newWatchExecution("a.unchanged", result.ImpactBlocking, "nb1"),
+		newWatchExecution("b.resolved", result.ImpactNone),
+		newWatchExecution("c.progress", result.ImpactAdvisory, "nb3", "nb4"),
+		newWatchExecution("d.new", result.ImpactProhibited, "nb1"),
+		newWatchExecution("e.passing", result.ImpactNone),
+	})
+
+	changes := diffWatchSnapshots(previous, current)
+	g.Expect(changes).To(HaveLen(3))
+
+	g.Expect(changes[0].CheckID).To(Equal("b.resolved"))
+	g.Expect(changes[0].Type).To(Equal(WatchChangeResolved))
+	g.Expect(changes[0].ResolvedFindings).To(Equal(2))
+
+	g.Expect(changes[1].CheckID).To(Equal("c.progress"))
+	g.Expect(changes[1].Type).To(Equal(WatchChangeChanged))
+	g.Expect(changes[1].PreviousImpact).To(Equal(result.ImpactBlocking))
+	g.Expect(changes[1].Impact).To(Equal(result.ImpactAdvisory))
+	g.Expect(changes[1].NewFindings).To(Equal(1))
+	g.Expect(changes[1].ResolvedFindings).To(Equal(2))
+	g.Expect(changes[1].PreviousObjects).To(Equal(3))
+	g.Expect(changes[1].Objects).To(Equal(2))
+
+	g.Expect(changes[2].CheckID).To(Equal("d.new"))
+	g.Expect(changes[2].Type).To(Equal(WatchChangeNewlyFailing))
+	g.Expect(changes[2].NewFindings).To(Equal(1))
+	g.Expect(countFailingChecks(current)).To(Equal(3))
+}
+
+func TestWriteWatchChanges(t *testing.T) {
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	t.Run("should report no changes", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var buf bytes.Buffer
+		g.Expect(writeWatchChanges(&buf, now, nil, 2)).To(Succeed())
+		g.Expect(buf.String()).To(Equal("[2026-01-02T03:04:05Z] No changes since last run (2 failing check(s))\n"))
+	})
+
+	t.Run("should report one line per change", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var buf bytes.Buffer
+		g.Expect(writeWatchChanges(&buf, now, []WatchChange{
+			{Type: WatchChangeNewlyFailing, CheckID: "a", Impact: result.ImpactBlocking, NewFindings: 2, Objects: 2},
+			{Type: WatchChangeResolved, CheckID: "b", PreviousImpact: result.ImpactAdvisory, ResolvedFindings: 1},
+			{
+				Type: WatchChangeChanged, CheckID: "c", PreviousImpact: result.ImpactBlocking, Impact: result.ImpactBlocking,
+				NewFindings: 0, ResolvedFindings: 1, PreviousObjects: 3, Objects: 2,
+			},
+		}, 2)).To(Succeed())
+
+		g.Expect(buf.String()).To(Equal(
+			"[2026-01-02T03:04:05Z] 3 change(s) since last run (2 failing check(s)):\n" +
+				"  + a: newly failing (blocking, 2 finding(s), 2 impacted object(s))\n" +
+				"  - b: resolved (was advisory, 1 finding(s) resolved)\n" +
+				"  ~ c: blocking, 0 new / 1 resolved finding(s), impacted objects 3 → 2\n"))
+	})
+}
+
+func TestCommand_ValidateWatch(t *testing.T) {
+	newCommand := func() *Command {
+		c := NewCommand(genericiooptions.IOStreams{
+			In: &bytes.Buffer{}, Out: &bytes.Buffer{}, ErrOut: &bytes.Buffer{},
+		}, nil)
+		c.OutputFormat = OutputFormatTable
+		c.TargetVersion = "3.0.0"
+		c.Watch = true
+
+		return c
+	}
+
+	t.Run("should accept watch with defaults", func(t *testing.T) {
+		g := NewWithT(t)
+
+		g.Expect(newCommand().validateWatch()).To(Succeed())
+	})
+
+	t.Run("should reject invalid combinations", func(t *testing.T) {
+		tests := []struct {
+			name      string
+			configure func(*Command)
+			message   string
+		}{
+			{"no target version", func(c *Command) { c.TargetVersion = "" }, "requires --target-version"},
+			{"zero interval", func(c *Command) { c.Interval = 0 }, "invalid --interval"},
+			{"structured output", func(c *Command) { c.OutputFormat = OutputFormatJSON }, "only supports table output"},
+			{"fix", func(c *Command) { c.Fix = true }, "cannot be used with --fix"},
+			{"from-dir", func(c *Command) { c.FromDir = "snapshot.tar.gz" }, "cannot be used with --from-dir"},
+			{"watch-file without watch", func(c *Command) { c.Watch = false; c.WatchFile = "changes.log" }, "--watch-file can only be used with --watch"},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				g := NewWithT(t)
+
+				c := newCommand()
+				tt.configure(c)
+
+				g.Expect(c.validateWatch()).To(MatchError(ContainSubstring(tt.message)))
+			})
+		}
+	})
+}
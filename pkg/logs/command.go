@@ -64,9 +64,9 @@ const (
 	suggestionInvalidTarget  = "Use 'operator' or a valid component name (dashboard, kserve, ray, etc.)"
 )
 
-// operatorLabelSelector finds ODH/RHOAI operator pods using a single query.
+// OperatorLabelSelector finds ODH/RHOAI operator pods using a single query.
 // Uses "in" operator to match both ODH and RHOAI operator names.
-const operatorLabelSelector = "control-plane=controller-manager,name in (opendatahub-operator,rhods-operator)"
+const OperatorLabelSelector = "control-plane=controller-manager,name in (opendatahub-operator,rhods-operator)"
 
 // operatorNamespaces contains namespaces where the operator might be installed.
 //
@@ -207,7 +207,7 @@ func (c *Command) discoverOperatorPods(ctx context.Context) ([]*corev1.Pod, erro
 	for _, ns := range operatorNamespaces {
 		g.Go(func() error {
 			pods, err := coreClient.Pods(ns).List(ctx, metav1.ListOptions{
-				LabelSelector: operatorLabelSelector,
+				LabelSelector: OperatorLabelSelector,
 				FieldSelector: "status.phase=Running",
 			})
 			if err != nil {
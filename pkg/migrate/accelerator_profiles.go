@@ -0,0 +1,260 @@
+package migrate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/validate"
+	"github.com/opendatahub-io/odh-cli/pkg/migrate/manifest"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+	"github.com/opendatahub-io/odh-cli/pkg/util/jq"
+	"github.com/opendatahub-io/odh-cli/pkg/util/kube"
+)
+
+const (
+	annotationHardwareProfileName      = "opendatahub.io/hardware-profile-name"
+	annotationHardwareProfileNamespace = "opendatahub.io/hardware-profile-namespace"
+	annotationDisplayName              = "opendatahub.io/display-name"
+	annotationDescription              = "opendatahub.io/description"
+	annotationDisabled                 = "opendatahub.io/disabled"
+	annotationFeatureVisibility        = "opendatahub.io/dashboard-feature-visibility"
+
+	// Suffixes and dashboard features of the HardwareProfiles created from an AcceleratorProfile,
+	// matching the names the upgrade gives them.
+	hardwareProfileSuffixNotebooks = "-notebooks"
+	hardwareProfileSuffixServing   = "-serving"
+	featureWorkbench               = `["workbench"]`
+	featureModelServing            = `["model-serving"]`
+
+	// Defaults of the CPU and memory identifiers added to generated HardwareProfiles.
+	defaultCPUMin        = 1
+	defaultCPUDefault    = 2
+	defaultMemoryMin     = "2Gi"
+	defaultMemoryDefault = "4Gi"
+)
+
+// acceleratorWorkloadTypes are the workloads the accelerator migration checks flag, with the
+// HardwareProfile variant each one is migrated to.
+//
+//nolint:gochecknoglobals // Constant-like table used by the generator.
+var acceleratorWorkloadTypes = []struct {
+	resourceType resources.ResourceType
+	suffix       string
+	feature      string
+}{
+	{resources.Notebook, hardwareProfileSuffixNotebooks, featureWorkbench},
+	{resources.InferenceService, hardwareProfileSuffixServing, featureModelServing},
+}
+
+// GenerateAcceleratorProfileMigration builds the manifests migrating workloads that reference
+// AcceleratorProfiles to HardwareProfiles (infrastructure.opendatahub.io): a HardwareProfile
+// per AcceleratorProfile and workload type, named like the ones the upgrade creates, and an
+// annotation patch pointing each Notebook and InferenceService at its HardwareProfile.
+// Workloads already referencing a HardwareProfile are left alone; workloads referencing a
+// missing AcceleratorProfile are reported as skipped.
+func GenerateAcceleratorProfileMigration(ctx context.Context, c client.Reader) (*manifest.Bundle, error) {
+	appNS, err := client.GetApplicationsNamespace(ctx, c)
+	if err != nil {
+		return nil, fmt.Errorf("getting applications namespace: %w", err)
+	}
+
+	existing, err := kube.BuildResourceNameSet(ctx, c, resources.InfrastructureHardwareProfile)
+	if err != nil {
+		return nil, fmt.Errorf("building HardwareProfile cache: %w", err)
+	}
+
+	bundle := &manifest.Bundle{}
+	profiles := map[types.NamespacedName]*unstructured.Unstructured{}
+	generated := sets.New[types.NamespacedName]()
+
+	for _, wt := range acceleratorWorkloadTypes {
+		workloads, err := c.ListMetadata(ctx, wt.resourceType)
+		if err != nil {
+			if client.IsResourceTypeNotFound(err) {
+				continue
+			}
+
+			return nil, fmt.Errorf("listing %s: %w", wt.resourceType.Kind, err)
+		}
+
+		for _, w := range workloads {
+			apRef := types.NamespacedName{
+				Namespace: kube.GetAnnotation(w, validate.AnnotationAcceleratorNamespace),
+				Name:      kube.GetAnnotation(w, validate.AnnotationAcceleratorName),
+			}
+
+			if apRef.Name == "" || kube.GetAnnotation(w, annotationHardwareProfileName) != "" {
+				continue
+			}
+
+			if apRef.Namespace == "" {
+				apRef.Namespace = appNS
+			}
+
+			ap, ok := profiles[apRef]
+			if !ok {
+				ap, err = getAcceleratorProfile(ctx, c, apRef)
+				if err != nil {
+					return nil, err
+				}
+
+				profiles[apRef] = ap
+			}
+
+			if ap == nil {
+				bundle.Skip(wt.resourceType.Kind, w.GetNamespace(), w.GetName(),
+					"references AcceleratorProfile %s which does not exist", apRef)
+
+				continue
+			}
+
+			hwpRef := types.NamespacedName{Namespace: apRef.Namespace, Name: apRef.Name + wt.suffix}
+
+			if !existing.Has(hwpRef) && !generated.Has(hwpRef) {
+				hwp, err := newHardwareProfile(ap, hwpRef, wt.feature)
+				if err != nil {
+					return nil, fmt.Errorf("converting AcceleratorProfile %s: %w", apRef, err)
+				}
+
+				bundle.AddObject(resources.InfrastructureHardwareProfile, hwp)
+				generated.Insert(hwpRef)
+			}
+
+			bundle.AddPatch(manifest.Patch{
+				ResourceType: wt.resourceType,
+				Namespace:    w.GetNamespace(),
+				Name:         w.GetName(),
+				Patch: map[string]any{
+					"metadata": map[string]any{
+						"annotations": map[string]any{
+							annotationHardwareProfileName:      hwpRef.Name,
+							annotationHardwareProfileNamespace: hwpRef.Namespace,
+						},
+					},
+				},
+				Description: "reference HardwareProfile " + hwpRef.String(),
+			})
+		}
+	}
+
+	return bundle, nil
+}
+
+// getAcceleratorProfile returns the AcceleratorProfile, or nil when it does not exist.
+func getAcceleratorProfile(
+	ctx context.Context,
+	c client.Reader,
+	ref types.NamespacedName,
+) (*unstructured.Unstructured, error) {
+	ap, err := c.GetResource(ctx, resources.AcceleratorProfile, ref.Name, client.InNamespace(ref.Namespace))
+
+	switch {
+	case apierrors.IsNotFound(err) || client.IsResourceTypeNotFound(err):
+		return nil, nil
+	case err != nil:
+		return nil, fmt.Errorf("getting AcceleratorProfile %s: %w", ref, err)
+	}
+
+	return ap, nil
+}
+
+// newHardwareProfile converts an AcceleratorProfile into a HardwareProfile exposing the
+// accelerator identifier alongside CPU and memory, and carrying its tolerations as node
+// scheduling.
+func newHardwareProfile(
+	ap *unstructured.Unstructured,
+	ref types.NamespacedName,
+	feature string,
+) (*unstructured.Unstructured, error) {
+	identifier, err := jq.Query[string](ap, ".spec.identifier")
+	if err != nil {
+		return nil, fmt.Errorf("querying .spec.identifier: %w", err)
+	}
+
+	displayName, err := jq.Query[string](ap, ".spec.displayName")
+	if err != nil && !errors.Is(err, jq.ErrNotFound) {
+		return nil, fmt.Errorf("querying .spec.displayName: %w", err)
+	}
+
+	if displayName == "" {
+		displayName = ap.GetName()
+	}
+
+	description, err := jq.Query[string](ap, ".spec.description")
+	if err != nil && !errors.Is(err, jq.ErrNotFound) {
+		return nil, fmt.Errorf("querying .spec.description: %w", err)
+	}
+
+	enabled, err := jq.Query[bool](ap, ".spec.enabled")
+	if errors.Is(err, jq.ErrNotFound) {
+		enabled = true
+	} else if err != nil {
+		return nil, fmt.Errorf("querying .spec.enabled: %w", err)
+	}
+
+	spec := map[string]any{
+		"identifiers": []any{
+			map[string]any{
+				"displayName":  "CPU",
+				"identifier":   "cpu",
+				"resourceType": "CPU",
+				"minCount":     int64(defaultCPUMin),
+				"defaultCount": int64(defaultCPUDefault),
+			},
+			map[string]any{
+				"displayName":  "Memory",
+				"identifier":   "memory",
+				"resourceType": "Memory",
+				"minCount":     defaultMemoryMin,
+				"defaultCount": defaultMemoryDefault,
+			},
+			map[string]any{
+				"displayName":  displayName,
+				"identifier":   identifier,
+				"resourceType": "Accelerator",
+				"minCount":     int64(1),
+				"defaultCount": int64(1),
+			},
+		},
+	}
+
+	tolerations, found, err := unstructured.NestedSlice(ap.Object, "spec", "tolerations")
+	if err != nil {
+		return nil, fmt.Errorf("reading .spec.tolerations: %w", err)
+	}
+
+	if found && len(tolerations) > 0 {
+		spec["scheduling"] = map[string]any{
+			"type": "Node",
+			"node": map[string]any{"tolerations": tolerations},
+		}
+	}
+
+	hwp := &unstructured.Unstructured{Object: map[string]any{"spec": spec}}
+	hwp.SetAPIVersion(resources.InfrastructureHardwareProfile.APIVersion())
+	hwp.SetKind(resources.InfrastructureHardwareProfile.Kind)
+	hwp.SetNamespace(ref.Namespace)
+	hwp.SetName(ref.Name)
+
+	annotations := map[string]string{
+		annotationDisplayName:       displayName,
+		annotationDisabled:          strconv.FormatBool(!enabled),
+		annotationFeatureVisibility: feature,
+	}
+
+	if description != "" {
+		annotations[annotationDescription] = description
+	}
+
+	hwp.SetAnnotations(annotations)
+
+	return hwp, nil
+}
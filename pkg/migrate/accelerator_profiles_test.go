@@ -0,0 +1,285 @@
+package migrate_test
+
+import (
+	"bytes"
+	"path/filepath"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	metadatafake "k8s.io/client-go/metadata/fake"
+
+	"github.com/opendatahub-io/odh-cli/pkg/migrate"
+	"github.com/opendatahub-io/odh-cli/pkg/migrate/manifest"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+	"github.com/opendatahub-io/odh-cli/pkg/util/kube"
+
+	. "github.com/onsi/gomega"
+)
+
+const applicationsNamespace = "opendatahub"
+
+//nolint:gochecknoglobals // Test fixture
+var generateListKinds = map[schema.GroupVersionResource]string{
+	resources.DataScienceCluster.GVR():            resources.DataScienceCluster.ListKind(),
+	resources.DSCInitialization.GVR():             resources.DSCInitialization.ListKind(),
+	resources.AcceleratorProfile.GVR():            resources.AcceleratorProfile.ListKind(),
+	resources.InfrastructureHardwareProfile.GVR(): resources.InfrastructureHardwareProfile.ListKind(),
+	resources.Notebook.GVR():                      resources.Notebook.ListKind(),
+	resources.InferenceService.GVR():              resources.InferenceService.ListKind(),
+	resources.ServingRuntime.GVR():                resources.ServingRuntime.ListKind(),
+	resources.ImageStream.GVR():                   resources.ImageStream.ListKind(),
+	resources.ImageStreamTag.GVR():                resources.ImageStreamTag.ListKind(),
+	resources.ImageDigestMirrorSet.GVR():          resources.ImageDigestMirrorSet.ListKind(),
+	resources.ImageTagMirrorSet.GVR():             resources.ImageTagMirrorSet.ListKind(),
+	resources.ImageContentSourcePolicy.GVR():      resources.ImageContentSourcePolicy.ListKind(),
+}
+
+func newFakeClient(objs ...*unstructured.Unstructured) client.Client {
+	scheme := runtime.NewScheme()
+	_ = metav1.AddMetaToScheme(scheme)
+
+	dynamicObjs := make([]runtime.Object, len(objs))
+	for i, obj := range objs {
+		dynamicObjs[i] = obj
+	}
+
+	return client.NewForTesting(client.TestClientConfig{
+		Dynamic:  dynamicfake.NewSimpleDynamicClientWithCustomListKinds(scheme, generateListKinds, dynamicObjs...),
+		Metadata: metadatafake.NewSimpleMetadataClient(scheme, kube.ToPartialObjectMetadata(objs...)...),
+	})
+}
+
+func newDSCI() *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": resources.DSCInitialization.APIVersion(),
+		"kind":       resources.DSCInitialization.Kind,
+		"metadata":   map[string]any{"name": "default-dsci"},
+		"spec":       map[string]any{"applicationsNamespace": applicationsNamespace},
+	}}
+}
+
+func newAcceleratorProfile(name string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": resources.AcceleratorProfile.APIVersion(),
+		"kind":       resources.AcceleratorProfile.Kind,
+		"metadata":   map[string]any{"name": name, "namespace": applicationsNamespace},
+		"spec": map[string]any{
+			"displayName": "NVIDIA GPU",
+			"identifier":  "nvidia.com/gpu",
+			"enabled":     true,
+			"tolerations": []any{
+				map[string]any{"key": "nvidia.com/gpu", "operator": "Exists", "effect": "NoSchedule"},
+			},
+		},
+	}}
+}
+
+func newWorkload(rt resources.ResourceType, namespace, name string, annotations map[string]any) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": rt.APIVersion(),
+		"kind":       rt.Kind,
+		"metadata": map[string]any{
+			"name":        name,
+			"namespace":   namespace,
+			"annotations": annotations,
+		},
+	}}
+}
+
+func TestGenerateAcceleratorProfileMigration(t *testing.T) {
+	t.Run("should generate a HardwareProfile per workload type and a patch per workload", func(t *testing.T) {
+		g := NewWithT(t)
+
+		c := newFakeClient(
+			newDSCI(),
+			newAcceleratorProfile("nvidia-gpu"),
+			newWorkload(resources.Notebook, "user-ns", "wb-1", map[string]any{
+				"opendatahub.io/accelerator-name": "nvidia-gpu",
+			}),
+			newWorkload(resources.Notebook, "user-ns", "wb-2", map[string]any{
+				"opendatahub.io/accelerator-name": "nvidia-gpu",
+			}),
+			newWorkload(resources.InferenceService, "user-ns", "model", map[string]any{
+				"opendatahub.io/accelerator-name": "nvidia-gpu",
+			}),
+		)
+
+		bundle, err := migrate.GenerateAcceleratorProfileMigration(t.Context(), c)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(bundle.Skipped).To(BeEmpty())
+
+		g.Expect(bundle.Objects).To(HaveLen(2))
+		g.Expect(bundle.Objects[0].Object.GetName()).To(Equal("nvidia-gpu-notebooks"))
+		g.Expect(bundle.Objects[0].Object.GetNamespace()).To(Equal(applicationsNamespace))
+		g.Expect(bundle.Objects[0].Object.GetAnnotations()).To(HaveKeyWithValue(
+			"opendatahub.io/dashboard-feature-visibility", `["workbench"]`))
+		g.Expect(bundle.Objects[1].Object.GetName()).To(Equal("nvidia-gpu-serving"))
+
+		identifiers, _, _ := unstructured.NestedSlice(bundle.Objects[0].Object.Object, "spec", "identifiers")
+		g.Expect(identifiers).To(HaveLen(3))
+		g.Expect(identifiers[2]).To(HaveKeyWithValue("identifier", "nvidia.com/gpu"))
+
+		tolerations, found, _ := unstructured.NestedSlice(bundle.Objects[0].Object.Object,
+			"spec", "scheduling", "node", "tolerations")
+		g.Expect(found).To(BeTrue())
+		g.Expect(tolerations).To(HaveLen(1))
+
+		g.Expect(bundle.Patches).To(HaveLen(3))
+		g.Expect(bundle.Patches[0].Reference()).To(Equal("Notebook/user-ns/wb-1"))
+		g.Expect(bundle.Patches[0].Patch).To(HaveKeyWithValue("metadata", HaveKeyWithValue("annotations",
+			HaveKeyWithValue("opendatahub.io/hardware-profile-name", "nvidia-gpu-notebooks"))))
+		g.Expect(bundle.Patches[2].Reference()).To(Equal("InferenceService/user-ns/model"))
+	})
+
+	t.Run("should skip workloads already using a HardwareProfile", func(t *testing.T) {
+		g := NewWithT(t)
+
+		c := newFakeClient(
+			newDSCI(),
+			newAcceleratorProfile("nvidia-gpu"),
+			newWorkload(resources.Notebook, "user-ns", "wb", map[string]any{
+				"opendatahub.io/accelerator-name":      "nvidia-gpu",
+				"opendatahub.io/hardware-profile-name": "gpu",
+			}),
+		)
+
+		bundle, err := migrate.GenerateAcceleratorProfileMigration(t.Context(), c)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(bundle.Empty()).To(BeTrue())
+	})
+
+	t.Run("should not regenerate existing HardwareProfiles", func(t *testing.T) {
+		g := NewWithT(t)
+
+		hwp := &unstructured.Unstructured{}
+		hwp.SetAPIVersion(resources.InfrastructureHardwareProfile.APIVersion())
+		hwp.SetKind(resources.InfrastructureHardwareProfile.Kind)
+		hwp.SetNamespace(applicationsNamespace)
+		hwp.SetName("nvidia-gpu-notebooks")
+
+		c := newFakeClient(
+			newDSCI(),
+			newAcceleratorProfile("nvidia-gpu"),
+			hwp,
+			newWorkload(resources.Notebook, "user-ns", "wb", map[string]any{
+				"opendatahub.io/accelerator-name": "nvidia-gpu",
+			}),
+		)
+
+		bundle, err := migrate.GenerateAcceleratorProfileMigration(t.Context(), c)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(bundle.Objects).To(BeEmpty())
+		g.Expect(bundle.Patches).To(HaveLen(1))
+	})
+
+	t.Run("should report workloads referencing a missing AcceleratorProfile", func(t *testing.T) {
+		g := NewWithT(t)
+
+		c := newFakeClient(
+			newDSCI(),
+			newWorkload(resources.Notebook, "user-ns", "wb", map[string]any{
+				"opendatahub.io/accelerator-name": "missing",
+			}),
+		)
+
+		bundle, err := migrate.GenerateAcceleratorProfileMigration(t.Context(), c)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(bundle.Empty()).To(BeTrue())
+		g.Expect(bundle.Skipped).To(ConsistOf(manifest.Skipped{
+			Reference: "Notebook/user-ns/wb",
+			Reason:    "references AcceleratorProfile opendatahub/missing which does not exist",
+		}))
+	})
+}
+
+func TestAcceleratorProfilesCommand(t *testing.T) {
+	objects := func() []*unstructured.Unstructured {
+		return []*unstructured.Unstructured{
+			newDSCI(),
+			newAcceleratorProfile("nvidia-gpu"),
+			newWorkload(resources.Notebook, "user-ns", "wb", map[string]any{
+				"opendatahub.io/accelerator-name": "nvidia-gpu",
+			}),
+		}
+	}
+
+	t.Run("should print manifests to stdout by default", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var out, errOut bytes.Buffer
+
+		cmd := migrate.NewAcceleratorProfilesCommand(genericiooptions.IOStreams{Out: &out, ErrOut: &errOut})
+		cmd.Client = newFakeClient(objects()...)
+
+		g.Expect(cmd.Complete()).To(Succeed())
+		g.Expect(cmd.Validate()).To(Succeed())
+		g.Expect(cmd.Run(t.Context())).To(Succeed())
+
+		g.Expect(out.String()).To(ContainSubstring("kind: HardwareProfile"))
+		g.Expect(out.String()).To(ContainSubstring("# Merge patch for Notebook/user-ns/wb"))
+	})
+
+	t.Run("should write manifests to the output directory", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var out, errOut bytes.Buffer
+
+		dir := t.TempDir()
+
+		cmd := migrate.NewAcceleratorProfilesCommand(genericiooptions.IOStreams{Out: &out, ErrOut: &errOut})
+		cmd.Client = newFakeClient(objects()...)
+		cmd.OutputDir = dir
+
+		g.Expect(cmd.Run(t.Context())).To(Succeed())
+		g.Expect(out.String()).To(BeEmpty())
+		g.Expect(errOut.String()).To(ContainSubstring("Wrote 2 file(s)"))
+
+		g.Expect(filepath.Join(dir, applicationsNamespace,
+			"hardwareprofiles.infrastructure.opendatahub.io-nvidia-gpu-notebooks.yaml")).To(BeAnExistingFile())
+		g.Expect(filepath.Join(dir, manifest.PatchesDir, "user-ns",
+			"notebooks.kubeflow.org-wb.yaml")).To(BeAnExistingFile())
+	})
+
+	t.Run("should create HardwareProfiles and patch workloads with --apply", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var out, errOut bytes.Buffer
+
+		c := newFakeClient(objects()...)
+
+		cmd := migrate.NewAcceleratorProfilesCommand(genericiooptions.IOStreams{Out: &out, ErrOut: &errOut})
+		cmd.Client = c
+		cmd.Apply = true
+		cmd.Yes = true
+
+		g.Expect(cmd.Run(t.Context())).To(Succeed())
+
+		hwp, err := c.GetResource(t.Context(), resources.InfrastructureHardwareProfile, "nvidia-gpu-notebooks",
+			client.InNamespace(applicationsNamespace))
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(hwp.GetName()).To(Equal("nvidia-gpu-notebooks"))
+
+		nb, err := c.GetResource(t.Context(), resources.Notebook, "wb", client.InNamespace("user-ns"))
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(nb.GetAnnotations()).To(HaveKeyWithValue("opendatahub.io/hardware-profile-name", "nvidia-gpu-notebooks"))
+		g.Expect(nb.GetAnnotations()).To(HaveKeyWithValue("opendatahub.io/hardware-profile-namespace", applicationsNamespace))
+	})
+
+	t.Run("should report when nothing needs migration", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var out, errOut bytes.Buffer
+
+		cmd := migrate.NewAcceleratorProfilesCommand(genericiooptions.IOStreams{Out: &out, ErrOut: &errOut})
+		cmd.Client = newFakeClient(newDSCI())
+
+		g.Expect(cmd.Run(t.Context())).To(Succeed())
+		g.Expect(errOut.String()).To(ContainSubstring("No AcceleratorProfile references to migrate"))
+	})
+}
@@ -0,0 +1,245 @@
+package migrate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"github.com/spf13/pflag"
+
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/opendatahub-io/odh-cli/pkg/cmd"
+	"github.com/opendatahub-io/odh-cli/pkg/migrate/manifest"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+	"github.com/opendatahub-io/odh-cli/pkg/util/confirmation"
+)
+
+var _ cmd.Command = (*GenerateCommand)(nil)
+
+const (
+	msgGenerateSkipped   = "Warning: %s needs manual migration: %s"
+	msgGenerateNothing   = "No %s to migrate"
+	msgGenerateWritten   = "Wrote %d file(s) to %s"
+	msgGeneratePreview   = "About to create %d resource(s) and patch %d resource(s):"
+	msgGeneratePrompt    = "Apply these changes to the cluster?"
+	msgGenerateCancelled = "Cancelled; no changes were applied."
+	msgGenerateRollback  = "Wrote %d rollback patch(es) to %s"
+)
+
+// Generator builds the manifests of a migration from the cluster state.
+type Generator func(ctx context.Context, c client.Reader) (*manifest.Bundle, error)
+
+// GenerateCommand runs a Generator and renders its manifests to stdout or a directory, or
+// applies them to the cluster with --apply. Unlike the action-based commands, it never
+// modifies the cluster unless --apply is given.
+type GenerateCommand struct {
+	*SharedOptions
+
+	OutputDir   string
+	RollbackDir string
+	Apply       bool
+	DryRun      bool
+	Yes         bool
+
+	// Namespace restricts the migration to a namespace; empty selects all namespaces.
+	// Only used by generators that support it.
+	Namespace string
+
+	// subject names what is migrated in messages, e.g. "AcceleratorProfile references".
+	subject  string
+	generate Generator
+
+	// renderOnly disables --apply for migrations whose manifests replace existing resources
+	// and must be reviewed and applied by the user.
+	renderOnly bool
+
+	// diffOnDryRun renders --dry-run output as per-object diffs instead of the manifests.
+	diffOnDryRun bool
+
+	// rollback writes the rollback patches to RollbackDir before --apply changes the cluster.
+	rollback bool
+}
+
+// NewAcceleratorProfilesCommand creates the command migrating AcceleratorProfile references
+// to HardwareProfiles.
+func NewAcceleratorProfilesCommand(streams genericiooptions.IOStreams) *GenerateCommand {
+	return newGenerateCommand(streams, "AcceleratorProfile references", GenerateAcceleratorProfileMigration)
+}
+
+// NewModelMeshCommand creates the command rendering KServe RawDeployment manifests for ModelMesh
+// InferenceServices and ServingRuntimes. It never modifies the cluster.
+func NewModelMeshCommand(streams genericiooptions.IOStreams) *GenerateCommand {
+	c := newGenerateCommand(streams, "ModelMesh InferenceServices or ServingRuntimes", GenerateModelMeshMigration)
+	c.renderOnly = true
+
+	return c
+}
+
+// NewServerlessISVCCommand creates the command converting Serverless InferenceServices to
+// RawDeployment. --dry-run shows the change to each InferenceService as a diff.
+func NewServerlessISVCCommand(streams genericiooptions.IOStreams) *GenerateCommand {
+	c := newGenerateCommand(streams, "Serverless InferenceServices", GenerateServerlessISVCMigration)
+	c.diffOnDryRun = true
+
+	return c
+}
+
+// NewWorkbenchImagesCommand creates the command moving workbenches that run outdated images to
+// the 2025.2+ tags of their ImageStream. --dry-run shows the change to each Notebook as a diff
+// and --apply saves rollback patches before patching the Notebooks.
+func NewWorkbenchImagesCommand(streams genericiooptions.IOStreams) *GenerateCommand {
+	c := newGenerateCommand(streams, "workbench images", nil)
+	c.generate = func(ctx context.Context, r client.Reader) (*manifest.Bundle, error) {
+		return GenerateWorkbenchImageMigration(ctx, r, c.Namespace)
+	}
+	c.diffOnDryRun = true
+	c.rollback = true
+
+	return c
+}
+
+func newGenerateCommand(streams genericiooptions.IOStreams, subject string, generate Generator) *GenerateCommand {
+	return &GenerateCommand{
+		SharedOptions: NewSharedOptions(streams),
+		subject:       subject,
+		generate:      generate,
+	}
+}
+
+func (c *GenerateCommand) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&c.OutputDir, "output-dir", "", flagDescGenerateOutputDir)
+
+	if c.renderOnly {
+		fs.BoolVar(&c.DryRun, "dry-run", false, flagDescGenerateRenderOnlyDryRun)
+	} else {
+		fs.BoolVar(&c.Apply, "apply", false, flagDescGenerateApply)
+		fs.BoolVar(&c.DryRun, "dry-run", false, flagDescGenerateDryRun)
+		fs.BoolVarP(&c.Yes, "yes", "y", false, flagDescGenerateYes)
+	}
+
+	if c.rollback {
+		fs.StringVar(&c.RollbackDir, "rollback-dir", "", flagDescGenerateRollbackDir)
+	}
+
+	fs.DurationVar(&c.Timeout, "timeout", c.Timeout, flagDescGenerateTimeout)
+
+	// Throttling settings
+	fs.Float32Var(&c.QPS, "qps", c.QPS, "Kubernetes API QPS limit (queries per second)")
+	fs.IntVar(&c.Burst, "burst", c.Burst, "Kubernetes API burst capacity")
+}
+
+func (c *GenerateCommand) Complete() error {
+	if c.ConfigFlags != nil && c.ConfigFlags.Namespace != nil {
+		c.Namespace = *c.ConfigFlags.Namespace
+	}
+
+	if c.rollback && c.RollbackDir == "" {
+		timestamp := time.Now().Format("20060102-150405")
+		c.RollbackDir = filepath.Join(".", "rollback-migrate-"+timestamp)
+	}
+
+	// The client may already be set, e.g. by tests
+	if c.Client != nil {
+		return nil
+	}
+
+	if err := c.SharedOptions.Complete(); err != nil {
+		return fmt.Errorf("completing shared options: %w", err)
+	}
+
+	return nil
+}
+
+func (c *GenerateCommand) Validate() error {
+	if err := c.SharedOptions.Validate(); err != nil {
+		return fmt.Errorf("validating shared options: %w", err)
+	}
+
+	if c.renderOnly && c.Apply {
+		return errors.New("this migration does not support --apply; apply the generated manifests manually")
+	}
+
+	return nil
+}
+
+func (c *GenerateCommand) Run(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, c.Timeout)
+	defer cancel()
+
+	bundle, err := c.generate(ctx, c.Client)
+	if err != nil {
+		return fmt.Errorf("generating manifests: %w", err)
+	}
+
+	for _, s := range bundle.Skipped {
+		c.IO.Errorf(msgGenerateSkipped, s.Reference, s.Reason)
+	}
+
+	if bundle.Empty() {
+		c.IO.Errorf(msgGenerateNothing, c.subject)
+
+		return nil
+	}
+
+	switch {
+	case c.OutputDir != "":
+		paths, err := bundle.WriteDir(c.OutputDir)
+		if err != nil {
+			return fmt.Errorf("writing manifests: %w", err)
+		}
+
+		c.IO.Errorf(msgGenerateWritten, len(paths), c.OutputDir)
+	case c.DryRun && c.diffOnDryRun:
+		if err := bundle.WriteDiff(c.IO.Out()); err != nil {
+			return fmt.Errorf("writing diff: %w", err)
+		}
+	case !c.Apply:
+		if err := bundle.Write(c.IO.Out()); err != nil {
+			return fmt.Errorf("writing manifests: %w", err)
+		}
+	}
+
+	if !c.Apply {
+		return nil
+	}
+
+	return c.apply(ctx, bundle)
+}
+
+// apply previews the changes, asks for confirmation unless --yes or --dry-run is set, and
+// applies them.
+func (c *GenerateCommand) apply(ctx context.Context, bundle *manifest.Bundle) error {
+	c.IO.Errorf(msgGeneratePreview, len(bundle.Objects), len(bundle.Patches))
+
+	for _, o := range bundle.Objects {
+		c.IO.Errorf("  + %s", o.Reference())
+	}
+
+	for _, p := range bundle.Patches {
+		c.IO.Errorf("  ~ %s: %s", p.Reference(), p.Description)
+	}
+
+	if !c.Yes && !c.DryRun && !confirmation.Prompt(c.IO, msgGeneratePrompt) {
+		c.IO.Errorf(msgGenerateCancelled)
+
+		return nil
+	}
+
+	if c.rollback && !c.DryRun {
+		paths, err := bundle.WriteRollback(c.RollbackDir)
+		if err != nil {
+			return fmt.Errorf("writing rollback patches: %w", err)
+		}
+
+		c.IO.Errorf(msgGenerateRollback, len(paths), c.RollbackDir)
+	}
+
+	if err := bundle.Apply(ctx, c.Client, c.DryRun, c.IO.ErrOut()); err != nil {
+		return fmt.Errorf("applying manifests: %w", err)
+	}
+
+	return nil
+}
@@ -31,3 +31,16 @@ const (
 	flagDescPrepareTargetVersion = "Target version for migration (required)"
 	flagDescPreparePhase         = "Filter preparations by lifecycle phase (pre-upgrade|post-upgrade|pre-enablement)"
 )
+
+// Flag descriptions for the manifest-generating migrate commands.
+const (
+	flagDescGenerateTimeout   = "Operation timeout (e.g., 10m, 30m)"
+	flagDescGenerateOutputDir = "Write the manifests and patches to this directory instead of stdout"
+	flagDescGenerateApply     = "Create the generated resources and apply the patches to the cluster"
+	flagDescGenerateDryRun    = "Only render the changes; with --apply, validate them with a server-side dry run"
+	flagDescGenerateYes       = "Skip the confirmation prompt of --apply"
+
+	flagDescGenerateRollbackDir = "Directory the rollback patches are written to on --apply (default: ./rollback-migrate-<timestamp>/)"
+
+	flagDescGenerateRenderOnlyDryRun = "Only render the manifests (the default; this command never modifies the cluster)"
+)
@@ -0,0 +1,421 @@
+package manifest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	jsonpatch "github.com/evanphx/json-patch/v5"
+	"github.com/pmezard/go-difflib/difflib"
+	"sigs.k8s.io/yaml"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+)
+
+const (
+	// PatchesDir is the subdirectory of the output directory holding the patch files.
+	PatchesDir = "patches"
+
+	// RollbackDir is the subdirectory of the output directory holding the rollback patches.
+	RollbackDir = "rollback"
+
+	// FieldOwner is the field manager recorded for objects created or patched on --apply.
+	FieldOwner = "odh-cli-migrate"
+
+	dirPermissions  = 0o750
+	filePermissions = 0o600
+	diffContext     = 3
+)
+
+// Object is a manifest for a new resource.
+type Object struct {
+	ResourceType resources.ResourceType
+	Object       *unstructured.Unstructured
+}
+
+// Reference returns a kind/namespace/name reference suitable for display.
+func (o Object) Reference() string {
+	return reference(o.ResourceType.Kind, o.Object.GetNamespace(), o.Object.GetName())
+}
+
+// Patch is a JSON merge patch to an existing resource.
+type Patch struct {
+	// ResourceType identifies the kind of resource to patch.
+	ResourceType resources.ResourceType
+
+	// Namespace of the resource to patch. Empty for cluster-scoped resources.
+	Namespace string
+
+	// Name of the resource to patch.
+	Name string
+
+	// Patch is the merge patch document.
+	Patch map[string]any
+
+	// Description is a short human-readable summary of the change.
+	Description string
+
+	// Original is the current state of the resource. Optional; when set, WriteDiff renders
+	// the patch as a diff of the resource.
+	Original *unstructured.Unstructured
+
+	// Rollback is a merge patch restoring the fields the patch changes. Optional; when set,
+	// WriteDir and WriteRollback write it alongside the patch.
+	Rollback map[string]any
+}
+
+// Reference returns a kind/namespace/name reference suitable for display.
+func (p Patch) Reference() string {
+	return reference(p.ResourceType.Kind, p.Namespace, p.Name)
+}
+
+// Skipped records a resource the generator could not convert automatically.
+type Skipped struct {
+	// Reference identifies the resource as kind/namespace/name.
+	Reference string
+
+	// Reason explains why the resource needs manual attention.
+	Reason string
+}
+
+// Bundle is the output of a manifest generator: resources to create, patches to existing
+// resources and the resources that need manual attention.
+type Bundle struct {
+	Objects []Object
+	Patches []Patch
+	Skipped []Skipped
+}
+
+// AddObject appends a manifest for a new resource.
+func (b *Bundle) AddObject(rt resources.ResourceType, obj *unstructured.Unstructured) {
+	b.Objects = append(b.Objects, Object{ResourceType: rt, Object: obj})
+}
+
+// AddPatch appends a merge patch for an existing resource.
+func (b *Bundle) AddPatch(p Patch) {
+	b.Patches = append(b.Patches, p)
+}
+
+// Skip records a resource that could not be converted.
+func (b *Bundle) Skip(kind, namespace, name, reasonFormat string, args ...any) {
+	b.Skipped = append(b.Skipped, Skipped{
+		Reference: reference(kind, namespace, name),
+		Reason:    fmt.Sprintf(reasonFormat, args...),
+	})
+}
+
+// Empty reports whether the bundle contains no objects and no patches.
+func (b *Bundle) Empty() bool {
+	return len(b.Objects) == 0 && len(b.Patches) == 0
+}
+
+// Write renders the bundle as a YAML stream: new objects first, then each patch preceded by a
+// comment naming its target.
+func (b *Bundle) Write(w io.Writer) error {
+	for _, o := range b.Objects {
+		if err := writeDocument(w, "", o.Object.Object); err != nil {
+			return fmt.Errorf("writing %s: %w", o.Reference(), err)
+		}
+	}
+
+	for _, p := range b.Patches {
+		header := fmt.Sprintf("# Merge patch for %s: %s\n", p.Reference(), p.Description)
+		if err := writeDocument(w, header, p.Patch); err != nil {
+			return fmt.Errorf("writing patch for %s: %w", p.Reference(), err)
+		}
+	}
+
+	return nil
+}
+
+// WriteDiff renders the bundle as unified diffs: new objects as additions and patches with an
+// Original as the change they make to it. Patches without an Original are written like Write
+// does. Status and managed fields are left out of the diffs.
+func (b *Bundle) WriteDiff(w io.Writer) error {
+	for _, o := range b.Objects {
+		if err := writeDiff(w, o.Reference(), nil, o.Object.Object); err != nil {
+			return err
+		}
+	}
+
+	for _, p := range b.Patches {
+		if p.Original == nil {
+			header := fmt.Sprintf("# Merge patch for %s: %s\n", p.Reference(), p.Description)
+			if err := writeDocument(w, header, p.Patch); err != nil {
+				return fmt.Errorf("writing patch for %s: %w", p.Reference(), err)
+			}
+
+			continue
+		}
+
+		patched, err := p.Apply(p.Original)
+		if err != nil {
+			return err
+		}
+
+		if err := writeDiff(w, p.Reference(), p.Original.Object, patched.Object); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Apply returns a copy of obj with the merge patch applied.
+func (p Patch) Apply(obj *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+	doc, err := json.Marshal(obj.Object)
+	if err != nil {
+		return nil, fmt.Errorf("encoding %s: %w", p.Reference(), err)
+	}
+
+	data, err := json.Marshal(p.Patch)
+	if err != nil {
+		return nil, fmt.Errorf("encoding patch for %s: %w", p.Reference(), err)
+	}
+
+	merged, err := jsonpatch.MergePatch(doc, data)
+	if err != nil {
+		return nil, fmt.Errorf("applying patch to %s: %w", p.Reference(), err)
+	}
+
+	patched := &unstructured.Unstructured{}
+	if err := patched.UnmarshalJSON(merged); err != nil {
+		return nil, fmt.Errorf("decoding patched %s: %w", p.Reference(), err)
+	}
+
+	return patched, nil
+}
+
+// WriteDir writes every object to $dir/$namespace/$resource.$group-$name.yaml and every patch
+// to $dir/patches/$namespace/$resource.$group-$name.yaml, so patches can be applied with
+// `kubectl patch --type merge --patch-file`. Rollback patches are written like WriteRollback
+// does under $dir/rollback. Returns the written paths.
+func (b *Bundle) WriteDir(dir string) ([]string, error) {
+	paths := make([]string, 0, len(b.Objects)+len(b.Patches))
+
+	for _, o := range b.Objects {
+		path, err := writeFile(dir, o.ResourceType, o.Object.GetNamespace(), o.Object.GetName(), o.Object.Object)
+		if err != nil {
+			return paths, fmt.Errorf("writing %s: %w", o.Reference(), err)
+		}
+
+		paths = append(paths, path)
+	}
+
+	for _, p := range b.Patches {
+		path, err := writeFile(filepath.Join(dir, PatchesDir), p.ResourceType, p.Namespace, p.Name, p.Patch)
+		if err != nil {
+			return paths, fmt.Errorf("writing patch for %s: %w", p.Reference(), err)
+		}
+
+		paths = append(paths, path)
+	}
+
+	rollbacks, err := b.WriteRollback(filepath.Join(dir, RollbackDir))
+	paths = append(paths, rollbacks...)
+
+	return paths, err
+}
+
+// WriteRollback writes the rollback patch of every patch that has one to
+// $dir/$namespace/$resource.$group-$name.yaml. Returns the written paths.
+func (b *Bundle) WriteRollback(dir string) ([]string, error) {
+	var paths []string
+
+	for _, p := range b.Patches {
+		if p.Rollback == nil {
+			continue
+		}
+
+		path, err := writeFile(dir, p.ResourceType, p.Namespace, p.Name, p.Rollback)
+		if err != nil {
+			return paths, fmt.Errorf("writing rollback patch for %s: %w", p.Reference(), err)
+		}
+
+		paths = append(paths, path)
+	}
+
+	return paths, nil
+}
+
+// Apply creates the objects and patches the existing resources, optionally as a server-side
+// dry run. Objects that already exist are left untouched. Progress is written to out; failures
+// do not stop the remaining changes and are returned joined.
+func (b *Bundle) Apply(ctx context.Context, c client.Client, dryRun bool, out io.Writer) error {
+	var errs []error
+
+	createOpts := metav1.CreateOptions{FieldManager: FieldOwner}
+	if dryRun {
+		createOpts.DryRun = []string{metav1.DryRunAll}
+	}
+
+	for _, o := range b.Objects {
+		ri := c.Dynamic().Resource(o.ResourceType.GVR())
+
+		var err error
+		if ns := o.Object.GetNamespace(); ns != "" {
+			_, err = ri.Namespace(ns).Create(ctx, o.Object, createOpts)
+		} else {
+			_, err = ri.Create(ctx, o.Object, createOpts)
+		}
+
+		switch {
+		case apierrors.IsAlreadyExists(err):
+			_, _ = fmt.Fprintf(out, "  - %s: already exists, skipped\n", o.Reference())
+		case err != nil:
+			_, _ = fmt.Fprintf(out, "  - %s: create failed: %v\n", o.Reference(), err)
+			errs = append(errs, fmt.Errorf("creating %s: %w", o.Reference(), err))
+		default:
+			_, _ = fmt.Fprintf(out, "  - %s: created%s\n", o.Reference(), dryRunSuffix(dryRun))
+		}
+	}
+
+	for _, p := range b.Patches {
+		if err := applyPatch(ctx, c, p, dryRun); err != nil {
+			_, _ = fmt.Fprintf(out, "  - %s: patch failed: %v\n", p.Reference(), err)
+			errs = append(errs, err)
+
+			continue
+		}
+
+		_, _ = fmt.Fprintf(out, "  - %s: patched%s\n", p.Reference(), dryRunSuffix(dryRun))
+	}
+
+	return errors.Join(errs...)
+}
+
+func applyPatch(ctx context.Context, c client.Writer, p Patch, dryRun bool) error {
+	data, err := json.Marshal(p.Patch)
+	if err != nil {
+		return fmt.Errorf("encoding patch for %s: %w", p.Reference(), err)
+	}
+
+	opts := []client.PatchOption{client.WithFieldOwner(FieldOwner)}
+
+	if p.Namespace != "" {
+		opts = append(opts, client.PatchInNamespace(p.Namespace))
+	}
+
+	if dryRun {
+		opts = append(opts, client.WithDryRun())
+	}
+
+	if _, err := c.Patch(ctx, p.ResourceType, p.Name, types.MergePatchType, data, opts...); err != nil {
+		return fmt.Errorf("patching %s: %w", p.Reference(), err)
+	}
+
+	return nil
+}
+
+func dryRunSuffix(dryRun bool) string {
+	if dryRun {
+		return " (dry run)"
+	}
+
+	return ""
+}
+
+func reference(kind, namespace, name string) string {
+	if namespace == "" {
+		return kind + "/" + name
+	}
+
+	return fmt.Sprintf("%s/%s/%s", kind, namespace, name)
+}
+
+func writeDocument(w io.Writer, header string, content any) error {
+	data, err := yaml.Marshal(content)
+	if err != nil {
+		return fmt.Errorf("marshaling to YAML: %w", err)
+	}
+
+	if _, err := io.WriteString(w, "---\n"+header+string(data)); err != nil {
+		return fmt.Errorf("writing YAML: %w", err)
+	}
+
+	return nil
+}
+
+func writeDiff(w io.Writer, ref string, before, after map[string]any) error {
+	from, to := "/dev/null", ref+" (new)"
+
+	var a []string
+
+	if before != nil {
+		data, err := yaml.Marshal(withoutStatus(before))
+		if err != nil {
+			return fmt.Errorf("marshaling %s: %w", ref, err)
+		}
+
+		from, to = ref+" (current)", ref+" (migrated)"
+		a = difflib.SplitLines(string(data))
+	}
+
+	data, err := yaml.Marshal(withoutStatus(after))
+	if err != nil {
+		return fmt.Errorf("marshaling %s: %w", ref, err)
+	}
+
+	diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        a,
+		B:        difflib.SplitLines(string(data)),
+		FromFile: from,
+		ToFile:   to,
+		Context:  diffContext,
+	})
+	if err != nil {
+		return fmt.Errorf("computing %s diff: %w", ref, err)
+	}
+
+	if diff == "" {
+		diff = ref + ": no changes\n"
+	}
+
+	if _, err := io.WriteString(w, diff+"\n"); err != nil {
+		return fmt.Errorf("writing diff: %w", err)
+	}
+
+	return nil
+}
+
+// withoutStatus returns a copy of obj without status and managed fields.
+func withoutStatus(obj map[string]any) map[string]any {
+	u := (&unstructured.Unstructured{Object: obj}).DeepCopy()
+	unstructured.RemoveNestedField(u.Object, "status")
+	unstructured.RemoveNestedField(u.Object, "metadata", "managedFields")
+
+	return u.Object
+}
+
+func writeFile(dir string, rt resources.ResourceType, namespace, name string, content any) (string, error) {
+	if namespace == "" {
+		namespace = "cluster-scoped"
+	}
+
+	nsDir := filepath.Join(dir, namespace)
+	if err := os.MkdirAll(nsDir, dirPermissions); err != nil {
+		return "", fmt.Errorf("creating directory: %w", err)
+	}
+
+	data, err := yaml.Marshal(content)
+	if err != nil {
+		return "", fmt.Errorf("marshaling to YAML: %w", err)
+	}
+
+	path := filepath.Join(nsDir, fmt.Sprintf("%s-%s.yaml", rt.CRDFQN(), name))
+	if err := os.WriteFile(path, data, filePermissions); err != nil {
+		return "", fmt.Errorf("writing file: %w", err)
+	}
+
+	return path, nil
+}
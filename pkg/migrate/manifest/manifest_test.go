@@ -0,0 +1,134 @@
+package manifest_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/opendatahub-io/odh-cli/pkg/migrate/manifest"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+
+	. "github.com/onsi/gomega"
+)
+
+func newConfigMap(data map[string]any) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "v1",
+		"kind":       "ConfigMap",
+		"metadata":   map[string]any{"name": "cfg", "namespace": "ns"},
+		"data":       data,
+	}}
+}
+
+func newBundle() *manifest.Bundle {
+	b := &manifest.Bundle{}
+	b.AddObject(resources.ConfigMap, newConfigMap(map[string]any{"a": "1"}))
+	b.AddPatch(manifest.Patch{
+		ResourceType: resources.Notebook,
+		Namespace:    "ns",
+		Name:         "wb",
+		Patch:        map[string]any{"metadata": map[string]any{"annotations": map[string]any{"x": "y"}}},
+		Description:  "set x",
+	})
+
+	return b
+}
+
+func TestBundle_Write(t *testing.T) {
+	g := NewWithT(t)
+
+	var out bytes.Buffer
+
+	g.Expect(newBundle().Write(&out)).To(Succeed())
+	g.Expect(out.String()).To(Equal(`---
+apiVersion: v1
+data:
+  a: "1"
+kind: ConfigMap
+metadata:
+  name: cfg
+  namespace: ns
+---
+# Merge patch for Notebook/ns/wb: set x
+metadata:
+  annotations:
+    x: "y"
+`))
+}
+
+func TestBundle_WriteDir(t *testing.T) {
+	g := NewWithT(t)
+
+	dir := t.TempDir()
+
+	paths, err := newBundle().WriteDir(dir)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(paths).To(Equal([]string{
+		filepath.Join(dir, "ns", "configmaps-cfg.yaml"),
+		filepath.Join(dir, manifest.PatchesDir, "ns", "notebooks.kubeflow.org-wb.yaml"),
+	}))
+
+	data, err := os.ReadFile(paths[1])
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(string(data)).To(ContainSubstring(`x: "y"`))
+}
+
+func TestBundle_WriteDir_Rollback(t *testing.T) {
+	g := NewWithT(t)
+
+	dir := t.TempDir()
+
+	b := newBundle()
+	b.Patches[0].Rollback = map[string]any{"metadata": map[string]any{"annotations": map[string]any{"x": nil}}}
+
+	paths, err := b.WriteDir(dir)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(paths).To(HaveLen(3))
+	g.Expect(paths[2]).To(Equal(filepath.Join(dir, manifest.RollbackDir, "ns", "notebooks.kubeflow.org-wb.yaml")))
+
+	data, err := os.ReadFile(paths[2])
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(string(data)).To(ContainSubstring("x: null"))
+}
+
+func TestPatch_Apply(t *testing.T) {
+	g := NewWithT(t)
+
+	p := manifest.Patch{
+		ResourceType: resources.ConfigMap,
+		Namespace:    "ns",
+		Name:         "cfg",
+		Patch:        map[string]any{"data": map[string]any{"a": nil, "b": "2"}},
+	}
+
+	original := newConfigMap(map[string]any{"a": "1"})
+
+	patched, err := p.Apply(original)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(patched.Object["data"]).To(Equal(map[string]any{"b": "2"}))
+	g.Expect(original.Object["data"]).To(Equal(map[string]any{"a": "1"}))
+}
+
+func TestBundle_WriteDiff(t *testing.T) {
+	g := NewWithT(t)
+
+	b := newBundle()
+	b.AddPatch(manifest.Patch{
+		ResourceType: resources.ConfigMap,
+		Namespace:    "ns",
+		Name:         "cfg",
+		Patch:        map[string]any{"data": map[string]any{"a": "2"}},
+		Original:     newConfigMap(map[string]any{"a": "1"}),
+	})
+
+	var out bytes.Buffer
+
+	g.Expect(b.WriteDiff(&out)).To(Succeed())
+	g.Expect(out.String()).To(ContainSubstring("--- /dev/null\n+++ ConfigMap/ns/cfg (new)\n"))
+	g.Expect(out.String()).To(ContainSubstring("# Merge patch for Notebook/ns/wb: set x"))
+	g.Expect(out.String()).To(ContainSubstring("--- ConfigMap/ns/cfg (current)\n+++ ConfigMap/ns/cfg (migrated)\n"))
+	g.Expect(out.String()).To(ContainSubstring("-  a: \"1\"\n+  a: \"2\"\n"))
+}
@@ -0,0 +1,288 @@
+package migrate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/opendatahub-io/odh-cli/pkg/backup"
+	"github.com/opendatahub-io/odh-cli/pkg/migrate/manifest"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+	"github.com/opendatahub-io/odh-cli/pkg/util/jq"
+	"github.com/opendatahub-io/odh-cli/pkg/util/kube"
+)
+
+const (
+	annotationDeploymentMode = "serving.kserve.io/deploymentMode"
+	annotationEnableAuth     = "enable-auth"
+	annotationEnableRoute    = "enable-route"
+	annotationSecurityAuth   = "security.opendatahub.io/enable-auth"
+	annotationAPIProtocol    = "opendatahub.io/apiProtocol"
+	annotationMetricsPort    = "prometheus.kserve.io/port"
+	annotationMetricsPath    = "prometheus.kserve.io/path"
+	annotationLastApplied    = "kubectl.kubernetes.io/last-applied-configuration"
+	labelVisibility          = "networking.kserve.io/visibility"
+
+	deploymentModeModelMesh     = "ModelMesh"
+	deploymentModeRawDeployment = "RawDeployment"
+
+	// rawRuntimeSuffix names the single-model ServingRuntime generated from a ModelMesh one, so
+	// both can coexist while InferenceServices are moved over.
+	rawRuntimeSuffix = "-raw"
+
+	// adapterOVMS is the only ModelMesh built-in adapter with a single-model equivalent shipped
+	// for RawDeployment.
+	adapterOVMS = "ovms"
+
+	ovmsRESTPort = 8888
+)
+
+// modelMeshStripFields are the fields dropped from a ModelMesh InferenceService on top of the
+// cluster-specific ones, since the converted manifest replaces the object.
+//
+//nolint:gochecknoglobals // Constant-like list used by the generator.
+var modelMeshStripFields = append(slices.Clone(backup.DefaultStripFields), ".metadata.finalizers")
+
+// ovmsRawArgs are the arguments of the OpenVINO Model Server in single-model mode, as used by
+// the kserve-ovms runtime template.
+//
+//nolint:gochecknoglobals // Constant-like list used by the generator.
+var ovmsRawArgs = []any{
+	"--model_name={{.Name}}",
+	"--port=8001",
+	"--rest_port=" + strconv.Itoa(ovmsRESTPort),
+	"--model_path=/mnt/models",
+	"--file_system_poll_wait_seconds=0",
+	"--grpc_bind_address=0.0.0.0",
+	"--rest_bind_address=0.0.0.0",
+	"--target_device=AUTO",
+	"--metrics_enable",
+}
+
+// GenerateModelMeshMigration builds KServe RawDeployment manifests for the ModelMesh
+// InferenceServices and multi-model ServingRuntimes flagged by the KServe impacted-workloads
+// check. Each multi-model ServingRuntime is converted into a single-model <name>-raw runtime,
+// and each InferenceService into a manifest with the RawDeployment mode pointing at it. Cases
+// with no mechanical equivalent, such as runtimes using a custom adapter or InferenceServices
+// relying on runtime auto-selection, are reported as skipped.
+//
+// The InferenceService manifests keep their names: the deployment mode of an existing
+// InferenceService cannot change, so they replace the ModelMesh objects (kubectl replace --force).
+func GenerateModelMeshMigration(ctx context.Context, c client.Reader) (*manifest.Bundle, error) {
+	bundle := &manifest.Bundle{}
+
+	runtimes, err := client.List[*unstructured.Unstructured](
+		ctx, c, resources.ServingRuntime, jq.Predicate(".spec.multiModel == true"),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("listing ServingRuntimes: %w", err)
+	}
+
+	existing, err := kube.BuildResourceNameSet(ctx, c, resources.ServingRuntime)
+	if err != nil {
+		return nil, fmt.Errorf("building ServingRuntime cache: %w", err)
+	}
+
+	// converted maps each multi-model runtime to its single-model runtime, or to nil when it
+	// could not be converted.
+	converted := make(map[types.NamespacedName]*unstructured.Unstructured, len(runtimes))
+
+	for _, sr := range runtimes {
+		ref := types.NamespacedName{Namespace: sr.GetNamespace(), Name: sr.GetName()}
+
+		raw, reason, err := newRawServingRuntime(sr)
+		if err != nil {
+			return nil, fmt.Errorf("converting ServingRuntime %s: %w", ref, err)
+		}
+
+		if raw == nil {
+			bundle.Skip(resources.ServingRuntime.Kind, ref.Namespace, ref.Name, "%s", reason)
+			converted[ref] = nil
+
+			continue
+		}
+
+		converted[ref] = raw
+
+		if !existing.Has(types.NamespacedName{Namespace: raw.GetNamespace(), Name: raw.GetName()}) {
+			bundle.AddObject(resources.ServingRuntime, raw)
+		}
+	}
+
+	isvcs, err := client.List[*unstructured.Unstructured](ctx, c, resources.InferenceService, isModelMeshISVC)
+	if err != nil {
+		return nil, fmt.Errorf("listing InferenceServices: %w", err)
+	}
+
+	for _, isvc := range isvcs {
+		raw, reason, err := newRawInferenceService(isvc, converted)
+		if err != nil {
+			return nil, fmt.Errorf("converting InferenceService %s/%s: %w", isvc.GetNamespace(), isvc.GetName(), err)
+		}
+
+		if raw == nil {
+			bundle.Skip(resources.InferenceService.Kind, isvc.GetNamespace(), isvc.GetName(), "%s", reason)
+
+			continue
+		}
+
+		bundle.AddObject(resources.InferenceService, raw)
+	}
+
+	return bundle, nil
+}
+
+func isModelMeshISVC(obj *unstructured.Unstructured) (bool, error) {
+	return kube.GetAnnotation(obj, annotationDeploymentMode) == deploymentModeModelMesh, nil
+}
+
+// newRawServingRuntime converts a multi-model ServingRuntime into a single-model one. It returns
+// a nil runtime and the reason when the runtime cannot be converted.
+func newRawServingRuntime(sr *unstructured.Unstructured) (*unstructured.Unstructured, string, error) {
+	adapter, err := jq.Query[string](sr, ".spec.builtInAdapter.serverType")
+	if err != nil && !errors.Is(err, jq.ErrNotFound) {
+		return nil, "", fmt.Errorf("querying .spec.builtInAdapter.serverType: %w", err)
+	}
+
+	switch adapter {
+	case adapterOVMS:
+	case "":
+		return nil, "uses a custom ModelMesh adapter; create a single-model ServingRuntime manually", nil
+	default:
+		return nil, fmt.Sprintf("uses the %s ModelMesh adapter, which has no RawDeployment equivalent; "+
+			"create a single-model ServingRuntime manually", adapter), nil
+	}
+
+	srSpec, _, err := unstructured.NestedMap(sr.Object, "spec")
+	if err != nil {
+		return nil, "", fmt.Errorf("reading .spec: %w", err)
+	}
+
+	containers, _, err := unstructured.NestedSlice(srSpec, "containers")
+	if err != nil {
+		return nil, "", fmt.Errorf("reading .spec.containers: %w", err)
+	}
+
+	if len(containers) != 1 {
+		return nil, fmt.Sprintf("has %d containers; a single-model runtime needs exactly one", len(containers)), nil
+	}
+
+	server, ok := containers[0].(map[string]any)
+	if !ok {
+		return nil, "", errors.New(".spec.containers[0] is not an object")
+	}
+
+	container := map[string]any{
+		"name":  "kserve-container",
+		"image": server["image"],
+		"args":  ovmsRawArgs,
+		"ports": []any{
+			map[string]any{"containerPort": int64(ovmsRESTPort), "protocol": "TCP"},
+		},
+	}
+
+	if res, ok := server["resources"]; ok {
+		container["resources"] = res
+	}
+
+	spec := map[string]any{
+		"multiModel":       false,
+		"protocolVersions": []any{"v2", "grpc-v2"},
+		"containers":       []any{container},
+	}
+
+	for _, field := range []string{"supportedModelFormats", "tolerations", "nodeSelector", "affinity", "volumes"} {
+		if v, ok := srSpec[field]; ok {
+			spec[field] = v
+		}
+	}
+
+	raw := &unstructured.Unstructured{Object: map[string]any{"spec": spec}}
+	raw.SetAPIVersion(resources.ServingRuntime.APIVersion())
+	raw.SetKind(resources.ServingRuntime.Kind)
+	raw.SetNamespace(sr.GetNamespace())
+	raw.SetName(sr.GetName() + rawRuntimeSuffix)
+	raw.SetLabels(sr.GetLabels())
+
+	annotations := maps.Clone(sr.GetAnnotations())
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+
+	delete(annotations, annotationLastApplied)
+	annotations[annotationAPIProtocol] = "REST"
+	annotations[annotationMetricsPort] = strconv.Itoa(ovmsRESTPort)
+	annotations[annotationMetricsPath] = "/metrics"
+	raw.SetAnnotations(annotations)
+
+	return raw, "", nil
+}
+
+// newRawInferenceService converts a ModelMesh InferenceService into a RawDeployment one served by
+// the converted runtime. It returns a nil object and the reason when it cannot be converted.
+func newRawInferenceService(
+	isvc *unstructured.Unstructured,
+	converted map[types.NamespacedName]*unstructured.Unstructured,
+) (*unstructured.Unstructured, string, error) {
+	runtimeName, err := jq.Query[string](isvc, ".spec.predictor.model.runtime")
+
+	switch {
+	case errors.Is(err, jq.ErrNotFound):
+		return nil, "does not name a ServingRuntime (.spec.predictor.model.runtime); " +
+			"set it explicitly and rerun", nil
+	case err != nil:
+		return nil, "", fmt.Errorf("querying .spec.predictor.model.runtime: %w", err)
+	}
+
+	runtimeRef := types.NamespacedName{Namespace: isvc.GetNamespace(), Name: runtimeName}
+
+	runtime, ok := converted[runtimeRef]
+	if !ok {
+		return nil, fmt.Sprintf("references ServingRuntime %s, which is not a ModelMesh runtime", runtimeRef), nil
+	}
+
+	if runtime == nil {
+		return nil, fmt.Sprintf("references ServingRuntime %s, which could not be converted", runtimeRef), nil
+	}
+
+	raw, err := kube.StripFields(isvc, modelMeshStripFields)
+	if err != nil {
+		return nil, "", fmt.Errorf("stripping cluster-specific fields: %w", err)
+	}
+
+	if err := unstructured.SetNestedField(raw.Object, runtime.GetName(), "spec", "predictor", "model", "runtime"); err != nil {
+		return nil, "", fmt.Errorf("setting runtime: %w", err)
+	}
+
+	annotations := raw.GetAnnotations()
+	annotations[annotationDeploymentMode] = deploymentModeRawDeployment
+
+	// ModelMesh configures token auth and external routes on the runtime, RawDeployment on the
+	// InferenceService.
+	runtimeAnnotations := runtime.GetAnnotations()
+
+	if runtimeAnnotations[annotationEnableAuth] == "true" {
+		annotations[annotationSecurityAuth] = "true"
+	}
+
+	raw.SetAnnotations(annotations)
+
+	if runtimeAnnotations[annotationEnableRoute] == "true" {
+		labels := raw.GetLabels()
+		if labels == nil {
+			labels = map[string]string{}
+		}
+
+		labels[labelVisibility] = "exposed"
+		raw.SetLabels(labels)
+	}
+
+	return raw, "", nil
+}
@@ -0,0 +1,199 @@
+package migrate_test
+
+import (
+	"bytes"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/opendatahub-io/odh-cli/pkg/migrate"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+
+	. "github.com/onsi/gomega"
+)
+
+func newModelMeshRuntime(name, adapter string, annotations map[string]any) *unstructured.Unstructured {
+	spec := map[string]any{
+		"multiModel":            true,
+		"supportedModelFormats": []any{map[string]any{"name": "onnx", "version": "1"}},
+		"containers": []any{
+			map[string]any{
+				"name":  "ovms",
+				"image": "quay.io/modh/openvino_model_server:stable",
+				"args":  []any{"--port=8001", "--rest_port=8888"},
+			},
+		},
+	}
+
+	if adapter != "" {
+		spec["builtInAdapter"] = map[string]any{"serverType": adapter}
+	}
+
+	return &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": resources.ServingRuntime.APIVersion(),
+		"kind":       resources.ServingRuntime.Kind,
+		"metadata": map[string]any{
+			"name":        name,
+			"namespace":   "user-ns",
+			"annotations": annotations,
+		},
+		"spec": spec,
+	}}
+}
+
+func newModelMeshISVC(name, runtime string) *unstructured.Unstructured {
+	model := map[string]any{
+		"modelFormat": map[string]any{"name": "onnx"},
+		"storage":     map[string]any{"key": "aws-connection", "path": "models/" + name},
+	}
+
+	if runtime != "" {
+		model["runtime"] = runtime
+	}
+
+	return &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": resources.InferenceService.APIVersion(),
+		"kind":       resources.InferenceService.Kind,
+		"metadata": map[string]any{
+			"name":            name,
+			"namespace":       "user-ns",
+			"resourceVersion": "42",
+			"uid":             "0000-1111",
+			"finalizers":      []any{"inferenceservice.finalizers"},
+			"annotations":     map[string]any{"serving.kserve.io/deploymentMode": "ModelMesh"},
+		},
+		"spec":   map[string]any{"predictor": map[string]any{"model": model}},
+		"status": map[string]any{"url": "grpc://modelmesh-serving.user-ns:8033"},
+	}}
+}
+
+func TestGenerateModelMeshMigration(t *testing.T) {
+	t.Run("should convert OVMS runtimes and their InferenceServices", func(t *testing.T) {
+		g := NewWithT(t)
+
+		c := newFakeClient(
+			newModelMeshRuntime("ovms", "ovms", map[string]any{"enable-auth": "true", "enable-route": "true"}),
+			newModelMeshISVC("model", "ovms"),
+		)
+
+		bundle, err := migrate.GenerateModelMeshMigration(t.Context(), c)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(bundle.Skipped).To(BeEmpty())
+		g.Expect(bundle.Patches).To(BeEmpty())
+		g.Expect(bundle.Objects).To(HaveLen(2))
+
+		sr := bundle.Objects[0].Object
+		g.Expect(sr.GetKind()).To(Equal("ServingRuntime"))
+		g.Expect(sr.GetName()).To(Equal("ovms-raw"))
+
+		multiModel, _, _ := unstructured.NestedBool(sr.Object, "spec", "multiModel")
+		g.Expect(multiModel).To(BeFalse())
+
+		_, found, _ := unstructured.NestedMap(sr.Object, "spec", "builtInAdapter")
+		g.Expect(found).To(BeFalse())
+
+		containers, _, _ := unstructured.NestedSlice(sr.Object, "spec", "containers")
+		g.Expect(containers).To(HaveLen(1))
+		g.Expect(containers[0]).To(HaveKeyWithValue("args", ContainElement("--model_path=/mnt/models")))
+
+		isvc := bundle.Objects[1].Object
+		g.Expect(isvc.GetName()).To(Equal("model"))
+		g.Expect(isvc.GetResourceVersion()).To(BeEmpty())
+		g.Expect(isvc.GetUID()).To(BeEmpty())
+		g.Expect(isvc.GetFinalizers()).To(BeEmpty())
+		g.Expect(isvc.Object).ToNot(HaveKey("status"))
+		g.Expect(isvc.GetAnnotations()).To(HaveKeyWithValue("serving.kserve.io/deploymentMode", "RawDeployment"))
+		g.Expect(isvc.GetAnnotations()).To(HaveKeyWithValue("security.opendatahub.io/enable-auth", "true"))
+		g.Expect(isvc.GetLabels()).To(HaveKeyWithValue("networking.kserve.io/visibility", "exposed"))
+
+		runtime, _, _ := unstructured.NestedString(isvc.Object, "spec", "predictor", "model", "runtime")
+		g.Expect(runtime).To(Equal("ovms-raw"))
+
+		storageKey, _, _ := unstructured.NestedString(isvc.Object, "spec", "predictor", "model", "storage", "key")
+		g.Expect(storageKey).To(Equal("aws-connection"))
+	})
+
+	t.Run("should flag runtimes without a RawDeployment equivalent and their InferenceServices", func(t *testing.T) {
+		g := NewWithT(t)
+
+		c := newFakeClient(
+			newModelMeshRuntime("triton", "triton", nil),
+			newModelMeshRuntime("custom", "", nil),
+			newModelMeshISVC("model", "triton"),
+		)
+
+		bundle, err := migrate.GenerateModelMeshMigration(t.Context(), c)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(bundle.Empty()).To(BeTrue())
+		g.Expect(bundle.Skipped).To(HaveLen(3))
+		g.Expect(bundle.Skipped[0].Reference).To(Equal("ServingRuntime/user-ns/custom"))
+		g.Expect(bundle.Skipped[0].Reason).To(ContainSubstring("custom ModelMesh adapter"))
+		g.Expect(bundle.Skipped[1].Reference).To(Equal("ServingRuntime/user-ns/triton"))
+		g.Expect(bundle.Skipped[1].Reason).To(ContainSubstring("triton ModelMesh adapter"))
+		g.Expect(bundle.Skipped[2].Reference).To(Equal("InferenceService/user-ns/model"))
+		g.Expect(bundle.Skipped[2].Reason).To(ContainSubstring("could not be converted"))
+	})
+
+	t.Run("should flag InferenceServices relying on runtime auto-selection", func(t *testing.T) {
+		g := NewWithT(t)
+
+		c := newFakeClient(newModelMeshISVC("model", ""))
+
+		bundle, err := migrate.GenerateModelMeshMigration(t.Context(), c)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(bundle.Empty()).To(BeTrue())
+		g.Expect(bundle.Skipped).To(HaveLen(1))
+		g.Expect(bundle.Skipped[0].Reason).To(ContainSubstring("does not name a ServingRuntime"))
+	})
+
+	t.Run("should not regenerate an existing converted runtime", func(t *testing.T) {
+		g := NewWithT(t)
+
+		existing := newModelMeshRuntime("ovms-raw", "", nil)
+		unstructured.RemoveNestedField(existing.Object, "spec", "multiModel")
+
+		c := newFakeClient(
+			newModelMeshRuntime("ovms", "ovms", nil),
+			existing,
+			newModelMeshISVC("model", "ovms"),
+		)
+
+		bundle, err := migrate.GenerateModelMeshMigration(t.Context(), c)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(bundle.Objects).To(HaveLen(1))
+		g.Expect(bundle.Objects[0].Object.GetKind()).To(Equal("InferenceService"))
+	})
+}
+
+func TestModelMeshCommand(t *testing.T) {
+	t.Run("should reject --apply", func(t *testing.T) {
+		g := NewWithT(t)
+
+		cmd := migrate.NewModelMeshCommand(genericiooptions.IOStreams{})
+		cmd.Apply = true
+
+		err := cmd.Validate()
+		g.Expect(err).To(HaveOccurred())
+		g.Expect(err.Error()).To(ContainSubstring("--apply"))
+	})
+
+	t.Run("should report skipped workloads and print manifests", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var out, errOut bytes.Buffer
+
+		cmd := migrate.NewModelMeshCommand(genericiooptions.IOStreams{Out: &out, ErrOut: &errOut})
+		cmd.Client = newFakeClient(
+			newModelMeshRuntime("ovms", "ovms", nil),
+			newModelMeshISVC("model", "ovms"),
+			newModelMeshISVC("auto", ""),
+		)
+		cmd.DryRun = true
+
+		g.Expect(cmd.Run(t.Context())).To(Succeed())
+		g.Expect(out.String()).To(ContainSubstring("name: ovms-raw"))
+		g.Expect(out.String()).To(ContainSubstring("serving.kserve.io/deploymentMode: RawDeployment"))
+		g.Expect(errOut.String()).To(ContainSubstring("InferenceService/user-ns/auto needs manual migration"))
+	})
+}
@@ -0,0 +1,196 @@
+package migrate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/opendatahub-io/odh-cli/pkg/migrate/manifest"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+	"github.com/opendatahub-io/odh-cli/pkg/util/kube"
+)
+
+const (
+	deploymentModeServerless = "Serverless"
+
+	annotationAutoscalerClass = "serving.kserve.io/autoscalerClass"
+	autoscalerClassHPA        = "hpa"
+
+	// knativeAutoscalingPrefix is the prefix of the annotations read only by the Knative Pod
+	// Autoscaler.
+	knativeAutoscalingPrefix = "autoscaling.knative.dev/"
+)
+
+// knativeReplicaFields maps the Knative autoscaling annotations (without prefix) holding an
+// integer to the predictor field RawDeployment reads instead.
+//
+//nolint:gochecknoglobals // Constant-like lookup table used by the generator.
+var knativeReplicaFields = map[string]string{
+	"min-scale": "minReplicas",
+	"minScale":  "minReplicas",
+	"max-scale": "maxReplicas",
+	"maxScale":  "maxReplicas",
+	"target":    "scaleTarget",
+}
+
+// rawScaleMetrics are the Knative autoscaling metrics the HPA used by RawDeployment supports.
+//
+//nolint:gochecknoglobals // Constant-like list used by the generator.
+var rawScaleMetrics = []string{"cpu", "memory"}
+
+// GenerateServerlessISVCMigration builds the merge patches converting Serverless
+// InferenceServices to RawDeployment: the deployment mode annotation is switched, and the
+// Knative autoscaling annotations are replaced by the predictor fields and autoscaler class
+// RawDeployment honours. Explicit predictor fields win over annotations. Settings without an
+// equivalent, such as scale to zero or the concurrency metric, are dropped and reported so
+// they can be reviewed.
+func GenerateServerlessISVCMigration(ctx context.Context, c client.Reader) (*manifest.Bundle, error) {
+	isvcs, err := client.List[*unstructured.Unstructured](ctx, c, resources.InferenceService, isServerlessISVC)
+	if err != nil {
+		return nil, fmt.Errorf("listing InferenceServices: %w", err)
+	}
+
+	bundle := &manifest.Bundle{}
+
+	for _, isvc := range isvcs {
+		patch, err := newServerlessISVCPatch(bundle, isvc)
+		if err != nil {
+			return nil, fmt.Errorf("converting InferenceService %s/%s: %w", isvc.GetNamespace(), isvc.GetName(), err)
+		}
+
+		bundle.AddPatch(patch)
+	}
+
+	return bundle, nil
+}
+
+func isServerlessISVC(obj *unstructured.Unstructured) (bool, error) {
+	return kube.GetAnnotation(obj, annotationDeploymentMode) == deploymentModeServerless, nil
+}
+
+// newServerlessISVCPatch builds the patch of a single InferenceService, recording the settings
+// that could not be carried over in the bundle.
+func newServerlessISVCPatch(bundle *manifest.Bundle, isvc *unstructured.Unstructured) (manifest.Patch, error) {
+	ns, name := isvc.GetNamespace(), isvc.GetName()
+	note := func(format string, args ...any) {
+		bundle.Skip(resources.InferenceService.Kind, ns, name, format, args...)
+	}
+
+	predictor, _, err := unstructured.NestedMap(isvc.Object, "spec", "predictor")
+	if err != nil {
+		return manifest.Patch{}, fmt.Errorf("reading .spec.predictor: %w", err)
+	}
+
+	annotations := map[string]any{annotationDeploymentMode: deploymentModeRawDeployment}
+	predictorPatch := map[string]any{}
+	changes := []string{"deploymentMode " + deploymentModeServerless + " → " + deploymentModeRawDeployment}
+
+	isvcAnnotations := isvc.GetAnnotations()
+
+	var keys []string
+
+	for k := range isvcAnnotations {
+		if strings.HasPrefix(k, knativeAutoscalingPrefix) {
+			keys = append(keys, k)
+		}
+	}
+
+	slices.Sort(keys)
+
+	for _, key := range keys {
+		value := isvcAnnotations[key]
+		setting := strings.TrimPrefix(key, knativeAutoscalingPrefix)
+		annotations[key] = nil
+
+		field, isReplicaField := knativeReplicaFields[setting]
+
+		switch {
+		case isReplicaField:
+			if _, ok := predictor[field]; ok {
+				note("%s=%s dropped in favour of .spec.predictor.%s", key, value, field)
+
+				continue
+			}
+
+			n, err := strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				note("%s=%s is not an integer and is dropped", key, value)
+
+				continue
+			}
+
+			predictorPatch[field] = n
+			changes = append(changes, setting+" → "+field)
+		case setting == "metric":
+			if _, ok := predictor["scaleMetric"]; ok {
+				note("%s=%s dropped in favour of .spec.predictor.scaleMetric", key, value)
+
+				continue
+			}
+
+			if !slices.Contains(rawScaleMetrics, value) {
+				note("%s=%s has no RawDeployment equivalent (HPA supports cpu and memory); "+
+					"configure KEDA to scale on it", key, value)
+
+				continue
+			}
+
+			predictorPatch["scaleMetric"] = value
+			changes = append(changes, "metric → scaleMetric")
+		case setting == "class":
+			annotations[annotationAutoscalerClass] = autoscalerClassHPA
+			changes = append(changes, "class → "+annotationAutoscalerClass)
+		default:
+			note("%s has no RawDeployment equivalent and is dropped", key)
+		}
+	}
+
+	if err := clampMinReplicas(predictor, predictorPatch, note); err != nil {
+		return manifest.Patch{}, err
+	}
+
+	patch := map[string]any{"metadata": map[string]any{"annotations": annotations}}
+	if len(predictorPatch) > 0 {
+		patch["spec"] = map[string]any{"predictor": predictorPatch}
+	}
+
+	return manifest.Patch{
+		ResourceType: resources.InferenceService,
+		Namespace:    ns,
+		Name:         name,
+		Patch:        patch,
+		Description:  strings.Join(changes, ", "),
+		Original:     isvc,
+	}, nil
+}
+
+// clampMinReplicas raises a minReplicas of 0, set in the spec or mapped from an annotation, to 1
+// since RawDeployment cannot scale to zero.
+func clampMinReplicas(predictor, predictorPatch map[string]any, note func(string, ...any)) error {
+	minReplicas, ok := predictorPatch["minReplicas"]
+	if !ok {
+		minReplicas, ok = predictor["minReplicas"]
+	}
+
+	if !ok {
+		return nil
+	}
+
+	n, ok := minReplicas.(int64)
+	if !ok {
+		return errors.New(".spec.predictor.minReplicas is not an integer")
+	}
+
+	if n == 0 {
+		predictorPatch["minReplicas"] = int64(1)
+		note("scale to zero is not supported by RawDeployment; minReplicas set to 1")
+	}
+
+	return nil
+}
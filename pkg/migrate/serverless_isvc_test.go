@@ -0,0 +1,161 @@
+package migrate_test
+
+import (
+	"bytes"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/opendatahub-io/odh-cli/pkg/migrate"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+
+	. "github.com/onsi/gomega"
+)
+
+func newServerlessISVC(name string, annotations map[string]any, predictor map[string]any) *unstructured.Unstructured {
+	annotations["serving.kserve.io/deploymentMode"] = "Serverless"
+
+	if predictor == nil {
+		predictor = map[string]any{}
+	}
+
+	predictor["model"] = map[string]any{"modelFormat": map[string]any{"name": "sklearn"}}
+
+	return &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": resources.InferenceService.APIVersion(),
+		"kind":       resources.InferenceService.Kind,
+		"metadata": map[string]any{
+			"name":        name,
+			"namespace":   "user-ns",
+			"annotations": annotations,
+		},
+		"spec": map[string]any{"predictor": predictor},
+	}}
+}
+
+func TestGenerateServerlessISVCMigration(t *testing.T) {
+	t.Run("should map Knative autoscaling annotations to predictor fields", func(t *testing.T) {
+		g := NewWithT(t)
+
+		c := newFakeClient(newServerlessISVC("model", map[string]any{
+			"autoscaling.knative.dev/min-scale": "2",
+			"autoscaling.knative.dev/max-scale": "5",
+			"autoscaling.knative.dev/target":    "80",
+			"autoscaling.knative.dev/metric":    "cpu",
+			"autoscaling.knative.dev/class":     "hpa.autoscaling.knative.dev",
+		}, nil))
+
+		bundle, err := migrate.GenerateServerlessISVCMigration(t.Context(), c)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(bundle.Skipped).To(BeEmpty())
+		g.Expect(bundle.Patches).To(HaveLen(1))
+
+		patch := bundle.Patches[0].Patch
+		g.Expect(patch).To(HaveKeyWithValue("metadata", HaveKeyWithValue("annotations", And(
+			HaveKeyWithValue("serving.kserve.io/deploymentMode", "RawDeployment"),
+			HaveKeyWithValue("serving.kserve.io/autoscalerClass", "hpa"),
+			HaveKeyWithValue("autoscaling.knative.dev/min-scale", BeNil()),
+		))))
+		g.Expect(patch).To(HaveKeyWithValue("spec", HaveKeyWithValue("predictor", And(
+			HaveKeyWithValue("minReplicas", int64(2)),
+			HaveKeyWithValue("maxReplicas", int64(5)),
+			HaveKeyWithValue("scaleTarget", int64(80)),
+			HaveKeyWithValue("scaleMetric", "cpu"),
+		))))
+	})
+
+	t.Run("should report settings without a RawDeployment equivalent", func(t *testing.T) {
+		g := NewWithT(t)
+
+		c := newFakeClient(newServerlessISVC("model", map[string]any{
+			"autoscaling.knative.dev/metric": "concurrency",
+			"autoscaling.knative.dev/window": "60s",
+		}, map[string]any{"minReplicas": int64(0)}))
+
+		bundle, err := migrate.GenerateServerlessISVCMigration(t.Context(), c)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(bundle.Patches).To(HaveLen(1))
+		g.Expect(bundle.Patches[0].Patch).To(HaveKeyWithValue("spec",
+			HaveKeyWithValue("predictor", HaveKeyWithValue("minReplicas", int64(1)))))
+
+		g.Expect(bundle.Skipped).To(HaveLen(3))
+		g.Expect(bundle.Skipped[0].Reason).To(ContainSubstring("configure KEDA"))
+		g.Expect(bundle.Skipped[1].Reason).To(ContainSubstring("window has no RawDeployment equivalent"))
+		g.Expect(bundle.Skipped[2].Reason).To(ContainSubstring("scale to zero"))
+	})
+
+	t.Run("should prefer predictor fields over annotations", func(t *testing.T) {
+		g := NewWithT(t)
+
+		c := newFakeClient(newServerlessISVC("model", map[string]any{
+			"autoscaling.knative.dev/max-scale": "5",
+		}, map[string]any{"maxReplicas": int64(3)}))
+
+		bundle, err := migrate.GenerateServerlessISVCMigration(t.Context(), c)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(bundle.Patches).To(HaveLen(1))
+		g.Expect(bundle.Patches[0].Patch).ToNot(HaveKey("spec"))
+		g.Expect(bundle.Skipped).To(HaveLen(1))
+		g.Expect(bundle.Skipped[0].Reason).To(ContainSubstring("in favour of .spec.predictor.maxReplicas"))
+	})
+
+	t.Run("should ignore InferenceServices not in Serverless mode", func(t *testing.T) {
+		g := NewWithT(t)
+
+		isvc := newServerlessISVC("model", map[string]any{}, nil)
+		isvc.SetAnnotations(map[string]string{"serving.kserve.io/deploymentMode": "RawDeployment"})
+
+		bundle, err := migrate.GenerateServerlessISVCMigration(t.Context(), newFakeClient(isvc))
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(bundle.Empty()).To(BeTrue())
+	})
+}
+
+func TestServerlessISVCCommand(t *testing.T) {
+	objects := func() []*unstructured.Unstructured {
+		return []*unstructured.Unstructured{
+			newServerlessISVC("model", map[string]any{"autoscaling.knative.dev/min-scale": "2"}, nil),
+		}
+	}
+
+	t.Run("should show a diff per InferenceService with --dry-run", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var out, errOut bytes.Buffer
+
+		cmd := migrate.NewServerlessISVCCommand(genericiooptions.IOStreams{Out: &out, ErrOut: &errOut})
+		cmd.Client = newFakeClient(objects()...)
+		cmd.DryRun = true
+
+		g.Expect(cmd.Run(t.Context())).To(Succeed())
+		g.Expect(out.String()).To(ContainSubstring("--- InferenceService/user-ns/model (current)"))
+		g.Expect(out.String()).To(ContainSubstring("-    serving.kserve.io/deploymentMode: Serverless"))
+		g.Expect(out.String()).To(ContainSubstring("+    serving.kserve.io/deploymentMode: RawDeployment"))
+		g.Expect(out.String()).To(ContainSubstring("+    minReplicas: 2"))
+	})
+
+	t.Run("should patch the InferenceServices with --apply", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var out, errOut bytes.Buffer
+
+		c := newFakeClient(objects()...)
+
+		cmd := migrate.NewServerlessISVCCommand(genericiooptions.IOStreams{Out: &out, ErrOut: &errOut})
+		cmd.Client = c
+		cmd.Apply = true
+		cmd.Yes = true
+
+		g.Expect(cmd.Run(t.Context())).To(Succeed())
+
+		isvc, err := c.GetResource(t.Context(), resources.InferenceService, "model", client.InNamespace("user-ns"))
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(isvc.GetAnnotations()).To(HaveKeyWithValue("serving.kserve.io/deploymentMode", "RawDeployment"))
+		g.Expect(isvc.GetAnnotations()).ToNot(HaveKey("autoscaling.knative.dev/min-scale"))
+
+		minReplicas, _, _ := unstructured.NestedInt64(isvc.Object, "spec", "predictor", "minReplicas")
+		g.Expect(minReplicas).To(Equal(int64(2)))
+	})
+}
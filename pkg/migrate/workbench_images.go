@@ -0,0 +1,258 @@
+package migrate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+
+	"github.com/blang/semver/v4"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/workloads/notebook"
+	"github.com/opendatahub-io/odh-cli/pkg/migrate/manifest"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+	"github.com/opendatahub-io/odh-cli/pkg/util/jq"
+	"github.com/opendatahub-io/odh-cli/pkg/util/kube"
+)
+
+const (
+	annotationLastImageSelection = "notebooks.opendatahub.io/last-image-selection"
+	annotationImageTagOutdated   = "opendatahub.io/image-tag-outdated"
+
+	// Oldest ImageStream tag of the workbench images shipping the nginx fix required by 3.x.
+	workbenchImageMinTag = "2025.2"
+)
+
+// workbenchImageTagRegex matches the YYYY.N tags of the workbench ImageStreams.
+var workbenchImageTagRegex = regexp.MustCompile(`^\d{4}\.\d+$`)
+
+// GenerateWorkbenchImageMigration builds the patches updating the workbenches the notebook
+// impacted-workloads check reports as needing action before the upgrade: the containers
+// running the outdated image are moved to the newest 2025.2+ tag of the same ImageStream.
+// Each patch carries a rollback patch restoring the original containers. An empty namespace
+// selects all namespaces. Workbenches that need action after the upgrade or run custom images
+// are reported as skipped.
+func GenerateWorkbenchImageMigration(
+	ctx context.Context,
+	c client.Reader,
+	namespace string,
+) (*manifest.Bundle, error) {
+	dr, err := notebook.NewImpactedWorkloadsCheck().Validate(ctx, check.Target{Client: c})
+	if err != nil {
+		return nil, fmt.Errorf("analyzing workbench images: %w", err)
+	}
+
+	bundle := &manifest.Bundle{}
+
+	// The check returns no result when the workbenches component is removed
+	if dr == nil {
+		return bundle, nil
+	}
+
+	appNS, err := client.GetApplicationsNamespace(ctx, c)
+	if err != nil {
+		return nil, fmt.Errorf("getting applications namespace: %w", err)
+	}
+
+	imageStreams := map[string]*unstructured.Unstructured{}
+
+	for _, obj := range dr.ImpactedObjects {
+		if namespace != "" && obj.Namespace != namespace {
+			continue
+		}
+
+		reason := kube.GetAnnotation(&obj, notebook.AnnotationCheckReason)
+
+		status := notebook.ImageStatus(kube.GetAnnotation(&obj, notebook.AnnotationCheckImageStatus))
+		if status != notebook.ImageStatusPreUpgradeActionRequired {
+			bundle.Skip(resources.Notebook.Kind, obj.Namespace, obj.Name, "%s", reason)
+
+			continue
+		}
+
+		isName := kube.GetAnnotation(&obj, notebook.AnnotationCheckImageStream)
+
+		is, ok := imageStreams[isName]
+		if !ok {
+			is, err = getImageStream(ctx, c, appNS, isName)
+			if err != nil {
+				return nil, err
+			}
+
+			imageStreams[isName] = is
+		}
+
+		if is == nil {
+			bundle.Skip(resources.Notebook.Kind, obj.Namespace, obj.Name,
+				"%s (ImageStream %q not found in %s)", reason, isName, appNS)
+
+			continue
+		}
+
+		tag, image, err := latestWorkbenchImage(is)
+		if err != nil {
+			return nil, fmt.Errorf("reading ImageStream %s: %w", isName, err)
+		}
+
+		if image == "" {
+			bundle.Skip(resources.Notebook.Kind, obj.Namespace, obj.Name,
+				"%s (ImageStream %s has no %s+ tag to update to)", reason, isName, workbenchImageMinTag)
+
+			continue
+		}
+
+		nb, err := c.GetResource(ctx, resources.Notebook, obj.Name, client.InNamespace(obj.Namespace))
+		if err != nil {
+			return nil, fmt.Errorf("getting Notebook %s/%s: %w", obj.Namespace, obj.Name, err)
+		}
+
+		p, err := newWorkbenchImagePatch(nb, kube.GetAnnotation(&obj, notebook.AnnotationCheckImageRef), image,
+			isName+":"+tag)
+		if err != nil {
+			return nil, fmt.Errorf("converting Notebook %s/%s: %w", obj.Namespace, obj.Name, err)
+		}
+
+		bundle.AddPatch(p)
+	}
+
+	return bundle, nil
+}
+
+// getImageStream returns the ImageStream, or nil when it does not exist.
+func getImageStream(ctx context.Context, c client.Reader, namespace, name string) (*unstructured.Unstructured, error) {
+	if name == "" {
+		return nil, nil
+	}
+
+	is, err := c.GetResource(ctx, resources.ImageStream, name, client.InNamespace(namespace))
+
+	switch {
+	case apierrors.IsNotFound(err) || client.IsResourceTypeNotFound(err):
+		return nil, nil
+	case err != nil:
+		return nil, fmt.Errorf("getting ImageStream %s/%s: %w", namespace, name, err)
+	}
+
+	return is, nil
+}
+
+// latestWorkbenchImage returns the newest YYYY.N tag of the ImageStream that is not older than
+// workbenchImageMinTag and not marked outdated, with its image reference. The reference is the
+// imported image when available, the tag's source image otherwise. Returns empty strings when
+// no such tag exists.
+func latestWorkbenchImage(is *unstructured.Unstructured) (string, string, error) {
+	specTags, err := jq.Query[[]any](is, ".spec.tags")
+	if errors.Is(err, jq.ErrNotFound) {
+		return "", "", nil
+	} else if err != nil {
+		return "", "", fmt.Errorf("querying .spec.tags: %w", err)
+	}
+
+	minVersion := semver.MustParse(workbenchImageMinTag + ".0")
+
+	var (
+		latestTag     string
+		latestVersion semver.Version
+		latestSource  string
+	)
+
+	for _, t := range specTags {
+		tagMap, ok := t.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		name, _ := tagMap["name"].(string)
+		if !workbenchImageTagRegex.MatchString(name) {
+			continue
+		}
+
+		v, err := semver.ParseTolerant(name)
+		if err != nil || v.LT(minVersion) || (latestTag != "" && v.LTE(latestVersion)) {
+			continue
+		}
+
+		if annotations, ok := tagMap["annotations"].(map[string]any); ok && annotations[annotationImageTagOutdated] == "true" {
+			continue
+		}
+
+		latestTag, latestVersion = name, v
+		latestSource = ""
+
+		if from, ok := tagMap["from"].(map[string]any); ok && from["kind"] == "DockerImage" {
+			latestSource, _ = from["name"].(string)
+		}
+	}
+
+	if latestTag == "" {
+		return "", "", nil
+	}
+
+	image, err := jq.Query[string](is,
+		fmt.Sprintf(`.status.tags[]? | select(.tag == %q) | .items[0].dockerImageReference`, latestTag))
+	if err != nil && !errors.Is(err, jq.ErrNotFound) {
+		return "", "", fmt.Errorf("querying .status.tags: %w", err)
+	}
+
+	if image == "" {
+		image = latestSource
+	}
+
+	if image == "" {
+		return "", "", nil
+	}
+
+	return latestTag, image, nil
+}
+
+// newWorkbenchImagePatch builds the patch replacing imageRef with image in the containers of
+// the Notebook, along with the rollback patch. A merge patch replaces lists as a whole, so both
+// carry the complete container list. The dashboard's last image selection is updated too.
+func newWorkbenchImagePatch(
+	nb *unstructured.Unstructured,
+	imageRef, image, selection string,
+) (manifest.Patch, error) {
+	original, _, err := unstructured.NestedSlice(nb.Object, "spec", "template", "spec", "containers")
+	if err != nil {
+		return manifest.Patch{}, fmt.Errorf("reading containers: %w", err)
+	}
+
+	containers, _, _ := unstructured.NestedSlice(nb.Object, "spec", "template", "spec", "containers")
+
+	for _, ct := range containers {
+		if container, ok := ct.(map[string]any); ok && container["image"] == imageRef {
+			container["image"] = image
+		}
+	}
+
+	patch := map[string]any{
+		"spec": map[string]any{"template": map[string]any{"spec": map[string]any{"containers": containers}}},
+	}
+	rollback := map[string]any{
+		"spec": map[string]any{"template": map[string]any{"spec": map[string]any{"containers": original}}},
+	}
+
+	if previous := kube.GetAnnotation(nb, annotationLastImageSelection); previous != "" {
+		patch["metadata"] = map[string]any{
+			"annotations": map[string]any{annotationLastImageSelection: selection},
+		}
+		rollback["metadata"] = map[string]any{
+			"annotations": map[string]any{annotationLastImageSelection: previous},
+		}
+	}
+
+	return manifest.Patch{
+		ResourceType: resources.Notebook,
+		Namespace:    nb.GetNamespace(),
+		Name:         nb.GetName(),
+		Patch:        patch,
+		Description:  "update image to " + selection,
+		Original:     nb,
+		Rollback:     rollback,
+	}, nil
+}
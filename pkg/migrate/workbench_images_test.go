@@ -0,0 +1,230 @@
+package migrate_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/opendatahub-io/odh-cli/pkg/migrate"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+
+	. "github.com/onsi/gomega"
+)
+
+const (
+	codeServerImageStream = "code-server-notebook"
+	codeServerRepository  = "quay.io/modh/codeserver"
+	codeServerImageOld    = codeServerRepository + "@sha256:old"
+	codeServerImageNew    = codeServerRepository + "@sha256:new"
+	codeServerImageNewer  = codeServerRepository + "@sha256:newer"
+)
+
+func newDSC() *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": resources.DataScienceCluster.APIVersion(),
+		"kind":       resources.DataScienceCluster.Kind,
+		"metadata":   map[string]any{"name": "default-dsc"},
+		"spec": map[string]any{
+			"components": map[string]any{"workbenches": map[string]any{"managementState": "Managed"}},
+		},
+	}}
+}
+
+// newCodeServerImageStream creates an OOTB code-server ImageStream tagging each image with its
+// tag.
+func newCodeServerImageStream(tags map[string]string) *unstructured.Unstructured {
+	specTags := make([]any, 0, len(tags))
+	statusTags := make([]any, 0, len(tags))
+
+	for tag, image := range tags {
+		specTags = append(specTags, map[string]any{
+			"name": tag,
+			"from": map[string]any{"kind": "DockerImage", "name": image},
+			"annotations": map[string]any{
+				"opendatahub.io/notebook-software": `[{"name":"code-server","version":"4.0"}]`,
+			},
+		})
+		statusTags = append(statusTags, map[string]any{
+			"tag":   tag,
+			"items": []any{map[string]any{"dockerImageReference": image}},
+		})
+	}
+
+	return &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": resources.ImageStream.APIVersion(),
+		"kind":       resources.ImageStream.Kind,
+		"metadata": map[string]any{
+			"name":        codeServerImageStream,
+			"namespace":   applicationsNamespace,
+			"labels":      map[string]any{"app.kubernetes.io/part-of": "workbenches"},
+			"annotations": map[string]any{"platform.opendatahub.io/version": "2.25.0"},
+		},
+		"spec":   map[string]any{"tags": specTags},
+		"status": map[string]any{"tags": statusTags},
+	}}
+}
+
+func newWorkbench(namespace, name, image string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": resources.Notebook.APIVersion(),
+		"kind":       resources.Notebook.Kind,
+		"metadata": map[string]any{
+			"name":      name,
+			"namespace": namespace,
+			"annotations": map[string]any{
+				"notebooks.opendatahub.io/last-image-selection": codeServerImageStream + ":2025.1",
+			},
+		},
+		"spec": map[string]any{
+			"template": map[string]any{
+				"spec": map[string]any{
+					"containers": []any{
+						map[string]any{"name": name, "image": image},
+					},
+				},
+			},
+		},
+	}}
+}
+
+func newWorkbenchImageObjects() []*unstructured.Unstructured {
+	return []*unstructured.Unstructured{
+		newDSC(),
+		newDSCI(),
+		newCodeServerImageStream(map[string]string{
+			"2025.1": codeServerImageOld,
+			"2025.2": codeServerImageNew,
+		}),
+		newWorkbench("user-ns", "wb", codeServerImageOld),
+		newWorkbench("other-ns", "wb", codeServerImageOld),
+		newWorkbench("user-ns", "custom", "quay.io/myorg/custom:v1"),
+	}
+}
+
+func TestGenerateWorkbenchImageMigration(t *testing.T) {
+	t.Run("should update outdated images to the newest 2025.2+ tag", func(t *testing.T) {
+		g := NewWithT(t)
+
+		objs := newWorkbenchImageObjects()
+		objs[2] = newCodeServerImageStream(map[string]string{
+			"2025.1": codeServerImageOld,
+			"2025.2": codeServerImageNew,
+			"2026.1": codeServerImageNewer,
+			"latest": codeServerImageNewer,
+		})
+
+		bundle, err := migrate.GenerateWorkbenchImageMigration(t.Context(), newFakeClient(objs...), "")
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(bundle.Patches).To(HaveLen(2))
+
+		p := bundle.Patches[0]
+		if p.Namespace != "user-ns" {
+			p = bundle.Patches[1]
+		}
+
+		g.Expect(p.Reference()).To(Equal("Notebook/user-ns/wb"))
+		g.Expect(p.Description).To(Equal("update image to " + codeServerImageStream + ":2026.1"))
+
+		containers, _, _ := unstructured.NestedSlice(p.Patch, "spec", "template", "spec", "containers")
+		g.Expect(containers).To(ConsistOf(HaveKeyWithValue("image", codeServerImageNewer)))
+		g.Expect(p.Patch).To(HaveKeyWithValue("metadata", HaveKeyWithValue("annotations",
+			HaveKeyWithValue("notebooks.opendatahub.io/last-image-selection", codeServerImageStream+":2026.1"))))
+
+		rollback, _, _ := unstructured.NestedSlice(p.Rollback, "spec", "template", "spec", "containers")
+		g.Expect(rollback).To(ConsistOf(HaveKeyWithValue("image", codeServerImageOld)))
+
+		g.Expect(bundle.Skipped).To(HaveLen(1))
+		g.Expect(bundle.Skipped[0].Reference).To(Equal("Notebook/user-ns/custom"))
+		g.Expect(bundle.Skipped[0].Reason).To(ContainSubstring("not a recognized OOTB notebook image"))
+	})
+
+	t.Run("should only migrate the given namespace", func(t *testing.T) {
+		g := NewWithT(t)
+
+		bundle, err := migrate.GenerateWorkbenchImageMigration(t.Context(),
+			newFakeClient(newWorkbenchImageObjects()...), "other-ns")
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(bundle.Skipped).To(BeEmpty())
+		g.Expect(bundle.Patches).To(HaveLen(1))
+		g.Expect(bundle.Patches[0].Reference()).To(Equal("Notebook/other-ns/wb"))
+	})
+
+	t.Run("should skip workbenches without a 2025.2+ tag to update to", func(t *testing.T) {
+		g := NewWithT(t)
+
+		objs := newWorkbenchImageObjects()
+		objs[2] = newCodeServerImageStream(map[string]string{"2025.1": codeServerImageOld})
+
+		bundle, err := migrate.GenerateWorkbenchImageMigration(t.Context(), newFakeClient(objs...), "user-ns")
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(bundle.Empty()).To(BeTrue())
+		g.Expect(bundle.Skipped).To(HaveLen(2))
+		g.Expect(bundle.Skipped).To(ContainElement(
+			HaveField("Reason", ContainSubstring("has no 2025.2+ tag to update to"))))
+	})
+
+	t.Run("should do nothing when workbenches are removed", func(t *testing.T) {
+		g := NewWithT(t)
+
+		objs := newWorkbenchImageObjects()
+		_ = unstructured.SetNestedField(objs[0].Object, "Removed", "spec", "components", "workbenches", "managementState")
+
+		bundle, err := migrate.GenerateWorkbenchImageMigration(t.Context(), newFakeClient(objs...), "")
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(bundle.Empty()).To(BeTrue())
+		g.Expect(bundle.Skipped).To(BeEmpty())
+	})
+}
+
+func TestWorkbenchImagesCommand(t *testing.T) {
+	t.Run("should show a diff per Notebook with --dry-run", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var out, errOut bytes.Buffer
+
+		cmd := migrate.NewWorkbenchImagesCommand(genericiooptions.IOStreams{Out: &out, ErrOut: &errOut})
+		cmd.Client = newFakeClient(newWorkbenchImageObjects()...)
+		cmd.Namespace = "user-ns"
+		cmd.DryRun = true
+
+		g.Expect(cmd.Run(t.Context())).To(Succeed())
+		g.Expect(out.String()).To(ContainSubstring("--- Notebook/user-ns/wb (current)"))
+		g.Expect(out.String()).To(ContainSubstring("-      - image: " + codeServerImageOld))
+		g.Expect(out.String()).To(ContainSubstring("+      - image: " + codeServerImageNew))
+		g.Expect(out.String()).ToNot(ContainSubstring("other-ns"))
+		g.Expect(errOut.String()).To(ContainSubstring("Notebook/user-ns/custom needs manual migration"))
+	})
+
+	t.Run("should write rollback patches and patch the Notebooks with --apply", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var out, errOut bytes.Buffer
+
+		c := newFakeClient(newWorkbenchImageObjects()...)
+		dir := t.TempDir()
+
+		cmd := migrate.NewWorkbenchImagesCommand(genericiooptions.IOStreams{Out: &out, ErrOut: &errOut})
+		cmd.Client = c
+		cmd.Apply = true
+		cmd.Yes = true
+		cmd.RollbackDir = dir
+
+		g.Expect(cmd.Run(t.Context())).To(Succeed())
+		g.Expect(errOut.String()).To(ContainSubstring("Wrote 2 rollback patch(es) to " + dir))
+
+		rollback, err := os.ReadFile(filepath.Join(dir, "user-ns", "notebooks.kubeflow.org-wb.yaml"))
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(string(rollback)).To(ContainSubstring(codeServerImageOld))
+
+		nb, err := c.GetResource(t.Context(), resources.Notebook, "wb", client.InNamespace("user-ns"))
+		g.Expect(err).ToNot(HaveOccurred())
+
+		containers, _, _ := unstructured.NestedSlice(nb.Object, "spec", "template", "spec", "containers")
+		g.Expect(containers).To(ConsistOf(HaveKeyWithValue("image", codeServerImageNew)))
+	})
+}
@@ -0,0 +1,124 @@
+package plan
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/spf13/pflag"
+
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/opendatahub-io/odh-cli/pkg/api"
+	"github.com/opendatahub-io/odh-cli/pkg/cmd"
+	"github.com/opendatahub-io/odh-cli/pkg/lint"
+	printerjson "github.com/opendatahub-io/odh-cli/pkg/printer/json"
+	"github.com/opendatahub-io/odh-cli/pkg/util/iostreams"
+)
+
+var _ cmd.Command = (*Command)(nil)
+
+// OutputFormat represents the output format of the plan command.
+type OutputFormat string
+
+const (
+	OutputFormatMarkdown OutputFormat = "markdown"
+	OutputFormatJSON     OutputFormat = "json"
+
+	flagDescOutput = "output format (markdown|json)"
+)
+
+// Validate checks if the output format is valid.
+func (o OutputFormat) Validate() error {
+	switch o {
+	case OutputFormatMarkdown, OutputFormatJSON:
+		return nil
+	default:
+		return fmt.Errorf("invalid output format: %s (must be one of: markdown, json)", o)
+	}
+}
+
+// Command converts lint results for a target version into an ordered upgrade runbook.
+// Check selection, severity, config file and offline snapshot options are shared with lint.
+type Command struct {
+	// OutputFormat specifies the output format (markdown, json)
+	OutputFormat OutputFormat
+
+	assessment *lint.Command
+}
+
+// NewCommand creates a new plan Command with defaults.
+func NewCommand(
+	streams genericiooptions.IOStreams,
+	configFlags *genericclioptions.ConfigFlags,
+) *Command {
+	return &Command{
+		OutputFormat: OutputFormatMarkdown,
+		assessment:   lint.NewCommand(streams, configFlags),
+	}
+}
+
+// AddFlags registers command-specific flags with the provided FlagSet.
+func (c *Command) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVarP((*string)(&c.OutputFormat), "output", "o", string(OutputFormatMarkdown), flagDescOutput)
+	_ = fs.SetAnnotation("output", api.AnnotationValidValues, []string{"markdown", "json"})
+	c.assessment.AddAssessmentFlags(fs)
+}
+
+// Complete creates the client and parses the target version.
+func (c *Command) Complete() error {
+	if err := c.assessment.Complete(); err != nil {
+		return fmt.Errorf("completing lint options: %w", err)
+	}
+
+	return nil
+}
+
+// Validate checks that all required options are valid.
+func (c *Command) Validate() error {
+	if err := c.OutputFormat.Validate(); err != nil {
+		return err
+	}
+
+	if c.assessment.TargetVersion == "" {
+		return errors.New("--target-version is required")
+	}
+
+	if err := c.assessment.Validate(); err != nil {
+		return fmt.Errorf("validating lint options: %w", err)
+	}
+
+	return nil
+}
+
+// Run assesses the cluster and writes the plan.
+func (c *Command) Run(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, c.assessment.Timeout)
+	defer cancel()
+
+	assessment, err := c.assessment.Assess(ctx)
+	if err != nil {
+		return fmt.Errorf("assessing cluster: %w", err)
+	}
+
+	p := Build(ctx, assessment, c.streams().ErrOut())
+
+	switch c.OutputFormat {
+	case OutputFormatJSON:
+		renderer := printerjson.NewRenderer[*Plan](printerjson.WithWriter[*Plan](c.streams().Out()))
+		if err := renderer.Render(p); err != nil {
+			return fmt.Errorf("rendering JSON output: %w", err)
+		}
+
+		return nil
+	case OutputFormatMarkdown:
+		return WriteMarkdown(c.streams().Out(), p)
+	default:
+		return fmt.Errorf("unsupported output format: %s", c.OutputFormat)
+	}
+}
+
+func (c *Command) streams() iostreams.Interface {
+	return c.assessment.IO
+}
@@ -0,0 +1,99 @@
+package plan_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/pflag"
+
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/opendatahub-io/odh-cli/pkg/plan"
+
+	. "github.com/onsi/gomega"
+)
+
+const (
+	fixtureSnapshotDSC = `
+apiVersion: datasciencecluster.opendatahub.io/v1
+kind: DataScienceCluster
+metadata:
+  name: default-dsc
+status:
+  release:
+    version: 2.25.0
+`
+
+	fixtureSnapshotDSCI = `
+apiVersion: dscinitialization.opendatahub.io/v1
+kind: DSCInitialization
+metadata:
+  name: default-dsci
+spec:
+  applicationsNamespace: redhat-ods-applications
+status:
+  release:
+    version: 2.25.0
+`
+)
+
+func newTestCommand(t *testing.T, out *bytes.Buffer, args ...string) *plan.Command {
+	t.Helper()
+
+	command := plan.NewCommand(genericiooptions.IOStreams{
+		In: &bytes.Buffer{}, Out: out, ErrOut: &bytes.Buffer{},
+	}, genericclioptions.NewConfigFlags(true))
+
+	fs := pflag.NewFlagSet("plan", pflag.ContinueOnError)
+	command.AddFlags(fs)
+
+	if err := fs.Parse(args); err != nil {
+		t.Fatal(err)
+	}
+
+	return command
+}
+
+func TestCommand(t *testing.T) {
+	t.Run("should require a target version", func(t *testing.T) {
+		g := NewWithT(t)
+
+		command := newTestCommand(t, &bytes.Buffer{})
+
+		g.Expect(command.Validate()).To(MatchError(ContainSubstring("--target-version")))
+	})
+
+	t.Run("should reject unknown output formats", func(t *testing.T) {
+		g := NewWithT(t)
+
+		command := newTestCommand(t, &bytes.Buffer{}, "--target-version", "3.0", "-o", "table")
+
+		g.Expect(command.Validate()).To(MatchError(ContainSubstring("invalid output format")))
+	})
+
+	t.Run("should write a JSON plan for a snapshot", func(t *testing.T) {
+		g := NewWithT(t)
+
+		dir := t.TempDir()
+		g.Expect(os.WriteFile(filepath.Join(dir, "dsc.yaml"), []byte(fixtureSnapshotDSC), 0o600)).To(Succeed())
+		g.Expect(os.WriteFile(filepath.Join(dir, "dsci.yaml"), []byte(fixtureSnapshotDSCI), 0o600)).To(Succeed())
+
+		var out bytes.Buffer
+		command := newTestCommand(t, &out, "--target-version", "3.0.0", "--from-dir", dir, "-o", "json")
+
+		g.Expect(command.Complete()).To(Succeed())
+		g.Expect(command.Validate()).To(Succeed())
+		g.Expect(command.Run(t.Context())).To(Succeed())
+
+		var p plan.Plan
+		g.Expect(json.Unmarshal(out.Bytes(), &p)).To(Succeed())
+		g.Expect(p.CurrentVersion).To(Equal("2.25.0"))
+		g.Expect(p.Summary.Steps).To(Equal(len(p.Steps)))
+		g.Expect(p.Steps).ToNot(BeEmpty())
+		g.Expect(p.Steps[0].BlocksUpgrade).To(BeTrue())
+	})
+}
@@ -0,0 +1,87 @@
+package plan
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// WriteMarkdown renders the plan as a Markdown runbook suitable for change-management tickets.
+func WriteMarkdown(w io.Writer, p *Plan) error {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "# Upgrade Plan: %s → %s\n\n", p.CurrentVersion, p.TargetVersion)
+	fmt.Fprintf(&b, "Generated %s by odh-cli %s.", p.Metadata.GeneratedAt, p.Metadata.CLIVersion)
+
+	if p.OpenShiftVersion != "" {
+		fmt.Fprintf(&b, " OpenShift version: %s.", p.OpenShiftVersion)
+	}
+
+	b.WriteString("\n\n## Summary\n\n")
+	b.WriteString("| Steps | Prohibited | Blocking | Advisory |\n")
+	b.WriteString("|-------|------------|----------|----------|\n")
+	fmt.Fprintf(&b, "| %d | %d | %d | %d |\n", p.Summary.Steps, p.Summary.Prohibited, p.Summary.Blocking, p.Summary.Advisory)
+
+	if len(p.Steps) == 0 {
+		b.WriteString("\nNo remediation steps are required before upgrading.\n")
+	} else {
+		b.WriteString("\n## Steps\n")
+	}
+
+	for _, step := range p.Steps {
+		writeMarkdownStep(&b, step)
+	}
+
+	if _, err := io.WriteString(w, b.String()); err != nil {
+		return fmt.Errorf("writing markdown output: %w", err)
+	}
+
+	return nil
+}
+
+func writeMarkdownStep(b *strings.Builder, step Step) {
+	fmt.Fprintf(b, "\n### %d. [%s] %s\n\n", step.Number, strings.ToUpper(string(step.Impact)), step.Title)
+	fmt.Fprintf(b, "- Check: `%s` (%s)\n", step.CheckID, step.Group)
+
+	if step.BlocksUpgrade {
+		b.WriteString("- Status: must be completed before upgrading\n")
+	} else {
+		b.WriteString("- Status: recommended, does not block the upgrade\n")
+	}
+
+	writeMarkdownList(b, "Findings", step.Findings)
+	writeMarkdownList(b, "Remediation", step.Remediation)
+
+	if len(step.AffectedObjects) > 0 {
+		fmt.Fprintf(b, "\n<details>\n<summary>Affected objects (%d)</summary>\n\n", len(step.AffectedObjects))
+
+		for _, obj := range step.AffectedObjects {
+			fmt.Fprintf(b, "- `%s`\n", obj)
+		}
+
+		b.WriteString("\n</details>\n")
+	}
+
+	if len(step.Commands) > 0 {
+		b.WriteString("\n**Commands**\n\n```bash\n")
+
+		for _, cmd := range step.Commands {
+			b.WriteString(cmd)
+			b.WriteString("\n")
+		}
+
+		b.WriteString("```\n")
+	}
+}
+
+func writeMarkdownList(b *strings.Builder, title string, items []string) {
+	if len(items) == 0 {
+		return
+	}
+
+	fmt.Fprintf(b, "\n**%s**\n\n", title)
+
+	for _, item := range items {
+		fmt.Fprintf(b, "- %s\n", item)
+	}
+}
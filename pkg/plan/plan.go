@@ -0,0 +1,180 @@
+package plan
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"slices"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/output"
+)
+
+const planKind = "UpgradePlan"
+
+// Plan is an ordered upgrade runbook derived from lint results.
+type Plan struct {
+	output.Envelope
+
+	CurrentVersion   string  `json:"currentVersion"             yaml:"currentVersion"`
+	TargetVersion    string  `json:"targetVersion"              yaml:"targetVersion"`
+	OpenShiftVersion string  `json:"openShiftVersion,omitempty" yaml:"openShiftVersion,omitempty"`
+	Summary          Summary `json:"summary"                    yaml:"summary"`
+	Steps            []Step  `json:"steps"                      yaml:"steps"`
+}
+
+// Summary counts plan steps by impact.
+type Summary struct {
+	Steps      int `json:"steps"      yaml:"steps"`
+	Prohibited int `json:"prohibited" yaml:"prohibited"`
+	Blocking   int `json:"blocking"   yaml:"blocking"`
+	Advisory   int `json:"advisory"   yaml:"advisory"`
+}
+
+// Step is a single task of the plan, covering all findings of one check.
+type Step struct {
+	// Number is the 1-based position of the step in the plan.
+	Number int `json:"number" yaml:"number"`
+
+	CheckID string `json:"checkId" yaml:"checkId"`
+	Title   string `json:"title"   yaml:"title"`
+	Group   string `json:"group"   yaml:"group"`
+
+	// Impact is the highest impact of the check's findings.
+	Impact result.Impact `json:"impact" yaml:"impact"`
+
+	// BlocksUpgrade is true when the step must be completed before upgrading.
+	BlocksUpgrade bool `json:"blocksUpgrade" yaml:"blocksUpgrade"`
+
+	// Findings are the messages of the non-passing conditions.
+	Findings []string `json:"findings" yaml:"findings"`
+
+	// Remediation is the guidance from the conditions, falling back to the check's remediation.
+	Remediation []string `json:"remediation,omitempty" yaml:"remediation,omitempty"`
+
+	// AffectedObjects lists the impacted objects as "Kind namespace/name".
+	AffectedObjects []string `json:"affectedObjects,omitempty" yaml:"affectedObjects,omitempty"`
+
+	// Commands are kubectl commands applying the automatic remediations of the check, if any.
+	Commands []string `json:"commands,omitempty" yaml:"commands,omitempty"`
+}
+
+// Build converts an assessment into a plan. Steps that block the upgrade come first; within
+// the same impact class steps keep the canonical check order (dependencies, services,
+// platform, components, workloads), so prerequisites are addressed before what depends on them.
+// Failures to plan automatic remediations are reported to errOut and do not abort the plan.
+func Build(ctx context.Context, assessment *lint.Assessment, errOut io.Writer) *Plan {
+	p := &Plan{
+		Envelope:         output.NewEnvelope(planKind, "plan"),
+		CurrentVersion:   assessment.CurrentVersion,
+		TargetVersion:    assessment.TargetVersion,
+		OpenShiftVersion: assessment.OpenShiftVersion,
+		Steps:            []Step{},
+	}
+
+	for _, exec := range assessment.Results {
+		if exec.Result == nil || exec.Result.GetImpact() == result.ImpactNone {
+			continue
+		}
+
+		p.Steps = append(p.Steps, newStep(ctx, assessment.Target, exec, errOut))
+	}
+
+	slices.SortStableFunc(p.Steps, func(a, b Step) int {
+		return impactRank(a.Impact) - impactRank(b.Impact)
+	})
+
+	for i := range p.Steps {
+		p.Steps[i].Number = i + 1
+
+		switch p.Steps[i].Impact {
+		case result.ImpactProhibited:
+			p.Summary.Prohibited++
+		case result.ImpactBlocking:
+			p.Summary.Blocking++
+		case result.ImpactAdvisory, result.ImpactNone:
+			p.Summary.Advisory++
+		}
+	}
+
+	p.Summary.Steps = len(p.Steps)
+
+	return p
+}
+
+func newStep(ctx context.Context, target check.Target, exec check.CheckExecution, errOut io.Writer) Step {
+	impact := exec.Result.GetImpact()
+
+	step := Step{
+		CheckID:       exec.Check.ID(),
+		Title:         exec.Check.Name(),
+		Group:         string(exec.Check.Group()),
+		Impact:        impact,
+		BlocksUpgrade: impact == result.ImpactProhibited || impact == result.ImpactBlocking,
+		Findings:      []string{},
+	}
+
+	for _, cond := range exec.Result.Status.Conditions {
+		if cond.Impact == result.ImpactNone {
+			continue
+		}
+
+		step.Findings = append(step.Findings, cond.Message)
+
+		if cond.Remediation != "" && !slices.Contains(step.Remediation, cond.Remediation) {
+			step.Remediation = append(step.Remediation, cond.Remediation)
+		}
+	}
+
+	if len(step.Remediation) == 0 {
+		if provider, ok := exec.Check.(check.RemediationProvider); ok && provider.Remediation() != "" {
+			step.Remediation = []string{provider.Remediation()}
+		}
+	}
+
+	for i := range exec.Result.ImpactedObjects {
+		step.AffectedObjects = append(step.AffectedObjects, objectReference(exec, i))
+	}
+
+	if remediator, ok := exec.Check.(check.Remediator); ok {
+		remediations, err := remediator.Remediations(ctx, target, exec.Result)
+		if err != nil {
+			_, _ = fmt.Fprintf(errOut, "Warning: planning remediations for %s: %v\n", step.CheckID, err)
+		}
+
+		for _, r := range remediations {
+			step.Commands = append(step.Commands, PatchCommand(r))
+		}
+	}
+
+	return step
+}
+
+func objectReference(exec check.CheckExecution, i int) string {
+	obj := exec.Result.ImpactedObjects[i]
+	if obj.Namespace == "" {
+		return obj.Kind + " " + obj.Name
+	}
+
+	return obj.Kind + " " + obj.Namespace + "/" + obj.Name
+}
+
+// PatchCommand renders a remediation as an equivalent `kubectl patch` command.
+func PatchCommand(r check.Remediation) string {
+	return r.PatchCommand()
+}
+
+func impactRank(impact result.Impact) int {
+	switch impact {
+	case result.ImpactProhibited:
+		return 0
+	case result.ImpactBlocking:
+		return 1
+	case result.ImpactAdvisory, result.ImpactNone:
+		return 2
+	}
+
+	return 2
+}
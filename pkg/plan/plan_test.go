@@ -0,0 +1,121 @@
+package plan_test
+
+import (
+	"bytes"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/plan"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	mocks "github.com/opendatahub-io/odh-cli/pkg/util/test/mocks/check"
+
+	. "github.com/onsi/gomega"
+)
+
+func newExecution(id string, group check.CheckGroup, impact result.Impact) check.CheckExecution {
+	chk := mocks.NewMockCheck()
+	chk.On("ID").Return(id)
+	chk.On("Name").Return("Check " + id)
+	chk.On("Group").Return(group)
+
+	dr := result.New(string(group), "test", "test", "description")
+
+	status := metav1.ConditionFalse
+	if impact == result.ImpactNone {
+		status = metav1.ConditionTrue
+	}
+
+	dr.SetCondition(check.NewCondition(check.ConditionTypeCompatible, status,
+		check.WithReason(check.ReasonVersionIncompatible),
+		check.WithMessage("finding of %s", id),
+		check.WithImpact(impact),
+		check.WithRemediation("fix "+id),
+	))
+
+	return check.CheckExecution{Check: chk, Result: dr}
+}
+
+func TestBuild(t *testing.T) {
+	g := NewWithT(t)
+
+	advisory := newExecution("dependencies.advisory", check.GroupDependency, result.ImpactAdvisory)
+	blocking := newExecution("workloads.blocking", check.GroupWorkload, result.ImpactBlocking)
+	blocking.Result.AddImpactedObjects(resources.Notebook, []types.NamespacedName{{Namespace: "ns1", Name: "nb"}})
+
+	p := plan.Build(t.Context(), &lint.Assessment{
+		CurrentVersion: "2.25.0",
+		TargetVersion:  "3.0.0",
+		Results: []check.CheckExecution{
+			advisory,
+			newExecution("platform.passing", check.GroupPlatform, result.ImpactNone),
+			blocking,
+		},
+	}, &bytes.Buffer{})
+
+	g.Expect(p.Kind).To(Equal("UpgradePlan"))
+	g.Expect(p.Summary).To(Equal(plan.Summary{Steps: 2, Blocking: 1, Advisory: 1}))
+	g.Expect(p.Steps).To(HaveLen(2))
+
+	g.Expect(p.Steps[0].Number).To(Equal(1))
+	g.Expect(p.Steps[0].CheckID).To(Equal("workloads.blocking"))
+	g.Expect(p.Steps[0].BlocksUpgrade).To(BeTrue())
+	g.Expect(p.Steps[0].Findings).To(Equal([]string{"finding of workloads.blocking"}))
+	g.Expect(p.Steps[0].Remediation).To(Equal([]string{"fix workloads.blocking"}))
+	g.Expect(p.Steps[0].AffectedObjects).To(Equal([]string{"Notebook ns1/nb"}))
+
+	g.Expect(p.Steps[1].CheckID).To(Equal("dependencies.advisory"))
+	g.Expect(p.Steps[1].BlocksUpgrade).To(BeFalse())
+}
+
+func TestPatchCommand(t *testing.T) {
+	g := NewWithT(t)
+
+	cmd := plan.PatchCommand(check.Remediation{
+		ResourceType: resources.Notebook,
+		Namespace:    "ns1",
+		Name:         "nb",
+		PatchType:    types.MergePatchType,
+		Patch:        []byte(`{"metadata":{"annotations":{"a":"it's"}}}`),
+	})
+
+	g.Expect(cmd).To(Equal(
+		`kubectl patch notebooks.kubeflow.org nb -n ns1 --type=merge -p '{"metadata":{"annotations":{"a":"it'\''s"}}}'`))
+}
+
+func TestWriteMarkdown(t *testing.T) {
+	g := NewWithT(t)
+
+	blocking := newExecution("workloads.blocking", check.GroupWorkload, result.ImpactBlocking)
+	blocking.Result.AddImpactedObjects(resources.Notebook, []types.NamespacedName{{Namespace: "ns1", Name: "nb"}})
+
+	p := plan.Build(t.Context(), &lint.Assessment{
+		CurrentVersion: "2.25.0",
+		TargetVersion:  "3.0.0",
+		Results:        []check.CheckExecution{blocking},
+	}, &bytes.Buffer{})
+
+	var out bytes.Buffer
+	g.Expect(plan.WriteMarkdown(&out, p)).To(Succeed())
+
+	md := out.String()
+	g.Expect(md).To(ContainSubstring("# Upgrade Plan: 2.25.0 → 3.0.0"))
+	g.Expect(md).To(ContainSubstring("| 1 | 0 | 1 | 0 |"))
+	g.Expect(md).To(ContainSubstring("### 1. [BLOCKING] Check workloads.blocking"))
+	g.Expect(md).To(ContainSubstring("- `Notebook ns1/nb`"))
+	g.Expect(md).To(ContainSubstring("- fix workloads.blocking"))
+}
+
+func TestWriteMarkdown_NoSteps(t *testing.T) {
+	g := NewWithT(t)
+
+	p := plan.Build(t.Context(), &lint.Assessment{CurrentVersion: "2.25.0", TargetVersion: "3.0.0"}, &bytes.Buffer{})
+
+	var out bytes.Buffer
+	g.Expect(plan.WriteMarkdown(&out, p)).To(Succeed())
+	g.Expect(out.String()).To(ContainSubstring("No remediation steps are required"))
+}
@@ -0,0 +1,78 @@
+package html
+
+import (
+	"errors"
+	"fmt"
+	"html/template"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/opendatahub-io/odh-cli/pkg/util"
+)
+
+// Renderer renders values into HTML documents using an html/template.
+// Values are escaped by html/template, so untrusted cluster data can be rendered safely.
+type Renderer[T any] struct {
+	writer   io.Writer
+	template *template.Template
+}
+
+// Option is a functional option for configuring a Renderer.
+type Option[T any] = util.Option[Renderer[T]]
+
+// NewRenderer creates a new HTML renderer with the given options.
+// A template must be provided with WithTemplate before calling Render.
+func NewRenderer[T any](opts ...Option[T]) *Renderer[T] {
+	r := &Renderer[T]{
+		writer: os.Stdout,
+	}
+
+	for _, opt := range opts {
+		opt.ApplyTo(r)
+	}
+
+	return r
+}
+
+// WithWriter sets the output writer for the HTML renderer.
+func WithWriter[T any](w io.Writer) Option[T] {
+	return util.FunctionalOption[Renderer[T]](func(r *Renderer[T]) {
+		r.writer = w
+	})
+}
+
+// WithTemplate sets the template executed by Render.
+func WithTemplate[T any](tmpl *template.Template) Option[T] {
+	return util.FunctionalOption[Renderer[T]](func(r *Renderer[T]) {
+		r.template = tmpl
+	})
+}
+
+// Parse parses an HTML template with the helper functions available to all templates:
+// lower, upper and join.
+func Parse(name string, text string) (*template.Template, error) {
+	tmpl, err := template.New(name).Funcs(template.FuncMap{
+		"lower": strings.ToLower,
+		"upper": strings.ToUpper,
+		"join":  strings.Join,
+	}).Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("parsing HTML template %s: %w", name, err)
+	}
+
+	return tmpl, nil
+}
+
+// Render executes the template with the value and writes the result to the configured writer.
+func (r *Renderer[T]) Render(value T) error {
+	if r.template == nil {
+		return errors.New("no HTML template configured")
+	}
+
+	if err := r.template.Execute(r.writer, value); err != nil {
+		return fmt.Errorf("failed to render HTML: %w", err)
+	}
+
+	return nil
+}
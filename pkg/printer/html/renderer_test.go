@@ -0,0 +1,68 @@
+package html_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/opendatahub-io/odh-cli/pkg/printer/html"
+
+	. "github.com/onsi/gomega"
+)
+
+type testReport struct {
+	Title string
+	Tags  []string
+}
+
+func TestRenderer_Render(t *testing.T) {
+	g := NewWithT(t)
+
+	tmpl, err := html.Parse("report", `<h1>{{ .Title }}</h1><p>{{ join .Tags ", " | upper }}</p>`)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	var buf bytes.Buffer
+
+	renderer := html.NewRenderer[testReport](
+		html.WithWriter[testReport](&buf),
+		html.WithTemplate[testReport](tmpl),
+	)
+
+	err = renderer.Render(testReport{Title: "Report", Tags: []string{"a", "b"}})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(buf.String()).To(Equal("<h1>Report</h1><p>A, B</p>"))
+}
+
+func TestRenderer_EscapesValues(t *testing.T) {
+	g := NewWithT(t)
+
+	tmpl, err := html.Parse("report", `<p>{{ .Title }}</p>`)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	var buf bytes.Buffer
+
+	renderer := html.NewRenderer[testReport](
+		html.WithWriter[testReport](&buf),
+		html.WithTemplate[testReport](tmpl),
+	)
+
+	err = renderer.Render(testReport{Title: "<script>alert(1)</script>"})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(buf.String()).ToNot(ContainSubstring("<script>"))
+	g.Expect(buf.String()).To(ContainSubstring("&lt;script&gt;"))
+}
+
+func TestRenderer_NoTemplate(t *testing.T) {
+	g := NewWithT(t)
+
+	renderer := html.NewRenderer[testReport](html.WithWriter[testReport](&bytes.Buffer{}))
+
+	err := renderer.Render(testReport{})
+	g.Expect(err).To(HaveOccurred())
+}
+
+func TestParse_InvalidTemplate(t *testing.T) {
+	g := NewWithT(t)
+
+	_, err := html.Parse("broken", `{{ .Title `)
+	g.Expect(err).To(HaveOccurred())
+}
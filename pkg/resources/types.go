@@ -172,6 +172,14 @@ var (
 		Resource: "cronjobs",
 	}
 
+	// PodDisruptionBudget is the Kubernetes PodDisruptionBudget resource.
+	PodDisruptionBudget = ResourceType{
+		Group:    "policy",
+		Version:  "v1",
+		Kind:     "PodDisruptionBudget",
+		Resource: "poddisruptionbudgets",
+	}
+
 	// Namespace is the core Kubernetes Namespace resource.
 	Namespace = ResourceType{
 		Group:    "",
@@ -180,6 +188,14 @@ var (
 		Resource: "namespaces",
 	}
 
+	// Node is the core Kubernetes Node resource.
+	Node = ResourceType{
+		Group:    "",
+		Version:  "v1",
+		Kind:     "Node",
+		Resource: "nodes",
+	}
+
 	Pod = ResourceType{
 		Group:    "",
 		Version:  "v1",
@@ -236,6 +252,14 @@ var (
 		Resource: "persistentvolumeclaims",
 	}
 
+	// StorageClass is the Kubernetes StorageClass resource.
+	StorageClass = ResourceType{
+		Group:    "storage.k8s.io",
+		Version:  "v1",
+		Kind:     "StorageClass",
+		Resource: "storageclasses",
+	}
+
 	// Notebook is the Kubeflow Notebook resource.
 	Notebook = ResourceType{
 		Group:    "kubeflow.org",
@@ -393,6 +417,47 @@ var (
 		Resource: "clusterversions",
 	}
 
+	// ImageDigestMirrorSet is the OpenShift registry mirror configuration for pulls by digest.
+	ImageDigestMirrorSet = ResourceType{
+		Group:    "config.openshift.io",
+		Version:  "v1",
+		Kind:     "ImageDigestMirrorSet",
+		Resource: "imagedigestmirrorsets",
+	}
+
+	// ImageTagMirrorSet is the OpenShift registry mirror configuration for pulls by tag.
+	ImageTagMirrorSet = ResourceType{
+		Group:    "config.openshift.io",
+		Version:  "v1",
+		Kind:     "ImageTagMirrorSet",
+		Resource: "imagetagmirrorsets",
+	}
+
+	// ImageContentSourcePolicy is the deprecated predecessor of ImageDigestMirrorSet.
+	ImageContentSourcePolicy = ResourceType{
+		Group:    "operator.openshift.io",
+		Version:  "v1alpha1",
+		Kind:     "ImageContentSourcePolicy",
+		Resource: "imagecontentsourcepolicies",
+	}
+
+	// OpenShiftGroup is the OpenShift user group resource.
+	OpenShiftGroup = ResourceType{
+		Group:    "user.openshift.io",
+		Version:  "v1",
+		Kind:     "Group",
+		Resource: "groups",
+	}
+
+	// Template is the OpenShift Template resource; the Dashboard stores serving runtime templates
+	// as Templates wrapping a ServingRuntime.
+	Template = ResourceType{
+		Group:    "template.openshift.io",
+		Version:  "v1",
+		Kind:     "Template",
+		Resource: "templates",
+	}
+
 	// AcceleratorProfile is the OpenShift AI AcceleratorProfile resource.
 	AcceleratorProfile = ResourceType{
 		Group:    "dashboard.opendatahub.io",
@@ -418,6 +483,38 @@ var (
 		Resource: "hardwareprofiles",
 	}
 
+	// OdhApplication is the Dashboard resource describing an application tile.
+	OdhApplication = ResourceType{
+		Group:    "dashboard.opendatahub.io",
+		Version:  "v1",
+		Kind:     "OdhApplication",
+		Resource: "odhapplications",
+	}
+
+	// OdhDashboardConfig is the Dashboard configuration resource (odh-dashboard-config).
+	OdhDashboardConfig = ResourceType{
+		Group:    "opendatahub.io",
+		Version:  "v1alpha",
+		Kind:     "OdhDashboardConfig",
+		Resource: "odhdashboardconfigs",
+	}
+
+	// OdhDocument is the Dashboard resource describing a documentation tile.
+	OdhDocument = ResourceType{
+		Group:    "dashboard.opendatahub.io",
+		Version:  "v1",
+		Kind:     "OdhDocument",
+		Resource: "odhdocuments",
+	}
+
+	// OdhQuickStart is the Dashboard resource describing a quick start tutorial.
+	OdhQuickStart = ResourceType{
+		Group:    "console.openshift.io",
+		Version:  "v1",
+		Kind:     "OdhQuickStart",
+		Resource: "odhquickstarts",
+	}
+
 	// LlamaStackDistribution is the LlamaStack distribution configuration resource.
 	LlamaStackDistribution = ResourceType{
 		Group:    "llamastack.io",
@@ -442,6 +539,22 @@ var (
 		Resource: "authorinos",
 	}
 
+	// AuthConfig is the Authorino AuthConfig resource.
+	AuthConfig = ResourceType{
+		Group:    "authorino.kuadrant.io",
+		Version:  "v1beta2",
+		Kind:     "AuthConfig",
+		Resource: "authconfigs",
+	}
+
+	// Auth is the OpenShift AI platform Auth service resource.
+	Auth = ResourceType{
+		Group:    "services.platform.opendatahub.io",
+		Version:  "v1alpha1",
+		Kind:     "Auth",
+		Resource: "auths",
+	}
+
 	// LLMInferenceService is the llm-d LLMInferenceService resource.
 	LLMInferenceService = ResourceType{
 		Group:    "serving.kserve.io",
@@ -515,6 +628,14 @@ var (
 		Resource: "trustyaiservices",
 	}
 
+	// ModelRegistry is the model registry operator's ModelRegistry instance resource.
+	ModelRegistry = ResourceType{
+		Group:    "modelregistry.opendatahub.io",
+		Version:  "v1beta1",
+		Kind:     "ModelRegistry",
+		Resource: "modelregistries",
+	}
+
 	// ServiceMeshControlPlane is the OSSM v2 ServiceMeshControlPlane resource.
 	ServiceMeshControlPlane = ResourceType{
 		Group:    "maistra.io",
@@ -0,0 +1,44 @@
+package schema
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/santhosh-tekuri/jsonschema/v6"
+)
+
+// Validate checks a JSON document against the embedded JSON Schema for the given type.
+func Validate(schemaType SchemaType, document []byte) error {
+	data, err := Get(schemaType)
+	if err != nil {
+		return err
+	}
+
+	schemaDoc, err := jsonschema.UnmarshalJSON(bytes.NewReader(data))
+	if err != nil {
+		return fmt.Errorf("parsing schema %q: %w", schemaType, err)
+	}
+
+	location := string(schemaType) + ".json"
+
+	compiler := jsonschema.NewCompiler()
+	if err := compiler.AddResource(location, schemaDoc); err != nil {
+		return fmt.Errorf("loading schema %q: %w", schemaType, err)
+	}
+
+	compiled, err := compiler.Compile(location)
+	if err != nil {
+		return fmt.Errorf("compiling schema %q: %w", schemaType, err)
+	}
+
+	instance, err := jsonschema.UnmarshalJSON(bytes.NewReader(document))
+	if err != nil {
+		return fmt.Errorf("parsing document: %w", err)
+	}
+
+	if err := compiled.Validate(instance); err != nil {
+		return fmt.Errorf("document does not conform to schema %q: %w", schemaType, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,41 @@
+package schema_test
+
+import (
+	"testing"
+
+	"github.com/opendatahub-io/odh-cli/pkg/schema"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestValidate_ConformingDocument(t *testing.T) {
+	g := NewWithT(t)
+
+	doc := []byte(`{"version": "v1.0.0", "commit": "abc123", "date": "2025-01-01"}`)
+
+	g.Expect(schema.Validate(schema.SchemaVersionInfo, doc)).To(Succeed())
+}
+
+func TestValidate_NonConformingDocument(t *testing.T) {
+	g := NewWithT(t)
+
+	doc := []byte(`{"version": 1, "commit": "abc123", "date": "2025-01-01"}`)
+
+	err := schema.Validate(schema.SchemaVersionInfo, doc)
+	g.Expect(err).To(HaveOccurred())
+	g.Expect(err.Error()).To(ContainSubstring("does not conform"))
+}
+
+func TestValidate_InvalidJSON(t *testing.T) {
+	g := NewWithT(t)
+
+	err := schema.Validate(schema.SchemaVersionInfo, []byte(`{`))
+	g.Expect(err).To(MatchError(ContainSubstring("parsing document")))
+}
+
+func TestValidate_UnknownSchema(t *testing.T) {
+	g := NewWithT(t)
+
+	err := schema.Validate("nonexistent_schema", []byte(`{}`))
+	g.Expect(err).To(MatchError(ContainSubstring("not found")))
+}
@@ -0,0 +1,70 @@
+package status
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+
+	"github.com/opendatahub-io/odh-cli/pkg/components"
+	utilcolor "github.com/opendatahub-io/odh-cli/pkg/util/color"
+)
+
+// collectComponents reads the DSC components and the readiness reported by their component CRs.
+// Returns nil when components are excluded, no client is configured, or the DSC is missing
+// (non-fatal).
+func (c *Command) collectComponents(ctx context.Context) []components.ComponentInfo {
+	if !c.IncludeComponents || c.client == nil {
+		return nil
+	}
+
+	comps, err := components.DiscoverComponents(ctx, c.client)
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			_, _ = fmt.Fprintf(c.IO.ErrOut(), "Components: skipped (%v)\n", err)
+		}
+
+		return nil
+	}
+
+	return components.EnrichWithHealth(ctx, c.client, comps)
+}
+
+// renderComponentsTable writes one line per active component with its readiness.
+// Removed components are omitted.
+func (c *Command) renderComponentsTable(w io.Writer, comps []components.ComponentInfo) error {
+	if _, err := fmt.Fprintln(w, "Components:"); err != nil {
+		return fmt.Errorf("writing components header: %w", err)
+	}
+
+	for _, comp := range comps {
+		if !comp.IsActive() {
+			continue
+		}
+
+		line := fmt.Sprintf("  %s %s (%s)", componentStatusSymbol(comp), comp.Name, comp.ManagementState)
+
+		if comp.Message != "" && (comp.Ready == nil || !*comp.Ready) {
+			line += " - " + truncate(comp.Message, maxDetailLen)
+		}
+
+		if _, err := fmt.Fprintln(w, line); err != nil {
+			return fmt.Errorf("writing component line: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// componentStatusSymbol returns the appropriate symbol for a component's readiness.
+func componentStatusSymbol(comp components.ComponentInfo) string {
+	switch {
+	case comp.Ready == nil:
+		return utilcolor.StatusUnknown()
+	case *comp.Ready:
+		return utilcolor.StatusPass()
+	default:
+		return utilcolor.StatusFail()
+	}
+}
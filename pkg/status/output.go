@@ -6,14 +6,20 @@ import (
 
 	"github.com/opendatahub-io/opendatahub-operator/pkg/clusterhealth"
 
+	"github.com/opendatahub-io/odh-cli/pkg/components"
 	"github.com/opendatahub-io/odh-cli/pkg/deps"
 	"github.com/opendatahub-io/odh-cli/pkg/printer/json"
 	"github.com/opendatahub-io/odh-cli/pkg/printer/yaml"
 )
 
 // renderJSON writes the report as JSON with envelope to w.
-func renderJSON(w io.Writer, report *clusterhealth.Report, depStatuses []deps.DependencyStatus) error {
-	statusReport := NewStatusReport(report, depStatuses)
+func renderJSON(
+	w io.Writer,
+	report *clusterhealth.Report,
+	depStatuses []deps.DependencyStatus,
+	comps []components.ComponentInfo,
+) error {
+	statusReport := NewStatusReport(report, depStatuses, comps)
 	renderer := json.NewRenderer[*StatusReport](
 		json.WithWriter[*StatusReport](w),
 	)
@@ -26,8 +32,13 @@ func renderJSON(w io.Writer, report *clusterhealth.Report, depStatuses []deps.De
 }
 
 // renderYAML writes the report as YAML with envelope to w.
-func renderYAML(w io.Writer, report *clusterhealth.Report, depStatuses []deps.DependencyStatus) error {
-	statusReport := NewStatusReport(report, depStatuses)
+func renderYAML(
+	w io.Writer,
+	report *clusterhealth.Report,
+	depStatuses []deps.DependencyStatus,
+	comps []components.ComponentInfo,
+) error {
+	statusReport := NewStatusReport(report, depStatuses, comps)
 	renderer := yaml.NewRenderer[*StatusReport](
 		yaml.WithWriter[*StatusReport](w),
 	)
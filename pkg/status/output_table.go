@@ -9,6 +9,7 @@ import (
 
 	"github.com/opendatahub-io/opendatahub-operator/pkg/clusterhealth"
 
+	"github.com/opendatahub-io/odh-cli/pkg/components"
 	"github.com/opendatahub-io/odh-cli/pkg/deps"
 	utilcolor "github.com/opendatahub-io/odh-cli/pkg/util/color"
 )
@@ -102,7 +103,11 @@ func truncate(s string, maxLen int) string {
 }
 
 // renderTableOutput renders the report as a formatted table with symbols.
-func (c *Command) renderTableOutput(report *clusterhealth.Report, depStatuses []deps.DependencyStatus) error {
+func (c *Command) renderTableOutput(
+	report *clusterhealth.Report,
+	depStatuses []deps.DependencyStatus,
+	comps []components.ComponentInfo,
+) error {
 	w := c.IO.Out()
 
 	// Build section filter set
@@ -137,6 +142,16 @@ func (c *Command) renderTableOutput(report *clusterhealth.Report, depStatuses []
 		}
 	}
 
+	// Print component health if available
+	if len(comps) > 0 {
+		if _, err := fmt.Fprintln(w); err != nil {
+			return fmt.Errorf("writing newline: %w", err)
+		}
+		if err := c.renderComponentsTable(w, comps); err != nil {
+			return err
+		}
+	}
+
 	// Print dependencies if available
 	if len(depStatuses) > 0 {
 		if _, err := fmt.Fprintln(w); err != nil {
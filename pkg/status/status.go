@@ -22,6 +22,7 @@ import (
 
 	"github.com/opendatahub-io/odh-cli/pkg/api"
 	"github.com/opendatahub-io/odh-cli/pkg/cmd"
+	"github.com/opendatahub-io/odh-cli/pkg/components"
 	"github.com/opendatahub-io/odh-cli/pkg/deps"
 	"github.com/opendatahub-io/odh-cli/pkg/util/client"
 	"github.com/opendatahub-io/odh-cli/pkg/util/iostreams"
@@ -53,19 +54,20 @@ const (
 )
 
 const (
-	flagDescOutput      = `Output format: "table", "json", or "yaml"`
-	flagDescVerbose     = "Show per-item details for each section"
-	flagDescSection     = "Limit output to specific sections (repeatable): nodes, deployments, pods, events, quotas, operator, dsci, dsc"
-	flagDescLayer       = "Limit output to a layer (repeatable): infrastructure (nodes), workload (deployments, pods, events, quotas), operator (operator, dsci, dsc)"
-	flagDescNoColor     = "Disable color output"
-	flagDescTimeout     = "Maximum time to wait for health checks to complete"
-	flagDescAppsNS      = "Override the applications namespace (auto-detected from DSCI)"
-	flagDescOperNS      = "Override the operator namespace (auto-detected from OLM/CSV)"
-	flagDescOperName    = "Override the operator deployment name (auto-detected from CSV)"
-	flagDescInfra       = "Also scan kube-system for core infrastructure health"
-	flagDescIncludeDeps = "Include dependency operator status in output"
-	flagDescQPS         = "Kubernetes API queries per second"
-	flagDescBurst       = "Kubernetes API burst capacity"
+	flagDescOutput       = `Output format: "table", "json", or "yaml"`
+	flagDescVerbose      = "Show per-item details for each section"
+	flagDescSection      = "Limit output to specific sections (repeatable): nodes, deployments, pods, events, quotas, operator, dsci, dsc"
+	flagDescLayer        = "Limit output to a layer (repeatable): infrastructure (nodes), workload (deployments, pods, events, quotas), operator (operator, dsci, dsc)"
+	flagDescNoColor      = "Disable color output"
+	flagDescTimeout      = "Maximum time to wait for health checks to complete"
+	flagDescAppsNS       = "Override the applications namespace (auto-detected from DSCI)"
+	flagDescOperNS       = "Override the operator namespace (auto-detected from OLM/CSV)"
+	flagDescOperName     = "Override the operator deployment name (auto-detected from CSV)"
+	flagDescInfra        = "Also scan kube-system for core infrastructure health"
+	flagDescIncludeDeps  = "Include dependency operator status in output"
+	flagDescIncludeComps = "Include the readiness of each enabled DSC component in output"
+	flagDescQPS          = "Kubernetes API queries per second"
+	flagDescBurst        = "Kubernetes API burst capacity"
 )
 
 // Command contains the status command configuration.
@@ -88,6 +90,7 @@ type Command struct {
 	OperatorName      string
 	IncludeInfra      bool
 	IncludeDeps       bool
+	IncludeComponents bool
 
 	QPS   float32
 	Burst int
@@ -118,9 +121,10 @@ func NewCommand(
 		WaitOptions: cmd.WaitOptions{
 			PollInterval: cmd.DefaultPollInterval,
 		},
-		IncludeDeps: true,
-		QPS:         client.DefaultQPS,
-		Burst:       client.DefaultBurst,
+		IncludeDeps:       true,
+		IncludeComponents: true,
+		QPS:               client.DefaultQPS,
+		Burst:             client.DefaultBurst,
 	}
 }
 
@@ -138,6 +142,7 @@ func (c *Command) AddFlags(fs *pflag.FlagSet) {
 	fs.StringVar(&c.OperatorName, "operator-name", "", flagDescOperName)
 	fs.BoolVar(&c.IncludeInfra, "include-infra", false, flagDescInfra)
 	fs.BoolVar(&c.IncludeDeps, "include-deps", c.IncludeDeps, flagDescIncludeDeps)
+	fs.BoolVar(&c.IncludeComponents, "include-components", c.IncludeComponents, flagDescIncludeComps)
 	c.AddWaitFlags(fs, []string{WaitConditionHealthy})
 	c.AddWatchFlag(fs)
 	fs.Float32Var(&c.QPS, "qps", c.QPS, flagDescQPS)
@@ -252,22 +257,29 @@ func (c *Command) checkDependencies(ctx context.Context) []deps.DependencyStatus
 	return statuses
 }
 
-// output renders the report in the requested format.
+// output renders the report in the requested format, along with the component health.
 func (c *Command) output(ctx context.Context, report *clusterhealth.Report, depStatuses []deps.DependencyStatus) error {
+	comps := c.collectComponents(ctx)
+
 	switch c.OutputFormat {
 	case OutputFormatTable:
-		return c.outputTable(ctx, report, depStatuses)
+		return c.outputTable(ctx, report, depStatuses, comps)
 	case OutputFormatJSON:
-		return c.outputJSON(report, depStatuses)
+		return renderJSON(c.IO.Out(), report, depStatuses, comps)
 	case OutputFormatYAML:
-		return c.outputYAML(report, depStatuses)
+		return renderYAML(c.IO.Out(), report, depStatuses, comps)
 	default:
 		return fmt.Errorf("unsupported output format: %s", c.OutputFormat)
 	}
 }
 
 // outputTable renders the report as a human-readable table.
-func (c *Command) outputTable(ctx context.Context, report *clusterhealth.Report, depStatuses []deps.DependencyStatus) error {
+func (c *Command) outputTable(
+	ctx context.Context,
+	report *clusterhealth.Report,
+	depStatuses []deps.DependencyStatus,
+	comps []components.ComponentInfo,
+) error {
 	w := c.IO.Out()
 
 	// Detect versions concurrently
@@ -309,7 +321,7 @@ func (c *Command) outputTable(ctx context.Context, report *clusterhealth.Report,
 		return fmt.Errorf("writing newline: %w", err)
 	}
 
-	return c.renderTableOutput(report, depStatuses)
+	return c.renderTableOutput(report, depStatuses, comps)
 }
 
 // formatPlatformStatus returns colored "Healthy" or "Unhealthy" based on report state.
@@ -346,16 +358,6 @@ func (c *Command) writeEnvironmentSection(w io.Writer, ver, ocpVer *semver.Versi
 	return nil
 }
 
-// outputJSON renders the report as JSON with envelope.
-func (c *Command) outputJSON(report *clusterhealth.Report, depStatuses []deps.DependencyStatus) error {
-	return renderJSON(c.IO.Out(), report, depStatuses)
-}
-
-// outputYAML renders the report as YAML with envelope.
-func (c *Command) outputYAML(report *clusterhealth.Report, depStatuses []deps.DependencyStatus) error {
-	return renderYAML(c.IO.Out(), report, depStatuses)
-}
-
 // Validate checks if the output format is valid.
 func (o OutputFormat) Validate() error {
 	switch o {
@@ -3,6 +3,7 @@ package status
 import (
 	"github.com/opendatahub-io/opendatahub-operator/pkg/clusterhealth"
 
+	"github.com/opendatahub-io/odh-cli/pkg/components"
 	"github.com/opendatahub-io/odh-cli/pkg/deps"
 	"github.com/opendatahub-io/odh-cli/pkg/output"
 )
@@ -11,23 +12,30 @@ import (
 type StatusReport struct {
 	output.Envelope
 
-	Report       *clusterhealth.Report   `json:"report"                 yaml:"report"`
-	Dependencies []deps.DependencyStatus `json:"dependencies,omitempty" yaml:"dependencies,omitempty"`
+	Report       *clusterhealth.Report      `json:"report"                 yaml:"report"`
+	Dependencies []deps.DependencyStatus    `json:"dependencies,omitempty" yaml:"dependencies,omitempty"`
+	Components   []components.ComponentInfo `json:"components,omitempty"   yaml:"components,omitempty"`
 }
 
 // NewStatusReport creates a new StatusReport with envelope fields populated.
-func NewStatusReport(report *clusterhealth.Report, depStatuses []deps.DependencyStatus) *StatusReport {
+func NewStatusReport(
+	report *clusterhealth.Report,
+	depStatuses []deps.DependencyStatus,
+	comps []components.ComponentInfo,
+) *StatusReport {
 	sr := &StatusReport{
 		Envelope:     output.NewEnvelope("StatusReport", "status"),
 		Report:       report,
 		Dependencies: depStatuses,
+		Components:   comps,
 	}
 	sr.computeStatus()
 
 	return sr
 }
 
-// computeStatus calculates warnings/errors from the report sections and dependencies.
+// computeStatus calculates warnings/errors from the report sections, dependencies and
+// active components.
 func (sr *StatusReport) computeStatus() {
 	errors := sr.countSectionErrors()
 	warnings := 0
@@ -50,6 +58,19 @@ func (sr *StatusReport) computeStatus() {
 		}
 	}
 
+	// Active components that are not ready are errors; unknown readiness is a warning
+	for _, comp := range sr.Components {
+		if !comp.IsActive() {
+			continue
+		}
+
+		if comp.Ready == nil {
+			warnings++
+		} else if !*comp.Ready {
+			errors++
+		}
+	}
+
 	sr.SetStatus(warnings, errors)
 }
 
@@ -6,6 +6,7 @@ import (
 
 	"github.com/opendatahub-io/opendatahub-operator/pkg/clusterhealth"
 
+	"github.com/opendatahub-io/odh-cli/pkg/components"
 	"github.com/opendatahub-io/odh-cli/pkg/deps"
 	"github.com/opendatahub-io/odh-cli/pkg/output"
 	"github.com/opendatahub-io/odh-cli/pkg/status"
@@ -25,7 +26,7 @@ func TestNewStatusReport_EnvelopeFields(t *testing.T) {
 		CollectedAt: fixedTestTime,
 	}
 
-	sr := status.NewStatusReport(report, nil)
+	sr := status.NewStatusReport(report, nil, nil)
 
 	g.Expect(sr.APIVersion).To(Equal(output.APIVersion))
 	g.Expect(sr.Kind).To(Equal("StatusReport"))
@@ -48,7 +49,7 @@ func TestNewStatusReport_ComputeStatus_Healthy(t *testing.T) {
 		DSC:         clusterhealth.SectionResult[clusterhealth.CRConditionsSection]{},
 	}
 
-	sr := status.NewStatusReport(report, nil)
+	sr := status.NewStatusReport(report, nil, nil)
 
 	g.Expect(sr.Status).NotTo(BeNil())
 	g.Expect(sr.Status.Result).To(Equal(output.StatusSuccess))
@@ -71,7 +72,7 @@ func TestNewStatusReport_ComputeStatus_WithSectionErrors(t *testing.T) {
 		DSC:         clusterhealth.SectionResult[clusterhealth.CRConditionsSection]{},
 	}
 
-	sr := status.NewStatusReport(report, nil)
+	sr := status.NewStatusReport(report, nil, nil)
 
 	g.Expect(sr.Status).NotTo(BeNil())
 	g.Expect(sr.Status.Result).To(Equal(output.StatusFailure))
@@ -91,7 +92,7 @@ func TestNewStatusReport_ComputeStatus_WithDependencyErrors(t *testing.T) {
 		{Name: "authorino", Status: deps.StatusUnknown},
 	}
 
-	sr := status.NewStatusReport(report, depStatuses)
+	sr := status.NewStatusReport(report, depStatuses, nil)
 
 	g.Expect(sr.Status).NotTo(BeNil())
 	g.Expect(sr.Status.Errors).To(Equal(1), "missing dep should count as error")
@@ -110,7 +111,7 @@ func TestNewStatusReport_ComputeStatus_WithDependencyAPIError(t *testing.T) {
 		{Name: "serverless", Status: deps.StatusUnknown, Error: "get subscription failed"},
 	}
 
-	sr := status.NewStatusReport(report, depStatuses)
+	sr := status.NewStatusReport(report, depStatuses, nil)
 
 	g.Expect(sr.Status).NotTo(BeNil())
 	g.Expect(sr.Status.Errors).To(Equal(1), "dep.Error should count as error")
@@ -129,8 +130,33 @@ func TestNewStatusReport_IncludesDependencies(t *testing.T) {
 		{Name: "serverless", DisplayName: "Serverless", Status: deps.StatusMissing},
 	}
 
-	sr := status.NewStatusReport(report, depStatuses)
+	sr := status.NewStatusReport(report, depStatuses, nil)
 
 	g.Expect(sr.Dependencies).To(HaveLen(2))
 	g.Expect(sr.Dependencies[0].Name).To(Equal("servicemesh"))
 }
+
+func TestNewStatusReport_ComputeStatus_WithComponents(t *testing.T) {
+	g := NewWithT(t)
+
+	report := &clusterhealth.Report{
+		CollectedAt: fixedTestTime,
+	}
+
+	ready := true
+	notReady := false
+
+	comps := []components.ComponentInfo{
+		{Name: "dashboard", ManagementState: "Managed", Ready: &ready},
+		{Name: "kserve", ManagementState: "Managed", Ready: &notReady, Message: "deployment not available"},
+		{Name: "ray", ManagementState: "Unmanaged"},
+		{Name: "trainingoperator", ManagementState: "Removed", Ready: &notReady},
+	}
+
+	sr := status.NewStatusReport(report, nil, comps)
+
+	g.Expect(sr.Components).To(HaveLen(4))
+	g.Expect(sr.Status).NotTo(BeNil())
+	g.Expect(sr.Status.Errors).To(Equal(1), "not-ready active component should count as error")
+	g.Expect(sr.Status.Warnings).To(Equal(1), "unknown readiness should count as warning")
+}
@@ -87,7 +87,7 @@ func snapshotReport(report *clusterhealth.Report) []byte {
 
 // streamJSON writes a single StatusReport as a compact NDJSON line.
 func streamJSON(w io.Writer, report *clusterhealth.Report, depStatuses []deps.DependencyStatus) error {
-	statusReport := NewStatusReport(report, depStatuses)
+	statusReport := NewStatusReport(report, depStatuses, nil)
 
 	data, err := json.Marshal(statusReport)
 	if err != nil {
@@ -108,5 +108,5 @@ func streamYAML(w io.Writer, report *clusterhealth.Report, depStatuses []deps.De
 		return fmt.Errorf("writing YAML separator: %w", err)
 	}
 
-	return renderYAML(w, report, depStatuses)
+	return renderYAML(w, report, depStatuses, nil)
 }
@@ -0,0 +1,215 @@
+package upgrade
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/blang/semver/v4"
+	"github.com/spf13/pflag"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/opendatahub-io/odh-cli/pkg/api"
+	"github.com/opendatahub-io/odh-cli/pkg/cmd"
+	printerjson "github.com/opendatahub-io/odh-cli/pkg/printer/json"
+	printeryaml "github.com/opendatahub-io/odh-cli/pkg/printer/yaml"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+	clierrors "github.com/opendatahub-io/odh-cli/pkg/util/errors"
+	"github.com/opendatahub-io/odh-cli/pkg/util/iostreams"
+	"github.com/opendatahub-io/odh-cli/pkg/util/version"
+)
+
+var _ cmd.Command = (*SimulateCommand)(nil)
+
+// OutputFormat represents the output format of the simulate command.
+type OutputFormat string
+
+const (
+	OutputFormatDiff OutputFormat = "diff"
+	OutputFormatYAML OutputFormat = "yaml"
+	OutputFormatJSON OutputFormat = "json"
+
+	flagDescOutput        = "output format (diff|yaml|json)"
+	flagDescTargetVersion = "Target version to simulate the upgrade to (required)"
+	flagDescRules         = "Path to a transformation rules file overriding the embedded rules"
+
+	msgSimulating      = "Simulating upgrade: %s → %s (the cluster is not modified)"
+	msgResourceMissing = "Warning: %s not found, skipping"
+)
+
+// Validate checks if the output format is valid.
+func (o OutputFormat) Validate() error {
+	switch o {
+	case OutputFormatDiff, OutputFormatYAML, OutputFormatJSON:
+		return nil
+	default:
+		return fmt.Errorf("invalid output format: %s (must be one of: diff, yaml, json)", o)
+	}
+}
+
+// SimulateCommand previews the DSC and DSCI after an upgrade by applying the transformation
+// rules of every release up to the target version. It only reads from the cluster.
+type SimulateCommand struct {
+	IO          iostreams.Interface
+	ConfigFlags *genericclioptions.ConfigFlags
+	Client      client.Client
+
+	// OutputFormat specifies the output format (diff, yaml, json).
+	OutputFormat OutputFormat
+	// TargetVersion is the version the upgrade is simulated to.
+	TargetVersion string
+	// RulesFile overrides the embedded transformation rules.
+	RulesFile string
+
+	parsedTargetVersion *semver.Version
+	rules               *Rules
+}
+
+// NewSimulateCommand creates a new SimulateCommand with defaults.
+func NewSimulateCommand(
+	streams genericiooptions.IOStreams,
+	configFlags *genericclioptions.ConfigFlags,
+) *SimulateCommand {
+	return &SimulateCommand{
+		IO:           iostreams.NewIOStreams(streams.In, streams.Out, streams.ErrOut),
+		ConfigFlags:  configFlags,
+		OutputFormat: OutputFormatDiff,
+	}
+}
+
+// AddFlags registers command-specific flags.
+func (c *SimulateCommand) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVarP((*string)(&c.OutputFormat), "output", "o", string(OutputFormatDiff), flagDescOutput)
+	_ = fs.SetAnnotation("output", api.AnnotationValidValues, []string{"diff", "yaml", "json"})
+	fs.StringVar(&c.TargetVersion, "target-version", "", flagDescTargetVersion)
+	fs.StringVar(&c.RulesFile, "rules", "", flagDescRules)
+}
+
+// Complete creates the client, parses the target version and loads the rules.
+func (c *SimulateCommand) Complete() error {
+	if c.Client == nil {
+		k8sClient, err := client.NewClient(c.ConfigFlags)
+		if err != nil {
+			return fmt.Errorf("creating Kubernetes client: %w", err)
+		}
+
+		c.Client = k8sClient
+	}
+
+	if c.TargetVersion != "" {
+		// Use ParseTolerant to accept partial versions (e.g., "3.0" → "3.0.0")
+		targetVer, err := semver.ParseTolerant(c.TargetVersion)
+		if err != nil {
+			return fmt.Errorf("invalid target version %q: %w", c.TargetVersion, err)
+		}
+
+		c.parsedTargetVersion = &targetVer
+	}
+
+	c.rules = DefaultRules()
+
+	if c.RulesFile != "" {
+		rules, err := LoadRules(c.RulesFile)
+		if err != nil {
+			return err
+		}
+
+		c.rules = rules
+	}
+
+	return nil
+}
+
+// Validate checks that all required options are valid.
+func (c *SimulateCommand) Validate() error {
+	if err := c.OutputFormat.Validate(); err != nil {
+		return err
+	}
+
+	if c.TargetVersion == "" {
+		return errors.New("--target-version is required")
+	}
+
+	return nil
+}
+
+// Run reads the DSC and DSCI, simulates the upgrade and writes the result.
+func (c *SimulateCommand) Run(ctx context.Context) error {
+	currentVersion, err := version.Detect(ctx, c.Client)
+	if err != nil {
+		return fmt.Errorf("detecting cluster version: %w", err)
+	}
+
+	if c.parsedTargetVersion.LT(*currentVersion) {
+		//nolint:wrapcheck // NewExitCodeError is a same-module constructor, not an external error
+		return clierrors.NewExitCodeError(clierrors.ExitValidation,
+			fmt.Errorf("target version %s is older than current version %s (downgrades not supported)",
+				c.TargetVersion, currentVersion.String()))
+	}
+
+	c.IO.Errorf(msgSimulating, currentVersion.String(), c.parsedTargetVersion.String())
+
+	dsc, err := c.getResource(ctx, "DataScienceCluster", version.GetDataScienceCluster)
+	if err != nil {
+		return err
+	}
+
+	dsci, err := c.getResource(ctx, "DSCInitialization", version.GetDSCInitialization)
+	if err != nil {
+		return err
+	}
+
+	if dsc == nil && dsci == nil {
+		return errors.New("neither a DataScienceCluster nor a DSCInitialization was found")
+	}
+
+	sim, err := Simulate(c.rules, *currentVersion, *c.parsedTargetVersion, dsc, dsci)
+	if err != nil {
+		return fmt.Errorf("simulating upgrade: %w", err)
+	}
+
+	switch c.OutputFormat {
+	case OutputFormatJSON:
+		renderer := printerjson.NewRenderer[*Simulation](printerjson.WithWriter[*Simulation](c.IO.Out()))
+		if err := renderer.Render(sim); err != nil {
+			return fmt.Errorf("rendering JSON output: %w", err)
+		}
+
+		return nil
+	case OutputFormatYAML:
+		renderer := printeryaml.NewRenderer[*Simulation](printeryaml.WithWriter[*Simulation](c.IO.Out()))
+		if err := renderer.Render(sim); err != nil {
+			return fmt.Errorf("rendering YAML output: %w", err)
+		}
+
+		return nil
+	case OutputFormatDiff:
+		return WriteDiff(c.IO.Out(), sim)
+	default:
+		return fmt.Errorf("unsupported output format: %s", c.OutputFormat)
+	}
+}
+
+// getResource fetches a singleton, returning nil with a warning when it does not exist.
+func (c *SimulateCommand) getResource(
+	ctx context.Context,
+	kind string,
+	get func(context.Context, client.Reader) (*unstructured.Unstructured, error),
+) (*unstructured.Unstructured, error) {
+	obj, err := get(ctx, c.Client)
+
+	switch {
+	case apierrors.IsNotFound(err):
+		c.IO.Errorf(msgResourceMissing, kind)
+
+		return nil, nil
+	case err != nil:
+		return nil, fmt.Errorf("getting %s: %w", kind, err)
+	}
+
+	return obj, nil
+}
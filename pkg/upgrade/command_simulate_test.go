@@ -0,0 +1,92 @@
+package upgrade_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/upgrade"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+
+	. "github.com/onsi/gomega"
+)
+
+func newTestSimulateCommand(t *testing.T, objects ...runtime.Object) (*upgrade.SimulateCommand, *bytes.Buffer) {
+	t.Helper()
+
+	listKinds := map[schema.GroupVersionResource]string{
+		resources.DataScienceClusterV1.GVR(): resources.DataScienceClusterV1.ListKind(),
+		resources.DSCInitializationV1.GVR():  resources.DSCInitializationV1.ListKind(),
+	}
+
+	var out bytes.Buffer
+
+	cmd := upgrade.NewSimulateCommand(genericiooptions.IOStreams{Out: &out, ErrOut: &bytes.Buffer{}}, nil)
+	cmd.Client = client.NewForTesting(client.TestClientConfig{
+		Dynamic: dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), listKinds, objects...),
+	})
+
+	return cmd, &out
+}
+
+func TestSimulateCommand_Validate(t *testing.T) {
+	g := NewWithT(t)
+
+	cmd, _ := newTestSimulateCommand(t)
+	g.Expect(cmd.Complete()).To(Succeed())
+	g.Expect(cmd.Validate()).To(MatchError(ContainSubstring("--target-version is required")))
+
+	cmd.TargetVersion = "3.0"
+	cmd.OutputFormat = "table"
+	g.Expect(cmd.Validate()).To(MatchError(ContainSubstring("invalid output format")))
+}
+
+func TestSimulateCommand_Run(t *testing.T) {
+	t.Run("renders the diff", func(t *testing.T) {
+		g := NewWithT(t)
+
+		cmd, out := newTestSimulateCommand(t, newDSC(newComponents()), newDSCI(map[string]any{}))
+		cmd.TargetVersion = "3.0"
+
+		g.Expect(cmd.Complete()).To(Succeed())
+		g.Expect(cmd.Validate()).To(Succeed())
+		g.Expect(cmd.Run(t.Context())).To(Succeed())
+		g.Expect(out.String()).To(ContainSubstring("+apiVersion: datasciencecluster.opendatahub.io/v2"))
+		g.Expect(out.String()).To(ContainSubstring("+apiVersion: dscinitialization.opendatahub.io/v2"))
+	})
+
+	t.Run("renders JSON", func(t *testing.T) {
+		g := NewWithT(t)
+
+		cmd, out := newTestSimulateCommand(t, newDSC(newComponents()))
+		cmd.TargetVersion = "3.0"
+		cmd.OutputFormat = upgrade.OutputFormatJSON
+
+		g.Expect(cmd.Complete()).To(Succeed())
+		g.Expect(cmd.Run(t.Context())).To(Succeed())
+
+		var sim upgrade.Simulation
+		g.Expect(json.Unmarshal(out.Bytes(), &sim)).To(Succeed())
+		g.Expect(sim.Kind).To(Equal("UpgradeSimulation"))
+		g.Expect(sim.CurrentVersion).To(Equal("2.25.0"))
+		g.Expect(sim.TargetVersion).To(Equal("3.0.0"))
+		g.Expect(sim.Resources).To(HaveLen(1))
+		g.Expect(sim.Changes).ToNot(BeEmpty())
+	})
+
+	t.Run("rejects downgrades", func(t *testing.T) {
+		g := NewWithT(t)
+
+		cmd, _ := newTestSimulateCommand(t, newDSC(newComponents()))
+		cmd.TargetVersion = "2.24"
+
+		g.Expect(cmd.Complete()).To(Succeed())
+		g.Expect(cmd.Run(t.Context())).To(MatchError(ContainSubstring("downgrades not supported")))
+	})
+}
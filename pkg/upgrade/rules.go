@@ -0,0 +1,239 @@
+package upgrade
+
+import (
+	"bytes"
+	_ "embed"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/blang/semver/v4"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/opendatahub-io/odh-cli/pkg/util/stdin"
+)
+
+// Resources the rules transform.
+const (
+	ResourceDSC  = "dsc"
+	ResourceDSCI = "dsci"
+)
+
+// Op is the operation a rule applies to a field.
+type Op string
+
+const (
+	// OpSet replaces the value at the path; it is skipped when the parent field is absent so
+	// rules never add components that are not configured.
+	OpSet Op = "set"
+	// OpRename moves the value at the path to the rule's To path.
+	OpRename Op = "rename"
+	// OpRemove deletes the field at the path.
+	OpRemove Op = "remove"
+)
+
+//go:embed data/rules.yaml
+var embeddedRules []byte
+
+// Rules are the per-release transformations applied to the DSC and DSCI by an upgrade.
+type Rules struct {
+	// Releases lists the releases that change the DSC or DSCI.
+	Releases []Release `json:"releases" yaml:"releases"`
+}
+
+// Release holds the transformations introduced by a major.minor release.
+type Release struct {
+	// Version is the major.minor release, e.g. "3.0".
+	Version string `json:"version" yaml:"version"`
+
+	// Rules are applied in order.
+	Rules []Rule `json:"rules" yaml:"rules"`
+
+	// version is the parsed Version
+	version semver.Version
+}
+
+// Rule is a single field transformation.
+type Rule struct {
+	// Resource is the transformed resource (dsc, dsci).
+	Resource string `json:"resource" yaml:"resource"`
+
+	// Op is the operation (set, rename, remove).
+	Op Op `json:"op" yaml:"op"`
+
+	// Path is the dot-separated field path, e.g. "spec.components.codeflare".
+	Path string `json:"path" yaml:"path"`
+
+	// To is the destination path of a rename.
+	To string `json:"to,omitempty" yaml:"to,omitempty"`
+
+	// Value is the value written by a set.
+	Value any `json:"value,omitempty" yaml:"value,omitempty"`
+
+	// Description explains the change to the user.
+	Description string `json:"description" yaml:"description"`
+}
+
+// validate checks that the rule is well-formed for its operation.
+func (r *Rule) validate() error {
+	if r.Resource != ResourceDSC && r.Resource != ResourceDSCI {
+		return fmt.Errorf("invalid resource %q (must be one of: dsc, dsci)", r.Resource)
+	}
+
+	if r.Path == "" {
+		return errors.New("path must not be empty")
+	}
+
+	switch r.Op {
+	case OpSet:
+		if r.Value == nil {
+			return fmt.Errorf("%s: set requires a value", r.Path)
+		}
+	case OpRename:
+		if r.To == "" {
+			return fmt.Errorf("%s: rename requires a destination path", r.Path)
+		}
+	case OpRemove:
+	default:
+		return fmt.Errorf("%s: invalid op %q (must be one of: set, rename, remove)", r.Path, r.Op)
+	}
+
+	if r.Description == "" {
+		return fmt.Errorf("%s: description must not be empty", r.Path)
+	}
+
+	return nil
+}
+
+// apply transforms obj in place and reports whether it changed.
+func (r *Rule) apply(obj *unstructured.Unstructured) (bool, error) {
+	fields := strings.Split(r.Path, ".")
+
+	value, found, err := unstructured.NestedFieldNoCopy(obj.Object, fields...)
+	if err != nil {
+		return false, fmt.Errorf("reading %s: %w", r.Path, err)
+	}
+
+	switch r.Op {
+	case OpSet:
+		if found && reflect.DeepEqual(value, r.Value) {
+			return false, nil
+		}
+
+		if len(fields) > 1 {
+			if _, parentFound, _ := unstructured.NestedMap(obj.Object, fields[:len(fields)-1]...); !parentFound {
+				return false, nil
+			}
+		}
+
+		if err := unstructured.SetNestedField(obj.Object, r.Value, fields...); err != nil {
+			return false, fmt.Errorf("setting %s: %w", r.Path, err)
+		}
+	case OpRename:
+		if !found {
+			return false, nil
+		}
+
+		unstructured.RemoveNestedField(obj.Object, fields...)
+
+		if err := unstructured.SetNestedField(obj.Object, value, strings.Split(r.To, ".")...); err != nil {
+			return false, fmt.Errorf("setting %s: %w", r.To, err)
+		}
+	case OpRemove:
+		if !found {
+			return false, nil
+		}
+
+		unstructured.RemoveNestedField(obj.Object, fields...)
+	}
+
+	return true, nil
+}
+
+//nolint:gochecknoglobals // Parsed once from the embedded rules
+var defaultRules = sync.OnceValue(func() *Rules {
+	r, err := ParseRules(bytes.NewReader(embeddedRules))
+	if err != nil {
+		panic(fmt.Sprintf("parsing embedded upgrade rules: %v", err))
+	}
+
+	return r
+})
+
+// DefaultRules returns the transformation rules embedded in the binary.
+func DefaultRules() *Rules {
+	return defaultRules()
+}
+
+// LoadRules reads and validates a rules file, e.g. one passed with --rules.
+func LoadRules(path string) (*Rules, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("opening upgrade rules: %w", err)
+	}
+	defer func() { _ = f.Close() }()
+
+	r, err := ParseRules(f)
+	if err != nil {
+		return nil, fmt.Errorf("loading upgrade rules %s: %w", path, err)
+	}
+
+	return r, nil
+}
+
+// ParseRules strictly parses YAML/JSON transformation rules and validates them.
+// Releases are sorted in ascending version order.
+func ParseRules(r io.Reader) (*Rules, error) {
+	var rules Rules
+	if err := stdin.Parse(r, &rules); err != nil {
+		return nil, fmt.Errorf("parsing upgrade rules: %w", err)
+	}
+
+	if len(rules.Releases) == 0 {
+		return nil, errors.New("upgrade rules have no releases")
+	}
+
+	for i := range rules.Releases {
+		rel := &rules.Releases[i]
+
+		v, err := semver.ParseTolerant(rel.Version)
+		if err != nil {
+			return nil, fmt.Errorf("release %q: invalid version: %w", rel.Version, err)
+		}
+
+		rel.version = semver.Version{Major: v.Major, Minor: v.Minor}
+
+		for j := range rel.Rules {
+			if err := rel.Rules[j].validate(); err != nil {
+				return nil, fmt.Errorf("release %q: rule %d: %w", rel.Version, j+1, err)
+			}
+		}
+	}
+
+	slices.SortStableFunc(rules.Releases, func(a, b Release) int { return a.version.Compare(b.version) })
+
+	return &rules, nil
+}
+
+// Between returns the releases newer than current and no newer than target, compared by
+// major.minor, in ascending order.
+func (r *Rules) Between(current, target semver.Version) []Release {
+	from := semver.Version{Major: current.Major, Minor: current.Minor}
+	to := semver.Version{Major: target.Major, Minor: target.Minor}
+
+	var releases []Release
+
+	for _, rel := range r.Releases {
+		if rel.version.GT(from) && rel.version.LTE(to) {
+			releases = append(releases, rel)
+		}
+	}
+
+	return releases
+}
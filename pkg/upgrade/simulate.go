@@ -0,0 +1,192 @@
+package upgrade
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/blang/semver/v4"
+	"github.com/pmezard/go-difflib/difflib"
+	"sigs.k8s.io/yaml"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/opendatahub-io/odh-cli/pkg/output"
+)
+
+const (
+	simulationKind = "UpgradeSimulation"
+	diffContext    = 3
+)
+
+// Simulation is the previewed post-upgrade state of the DSC and DSCI.
+type Simulation struct {
+	output.Envelope
+
+	CurrentVersion string     `json:"currentVersion" yaml:"currentVersion"`
+	TargetVersion  string     `json:"targetVersion"  yaml:"targetVersion"`
+	Changes        []Change   `json:"changes"        yaml:"changes"`
+	Resources      []Resource `json:"resources"      yaml:"resources"`
+}
+
+// Change is a rule that changed a resource during the simulation.
+type Change struct {
+	// Release is the release introducing the change.
+	Release string `json:"release" yaml:"release"`
+
+	// Kind is the kind of the changed resource.
+	Kind string `json:"kind" yaml:"kind"`
+
+	Op          Op     `json:"op"              yaml:"op"`
+	Path        string `json:"path"            yaml:"path"`
+	To          string `json:"to,omitempty"    yaml:"to,omitempty"`
+	Value       any    `json:"value,omitempty" yaml:"value,omitempty"`
+	Description string `json:"description"     yaml:"description"`
+}
+
+// Resource holds the current and simulated manifests of a resource. Status and managed
+// fields are dropped since upgrades rewrite them.
+type Resource struct {
+	Kind      string         `json:"kind"      yaml:"kind"`
+	Name      string         `json:"name"      yaml:"name"`
+	Current   map[string]any `json:"current"   yaml:"current"`
+	Simulated map[string]any `json:"simulated" yaml:"simulated"`
+}
+
+// Simulate applies, in order, the rules of the releases between current and target to copies of
+// the DSC and DSCI. Either resource may be nil when it does not exist on the cluster.
+func Simulate(rules *Rules, current, target semver.Version, dsc, dsci *unstructured.Unstructured) (*Simulation, error) {
+	sim := &Simulation{
+		Envelope:       output.NewEnvelope(simulationKind, "upgrade simulate"),
+		CurrentVersion: current.String(),
+		TargetVersion:  target.String(),
+		Changes:        []Change{},
+		Resources:      []Resource{},
+	}
+
+	objects := map[string]*unstructured.Unstructured{}
+	order := make([]string, 0, 2) //nolint:mnd // DSC and DSCI
+
+	for _, entry := range []struct {
+		resource string
+		obj      *unstructured.Unstructured
+	}{
+		{ResourceDSC, dsc},
+		{ResourceDSCI, dsci},
+	} {
+		if entry.obj == nil {
+			continue
+		}
+
+		before := entry.obj.DeepCopy()
+		unstructured.RemoveNestedField(before.Object, "status")
+		unstructured.RemoveNestedField(before.Object, "metadata", "managedFields")
+
+		objects[entry.resource] = before.DeepCopy()
+		order = append(order, entry.resource)
+		sim.Resources = append(sim.Resources, Resource{
+			Kind:    before.GetKind(),
+			Name:    before.GetName(),
+			Current: before.Object,
+		})
+	}
+
+	for _, rel := range rules.Between(current, target) {
+		for _, rule := range rel.Rules {
+			obj, ok := objects[rule.Resource]
+			if !ok {
+				continue
+			}
+
+			changed, err := rule.apply(obj)
+			if err != nil {
+				return nil, fmt.Errorf("applying %s rule %s to %s: %w", rel.Version, rule.Op, obj.GetKind(), err)
+			}
+
+			if !changed {
+				continue
+			}
+
+			sim.Changes = append(sim.Changes, Change{
+				Release:     rel.Version,
+				Kind:        obj.GetKind(),
+				Op:          rule.Op,
+				Path:        rule.Path,
+				To:          rule.To,
+				Value:       rule.Value,
+				Description: rule.Description,
+			})
+		}
+	}
+
+	for i, resource := range order {
+		sim.Resources[i].Simulated = objects[resource].Object
+	}
+
+	return sim, nil
+}
+
+// WriteDiff writes a unified diff of the current and simulated YAML of every resource, followed
+// by the list of changes.
+func WriteDiff(w io.Writer, sim *Simulation) error {
+	for _, r := range sim.Resources {
+		before, err := yaml.Marshal(r.Current)
+		if err != nil {
+			return fmt.Errorf("marshaling current %s: %w", r.Kind, err)
+		}
+
+		after, err := yaml.Marshal(r.Simulated)
+		if err != nil {
+			return fmt.Errorf("marshaling simulated %s: %w", r.Kind, err)
+		}
+
+		diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+			A:        difflib.SplitLines(string(before)),
+			B:        difflib.SplitLines(string(after)),
+			FromFile: fmt.Sprintf("%s/%s (%s)", r.Kind, r.Name, sim.CurrentVersion),
+			ToFile:   fmt.Sprintf("%s/%s (%s, simulated)", r.Kind, r.Name, sim.TargetVersion),
+			Context:  diffContext,
+		})
+		if err != nil {
+			return fmt.Errorf("computing %s diff: %w", r.Kind, err)
+		}
+
+		if diff == "" {
+			diff = fmt.Sprintf("%s/%s: no changes\n", r.Kind, r.Name)
+		}
+
+		if _, err := io.WriteString(w, diff+"\n"); err != nil {
+			return fmt.Errorf("writing diff: %w", err)
+		}
+	}
+
+	var b strings.Builder
+
+	if len(sim.Changes) == 0 {
+		fmt.Fprintf(&b, "No changes expected when upgrading from %s to %s\n", sim.CurrentVersion, sim.TargetVersion)
+	} else {
+		b.WriteString("Changes:\n")
+
+		for _, c := range sim.Changes {
+			fmt.Fprintf(&b, "  [%s] %s: %s (%s)\n", c.Release, c.Kind, c.Description, changeSummary(c))
+		}
+	}
+
+	if _, err := io.WriteString(w, b.String()); err != nil {
+		return fmt.Errorf("writing changes: %w", err)
+	}
+
+	return nil
+}
+
+// changeSummary describes the field-level effect of a change.
+func changeSummary(c Change) string {
+	switch c.Op {
+	case OpRename:
+		return fmt.Sprintf("%s → %s", c.Path, c.To)
+	case OpSet:
+		return fmt.Sprintf("%s = %v", c.Path, c.Value)
+	default:
+		return string(c.Op) + " " + c.Path
+	}
+}
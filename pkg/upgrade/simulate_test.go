@@ -0,0 +1,180 @@
+package upgrade_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/blang/semver/v4"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/upgrade"
+
+	. "github.com/onsi/gomega"
+)
+
+func newDSC(components map[string]any) *unstructured.Unstructured {
+	return &unstructured.Unstructured{
+		Object: map[string]any{
+			"apiVersion": resources.DataScienceClusterV1.APIVersion(),
+			"kind":       resources.DataScienceClusterV1.Kind,
+			"metadata":   map[string]any{"name": "default-dsc"},
+			"spec":       map[string]any{"components": components},
+			"status":     map[string]any{"release": map[string]any{"version": "2.25.0"}},
+		},
+	}
+}
+
+func newDSCI(spec map[string]any) *unstructured.Unstructured {
+	return &unstructured.Unstructured{
+		Object: map[string]any{
+			"apiVersion": resources.DSCInitializationV1.APIVersion(),
+			"kind":       resources.DSCInitializationV1.Kind,
+			"metadata":   map[string]any{"name": "default-dsci"},
+			"spec":       spec,
+			"status":     map[string]any{"release": map[string]any{"version": "2.25.0"}},
+		},
+	}
+}
+
+func newComponents() map[string]any {
+	return map[string]any{
+		"datasciencepipelines": map[string]any{"managementState": "Managed"},
+		"modelmeshserving":     map[string]any{"managementState": "Removed"},
+		"kserve": map[string]any{
+			"managementState": "Managed",
+			"serving":         map[string]any{"managementState": "Managed"},
+		},
+		"dashboard": map[string]any{"managementState": "Managed"},
+	}
+}
+
+func TestSimulate_2xTo30(t *testing.T) {
+	g := NewWithT(t)
+
+	dsc := newDSC(newComponents())
+	dsci := newDSCI(map[string]any{
+		"applicationsNamespace": "redhat-ods-applications",
+		"serviceMesh":           map[string]any{"managementState": "Managed"},
+	})
+
+	sim, err := upgrade.Simulate(upgrade.DefaultRules(),
+		semver.MustParse("2.25.0"), semver.MustParse("3.0.0"), dsc, dsci)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(sim.Resources).To(HaveLen(2))
+
+	simulated := sim.Resources[0].Simulated
+	g.Expect(simulated).To(HaveKeyWithValue("apiVersion", "datasciencecluster.opendatahub.io/v2"))
+	g.Expect(simulated).ToNot(HaveKey("status"))
+
+	components, _, _ := unstructured.NestedMap(simulated, "spec", "components")
+	g.Expect(components).To(HaveKey("aipipelines"))
+	g.Expect(components).To(HaveKey("dashboard"))
+	g.Expect(components).ToNot(HaveKey("datasciencepipelines"))
+	g.Expect(components).ToNot(HaveKey("modelmeshserving"))
+	g.Expect(components["kserve"]).To(Equal(map[string]any{
+		"managementState":       "Managed",
+		"defaultDeploymentMode": "RawDeployment",
+	}))
+
+	g.Expect(sim.Resources[1].Simulated).To(HaveKeyWithValue("spec", map[string]any{
+		"applicationsNamespace": "redhat-ods-applications",
+	}))
+
+	// Rules for absent fields (codeflare) and later releases (3.5) do not apply.
+	paths := make([]string, 0, len(sim.Changes))
+	for _, c := range sim.Changes {
+		paths = append(paths, c.Kind+":"+c.Path)
+	}
+
+	g.Expect(paths).To(Equal([]string{
+		"DataScienceCluster:apiVersion",
+		"DataScienceCluster:spec.components.datasciencepipelines",
+		"DataScienceCluster:spec.components.modelmeshserving",
+		"DataScienceCluster:spec.components.kserve.serving",
+		"DataScienceCluster:spec.components.kserve.defaultDeploymentMode",
+		"DSCInitialization:apiVersion",
+		"DSCInitialization:spec.serviceMesh",
+	}))
+
+	// The cluster objects are left untouched.
+	g.Expect(dsc.Object).To(HaveKey("status"))
+	g.Expect(dsc.GetAPIVersion()).To(Equal(resources.DataScienceClusterV1.APIVersion()))
+}
+
+func TestSimulate_SameVersion(t *testing.T) {
+	g := NewWithT(t)
+
+	sim, err := upgrade.Simulate(upgrade.DefaultRules(),
+		semver.MustParse("3.0.1"), semver.MustParse("3.0.2"), newDSC(newComponents()), nil)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(sim.Changes).To(BeEmpty())
+	g.Expect(sim.Resources).To(HaveLen(1))
+	g.Expect(sim.Resources[0].Simulated).To(Equal(sim.Resources[0].Current))
+}
+
+func TestWriteDiff(t *testing.T) {
+	g := NewWithT(t)
+
+	sim, err := upgrade.Simulate(upgrade.DefaultRules(),
+		semver.MustParse("2.25.0"), semver.MustParse("3.0.0"), newDSC(newComponents()), nil)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	var buf bytes.Buffer
+	g.Expect(upgrade.WriteDiff(&buf, sim)).To(Succeed())
+
+	out := buf.String()
+	g.Expect(out).To(ContainSubstring("--- DataScienceCluster/default-dsc (2.25.0)"))
+	g.Expect(out).To(ContainSubstring("+++ DataScienceCluster/default-dsc (3.0.0, simulated)"))
+	g.Expect(out).To(ContainSubstring("-apiVersion: datasciencecluster.opendatahub.io/v1"))
+	g.Expect(out).To(ContainSubstring("+apiVersion: datasciencecluster.opendatahub.io/v2"))
+	g.Expect(out).To(ContainSubstring("+    aipipelines:"))
+	g.Expect(out).To(ContainSubstring("-    modelmeshserving:"))
+	g.Expect(out).To(ContainSubstring(
+		"[3.0] DataScienceCluster: DataSciencePipelines is renamed to AIPipelines " +
+			"(spec.components.datasciencepipelines → spec.components.aipipelines)"))
+}
+
+func TestParseRules_Invalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		wantErr string
+	}{
+		{name: "no releases", content: "releases: []", wantErr: "no releases"},
+		{name: "invalid version", content: "releases:\n  - version: x\n", wantErr: "invalid version"},
+		{
+			name:    "invalid resource",
+			content: "releases:\n  - version: '3.0'\n    rules:\n      - {resource: pod, op: remove, path: spec, description: d}",
+			wantErr: "invalid resource",
+		},
+		{
+			name:    "invalid op",
+			content: "releases:\n  - version: '3.0'\n    rules:\n      - {resource: dsc, op: patch, path: spec, description: d}",
+			wantErr: "invalid op",
+		},
+		{
+			name:    "rename without destination",
+			content: "releases:\n  - version: '3.0'\n    rules:\n      - {resource: dsc, op: rename, path: spec.a, description: d}",
+			wantErr: "requires a destination",
+		},
+		{
+			name:    "set without value",
+			content: "releases:\n  - version: '3.0'\n    rules:\n      - {resource: dsci, op: set, path: spec.a, description: d}",
+			wantErr: "requires a value",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			_, err := upgrade.ParseRules(strings.NewReader(tt.content))
+			g.Expect(err).To(MatchError(ContainSubstring(tt.wantErr)))
+		})
+	}
+}
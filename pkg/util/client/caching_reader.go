@@ -0,0 +1,247 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util"
+)
+
+// Compile-time verification that cachingReader implements Reader.
+var _ Reader = (*cachingReader)(nil)
+
+type cacheOp string
+
+const (
+	cacheOpList         cacheOp = "list"
+	cacheOpListMetadata cacheOp = "list-metadata"
+	cacheOpGet          cacheOp = "get"
+	cacheOpGetMetadata  cacheOp = "get-metadata"
+)
+
+// cacheKey identifies a memoized read. List operations use the list options as the
+// selector; get operations use the namespace and name.
+type cacheKey struct {
+	op            cacheOp
+	gvr           schema.GroupVersionResource
+	namespace     string
+	name          string
+	labelSelector string
+	fieldSelector string
+	limit         int64
+}
+
+// cacheEntry holds the outcome of a single read. The once guard ensures concurrent callers
+// asking for the same key wait for one request instead of issuing their own.
+type cacheEntry struct {
+	once  sync.Once
+	value any
+	err   error
+}
+
+// cachingReader memoizes reads of a delegate Reader for the lifetime of the reader.
+//
+// Results are shared between callers, so every call returns a deep copy and callers remain
+// free to modify what they receive. Successful results and "resource type not found" errors
+// are cached; other errors (timeouts, throttling, permission problems) are returned to the
+// caller that triggered the request and retried on the next call.
+type cachingReader struct {
+	delegate Reader
+
+	mu      sync.Mutex
+	entries map[cacheKey]*cacheEntry
+}
+
+// NewCachingReader wraps a Reader so that each distinct List/Get request (keyed by GVR,
+// namespace, name and selectors) reaches the delegate at most once.
+//
+// It is meant to be created per command run and shared between lint checks, which frequently
+// read the same resources (DataScienceCluster, DSCInitialization, InferenceServices, ...).
+// The cache is never invalidated; create a new reader to observe cluster changes.
+// OLM reads are passed through to the delegate unchanged.
+func NewCachingReader(delegate Reader) Reader {
+	return &cachingReader{
+		delegate: delegate,
+		entries:  make(map[cacheKey]*cacheEntry),
+	}
+}
+
+// do returns the memoized result for key, calling fetch on the first request.
+func (r *cachingReader) do(key cacheKey, fetch func() (any, error)) (any, error) {
+	r.mu.Lock()
+
+	entry, ok := r.entries[key]
+	if !ok {
+		entry = &cacheEntry{}
+		r.entries[key] = entry
+	}
+
+	r.mu.Unlock()
+
+	entry.once.Do(func() {
+		entry.value, entry.err = fetch()
+	})
+
+	if entry.err != nil && !IsResourceTypeNotFound(entry.err) {
+		// Do not cache transient failures: drop the entry so the next caller retries.
+		r.mu.Lock()
+		if r.entries[key] == entry {
+			delete(r.entries, key)
+		}
+		r.mu.Unlock()
+	}
+
+	return entry.value, entry.err
+}
+
+func listCacheKey(op cacheOp, gvr schema.GroupVersionResource, opts []ListResourcesOption) cacheKey {
+	cfg := &ListResourcesConfig{}
+	util.ApplyOptions(cfg, opts...)
+
+	return cacheKey{
+		op:            op,
+		gvr:           gvr,
+		namespace:     cfg.Namespace,
+		labelSelector: cfg.LabelSelector,
+		fieldSelector: cfg.FieldSelector,
+		limit:         cfg.Limit,
+	}
+}
+
+func getCacheKey(op cacheOp, gvr schema.GroupVersionResource, name string, opts []GetOption) cacheKey {
+	cfg := &GetConfig{}
+	util.ApplyOptions(cfg, opts...)
+
+	return cacheKey{
+		op:        op,
+		gvr:       gvr,
+		namespace: cfg.Namespace,
+		name:      name,
+	}
+}
+
+// ListResources lists resources by GVR, reusing a previous result for the same options.
+func (r *cachingReader) ListResources(
+	ctx context.Context,
+	gvr schema.GroupVersionResource,
+	opts ...ListResourcesOption,
+) ([]*unstructured.Unstructured, error) {
+	value, err := r.do(listCacheKey(cacheOpList, gvr, opts), func() (any, error) {
+		return r.delegate.ListResources(ctx, gvr, opts...) //nolint:wrapcheck // cached and returned unchanged
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	items, ok := value.([]*unstructured.Unstructured)
+	if !ok {
+		return nil, fmt.Errorf("unexpected cached value %T for %s", value, gvr.String())
+	}
+
+	out := make([]*unstructured.Unstructured, len(items))
+	for i, item := range items {
+		out[i] = item.DeepCopy()
+	}
+
+	return out, nil
+}
+
+// List lists resources by ResourceType; it shares cache entries with ListResources.
+func (r *cachingReader) List(
+	ctx context.Context,
+	resourceType resources.ResourceType,
+	opts ...ListResourcesOption,
+) ([]*unstructured.Unstructured, error) {
+	return r.ListResources(ctx, resourceType.GVR(), opts...)
+}
+
+// ListMetadata lists resource metadata, reusing a previous result for the same options.
+func (r *cachingReader) ListMetadata(
+	ctx context.Context,
+	resourceType resources.ResourceType,
+	opts ...ListResourcesOption,
+) ([]*metav1.PartialObjectMetadata, error) {
+	value, err := r.do(listCacheKey(cacheOpListMetadata, resourceType.GVR(), opts), func() (any, error) {
+		return r.delegate.ListMetadata(ctx, resourceType, opts...) //nolint:wrapcheck // cached and returned unchanged
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	items, ok := value.([]*metav1.PartialObjectMetadata)
+	if !ok {
+		return nil, fmt.Errorf("unexpected cached value %T for %s", value, resourceType.GVR().String())
+	}
+
+	out := make([]*metav1.PartialObjectMetadata, len(items))
+	for i, item := range items {
+		out[i] = item.DeepCopy()
+	}
+
+	return out, nil
+}
+
+// Get retrieves a single resource by GVR, reusing a previous result for the same object.
+func (r *cachingReader) Get(
+	ctx context.Context,
+	gvr schema.GroupVersionResource,
+	name string,
+	opts ...GetOption,
+) (*unstructured.Unstructured, error) {
+	value, err := r.do(getCacheKey(cacheOpGet, gvr, name, opts), func() (any, error) {
+		return r.delegate.Get(ctx, gvr, name, opts...) //nolint:wrapcheck // cached and returned unchanged
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	obj, ok := value.(*unstructured.Unstructured)
+	if !ok {
+		return nil, fmt.Errorf("unexpected cached value %T for %s", value, gvr.String())
+	}
+
+	return obj.DeepCopy(), nil
+}
+
+// GetResource retrieves a single resource by ResourceType; it shares cache entries with Get.
+func (r *cachingReader) GetResource(
+	ctx context.Context,
+	resourceType resources.ResourceType,
+	name string,
+	opts ...GetOption,
+) (*unstructured.Unstructured, error) {
+	return r.Get(ctx, resourceType.GVR(), name, opts...)
+}
+
+// GetResourceMetadata retrieves a single resource's metadata, reusing a previous result.
+func (r *cachingReader) GetResourceMetadata(
+	ctx context.Context,
+	resourceType resources.ResourceType,
+	name string,
+	opts ...GetOption,
+) (*metav1.PartialObjectMetadata, error) {
+	value, err := r.do(getCacheKey(cacheOpGetMetadata, resourceType.GVR(), name, opts), func() (any, error) {
+		return r.delegate.GetResourceMetadata(ctx, resourceType, name, opts...) //nolint:wrapcheck // cached and returned unchanged
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	obj, ok := value.(*metav1.PartialObjectMetadata)
+	if !ok {
+		return nil, fmt.Errorf("unexpected cached value %T for %s", value, resourceType.GVR().String())
+	}
+
+	return obj.DeepCopy(), nil
+}
+
+// OLM returns the delegate's OLM accessor without caching.
+func (r *cachingReader) OLM() OLMReader {
+	return r.delegate.OLM()
+}
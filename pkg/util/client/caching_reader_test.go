@@ -0,0 +1,193 @@
+package client_test
+
+import (
+	"errors"
+	"testing"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+
+	. "github.com/onsi/gomega"
+)
+
+func newCachingTestNotebook(namespace, name string) *unstructured.Unstructured {
+	obj := resources.Notebook.Unstructured()
+	obj.SetNamespace(namespace)
+	obj.SetName(name)
+
+	return &obj
+}
+
+// newCountingClient returns a client backed by a fake dynamic client and a map counting
+// the requests it receives per verb.
+func newCountingClient(objs ...runtime.Object) (client.Client, *dynamicfake.FakeDynamicClient, map[string]int) {
+	scheme := runtime.NewScheme()
+	_ = metav1.AddMetaToScheme(scheme)
+
+	dyn := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(
+		scheme,
+		map[schema.GroupVersionResource]string{
+			resources.Notebook.GVR():         resources.Notebook.ListKind(),
+			resources.InferenceService.GVR(): resources.InferenceService.ListKind(),
+		},
+		objs...,
+	)
+
+	calls := make(map[string]int)
+	dyn.PrependReactor("*", "*", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		calls[action.GetVerb()]++
+
+		return false, nil, nil
+	})
+
+	return client.NewForTesting(client.TestClientConfig{Dynamic: dyn}), dyn, calls
+}
+
+func TestCachingReader_List(t *testing.T) {
+	t.Run("lists each resource once", func(t *testing.T) {
+		g := NewWithT(t)
+		ctx := t.Context()
+
+		c, _, calls := newCountingClient(
+			newCachingTestNotebook("team-a", "nb-a"),
+			newCachingTestNotebook("team-b", "nb-b"),
+		)
+		reader := client.NewCachingReader(c)
+
+		for range 3 {
+			items, err := reader.List(ctx, resources.Notebook)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(items).To(HaveLen(2))
+		}
+
+		items, err := reader.ListResources(ctx, resources.Notebook.GVR())
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(items).To(HaveLen(2))
+
+		g.Expect(calls["list"]).To(Equal(1))
+	})
+
+	t.Run("keys entries by namespace and selector", func(t *testing.T) {
+		g := NewWithT(t)
+		ctx := t.Context()
+
+		c, _, calls := newCountingClient(
+			newCachingTestNotebook("team-a", "nb-a"),
+			newCachingTestNotebook("team-b", "nb-b"),
+		)
+		reader := client.NewCachingReader(c)
+
+		all, err := reader.List(ctx, resources.Notebook)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(all).To(HaveLen(2))
+
+		scoped, err := reader.List(ctx, resources.Notebook, client.WithNamespace("team-a"))
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(scoped).To(HaveLen(1))
+
+		_, err = reader.List(ctx, resources.Notebook, client.WithNamespace("team-a"))
+		g.Expect(err).ToNot(HaveOccurred())
+
+		selected, err := reader.List(ctx, resources.Notebook, client.WithLabelSelector("app=missing"))
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(selected).To(BeEmpty())
+
+		g.Expect(calls["list"]).To(Equal(3))
+	})
+
+	t.Run("returns copies of cached objects", func(t *testing.T) {
+		g := NewWithT(t)
+		ctx := t.Context()
+
+		c, _, _ := newCountingClient(newCachingTestNotebook("team-a", "nb-a"))
+		reader := client.NewCachingReader(c)
+
+		first, err := reader.List(ctx, resources.Notebook)
+		g.Expect(err).ToNot(HaveOccurred())
+		first[0].SetName("mutated")
+
+		second, err := reader.List(ctx, resources.Notebook)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(second[0].GetName()).To(Equal("nb-a"))
+	})
+
+	t.Run("retries transient errors", func(t *testing.T) {
+		g := NewWithT(t)
+		ctx := t.Context()
+
+		c, dyn, _ := newCountingClient(newCachingTestNotebook("team-a", "nb-a"))
+		reader := client.NewCachingReader(c)
+
+		attempts, failures := 0, 1
+		dyn.PrependReactor("list", resources.Notebook.Resource, func(_ k8stesting.Action) (bool, runtime.Object, error) {
+			attempts++
+
+			if failures == 0 {
+				return false, nil, nil
+			}
+
+			failures--
+
+			return true, nil, errors.New("connection reset")
+		})
+
+		_, err := reader.List(ctx, resources.Notebook)
+		g.Expect(err).To(HaveOccurred())
+
+		items, err := reader.List(ctx, resources.Notebook)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(items).To(HaveLen(1))
+		g.Expect(attempts).To(Equal(2))
+	})
+
+	t.Run("caches resource type not found", func(t *testing.T) {
+		g := NewWithT(t)
+		ctx := t.Context()
+
+		c, dyn, _ := newCountingClient()
+		reader := client.NewCachingReader(c)
+
+		attempts := 0
+		dyn.PrependReactor("list", resources.InferenceService.Resource, func(_ k8stesting.Action) (bool, runtime.Object, error) {
+			attempts++
+
+			return true, nil, apierrors.NewNotFound(resources.InferenceService.GVR().GroupResource(), "")
+		})
+
+		for range 2 {
+			_, err := reader.List(ctx, resources.InferenceService)
+			g.Expect(client.IsResourceTypeNotFound(err)).To(BeTrue())
+		}
+
+		g.Expect(attempts).To(Equal(1))
+	})
+}
+
+func TestCachingReader_Get(t *testing.T) {
+	g := NewWithT(t)
+	ctx := t.Context()
+
+	c, _, calls := newCountingClient(newCachingTestNotebook("team-a", "nb-a"))
+	reader := client.NewCachingReader(c)
+
+	for range 2 {
+		obj, err := reader.GetResource(ctx, resources.Notebook, "nb-a", client.InNamespace("team-a"))
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(obj.GetName()).To(Equal("nb-a"))
+	}
+
+	for range 2 {
+		_, err := reader.Get(ctx, resources.Notebook.GVR(), "missing", client.InNamespace("team-a"))
+		g.Expect(apierrors.IsNotFound(err)).To(BeTrue())
+	}
+
+	g.Expect(calls["get"]).To(Equal(2))
+}
@@ -41,6 +41,26 @@ type defaultClient struct {
 	restMapper    meta.RESTMapper
 
 	olmReader OLMReader
+
+	// pageSize is the number of items requested per page by list calls; 0 lists in one request.
+	pageSize int64
+}
+
+// ClientConfig configures a client created by NewClientWithConfig.
+type ClientConfig struct {
+	// PageSize is the number of items requested per page when listing; 0 disables pagination.
+	PageSize int64
+}
+
+// ClientOption is an option for configuring NewClientWithConfig.
+type ClientOption = util.Option[ClientConfig]
+
+// WithPageSize sets the number of items requested per page when listing (default
+// DefaultPageSize). Pages are aggregated transparently; 0 lists in a single request.
+func WithPageSize(size int64) ClientOption {
+	return util.FunctionalOption[ClientConfig](func(c *ClientConfig) {
+		c.PageSize = size
+	})
 }
 
 func (c *defaultClient) Dynamic() dynamic.Interface                      { return c.dynamic }
@@ -68,7 +88,10 @@ func (c *defaultClient) AuthorizationV1() authorizationv1client.AuthorizationV1I
 
 // NewClientWithConfig creates a client from a pre-configured REST config.
 // This allows callers to customize throttling settings before client creation.
-func NewClientWithConfig(restConfig *rest.Config) (Client, error) {
+func NewClientWithConfig(restConfig *rest.Config, opts ...ClientOption) (Client, error) {
+	cfg := &ClientConfig{PageSize: DefaultPageSize}
+	util.ApplyOptions(cfg, opts...)
+
 	dynamicClient, err := dynamic.NewForConfig(restConfig)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create dynamic client: %w", err)
@@ -113,6 +136,7 @@ func NewClientWithConfig(restConfig *rest.Config) (Client, error) {
 		kubernetes:    kubeClient,
 		restMapper:    restMapper,
 		olmReader:     newOLMReader(olmClient),
+		pageSize:      cfg.PageSize,
 	}, nil
 }
 
@@ -157,7 +181,8 @@ func NewDiscoveryClient(configFlags *genericclioptions.ConfigFlags) (discovery.D
 	return discoveryClient, nil
 }
 
-// Patch applies a patch to an existing cluster-scoped resource.
+// Patch applies a patch to an existing resource.
+// The resource is treated as cluster-scoped unless PatchInNamespace is provided.
 func (c *defaultClient) Patch(
 	ctx context.Context,
 	resourceType resources.ResourceType,
@@ -188,7 +213,15 @@ func (c *defaultClient) Patch(
 
 	gvr := resourceType.GVR()
 
-	result, err := c.dynamic.Resource(gvr).Patch(ctx, name, patchType, data, patchOpts)
+	var result *unstructured.Unstructured
+	var err error
+
+	if cfg.Namespace != "" {
+		result, err = c.dynamic.Resource(gvr).Namespace(cfg.Namespace).Patch(ctx, name, patchType, data, patchOpts)
+	} else {
+		result, err = c.dynamic.Resource(gvr).Patch(ctx, name, patchType, data, patchOpts)
+	}
+
 	if err != nil {
 		return nil, fmt.Errorf("patching resource: %w", err)
 	}
@@ -1,8 +1,13 @@
 package client
 
 import (
+	"fmt"
+	"maps"
+	"slices"
+
 	"k8s.io/cli-runtime/pkg/genericclioptions"
 	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
 
 	clierrors "github.com/opendatahub-io/odh-cli/pkg/util/errors"
 )
@@ -17,6 +22,11 @@ const (
 	// This is significantly higher than kubectl's default (10) to handle
 	// initial spikes when all workers start simultaneously.
 	DefaultBurst = 100
+
+	// DefaultPageSize is the default number of items requested per page when listing.
+	// Listing tens of thousands of objects in a single response can time out on the API
+	// server and requires holding the whole response at once.
+	DefaultPageSize int64 = 500
 )
 
 // ConfigureThrottling configures QPS and Burst on a REST config.
@@ -33,6 +43,7 @@ func ConfigureThrottling(config *rest.Config, qps float32, burst int) {
 }
 
 // NewRESTConfig creates a REST config with appropriate throttling for CLI usage.
+// When no kubeconfig is found, the in-cluster config of the pod's service account is used.
 // The QPS and Burst parameters allow callers to customize throttling settings.
 // Use DefaultQPS and DefaultBurst for standard parallel operations.
 func NewRESTConfig(
@@ -42,7 +53,17 @@ func NewRESTConfig(
 ) (*rest.Config, error) {
 	restConfig, err := configFlags.ToRESTConfig()
 	if err != nil {
-		return nil, clierrors.NewConfigError(err)
+		// Without a kubeconfig, e.g. when running as a Job, use the mounted service account
+		if !clientcmd.IsEmptyConfig(err) {
+			return nil, clierrors.NewConfigError(err)
+		}
+
+		inCluster, inClusterErr := rest.InClusterConfig()
+		if inClusterErr != nil {
+			return nil, clierrors.NewConfigError(err)
+		}
+
+		restConfig = inCluster
 	}
 
 	ConfigureThrottling(restConfig, qps, burst)
@@ -52,3 +73,43 @@ func NewRESTConfig(
 
 	return restConfig, nil
 }
+
+// ListContexts returns the names of the contexts defined in the kubeconfig selected by
+// configFlags, sorted.
+func ListContexts(configFlags *genericclioptions.ConfigFlags) ([]string, error) {
+	raw, err := configFlags.ToRawKubeConfigLoader().RawConfig()
+	if err != nil {
+		return nil, clierrors.NewConfigError(err)
+	}
+
+	return slices.Sorted(maps.Keys(raw.Contexts)), nil
+}
+
+// NewRESTConfigForContext creates a REST config like NewRESTConfig, for the named kubeconfig
+// context instead of the current one. Overrides from configFlags other than the kubeconfig
+// path are not applied, since they target a single cluster.
+func NewRESTConfigForContext(
+	configFlags *genericclioptions.ConfigFlags,
+	contextName string,
+	qps float32,
+	burst int,
+) (*rest.Config, error) {
+	raw, err := configFlags.ToRawKubeConfigLoader().RawConfig()
+	if err != nil {
+		return nil, clierrors.NewConfigError(err)
+	}
+
+	if _, ok := raw.Contexts[contextName]; !ok {
+		return nil, clierrors.NewConfigError(fmt.Errorf("context %q not found in kubeconfig", contextName))
+	}
+
+	restConfig, err := clientcmd.NewNonInteractiveClientConfig(raw, contextName, &clientcmd.ConfigOverrides{}, nil).ClientConfig()
+	if err != nil {
+		return nil, clierrors.NewConfigError(err)
+	}
+
+	ConfigureThrottling(restConfig, qps, burst)
+	restConfig.WarningHandler = rest.NoWarnings{}
+
+	return restConfig, nil
+}
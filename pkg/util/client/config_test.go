@@ -1,6 +1,8 @@
 package client_test
 
 import (
+	"os"
+	"path/filepath"
 	"testing"
 
 	"k8s.io/cli-runtime/pkg/genericclioptions"
@@ -86,3 +88,67 @@ func TestDefaultConstants(t *testing.T) {
 		g.Expect(client.DefaultBurst).To(Equal(100))
 	})
 }
+
+const testKubeconfig = `
+apiVersion: v1
+kind: Config
+clusters:
+  - name: east
+    cluster:
+      server: https://east.example.com:6443
+  - name: west
+    cluster:
+      server: https://west.example.com:6443
+users:
+  - name: admin
+    user:
+      token: secret
+contexts:
+  - name: west-admin
+    context: {cluster: west, user: admin}
+  - name: east-admin
+    context: {cluster: east, user: admin}
+current-context: east-admin
+`
+
+func newTestConfigFlags(t *testing.T) *genericclioptions.ConfigFlags {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "kubeconfig")
+	NewWithT(t).Expect(os.WriteFile(path, []byte(testKubeconfig), 0o600)).To(Succeed())
+
+	configFlags := genericclioptions.NewConfigFlags(false)
+	configFlags.KubeConfig = &path
+
+	return configFlags
+}
+
+func TestListContexts(t *testing.T) {
+	g := NewWithT(t)
+
+	contexts, err := client.ListContexts(newTestConfigFlags(t))
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(contexts).To(Equal([]string{"east-admin", "west-admin"}))
+}
+
+func TestNewRESTConfigForContext(t *testing.T) {
+	t.Run("should create a REST config for the named context", func(t *testing.T) {
+		g := NewWithT(t)
+
+		config, err := client.NewRESTConfigForContext(newTestConfigFlags(t), "west-admin", 75, 150)
+
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(config.Host).To(Equal("https://west.example.com:6443"))
+		g.Expect(config.QPS).To(Equal(float32(75)))
+		g.Expect(config.Burst).To(Equal(150))
+	})
+
+	t.Run("should fail for an unknown context", func(t *testing.T) {
+		g := NewWithT(t)
+
+		_, err := client.NewRESTConfigForContext(newTestConfigFlags(t), "north-admin", 75, 150)
+
+		g.Expect(err).To(MatchError(ContainSubstring(`context "north-admin" not found`)))
+	})
+}
@@ -33,3 +33,14 @@ func IsResourceTypeNotFound(err error) bool {
 func IsPermissionError(err error) bool {
 	return apierrors.IsForbidden(err) || apierrors.IsUnauthorized(err)
 }
+
+// IsTransientError checks if an error is a transient API server error worth retrying:
+// throttling (429), server timeouts and 5xx errors.
+func IsTransientError(err error) bool {
+	return apierrors.IsTooManyRequests(err) ||
+		apierrors.IsServerTimeout(err) ||
+		apierrors.IsTimeout(err) ||
+		apierrors.IsServiceUnavailable(err) ||
+		apierrors.IsInternalError(err) ||
+		apierrors.IsUnexpectedServerError(err)
+}
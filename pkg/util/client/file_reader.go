@@ -0,0 +1,486 @@
+package client
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	operatorsv1alpha1 "github.com/operator-framework/api/pkg/operators/v1alpha1"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util"
+)
+
+const decoderBufferSize = 4096
+
+// Compile-time verification that fileReader implements Reader.
+var _ Reader = (*fileReader)(nil)
+
+// fileReader is a Reader backed by resource manifests loaded from disk instead of a live cluster.
+//
+// Objects are indexed by group and resource, ignoring the API version: a snapshot usually
+// contains each object in a single (preferred) version, whereas the API server would serve
+// it in every version through conversion. Callers receive the object as it was exported.
+type fileReader struct {
+	objects map[schema.GroupResource][]*unstructured.Unstructured
+}
+
+// NewFileReader creates a Reader serving resources from YAML/JSON manifests, such as a
+// must-gather, `kubectl get -o yaml` exports, or an archive written by `lint export-snapshot`.
+// The path may be a directory, which is walked recursively, or a .tar/.tar.gz/.tgz archive.
+// Multi-document files and List kinds are expanded into their individual items.
+func NewFileReader(path string) (Reader, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading snapshot: %w", err)
+	}
+
+	r := newFileReader()
+
+	switch {
+	case info.IsDir():
+		err = r.loadDir(path)
+	case isArchiveFile(path):
+		err = r.loadArchive(path)
+	default:
+		return nil, fmt.Errorf("snapshot path %q is neither a directory nor a .tar/.tar.gz/.tgz archive", path)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("loading snapshot from %s: %w", path, err)
+	}
+
+	return r, nil
+}
+
+func newFileReader() *fileReader {
+	return &fileReader{
+		objects: make(map[schema.GroupResource][]*unstructured.Unstructured),
+	}
+}
+
+// isManifestFile reports whether the path has a YAML or JSON extension.
+func isManifestFile(path string) bool {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml", ".json":
+		return true
+	default:
+		return false
+	}
+}
+
+// isArchiveFile reports whether the path has a tar or gzipped tar extension.
+func isArchiveFile(path string) bool {
+	lower := strings.ToLower(path)
+
+	return strings.HasSuffix(lower, ".tar") ||
+		strings.HasSuffix(lower, ".tar.gz") ||
+		strings.HasSuffix(lower, ".tgz")
+}
+
+// loadDir loads every manifest file below dir.
+func (r *fileReader) loadDir(dir string) error {
+	return filepath.WalkDir(dir, func(path string, d fs.DirEntry, walkErr error) error {
+		if walkErr != nil {
+			return walkErr
+		}
+
+		if d.IsDir() || !isManifestFile(path) {
+			return nil
+		}
+
+		f, err := os.Open(path)
+		if err != nil {
+			return fmt.Errorf("opening %s: %w", path, err)
+		}
+		defer func() { _ = f.Close() }()
+
+		return r.load(f, path)
+	})
+}
+
+// loadArchive loads every manifest file contained in a tar archive, optionally gzip-compressed.
+func (r *fileReader) loadArchive(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("opening archive: %w", err)
+	}
+	defer func() { _ = f.Close() }()
+
+	var in io.Reader = f
+
+	if !strings.HasSuffix(strings.ToLower(path), ".tar") {
+		gz, err := gzip.NewReader(f)
+		if err != nil {
+			return fmt.Errorf("opening gzip stream: %w", err)
+		}
+		defer func() { _ = gz.Close() }()
+
+		in = gz
+	}
+
+	tr := tar.NewReader(in)
+
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("reading archive: %w", err)
+		}
+
+		if hdr.Typeflag != tar.TypeReg || !isManifestFile(hdr.Name) {
+			continue
+		}
+
+		if err := r.load(tr, hdr.Name); err != nil {
+			return err
+		}
+	}
+}
+
+// load decodes every document in the stream and adds the contained objects to the index.
+func (r *fileReader) load(in io.Reader, source string) error {
+	decoder := utilyaml.NewYAMLOrJSONDecoder(in, decoderBufferSize)
+
+	for {
+		var doc map[string]any
+
+		err := decoder.Decode(&doc)
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("decoding %s: %w", source, err)
+		}
+
+		r.add(&unstructured.Unstructured{Object: doc})
+	}
+}
+
+// add indexes an object, expanding List kinds and ignoring documents without type information.
+// Later objects replace earlier ones with the same namespace and name.
+func (r *fileReader) add(obj *unstructured.Unstructured) {
+	if obj.Object == nil {
+		return
+	}
+
+	if obj.IsList() {
+		_ = obj.EachListItem(func(item runtime.Object) error {
+			if u, ok := item.(*unstructured.Unstructured); ok {
+				r.add(u)
+			}
+
+			return nil
+		})
+
+		return
+	}
+
+	gvk := obj.GroupVersionKind()
+	if gvk.Kind == "" || gvk.Version == "" || obj.GetName() == "" {
+		return
+	}
+
+	plural, _ := meta.UnsafeGuessKindToResource(gvk)
+	gr := plural.GroupResource()
+
+	for i, existing := range r.objects[gr] {
+		if existing.GetNamespace() == obj.GetNamespace() && existing.GetName() == obj.GetName() {
+			r.objects[gr][i] = obj
+
+			return
+		}
+	}
+
+	r.objects[gr] = append(r.objects[gr], obj)
+}
+
+// ListResources returns the snapshot objects of the given resource matching the list options.
+// A resource absent from the snapshot yields an empty list.
+func (r *fileReader) ListResources(
+	_ context.Context,
+	gvr schema.GroupVersionResource,
+	opts ...ListResourcesOption,
+) ([]*unstructured.Unstructured, error) {
+	cfg := &ListResourcesConfig{}
+	util.ApplyOptions(cfg, opts...)
+
+	labelSelector, err := labels.Parse(cfg.LabelSelector)
+	if err != nil {
+		return nil, fmt.Errorf("parsing label selector: %w", err)
+	}
+
+	fieldSelector, err := fields.ParseSelector(cfg.FieldSelector)
+	if err != nil {
+		return nil, fmt.Errorf("parsing field selector: %w", err)
+	}
+
+	items := make([]*unstructured.Unstructured, 0)
+
+	for _, obj := range r.objects[gvr.GroupResource()] {
+		if cfg.Namespace != "" && obj.GetNamespace() != cfg.Namespace {
+			continue
+		}
+
+		if !labelSelector.Matches(labels.Set(obj.GetLabels())) {
+			continue
+		}
+
+		if !fieldSelector.Matches(fields.Set{
+			"metadata.name":      obj.GetName(),
+			"metadata.namespace": obj.GetNamespace(),
+		}) {
+			continue
+		}
+
+		items = append(items, obj.DeepCopy())
+	}
+
+	sort.SliceStable(items, func(i, j int) bool {
+		if items[i].GetNamespace() != items[j].GetNamespace() {
+			return items[i].GetNamespace() < items[j].GetNamespace()
+		}
+
+		return items[i].GetName() < items[j].GetName()
+	})
+
+	if cfg.Limit > 0 && int64(len(items)) > cfg.Limit {
+		items = items[:cfg.Limit]
+	}
+
+	return items, nil
+}
+
+// List is a convenience wrapper around ListResources that accepts ResourceType.
+func (r *fileReader) List(
+	ctx context.Context,
+	resourceType resources.ResourceType,
+	opts ...ListResourcesOption,
+) ([]*unstructured.Unstructured, error) {
+	return r.ListResources(ctx, resourceType.GVR(), opts...)
+}
+
+// ListMetadata lists snapshot objects of the given type returning only metadata.
+func (r *fileReader) ListMetadata(
+	ctx context.Context,
+	resourceType resources.ResourceType,
+	opts ...ListResourcesOption,
+) ([]*metav1.PartialObjectMetadata, error) {
+	items, err := r.List(ctx, resourceType, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*metav1.PartialObjectMetadata, 0, len(items))
+	for _, item := range items {
+		result = append(result, toPartialObjectMetadata(resourceType, item))
+	}
+
+	return result, nil
+}
+
+// Get retrieves a single snapshot object by GVR and name.
+// Returns a NotFound error when the object is not part of the snapshot.
+func (r *fileReader) Get(
+	_ context.Context,
+	gvr schema.GroupVersionResource,
+	name string,
+	opts ...GetOption,
+) (*unstructured.Unstructured, error) {
+	cfg := &GetConfig{}
+	util.ApplyOptions(cfg, opts...)
+
+	for _, obj := range r.objects[gvr.GroupResource()] {
+		if obj.GetName() == name && obj.GetNamespace() == cfg.Namespace {
+			return obj.DeepCopy(), nil
+		}
+	}
+
+	return nil, fmt.Errorf("getting resource: %w", apierrors.NewNotFound(gvr.GroupResource(), name))
+}
+
+// GetResource is a convenience wrapper around Get that accepts ResourceType.
+func (r *fileReader) GetResource(
+	ctx context.Context,
+	resourceType resources.ResourceType,
+	name string,
+	opts ...GetOption,
+) (*unstructured.Unstructured, error) {
+	return r.Get(ctx, resourceType.GVR(), name, opts...)
+}
+
+// GetResourceMetadata retrieves only the metadata of a single snapshot object.
+func (r *fileReader) GetResourceMetadata(
+	ctx context.Context,
+	resourceType resources.ResourceType,
+	name string,
+	opts ...GetOption,
+) (*metav1.PartialObjectMetadata, error) {
+	obj, err := r.GetResource(ctx, resourceType, name, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return toPartialObjectMetadata(resourceType, obj), nil
+}
+
+// OLM returns a read-only accessor for the OLM resources contained in the snapshot.
+func (r *fileReader) OLM() OLMReader {
+	return &fileOLMReader{reader: r}
+}
+
+// toPartialObjectMetadata strips an object down to the metadata returned by the metadata client.
+func toPartialObjectMetadata(resourceType resources.ResourceType, obj *unstructured.Unstructured) *metav1.PartialObjectMetadata {
+	return &metav1.PartialObjectMetadata{
+		TypeMeta: resourceType.TypeMeta(),
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              obj.GetName(),
+			Namespace:         obj.GetNamespace(),
+			UID:               obj.GetUID(),
+			ResourceVersion:   obj.GetResourceVersion(),
+			Labels:            obj.GetLabels(),
+			Annotations:       obj.GetAnnotations(),
+			OwnerReferences:   obj.GetOwnerReferences(),
+			CreationTimestamp: obj.GetCreationTimestamp(),
+			DeletionTimestamp: obj.GetDeletionTimestamp(),
+			Finalizers:        obj.GetFinalizers(),
+		},
+	}
+}
+
+// fileOLMReader serves OLM Subscriptions and ClusterServiceVersions from a snapshot.
+type fileOLMReader struct {
+	reader *fileReader
+}
+
+func (r *fileOLMReader) Available() bool {
+	return true
+}
+
+func (r *fileOLMReader) Subscriptions(namespace string) SubscriptionReader {
+	return &fileSubscriptionReader{reader: r.reader, namespace: namespace}
+}
+
+func (r *fileOLMReader) ClusterServiceVersions(namespace string) CSVReader {
+	return &fileCSVReader{reader: r.reader, namespace: namespace}
+}
+
+// fileSubscriptionReader converts snapshot Subscriptions to their typed representation.
+type fileSubscriptionReader struct {
+	reader    *fileReader
+	namespace string
+}
+
+func (s *fileSubscriptionReader) List(ctx context.Context, opts metav1.ListOptions) (*operatorsv1alpha1.SubscriptionList, error) {
+	list := &operatorsv1alpha1.SubscriptionList{}
+	if err := listTyped(ctx, s.reader, resources.Subscription, s.namespace, opts, &list.Items); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (s *fileSubscriptionReader) Get(ctx context.Context, name string, _ metav1.GetOptions) (*operatorsv1alpha1.Subscription, error) {
+	sub := &operatorsv1alpha1.Subscription{}
+	if err := getTyped(ctx, s.reader, resources.Subscription, s.namespace, name, sub); err != nil {
+		return nil, err
+	}
+
+	return sub, nil
+}
+
+// fileCSVReader converts snapshot ClusterServiceVersions to their typed representation.
+type fileCSVReader struct {
+	reader    *fileReader
+	namespace string
+}
+
+func (c *fileCSVReader) List(ctx context.Context, opts metav1.ListOptions) (*operatorsv1alpha1.ClusterServiceVersionList, error) {
+	list := &operatorsv1alpha1.ClusterServiceVersionList{}
+	if err := listTyped(ctx, c.reader, resources.ClusterServiceVersion, c.namespace, opts, &list.Items); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (c *fileCSVReader) Get(ctx context.Context, name string, _ metav1.GetOptions) (*operatorsv1alpha1.ClusterServiceVersion, error) {
+	csv := &operatorsv1alpha1.ClusterServiceVersion{}
+	if err := getTyped(ctx, c.reader, resources.ClusterServiceVersion, c.namespace, name, csv); err != nil {
+		return nil, err
+	}
+
+	return csv, nil
+}
+
+// listTyped lists snapshot objects and converts them into the typed items slice.
+func listTyped[T any](
+	ctx context.Context,
+	r *fileReader,
+	resourceType resources.ResourceType,
+	namespace string,
+	opts metav1.ListOptions,
+	items *[]T,
+) error {
+	objs, err := r.List(ctx, resourceType,
+		WithNamespace(namespace),
+		WithLabelSelector(opts.LabelSelector),
+		WithFieldSelector(opts.FieldSelector),
+		WithLimit(opts.Limit),
+	)
+	if err != nil {
+		return err
+	}
+
+	*items = make([]T, len(objs))
+	for i, obj := range objs {
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, &(*items)[i]); err != nil {
+			return fmt.Errorf("converting %s %s: %w", resourceType.Kind, obj.GetName(), err)
+		}
+	}
+
+	return nil
+}
+
+// getTyped retrieves a snapshot object and converts it into out.
+func getTyped(
+	ctx context.Context,
+	r *fileReader,
+	resourceType resources.ResourceType,
+	namespace string,
+	name string,
+	out any,
+) error {
+	obj, err := r.GetResource(ctx, resourceType, name, InNamespace(namespace))
+	if err != nil {
+		return err
+	}
+
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, out); err != nil {
+		return fmt.Errorf("converting %s %s: %w", resourceType.Kind, name, err)
+	}
+
+	return nil
+}
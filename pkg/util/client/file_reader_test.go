@@ -0,0 +1,212 @@
+package client_test
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"os"
+	"path/filepath"
+	"testing"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+
+	. "github.com/onsi/gomega"
+)
+
+const (
+	snapshotNotebooks = `
+apiVersion: kubeflow.org/v1
+kind: Notebook
+metadata:
+  name: nb-a
+  namespace: team-a
+  labels:
+    app: jupyter
+---
+apiVersion: kubeflow.org/v1
+kind: Notebook
+metadata:
+  name: nb-b
+  namespace: team-b
+`
+
+	snapshotList = `{
+  "apiVersion": "v1",
+  "kind": "List",
+  "items": [
+    {
+      "apiVersion": "datasciencecluster.opendatahub.io/v1",
+      "kind": "DataScienceCluster",
+      "metadata": {"name": "default-dsc"},
+      "status": {"release": {"version": "2.25.0"}}
+    }
+  ]
+}`
+
+	snapshotCSV = `
+apiVersion: operators.coreos.com/v1alpha1
+kind: ClusterServiceVersion
+metadata:
+  name: rhods-operator.2.25.0
+  namespace: redhat-ods-operator
+  labels:
+    operators.coreos.com/rhods-operator.redhat-ods-operator: ""
+spec:
+  version: 2.25.0
+`
+
+	snapshotIgnored = `not a manifest`
+)
+
+func writeSnapshot(t *testing.T, files map[string]string) string {
+	t.Helper()
+
+	dir := t.TempDir()
+
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return dir
+}
+
+func TestFileReader(t *testing.T) {
+	dir := writeSnapshot(t, map[string]string{
+		"notebooks.yaml":            snapshotNotebooks,
+		"nested/dsc.json":           snapshotList,
+		"olm/csv.yml":               snapshotCSV,
+		"must-gather/timestamp.txt": snapshotIgnored,
+	})
+
+	r, err := client.NewFileReader(dir)
+	NewWithT(t).Expect(err).ToNot(HaveOccurred())
+
+	t.Run("lists objects across namespaces", func(t *testing.T) {
+		g := NewWithT(t)
+
+		items, err := r.List(t.Context(), resources.Notebook)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(items).To(HaveLen(2))
+		g.Expect(items[0].GetName()).To(Equal("nb-a"))
+	})
+
+	t.Run("applies namespace and selectors", func(t *testing.T) {
+		g := NewWithT(t)
+
+		items, err := r.List(t.Context(), resources.Notebook, client.WithNamespace("team-b"))
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(items).To(HaveLen(1))
+
+		items, err = r.List(t.Context(), resources.Notebook, client.WithLabelSelector("app=jupyter"))
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(items).To(HaveLen(1))
+		g.Expect(items[0].GetName()).To(Equal("nb-a"))
+
+		items, err = r.List(t.Context(), resources.Notebook, client.WithFieldSelector("metadata.name=nb-b"))
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(items).To(HaveLen(1))
+		g.Expect(items[0].GetNamespace()).To(Equal("team-b"))
+	})
+
+	t.Run("returns metadata only", func(t *testing.T) {
+		g := NewWithT(t)
+
+		items, err := r.ListMetadata(t.Context(), resources.Notebook)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(items).To(HaveLen(2))
+		g.Expect(items[0]).To(HaveField("TypeMeta", resources.Notebook.TypeMeta()))
+	})
+
+	t.Run("expands List kinds and serves other API versions", func(t *testing.T) {
+		g := NewWithT(t)
+
+		dsc, err := client.GetDataScienceCluster(t.Context(), r)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(dsc.GetName()).To(Equal("default-dsc"))
+	})
+
+	t.Run("returns NotFound for missing objects", func(t *testing.T) {
+		g := NewWithT(t)
+
+		_, err := r.GetResource(t.Context(), resources.Notebook, "missing", client.InNamespace("team-a"))
+		g.Expect(apierrors.IsNotFound(err)).To(BeTrue())
+
+		items, err := r.List(t.Context(), resources.InferenceService)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(items).To(BeEmpty())
+	})
+
+	t.Run("serves OLM resources", func(t *testing.T) {
+		g := NewWithT(t)
+
+		g.Expect(r.OLM().Available()).To(BeTrue())
+
+		csvs, err := r.OLM().ClusterServiceVersions("").List(t.Context(), metav1.ListOptions{
+			LabelSelector: "operators.coreos.com/rhods-operator.redhat-ods-operator",
+		})
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(csvs.Items).To(HaveLen(1))
+		g.Expect(csvs.Items[0].Spec.Version.String()).To(Equal("2.25.0"))
+	})
+}
+
+func TestNewFileReader_InvalidPath(t *testing.T) {
+	g := NewWithT(t)
+
+	_, err := client.NewFileReader(filepath.Join(t.TempDir(), "missing"))
+	g.Expect(err).To(HaveOccurred())
+}
+
+func TestNewFileReader_Archive(t *testing.T) {
+	g := NewWithT(t)
+
+	path := filepath.Join(t.TempDir(), "snapshot.tar.gz")
+
+	f, err := os.Create(path)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	gz := gzip.NewWriter(f)
+	tw := tar.NewWriter(gz)
+
+	for name, content := range map[string]string{
+		"kubeflow.org/notebooks.yaml":                      snapshotNotebooks,
+		"datasciencecluster.opendatahub.io/clusters.json":  snapshotList,
+		"operators.coreos.com/clusterserviceversions.yaml": snapshotCSV,
+		"README.txt": snapshotIgnored,
+	} {
+		g.Expect(tw.WriteHeader(&tar.Header{
+			Name:     name,
+			Mode:     0o600,
+			Size:     int64(len(content)),
+			Typeflag: tar.TypeReg,
+		})).To(Succeed())
+
+		_, err = tw.Write([]byte(content))
+		g.Expect(err).ToNot(HaveOccurred())
+	}
+
+	g.Expect(tw.Close()).To(Succeed())
+	g.Expect(gz.Close()).To(Succeed())
+	g.Expect(f.Close()).To(Succeed())
+
+	reader, err := client.NewFileReader(path)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	notebooks, err := reader.List(t.Context(), resources.Notebook)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(notebooks).To(HaveLen(2))
+
+	dscs, err := reader.List(t.Context(), resources.DataScienceCluster)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(dscs).To(HaveLen(1))
+}
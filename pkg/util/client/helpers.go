@@ -110,23 +110,11 @@ func WithLimit(limit int64) ListResourcesOption {
 
 // ListResources lists all instances of a resource type handling pagination automatically.
 // Returns pointers to avoid copying large objects.
-//
-//nolint:dupl // Pagination loop is similar to ListMetadata but operates on different client and types
 func (c *defaultClient) ListResources(ctx context.Context, gvr schema.GroupVersionResource, opts ...ListResourcesOption) ([]*unstructured.Unstructured, error) {
 	cfg := &ListResourcesConfig{}
 	util.ApplyOptions(cfg, opts...)
 
-	var allItems []*unstructured.Unstructured
-	continueToken := ""
-
-	for {
-		listOpts := metav1.ListOptions{
-			LabelSelector: cfg.LabelSelector,
-			FieldSelector: cfg.FieldSelector,
-			Limit:         cfg.Limit,
-			Continue:      continueToken,
-		}
-
+	items, err := listPages(cfg, c.pageSize, func(listOpts metav1.ListOptions) ([]*unstructured.Unstructured, string, error) {
 		var list *unstructured.UnstructuredList
 		var err error
 
@@ -137,31 +125,26 @@ func (c *defaultClient) ListResources(ctx context.Context, gvr schema.GroupVersi
 		}
 
 		if err != nil {
-			// Permission errors are non-fatal - return empty list
-			if IsPermissionError(err) {
-				return []*unstructured.Unstructured{}, nil
-			}
-
-			return nil, fmt.Errorf("listing resources: %w", err)
+			return nil, "", err
 		}
 
-		// Append results (convert to pointers)
+		page := make([]*unstructured.Unstructured, 0, len(list.Items))
 		for i := range list.Items {
-			allItems = append(allItems, &list.Items[i])
+			page = append(page, &list.Items[i])
 		}
 
-		// Stop if limit reached or no more pages
-		if cfg.Limit > 0 && int64(len(allItems)) >= cfg.Limit {
-			break
+		return page, list.GetContinue(), nil
+	})
+	if err != nil {
+		// Permission errors are non-fatal - return empty list
+		if IsPermissionError(err) {
+			return []*unstructured.Unstructured{}, nil
 		}
 
-		if list.GetContinue() == "" {
-			break
-		}
-		continueToken = list.GetContinue()
+		return nil, fmt.Errorf("listing resources: %w", err)
 	}
 
-	return allItems, nil
+	return items, nil
 }
 
 // List lists all instances of a resource type handling pagination automatically.
@@ -174,25 +157,13 @@ func (c *defaultClient) List(ctx context.Context, resourceType resources.Resourc
 // ListMetadata lists all instances of a resource type returning only metadata.
 // Handles pagination automatically. Returns pointers to avoid copying.
 // This is more efficient than List when only metadata fields (name, namespace, labels, annotations) are needed.
-//
-//nolint:dupl // Pagination loop is similar to ListResources but operates on different client and types
 func (c *defaultClient) ListMetadata(ctx context.Context, resourceType resources.ResourceType, opts ...ListResourcesOption) ([]*metav1.PartialObjectMetadata, error) {
 	cfg := &ListResourcesConfig{}
 	util.ApplyOptions(cfg, opts...)
 
-	var allItems []*metav1.PartialObjectMetadata
-	continueToken := ""
-
 	gvr := resourceType.GVR()
 
-	for {
-		listOpts := metav1.ListOptions{
-			LabelSelector: cfg.LabelSelector,
-			FieldSelector: cfg.FieldSelector,
-			Limit:         cfg.Limit,
-			Continue:      continueToken,
-		}
-
+	items, err := listPages(cfg, c.pageSize, func(listOpts metav1.ListOptions) ([]*metav1.PartialObjectMetadata, string, error) {
 		var list *metav1.PartialObjectMetadataList
 		var err error
 
@@ -203,31 +174,66 @@ func (c *defaultClient) ListMetadata(ctx context.Context, resourceType resources
 		}
 
 		if err != nil {
-			// Permission errors are non-fatal - return empty list
-			if IsPermissionError(err) {
-				return []*metav1.PartialObjectMetadata{}, nil
-			}
-
-			return nil, fmt.Errorf("listing metadata for resources: %w", err)
+			return nil, "", err
 		}
 
-		// Append results (convert to pointers)
+		page := make([]*metav1.PartialObjectMetadata, 0, len(list.Items))
 		for i := range list.Items {
-			allItems = append(allItems, &list.Items[i])
+			page = append(page, &list.Items[i])
+		}
+
+		return page, list.GetContinue(), nil
+	})
+	if err != nil {
+		// Permission errors are non-fatal - return empty list
+		if IsPermissionError(err) {
+			return []*metav1.PartialObjectMetadata{}, nil
+		}
+
+		return nil, fmt.Errorf("listing metadata for resources: %w", err)
+	}
+
+	return items, nil
+}
+
+// listPages requests the pages of a list of at most pageSize items each (one request when 0),
+// following continue tokens until the last page or the limit of cfg, and aggregates the items.
+func listPages[T any](
+	cfg *ListResourcesConfig,
+	pageSize int64,
+	fetch func(listOpts metav1.ListOptions) ([]T, string, error),
+) ([]T, error) {
+	if cfg.Limit > 0 && (pageSize == 0 || cfg.Limit < pageSize) {
+		pageSize = cfg.Limit
+	}
+
+	var allItems []T
+	continueToken := ""
+
+	for {
+		page, next, err := fetch(metav1.ListOptions{
+			LabelSelector: cfg.LabelSelector,
+			FieldSelector: cfg.FieldSelector,
+			Limit:         pageSize,
+			Continue:      continueToken,
+		})
+		if err != nil {
+			return nil, err
 		}
 
+		allItems = append(allItems, page...)
+
 		// Stop if limit reached or no more pages
 		if cfg.Limit > 0 && int64(len(allItems)) >= cfg.Limit {
-			break
+			return allItems[:cfg.Limit], nil
 		}
 
-		if list.GetContinue() == "" {
-			break
+		if next == "" {
+			return allItems, nil
 		}
-		continueToken = list.GetContinue()
-	}
 
-	return allItems, nil
+		continueToken = next
+	}
 }
 
 // GetResource is a convenience wrapper around Get that accepts ResourceType.
@@ -549,6 +555,7 @@ func isCRDEstablished(crd *apiextensionsv1.CustomResourceDefinition) bool {
 type PatchConfig struct {
 	DryRun     bool
 	FieldOwner string
+	Namespace  string
 }
 
 // PatchOption is a functional option for configuring Patch operations.
@@ -561,6 +568,13 @@ func WithDryRun() PatchOption {
 	})
 }
 
+// PatchInNamespace targets a namespaced resource. Omit for cluster-scoped resources.
+func PatchInNamespace(ns string) PatchOption {
+	return util.FunctionalOption[PatchConfig](func(c *PatchConfig) {
+		c.Namespace = ns
+	})
+}
+
 // WithFieldOwner sets the field owner for server-side apply.
 func WithFieldOwner(owner string) PatchOption {
 	return util.FunctionalOption[PatchConfig](func(c *PatchConfig) {
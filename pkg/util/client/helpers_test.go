@@ -4,6 +4,7 @@ package client
 import (
 	"context"
 	"errors"
+	"strconv"
 	"testing"
 
 	"github.com/onsi/gomega/types"
@@ -123,6 +124,97 @@ func TestListResources_MultiplePages(t *testing.T) {
 	}
 }
 
+// pagedFetch returns a fetch function for listPages serving items in pages of the requested
+// limit, and records the list options of each request.
+func pagedFetch(items []string, requests *[]metav1.ListOptions) func(metav1.ListOptions) ([]string, string, error) {
+	return func(opts metav1.ListOptions) ([]string, string, error) {
+		*requests = append(*requests, opts)
+
+		start, end := 0, len(items)
+		if opts.Continue != "" {
+			start, _ = strconv.Atoi(opts.Continue)
+		}
+
+		if opts.Limit > 0 && start+int(opts.Limit) < end {
+			end = start + int(opts.Limit)
+		}
+
+		next := ""
+		if end < len(items) {
+			next = strconv.Itoa(end)
+		}
+
+		return items[start:end], next, nil
+	}
+}
+
+func TestListPages(t *testing.T) {
+	items := []string{"a", "b", "c", "d", "e"}
+
+	t.Run("aggregates pages following continue tokens", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var requests []metav1.ListOptions
+
+		results, err := listPages(&ListResourcesConfig{LabelSelector: "app=x"}, 2, pagedFetch(items, &requests))
+
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(results).To(Equal(items))
+		g.Expect(requests).To(HaveLen(3))
+		g.Expect(requests[0]).To(And(HaveField("Limit", int64(2)), HaveField("Continue", ""), HaveField("LabelSelector", "app=x")))
+		g.Expect(requests[1].Continue).To(Equal("2"))
+		g.Expect(requests[2].Continue).To(Equal("4"))
+	})
+
+	t.Run("lists in a single request without page size", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var requests []metav1.ListOptions
+
+		results, err := listPages(&ListResourcesConfig{}, 0, pagedFetch(items, &requests))
+
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(results).To(Equal(items))
+		g.Expect(requests).To(HaveLen(1))
+		g.Expect(requests[0].Limit).To(BeZero())
+	})
+
+	t.Run("limit caps the total across pages", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var requests []metav1.ListOptions
+
+		results, err := listPages(&ListResourcesConfig{Limit: 3}, 2, pagedFetch(items, &requests))
+
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(results).To(Equal([]string{"a", "b", "c"}))
+		g.Expect(requests).To(HaveLen(2))
+	})
+
+	t.Run("limit below page size sets the page size", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var requests []metav1.ListOptions
+
+		results, err := listPages(&ListResourcesConfig{Limit: 1}, 2, pagedFetch(items, &requests))
+
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(results).To(Equal([]string{"a"}))
+		g.Expect(requests).To(HaveLen(1))
+		g.Expect(requests[0].Limit).To(Equal(int64(1)))
+	})
+
+	t.Run("errors are returned", func(t *testing.T) {
+		g := NewWithT(t)
+
+		_, err := listPages(&ListResourcesConfig{}, 2, func(metav1.ListOptions) ([]string, string, error) {
+			return nil, "", errors.New("boom")
+		})
+
+		g.Expect(err).To(MatchError("boom"))
+	})
+}
+
 func TestListResources_EmptyResults(t *testing.T) {
 	g := NewWithT(t)
 	ctx := t.Context()
@@ -82,6 +82,49 @@ func TestPatch(t *testing.T) {
 		g.Expect(result.GetName()).To(Equal("default-dsc"))
 	})
 
+	t.Run("patches namespaced resource when PatchInNamespace is set", func(t *testing.T) {
+		g := NewWithT(t)
+		ctx := t.Context()
+
+		nb := &unstructured.Unstructured{
+			Object: map[string]any{
+				"apiVersion": resources.Notebook.APIVersion(),
+				"kind":       resources.Notebook.Kind,
+				"metadata": map[string]any{
+					"name":      "my-notebook",
+					"namespace": "user-ns",
+					"annotations": map[string]any{
+						"opendatahub.io/legacy-hardware-profile-name": "small",
+					},
+				},
+			},
+		}
+
+		scheme := runtime.NewScheme()
+		_ = metav1.AddMetaToScheme(scheme)
+
+		listKinds := map[schema.GroupVersionResource]string{
+			resources.Notebook.GVR(): resources.Notebook.ListKind(),
+		}
+
+		client := &defaultClient{
+			dynamic: dynamicfake.NewSimpleDynamicClientWithCustomListKinds(scheme, listKinds, nb),
+		}
+
+		result, err := client.Patch(
+			ctx,
+			resources.Notebook,
+			"my-notebook",
+			types.MergePatchType,
+			[]byte(`{"metadata":{"annotations":{"opendatahub.io/legacy-hardware-profile-name":null}}}`),
+			PatchInNamespace("user-ns"),
+		)
+
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(result.GetNamespace()).To(Equal("user-ns"))
+		g.Expect(result.GetAnnotations()).ToNot(HaveKey("opendatahub.io/legacy-hardware-profile-name"))
+	})
+
 	t.Run("returns error for non-existent resource", func(t *testing.T) {
 		g := NewWithT(t)
 		ctx := context.Background()
@@ -139,6 +182,16 @@ func TestPatchConfig_Options(t *testing.T) {
 		g.Expect(cfg.FieldOwner).To(Equal("test-owner"))
 	})
 
+	t.Run("PatchInNamespace sets namespace", func(t *testing.T) {
+		g := NewWithT(t)
+
+		cfg := &PatchConfig{}
+		opt := PatchInNamespace("user-ns")
+		opt.ApplyTo(cfg)
+
+		g.Expect(cfg.Namespace).To(Equal("user-ns"))
+	})
+
 	t.Run("multiple options can be combined", func(t *testing.T) {
 		g := NewWithT(t)
 
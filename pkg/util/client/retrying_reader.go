@@ -0,0 +1,164 @@
+package client
+
+import (
+	"context"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+)
+
+// Compile-time verification that retryingReader implements Reader.
+var _ Reader = (*retryingReader)(nil)
+
+const (
+	// DefaultRetryAttempts is the default number of attempts per read, including the first one.
+	DefaultRetryAttempts = 3
+
+	// DefaultRetryBackoff is the default delay before the first retry.
+	DefaultRetryBackoff = 500 * time.Millisecond
+)
+
+// RetryPolicy controls how reads failing with transient API errors are retried.
+type RetryPolicy struct {
+	// Attempts is the maximum number of attempts per read, including the first one.
+	// Values below 2 disable retries.
+	Attempts int
+
+	// Backoff is the delay before the first retry; it doubles for every further retry.
+	// A longer delay requested by the server (Retry-After) takes precedence.
+	Backoff time.Duration
+}
+
+// DefaultRetryPolicy returns the retry policy used by lint checks.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		Attempts: DefaultRetryAttempts,
+		Backoff:  DefaultRetryBackoff,
+	}
+}
+
+// retryingReader retries reads of a delegate Reader that fail with transient errors
+// (see IsTransientError), so throttling or a briefly unavailable API server does not
+// fail the caller. Other errors are returned immediately.
+type retryingReader struct {
+	delegate Reader
+	policy   RetryPolicy
+}
+
+// NewRetryingReader wraps a Reader so that reads failing with transient API errors are
+// retried with exponential backoff according to policy. OLM reads are not retried.
+func NewRetryingReader(delegate Reader, policy RetryPolicy) Reader {
+	return &retryingReader{
+		delegate: delegate,
+		policy:   policy,
+	}
+}
+
+// withRetry calls fn until it succeeds, fails with a non-transient error, the attempts are
+// exhausted or ctx is done. The last error is returned.
+func withRetry[T any](ctx context.Context, policy RetryPolicy, fn func() (T, error)) (T, error) {
+	backoff := policy.Backoff
+
+	for attempt := 1; ; attempt++ {
+		value, err := fn()
+		if err == nil || attempt >= policy.Attempts || !IsTransientError(err) {
+			return value, err
+		}
+
+		delay := backoff
+		if seconds, ok := apierrors.SuggestsClientDelay(err); ok && time.Duration(seconds)*time.Second > delay {
+			delay = time.Duration(seconds) * time.Second
+		}
+
+		timer := time.NewTimer(delay)
+
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+
+			return value, err
+		case <-timer.C:
+		}
+
+		backoff *= 2
+	}
+}
+
+// List lists resources by ResourceType, retrying transient failures.
+func (r *retryingReader) List(
+	ctx context.Context,
+	resourceType resources.ResourceType,
+	opts ...ListResourcesOption,
+) ([]*unstructured.Unstructured, error) {
+	return withRetry(ctx, r.policy, func() ([]*unstructured.Unstructured, error) {
+		return r.delegate.List(ctx, resourceType, opts...) //nolint:wrapcheck // returned unchanged
+	})
+}
+
+// ListMetadata lists resource metadata, retrying transient failures.
+func (r *retryingReader) ListMetadata(
+	ctx context.Context,
+	resourceType resources.ResourceType,
+	opts ...ListResourcesOption,
+) ([]*metav1.PartialObjectMetadata, error) {
+	return withRetry(ctx, r.policy, func() ([]*metav1.PartialObjectMetadata, error) {
+		return r.delegate.ListMetadata(ctx, resourceType, opts...) //nolint:wrapcheck // returned unchanged
+	})
+}
+
+// ListResources lists resources by GVR, retrying transient failures.
+func (r *retryingReader) ListResources(
+	ctx context.Context,
+	gvr schema.GroupVersionResource,
+	opts ...ListResourcesOption,
+) ([]*unstructured.Unstructured, error) {
+	return withRetry(ctx, r.policy, func() ([]*unstructured.Unstructured, error) {
+		return r.delegate.ListResources(ctx, gvr, opts...) //nolint:wrapcheck // returned unchanged
+	})
+}
+
+// Get retrieves a single resource by GVR, retrying transient failures.
+func (r *retryingReader) Get(
+	ctx context.Context,
+	gvr schema.GroupVersionResource,
+	name string,
+	opts ...GetOption,
+) (*unstructured.Unstructured, error) {
+	return withRetry(ctx, r.policy, func() (*unstructured.Unstructured, error) {
+		return r.delegate.Get(ctx, gvr, name, opts...) //nolint:wrapcheck // returned unchanged
+	})
+}
+
+// GetResource retrieves a single resource by ResourceType, retrying transient failures.
+func (r *retryingReader) GetResource(
+	ctx context.Context,
+	resourceType resources.ResourceType,
+	name string,
+	opts ...GetOption,
+) (*unstructured.Unstructured, error) {
+	return withRetry(ctx, r.policy, func() (*unstructured.Unstructured, error) {
+		return r.delegate.GetResource(ctx, resourceType, name, opts...) //nolint:wrapcheck // returned unchanged
+	})
+}
+
+// GetResourceMetadata retrieves a single resource's metadata, retrying transient failures.
+func (r *retryingReader) GetResourceMetadata(
+	ctx context.Context,
+	resourceType resources.ResourceType,
+	name string,
+	opts ...GetOption,
+) (*metav1.PartialObjectMetadata, error) {
+	return withRetry(ctx, r.policy, func() (*metav1.PartialObjectMetadata, error) {
+		return r.delegate.GetResourceMetadata(ctx, resourceType, name, opts...) //nolint:wrapcheck // returned unchanged
+	})
+}
+
+// OLM returns the delegate's OLM accessor without retries.
+func (r *retryingReader) OLM() OLMReader {
+	return r.delegate.OLM()
+}
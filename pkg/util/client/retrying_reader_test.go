@@ -0,0 +1,110 @@
+package client_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8stesting "k8s.io/client-go/testing"
+
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+
+	. "github.com/onsi/gomega"
+)
+
+func newTestRetryPolicy() client.RetryPolicy {
+	return client.RetryPolicy{Attempts: 3, Backoff: time.Millisecond}
+}
+
+func TestRetryingReader(t *testing.T) {
+	t.Run("retries transient errors until the read succeeds", func(t *testing.T) {
+		g := NewWithT(t)
+
+		c, dyn, _ := newCountingClient(newCachingTestNotebook("team-a", "nb-a"))
+
+		var calls int
+
+		failures := 2
+		dyn.PrependReactor("list", resources.Notebook.Resource, func(_ k8stesting.Action) (bool, runtime.Object, error) {
+			calls++
+
+			if failures == 0 {
+				return false, nil, nil
+			}
+
+			failures--
+
+			return true, nil, apierrors.NewTooManyRequests("throttled", 0)
+		})
+
+		reader := client.NewRetryingReader(c, newTestRetryPolicy())
+
+		items, err := reader.List(t.Context(), resources.Notebook)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(items).To(HaveLen(1))
+		g.Expect(calls).To(Equal(3))
+	})
+
+	t.Run("gives up after the configured attempts", func(t *testing.T) {
+		g := NewWithT(t)
+
+		c, dyn, _ := newCountingClient()
+		var calls int
+
+		dyn.PrependReactor("list", resources.Notebook.Resource, func(_ k8stesting.Action) (bool, runtime.Object, error) {
+			calls++
+
+			return true, nil, apierrors.NewServiceUnavailable("unavailable")
+		})
+
+		reader := client.NewRetryingReader(c, newTestRetryPolicy())
+
+		_, err := reader.List(t.Context(), resources.Notebook)
+		g.Expect(apierrors.IsServiceUnavailable(err)).To(BeTrue())
+		g.Expect(calls).To(Equal(3))
+	})
+
+	t.Run("does not retry non-transient errors", func(t *testing.T) {
+		g := NewWithT(t)
+
+		c, dyn, _ := newCountingClient()
+		var calls int
+
+		dyn.PrependReactor("get", resources.Notebook.Resource, func(_ k8stesting.Action) (bool, runtime.Object, error) {
+			calls++
+
+			return true, nil, apierrors.NewNotFound(resources.Notebook.GVR().GroupResource(), "nb-a")
+		})
+
+		reader := client.NewRetryingReader(c, newTestRetryPolicy())
+
+		_, err := reader.GetResource(t.Context(), resources.Notebook, "nb-a", client.InNamespace("team-a"))
+		g.Expect(apierrors.IsNotFound(err)).To(BeTrue())
+		g.Expect(calls).To(Equal(1))
+	})
+
+	t.Run("stops retrying when the context is done", func(t *testing.T) {
+		g := NewWithT(t)
+
+		c, dyn, _ := newCountingClient()
+		var calls int
+
+		dyn.PrependReactor("list", resources.Notebook.Resource, func(_ k8stesting.Action) (bool, runtime.Object, error) {
+			calls++
+
+			return true, nil, apierrors.NewInternalError(context.DeadlineExceeded)
+		})
+
+		ctx, cancel := context.WithCancel(t.Context())
+		cancel()
+
+		reader := client.NewRetryingReader(c, client.RetryPolicy{Attempts: 5, Backoff: time.Hour})
+
+		_, err := reader.List(ctx, resources.Notebook)
+		g.Expect(apierrors.IsInternalError(err)).To(BeTrue())
+		g.Expect(calls).To(Equal(1))
+	})
+}
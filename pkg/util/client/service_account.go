@@ -0,0 +1,71 @@
+package client
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// ServiceAccountDir is where Kubernetes mounts the service account token of a pod.
+const ServiceAccountDir = "/var/run/secrets/kubernetes.io/serviceaccount"
+
+const serviceAccountUserPrefix = "system:serviceaccount:"
+
+// ErrNoServiceAccount is returned by ReadServiceAccount when no token is mounted, i.e. the
+// CLI is not running in a pod.
+var ErrNoServiceAccount = errors.New("no service account token mounted")
+
+// ServiceAccount identifies the service account a pod runs as.
+type ServiceAccount struct {
+	Namespace string
+	Name      string
+}
+
+// String returns the service account as namespace:name, the form accepted by
+// `lint rbac --service-account`.
+func (sa ServiceAccount) String() string {
+	return sa.Namespace + ":" + sa.Name
+}
+
+// ReadServiceAccount reads the service account of the token mounted in dir (usually
+// ServiceAccountDir). The name is taken from the subject of the token, which is not verified.
+func ReadServiceAccount(dir string) (*ServiceAccount, error) {
+	token, err := os.ReadFile(filepath.Join(dir, "token"))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, ErrNoServiceAccount
+		}
+
+		return nil, fmt.Errorf("reading service account token: %w", err)
+	}
+
+	// A JWT is header.payload.signature, with a base64url-encoded JSON payload
+	parts := strings.Split(strings.TrimSpace(string(token)), ".")
+	if len(parts) != 3 {
+		return nil, errors.New("parsing service account token: not a JWT")
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return nil, fmt.Errorf("parsing service account token: %w", err)
+	}
+
+	var claims struct {
+		Subject string `json:"sub"`
+	}
+
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return nil, fmt.Errorf("parsing service account token: %w", err)
+	}
+
+	namespace, name, found := strings.Cut(strings.TrimPrefix(claims.Subject, serviceAccountUserPrefix), ":")
+	if !strings.HasPrefix(claims.Subject, serviceAccountUserPrefix) || !found || namespace == "" || name == "" {
+		return nil, fmt.Errorf("parsing service account token: unexpected subject %q", claims.Subject)
+	}
+
+	return &ServiceAccount{Namespace: namespace, Name: name}, nil
+}
@@ -0,0 +1,58 @@
+package client_test
+
+import (
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+
+	. "github.com/onsi/gomega"
+)
+
+const (
+	testTokenHeader    = `{"alg":"RS256"}`
+	testTokenPayload   = `{"iss":"kubernetes/serviceaccount","sub":"system:serviceaccount:odh-lint:odh-lint"}`
+	testUserTokenClaim = `{"sub":"kube:admin"}`
+)
+
+func writeToken(t *testing.T, payload string) string {
+	t.Helper()
+
+	dir := t.TempDir()
+	token := base64.RawURLEncoding.EncodeToString([]byte(testTokenHeader)) + "." +
+		base64.RawURLEncoding.EncodeToString([]byte(payload)) + ".signature"
+
+	NewWithT(t).Expect(os.WriteFile(filepath.Join(dir, "token"), []byte(token+"\n"), 0o600)).To(Succeed())
+
+	return dir
+}
+
+func TestReadServiceAccount(t *testing.T) {
+	t.Run("should read the service account from the token subject", func(t *testing.T) {
+		g := NewWithT(t)
+
+		sa, err := client.ReadServiceAccount(writeToken(t, testTokenPayload))
+
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(sa).To(And(HaveField("Namespace", "odh-lint"), HaveField("Name", "odh-lint")))
+		g.Expect(sa.String()).To(Equal("odh-lint:odh-lint"))
+	})
+
+	t.Run("should report a missing token", func(t *testing.T) {
+		g := NewWithT(t)
+
+		_, err := client.ReadServiceAccount(t.TempDir())
+
+		g.Expect(err).To(MatchError(client.ErrNoServiceAccount))
+	})
+
+	t.Run("should reject a token of another subject", func(t *testing.T) {
+		g := NewWithT(t)
+
+		_, err := client.ReadServiceAccount(writeToken(t, testUserTokenClaim))
+
+		g.Expect(err).To(MatchError(ContainSubstring(`unexpected subject "kube:admin"`)))
+	})
+}
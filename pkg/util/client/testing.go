@@ -20,6 +20,9 @@ type TestClientConfig struct {
 	Metadata      metadata.Interface
 	Kubernetes    kubernetes.Interface
 	RESTMapper    meta.RESTMapper
+
+	// PageSize is the number of items requested per page when listing; 0 disables pagination.
+	PageSize int64
 }
 
 // NewForTesting creates a Client for use in tests.
@@ -34,5 +37,6 @@ func NewForTesting(cfg TestClientConfig) Client {
 		kubernetes:    cfg.Kubernetes,
 		restMapper:    cfg.RESTMapper,
 		olmReader:     newOLMReader(cfg.OLM),
+		pageSize:      cfg.PageSize,
 	}
 }
@@ -0,0 +1,373 @@
+package client
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	operatorsv1alpha1 "github.com/operator-framework/api/pkg/operators/v1alpha1"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util"
+)
+
+// Compile-time verification that tracingReader implements Reader.
+var _ Reader = (*tracingReader)(nil)
+
+// Verbs of traced API calls.
+const (
+	TraceVerbGet  = "get"
+	TraceVerbList = "list"
+)
+
+//nolint:gochecknoglobals // Read-only GVRs of the OLM resources read through OLMReader
+var (
+	subscriptionGVR = operatorsv1alpha1.SchemeGroupVersion.WithResource("subscriptions")
+	csvGVR          = operatorsv1alpha1.SchemeGroupVersion.WithResource("clusterserviceversions")
+)
+
+// APICall records a read performed through a tracing reader.
+type APICall struct {
+	Verb     string `json:"verb"               yaml:"verb"`
+	Group    string `json:"group,omitempty"    yaml:"group,omitempty"`
+	Version  string `json:"version"            yaml:"version"`
+	Resource string `json:"resource"           yaml:"resource"`
+
+	Namespace     string `json:"namespace,omitempty"     yaml:"namespace,omitempty"`
+	Name          string `json:"name,omitempty"          yaml:"name,omitempty"`
+	LabelSelector string `json:"labelSelector,omitempty" yaml:"labelSelector,omitempty"`
+	FieldSelector string `json:"fieldSelector,omitempty" yaml:"fieldSelector,omitempty"`
+
+	// MetadataOnly is set for reads returning object metadata only.
+	MetadataOnly bool `json:"metadataOnly,omitempty" yaml:"metadataOnly,omitempty"`
+
+	StartedAt metav1.Time     `json:"startedAt" yaml:"startedAt"`
+	Duration  metav1.Duration `json:"duration"  yaml:"duration"`
+
+	// Items is the number of objects returned: the list size, or 1 for a successful get.
+	Items int `json:"items" yaml:"items"`
+
+	Error string `json:"error,omitempty" yaml:"error,omitempty"`
+}
+
+// Tracer collects the API calls recorded by tracing readers. It is safe for concurrent use.
+type Tracer struct {
+	mu    sync.Mutex
+	calls []APICall
+}
+
+// NewTracer creates an empty Tracer.
+func NewTracer() *Tracer {
+	return &Tracer{}
+}
+
+// Calls returns the recorded calls in the order they started.
+func (t *Tracer) Calls() []APICall {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	calls := slices.Clone(t.calls)
+	slices.SortStableFunc(calls, func(a, b APICall) int {
+		return a.StartedAt.Compare(b.StartedAt.Time)
+	})
+
+	return calls
+}
+
+func (t *Tracer) record(call APICall) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.calls = append(t.calls, call)
+}
+
+// observer receives the reads of a tracing reader: recorded in a Tracer, exported as
+// OpenTelemetry spans, or both.
+type observer struct {
+	calls *Tracer
+	spans trace.Tracer
+}
+
+// observe runs fn and reports it as call, with its duration, result size and error.
+func observe[T any](ctx context.Context, o observer, call APICall, fn func() (T, error), size func(T) int) (T, error) {
+	var span trace.Span
+	if o.spans != nil {
+		_, span = o.spans.Start(ctx, call.Verb+" "+call.Resource,
+			trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(call.attributes()...))
+		defer span.End()
+	}
+
+	start := time.Now()
+
+	value, err := fn()
+
+	call.StartedAt = metav1.NewTime(start)
+	call.Duration = metav1.Duration{Duration: time.Since(start)}
+	if err != nil {
+		call.Error = err.Error()
+	} else {
+		call.Items = size(value)
+	}
+
+	if span != nil {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, call.Error)
+		} else {
+			span.SetAttributes(attribute.Int("k8s.items", call.Items))
+		}
+	}
+
+	if o.calls != nil {
+		o.calls.record(call)
+	}
+
+	return value, err
+}
+
+// attributes returns the span attributes describing the request of the call.
+func (c APICall) attributes() []attribute.KeyValue {
+	attrs := []attribute.KeyValue{
+		attribute.String("k8s.verb", c.Verb),
+		attribute.String("k8s.group", c.Group),
+		attribute.String("k8s.version", c.Version),
+		attribute.String("k8s.resource", c.Resource),
+		attribute.Bool("k8s.metadata_only", c.MetadataOnly),
+	}
+
+	for _, optional := range []attribute.KeyValue{
+		attribute.String("k8s.namespace", c.Namespace),
+		attribute.String("k8s.name", c.Name),
+		attribute.String("k8s.label_selector", c.LabelSelector),
+		attribute.String("k8s.field_selector", c.FieldSelector),
+	} {
+		if optional.Value.AsString() != "" {
+			attrs = append(attrs, optional)
+		}
+	}
+
+	return attrs
+}
+
+func newListCall(gvr schema.GroupVersionResource, metadataOnly bool, opts []ListResourcesOption) APICall {
+	cfg := &ListResourcesConfig{}
+	util.ApplyOptions(cfg, opts...)
+
+	return APICall{
+		Verb:          TraceVerbList,
+		Group:         gvr.Group,
+		Version:       gvr.Version,
+		Resource:      gvr.Resource,
+		Namespace:     cfg.Namespace,
+		LabelSelector: cfg.LabelSelector,
+		FieldSelector: cfg.FieldSelector,
+		MetadataOnly:  metadataOnly,
+	}
+}
+
+func newGetCall(gvr schema.GroupVersionResource, name string, metadataOnly bool, opts []GetOption) APICall {
+	cfg := &GetConfig{}
+	util.ApplyOptions(cfg, opts...)
+
+	return APICall{
+		Verb:         TraceVerbGet,
+		Group:        gvr.Group,
+		Version:      gvr.Version,
+		Resource:     gvr.Resource,
+		Namespace:    cfg.Namespace,
+		Name:         name,
+		MetadataOnly: metadataOnly,
+	}
+}
+
+func sizeOf[T any](items []T) int {
+	return len(items)
+}
+
+func sizeOne[T any](_ T) int {
+	return 1
+}
+
+// tracingReader reports every read of a delegate Reader to an observer.
+type tracingReader struct {
+	delegate Reader
+	observer observer
+}
+
+// NewTracingReader wraps a Reader so that every get and list, including OLM reads, is
+// recorded in tracer with its GVR, namespace, selectors, duration and result size.
+func NewTracingReader(delegate Reader, tracer *Tracer) Reader {
+	return &tracingReader{
+		delegate: delegate,
+		observer: observer{calls: tracer},
+	}
+}
+
+// NewSpanReader wraps a Reader so that every get and list, including OLM reads, is exported
+// as an OpenTelemetry client span of spans, a child of the span in the call's context.
+func NewSpanReader(delegate Reader, spans trace.Tracer) Reader {
+	return &tracingReader{
+		delegate: delegate,
+		observer: observer{spans: spans},
+	}
+}
+
+// List lists resources by ResourceType and reports the call.
+func (r *tracingReader) List(
+	ctx context.Context,
+	resourceType resources.ResourceType,
+	opts ...ListResourcesOption,
+) ([]*unstructured.Unstructured, error) {
+	return observe(ctx, r.observer, newListCall(resourceType.GVR(), false, opts), func() ([]*unstructured.Unstructured, error) {
+		return r.delegate.List(ctx, resourceType, opts...) //nolint:wrapcheck // returned unchanged
+	}, sizeOf)
+}
+
+// ListMetadata lists resource metadata and reports the call.
+func (r *tracingReader) ListMetadata(
+	ctx context.Context,
+	resourceType resources.ResourceType,
+	opts ...ListResourcesOption,
+) ([]*metav1.PartialObjectMetadata, error) {
+	return observe(ctx, r.observer, newListCall(resourceType.GVR(), true, opts), func() ([]*metav1.PartialObjectMetadata, error) {
+		return r.delegate.ListMetadata(ctx, resourceType, opts...) //nolint:wrapcheck // returned unchanged
+	}, sizeOf)
+}
+
+// ListResources lists resources by GVR and reports the call.
+func (r *tracingReader) ListResources(
+	ctx context.Context,
+	gvr schema.GroupVersionResource,
+	opts ...ListResourcesOption,
+) ([]*unstructured.Unstructured, error) {
+	return observe(ctx, r.observer, newListCall(gvr, false, opts), func() ([]*unstructured.Unstructured, error) {
+		return r.delegate.ListResources(ctx, gvr, opts...) //nolint:wrapcheck // returned unchanged
+	}, sizeOf)
+}
+
+// Get retrieves a single resource by GVR and reports the call.
+func (r *tracingReader) Get(
+	ctx context.Context,
+	gvr schema.GroupVersionResource,
+	name string,
+	opts ...GetOption,
+) (*unstructured.Unstructured, error) {
+	return observe(ctx, r.observer, newGetCall(gvr, name, false, opts), func() (*unstructured.Unstructured, error) {
+		return r.delegate.Get(ctx, gvr, name, opts...) //nolint:wrapcheck // returned unchanged
+	}, sizeOne)
+}
+
+// GetResource retrieves a single resource by ResourceType and reports the call.
+func (r *tracingReader) GetResource(
+	ctx context.Context,
+	resourceType resources.ResourceType,
+	name string,
+	opts ...GetOption,
+) (*unstructured.Unstructured, error) {
+	return observe(ctx, r.observer, newGetCall(resourceType.GVR(), name, false, opts), func() (*unstructured.Unstructured, error) {
+		return r.delegate.GetResource(ctx, resourceType, name, opts...) //nolint:wrapcheck // returned unchanged
+	}, sizeOne)
+}
+
+// GetResourceMetadata retrieves a single resource's metadata and reports the call.
+func (r *tracingReader) GetResourceMetadata(
+	ctx context.Context,
+	resourceType resources.ResourceType,
+	name string,
+	opts ...GetOption,
+) (*metav1.PartialObjectMetadata, error) {
+	return observe(ctx, r.observer, newGetCall(resourceType.GVR(), name, true, opts), func() (*metav1.PartialObjectMetadata, error) {
+		return r.delegate.GetResourceMetadata(ctx, resourceType, name, opts...) //nolint:wrapcheck // returned unchanged
+	}, sizeOne)
+}
+
+// OLM returns the delegate's OLM accessor, reporting its reads.
+func (r *tracingReader) OLM() OLMReader {
+	return &tracingOLMReader{delegate: r.delegate.OLM(), observer: r.observer}
+}
+
+// tracingOLMReader reports the reads of a delegate OLMReader.
+type tracingOLMReader struct {
+	delegate OLMReader
+	observer observer
+}
+
+func (r *tracingOLMReader) Available() bool {
+	return r.delegate.Available()
+}
+
+func (r *tracingOLMReader) Subscriptions(namespace string) SubscriptionReader {
+	return &tracingSubscriptionReader{delegate: r.delegate.Subscriptions(namespace), observer: r.observer, namespace: namespace}
+}
+
+func (r *tracingOLMReader) ClusterServiceVersions(namespace string) CSVReader {
+	return &tracingCSVReader{delegate: r.delegate.ClusterServiceVersions(namespace), observer: r.observer, namespace: namespace}
+}
+
+type tracingSubscriptionReader struct {
+	delegate  SubscriptionReader
+	observer  observer
+	namespace string
+}
+
+func (r *tracingSubscriptionReader) List(
+	ctx context.Context,
+	opts metav1.ListOptions,
+) (*operatorsv1alpha1.SubscriptionList, error) {
+	call := newListCall(subscriptionGVR, false, []ListResourcesOption{
+		WithNamespace(r.namespace), WithLabelSelector(opts.LabelSelector), WithFieldSelector(opts.FieldSelector),
+	})
+
+	return observe(ctx, r.observer, call, func() (*operatorsv1alpha1.SubscriptionList, error) {
+		return r.delegate.List(ctx, opts) //nolint:wrapcheck // returned unchanged
+	}, func(list *operatorsv1alpha1.SubscriptionList) int { return len(list.Items) })
+}
+
+func (r *tracingSubscriptionReader) Get(
+	ctx context.Context,
+	name string,
+	opts metav1.GetOptions,
+) (*operatorsv1alpha1.Subscription, error) {
+	return observe(ctx, r.observer, newGetCall(subscriptionGVR, name, false, []GetOption{InNamespace(r.namespace)}),
+		func() (*operatorsv1alpha1.Subscription, error) {
+			return r.delegate.Get(ctx, name, opts) //nolint:wrapcheck // returned unchanged
+		}, sizeOne)
+}
+
+type tracingCSVReader struct {
+	delegate  CSVReader
+	observer  observer
+	namespace string
+}
+
+func (r *tracingCSVReader) List(
+	ctx context.Context,
+	opts metav1.ListOptions,
+) (*operatorsv1alpha1.ClusterServiceVersionList, error) {
+	call := newListCall(csvGVR, false, []ListResourcesOption{
+		WithNamespace(r.namespace), WithLabelSelector(opts.LabelSelector), WithFieldSelector(opts.FieldSelector),
+	})
+
+	return observe(ctx, r.observer, call, func() (*operatorsv1alpha1.ClusterServiceVersionList, error) {
+		return r.delegate.List(ctx, opts) //nolint:wrapcheck // returned unchanged
+	}, func(list *operatorsv1alpha1.ClusterServiceVersionList) int { return len(list.Items) })
+}
+
+func (r *tracingCSVReader) Get(
+	ctx context.Context,
+	name string,
+	opts metav1.GetOptions,
+) (*operatorsv1alpha1.ClusterServiceVersion, error) {
+	return observe(ctx, r.observer, newGetCall(csvGVR, name, false, []GetOption{InNamespace(r.namespace)}),
+		func() (*operatorsv1alpha1.ClusterServiceVersion, error) {
+			return r.delegate.Get(ctx, name, opts) //nolint:wrapcheck // returned unchanged
+		}, sizeOne)
+}
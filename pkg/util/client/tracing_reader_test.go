@@ -0,0 +1,145 @@
+package client_test
+
+import (
+	"errors"
+	"testing"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8stesting "k8s.io/client-go/testing"
+
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+)
+
+func TestTracingReader(t *testing.T) {
+	t.Run("records lists with their selectors and result size", func(t *testing.T) {
+		g := NewWithT(t)
+
+		c, _, _ := newCountingClient(
+			newCachingTestNotebook("team-a", "nb-a"),
+			newCachingTestNotebook("team-a", "nb-b"),
+		)
+
+		tracer := client.NewTracer()
+		reader := client.NewTracingReader(c, tracer)
+
+		items, err := reader.List(t.Context(), resources.Notebook,
+			client.WithNamespace("team-a"), client.WithLabelSelector("app=x"))
+		g.Expect(err).ToNot(HaveOccurred())
+
+		calls := tracer.Calls()
+		g.Expect(calls).To(HaveLen(1))
+		g.Expect(calls[0]).To(MatchFields(IgnoreExtras, Fields{
+			"Verb":          Equal(client.TraceVerbList),
+			"Group":         Equal(resources.Notebook.Group),
+			"Version":       Equal(resources.Notebook.Version),
+			"Resource":      Equal(resources.Notebook.Resource),
+			"Namespace":     Equal("team-a"),
+			"LabelSelector": Equal("app=x"),
+			"Items":         Equal(len(items)),
+			"Error":         BeEmpty(),
+		}))
+		g.Expect(calls[0].StartedAt.IsZero()).To(BeFalse())
+	})
+
+	t.Run("records gets and their errors", func(t *testing.T) {
+		g := NewWithT(t)
+
+		c, dyn, _ := newCountingClient(newCachingTestNotebook("team-a", "nb-a"))
+		dyn.PrependReactor("get", resources.Notebook.Resource, func(_ k8stesting.Action) (bool, runtime.Object, error) {
+			return true, nil, apierrors.NewInternalError(errors.New("etcd unavailable"))
+		})
+
+		tracer := client.NewTracer()
+		reader := client.NewTracingReader(c, tracer)
+
+		_, err := reader.GetResource(t.Context(), resources.Notebook, "nb-a", client.InNamespace("team-a"))
+		g.Expect(err).To(HaveOccurred())
+
+		calls := tracer.Calls()
+		g.Expect(calls).To(HaveLen(1))
+		g.Expect(calls[0]).To(MatchFields(IgnoreExtras, Fields{
+			"Verb":      Equal(client.TraceVerbGet),
+			"Namespace": Equal("team-a"),
+			"Name":      Equal("nb-a"),
+			"Items":     BeZero(),
+			"Error":     ContainSubstring("etcd unavailable"),
+		}))
+	})
+
+	t.Run("records the API calls behind a caching reader only once", func(t *testing.T) {
+		g := NewWithT(t)
+
+		c, _, _ := newCountingClient(newCachingTestNotebook("team-a", "nb-a"))
+
+		tracer := client.NewTracer()
+		reader := client.NewCachingReader(client.NewTracingReader(c, tracer))
+
+		for range 3 {
+			_, err := reader.List(t.Context(), resources.Notebook)
+			g.Expect(err).ToNot(HaveOccurred())
+		}
+
+		g.Expect(tracer.Calls()).To(HaveLen(1))
+	})
+}
+
+func TestSpanReader(t *testing.T) {
+	t.Run("exports reads as child spans of the caller", func(t *testing.T) {
+		g := NewWithT(t)
+
+		c, _, _ := newCountingClient(
+			newCachingTestNotebook("team-a", "nb-a"),
+			newCachingTestNotebook("team-a", "nb-b"),
+		)
+
+		recorder := tracetest.NewSpanRecorder()
+		tracer := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)).Tracer("test")
+		reader := client.NewSpanReader(c, tracer)
+
+		ctx, parent := tracer.Start(t.Context(), "check")
+		_, err := reader.List(ctx, resources.Notebook, client.WithNamespace("team-a"))
+		g.Expect(err).ToNot(HaveOccurred())
+		parent.End()
+
+		spans := recorder.Ended()
+		g.Expect(spans).To(HaveLen(2))
+		g.Expect(spans[0].Name()).To(Equal("list " + resources.Notebook.Resource))
+		g.Expect(spans[0].Parent().SpanID()).To(Equal(parent.SpanContext().SpanID()))
+		g.Expect(spans[0].Attributes()).To(ContainElements(
+			attribute.String("k8s.verb", client.TraceVerbList),
+			attribute.String("k8s.resource", resources.Notebook.Resource),
+			attribute.String("k8s.namespace", "team-a"),
+			attribute.Int("k8s.items", 2),
+		))
+	})
+
+	t.Run("marks failed reads as errors", func(t *testing.T) {
+		g := NewWithT(t)
+
+		c, dyn, _ := newCountingClient(newCachingTestNotebook("team-a", "nb-a"))
+		dyn.PrependReactor("get", resources.Notebook.Resource, func(_ k8stesting.Action) (bool, runtime.Object, error) {
+			return true, nil, apierrors.NewInternalError(errors.New("etcd unavailable"))
+		})
+
+		recorder := tracetest.NewSpanRecorder()
+		reader := client.NewSpanReader(c, sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)).Tracer("test"))
+
+		_, err := reader.GetResource(t.Context(), resources.Notebook, "nb-a", client.InNamespace("team-a"))
+		g.Expect(err).To(HaveOccurred())
+
+		spans := recorder.Ended()
+		g.Expect(spans).To(HaveLen(1))
+		g.Expect(spans[0].Status().Code).To(Equal(codes.Error))
+		g.Expect(spans[0].Status().Description).To(ContainSubstring("etcd unavailable"))
+	})
+}
@@ -37,6 +37,11 @@ func StatusUnknown() string {
 	return yellow.Sprint("?")
 }
 
+// StatusSkip returns a yellow skip symbol for checks skipped because a prerequisite failed.
+func StatusSkip() string {
+	return yellow.Sprint("⊘")
+}
+
 // Severity level formatting.
 
 // SeverityCritical returns "critical" in red.
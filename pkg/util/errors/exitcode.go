@@ -96,3 +96,17 @@ func priority(code ExitCode) int {
 func IsHigherPriority(a, b ExitCode) bool {
 	return priority(a) > priority(b)
 }
+
+// WithExitCode wraps err so the process exits with code, for commands with their own exit
+// code scheme. The structured error output of err reports code as its exitCode, keeping
+// it in sync with the process exit status. Returns nil when err is nil.
+func WithExitCode(code ExitCode, err error) error {
+	if err == nil {
+		return nil
+	}
+
+	structErr := *Classify(err)
+	structErr.ExitCode = int(code)
+
+	return &ExitCodeError{Code: code, Err: &structErr}
+}
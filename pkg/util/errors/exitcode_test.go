@@ -214,3 +214,25 @@ func TestExitCodeError(t *testing.T) {
 		g.Expect(exitErr.Code).To(Equal(clierrors.ExitConnection))
 	})
 }
+
+func TestWithExitCode(t *testing.T) {
+	t.Run("should return nil for nil error", func(t *testing.T) {
+		g := NewWithT(t)
+		g.Expect(clierrors.WithExitCode(clierrors.ExitValidation, nil)).ToNot(HaveOccurred())
+	})
+
+	t.Run("should exit with the given code and keep the classification", func(t *testing.T) {
+		g := NewWithT(t)
+		findings := clierrors.NewExitCodeError(clierrors.ExitError,
+			fmt.Errorf("%w: %s", clierrors.ErrLintBlocked, testMsgSomethingBroke))
+
+		err := clierrors.WithExitCode(clierrors.ExitValidation, findings)
+
+		g.Expect(clierrors.ExitCodeFromError(err)).To(Equal(clierrors.ExitValidation))
+		g.Expect(errors.Is(err, clierrors.ErrLintBlocked)).To(BeTrue())
+
+		structErr := clierrors.Classify(err)
+		g.Expect(structErr.Code).To(Equal("LINT_BLOCKED"))
+		g.Expect(structErr.ExitCode).To(Equal(int(clierrors.ExitValidation)))
+	})
+}
@@ -214,3 +214,43 @@ func (q *FullQuietWrapper) ErrOut() io.Writer {
 
 	return q.delegate.ErrOut()
 }
+
+// DataOnlyWrapper passes through only the data written to Out(), for structured output
+// consumed by pipelines (e.g. `-o json --quiet | jq`). Fprintf/Fprintln/Errorf/Errorln are
+// no-ops and ErrOut() returns io.Discard, so progress, discovery messages and logs written
+// to stderr are suppressed as well. Commands must write errors to their own stderr.
+type DataOnlyWrapper struct {
+	delegate Interface
+}
+
+// NewDataOnlyWrapper creates a wrapper that suppresses all output but the data written to Out().
+func NewDataOnlyWrapper(delegate Interface) *DataOnlyWrapper {
+	return &DataOnlyWrapper{delegate: delegate}
+}
+
+// Fprintf is suppressed (no-op) in data-only mode.
+func (d *DataOnlyWrapper) Fprintf(_ string, _ ...any) {}
+
+// Fprintln is suppressed (no-op) in data-only mode.
+func (d *DataOnlyWrapper) Fprintln(_ ...any) {}
+
+// Errorf is suppressed (no-op) in data-only mode.
+func (d *DataOnlyWrapper) Errorf(_ string, _ ...any) {}
+
+// Errorln is suppressed (no-op) in data-only mode.
+func (d *DataOnlyWrapper) Errorln(_ ...any) {}
+
+// Out returns the output writer from the delegate.
+func (d *DataOnlyWrapper) Out() io.Writer {
+	return d.delegate.Out()
+}
+
+// In returns the input reader from the delegate.
+func (d *DataOnlyWrapper) In() io.Reader {
+	return d.delegate.In()
+}
+
+// ErrOut returns io.Discard so writers of diagnostics to stderr also produce nothing.
+func (d *DataOnlyWrapper) ErrOut() io.Writer {
+	return io.Discard
+}
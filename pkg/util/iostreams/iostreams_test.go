@@ -236,6 +236,26 @@ func TestFullQuietWrapper_ImplementsInterface(t *testing.T) {
 	var _ iostreams.Interface = quiet
 }
 
+func TestDataOnlyWrapper_PassesOnlyOutData(t *testing.T) {
+	g := NewWithT(t)
+
+	var out, errOut bytes.Buffer
+	base := iostreams.NewIOStreams(nil, &out, &errOut)
+	dataOnly := iostreams.NewDataOnlyWrapper(base)
+
+	dataOnly.Fprintf("progress %d", 1)
+	dataOnly.Fprintln("info")
+	dataOnly.Errorf("discovered %s", "cluster")
+	dataOnly.Errorln("warning")
+	_, _ = dataOnly.ErrOut().Write([]byte("log line"))
+	_, _ = dataOnly.Out().Write([]byte(`{"kind":"DiagnosticResultList"}`))
+
+	g.Expect(out.String()).To(Equal(`{"kind":"DiagnosticResultList"}`))
+	g.Expect(errOut.String()).To(BeEmpty())
+
+	var _ iostreams.Interface = dataOnly
+}
+
 // T008: Test nil writer validation (edge case from spec).
 func TestIOStreams_NilWriterValidation(t *testing.T) {
 	t.Run("nil Out writer should not panic", func(t *testing.T) {
@@ -0,0 +1,136 @@
+package iostreams
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"golang.org/x/term"
+)
+
+const (
+	// progressInterval is the time between two frames of the progress spinner.
+	progressInterval = 100 * time.Millisecond
+
+	// clearLine moves the cursor to the start of the line and erases it.
+	clearLine = "\r\033[K"
+)
+
+//nolint:gochecknoglobals // Static spinner animation frames
+var spinnerFrames = []string{"⠋", "⠙", "⠹", "⠸", "⠼", "⠴", "⠦", "⠧", "⠇", "⠏"}
+
+// IsTerminal reports whether w is a terminal. Writers that are not an *os.File (buffers,
+// pipes wrapped by tests) are never terminals.
+func IsTerminal(w io.Writer) bool {
+	f, ok := w.(*os.File)
+	if !ok {
+		return false
+	}
+
+	return term.IsTerminal(int(f.Fd()))
+}
+
+// Progress renders an animated spinner followed by a status message on a single terminal
+// line that is redrawn in place. A nil *Progress is valid and renders nothing, so callers
+// can use NewTerminalProgress without checking whether the output is interactive.
+type Progress struct {
+	w io.Writer
+
+	mu      sync.Mutex
+	frame   int
+	message string
+
+	stop     chan struct{}
+	stopped  chan struct{}
+	stopOnce sync.Once
+}
+
+// NewProgress starts a progress display on w. Call Stop to end it and clear the line.
+func NewProgress(w io.Writer) *Progress {
+	p := &Progress{
+		w:       w,
+		stop:    make(chan struct{}),
+		stopped: make(chan struct{}),
+	}
+
+	go p.animate()
+
+	return p
+}
+
+// NewTerminalProgress starts a progress display on w when w is a terminal, and returns nil
+// (a no-op display) otherwise, so non-interactive output is left unchanged.
+func NewTerminalProgress(w io.Writer) *Progress {
+	if !IsTerminal(w) {
+		return nil
+	}
+
+	return NewProgress(w)
+}
+
+// Update replaces the status message and redraws the line.
+func (p *Progress) Update(format string, args ...any) {
+	if p == nil {
+		return
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if len(args) > 0 {
+		p.message = fmt.Sprintf(format, args...)
+	} else {
+		p.message = format
+	}
+
+	p.render()
+}
+
+// Stop ends the animation and clears the line. It is safe to call more than once.
+func (p *Progress) Stop() {
+	if p == nil {
+		return
+	}
+
+	p.stopOnce.Do(func() {
+		close(p.stop)
+		<-p.stopped
+
+		p.mu.Lock()
+		defer p.mu.Unlock()
+
+		if p.message != "" {
+			_, _ = io.WriteString(p.w, clearLine)
+		}
+	})
+}
+
+func (p *Progress) animate() {
+	defer close(p.stopped)
+
+	ticker := time.NewTicker(progressInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-p.stop:
+			return
+		case <-ticker.C:
+			p.mu.Lock()
+			p.frame++
+			p.render()
+			p.mu.Unlock()
+		}
+	}
+}
+
+// render draws the current frame and message; callers must hold mu.
+func (p *Progress) render() {
+	if p.message == "" {
+		return
+	}
+
+	_, _ = fmt.Fprintf(p.w, "%s%s %s", clearLine, spinnerFrames[p.frame%len(spinnerFrames)], p.message)
+}
@@ -0,0 +1,74 @@
+package iostreams_test
+
+import (
+	"bytes"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/opendatahub-io/odh-cli/pkg/util/iostreams"
+
+	. "github.com/onsi/gomega"
+)
+
+// syncBuffer is a bytes.Buffer safe for the concurrent writes of the spinner animation.
+type syncBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.buf.Write(p)
+}
+
+func (b *syncBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.buf.String()
+}
+
+func TestProgress(t *testing.T) {
+	t.Run("redraws the message in place and clears it on stop", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var out syncBuffer
+		progress := iostreams.NewProgress(&out)
+
+		progress.Update("%d/%d checks complete", 3, 5)
+		progress.Stop()
+		progress.Stop()
+
+		g.Expect(out.String()).To(HavePrefix("\r\033[K"))
+		g.Expect(out.String()).To(ContainSubstring(" 3/5 checks complete"))
+		g.Expect(out.String()).To(HaveSuffix("\r\033[K"))
+		g.Expect(strings.Count(out.String(), "\r\033[K")).To(BeNumerically(">=", 2))
+	})
+
+	t.Run("writes nothing when no message was set", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var out syncBuffer
+		iostreams.NewProgress(&out).Stop()
+
+		g.Expect(out.String()).To(BeEmpty())
+	})
+
+	t.Run("is disabled for non-terminal writers", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var out bytes.Buffer
+		progress := iostreams.NewTerminalProgress(&out)
+		g.Expect(progress).To(BeNil())
+
+		// A nil progress is a no-op
+		progress.Update("1/2 checks complete")
+		progress.Stop()
+
+		g.Expect(out.String()).To(BeEmpty())
+		g.Expect(iostreams.IsTerminal(&out)).To(BeFalse())
+	})
+}
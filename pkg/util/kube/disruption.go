@@ -0,0 +1,78 @@
+package kube
+
+import (
+	"context"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+)
+
+// DisruptionBudgets indexes PodDisruptionBudget pod selectors by namespace.
+type DisruptionBudgets map[string][]labels.Selector
+
+// ListDisruptionBudgets lists all PodDisruptionBudgets and indexes their selectors by namespace.
+// PodDisruptionBudgets without a selector are skipped since they match no pods.
+func ListDisruptionBudgets(ctx context.Context, c client.Reader) (DisruptionBudgets, error) {
+	items, err := c.List(ctx, resources.PodDisruptionBudget)
+	if err != nil {
+		if client.IsResourceTypeNotFound(err) {
+			return DisruptionBudgets{}, nil
+		}
+
+		return nil, fmt.Errorf("listing %s: %w", resources.PodDisruptionBudget.Kind, err)
+	}
+
+	budgets := make(DisruptionBudgets)
+
+	for _, item := range items {
+		selector, err := disruptionBudgetSelector(item)
+		if err != nil {
+			return nil, fmt.Errorf("parsing selector of PodDisruptionBudget %s/%s: %w", item.GetNamespace(), item.GetName(), err)
+		}
+
+		if selector == nil {
+			continue
+		}
+
+		budgets[item.GetNamespace()] = append(budgets[item.GetNamespace()], selector)
+	}
+
+	return budgets, nil
+}
+
+// Covers reports whether any PodDisruptionBudget in the namespace selects pods with the given labels.
+func (d DisruptionBudgets) Covers(namespace string, podLabels labels.Set) bool {
+	for _, selector := range d[namespace] {
+		if selector.Matches(podLabels) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func disruptionBudgetSelector(pdb *unstructured.Unstructured) (labels.Selector, error) {
+	raw, found, err := unstructured.NestedMap(pdb.Object, "spec", "selector")
+	if err != nil || !found {
+		return nil, err
+	}
+
+	var ls metav1.LabelSelector
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(raw, &ls); err != nil {
+		return nil, fmt.Errorf("converting label selector: %w", err)
+	}
+
+	// An empty selector in policy/v1 matches every pod in the namespace.
+	selector, err := metav1.LabelSelectorAsSelector(&ls)
+	if err != nil {
+		return nil, fmt.Errorf("converting label selector: %w", err)
+	}
+
+	return selector, nil
+}
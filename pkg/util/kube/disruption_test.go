@@ -0,0 +1,42 @@
+package kube_test
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/opendatahub-io/odh-cli/pkg/util/kube"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestDisruptionBudgetsCovers(t *testing.T) {
+	budgets := kube.DisruptionBudgets{
+		"models": {labels.SelectorFromSet(labels.Set{"app": "granite"})},
+		"shared": {labels.Everything()},
+	}
+
+	t.Run("should match pods selected in the same namespace", func(t *testing.T) {
+		g := NewWithT(t)
+
+		g.Expect(budgets.Covers("models", labels.Set{"app": "granite", "tier": "predictor"})).To(BeTrue())
+	})
+
+	t.Run("should not match pods with different labels", func(t *testing.T) {
+		g := NewWithT(t)
+
+		g.Expect(budgets.Covers("models", labels.Set{"app": "llama"})).To(BeFalse())
+	})
+
+	t.Run("should not match pods in other namespaces", func(t *testing.T) {
+		g := NewWithT(t)
+
+		g.Expect(budgets.Covers("other", labels.Set{"app": "granite"})).To(BeFalse())
+	})
+
+	t.Run("should match every pod for an empty selector", func(t *testing.T) {
+		g := NewWithT(t)
+
+		g.Expect(budgets.Covers("shared", labels.Set{"app": "anything"})).To(BeTrue())
+	})
+}
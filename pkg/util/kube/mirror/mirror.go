@@ -0,0 +1,108 @@
+// Package mirror maps image references pulled from registry mirrors, as configured on
+// disconnected clusters, back to the source references they mirror.
+package mirror
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+	"github.com/opendatahub-io/odh-cli/pkg/util/jq"
+)
+
+// Mapping maps a source repository, e.g. registry.redhat.io/rhoai, to the mirror it is pulled
+// from, e.g. mirror.example.com/rhoai. Either may be a registry host or a repository path.
+type Mapping struct {
+	Source string `json:"source" yaml:"source"`
+	Mirror string `json:"mirror" yaml:"mirror"`
+}
+
+// Parse parses a "source=mirror" mapping, as given to --registry-mirror.
+func Parse(value string) (Mapping, error) {
+	source, mirror, ok := strings.Cut(value, "=")
+
+	source, mirror = strings.TrimSuffix(strings.TrimSpace(source), "/"), strings.TrimSuffix(strings.TrimSpace(mirror), "/")
+	if !ok || source == "" || mirror == "" {
+		return Mapping{}, fmt.Errorf("invalid registry mirror %q: expected source=mirror", value)
+	}
+
+	return Mapping{Source: source, Mirror: mirror}, nil
+}
+
+// Set is a list of mirror mappings.
+type Set []Mapping
+
+// Sources returns the source references the image may have been mirrored from, for each
+// mapping whose mirror is a prefix of the image ending at a path, tag or digest boundary.
+// Returns nil when the image is not pulled from a known mirror.
+func (s Set) Sources(image string) []string {
+	var sources []string
+
+	for _, m := range s {
+		rest, ok := strings.CutPrefix(image, m.Mirror)
+		if !ok || rest == "" || !strings.ContainsAny(rest[:1], "/:@") {
+			continue
+		}
+
+		if source := m.Source + rest; source != image {
+			sources = append(sources, source)
+		}
+	}
+
+	return sources
+}
+
+// mirrorSetSource describes where a mirror configuration resource lists its mappings.
+type mirrorSetSource struct {
+	resourceType resources.ResourceType
+	query        string
+}
+
+// FromCluster returns the mappings configured on the cluster by ImageDigestMirrorSets,
+// ImageTagMirrorSets and the deprecated ImageContentSourcePolicies. Resource types the cluster
+// does not serve contribute no mappings, as do those the user cannot list.
+func FromCluster(ctx context.Context, r client.Reader) (Set, error) {
+	sources := []mirrorSetSource{
+		{resources.ImageDigestMirrorSet, ".spec.imageDigestMirrors"},
+		{resources.ImageTagMirrorSet, ".spec.imageTagMirrors"},
+		{resources.ImageContentSourcePolicy, ".spec.repositoryDigestMirrors"},
+	}
+
+	var set Set
+
+	for _, src := range sources {
+		items, err := r.List(ctx, src.resourceType)
+		if err != nil {
+			if client.IsResourceTypeNotFound(err) {
+				continue
+			}
+
+			return nil, fmt.Errorf("listing %s: %w", src.resourceType.Kind, err)
+		}
+
+		for _, item := range items {
+			mirrors, err := jq.Query[[]repositoryMirrors](item, src.query)
+			if err != nil && !errors.Is(err, jq.ErrNotFound) {
+				return nil, fmt.Errorf("querying %s %s: %w", src.resourceType.Kind, item.GetName(), err)
+			}
+
+			for _, rm := range mirrors {
+				for _, mirror := range rm.Mirrors {
+					set = append(set, Mapping{Source: rm.Source, Mirror: mirror})
+				}
+			}
+		}
+	}
+
+	return set, nil
+}
+
+// repositoryMirrors is an entry of the mirror lists of ImageDigestMirrorSet,
+// ImageTagMirrorSet and ImageContentSourcePolicy, which share the same shape.
+type repositoryMirrors struct {
+	Source  string   `json:"source"`
+	Mirrors []string `json:"mirrors"`
+}
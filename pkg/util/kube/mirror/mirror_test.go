@@ -0,0 +1,101 @@
+package mirror_test
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+	"github.com/opendatahub-io/odh-cli/pkg/util/kube/mirror"
+
+	. "github.com/onsi/gomega"
+)
+
+func newMirrorSet(rt resources.ResourceType, field string, entries ...map[string]any) *unstructured.Unstructured {
+	list := make([]any, 0, len(entries))
+	for _, e := range entries {
+		list = append(list, e)
+	}
+
+	return &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": rt.APIVersion(),
+		"kind":       rt.Kind,
+		"metadata":   map[string]any{"name": "mirrors"},
+		"spec":       map[string]any{field: list},
+	}}
+}
+
+func TestParse(t *testing.T) {
+	g := NewWithT(t)
+
+	m, err := mirror.Parse(" registry.redhat.io/rhoai/ = mirror.example.com:5000/rhoai")
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(m).To(Equal(mirror.Mapping{Source: "registry.redhat.io/rhoai", Mirror: "mirror.example.com:5000/rhoai"}))
+
+	for _, value := range []string{"registry.redhat.io", "=mirror.example.com", "registry.redhat.io="} {
+		_, err := mirror.Parse(value)
+		g.Expect(err).To(MatchError(ContainSubstring("expected source=mirror")), value)
+	}
+}
+
+func TestSet_Sources(t *testing.T) {
+	g := NewWithT(t)
+
+	set := mirror.Set{
+		{Source: "registry.redhat.io/rhoai", Mirror: "mirror.example.com/rhoai"},
+		{Source: "quay.io", Mirror: "mirror.example.com"},
+	}
+
+	g.Expect(set.Sources("mirror.example.com/rhoai/odh-workbench@sha256:abc")).To(Equal([]string{
+		"registry.redhat.io/rhoai/odh-workbench@sha256:abc",
+		"quay.io/rhoai/odh-workbench@sha256:abc",
+	}))
+	g.Expect(set.Sources("mirror.example.com/modh/codeserver:2025.2")).To(Equal([]string{"quay.io/modh/codeserver:2025.2"}))
+
+	// Mirrors only match at a path, tag or digest boundary
+	g.Expect(set.Sources("mirror.example.com.evil/rhoai/odh-workbench:2025.2")).To(BeEmpty())
+	g.Expect(set.Sources("mirror.example.com/rhoai-other/odh-workbench:2025.2")).To(Equal([]string{
+		"quay.io/rhoai-other/odh-workbench:2025.2",
+	}))
+	g.Expect(set.Sources("registry.redhat.io/rhoai/odh-workbench:2025.2")).To(BeEmpty())
+}
+
+func TestFromCluster(t *testing.T) {
+	g := NewWithT(t)
+
+	listKinds := map[schema.GroupVersionResource]string{
+		resources.ImageDigestMirrorSet.GVR():     resources.ImageDigestMirrorSet.ListKind(),
+		resources.ImageTagMirrorSet.GVR():        resources.ImageTagMirrorSet.ListKind(),
+		resources.ImageContentSourcePolicy.GVR(): resources.ImageContentSourcePolicy.ListKind(),
+	}
+
+	dynamicClient := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), listKinds,
+		newMirrorSet(resources.ImageDigestMirrorSet, "imageDigestMirrors", map[string]any{
+			"source":  "registry.redhat.io/rhoai",
+			"mirrors": []any{"mirror-a.example.com/rhoai", "mirror-b.example.com/rhoai"},
+		}),
+		newMirrorSet(resources.ImageTagMirrorSet, "imageTagMirrors", map[string]any{
+			"source":  "quay.io/modh",
+			"mirrors": []any{"mirror-a.example.com/modh"},
+		}),
+		newMirrorSet(resources.ImageContentSourcePolicy, "repositoryDigestMirrors", map[string]any{
+			"source":  "registry.redhat.io/ubi9",
+			"mirrors": []any{"mirror-a.example.com/ubi9"},
+		}),
+	)
+
+	c := client.NewForTesting(client.TestClientConfig{Dynamic: dynamicClient})
+
+	set, err := mirror.FromCluster(t.Context(), c)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(set).To(ConsistOf(
+		mirror.Mapping{Source: "registry.redhat.io/rhoai", Mirror: "mirror-a.example.com/rhoai"},
+		mirror.Mapping{Source: "registry.redhat.io/rhoai", Mirror: "mirror-b.example.com/rhoai"},
+		mirror.Mapping{Source: "quay.io/modh", Mirror: "mirror-a.example.com/modh"},
+		mirror.Mapping{Source: "registry.redhat.io/ubi9", Mirror: "mirror-a.example.com/ubi9"},
+	))
+}
@@ -3,6 +3,9 @@ package olm
 import (
 	"context"
 	"fmt"
+	"slices"
+
+	operatorsv1alpha1 "github.com/operator-framework/api/pkg/operators/v1alpha1"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
@@ -67,3 +70,25 @@ func FindOperator(
 
 	return nil, nil
 }
+
+// FindSubscriptionByPackage returns the first Subscription installing one of the given packages,
+// or nil if none is found. Returns an error only for infrastructure failures (listing subscriptions).
+func FindSubscriptionByPackage(
+	ctx context.Context,
+	k8sClient client.Reader,
+	packages ...string,
+) (*operatorsv1alpha1.Subscription, error) {
+	subscriptions, err := k8sClient.OLM().Subscriptions("").List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("listing subscriptions: %w", err)
+	}
+
+	for i := range subscriptions.Items {
+		sub := &subscriptions.Items[i]
+		if sub.Spec != nil && slices.Contains(packages, sub.Spec.Package) {
+			return sub, nil
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,169 @@
+package olm
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/blang/semver/v4"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+	"github.com/opendatahub-io/odh-cli/pkg/util/jq"
+)
+
+// OperatorPackages lists the OLM package names of the RHOAI and ODH operators, RHOAI first.
+//
+//nolint:gochecknoglobals // Read-only lookup table
+var OperatorPackages = []string{"rhods-operator", "opendatahub-operator"}
+
+// CatalogVersion is an operator version offered by a catalog.
+type CatalogVersion struct {
+	Version semver.Version `json:"version" yaml:"version"`
+	CSV     string         `json:"csv"     yaml:"csv"`
+	Package string         `json:"package" yaml:"package"`
+
+	// Channels lists the channels offering the version, sorted.
+	Channels []string `json:"channels" yaml:"channels"`
+}
+
+// ListOperatorCatalogVersions returns the RHOAI or ODH operator versions offered by the catalog,
+// sorted from oldest to newest. The catalog and package are taken from the operator's
+// Subscription; without one, the PackageManifest of the first of OperatorPackages found in any
+// catalog is used. Pre-release versions are skipped. Returns nil when no PackageManifest is found.
+func ListOperatorCatalogVersions(ctx context.Context, r client.Reader) ([]CatalogVersion, error) {
+	sub, err := FindSubscriptionByPackage(ctx, r, OperatorPackages...)
+	if err != nil {
+		return nil, err
+	}
+
+	packages := OperatorPackages
+	query := PackageQuery{}
+
+	if sub != nil {
+		packages = []string{sub.Spec.Package}
+		query = PackageQuery{
+			PackageName:     sub.Spec.Package,
+			CatalogSource:   sub.Spec.CatalogSource,
+			SourceNamespace: sub.Spec.CatalogSourceNamespace,
+		}
+	}
+
+	manifests, err := client.List[*unstructured.Unstructured](ctx, r, resources.PackageManifest,
+		func(pm *unstructured.Unstructured) (bool, error) {
+			if !slices.Contains(packages, pm.GetName()) {
+				return false, nil
+			}
+
+			if query.SourceNamespace != "" && pm.GetNamespace() != query.SourceNamespace {
+				return false, nil
+			}
+
+			if query.CatalogSource == "" {
+				return true, nil
+			}
+
+			catalogSource, err := jq.Query[string](pm, ".status.catalogSource")
+			if err != nil {
+				return false, nil
+			}
+
+			return catalogSource == query.CatalogSource, nil
+		})
+	if err != nil {
+		return nil, fmt.Errorf("listing operator PackageManifests: %w", err)
+	}
+
+	// Prefer the packages in the order they were given
+	for _, pkg := range packages {
+		for _, pm := range manifests {
+			if pm.GetName() == pkg {
+				return catalogVersionsFromManifest(pm)
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+// catalogVersionsFromManifest collects the CSV versions of every channel of a PackageManifest.
+func catalogVersionsFromManifest(pm *unstructured.Unstructured) ([]CatalogVersion, error) {
+	channels, err := jq.Query[[]any](pm, ".status.channels")
+	if err != nil {
+		return nil, fmt.Errorf("querying channels of PackageManifest %s: %w", pm.GetName(), err)
+	}
+
+	byVersion := make(map[string]*CatalogVersion)
+
+	for _, ch := range channels {
+		chMap, ok := ch.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		channel, _ := chMap["name"].(string)
+
+		csvs := make([]string, 0)
+		if current, ok := chMap["currentCSV"].(string); ok {
+			csvs = append(csvs, current)
+		}
+
+		if entries, ok := chMap["entries"].([]any); ok {
+			for _, e := range entries {
+				if entry, ok := e.(map[string]any); ok {
+					if name, ok := entry["name"].(string); ok {
+						csvs = append(csvs, name)
+					}
+				}
+			}
+		}
+
+		for _, csv := range csvs {
+			v, ok := csvVersion(csv)
+			if !ok || len(v.Pre) > 0 {
+				continue
+			}
+
+			cv, ok := byVersion[v.String()]
+			if !ok {
+				cv = &CatalogVersion{Version: v, CSV: csv, Package: pm.GetName()}
+				byVersion[v.String()] = cv
+			}
+
+			if channel != "" && !slices.Contains(cv.Channels, channel) {
+				cv.Channels = append(cv.Channels, channel)
+			}
+		}
+	}
+
+	versions := make([]CatalogVersion, 0, len(byVersion))
+	for _, cv := range byVersion {
+		slices.Sort(cv.Channels)
+		versions = append(versions, *cv)
+	}
+
+	slices.SortFunc(versions, func(a, b CatalogVersion) int {
+		return a.Version.Compare(b.Version)
+	})
+
+	return versions, nil
+}
+
+// csvVersion extracts the semantic version from a CSV name such as "rhods-operator.2.25.0" or
+// "opendatahub-operator.v2.30.0".
+func csvVersion(csv string) (semver.Version, bool) {
+	_, raw, found := strings.Cut(csv, ".")
+	if !found {
+		return semver.Version{}, false
+	}
+
+	v, err := semver.ParseTolerant(raw)
+	if err != nil {
+		return semver.Version{}, false
+	}
+
+	return v, true
+}
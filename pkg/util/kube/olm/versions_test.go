@@ -0,0 +1,116 @@
+package olm_test
+
+import (
+	"testing"
+
+	operatorsv1alpha1 "github.com/operator-framework/api/pkg/operators/v1alpha1"
+	operatorfake "github.com/operator-framework/operator-lifecycle-manager/pkg/api/client/clientset/versioned/fake"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+	"github.com/opendatahub-io/odh-cli/pkg/util/kube/olm"
+
+	. "github.com/onsi/gomega"
+)
+
+// newOperatorPackageManifest builds a PackageManifest whose channels offer the given CSVs;
+// the last CSV of each channel is its currentCSV.
+func newOperatorPackageManifest(name, catalog string, channels map[string][]string) *unstructured.Unstructured {
+	channelObjs := make([]any, 0, len(channels))
+	for ch, csvs := range channels {
+		entries := make([]any, 0, len(csvs))
+		for _, csv := range csvs {
+			entries = append(entries, map[string]any{"name": csv})
+		}
+
+		channelObjs = append(channelObjs, map[string]any{
+			"name":       ch,
+			"currentCSV": csvs[len(csvs)-1],
+			"entries":    entries,
+		})
+	}
+
+	return &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": resources.PackageManifest.APIVersion(),
+		"kind":       resources.PackageManifest.Kind,
+		"metadata": map[string]any{
+			"name":      name,
+			"namespace": "openshift-marketplace",
+		},
+		"status": map[string]any{
+			"catalogSource": catalog,
+			"channels":      channelObjs,
+		},
+	}}
+}
+
+func newVersionsClient(subs []runtime.Object, manifests ...runtime.Object) client.Client {
+	dyn := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), map[schema.GroupVersionResource]string{
+		resources.PackageManifest.GVR(): resources.PackageManifest.ListKind(),
+	}, manifests...)
+
+	return client.NewForTesting(client.TestClientConfig{
+		Dynamic: dyn,
+		OLM:     operatorfake.NewSimpleClientset(subs...), //nolint:staticcheck // fake client without apply configs
+	})
+}
+
+func TestListOperatorCatalogVersions(t *testing.T) {
+	t.Run("should collect versions of all channels sorted oldest first", func(t *testing.T) {
+		g := NewWithT(t)
+
+		c := newVersionsClient(nil, newOperatorPackageManifest("rhods-operator", "redhat-operators", map[string][]string{
+			"stable-2.25": {"rhods-operator.2.25.0", "rhods-operator.2.25.2"},
+			"fast":        {"rhods-operator.2.25.2", "rhods-operator.3.0.0"},
+			"beta":        {"rhods-operator.3.1.0-ea.1"},
+		}))
+
+		versions, err := olm.ListOperatorCatalogVersions(t.Context(), c)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(versions).To(HaveExactElements(
+			HaveField("CSV", "rhods-operator.2.25.0"),
+			And(HaveField("CSV", "rhods-operator.2.25.2"), HaveField("Channels", []string{"fast", "stable-2.25"})),
+			And(HaveField("CSV", "rhods-operator.3.0.0"), HaveField("Package", "rhods-operator")),
+		))
+	})
+
+	t.Run("should use the catalog of the operator subscription", func(t *testing.T) {
+		g := NewWithT(t)
+
+		sub := &operatorsv1alpha1.Subscription{
+			ObjectMeta: metav1.ObjectMeta{Name: "opendatahub-operator", Namespace: "openshift-operators"},
+			Spec: &operatorsv1alpha1.SubscriptionSpec{
+				Package:                "opendatahub-operator",
+				CatalogSource:          "community-operators",
+				CatalogSourceNamespace: "openshift-marketplace",
+			},
+		}
+
+		c := newVersionsClient([]runtime.Object{sub},
+			newOperatorPackageManifest("rhods-operator", "redhat-operators", map[string][]string{
+				"fast": {"rhods-operator.3.0.0"},
+			}),
+			newOperatorPackageManifest("opendatahub-operator", "community-operators", map[string][]string{
+				"fast": {"opendatahub-operator.v2.30.0"},
+			}),
+		)
+
+		versions, err := olm.ListOperatorCatalogVersions(t.Context(), c)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(versions).To(HaveExactElements(HaveField("CSV", "opendatahub-operator.v2.30.0")))
+	})
+
+	t.Run("should return nil without a PackageManifest", func(t *testing.T) {
+		g := NewWithT(t)
+
+		versions, err := olm.ListOperatorCatalogVersions(t.Context(), newVersionsClient(nil))
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(versions).To(BeNil())
+	})
+}
@@ -0,0 +1,73 @@
+package logging
+
+import (
+	"fmt"
+	"io"
+	"log/slog"
+	"strings"
+)
+
+// Log levels accepted by ParseLevel.
+const (
+	LevelDebug = "debug"
+	LevelInfo  = "info"
+	LevelWarn  = "warn"
+	LevelError = "error"
+)
+
+// Log formats accepted by New.
+const (
+	FormatText = "text"
+	FormatJSON = "json"
+)
+
+// Levels lists the accepted log levels, most verbose first.
+func Levels() []string {
+	return []string{LevelDebug, LevelInfo, LevelWarn, LevelError}
+}
+
+// Formats lists the accepted log formats.
+func Formats() []string {
+	return []string{FormatText, FormatJSON}
+}
+
+// ParseLevel converts a level name (case-insensitive) to a slog.Level.
+func ParseLevel(level string) (slog.Level, error) {
+	switch strings.ToLower(level) {
+	case LevelDebug:
+		return slog.LevelDebug, nil
+	case LevelInfo:
+		return slog.LevelInfo, nil
+	case LevelWarn:
+		return slog.LevelWarn, nil
+	case LevelError:
+		return slog.LevelError, nil
+	default:
+		return 0, fmt.Errorf("invalid log level: %s (must be one of: %s)", level, strings.Join(Levels(), ", "))
+	}
+}
+
+// New creates a structured logger writing records at or above level to w, as logfmt-style
+// text or as one JSON object per line.
+func New(w io.Writer, level string, format string) (*slog.Logger, error) {
+	lvl, err := ParseLevel(level)
+	if err != nil {
+		return nil, err
+	}
+
+	opts := &slog.HandlerOptions{Level: lvl}
+
+	switch strings.ToLower(format) {
+	case FormatText:
+		return slog.New(slog.NewTextHandler(w, opts)), nil
+	case FormatJSON:
+		return slog.New(slog.NewJSONHandler(w, opts)), nil
+	default:
+		return nil, fmt.Errorf("invalid log format: %s (must be one of: %s)", format, strings.Join(Formats(), ", "))
+	}
+}
+
+// Discard returns a logger dropping every record, for callers without a configured logger.
+func Discard() *slog.Logger {
+	return slog.New(slog.DiscardHandler)
+}
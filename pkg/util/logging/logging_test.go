@@ -0,0 +1,66 @@
+package logging_test
+
+import (
+	"bytes"
+	"log/slog"
+	"testing"
+
+	"github.com/opendatahub-io/odh-cli/pkg/util/logging"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestParseLevel(t *testing.T) {
+	g := NewWithT(t)
+
+	for name, want := range map[string]slog.Level{
+		"debug": slog.LevelDebug,
+		"INFO":  slog.LevelInfo,
+		"warn":  slog.LevelWarn,
+		"error": slog.LevelError,
+	} {
+		level, err := logging.ParseLevel(name)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(level).To(Equal(want))
+	}
+
+	_, err := logging.ParseLevel("trace")
+	g.Expect(err).To(MatchError(ContainSubstring("invalid log level: trace")))
+}
+
+func TestNew(t *testing.T) {
+	t.Run("should drop records below the level", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var buf bytes.Buffer
+
+		logger, err := logging.New(&buf, logging.LevelInfo, logging.FormatText)
+		g.Expect(err).ToNot(HaveOccurred())
+
+		logger.Debug("hidden")
+		logger.Info("shown", "check", "workloads.notebook.impacted-workloads")
+
+		g.Expect(buf.String()).ToNot(ContainSubstring("hidden"))
+		g.Expect(buf.String()).To(ContainSubstring("msg=shown check=workloads.notebook.impacted-workloads"))
+	})
+
+	t.Run("should write one JSON object per record", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var buf bytes.Buffer
+
+		logger, err := logging.New(&buf, logging.LevelDebug, logging.FormatJSON)
+		g.Expect(err).ToNot(HaveOccurred())
+
+		logger.Debug("analyzing notebooks", "count", 2)
+
+		g.Expect(buf.String()).To(ContainSubstring(`"level":"DEBUG","msg":"analyzing notebooks","count":2}`))
+	})
+
+	t.Run("should reject an unknown format", func(t *testing.T) {
+		g := NewWithT(t)
+
+		_, err := logging.New(&bytes.Buffer{}, logging.LevelInfo, "xml")
+		g.Expect(err).To(MatchError(ContainSubstring("invalid log format: xml")))
+	})
+}
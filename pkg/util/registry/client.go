@@ -0,0 +1,394 @@
+package registry
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	requestTimeout  = 30 * time.Second
+	maxResponseSize = 4 << 20 // 4 MiB, well above the size of manifests and image configs
+
+	mediaTypeOCIIndex         = "application/vnd.oci.image.index.v1+json"
+	mediaTypeOCIManifest      = "application/vnd.oci.image.manifest.v1+json"
+	mediaTypeDockerList       = "application/vnd.docker.distribution.manifest.list.v2+json"
+	mediaTypeDockerManifest   = "application/vnd.docker.distribution.manifest.v2+json"
+	headerDockerContentDigest = "Docker-Content-Digest"
+)
+
+// Image is the metadata of an image read from its registry.
+type Image struct {
+	// Reference is the parsed reference the image was read from.
+	Reference Reference
+
+	// Digest is the digest the reference resolves to: of the image index for multi-arch
+	// images, else of the image manifest.
+	Digest string
+
+	// ManifestDigest is the digest of the image manifest of the platform, which differs from
+	// Digest for multi-arch images.
+	ManifestDigest string
+
+	// Labels are the labels of the image config.
+	Labels map[string]string
+}
+
+// Digests returns the digests identifying the image: Digest, and ManifestDigest when it differs.
+func (i *Image) Digests() []string {
+	if i.ManifestDigest == "" || i.ManifestDigest == i.Digest {
+		return []string{i.Digest}
+	}
+
+	return []string{i.Digest, i.ManifestDigest}
+}
+
+// Client reads image metadata from registries through the Docker Registry HTTP API V2.
+// Requests are sent anonymously, then authenticated as challenged by the registry (bearer
+// token or basic) with the credentials of the keychain.
+type Client struct {
+	http     *http.Client
+	keychain Keychain
+	scheme   string
+	platform platform
+
+	mu     sync.Mutex
+	tokens map[string]string // Authorization headers by registry and repository
+}
+
+// Option configures a Client.
+type Option func(*Client)
+
+// WithHTTPClient sets the HTTP client of the requests, e.g. one trusting a custom CA.
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(c *Client) {
+		c.http = httpClient
+	}
+}
+
+// WithInsecureHTTP reaches registries over plain HTTP instead of HTTPS.
+func WithInsecureHTTP() Option {
+	return func(c *Client) {
+		c.scheme = "http"
+	}
+}
+
+// NewClient creates a Client authenticating with the credentials of the keychain. Images of
+// multi-arch indexes are resolved for the platform of the cluster nodes, assumed linux/amd64.
+func NewClient(keychain Keychain, opts ...Option) *Client {
+	c := &Client{
+		http:     &http.Client{Timeout: requestTimeout},
+		keychain: keychain,
+		scheme:   "https",
+		platform: platform{OS: "linux", Architecture: "amd64"},
+		tokens:   make(map[string]string),
+	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c
+}
+
+// platform identifies the image of a multi-arch index.
+type platform struct {
+	OS           string `json:"os"`
+	Architecture string `json:"architecture"`
+}
+
+// manifest holds the fields of image indexes and image manifests read by the client.
+type manifest struct {
+	MediaType string `json:"mediaType"`
+	Manifests []struct {
+		Digest   string   `json:"digest"`
+		Platform platform `json:"platform"`
+	} `json:"manifests"`
+	Config struct {
+		Digest string `json:"digest"`
+	} `json:"config"`
+}
+
+// Inspect resolves the image reference to its digest and reads the labels of its config.
+func (c *Client) Inspect(ctx context.Context, image string) (*Image, error) {
+	ref, err := ParseReference(image)
+	if err != nil {
+		return nil, err
+	}
+
+	m, digest, err := c.fetchManifest(ctx, ref, ref.Identifier())
+	if err != nil {
+		return nil, err
+	}
+
+	img := &Image{Reference: ref, Digest: digest, ManifestDigest: digest}
+
+	if len(m.Manifests) > 0 {
+		manifestDigest := m.Manifests[0].Digest
+
+		for _, entry := range m.Manifests {
+			if entry.Platform == c.platform {
+				manifestDigest = entry.Digest
+
+				break
+			}
+		}
+
+		if m, img.ManifestDigest, err = c.fetchManifest(ctx, ref, manifestDigest); err != nil {
+			return nil, err
+		}
+	}
+
+	if m.Config.Digest == "" {
+		return nil, fmt.Errorf("image %s: unsupported manifest media type %q", ref, m.MediaType)
+	}
+
+	var config struct {
+		Config struct {
+			Labels map[string]string `json:"Labels"`
+		} `json:"config"`
+	}
+
+	body, _, err := c.get(ctx, ref, "/blobs/"+m.Config.Digest, "")
+	if err != nil {
+		return nil, fmt.Errorf("image %s: reading config: %w", ref, err)
+	}
+
+	if err := json.Unmarshal(body, &config); err != nil {
+		return nil, fmt.Errorf("image %s: parsing config: %w", ref, err)
+	}
+
+	img.Labels = config.Config.Labels
+
+	return img, nil
+}
+
+// fetchManifest gets the manifest of the reference with the given tag or digest, returning it
+// with its digest.
+func (c *Client) fetchManifest(ctx context.Context, ref Reference, identifier string) (*manifest, string, error) {
+	accept := strings.Join([]string{
+		mediaTypeOCIIndex, mediaTypeDockerList, mediaTypeOCIManifest, mediaTypeDockerManifest,
+	}, ", ")
+
+	body, header, err := c.get(ctx, ref, "/manifests/"+identifier, accept)
+	if err != nil {
+		return nil, "", fmt.Errorf("image %s: reading manifest %s: %w", ref, identifier, err)
+	}
+
+	var m manifest
+	if err := json.Unmarshal(body, &m); err != nil {
+		return nil, "", fmt.Errorf("image %s: parsing manifest %s: %w", ref, identifier, err)
+	}
+
+	digest := header.Get(headerDockerContentDigest)
+	if digest == "" {
+		sum := sha256.Sum256(body)
+		digest = "sha256:" + hex.EncodeToString(sum[:])
+	}
+
+	return &m, digest, nil
+}
+
+// get requests a path of the repository API of the reference, authenticating when the
+// registry challenges the request.
+func (c *Client) get(ctx context.Context, ref Reference, path, accept string) ([]byte, http.Header, error) {
+	endpoint := fmt.Sprintf("%s://%s/v2/%s%s", c.scheme, ref.apiHost(), ref.Repository, path)
+	tokenKey := ref.Registry + "/" + ref.Repository
+
+	resp, err := c.do(ctx, endpoint, accept, c.authorization(tokenKey))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if resp.StatusCode == http.StatusUnauthorized {
+		challenge := resp.Header.Get("WWW-Authenticate")
+		_ = resp.Body.Close()
+
+		auth, err := c.authenticate(ctx, ref, challenge)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		c.mu.Lock()
+		c.tokens[tokenKey] = auth
+		c.mu.Unlock()
+
+		if resp, err = c.do(ctx, endpoint, accept, auth); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, nil, fmt.Errorf("GET %s: HTTP %d", endpoint, resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseSize))
+	if err != nil {
+		return nil, nil, fmt.Errorf("reading response of %s: %w", endpoint, err)
+	}
+
+	return body, resp.Header, nil
+}
+
+func (c *Client) do(ctx context.Context, endpoint, accept, authorization string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("creating request: %w", err)
+	}
+
+	if accept != "" {
+		req.Header.Set("Accept", accept)
+	}
+
+	if authorization != "" {
+		req.Header.Set("Authorization", authorization)
+	}
+
+	req.Header.Set("User-Agent", "odh-cli ("+runtime.GOOS+"/"+runtime.GOARCH+")")
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("GET %s: %w", endpoint, err)
+	}
+
+	return resp, nil
+}
+
+// authorization returns the Authorization header of a previous authentication to the
+// repository, empty when there is none.
+func (c *Client) authorization(tokenKey string) string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.tokens[tokenKey]
+}
+
+// authenticate answers the WWW-Authenticate challenge of the registry, returning the
+// Authorization header to retry the request with.
+func (c *Client) authenticate(ctx context.Context, ref Reference, challenge string) (string, error) {
+	scheme, params := parseChallenge(challenge)
+	creds, hasCreds := c.keychain.Lookup(ref)
+
+	switch strings.ToLower(scheme) {
+	case "basic":
+		if !hasCreds {
+			return "", fmt.Errorf("registry %s requires credentials", ref.Registry)
+		}
+
+		return "Basic " + base64.StdEncoding.EncodeToString([]byte(creds.Username+":"+creds.Password)), nil
+	case "bearer":
+		return c.fetchToken(ctx, ref, params, creds, hasCreds)
+	default:
+		return "", fmt.Errorf("registry %s: unsupported authentication challenge %q", ref.Registry, challenge)
+	}
+}
+
+// fetchToken gets a bearer token granting pull access to the repository from the token
+// service of the challenge.
+func (c *Client) fetchToken(
+	ctx context.Context,
+	ref Reference,
+	params map[string]string,
+	creds Credentials,
+	hasCreds bool,
+) (string, error) {
+	realm, err := url.Parse(params["realm"])
+	if err != nil || realm.Host == "" {
+		return "", fmt.Errorf("registry %s: invalid token realm %q", ref.Registry, params["realm"])
+	}
+
+	query := realm.Query()
+	if service := params["service"]; service != "" {
+		query.Set("service", service)
+	}
+
+	query.Set("scope", "repository:"+ref.Repository+":pull")
+	realm.RawQuery = query.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, realm.String(), nil)
+	if err != nil {
+		return "", fmt.Errorf("creating token request: %w", err)
+	}
+
+	if hasCreds {
+		req.SetBasicAuth(creds.Username, creds.Password)
+	}
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("registry %s: requesting token: %w", ref.Registry, err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("registry %s: requesting token: HTTP %d", ref.Registry, resp.StatusCode)
+	}
+
+	var token struct {
+		Token       string `json:"token"`
+		AccessToken string `json:"access_token"`
+	}
+	if err := json.NewDecoder(io.LimitReader(resp.Body, maxResponseSize)).Decode(&token); err != nil {
+		return "", fmt.Errorf("registry %s: parsing token: %w", ref.Registry, err)
+	}
+
+	if token.Token == "" {
+		token.Token = token.AccessToken
+	}
+
+	if token.Token == "" {
+		return "", errors.New("registry " + ref.Registry + ": empty token")
+	}
+
+	return "Bearer " + token.Token, nil
+}
+
+// parseChallenge parses a WWW-Authenticate header such as
+// `Bearer realm="https://auth.example.com/token",service="registry.example.com"`.
+func parseChallenge(challenge string) (string, map[string]string) {
+	scheme, rest, _ := strings.Cut(strings.TrimSpace(challenge), " ")
+	params := make(map[string]string)
+
+	for rest != "" {
+		var pair string
+
+		// Values are quoted and may contain commas
+		key, value, ok := strings.Cut(rest, "=")
+		if !ok {
+			break
+		}
+
+		key = strings.TrimSpace(key)
+
+		if strings.HasPrefix(value, `"`) {
+			end := strings.Index(value[1:], `"`)
+			if end < 0 {
+				params[key] = value[1:]
+
+				break
+			}
+
+			pair, rest = value[1:end+1], value[end+2:]
+		} else {
+			pair, rest, _ = strings.Cut(value, ",")
+		}
+
+		params[key] = pair
+		rest = strings.TrimLeft(rest, ", ")
+	}
+
+	return scheme, params
+}
@@ -0,0 +1,150 @@
+package registry_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/opendatahub-io/odh-cli/pkg/util/registry"
+
+	. "github.com/onsi/gomega"
+)
+
+const (
+	indexDigest    = "sha256:index"
+	manifestDigest = "sha256:amd64"
+	configDigest   = "sha256:config"
+)
+
+// newRegistryServer serves a multi-arch image at team/workbench:2025.2, requiring a bearer
+// token issued to the given credentials.
+func newRegistryServer(t *testing.T, username, password string) *httptest.Server {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	writeJSON := func(w http.ResponseWriter, digest string, v any) {
+		if digest != "" {
+			w.Header().Set("Docker-Content-Digest", digest)
+		}
+
+		_ = json.NewEncoder(w).Encode(v)
+	}
+
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		user, pass, ok := r.BasicAuth()
+		if !ok || user != username || pass != password ||
+			r.URL.Query().Get("scope") != "repository:team/workbench:pull" {
+			w.WriteHeader(http.StatusUnauthorized)
+
+			return
+		}
+
+		writeJSON(w, "", map[string]string{"token": "secret-token"})
+	})
+
+	mux.HandleFunc("/v2/team/workbench/", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer secret-token" {
+			w.Header().Set("WWW-Authenticate",
+				`Bearer realm="`+server.URL+`/token",service="registry.test"`)
+			w.WriteHeader(http.StatusUnauthorized)
+
+			return
+		}
+
+		switch strings.TrimPrefix(r.URL.Path, "/v2/team/workbench") {
+		case "/manifests/2025.2", "/manifests/" + indexDigest:
+			writeJSON(w, indexDigest, map[string]any{
+				"mediaType": "application/vnd.oci.image.index.v1+json",
+				"manifests": []any{
+					map[string]any{"digest": "sha256:arm64", "platform": map[string]string{"os": "linux", "architecture": "arm64"}},
+					map[string]any{"digest": manifestDigest, "platform": map[string]string{"os": "linux", "architecture": "amd64"}},
+				},
+			})
+		case "/manifests/" + manifestDigest:
+			writeJSON(w, manifestDigest, map[string]any{
+				"mediaType": "application/vnd.oci.image.manifest.v1+json",
+				"config":    map[string]string{"digest": configDigest},
+			})
+		case "/blobs/" + configDigest:
+			writeJSON(w, "", map[string]any{
+				"config": map[string]any{"Labels": map[string]string{"io.openshift.build.commit.id": "abc123"}},
+			})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	return server
+}
+
+func TestClient_Inspect(t *testing.T) {
+	server := newRegistryServer(t, "robot", "s3cret")
+	host := strings.TrimPrefix(server.URL, "http://")
+
+	t.Run("should resolve a tag to its digests and labels", func(t *testing.T) {
+		g := NewWithT(t)
+
+		c := registry.NewClient(registry.Keychain{host + "/team": {Username: "robot", Password: "s3cret"}},
+			registry.WithInsecureHTTP())
+
+		img, err := c.Inspect(t.Context(), host+"/team/workbench:2025.2")
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(img.Digest).To(Equal(indexDigest))
+		g.Expect(img.ManifestDigest).To(Equal(manifestDigest))
+		g.Expect(img.Digests()).To(Equal([]string{indexDigest, manifestDigest}))
+		g.Expect(img.Labels).To(HaveKeyWithValue("io.openshift.build.commit.id", "abc123"))
+	})
+
+	t.Run("should fail without credentials", func(t *testing.T) {
+		g := NewWithT(t)
+
+		c := registry.NewClient(nil, registry.WithInsecureHTTP())
+
+		_, err := c.Inspect(t.Context(), host+"/team/workbench:2025.2")
+		g.Expect(err).To(MatchError(ContainSubstring("requesting token: HTTP 401")))
+	})
+
+	t.Run("should fail for missing images", func(t *testing.T) {
+		g := NewWithT(t)
+
+		c := registry.NewClient(registry.Keychain{host: {Username: "robot", Password: "s3cret"}},
+			registry.WithInsecureHTTP())
+
+		_, err := c.Inspect(t.Context(), host+"/team/workbench:missing")
+		g.Expect(err).To(MatchError(ContainSubstring("HTTP 404")))
+	})
+}
+
+func TestParseReference(t *testing.T) {
+	g := NewWithT(t)
+
+	for image, expected := range map[string]registry.Reference{
+		"quay.io/modh/odh-workbench:2025.2": {
+			Registry: "quay.io", Repository: "modh/odh-workbench", Tag: "2025.2",
+		},
+		"registry.redhat.io/rhoai/odh-workbench@sha256:abc": {
+			Registry: "registry.redhat.io", Repository: "rhoai/odh-workbench", Digest: "sha256:abc",
+		},
+		"mirror.example.com:5000/rhoai/odh-workbench": {
+			Registry: "mirror.example.com:5000", Repository: "rhoai/odh-workbench", Tag: "latest",
+		},
+		"jupyter/base-notebook:lab": {
+			Registry: "docker.io", Repository: "jupyter/base-notebook", Tag: "lab",
+		},
+		"ubuntu": {
+			Registry: "docker.io", Repository: "library/ubuntu", Tag: "latest",
+		},
+	} {
+		ref, err := registry.ParseReference(image)
+		g.Expect(err).ToNot(HaveOccurred(), image)
+		g.Expect(ref).To(Equal(expected), image)
+	}
+
+	_, err := registry.ParseReference("quay.io/modh/odh-workbench@abc")
+	g.Expect(err).To(MatchError(ContainSubstring("malformed digest")))
+}
@@ -0,0 +1,164 @@
+package registry
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"maps"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Credentials are the username and password (or token) authenticating to a registry.
+type Credentials struct {
+	Username string
+	Password string
+}
+
+// Keychain holds registry credentials by registry host, or by host and repository path for
+// credentials scoped to a repository (e.g. "quay.io/modh").
+type Keychain map[string]Credentials
+
+// dockerConfig is the format of docker config.json files, podman auth.json files and
+// kubernetes.io/dockerconfigjson Secrets.
+type dockerConfig struct {
+	Auths map[string]dockerAuth `json:"auths"`
+}
+
+type dockerAuth struct {
+	Auth     string `json:"auth"`
+	Username string `json:"username"`
+	Password string `json:"password"`
+}
+
+// ParseDockerConfig parses the credentials of a docker config file, e.g. the .dockerconfigjson
+// of a pull secret. Credential helpers are not supported; entries without inline credentials
+// are skipped.
+func ParseDockerConfig(data []byte) (Keychain, error) {
+	var cfg dockerConfig
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return nil, fmt.Errorf("parsing docker config: %w", err)
+	}
+
+	keychain := make(Keychain, len(cfg.Auths))
+
+	for key, auth := range cfg.Auths {
+		creds := Credentials{Username: auth.Username, Password: auth.Password}
+
+		if auth.Auth != "" {
+			decoded, err := base64.StdEncoding.DecodeString(auth.Auth)
+			if err != nil {
+				return nil, fmt.Errorf("decoding credentials of %s: %w", key, err)
+			}
+
+			username, password, ok := strings.Cut(string(decoded), ":")
+			if !ok {
+				return nil, fmt.Errorf("decoding credentials of %s: expected username:password", key)
+			}
+
+			creds = Credentials{Username: username, Password: password}
+		}
+
+		if creds.Username == "" && creds.Password == "" {
+			continue
+		}
+
+		keychain[normalizeKey(key)] = creds
+	}
+
+	return keychain, nil
+}
+
+// LoadLocalKeychain reads the credentials of the local docker and podman config files:
+// $REGISTRY_AUTH_FILE, $XDG_RUNTIME_DIR/containers/auth.json and $DOCKER_CONFIG/config.json
+// (or ~/.docker/config.json). Files listed first take precedence; missing files are skipped.
+func LoadLocalKeychain() (Keychain, error) {
+	var paths []string
+
+	if path := os.Getenv("REGISTRY_AUTH_FILE"); path != "" {
+		paths = append(paths, path)
+	}
+
+	if dir := os.Getenv("XDG_RUNTIME_DIR"); dir != "" {
+		paths = append(paths, filepath.Join(dir, "containers", "auth.json"))
+	}
+
+	if dir := os.Getenv("DOCKER_CONFIG"); dir != "" {
+		paths = append(paths, filepath.Join(dir, "config.json"))
+	} else if home, err := os.UserHomeDir(); err == nil {
+		paths = append(paths, filepath.Join(home, ".docker", "config.json"))
+	}
+
+	keychain := make(Keychain)
+
+	for _, path := range paths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				continue
+			}
+
+			return nil, fmt.Errorf("reading %s: %w", path, err)
+		}
+
+		parsed, err := ParseDockerConfig(data)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+
+		keychain = parsed.Merge(keychain)
+	}
+
+	return keychain, nil
+}
+
+// Merge returns the credentials of both keychains; those of other win for the same key.
+func (k Keychain) Merge(other Keychain) Keychain {
+	merged := make(Keychain, len(k)+len(other))
+	maps.Copy(merged, k)
+	maps.Copy(merged, other)
+
+	return merged
+}
+
+// Lookup returns the credentials for the reference: those of the longest matching repository
+// path, else those of its registry host.
+func (k Keychain) Lookup(ref Reference) (Credentials, bool) {
+	path := ref.Registry + "/" + ref.Repository
+
+	for {
+		if creds, ok := k[path]; ok {
+			return creds, true
+		}
+
+		i := strings.LastIndex(path, "/")
+		if i < 0 {
+			return Credentials{}, false
+		}
+
+		path = path[:i]
+	}
+}
+
+// normalizeKey converts a docker config key to the registry host, or host and repository path,
+// it holds credentials for: the scheme is dropped, and Docker Hub aliases become docker.io.
+func normalizeKey(key string) string {
+	key = strings.TrimPrefix(strings.TrimPrefix(key, "https://"), "http://")
+	key = strings.TrimSuffix(key, "/")
+
+	host, path, _ := strings.Cut(key, "/")
+
+	// The legacy key of Docker Hub is https://index.docker.io/v1/
+	if host == "index.docker.io" || host == dockerHubAPI {
+		host = dockerHub
+	}
+
+	if path == "" || path == "v1" || path == "v2" {
+		return host
+	}
+
+	return host + "/" + path
+}
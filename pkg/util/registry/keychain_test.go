@@ -0,0 +1,90 @@
+package registry_test
+
+import (
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/opendatahub-io/odh-cli/pkg/util/registry"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestParseDockerConfig(t *testing.T) {
+	g := NewWithT(t)
+
+	auth := base64.StdEncoding.EncodeToString([]byte("robot:s3cret"))
+
+	keychain, err := registry.ParseDockerConfig([]byte(`{"auths": {
+		"https://index.docker.io/v1/": {"auth": "` + auth + `"},
+		"quay.io/modh": {"username": "modh", "password": "token"},
+		"registry.redhat.io": {"auth": "` + auth + `"},
+		"helper.example.com": {}
+	}}`))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(keychain).To(Equal(registry.Keychain{
+		"docker.io":          {Username: "robot", Password: "s3cret"},
+		"quay.io/modh":       {Username: "modh", Password: "token"},
+		"registry.redhat.io": {Username: "robot", Password: "s3cret"},
+	}))
+
+	_, err = registry.ParseDockerConfig([]byte(`{"auths": {"quay.io": {"auth": "bm9jb2xvbg=="}}}`))
+	g.Expect(err).To(MatchError(ContainSubstring("expected username:password")))
+}
+
+func TestKeychain_Lookup(t *testing.T) {
+	g := NewWithT(t)
+
+	keychain := registry.Keychain{
+		"quay.io":      {Username: "default"},
+		"quay.io/modh": {Username: "modh"},
+	}
+
+	for image, username := range map[string]string{
+		"quay.io/modh/odh-workbench:2025.2":  "modh",
+		"quay.io/myorg/custom-workbench:1.0": "default",
+	} {
+		ref, err := registry.ParseReference(image)
+		g.Expect(err).ToNot(HaveOccurred())
+
+		creds, ok := keychain.Lookup(ref)
+		g.Expect(ok).To(BeTrue(), image)
+		g.Expect(creds.Username).To(Equal(username), image)
+	}
+
+	ref, err := registry.ParseReference("registry.redhat.io/rhoai/odh-workbench:2025.2")
+	g.Expect(err).ToNot(HaveOccurred())
+
+	_, ok := keychain.Lookup(ref)
+	g.Expect(ok).To(BeFalse())
+}
+
+func TestLoadLocalKeychain(t *testing.T) {
+	g := NewWithT(t)
+
+	dir := t.TempDir()
+	authFile := filepath.Join(dir, "auth.json")
+	dockerDir := filepath.Join(dir, "docker")
+
+	g.Expect(os.WriteFile(authFile,
+		[]byte(`{"auths": {"quay.io": {"username": "podman", "password": "p"}}}`), 0o600)).To(Succeed())
+	g.Expect(os.MkdirAll(dockerDir, 0o700)).To(Succeed())
+	g.Expect(os.WriteFile(filepath.Join(dockerDir, "config.json"), []byte(`{"auths": {
+		"quay.io": {"username": "docker", "password": "d"},
+		"registry.redhat.io": {"username": "docker", "password": "d"}
+	}}`), 0o600)).To(Succeed())
+
+	t.Setenv("REGISTRY_AUTH_FILE", authFile)
+	t.Setenv("XDG_RUNTIME_DIR", filepath.Join(dir, "missing"))
+	t.Setenv("DOCKER_CONFIG", dockerDir)
+
+	keychain, err := registry.LoadLocalKeychain()
+	g.Expect(err).ToNot(HaveOccurred())
+
+	// REGISTRY_AUTH_FILE takes precedence over the docker config
+	g.Expect(keychain).To(Equal(registry.Keychain{
+		"quay.io":            {Username: "podman", Password: "p"},
+		"registry.redhat.io": {Username: "docker", Password: "d"},
+	}))
+}
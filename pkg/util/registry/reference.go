@@ -0,0 +1,102 @@
+// Package registry reads image metadata (digests and labels) from container registries through
+// the Docker Registry HTTP API V2, authenticating with the credentials of docker config files.
+package registry
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	// dockerHub is the canonical name of Docker Hub in image references and credentials.
+	dockerHub = "docker.io"
+
+	// dockerHubAPI is the host serving the registry API of Docker Hub.
+	dockerHubAPI = "registry-1.docker.io"
+
+	defaultTag = "latest"
+)
+
+// Reference is a parsed image reference, e.g. quay.io/modh/odh-workbench:2025.2 or
+// registry.redhat.io/rhoai/odh-workbench@sha256:abc.
+type Reference struct {
+	// Registry is the registry host, with its port if any, e.g. "quay.io" or "docker.io".
+	Registry string
+
+	// Repository is the repository path within the registry, e.g. "modh/odh-workbench".
+	Repository string
+
+	// Tag is the tag of the reference; "latest" when the reference has neither tag nor digest.
+	Tag string
+
+	// Digest is the digest of the reference, e.g. "sha256:abc"; empty for tag references.
+	Digest string
+}
+
+// ParseReference parses an image reference. References without a registry host refer to
+// Docker Hub, where single-component repositories are in the library namespace.
+func ParseReference(image string) (Reference, error) {
+	var ref Reference
+
+	name, digest, hasDigest := strings.Cut(image, "@")
+	if hasDigest {
+		if !strings.Contains(digest, ":") {
+			return Reference{}, fmt.Errorf("invalid image reference %q: malformed digest", image)
+		}
+
+		ref.Digest = digest
+	}
+
+	// A colon after the last slash separates the tag; one before it is the registry port
+	if i := strings.LastIndex(name, ":"); i > strings.LastIndex(name, "/") {
+		name, ref.Tag = name[:i], name[i+1:]
+	}
+
+	if ref.Tag == "" && ref.Digest == "" {
+		ref.Tag = defaultTag
+	}
+
+	host, path, hasHost := strings.Cut(name, "/")
+	if hasHost && (strings.ContainsAny(host, ".:") || host == "localhost") {
+		ref.Registry, ref.Repository = host, path
+	} else {
+		ref.Registry, ref.Repository = dockerHub, name
+	}
+
+	if ref.Registry == dockerHub && !strings.Contains(ref.Repository, "/") {
+		ref.Repository = "library/" + ref.Repository
+	}
+
+	if ref.Repository == "" {
+		return Reference{}, fmt.Errorf("invalid image reference %q: missing repository", image)
+	}
+
+	return ref, nil
+}
+
+// Identifier returns the digest of the reference, or its tag when it has none.
+func (r Reference) Identifier() string {
+	if r.Digest != "" {
+		return r.Digest
+	}
+
+	return r.Tag
+}
+
+// String returns the reference in its canonical form.
+func (r Reference) String() string {
+	if r.Digest != "" {
+		return r.Registry + "/" + r.Repository + "@" + r.Digest
+	}
+
+	return r.Registry + "/" + r.Repository + ":" + r.Tag
+}
+
+// apiHost returns the host serving the registry API of the reference.
+func (r Reference) apiHost() string {
+	if r.Registry == dockerHub {
+		return dockerHubAPI
+	}
+
+	return r.Registry
+}
@@ -0,0 +1,95 @@
+package telemetry
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.39.0"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/opendatahub-io/odh-cli/internal/version"
+)
+
+const (
+	// InstrumentationName identifies the spans created by the CLI.
+	InstrumentationName = "github.com/opendatahub-io/odh-cli"
+
+	serviceName = "odh-cli"
+)
+
+// Standard OpenTelemetry environment variables controlling the export of spans.
+const (
+	EnvSDKDisabled        = "OTEL_SDK_DISABLED"
+	EnvTracesExporter     = "OTEL_TRACES_EXPORTER"
+	EnvOTLPEndpoint       = "OTEL_EXPORTER_OTLP_ENDPOINT"
+	EnvOTLPTracesEndpoint = "OTEL_EXPORTER_OTLP_TRACES_ENDPOINT"
+)
+
+// Tracer returns the tracer for the spans of the CLI. Spans are dropped until Setup installs
+// an exporting tracer provider.
+func Tracer() trace.Tracer {
+	return otel.Tracer(InstrumentationName, trace.WithInstrumentationVersion(version.GetVersion()))
+}
+
+// Enabled reports whether the environment requests the export of spans: an OTLP endpoint is
+// configured, and neither OTEL_SDK_DISABLED nor OTEL_TRACES_EXPORTER=none turns it off.
+func Enabled() bool {
+	if strings.EqualFold(os.Getenv(EnvSDKDisabled), "true") {
+		return false
+	}
+
+	if strings.EqualFold(os.Getenv(EnvTracesExporter), "none") {
+		return false
+	}
+
+	return os.Getenv(EnvOTLPEndpoint) != "" || os.Getenv(EnvOTLPTracesEndpoint) != ""
+}
+
+// Setup installs a global tracer provider exporting spans over OTLP/HTTP when Enabled. The
+// exporter is configured by the standard OTEL_EXPORTER_OTLP_* variables and the resource by
+// OTEL_SERVICE_NAME and OTEL_RESOURCE_ATTRIBUTES. The returned function flushes pending spans
+// and must be called before the process exits; it is a no-op when export is disabled.
+func Setup(ctx context.Context) (func(context.Context) error, error) {
+	if !Enabled() {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	exporter, err := otlptracehttp.New(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("creating OTLP trace exporter: %w", err)
+	}
+
+	// Attributes from the environment take precedence over the defaults
+	res, err := resource.New(ctx,
+		resource.WithAttributes(
+			semconv.ServiceName(serviceName),
+			semconv.ServiceVersion(version.GetVersion()),
+		),
+		resource.WithTelemetrySDK(),
+		resource.WithFromEnv(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("creating telemetry resource: %w", err)
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+	)
+
+	otel.SetTracerProvider(provider)
+
+	return func(ctx context.Context) error {
+		if err := provider.Shutdown(ctx); err != nil {
+			return fmt.Errorf("flushing spans: %w", err)
+		}
+
+		return nil
+	}, nil
+}
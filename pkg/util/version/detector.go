@@ -10,46 +10,65 @@ import (
 	"github.com/opendatahub-io/odh-cli/pkg/util/client"
 )
 
+// versionSourceFunc reads a version string from a single source. It returns false when the
+// source exists but carries no version, or is absent from the cluster.
+type versionSourceFunc func(ctx context.Context, c client.Reader) (string, bool, error)
+
 // Detect performs priority-based version detection from multiple sources
-// Priority order: DataScienceCluster > DSCInitialization > OLM
+// Priority order: DataScienceCluster > DSCInitialization > OLM > operator Deployment
 // Returns parsed semver.TargetVersion or error if version cannot be determined from any source.
-func Detect(ctx context.Context, c client.Client) (*semver.Version, error) {
-	// Priority 1: DataScienceCluster
-	if versionStr, found, err := DetectFromDataScienceCluster(ctx, c); err != nil {
-		return nil, fmt.Errorf("detecting from DataScienceCluster: %w", err)
-	} else if found {
-		ver, err := semver.Parse(versionStr)
-		if err != nil {
-			return nil, fmt.Errorf("parsing version %q: %w", versionStr, err)
-		}
+// Readers without API discovery (e.g. snapshot-backed readers) resolve DSC and DSCI via the v1 API.
+func Detect(ctx context.Context, c client.Reader) (*semver.Version, error) {
+	ver, _, err := DetectWithSource(ctx, c)
 
-		return &ver, nil
+	return ver, err
+}
+
+// DetectWithSource is Detect, also returning the source the version was read from.
+// A source that cannot be read (e.g. Forbidden, or a version that is not semver) falls
+// through to the next one, so the version is detected on clusters where the primary
+// source is unavailable. The errors are returned only when no source yields a version.
+func DetectWithSource(ctx context.Context, c client.Reader) (*semver.Version, VersionSource, error) {
+	sources := []struct {
+		source VersionSource
+		detect versionSourceFunc
+		parse  func(string) (semver.Version, error)
+	}{
+		{SourceDataScienceCluster, DetectFromDataScienceCluster, semver.Parse},
+		{SourceDSCInitialization, DetectFromDSCInitialization, semver.Parse},
+		{SourceOLM, DetectFromOLM, semver.Parse},
+		// Image tags are commonly prefixed with "v"
+		{SourceOperatorDeployment, DetectFromOperatorDeployment, semver.ParseTolerant},
 	}
 
-	// Priority 2: DSCInitialization
-	if versionStr, found, err := DetectFromDSCInitialization(ctx, c); err != nil {
-		return nil, fmt.Errorf("detecting from DSCInitialization: %w", err)
-	} else if found {
-		ver, err := semver.Parse(versionStr)
+	var errs []error
+
+	for _, s := range sources {
+		versionStr, found, err := s.detect(ctx, c)
 		if err != nil {
-			return nil, fmt.Errorf("parsing version %q: %w", versionStr, err)
+			errs = append(errs, fmt.Errorf("detecting from %s: %w", s.source, err))
+
+			continue
 		}
 
-		return &ver, nil
-	}
+		if !found {
+			continue
+		}
 
-	// Priority 3: OLM
-	if versionStr, found, err := DetectFromOLM(ctx, c); err != nil {
-		return nil, fmt.Errorf("detecting from OLM: %w", err)
-	} else if found {
-		ver, err := semver.Parse(versionStr)
+		ver, err := s.parse(versionStr)
 		if err != nil {
-			return nil, fmt.Errorf("parsing version %q: %w", versionStr, err)
+			errs = append(errs, fmt.Errorf("parsing version %q from %s: %w", versionStr, s.source, err))
+
+			continue
 		}
 
-		return &ver, nil
+		return &ver, s.source, nil
+	}
+
+	if len(errs) > 0 {
+		return nil, SourceUnknown, fmt.Errorf("unable to detect cluster version: %w", errors.Join(errs...))
 	}
 
 	// No version found from any source
-	return nil, errors.New("unable to detect cluster version: no DataScienceCluster, DSCInitialization, or OLM resources found with version information")
+	return nil, SourceUnknown, errors.New("unable to detect cluster version: no DataScienceCluster, DSCInitialization, OLM or operator Deployment resources found with version information")
 }
@@ -8,6 +8,7 @@ import (
 	operatorsv1alpha1 "github.com/operator-framework/api/pkg/operators/v1alpha1"
 	operatorfake "github.com/operator-framework/operator-lifecycle-manager/pkg/api/client/clientset/versioned/fake"
 
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -403,3 +404,104 @@ func TestVersionToBranch_UnsupportedVersion(t *testing.T) {
 	g.Expect(err.Error()).To(ContainSubstring("unsupported version"))
 	g.Expect(branch).To(Equal(""))
 }
+
+func TestDetectWithSource_FallsBackToOperatorDeployment(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	// No DSC, DSCI or CSV: only the operator Deployment carries the version, in its image tag
+	deployment := &unstructured.Unstructured{
+		Object: map[string]any{
+			"apiVersion": resources.Deployment.APIVersion(),
+			"kind":       resources.Deployment.Kind,
+			"metadata": map[string]any{
+				"name":      "opendatahub-operator-controller-manager",
+				"namespace": "openshift-operators",
+			},
+			"spec": map[string]any{
+				"template": map[string]any{
+					"spec": map[string]any{
+						"containers": []any{
+							map[string]any{
+								"name":  "manager",
+								"image": "registry.example.com:5000/opendatahub/opendatahub-operator:v2.25.1",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	scheme := runtime.NewScheme()
+	dynamicClient := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(scheme, listKinds, deployment)
+
+	c := client.NewForTesting(client.TestClientConfig{
+		Dynamic: dynamicClient,
+	})
+
+	clusterVersion, source, err := version.DetectWithSource(ctx, c)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(clusterVersion.String()).To(Equal("2.25.1"))
+	g.Expect(source).To(Equal(version.SourceOperatorDeployment))
+}
+
+func TestDetectWithSource_SkipsUnavailableSources(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	dsci := &unstructured.Unstructured{
+		Object: map[string]any{
+			"apiVersion": resources.DSCInitializationV1.APIVersion(),
+			"kind":       resources.DSCInitializationV1.Kind,
+			"metadata": map[string]any{
+				"name": "default-dsci",
+			},
+			"status": map[string]any{
+				"release": map[string]any{
+					"version": "2.16.0",
+				},
+			},
+		},
+	}
+
+	scheme := runtime.NewScheme()
+	dynamicClient := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(scheme, listKinds, dsci)
+
+	// The DataScienceCluster cannot be read
+	dynamicClient.PrependReactor("list", resources.DataScienceClusterV1.Resource,
+		func(coretesting.Action) (bool, runtime.Object, error) {
+			return true, nil, apierrors.NewServiceUnavailable("etcd leader changed")
+		})
+
+	c := client.NewForTesting(client.TestClientConfig{
+		Dynamic: dynamicClient,
+	})
+
+	clusterVersion, source, err := version.DetectWithSource(ctx, c)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(clusterVersion.String()).To(Equal("2.16.0"))
+	g.Expect(source).To(Equal(version.SourceDSCInitialization))
+}
+
+func TestDetectWithSource_ReportsErrorsOfUnavailableSources(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	scheme := runtime.NewScheme()
+	dynamicClient := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(scheme, listKinds)
+
+	dynamicClient.PrependReactor("list", resources.DataScienceClusterV1.Resource,
+		func(coretesting.Action) (bool, runtime.Object, error) {
+			return true, nil, apierrors.NewServiceUnavailable("etcd leader changed")
+		})
+
+	c := client.NewForTesting(client.TestClientConfig{
+		Dynamic: dynamicClient,
+	})
+
+	clusterVersion, source, err := version.DetectWithSource(ctx, c)
+	g.Expect(err).To(MatchError(ContainSubstring("detecting from DataScienceCluster")))
+	g.Expect(clusterVersion).To(BeNil())
+	g.Expect(source).To(Equal(version.SourceUnknown))
+}
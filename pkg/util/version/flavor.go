@@ -0,0 +1,183 @@
+package version
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+	"github.com/opendatahub-io/odh-cli/pkg/util/jq"
+)
+
+// Flavor is the product flavor of the operator: upstream Open Data Hub or downstream Red Hat
+// OpenShift AI. Namespaces, image registries and removal timelines differ between them.
+type Flavor string
+
+const (
+	FlavorODH   Flavor = "ODH"
+	FlavorRHOAI Flavor = "RHOAI"
+
+	// FlavorUnknown is the flavor of clusters none of the flavor sources identify.
+	FlavorUnknown Flavor = ""
+)
+
+// Release names reported in .status.release.name of the DSC and DSCI.
+const (
+	releaseNameODH   = "Open Data Hub"
+	releaseNameRHOAI = "OpenShift AI" // "OpenShift AI Self-Managed" or "OpenShift AI Cloud Service"
+)
+
+// Default applications namespaces of each flavor.
+const (
+	ApplicationsNamespaceODH   = "opendatahub"
+	ApplicationsNamespaceRHOAI = "redhat-ods-applications"
+)
+
+// String returns the string representation of the flavor.
+func (f Flavor) String() string {
+	return string(f)
+}
+
+// DisplayName returns the product name of the flavor, e.g. "Red Hat OpenShift AI".
+func (f Flavor) DisplayName() string {
+	switch f {
+	case FlavorODH:
+		return "Open Data Hub"
+	case FlavorRHOAI:
+		return "Red Hat OpenShift AI"
+	default:
+		return "Unknown"
+	}
+}
+
+// ApplicationsNamespace returns the default applications namespace of the flavor, or "" when
+// the flavor is unknown.
+func (f Flavor) ApplicationsNamespace() string {
+	switch f {
+	case FlavorODH:
+		return ApplicationsNamespaceODH
+	case FlavorRHOAI:
+		return ApplicationsNamespaceRHOAI
+	default:
+		return ""
+	}
+}
+
+// DetectFlavor performs priority-based product flavor detection from multiple sources
+// Priority order: DSC/DSCI release name > DSCI applications namespace > operator Deployment name
+// Like DetectWithSource, a source that cannot be read falls through to the next one.
+// Returns FlavorUnknown, with the errors of the sources that could not be read, when none
+// identifies the flavor.
+func DetectFlavor(ctx context.Context, c client.Reader) (Flavor, error) {
+	var errs []error
+
+	for _, detect := range []func(context.Context, client.Reader) (Flavor, error){
+		flavorFromReleaseName,
+		flavorFromApplicationsNamespace,
+		flavorFromOperatorDeployment,
+	} {
+		flavor, err := detect(ctx, c)
+		if err != nil {
+			errs = append(errs, err)
+
+			continue
+		}
+
+		if flavor != FlavorUnknown {
+			return flavor, nil
+		}
+	}
+
+	if len(errs) > 0 {
+		return FlavorUnknown, fmt.Errorf("unable to detect product flavor: %w", errors.Join(errs...))
+	}
+
+	return FlavorUnknown, nil
+}
+
+// flavorFromReleaseName identifies the flavor from the .status.release.name of the
+// DataScienceCluster, else of the DSCInitialization.
+func flavorFromReleaseName(ctx context.Context, c client.Reader) (Flavor, error) {
+	for _, get := range []func(context.Context, client.Reader) (*unstructured.Unstructured, error){
+		GetDataScienceCluster,
+		GetDSCInitialization,
+	} {
+		obj, err := get(ctx, c)
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+
+			return FlavorUnknown, fmt.Errorf("reading release name: %w", err)
+		}
+
+		name, err := jq.Query[string](obj, ".status.release.name")
+		if err != nil && !errors.Is(err, jq.ErrNotFound) {
+			return FlavorUnknown, fmt.Errorf("querying .status.release.name: %w", err)
+		}
+
+		switch {
+		case name == releaseNameODH:
+			return FlavorODH, nil
+		case strings.Contains(name, releaseNameRHOAI):
+			return FlavorRHOAI, nil
+		}
+	}
+
+	return FlavorUnknown, nil
+}
+
+// flavorFromApplicationsNamespace identifies the flavor from the applications namespace of the
+// DSCInitialization, when it is the default of a flavor.
+func flavorFromApplicationsNamespace(ctx context.Context, c client.Reader) (Flavor, error) {
+	dsci, err := GetDSCInitialization(ctx, c)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return FlavorUnknown, nil
+		}
+
+		return FlavorUnknown, fmt.Errorf("getting DSCInitialization: %w", err)
+	}
+
+	namespace, err := jq.Query[string](dsci, ".spec.applicationsNamespace")
+	if err != nil && !errors.Is(err, jq.ErrNotFound) {
+		return FlavorUnknown, fmt.Errorf("querying .spec.applicationsNamespace: %w", err)
+	}
+
+	for _, flavor := range []Flavor{FlavorODH, FlavorRHOAI} {
+		if namespace == flavor.ApplicationsNamespace() {
+			return flavor, nil
+		}
+	}
+
+	return FlavorUnknown, nil
+}
+
+// flavorFromOperatorDeployment identifies the flavor from the name of the operator Deployment
+// in the well-known operator namespaces.
+func flavorFromOperatorDeployment(ctx context.Context, c client.Reader) (Flavor, error) {
+	flavors := map[string]Flavor{
+		"rhods-operator": FlavorRHOAI,
+		"opendatahub-operator-controller-manager": FlavorODH,
+	}
+
+	for _, ns := range operatorNamespaces() {
+		for _, name := range operatorDeploymentNames {
+			_, err := c.GetResource(ctx, resources.Deployment, name, client.InNamespace(ns))
+			if err == nil {
+				return flavors[name], nil
+			}
+
+			if !apierrors.IsNotFound(err) {
+				return FlavorUnknown, fmt.Errorf("getting Deployment %s/%s: %w", ns, name, err)
+			}
+		}
+	}
+
+	return FlavorUnknown, nil
+}
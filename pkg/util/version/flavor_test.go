@@ -0,0 +1,96 @@
+package version_test
+
+import (
+	"context"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+	"github.com/opendatahub-io/odh-cli/pkg/util/version"
+
+	. "github.com/onsi/gomega"
+)
+
+func newFlavorDSCI(releaseName string, applicationsNamespace string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{
+		Object: map[string]any{
+			"apiVersion": resources.DSCInitializationV1.APIVersion(),
+			"kind":       resources.DSCInitializationV1.Kind,
+			"metadata": map[string]any{
+				"name": "default-dsci",
+			},
+			"spec": map[string]any{
+				"applicationsNamespace": applicationsNamespace,
+			},
+			"status": map[string]any{
+				"release": map[string]any{
+					"name":    releaseName,
+					"version": "2.25.0",
+				},
+			},
+		},
+	}
+}
+
+func TestDetectFlavor(t *testing.T) {
+	tests := []struct {
+		name     string
+		objects  []runtime.Object
+		expected version.Flavor
+	}{
+		{
+			name:     "RHOAI release name",
+			objects:  []runtime.Object{newFlavorDSCI("OpenShift AI Self-Managed", "custom-apps")},
+			expected: version.FlavorRHOAI,
+		},
+		{
+			name:     "ODH release name",
+			objects:  []runtime.Object{newFlavorDSCI("Open Data Hub", "custom-apps")},
+			expected: version.FlavorODH,
+		},
+		{
+			name:     "default applications namespace without release name",
+			objects:  []runtime.Object{newFlavorDSCI("", version.ApplicationsNamespaceODH)},
+			expected: version.FlavorODH,
+		},
+		{
+			name: "operator Deployment",
+			objects: []runtime.Object{&unstructured.Unstructured{
+				Object: map[string]any{
+					"apiVersion": resources.Deployment.APIVersion(),
+					"kind":       resources.Deployment.Kind,
+					"metadata": map[string]any{
+						"name":      "rhods-operator",
+						"namespace": "redhat-ods-operator",
+					},
+				},
+			}},
+			expected: version.FlavorRHOAI,
+		},
+		{
+			name:     "no flavor source",
+			expected: version.FlavorUnknown,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			scheme := runtime.NewScheme()
+			dynamicClient := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(scheme, listKinds, tt.objects...)
+
+			c := client.NewForTesting(client.TestClientConfig{
+				Dynamic: dynamicClient,
+			})
+
+			flavor, err := version.DetectFlavor(context.Background(), c)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(flavor).To(Equal(tt.expected))
+		})
+	}
+}
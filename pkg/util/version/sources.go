@@ -2,12 +2,15 @@ package version
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	k8sdiscovery "k8s.io/client-go/discovery"
 
 	"github.com/opendatahub-io/odh-cli/pkg/resources"
 	"github.com/opendatahub-io/odh-cli/pkg/util/client"
@@ -15,12 +18,17 @@ import (
 	"github.com/opendatahub-io/odh-cli/pkg/util/kube/discovery"
 )
 
+// discoveryProvider is implemented by readers backed by a live API server.
+type discoveryProvider interface {
+	Discovery() k8sdiscovery.DiscoveryInterface
+}
+
 // getSingletonWithDiscovery dynamically detects the available API version and retrieves
 // a singleton resource. Tries v2 first, falls back to v1.
 // This avoids code duplication for resources that exist in both v1 and v2 API versions.
 func getSingletonWithDiscovery(
 	ctx context.Context,
-	c client.Client,
+	c client.Reader,
 	v2Resource, v1Resource resources.ResourceType,
 ) (*unstructured.Unstructured, error) {
 	// Try v2 first if Discovery is available
@@ -52,15 +60,16 @@ func getSingletonWithDiscovery(
 //   - (nil, false, err) if a real error occurred (403, timeout, unexpected count, etc.)
 func tryGetV2Singleton(
 	ctx context.Context,
-	c client.Client,
+	c client.Reader,
 	v2Resource resources.ResourceType,
 ) (*unstructured.Unstructured, bool, error) {
-	if c.Discovery() == nil {
+	dp, ok := c.(discoveryProvider)
+	if !ok || dp.Discovery() == nil {
 		return nil, true, nil // No discovery available, fall back to v1
 	}
 
 	v2Resources, err := discovery.GetGroupVersionResources(
-		c.Discovery(),
+		dp.Discovery(),
 		schema.GroupVersion{Group: v2Resource.Group, Version: "v2"},
 	)
 	if err != nil {
@@ -85,12 +94,23 @@ func tryGetV2Singleton(
 	return obj, false, nil
 }
 
+// GetDataScienceCluster retrieves the DataScienceCluster singleton at the API version the cluster
+// serves: v2 on RHOAI 3.x, v1 on 2.x and mid-upgrade clusters.
+func GetDataScienceCluster(ctx context.Context, c client.Reader) (*unstructured.Unstructured, error) {
+	return getSingletonWithDiscovery(ctx, c, resources.DataScienceCluster, resources.DataScienceClusterV1)
+}
+
+// GetDSCInitialization retrieves the DSCInitialization singleton at the API version the cluster
+// serves: v2 on RHOAI 3.x, v1 on 2.x and mid-upgrade clusters.
+func GetDSCInitialization(ctx context.Context, c client.Reader) (*unstructured.Unstructured, error) {
+	return getSingletonWithDiscovery(ctx, c, resources.DSCInitialization, resources.DSCInitializationV1)
+}
+
 // DetectFromDataScienceCluster attempts to detect version from DataScienceCluster resource.
 // Dynamically detects whether to use v1 or v2 API based on cluster capabilities.
 // Returns version string and true if found, empty string and false otherwise.
-func DetectFromDataScienceCluster(ctx context.Context, c client.Client) (string, bool, error) {
-	// Get the DataScienceCluster singleton using discovery-based version detection
-	dsc, err := getSingletonWithDiscovery(ctx, c, resources.DataScienceCluster, resources.DataScienceClusterV1)
+func DetectFromDataScienceCluster(ctx context.Context, c client.Reader) (string, bool, error) {
+	dsc, err := GetDataScienceCluster(ctx, c)
 	if err != nil {
 		if apierrors.IsNotFound(err) {
 			return "", false, nil
@@ -115,9 +135,8 @@ func DetectFromDataScienceCluster(ctx context.Context, c client.Client) (string,
 // DetectFromDSCInitialization attempts to detect version from DSCInitialization resource.
 // Dynamically detects whether to use v1 or v2 API based on cluster capabilities.
 // Returns version string and true if found, empty string and false otherwise.
-func DetectFromDSCInitialization(ctx context.Context, c client.Client) (string, bool, error) {
-	// Get the DSCInitialization singleton using discovery-based version detection
-	dsci, err := getSingletonWithDiscovery(ctx, c, resources.DSCInitialization, resources.DSCInitializationV1)
+func DetectFromDSCInitialization(ctx context.Context, c client.Reader) (string, bool, error) {
+	dsci, err := GetDSCInitialization(ctx, c)
 	if err != nil {
 		if apierrors.IsNotFound(err) {
 			return "", false, nil
@@ -175,3 +194,64 @@ func DetectFromOLM(ctx context.Context, c client.Reader) (string, bool, error) {
 
 	return versionStr, true, nil
 }
+
+// operatorDeploymentNames are the names of the RHOAI and ODH operator Deployments.
+//
+//nolint:gochecknoglobals // Constant list of well-known Deployment names
+var operatorDeploymentNames = []string{"rhods-operator", "opendatahub-operator-controller-manager"}
+
+// operatorNamespaces returns the well-known namespaces of the operator Deployment.
+func operatorNamespaces() []string {
+	return []string{
+		client.DefaultRHOAIOperatorNamespace,
+		client.DefaultODHOperatorNamespace,
+		client.DefaultOpenShiftOperatorsNS,
+	}
+}
+
+// DetectFromOperatorDeployment attempts to detect version from the image tag of the operator
+// Deployment in the well-known operator namespaces, for clusters without a DSC, DSCI or OLM.
+// Images referenced only by digest carry no version.
+// Returns version string and true if found, empty string and false otherwise.
+func DetectFromOperatorDeployment(ctx context.Context, c client.Reader) (string, bool, error) {
+	for _, ns := range operatorNamespaces() {
+		for _, name := range operatorDeploymentNames {
+			deployment, err := c.GetResource(ctx, resources.Deployment, name, client.InNamespace(ns))
+			if err != nil {
+				if apierrors.IsNotFound(err) {
+					continue
+				}
+
+				return "", false, fmt.Errorf("getting Deployment %s/%s: %w", ns, name, err)
+			}
+
+			image, err := jq.Query[string](deployment, ".spec.template.spec.containers[0].image")
+			if errors.Is(err, jq.ErrNotFound) {
+				continue
+			}
+
+			if err != nil {
+				return "", false, fmt.Errorf("querying image of Deployment %s/%s: %w", ns, name, err)
+			}
+
+			tag := imageTag(image)
+
+			return tag, tag != "", nil
+		}
+	}
+
+	return "", false, nil
+}
+
+// imageTag returns the tag of an image reference, e.g. "v2.25.0" for
+// "quay.io/opendatahub/opendatahub-operator:v2.25.0", or "" when it has none.
+func imageTag(image string) string {
+	// Drop the digest, then the repository; a ":" left in the repository is a registry port
+	image, _, _ = strings.Cut(image, "@")
+
+	repository := image[strings.LastIndex(image, "/")+1:]
+
+	_, tag, _ := strings.Cut(repository, ":")
+
+	return tag
+}
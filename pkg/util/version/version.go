@@ -17,7 +17,8 @@ const (
 	SourceDataScienceCluster VersionSource = "DataScienceCluster"
 	SourceDSCInitialization  VersionSource = "DSCInitialization"
 	SourceOLM                VersionSource = "OLM"
-	SourceManual             VersionSource = "Manual" // User-specified target version
+	SourceOperatorDeployment VersionSource = "OperatorDeployment" // Image tag of the operator Deployment
+	SourceManual             VersionSource = "Manual"             // User-specified version
 	SourceUnknown            VersionSource = "Unknown"
 )
 
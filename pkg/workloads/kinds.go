@@ -0,0 +1,81 @@
+package workloads
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+)
+
+// Kind filters accepted by --kind.
+const (
+	KindNotebook   = "notebook"
+	KindISVC       = "isvc"
+	KindPyTorchJob = "pytorchjob"
+	KindRay        = "ray"
+)
+
+// kindMap maps each --kind value to the resource types it selects.
+//
+//nolint:gochecknoglobals // Static lookup table
+var kindMap = map[string][]resources.ResourceType{
+	KindNotebook:   {resources.Notebook},
+	KindISVC:       {resources.InferenceService},
+	KindPyTorchJob: {resources.PyTorchJob},
+	KindRay:        {resources.RayCluster, resources.RayJob},
+}
+
+// KindNames returns the sorted list of accepted --kind values.
+func KindNames() []string {
+	names := make([]string, 0, len(kindMap))
+	for name := range kindMap {
+		names = append(names, name)
+	}
+
+	slices.Sort(names)
+
+	return names
+}
+
+// resolveKinds returns the resource types selected by the given --kind values.
+func resolveKinds(kinds []string) ([]resources.ResourceType, error) {
+	var types []resources.ResourceType
+
+	for _, kind := range kinds {
+		rts, ok := kindMap[strings.ToLower(kind)]
+		if !ok {
+			return nil, fmt.Errorf("unknown workload kind %q (must be one of: %s)", kind, strings.Join(KindNames(), ", "))
+		}
+
+		types = append(types, rts...)
+	}
+
+	return types, nil
+}
+
+// knownWorkloads returns the GVRs of every resource type selectable with --kind, used when
+// CRD discovery is not available.
+func knownWorkloads() []schema.GroupVersionResource {
+	var gvrs []schema.GroupVersionResource
+
+	for _, name := range KindNames() {
+		for _, rt := range kindMap[name] {
+			gvrs = append(gvrs, rt.GVR())
+		}
+	}
+
+	return gvrs
+}
+
+// filterGVRs keeps the discovered GVRs matching the API group and resource of one of the
+// selected types. Versions are ignored so the storage version found by discovery is used.
+func filterGVRs(gvrs []schema.GroupVersionResource, types []resources.ResourceType) []schema.GroupVersionResource {
+	return slices.DeleteFunc(slices.Clone(gvrs), func(gvr schema.GroupVersionResource) bool {
+		return !slices.ContainsFunc(types, func(rt resources.ResourceType) bool {
+			return rt.Group == gvr.Group && rt.Resource == gvr.Resource
+		})
+	})
+}
@@ -0,0 +1,354 @@
+package workloads
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/spf13/pflag"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/opendatahub-io/odh-cli/pkg/api"
+	"github.com/opendatahub-io/odh-cli/pkg/cmd"
+	printerjson "github.com/opendatahub-io/odh-cli/pkg/printer/json"
+	"github.com/opendatahub-io/odh-cli/pkg/printer/table"
+	printeryaml "github.com/opendatahub-io/odh-cli/pkg/printer/yaml"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+	"github.com/opendatahub-io/odh-cli/pkg/util/iostreams"
+	"github.com/opendatahub-io/odh-cli/pkg/util/kube/discovery"
+)
+
+var _ cmd.Command = (*ListCommand)(nil)
+
+const (
+	outputFormatTable = "table"
+	outputFormatJSON  = "json"
+	outputFormatYAML  = "yaml"
+
+	// AnnotationDeploymentMode is the KServe annotation selecting the InferenceService deployment mode.
+	AnnotationDeploymentMode = "serving.kserve.io/deploymentMode"
+	// AnnotationUsername is the annotation the dashboard sets to the user who created a workload.
+	AnnotationUsername = "opendatahub.io/username"
+
+	hoursPerDay = 24
+
+	colKind           = "KIND"
+	colNamespace      = "NAMESPACE"
+	colName           = "NAME"
+	colOwner          = "OWNER"
+	colDeploymentMode = "DEPLOYMENT MODE"
+	colAge            = "AGE"
+
+	msgDiscoveryFailed = "Warning: workload discovery failed, listing built-in workload kinds: %v"
+	msgNoWorkloads     = "No workloads found."
+)
+
+// ListCommand contains the workloads list command configuration.
+type ListCommand struct {
+	IO          iostreams.Interface
+	ConfigFlags *genericclioptions.ConfigFlags
+	Client      client.Client
+
+	// Kinds restricts the listing to the given workload kinds (notebook, isvc, pytorchjob, ray).
+	// All discovered workload kinds are listed when empty.
+	Kinds []string
+	// Namespace restricts the listing to a single namespace (populated during Complete from
+	// --namespace). Workloads are listed across all namespaces when empty.
+	Namespace string
+	// LabelSelector filters workloads by label (e.g. app=my-model).
+	LabelSelector string
+	// OutputFormat controls output rendering: table, json, or yaml.
+	OutputFormat string
+	// Verbose enables verbose output.
+	Verbose bool
+	// Quiet suppresses all non-essential output.
+	Quiet bool
+
+	kindTypes []resources.ResourceType
+}
+
+// NewListCommand creates a new ListCommand with defaults.
+func NewListCommand(
+	streams genericiooptions.IOStreams,
+	configFlags *genericclioptions.ConfigFlags,
+) *ListCommand {
+	return &ListCommand{
+		IO:           iostreams.NewIOStreams(streams.In, streams.Out, streams.ErrOut),
+		ConfigFlags:  configFlags,
+		OutputFormat: outputFormatTable,
+	}
+}
+
+// AddFlags registers command-specific flags.
+// Note: -n/--namespace is already registered by ConfigFlags on the root command.
+func (c *ListCommand) AddFlags(fs *pflag.FlagSet) {
+	fs.StringSliceVar(&c.Kinds, "kind", nil,
+		"Workload kinds to list (repeatable or comma-separated): "+strings.Join(KindNames(), ", "))
+	_ = fs.SetAnnotation("kind", api.AnnotationValidValues, KindNames())
+	fs.StringVarP(&c.LabelSelector, "selector", "l", "", "Label selector to filter workloads (e.g. app=my-model)")
+	fs.StringVarP(&c.OutputFormat, "output", "o", outputFormatTable, "Output format: table, json, or yaml")
+	_ = fs.SetAnnotation("output", api.AnnotationValidValues, []string{"table", "json", "yaml"})
+	fs.BoolVarP(&c.Verbose, "verbose", "v", false, "Enable verbose output")
+	fs.BoolVarP(&c.Quiet, "quiet", "q", false, "Suppress all non-essential output")
+}
+
+// Complete resolves derived fields after flag parsing.
+func (c *ListCommand) Complete() error {
+	if c.Verbose && c.Quiet {
+		return errors.New("--verbose and --quiet are mutually exclusive")
+	}
+
+	if c.ConfigFlags != nil && c.ConfigFlags.Namespace != nil {
+		c.Namespace = *c.ConfigFlags.Namespace
+	}
+
+	k8sClient, err := client.NewClient(c.ConfigFlags)
+	if err != nil {
+		return fmt.Errorf("creating Kubernetes client: %w", err)
+	}
+
+	c.Client = k8sClient
+
+	// Wrap IO only when --quiet is explicitly passed
+	if c.Quiet {
+		c.IO = iostreams.NewFullQuietWrapper(c.IO)
+	}
+
+	return nil
+}
+
+// Validate checks that all options are valid before execution.
+func (c *ListCommand) Validate() error {
+	types, err := resolveKinds(c.Kinds)
+	if err != nil {
+		return err
+	}
+
+	c.kindTypes = types
+
+	switch c.OutputFormat {
+	case outputFormatTable, outputFormatJSON, outputFormatYAML:
+	default:
+		return fmt.Errorf("invalid output format %q (must be one of: table, json, yaml)", c.OutputFormat)
+	}
+
+	return nil
+}
+
+// Run executes the workloads list command.
+func (c *ListCommand) Run(ctx context.Context) error {
+	gvrs, err := discovery.DiscoverWorkloads(ctx, c.Client)
+	if err != nil {
+		c.IO.Errorf(msgDiscoveryFailed, err)
+		gvrs = knownWorkloads()
+	}
+
+	if len(c.kindTypes) > 0 {
+		gvrs = filterGVRs(gvrs, c.kindTypes)
+	}
+
+	workloads, err := c.listWorkloads(ctx, gvrs)
+	if err != nil {
+		return err
+	}
+
+	return c.renderOutput(workloads)
+}
+
+// listWorkloads lists the instances of every workload GVR, sorted by kind, namespace and name.
+// Workload types that are not installed or not readable are skipped.
+func (c *ListCommand) listWorkloads(ctx context.Context, gvrs []schema.GroupVersionResource) ([]WorkloadInfo, error) {
+	opts := []client.ListResourcesOption{}
+	if c.Namespace != "" {
+		opts = append(opts, client.WithNamespace(c.Namespace))
+	}
+
+	if c.LabelSelector != "" {
+		opts = append(opts, client.WithLabelSelector(c.LabelSelector))
+	}
+
+	workloads := []WorkloadInfo{}
+
+	for _, gvr := range gvrs {
+		items, err := c.Client.ListResources(ctx, gvr, opts...)
+		if err != nil {
+			if client.IsResourceTypeNotFound(err) {
+				if c.Verbose {
+					c.IO.Errorf("Skipping %s: not installed", gvr.GroupResource())
+				}
+
+				continue
+			}
+
+			return nil, fmt.Errorf("listing %s: %w", gvr.GroupResource(), err)
+		}
+
+		for _, item := range items {
+			workloads = append(workloads, newWorkloadInfo(item))
+		}
+	}
+
+	slices.SortFunc(workloads, func(a, b WorkloadInfo) int {
+		if a.Kind != b.Kind {
+			return strings.Compare(a.Kind, b.Kind)
+		}
+
+		if a.Namespace != b.Namespace {
+			return strings.Compare(a.Namespace, b.Namespace)
+		}
+
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	return workloads, nil
+}
+
+// newWorkloadInfo extracts the inventory fields of a workload.
+func newWorkloadInfo(obj *unstructured.Unstructured) WorkloadInfo {
+	info := WorkloadInfo{
+		Kind:      obj.GetKind(),
+		Namespace: obj.GetNamespace(),
+		Name:      obj.GetName(),
+		Owner:     workloadOwner(obj),
+	}
+
+	if ts := obj.GetCreationTimestamp(); !ts.IsZero() {
+		info.CreatedAt = ts.UTC().Format(time.RFC3339)
+	}
+
+	if obj.GetKind() == resources.InferenceService.Kind {
+		info.DeploymentMode = obj.GetAnnotations()[AnnotationDeploymentMode]
+	}
+
+	return info
+}
+
+// workloadOwner returns the controller owner of a workload as Kind/name, falling back to the
+// user recorded by the dashboard. Returns an empty string when neither is set.
+func workloadOwner(obj *unstructured.Unstructured) string {
+	if ref := metav1.GetControllerOf(obj); ref != nil {
+		return ref.Kind + "/" + ref.Name
+	}
+
+	refs := obj.GetOwnerReferences()
+	if len(refs) > 0 {
+		return refs[0].Kind + "/" + refs[0].Name
+	}
+
+	return obj.GetAnnotations()[AnnotationUsername]
+}
+
+// renderOutput dispatches to the appropriate output formatter.
+func (c *ListCommand) renderOutput(workloads []WorkloadInfo) error {
+	switch c.OutputFormat {
+	case outputFormatJSON:
+		return OutputJSON(c.IO.Out(), workloads)
+	case outputFormatYAML:
+		return OutputYAML(c.IO.Out(), workloads)
+	default:
+		if len(workloads) == 0 {
+			c.IO.Fprintf(msgNoWorkloads)
+
+			return nil
+		}
+
+		return OutputTable(c.IO.Out(), workloads)
+	}
+}
+
+// workloadColumns returns the table columns for the workload inventory.
+func workloadColumns() []table.Column {
+	return []table.Column{
+		table.NewColumn(colKind).JQ(".kind"),
+		table.NewColumn(colNamespace).JQ(".namespace"),
+		table.NewColumn(colName).JQ(".name"),
+		table.NewColumn(colOwner).JQ(`.owner // "-"`),
+		table.NewColumn(colDeploymentMode).JQ(`.deploymentMode // "-"`),
+		table.NewColumn(colAge).JQ(`.createdAt // ""`).Fn(ageFormatter),
+	}
+}
+
+// OutputTable renders workloads as a formatted table.
+func OutputTable(w io.Writer, workloads []WorkloadInfo) error {
+	renderer := table.NewWithColumns[WorkloadInfo](w, workloadColumns()...)
+
+	for _, wl := range workloads {
+		if err := renderer.Append(wl); err != nil {
+			return fmt.Errorf("rendering row: %w", err)
+		}
+	}
+
+	if err := renderer.Render(); err != nil {
+		return fmt.Errorf("rendering table: %w", err)
+	}
+
+	return nil
+}
+
+// OutputJSON renders workloads as JSON.
+func OutputJSON(w io.Writer, workloads []WorkloadInfo) error {
+	renderer := printerjson.NewRenderer[*WorkloadList](
+		printerjson.WithWriter[*WorkloadList](w),
+	)
+
+	if err := renderer.Render(NewWorkloadList(workloads)); err != nil {
+		return fmt.Errorf("rendering JSON: %w", err)
+	}
+
+	return nil
+}
+
+// OutputYAML renders workloads as YAML.
+func OutputYAML(w io.Writer, workloads []WorkloadInfo) error {
+	renderer := printeryaml.NewRenderer[*WorkloadList](
+		printeryaml.WithWriter[*WorkloadList](w),
+	)
+
+	if err := renderer.Render(NewWorkloadList(workloads)); err != nil {
+		return fmt.Errorf("rendering YAML: %w", err)
+	}
+
+	return nil
+}
+
+// ageFormatter converts a creationTimestamp string to a human-readable age.
+func ageFormatter(value any) any {
+	ts, ok := value.(string)
+	if !ok {
+		return "<unknown>"
+	}
+
+	return formatAge(ts)
+}
+
+// formatAge computes a human-readable duration string from an RFC 3339 timestamp.
+func formatAge(timestamp string) string {
+	t, err := time.Parse(time.RFC3339, timestamp)
+	if err != nil {
+		return "<unknown>"
+	}
+
+	d := time.Since(t)
+
+	switch {
+	case d < time.Minute:
+		return fmt.Sprintf("%ds", int(d.Seconds()))
+	case d < time.Hour:
+		return fmt.Sprintf("%dm", int(d.Minutes()))
+	case d < hoursPerDay*time.Hour:
+		return fmt.Sprintf("%dh", int(d.Hours()))
+	default:
+		return fmt.Sprintf("%dd", int(math.Floor(d.Hours()/hoursPerDay)))
+	}
+}
@@ -0,0 +1,226 @@
+package workloads_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apiextensionsfake "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset/fake"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+	"github.com/opendatahub-io/odh-cli/pkg/util/iostreams"
+	"github.com/opendatahub-io/odh-cli/pkg/workloads"
+
+	. "github.com/onsi/gomega"
+)
+
+const (
+	testTimestamp = "2025-01-01T00:00:00Z"
+	partOfLabel   = "platform.opendatahub.io/part-of"
+)
+
+//nolint:gochecknoglobals // Test fixture - shared across test functions
+var listKinds = map[schema.GroupVersionResource]string{
+	resources.Notebook.GVR():         resources.Notebook.ListKind(),
+	resources.InferenceService.GVR(): resources.InferenceService.ListKind(),
+	resources.RayCluster.GVR():       resources.RayCluster.ListKind(),
+	resources.RayJob.GVR():           resources.RayJob.ListKind(),
+	resources.PyTorchJob.GVR():       resources.PyTorchJob.ListKind(),
+}
+
+func newWorkloadCRD(rt resources.ResourceType) *apiextensionsv1.CustomResourceDefinition {
+	return &apiextensionsv1.CustomResourceDefinition{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   rt.CRDFQN(),
+			Labels: map[string]string{partOfLabel: "workloads"},
+		},
+		Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+			Group: rt.Group,
+			Names: apiextensionsv1.CustomResourceDefinitionNames{Plural: rt.Resource, Kind: rt.Kind},
+			Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+				{Name: rt.Version, Served: true, Storage: true},
+			},
+		},
+		Status: apiextensionsv1.CustomResourceDefinitionStatus{
+			Conditions: []apiextensionsv1.CustomResourceDefinitionCondition{
+				{Type: apiextensionsv1.Established, Status: apiextensionsv1.ConditionTrue},
+			},
+		},
+	}
+}
+
+func newWorkload(rt resources.ResourceType, namespace, name string, annotations map[string]any) *unstructured.Unstructured {
+	metadata := map[string]any{
+		"name":              name,
+		"namespace":         namespace,
+		"creationTimestamp": testTimestamp,
+	}
+	if annotations != nil {
+		metadata["annotations"] = annotations
+	}
+
+	return &unstructured.Unstructured{
+		Object: map[string]any{
+			"apiVersion": rt.APIVersion(),
+			"kind":       rt.Kind,
+			"metadata":   metadata,
+		},
+	}
+}
+
+func newListCommand(
+	crds []runtime.Object,
+	objects ...runtime.Object,
+) (*workloads.ListCommand, *bytes.Buffer) {
+	var out bytes.Buffer
+
+	dynamicClient := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), listKinds, objects...)
+	k8sClient := client.NewForTesting(client.TestClientConfig{
+		Dynamic:       dynamicClient,
+		APIExtensions: apiextensionsfake.NewSimpleClientset(crds...), //nolint:staticcheck // apply configs not available for apiextensions fake
+	})
+
+	cmd := workloads.NewListCommand(genericiooptions.IOStreams{Out: &out, ErrOut: &out}, nil)
+	cmd.Client = k8sClient
+	cmd.IO = iostreams.NewIOStreams(nil, &out, &out)
+
+	return cmd, &out
+}
+
+func allWorkloadCRDs() []runtime.Object {
+	return []runtime.Object{
+		newWorkloadCRD(resources.Notebook),
+		newWorkloadCRD(resources.InferenceService),
+		newWorkloadCRD(resources.RayCluster),
+		newWorkloadCRD(resources.PyTorchJob),
+	}
+}
+
+func TestListCommand_Validate(t *testing.T) {
+	t.Run("rejects unknown kind", func(t *testing.T) {
+		g := NewWithT(t)
+
+		cmd, _ := newListCommand(nil)
+		cmd.Kinds = []string{"pipeline"}
+
+		err := cmd.Validate()
+		g.Expect(err).To(MatchError(ContainSubstring(`unknown workload kind "pipeline"`)))
+	})
+
+	t.Run("rejects invalid output format", func(t *testing.T) {
+		g := NewWithT(t)
+
+		cmd, _ := newListCommand(nil)
+		cmd.OutputFormat = "xml"
+
+		g.Expect(cmd.Validate()).To(MatchError(ContainSubstring("invalid output format")))
+	})
+
+	t.Run("accepts known kinds case-insensitively", func(t *testing.T) {
+		g := NewWithT(t)
+
+		cmd, _ := newListCommand(nil)
+		cmd.Kinds = []string{"Notebook", "isvc", "ray", "pytorchjob"}
+
+		g.Expect(cmd.Validate()).To(Succeed())
+	})
+}
+
+func TestListCommand_Run(t *testing.T) {
+	t.Run("lists discovered workloads with owner and deployment mode", func(t *testing.T) {
+		g := NewWithT(t)
+
+		isvc := newWorkload(resources.InferenceService, "team-a", "llm", map[string]any{
+			workloads.AnnotationDeploymentMode: "RawDeployment",
+		})
+		nb := newWorkload(resources.Notebook, "team-b", "wb", map[string]any{
+			workloads.AnnotationUsername: "alice",
+		})
+		ray := newWorkload(resources.RayCluster, "team-a", "ray", nil)
+		ray.SetOwnerReferences([]metav1.OwnerReference{
+			{APIVersion: resources.RayJob.APIVersion(), Kind: resources.RayJob.Kind, Name: "train", Controller: new(true)},
+		})
+
+		cmd, out := newListCommand(allWorkloadCRDs(), isvc, nb, ray)
+		cmd.OutputFormat = "json"
+
+		g.Expect(cmd.Validate()).To(Succeed())
+		g.Expect(cmd.Run(t.Context())).To(Succeed())
+
+		var list workloads.WorkloadList
+		g.Expect(json.Unmarshal(out.Bytes(), &list)).To(Succeed())
+		g.Expect(list.Kind).To(Equal("WorkloadList"))
+		g.Expect(list.Workloads).To(Equal([]workloads.WorkloadInfo{
+			{Kind: "InferenceService", Namespace: "team-a", Name: "llm", DeploymentMode: "RawDeployment", CreatedAt: testTimestamp},
+			{Kind: "Notebook", Namespace: "team-b", Name: "wb", Owner: "alice", CreatedAt: testTimestamp},
+			{Kind: "RayCluster", Namespace: "team-a", Name: "ray", Owner: "RayJob/train", CreatedAt: testTimestamp},
+		}))
+	})
+
+	t.Run("restricts listing to the selected kinds", func(t *testing.T) {
+		g := NewWithT(t)
+
+		cmd, out := newListCommand(allWorkloadCRDs(),
+			newWorkload(resources.InferenceService, "team-a", "llm", nil),
+			newWorkload(resources.Notebook, "team-b", "wb", nil),
+		)
+		cmd.Kinds = []string{workloads.KindNotebook}
+
+		g.Expect(cmd.Validate()).To(Succeed())
+		g.Expect(cmd.Run(t.Context())).To(Succeed())
+
+		g.Expect(out.String()).To(ContainSubstring("wb"))
+		g.Expect(out.String()).ToNot(ContainSubstring("llm"))
+	})
+
+	t.Run("restricts listing to a namespace", func(t *testing.T) {
+		g := NewWithT(t)
+
+		cmd, out := newListCommand(allWorkloadCRDs(),
+			newWorkload(resources.Notebook, "team-a", "wb-a", nil),
+			newWorkload(resources.Notebook, "team-b", "wb-b", nil),
+		)
+		cmd.Namespace = "team-a"
+
+		g.Expect(cmd.Validate()).To(Succeed())
+		g.Expect(cmd.Run(t.Context())).To(Succeed())
+
+		g.Expect(out.String()).To(ContainSubstring("wb-a"))
+		g.Expect(out.String()).ToNot(ContainSubstring("wb-b"))
+	})
+
+	t.Run("skips workload kinds that are not installed", func(t *testing.T) {
+		g := NewWithT(t)
+
+		cmd, out := newListCommand([]runtime.Object{newWorkloadCRD(resources.Notebook)},
+			newWorkload(resources.Notebook, "team-a", "wb", nil),
+			newWorkload(resources.InferenceService, "team-a", "llm", nil),
+		)
+
+		g.Expect(cmd.Validate()).To(Succeed())
+		g.Expect(cmd.Run(t.Context())).To(Succeed())
+
+		g.Expect(out.String()).To(ContainSubstring("KIND"))
+		g.Expect(out.String()).To(ContainSubstring("wb"))
+		g.Expect(out.String()).ToNot(ContainSubstring("llm"))
+	})
+
+	t.Run("reports when no workloads are found", func(t *testing.T) {
+		g := NewWithT(t)
+
+		cmd, out := newListCommand(allWorkloadCRDs())
+
+		g.Expect(cmd.Validate()).To(Succeed())
+		g.Expect(cmd.Run(t.Context())).To(Succeed())
+
+		g.Expect(out.String()).To(ContainSubstring("No workloads found."))
+	})
+}
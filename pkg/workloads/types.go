@@ -0,0 +1,33 @@
+package workloads
+
+import (
+	"github.com/opendatahub-io/odh-cli/pkg/output"
+)
+
+// WorkloadInfo describes a single AI workload discovered on the cluster.
+type WorkloadInfo struct {
+	Kind           string `json:"kind"                     yaml:"kind"`
+	Namespace      string `json:"namespace"                yaml:"namespace"`
+	Name           string `json:"name"                     yaml:"name"`
+	Owner          string `json:"owner,omitempty"          yaml:"owner,omitempty"`
+	DeploymentMode string `json:"deploymentMode,omitempty" yaml:"deploymentMode,omitempty"`
+	CreatedAt      string `json:"createdAt,omitempty"      yaml:"createdAt,omitempty"`
+}
+
+// WorkloadList wraps a slice of WorkloadInfo with a self-describing envelope.
+type WorkloadList struct {
+	output.Envelope
+
+	Workloads []WorkloadInfo `json:"workloads" yaml:"workloads"`
+}
+
+// NewWorkloadList creates a new WorkloadList with envelope fields populated.
+func NewWorkloadList(workloads []WorkloadInfo) *WorkloadList {
+	list := &WorkloadList{
+		Envelope:  output.NewEnvelope("WorkloadList", "workloads-list"),
+		Workloads: workloads,
+	}
+	list.SetStatus(0, 0)
+
+	return list
+}
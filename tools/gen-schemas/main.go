@@ -5,9 +5,12 @@ import (
 	"io"
 	"os"
 	"path/filepath"
+	"reflect"
 
 	"github.com/invopop/jsonschema"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
 	"github.com/opendatahub-io/odh-cli/internal/version"
 	"github.com/opendatahub-io/odh-cli/pkg/components"
 	"github.com/opendatahub-io/odh-cli/pkg/deps"
@@ -38,6 +41,7 @@ func main() {
 
 	reflector := &jsonschema.Reflector{
 		DoNotReference: true,
+		Mapper:         mapType,
 	}
 
 	for _, t := range targets {
@@ -63,3 +67,13 @@ func main() {
 		_, _ = io.WriteString(os.Stdout, "Generated "+outputPath+"\n")
 	}
 }
+
+// mapType overrides the schema of types whose JSON form differs from their Go structure.
+func mapType(t reflect.Type) *jsonschema.Schema {
+	// metav1.Time marshals to an RFC 3339 string rather than an object
+	if t == reflect.TypeFor[metav1.Time]() {
+		return &jsonschema.Schema{Type: "string", Format: "date-time"}
+	}
+
+	return nil
+}